@@ -12,10 +12,11 @@ import (
 	"github.com/gin-gonic/gin"
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
 )
 
 type createAccountRequest struct {
-	Currency string `json:"currency" binding:"required,currency"`
+	Currency string `json:"currency" binding:"currency"`
 }
 
 /*
@@ -34,10 +35,16 @@ func (server *Server) createAccount(ctx *gin.Context) {
 
 	//这段代码确保用户只能为自己创建账户。它从上下文中获取授权载荷（authPayload），这包含了用户名等信息，并用它来设置新账户的所有者。
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	number, err := util.GenerateAccountNumber()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
 	arg := db.CreateAccountParams{
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Number:   number,
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -59,12 +66,18 @@ type getAccountRequest struct {
 	ID int64 `uri:"id" binding:"required,min=1"`
 }
 
+// accountResponse 在账户信息之外返回可用余额，也就是余额减去所有 active hold 冻结的金额
+type accountResponse struct {
+	db.Account
+	AvailableBalance int64 `json:"available_balance"`
+}
+
 /*
 getAccount 函数处理获取特定银行账户信息的GET请求。
 它从请求的URI中提取账户ID，并尝试将其绑定到getAccountRequest结构体。
 如果URI中没有有效的ID（或者ID小于1），它会返回400状态码和错误信息。
 如果URI绑定成功，它使用账户ID调用server.store.GetAccount方法来获取账户详情。
-如果找到了账户，它返回200状态码和账户信息；如果账户不存在，它返回404状态码（NotFound）和错误信息；如果出现其他错误，它返回500状态码和错误信息。
+如果找到了账户，它返回200状态码和账户信息（包括扣除 hold 之后的可用余额）；如果账户不存在，它返回404状态码（NotFound）和错误信息；如果出现其他错误，它返回500状态码和错误信息。
 */
 func (server *Server) getAccount(ctx *gin.Context) {
 	var req getAccountRequest
@@ -73,41 +86,83 @@ func (server *Server) getAccount(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
+	// 没有找到账户返回404，账户不属于已认证的用户（banker 除外）返回401，见 authorizedAccount
+	account, valid := server.authorizedAccount(ctx, req.ID)
+	if !valid {
+		return
+	}
+
+	held, err := server.store.GetHeldAmount(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accountResponse{
+		Account:          account,
+		AvailableBalance: account.Balance - held,
+	})
+}
+
+type getAccountByNumberRequest struct {
+	Number string `form:"number" binding:"account_number"`
+}
+
+/*
+getAccountByNumber 按账号查询账户，和 getAccount 一样返回可用余额。
+只有账户的所有者和 banker 可以查看，别人的账户和不存在的账户一样返回404，不能用这个接口试出哪些账号存在。
+*/
+func (server *Server) getAccountByNumber(ctx *gin.Context) {
+	var req getAccountByNumberRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, err := server.store.GetAccountByNumber(ctx, util.NormalizeAccountNumber(req.Number))
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			// 如果没有找到账户，返回404状态码
 			ctx.JSON(http.StatusNotFound, errorResponse(err))
 			return
 		}
-
-		// 对于所有其他类型的错误，返回500状态码
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	//在这里，代码检查请求的账户是否属于已认证的用户。如果请求获取的账户不属于发起请求的用户，将返回一个HTTP 401（未授权）错误。
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	if account.Owner != authPayload.Username {
-		err := errors.New("account doesn't belong to the authenticated user")
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+	if authPayload.Role != util.BankerRole && account.Owner != authPayload.Username {
+		ctx.JSON(http.StatusNotFound, errorResponse(db.ErrRecordNotFound))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, account)
+	held, err := server.store.GetHeldAmount(ctx, account.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accountResponse{
+		Account:          account,
+		AvailableBalance: account.Balance - held,
+	})
 }
 
 type listAccountRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	PageToken string `form:"page_token"`
+	PageSize  int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+type listAccountResponse struct {
+	Accounts      []db.Account `json:"accounts"`
+	NextPageToken string       `json:"next_page_token"`
 }
 
 /*
 listAccounts 函数处理列出银行账户的GET请求。
 它从请求的查询字符串中提取分页参数，并尝试将其绑定到listAccountRequest结构体。
-如果查询字符串中的分页参数不符合要求，它会返回400状态码和错误信息。
-如果绑定成功，它将计算要查询的数据的偏移量，并调用server.store.ListAccounts方法来获取账户列表。
-如果成功获取到账户列表，它返回200状态码和账户列表；如果出现服务器内部错误，它返回500状态码和错误信息。
+如果查询字符串中的分页参数不符合要求，或者 page_token 无法解析，它会返回400状态码和错误信息。
+这里使用的是游标分页：从 page_token 中解出上一页最后一个账户的ID，只查询ID比它大的账户，
+并且多查一条用来判断是否还有下一页，有的话就在响应里返回 next_page_token。
 */
 func (server *Server) listAccounts(ctx *gin.Context) {
 	var req listAccountRequest
@@ -116,12 +171,18 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
+	afterID, err := util.DecodePageToken(req.PageToken)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
 	//此代码用于列出属于已认证用户的所有账户。它使用授权载荷中的用户名来查询数据库，并返回属于该用户的所有账户。
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
 	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+		Owner:   authPayload.Username,
+		AfterID: afterID,
+		Limit:   req.PageSize + 1,
 	}
 
 	accounts, err := server.store.ListAccounts(ctx, arg)
@@ -130,7 +191,41 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, accounts)
+	accounts, nextPageToken := util.Paginate(accounts, req.PageSize, func(account db.Account) int64 {
+		return account.ID
+	})
+
+	ctx.JSON(http.StatusOK, listAccountResponse{
+		Accounts:      accounts,
+		NextPageToken: nextPageToken,
+	})
+}
+
+/*
+authorizedAccount 根据URI中的账户ID查询账户，并确认已认证的用户可以访问它：账户的所有者本人，或者 banker，
+规则和 gapi 的 authorizeAccount 一样。
+查询账户、列出转账记录和账户流水的接口都需要先做这个检查，出错时已经写好了响应，调用方直接返回即可。
+*/
+func (server *Server) authorizedAccount(ctx *gin.Context, accountID int64) (db.Account, bool) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return account, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return account, false
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload.Role != util.BankerRole && account.Owner != authPayload.Username {
+		err := errors.New("account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
 }
 
 /*
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+type listEntryRequest struct {
+	PageToken string `form:"page_token"`
+	PageSize  int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+type listEntryResponse struct {
+	Entries       []db.Entry `json:"entries"`
+	NextPageToken string     `json:"next_page_token"`
+}
+
+/*
+listEntries 列出某个账户的资金流水（entries），只有账户的所有者才能查看。
+和 listAccounts、listTransfers 一样使用游标分页。
+*/
+func (server *Server) listEntries(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listEntryRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	afterID, err := util.DecodePageToken(req.PageToken)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizedAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	arg := db.ListEntriesParams{
+		AccountID: account.ID,
+		AfterID:   afterID,
+		Limit:     req.PageSize + 1,
+	}
+
+	entries, err := server.store.ListEntries(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entries, nextPageToken := util.Paginate(entries, req.PageSize, func(entry db.Entry) int64 {
+		return entry.ID
+	})
+
+	ctx.JSON(http.StatusOK, listEntryResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	})
+}
@@ -8,16 +8,19 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
 )
 
-func newTestServer(t *testing.T, store db.Store) *Server {
+func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
+		LinkSigningKey:      util.RandomString(32),
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store)
+	server, err := NewServer(config, store, taskDistributor, token.NewInMemoryRevocationStore())
 	require.NoError(t, err)
 
 	return server
@@ -6,13 +6,22 @@ package api
 //这是一种常见的身份验证方法，用于保护需要授权的HTTP端点
 
 import (
+	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
+	"runtime/debug"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/cors"
+	"github.com/techschool/bank/ratelimit"
 	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
 )
 
 // 定义了一些常量，包括
@@ -39,7 +48,9 @@ const (
 如果验证成功，将令牌有效负载存储在请求的上下文中，以便后续的处理函数可以使用。
 */
 //gin.HandlerFunc是一个类型，它是对应于Gin框架中的请求处理函数的签名。
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+//
+// 和 gapi 的 verifyAuthorizationHeader 一样，被撤销的令牌（revocationStore）在过期之前也不能再用。
+func authMiddleware(tokenMaker token.Maker, revocationStore token.RevocationStore) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		authorizationHeader := ctx.GetHeader(authorizationHeaderKey)
 
@@ -65,7 +76,7 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		}
 
 		accessToken := fields[1]
-		payload, err := tokenMaker.VerifyToken(accessToken)
+		payload, err := verifyAccessToken(ctx, tokenMaker, revocationStore, accessToken)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusUnauthorized, errorResponse(err))
 			return
@@ -76,3 +87,170 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// verifyAccessToken 验证令牌，并检查令牌有没有被管理员撤销
+func verifyAccessToken(ctx context.Context, tokenMaker token.Maker, revocationStore token.RevocationStore, accessToken string) (*token.Payload, error) {
+	payload, err := tokenMaker.VerifyToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := revocationStore.IsRevoked(ctx, payload.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check access token: %s", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("invalid access token: %s", token.ErrRevokedToken)
+	}
+
+	// 管理员禁用用户或者强制重置密码之前签发的令牌也不能再用
+	revoked, err = revocationStore.IsUserRevoked(ctx, payload.Username, payload.IssuedAt)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check access token: %s", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("invalid access token: %s", token.ErrRevokedToken)
+	}
+	return payload, nil
+}
+
+// corsMiddleware 让 Gin 版本的 API 和 HTTP 网关使用同一套 CORS 配置，
+// 预检请求在这里直接返回，不会走到后面的路由。
+func corsMiddleware(policy *cors.Policy) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		allowed := policy.Apply(ctx.Writer, ctx.Request)
+		if cors.IsPreflight(ctx.Request) {
+			if allowed {
+				ctx.AbortWithStatus(http.StatusNoContent)
+			} else {
+				ctx.AbortWithStatus(http.StatusForbidden)
+			}
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// timeoutMiddleware 给请求的 ctx 加上超时。处理函数把 *gin.Context 直接传给 store，
+// 所以路由要打开 ContextWithFallback，gin.Context 的 Deadline/Done 才会用 Request.Context()。
+func timeoutMiddleware(timeout time.Duration) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		timeoutCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
+
+		ctx.Request = ctx.Request.WithContext(timeoutCtx)
+		ctx.Next()
+	}
+}
+
+/*
+middlewares 返回所有路由共用的中间件，顺序和 gapi.Server.UnaryInterceptors 的拦截器链一致：
+tracing、日志、panic 恢复、metrics、限流、超时，最后是 CORS。
+
+认证只用在需要登录的路由组上（authMiddleware），在全局中间件之后执行，
+所以 Gin 版本的限流只能按客户端 IP 计算；参数校验由各个处理函数的 binding 完成。
+*/
+func (server *Server) middlewares() []gin.HandlerFunc {
+	middlewares := []gin.HandlerFunc{
+		tracingMiddleware(),
+		loggerMiddleware(),
+		recoveryMiddleware(),
+		metricsMiddleware(),
+		rateLimitMiddleware(server.rateLimiter),
+	}
+
+	if server.config.RequestTimeout > 0 {
+		middlewares = append(middlewares, timeoutMiddleware(server.config.RequestTimeout))
+	}
+
+	if policy := cors.NewPolicy(server.config); policy.Enabled() {
+		middlewares = append(middlewares, corsMiddleware(policy))
+	}
+
+	return middlewares
+}
+
+// tracingMiddleware 读取或生成 W3C traceparent，放进请求的 ctx 并写回响应头
+func tracingMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		trace := util.NewTraceContext(ctx.GetHeader(util.TraceparentHeader))
+		ctx.Request = ctx.Request.WithContext(util.ContextWithTrace(ctx.Request.Context(), trace))
+		ctx.Header(util.TraceparentHeader, trace.Traceparent())
+		ctx.Next()
+	}
+}
+
+// loggerMiddleware 用 zerolog 记录请求，格式和 HTTP 网关的 gapi.HttpLogger 一样
+func loggerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		startTime := time.Now()
+		ctx.Next()
+		duration := time.Since(startTime)
+
+		statusCode := ctx.Writer.Status()
+		logger := log.Info()
+		if statusCode >= http.StatusBadRequest {
+			logger = log.Error()
+		}
+		if trace, ok := util.TraceFromContext(ctx.Request.Context()); ok {
+			logger = logger.Str("trace_id", trace.TraceID)
+		}
+
+		logger.Str("protocol", "http").
+			Str("method", ctx.Request.Method).
+			Str("path", ctx.Request.RequestURI).
+			Int("status_code", statusCode).
+			Str("status_text", http.StatusText(statusCode)).
+			Dur("duration", duration).
+			Msg("received a HTTP request")
+	}
+}
+
+// recoveryMiddleware 捕获 panic，记录调用栈并返回 500，代替 gin.Recovery
+func recoveryMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			if r := recover(); r != nil {
+				if r == http.ErrAbortHandler {
+					panic(r)
+				}
+				log.Error().Str("protocol", "http").
+					Str("method", ctx.Request.Method).
+					Str("path", ctx.Request.RequestURI).
+					Interface("panic", r).
+					Str("stack", string(debug.Stack())).
+					Msg("recovered from panic")
+				ctx.AbortWithStatusJSON(http.StatusInternalServerError, errorResponse(errors.New("internal server error")))
+			}
+		}()
+
+		ctx.Next()
+	}
+}
+
+// ginRequests 按 "路由 状态码" 统计请求数，路由用的是注册时的模板（例如 /accounts/:id）
+var ginRequests = expvar.NewMap("gin_http_requests")
+
+func metricsMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		ginRequests.Add(route+" "+strconv.Itoa(ctx.Writer.Status()), 1)
+	}
+}
+
+// rateLimitMiddleware 按客户端 IP 限流，超过限制返回 429
+func rateLimitMiddleware(limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !limiter.Allow("ip:" + ctx.ClientIP()) {
+			err := errors.New("too many requests, please try again later")
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, errorResponse(err))
+			return
+		}
+		ctx.Next()
+	}
+}
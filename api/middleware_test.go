@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/ratelimit"
 	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
 )
@@ -105,12 +107,12 @@ func TestAuthMiddleware(t *testing.T) {
 		tc := testCases[i]
 
 		t.Run(tc.name, func(t *testing.T) {
-			server := newTestServer(t, nil)
+			server := newTestServer(t, nil, nil)
 			authPath := "/auth"
 			server.router.GET(
 				authPath,
 				//在定义路由时使用authMiddleware中间件，这样可以在请求到达处理函数之前验证授权。
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.revocationStore),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -126,3 +128,116 @@ func TestAuthMiddleware(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddlewareRevokedToken(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	authPath := "/auth"
+	server.router.GET(
+		authPath,
+		authMiddleware(server.tokenMaker, server.revocationStore),
+		func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gin.H{})
+		},
+	)
+
+	accessToken, payload, err := server.tokenMaker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	getAuth := func() int {
+		request, err := http.NewRequest(http.MethodGet, authPath, nil)
+		require.NoError(t, err)
+		request.Header.Set(authorizationHeaderKey, fmt.Sprintf("%s %s", authorizationTypeBearer, accessToken))
+
+		recorder := httptest.NewRecorder()
+		server.router.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+	require.Equal(t, http.StatusOK, getAuth())
+
+	// 被撤销的令牌在过期之前也不能再用
+	err = server.revocationStore.Revoke(context.Background(), payload.ID, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusUnauthorized, getAuth())
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	config := util.Config{
+		TokenSymmetricKey:  util.RandomString(32),
+		CORSAllowedOrigins: "https://app.example.com",
+	}
+	server, err := NewServer(config, nil, nil, token.NewInMemoryRevocationStore())
+	require.NoError(t, err)
+
+	// 预检请求直接返回 204，不需要授权
+	request, err := http.NewRequest(http.MethodOptions, "/accounts", nil)
+	require.NoError(t, err)
+	request.Header.Set("Origin", "https://app.example.com")
+	request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+
+	recorder := httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	// 普通请求照常走授权检查，但带上 CORS 头，浏览器才能读到错误信息
+	request, err = http.NewRequest(http.MethodGet, "/accounts", nil)
+	require.NoError(t, err)
+	request.Header.Set("Origin", "https://app.example.com")
+
+	recorder = httptest.NewRecorder()
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Equal(t, "https://app.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(timeoutMiddleware(time.Second))
+
+	router.GET("/timeout", func(ctx *gin.Context) {
+		// 处理函数直接把 *gin.Context 当作 context.Context 使用
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+		ctx.Status(http.StatusOK)
+	})
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/timeout", nil)
+	require.NoError(t, err)
+
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	server.router.GET("/panic", func(ctx *gin.Context) {
+		panic("something went wrong")
+	})
+
+	recorder := httptest.NewRecorder()
+	request, err := http.NewRequest(http.MethodGet, "/panic", nil)
+	require.NoError(t, err)
+
+	server.router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	require.NotContains(t, recorder.Body.String(), "something went wrong")
+	require.NotEmpty(t, recorder.Header().Get(util.TraceparentHeader))
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	server.rateLimiter = ratelimit.NewLimiter(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 1})
+	server.setupRouter()
+
+	for _, expected := range []int{http.StatusUnauthorized, http.StatusTooManyRequests} {
+		recorder := httptest.NewRecorder()
+		request, err := http.NewRequest(http.MethodGet, "/accounts", nil)
+		require.NoError(t, err)
+
+		server.router.ServeHTTP(recorder, request)
+		require.Equal(t, expected, recorder.Code)
+	}
+}
@@ -5,14 +5,20 @@ package api
 */
 
 import (
+	"errors"
+	"expvar"
 	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/geoip"
+	"github.com/techschool/bank/ratelimit"
 	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
 )
 
 // Server serves HTTP requests for our banking service.
@@ -22,51 +28,87 @@ store: 这是 db.Store 类型的一个字段，它是一个接口，定义了一
 router: 这是 *gin.Engine 类型的字段，它是 gin 框架的核心，用于处理 HTTP 请求和路由。
 */
 type Server struct {
-	config     util.Config
-	store      db.Store
-	tokenMaker token.Maker
-	router     *gin.Engine
+	config          util.Config
+	store           db.Store
+	tokenMaker      token.Maker
+	revocationStore token.RevocationStore
+	taskDistributor worker.TaskDistributor
+	rateLimiter     *ratelimit.Limiter
+	geoLocator      geoip.Locator
+	router          *gin.Engine
 }
 
 // NewServer creates a new HTTP server and set up routing.
 // NewServer 函数接受一个 db.Store 接口类型的参数，并返回一个 *Server 指针。这个函数初始化了一个 Server 结构体实例，并设置了相关的路由处理。
-func NewServer(config util.Config, store db.Store) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, revocationStore token.RevocationStore) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	geoLocator, err := geoip.NewLocator(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create geoip locator: %w", err)
+	}
+
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:          config,
+		store:           store,
+		tokenMaker:      tokenMaker,
+		revocationStore: revocationStore,
+		taskDistributor: taskDistributor,
+		rateLimiter:     ratelimit.NewLimiter(config),
+		geoLocator:      geoLocator,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-		v.RegisterValidation("currency", validCurrency)
+		registerFieldRules(v)
 	}
 
 	server.setupRouter()
 	return server, nil
 }
 
+/*
+setupRouter 注册 Gin 版本的路由。新功能只在 gapi 里实现，这里保留用户、账户和转账这些基础接口，
+每个接口的权限规则（用户本人或者 banker）和 gapi 里对应的 RPC 保持一致。
+*/
 func (server *Server) setupRouter() {
-	router := gin.Default()
+	// 日志和 panic 恢复用我们自己的中间件，所以不用 gin.Default()
+	router := gin.New()
+	router.ContextWithFallback = true
+	router.Use(server.middlewares()...)
+
+	// 中间件统计的请求数等数据通过 expvar 发布，和网关一样只在开发环境公开
+	if server.config.DebugEnabled() {
+		router.GET("/debug/vars", gin.WrapH(expvar.Handler()))
+	}
+	router.GET("/version", server.getVersion)
 
 	router.POST("/users", server.createUser)
 	router.POST("/users/login", server.loginUser)
 	router.POST("/tokens/renew_access", server.renewAccessToken)
+	router.GET("/verify_email", server.verifyEmail)
 
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.revocationStore))
+	authRoutes.PATCH("/users/:username", server.updateUser)
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccount)
+	authRoutes.GET("/account_by_number", server.getAccountByNumber)
 	authRoutes.GET("/accounts", server.listAccounts)
+	authRoutes.GET("/accounts/:id/transfers", server.listTransfers)
+	authRoutes.GET("/accounts/:id/entries", server.listEntries)
 
 	authRoutes.POST("/transfers", server.createTransfer)
 
 	server.router = router
 }
 
+// Handler returns the router, for serving the API with a custom http.Server.
+func (server *Server) Handler() http.Handler {
+	return server.router
+}
+
 // Start runs the HTTP server on a specific address.
 /*
 Start 方法接受一个字符串类型的 address 参数，表示服务器监听的地址和端口（
@@ -81,7 +123,12 @@ func (server *Server) Start(address string) error {
 errorResponse 函数接受一个 error 类型的参数，并返回一个 gin.H 类型，
 这是一个 map[string]interface{} 的别名。它用于创建一个 JSON 响应，
 其中包含了错误信息。这个函数通常在处理 HTTP 请求时遇到错误时被调用，以返回错误详情给客户端。
+参数校验失败时返回和 gapi 一样的 invalid parameters 和 field_violations，见 validationErrorResponse。
 */
 func errorResponse(err error) gin.H {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return validationErrorResponse(validationErrs)
+	}
 	return gin.H{"error": err.Error()}
 }
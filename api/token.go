@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/token"
 )
 
 /*
@@ -78,6 +79,43 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	// 被 BlockSession 或者 RevokeSession 撤销的 refresh token 不能再续期
+	revoked, err := server.revocationStore.IsRevoked(ctx, refreshPayload.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if revoked {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(token.ErrRevokedToken))
+		return
+	}
+
+	// 和 loginUser 一样，用户在登录之后被禁用、申请了删除或者被要求重置密码，都不能再续期
+	user, err := server.store.GetUser(ctx, session.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if user.IsDisabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(errUserDisabled))
+		return
+	}
+
+	if user.DeletionScheduledAt.Valid {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errAccountDeletionPending))
+		return
+	}
+
+	if user.PasswordResetRequired {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errPasswordResetRequired))
+		return
+	}
+
 	//创建新的access token: 如果所有验证步骤都通过，使用用户信息和配置的有效期创建新的access token
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		refreshPayload.Username,
@@ -4,22 +4,37 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
 )
 
 /*
 定义了一个transferRequest结构体，它用来表示一个转账请求的数据结构，
 包含了转出账户ID (FromAccountID)、转入账户ID (ToAccountID)、转账金额 (Amount)
 和货币类型 (Currency)。这些字段都使用了binding标签来指定验证规则。
+金额可以用最小货币单位的整数 (Amount)，也可以用十进制字符串 (AmountDecimal，比如 "12.34")，
+十进制字符串的小数位数要符合货币的规定，见 util.Money。
+转入账户可以用 ID (ToAccountID) 指定，也可以用收款人给的账号 (ToAccountNumber) 指定，
+还可以只给收款人的用户名或邮箱 (ToRecipient)，转到收款人同币种的账户，三者只能用一个。
+转到另一种货币的账户要先用 GetTransferQuote 拿到报价，再带上报价的 ID (QuoteID)，见 createFXTransfer。
 */
 type transferRequest struct {
-	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
-	Amount        int64  `json:"amount" binding:"required,gt=0"`
-	Currency      string `json:"currency" binding:"required,currency"`
+	FromAccountID   int64  `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID     int64  `json:"to_account_id" binding:"omitempty,min=1"`
+	ToAccountNumber string `json:"to_account_number" binding:"omitempty,account_number"`
+	ToRecipient     string `json:"to_recipient" binding:"omitempty,recipient"`
+	Amount          int64  `json:"amount" binding:"omitempty,amount"`
+	AmountDecimal   string `json:"amount_decimal"`
+	Currency        string `json:"currency" binding:"currency"`
+	Category        string `json:"category" binding:"omitempty,category"`
+	Memo            string `json:"memo" binding:"max=255"`
+	Reference       string `json:"reference" binding:"max=64"`
+	QuoteID         int64  `json:"quote_id" binding:"omitempty,min=1"`
 }
 
 /*
@@ -36,6 +51,25 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
+	if countSet(req.ToAccountID != 0, req.ToAccountNumber != "", req.ToRecipient != "") != 1 {
+		ctx.JSON(http.StatusBadRequest, fieldViolationsResponse([]fieldViolation{{
+			Field:       "to_account_id",
+			Description: "exactly one of to_account_id, to_account_number and to_recipient is required",
+		}}))
+		return
+	}
+
+	if req.QuoteID != 0 {
+		server.createFXTransfer(ctx, req)
+		return
+	}
+
+	amount, err := val.ValidateMoney(req.Amount, req.AmountDecimal, req.Currency)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("amount %s", err)))
+		return
+	}
+
 	//在转账API端点，代码首先验证请求的起始账户是否属于已认证的用户。
 	//如果不属于，将阻止操作并返回HTTP 401错误。然后，它会验证目标账户的有效性，
 	//但不检查目标账户的所有者，因为用户可以向任何账户转账。
@@ -51,7 +85,24 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
-	_, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	var toAccount db.Account
+	switch {
+	case req.ToRecipient != "":
+		toAccount, valid = server.recipientAccount(ctx, authPayload.Username, req.ToRecipient, req.Currency)
+	case req.ToAccountNumber != "":
+		toAccount, valid = server.validAccountByNumber(ctx, req.ToAccountNumber, req.Currency)
+	default:
+		toAccount, valid = server.validAccount(ctx, req.ToAccountID, req.Currency)
+	}
+	if !valid {
+		return
+	}
+
+	if !server.withinKYCTransferLimit(ctx, authPayload.Username, amount) {
+		return
+	}
+
+	fee, feeAccountID, valid := server.transferFee(ctx, authPayload.Role, req.Currency, amount)
 	if !valid {
 		return
 	}
@@ -59,17 +110,27 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 	//如果所有验证都通过了，函数会构造一个TransferTxParams结构体实例，并调用TransferTx方法进行转账操作。
 	arg := db.TransferTxParams{
 		FromAccountID: req.FromAccountID,
-		ToAccountID:   req.ToAccountID,
-		Amount:        req.Amount,
+		ToAccountID:   toAccount.ID,
+		Amount:        amount,
+		Category:      req.Category,
+		Memo:          req.Memo,
+		Reference:     req.Reference,
+		Fee:           fee,
+		FeeAccountID:  feeAccountID,
 	}
 
 	/*
 		ransferTx是在数据库层面处理事务的函数。如果转账操作成功，会返回转账的结果。
-		如果操作失败，比如数据库错误，会返回HTTP 500（服务器内部错误）响应。
+		余额不够返回 HTTP 400，其他的失败，比如数据库错误，会返回HTTP 500（服务器内部错误）响应。
 		如果转账成功，函数会返回HTTP 200响应，以及一个包含转账详情的JSON对象。
 	*/
 	result, err := server.store.TransferTx(ctx, arg)
 	if err != nil {
+		// 余额不够和 gapi 一样是 FailedPrecondition，按 HTTP 网关的映射返回 400
+		if errors.Is(err, db.ErrInsufficientBalance) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -78,6 +139,115 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 
 }
 
+// errQuoteNotFound 在报价不存在和报价属于别人时都返回，不暴露别人的报价
+var errQuoteNotFound = errors.New("transfer quote not found")
+
+/*
+createFXTransfer 按报价把钱转到另一种货币的账户，汇率、手续费和到账金额都以报价为准。
+请求里的 currency 必须是报价的转出货币，金额可以不填，填了就必须和报价的 from_amount 一样；
+转入账户（ID、账号或收款人）必须是报价的转入货币。
+报价过期返回 400，已经用过返回 409，转账本身见 db.FXTransferTx。
+*/
+func (server *Server) createFXTransfer(ctx *gin.Context, req transferRequest) {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	quote, err := server.store.GetTransferQuote(ctx, req.QuoteID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errQuoteNotFound))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if quote.Username != authPayload.Username {
+		ctx.JSON(http.StatusNotFound, errorResponse(errQuoteNotFound))
+		return
+	}
+
+	if req.Currency != quote.FromCurrency {
+		err := fmt.Errorf("currency mismatch: quote is from %s", quote.FromCurrency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+	if req.Amount != 0 || req.AmountDecimal != "" {
+		amount, err := val.ValidateMoney(req.Amount, req.AmountDecimal, req.Currency)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("amount %s", err)))
+			return
+		}
+		if amount != quote.FromAmount {
+			err := fmt.Errorf("amount %d doesn't match the quoted amount %d", amount, quote.FromAmount)
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.FromAccountID, quote.FromCurrency)
+	if !valid {
+		return
+	}
+	if fromAccount.Owner != authPayload.Username {
+		err := errors.New("from account doesn't belong to the authenticated user")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	var toAccount db.Account
+	switch {
+	case req.ToRecipient != "":
+		toAccount, valid = server.recipientAccount(ctx, authPayload.Username, req.ToRecipient, quote.ToCurrency)
+	case req.ToAccountNumber != "":
+		toAccount, valid = server.validAccountByNumber(ctx, req.ToAccountNumber, quote.ToCurrency)
+	default:
+		toAccount, valid = server.validAccount(ctx, req.ToAccountID, quote.ToCurrency)
+	}
+	if !valid {
+		return
+	}
+
+	if !server.withinKYCTransferLimit(ctx, authPayload.Username, quote.FromAmount) {
+		return
+	}
+
+	fxAccounts, err := util.ParseCurrencyAccounts(server.config.FXAccounts)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+	if fxAccounts[quote.FromCurrency] == 0 || fxAccounts[quote.ToCurrency] == 0 {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(util.ErrUnsupportedCurrencyPair))
+		return
+	}
+
+	result, err := server.store.FXTransferTx(ctx, db.FXTransferTxParams{
+		QuoteID:         quote.ID,
+		Username:        authPayload.Username,
+		FromAccountID:   fromAccount.ID,
+		ToAccountID:     toAccount.ID,
+		FromFXAccountID: fxAccounts[quote.FromCurrency],
+		ToFXAccountID:   fxAccounts[quote.ToCurrency],
+		Category:        req.Category,
+		Memo:            req.Memo,
+		Reference:       req.Reference,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrRecordNotFound):
+			ctx.JSON(http.StatusNotFound, errorResponse(errQuoteNotFound))
+		case errors.Is(err, db.ErrQuoteExpired), errors.Is(err, db.ErrInsufficientBalance):
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		case errors.Is(err, db.ErrQuoteUsed):
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
 /*
 validAccount函数会查询数据库，检查给定的账户ID是否存在，并且货币类型是否与请求中的货币类型相符。
 如果账户不存在或货币类型不匹配，会返回相应的HTTP错误响应。
@@ -102,3 +272,206 @@ func (server *Server) validAccount(ctx *gin.Context, accountID int64, currency s
 
 	return account, true
 }
+
+// validAccountByNumber 和 validAccount 一样，只是按账号查询，错误信息里只出现账号，不暴露账户的 ID
+func (server *Server) validAccountByNumber(ctx *gin.Context, number string, currency string) (db.Account, bool) {
+	number = util.NormalizeAccountNumber(number)
+	account, err := server.store.GetAccountByNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return account, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return account, false
+	}
+
+	if account.Currency != currency {
+		err := fmt.Errorf("account [%s] currency mismatch: %s vs %s", number, account.Currency, currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
+}
+
+// errRecipientUnavailable 是按用户名或邮箱转账失败时唯一的错误，
+// 不区分用户不存在、已停用、关闭了别名收款还是没有这个币种的账户，避免被用来探测用户名和邮箱
+var errRecipientUnavailable = errors.New("recipient cannot receive transfers in this currency")
+
+/*
+recipientAccount 把用户名或邮箱（包含 @ 的按邮箱查找）解析成收款人同币种的默认账户（见 SetDefaultAccount），
+没有设置默认账户时用这个币种最早开的账户。
+收款人可以用 allow_alias_transfers 关掉这种收款方式，所有找不到账户的情况都返回同样的 404。
+不能按用户名或邮箱转给自己。
+*/
+func (server *Server) recipientAccount(ctx *gin.Context, sender string, recipient string, currency string) (db.Account, bool) {
+	var user db.User
+	var err error
+	if strings.Contains(recipient, "@") {
+		user, err = server.store.GetUserByEmail(ctx, recipient)
+	} else {
+		user, err = server.store.GetUser(ctx, recipient)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errRecipientUnavailable))
+			return db.Account{}, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	if user.Username == sender {
+		err := errors.New("cannot transfer to yourself by username or email")
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return db.Account{}, false
+	}
+
+	if user.IsDisabled || user.DeletedAt.Valid || !user.AllowAliasTransfers {
+		ctx.JSON(http.StatusNotFound, errorResponse(errRecipientUnavailable))
+		return db.Account{}, false
+	}
+
+	account, err := server.store.GetAccountByOwnerCurrency(ctx, db.GetAccountByOwnerCurrencyParams{
+		Owner:    user.Username,
+		Currency: currency,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(errRecipientUnavailable))
+			return account, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return account, false
+	}
+
+	return account, true
+}
+
+type listTransferRequest struct {
+	PageToken string `form:"page_token"`
+	PageSize  int32  `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+type listTransferResponse struct {
+	Transfers     []db.Transfer `json:"transfers"`
+	NextPageToken string        `json:"next_page_token"`
+}
+
+/*
+listTransfers 列出某个账户转入和转出的所有转账记录，只有账户的所有者才能查看。
+分页方式和 listAccounts 一样，使用 page_token / next_page_token 的游标分页。
+*/
+func (server *Server) listTransfers(ctx *gin.Context) {
+	var uri getAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listTransferRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	afterID, err := util.DecodePageToken(req.PageToken)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizedAccount(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	arg := db.ListTransfersParams{
+		FromAccountID: account.ID,
+		ToAccountID:   account.ID,
+		AfterID:       afterID,
+		Limit:         req.PageSize + 1,
+	}
+
+	transfers, err := server.store.ListTransfers(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	transfers, nextPageToken := util.Paginate(transfers, req.PageSize, func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+
+	ctx.JSON(http.StatusOK, listTransferResponse{
+		Transfers:     transfers,
+		NextPageToken: nextPageToken,
+	})
+}
+
+// withinKYCTransferLimit 和 gRPC 版本的 checkKYCTransferLimit 一样，按用户的 KYC 等级限制单笔转账的金额
+func (server *Server) withinKYCTransferLimit(ctx *gin.Context, username string, amount int64) bool {
+	limits, err := util.ParseKYCTransferLimits(server.config.KYCTransferLimits)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+	if len(limits) == 0 {
+		return true
+	}
+
+	level, err := server.store.GetKYCLevel(ctx, username)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	if limit := limits[level]; limit > 0 && amount > limit {
+		err := fmt.Errorf("amount %d exceeds the transfer limit %d of your KYC level, upload KYC documents to raise it", amount, limit)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return false
+	}
+	return true
+}
+
+/*
+transferFee 按 TRANSFER_FEES 计算转账的手续费（见 util.FeeSchedule），手续费不算在 amount 里，由 TransferTx 另外扣，
+收手续费的币种必须在 FEE_ACCOUNTS 里有收入账户。
+*/
+func (server *Server) transferFee(ctx *gin.Context, role string, currency string, amount int64) (int64, int64, bool) {
+	schedule, err := util.ParseFeeSchedule(server.config.TransferFees)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return 0, 0, false
+	}
+
+	fee := schedule.Fee(currency, role, amount)
+	if fee == 0 {
+		return 0, 0, true
+	}
+
+	feeAccounts, err := util.ParseCurrencyAccounts(server.config.FeeAccounts)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return 0, 0, false
+	}
+	feeAccountID, ok := feeAccounts[currency]
+	if !ok {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(fmt.Errorf("no fee account for %s", currency)))
+		return 0, 0, false
+	}
+	return fee, feeAccountID, true
+}
+
+// countSet 返回 true 的个数，用来检查几个互斥的字段是不是正好设置了一个
+func countSet(fields ...bool) int {
+	n := 0
+	for _, set := range fields {
+		if set {
+			n++
+		}
+	}
+	return n
+}
@@ -1,27 +1,38 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/geoip"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/worker"
 )
 
 /*
 这段代码定义了一个HTTP处理函数createUser，用于创建新用户。
 这是一个典型的注册功能，接收用户名、密码、全名和电子邮件等信息。
 createUserRequest结构体用于映射和验证客户端请求的JSON体。
-它使用了binding标签来要求所有字段都是必须的，并且对于用户名要求是字母数字，密码至少6个字符，电子邮件要满足电子邮件格式。
+它使用了binding标签来验证所有字段，规则来自 val 包，和 gapi 的 CreateUser 一样（见 validator.go）。
 */
 type createUserRequest struct {
-	Username string `json:"username" binding:"required,alphanum"`
-	Password string `json:"password" binding:"required,min=6"`
-	FullName string `json:"full_name" binding:"required"`
-	Email    string `json:"email" binding:"required,email"`
+	Username string `json:"username" binding:"username"`
+	Password string `json:"password" binding:"password"`
+	FullName string `json:"full_name" binding:"full_name"`
+	Email    string `json:"email" binding:"email_address"`
 }
 
 // userResponse结构体定义了返回给客户端的用户信息格式，不包括敏感信息如密码。
@@ -31,6 +42,8 @@ type userResponse struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+
+	AllowAliasTransfers bool `json:"allow_alias_transfers"`
 }
 
 // newUserResponse函数接收一个db.User类型的参数，然后生成一个userResponse对象，这样在创建用户后可以发送回客户端。
@@ -41,6 +54,8 @@ func newUserResponse(user db.User) userResponse {
 		Email:             user.Email,
 		PasswordChangedAt: user.PasswordChangedAt,
 		CreatedAt:         user.CreatedAt,
+
+		AllowAliasTransfers: user.AllowAliasTransfers,
 	}
 }
 
@@ -74,11 +89,23 @@ func (server *Server) createUser(ctx *gin.Context) {
 		HashedPassword: hashedPassword,
 		FullName:       req.FullName,
 		Email:          req.Email,
+		Locale:         i18n.Match(ctx.GetHeader("Accept-Language")),
 	}
 
 	user, err := server.store.CreateUser(ctx, arg)
 	if err != nil {
 		if db.ErrorCode(err) == db.UniqueViolation {
+			if db.ConstraintName(err) == usersEmailKey && server.hideDuplicateEmail(ctx) {
+				server.recordDuplicateEmail(ctx, req)
+				now := time.Now()
+				ctx.JSON(http.StatusOK, newUserResponse(db.User{
+					Username:  req.Username,
+					FullName:  req.FullName,
+					Email:     req.Email,
+					CreatedAt: now,
+				}))
+				return
+			}
 			ctx.JSON(http.StatusForbidden, errorResponse(err))
 			return
 		}
@@ -91,14 +118,71 @@ func (server *Server) createUser(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, rsp)
 }
 
+// usersEmailKey 是 users 表 email 列的唯一约束
+const usersEmailKey = "users_email_key"
+
+/*
+hideDuplicateEmail 和 gapi 的 CreateUser 一样：开启 EMAIL_ENUMERATION_PROTECTION 时，
+邮箱已经注册的情况对普通调用方返回和注册成功一样的响应，带 banker 的访问令牌调用时返回准确的错误。
+*/
+func (server *Server) hideDuplicateEmail(ctx *gin.Context) bool {
+	if !server.config.EmailEnumProtection {
+		return false
+	}
+
+	// createUser 不经过 authMiddleware，令牌在这里验证，令牌无效时按普通调用方处理
+	fields := strings.Fields(ctx.GetHeader(authorizationHeaderKey))
+	if len(fields) < 2 || strings.ToLower(fields[0]) != authorizationTypeBearer {
+		return true
+	}
+	payload, err := verifyAccessToken(ctx, server.tokenMaker, server.revocationStore, fields[1])
+	return err != nil || payload.Role != util.BankerRole
+}
+
+/*
+recordDuplicateEmail 把这次用已经注册的邮箱注册的尝试记到审计日志里。
+给邮箱原来的主人发邮件只在 gapi 里做。
+记录失败只记日志，不能因为这个让响应和注册成功不一样。
+*/
+func (server *Server) recordDuplicateEmail(ctx *gin.Context, req createUserRequest) {
+	logger := log.With().Str("attempted_username", req.Username).Logger()
+	logger.Info().Msg("sign up with an email that is already registered")
+
+	user, err := server.store.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		logger.Error().Err(err).Msg("cannot get the owner of the email")
+		return
+	}
+
+	details, err := json.Marshal(map[string]string{"attempted_username": req.Username})
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to marshal audit log details")
+		return
+	}
+	location := geoip.Locate(server.geoLocator, ctx.ClientIP())
+	_, err = server.store.CreateAuditLog(ctx, db.CreateAuditLogParams{
+		Actor:        util.AuditActorAnonymous,
+		Action:       util.AuditDuplicateEmailSignup,
+		ResourceType: util.AuditResourceUser,
+		ResourceID:   user.Username,
+		Details:      details,
+		ClientIp:     ctx.ClientIP(),
+		Country:      location.Country,
+		City:         location.City,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to create audit log")
+	}
+}
+
 /*
 定义了用户登录请求的数据结构。
 Username 字段要求是字母数字且为必填项。
 Password 字段要求至少有6个字符且为必填项。
 */
 type loginUserRequest struct {
-	Username string `json:"username" binding:"required,alphanum"`
-	Password string `json:"password" binding:"required,min=6"`
+	Username string `json:"username" binding:"username"`
+	Password string `json:"password" binding:"login_password"`
 }
 
 /*
@@ -114,6 +198,16 @@ type loginUserResponse struct {
 	User                  userResponse `json:"user"`
 }
 
+// errInvalidCredentials 是用户不存在和密码错误共用的错误，不能用来猜测哪些用户名已经注册
+var errInvalidCredentials = errors.New("invalid username or password")
+
+// 和 gapi 的 LoginUser 一样，密码正确之后才返回这两个错误，不会透露用户的状态
+var (
+	errUserDisabled           = errors.New("user is disabled")
+	errAccountDeletionPending = errors.New("account deletion is pending: open the link in the account deletion email to cancel the deletion, then sign in again")
+	errPasswordResetRequired  = errors.New("password reset is required: contact the bank to set a new password, then sign in again")
+)
+
 /*
 实现了用户登录流程，验证用户凭证，并提供了访问令牌和刷新令牌。
 这使得用户可以在接下来的HTTP请求中使用这些令牌来验证其身份，
@@ -122,8 +216,9 @@ type loginUserResponse struct {
 
 处理用户登录请求的HTTP处理函数。
 尝试从请求的JSON体中绑定数据到loginUserRequest结构体实例。如果绑定失败，返回HTTP 400（错误请求）响应。
-使用请求中的Username查询数据库以获取用户信息。如果用户不存在或发生其他数据库错误，返回相应的HTTP响应。
-调用CheckPassword函数验证请求中的密码与数据库中散列存储的密码是否匹配。如果不匹配，返回HTTP 401（未授权）响应。
+使用请求中的Username查询数据库以获取用户信息。如果发生数据库错误，返回HTTP 500。
+调用CheckPassword函数验证请求中的密码与数据库中散列存储的密码是否匹配。
+用户不存在和密码不匹配都返回同样的HTTP 401（errInvalidCredentials）。
 如果密码验证成功，使用tokenMaker创建新的访问令牌，并设置适当的有效期。如果创建令牌失败，返回HTTP 500（服务器内部错误）响应。
 
 在你的服务器代码中，当用户通过验证后，他们会收到一个访问令牌和一个refresh token。
@@ -141,10 +236,13 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		return
 	}
 
+	// 用户不存在和密码错误的响应和耗时都一样，见 gapi 的 LoginUser
 	user, err := server.store.GetUser(ctx, req.Username)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			util.CheckDummyPassword(req.Password)
+			server.recordFailedLogin(ctx, req.Username, util.FailedLoginUserNotFound)
+			ctx.JSON(http.StatusUnauthorized, errorResponse(errInvalidCredentials))
 			return
 		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -153,10 +251,29 @@ func (server *Server) loginUser(ctx *gin.Context) {
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		server.recordFailedLogin(ctx, req.Username, util.FailedLoginIncorrectPassword)
+		ctx.JSON(http.StatusUnauthorized, errorResponse(errInvalidCredentials))
+		return
+	}
+
+	// 状态码和 HTTP 网关一致：PermissionDenied 是 403，FailedPrecondition 是 400
+	if user.IsDisabled {
+		ctx.JSON(http.StatusForbidden, errorResponse(errUserDisabled))
 		return
 	}
 
+	if user.DeletionScheduledAt.Valid {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errAccountDeletionPending))
+		return
+	}
+
+	if user.PasswordResetRequired {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errPasswordResetRequired))
+		return
+	}
+
+	server.rehashPassword(ctx, user, req.Password)
+
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
 		user.Role,
@@ -183,6 +300,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		通常，会话信息会包含用户的登录状态、角色权限、偏好设置等。
 	*/
 
+	location := geoip.Locate(server.geoLocator, ctx.ClientIP())
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		Username:     user.Username,
@@ -191,12 +309,17 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ClientIp:     ctx.ClientIP(),
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		Country:      location.Country,
+		City:         location.City,
 	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
+	// 和 gapi 的 LoginUser 一样，没见过的设备登录时给用户发安全提醒
+	worker.CheckNewDevice(ctx, server.store, server.taskDistributor, session)
+
 	//使用成功验证的用户信息创建loginUserResponse实例。
 	//封装了访问令牌、访问令牌过期时间和用户信息。
 	//返回HTTP 200（成功）响应，携带loginUserResponse实例作为JSON体
@@ -210,3 +333,199 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, rsp)
 }
+
+/*
+updateUserRequest 只更新请求里出现的字段，和 gapi 的 UpdateUser 一样，
+用户只能修改自己的信息，banker 可以修改任何用户的信息。
+*/
+type updateUserRequest struct {
+	FullName *string `json:"full_name" binding:"omitnil,full_name"`
+	Email    *string `json:"email" binding:"omitnil,email_address"`
+	Password *string `json:"password" binding:"omitnil,password"`
+
+	// 设为 false 之后别人不能再用用户名或邮箱给这个用户转账
+	AllowAliasTransfers *bool `json:"allow_alias_transfers"`
+}
+
+type updateUserURI struct {
+	Username string `uri:"username" binding:"username"`
+}
+
+func (server *Server) updateUser(ctx *gin.Context) {
+	var uri updateUserURI
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateUserRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if authPayload.Role != util.BankerRole && authPayload.Username != uri.Username {
+		err := errors.New("cannot update other user's info")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return
+	}
+
+	arg := db.UpdateUserParams{
+		Username: uri.Username,
+	}
+	if req.FullName != nil {
+		arg.FullName = pgtype.Text{String: *req.FullName, Valid: true}
+	}
+	if req.Email != nil {
+		arg.Email = pgtype.Text{String: *req.Email, Valid: true}
+	}
+	if req.AllowAliasTransfers != nil {
+		arg.AllowAliasTransfers = pgtype.Bool{Bool: *req.AllowAliasTransfers, Valid: true}
+	}
+	// 修改密码的同时清除强制重置密码的标记
+	if req.Password != nil {
+		hashedPassword, err := util.HashPassword(*req.Password)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+		arg.HashedPassword = pgtype.Text{String: hashedPassword, Valid: true}
+		arg.PasswordChangedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		arg.PasswordResetRequired = pgtype.Bool{Bool: false, Valid: true}
+	}
+
+	var user db.User
+	var err error
+	if req.Password != nil {
+		// 和 gapi 一样，新密码不能是最近用过的密码（PASSWORD_HISTORY_SIZE）
+		var result db.ChangePasswordTxResult
+		result, err = server.store.ChangePasswordTx(ctx, db.ChangePasswordTxParams{
+			UpdateUserParams: arg,
+			HistorySize:      server.config.PasswordHistorySize,
+			IsReused: func(hashedPassword string) bool {
+				return util.CheckPassword(*req.Password, hashedPassword) == nil
+			},
+		})
+		user = result.User
+	} else {
+		user, err = server.store.UpdateUser(ctx, arg)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrPasswordReused) {
+			violation := fieldViolation{Field: "password", Description: val.PasswordReusedError(server.config.PasswordHistorySize).Error()}
+			ctx.JSON(http.StatusBadRequest, fieldViolationsResponse([]fieldViolation{violation}))
+			return
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		if db.ErrorCode(err) == db.UniqueViolation {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newUserResponse(user))
+}
+
+// verifyEmailRequest 是验证邮件里的链接带的参数，expires_at 和 signature 由 util.LinkSigner 生成
+type verifyEmailRequest struct {
+	EmailID    int64  `form:"email_id" binding:"required,min=1"`
+	SecretCode string `form:"secret_code" binding:"required,min=32,max=128"`
+	ExpiresAt  int64  `form:"expires_at" binding:"required"`
+	Signature  string `form:"signature" binding:"required"`
+}
+
+type verifyEmailResponse struct {
+	IsVerified bool `json:"is_verified"`
+}
+
+/*
+verifyEmail 和 gapi 的 VerifyEmail 一样，先检查链接的签名和过期时间，再用 VerifyEmailTx 验证邮箱。
+链接无效、过期或者已经用过都返回400，错误信息里说明是哪一种。
+*/
+func (server *Server) verifyEmail(ctx *gin.Context) {
+	var req verifyEmailRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	params := url.Values{}
+	params.Set("email_id", strconv.FormatInt(req.EmailID, 10))
+	params.Set("secret_code", req.SecretCode)
+	params.Set(util.LinkExpiresAtParam, strconv.FormatInt(req.ExpiresAt, 10))
+	params.Set(util.LinkSignatureParam, req.Signature)
+
+	err := util.NewLinkSigner(server.config.LinkSigningKey, util.LinkPurposeVerifyEmail).Verify(params)
+	if err != nil {
+		if errors.Is(err, util.ErrLinkExpired) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("verification link has expired")))
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("invalid verification link")))
+		return
+	}
+
+	txResult, err := server.store.VerifyEmailTx(ctx, db.VerifyEmailTxParams{
+		EmailId:    req.EmailID,
+		SecretCode: req.SecretCode,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidSecretCode),
+			errors.Is(err, db.ErrVerifyEmailExpired),
+			errors.Is(err, db.ErrVerifyEmailUsed):
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, verifyEmailResponse{IsVerified: txResult.User.IsEmailVerified})
+}
+
+// recordFailedLogin 和 gapi 里的一样，记下失败的登录给管理后台统计，失败只记日志
+func (server *Server) recordFailedLogin(ctx *gin.Context, username string, reason string) {
+	_, err := server.store.CreateFailedLogin(ctx, db.CreateFailedLoginParams{
+		Username: username,
+		Reason:   reason,
+		ClientIp: ctx.ClientIP(),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("cannot record failed login")
+	}
+}
+
+// rehashPassword 和 gapi 里的一样，登录成功之后把过时的密码哈希换成当前配置的算法和参数，失败只记日志
+func (server *Server) rehashPassword(ctx *gin.Context, user db.User, password string) {
+	if !util.PasswordNeedsRehash(user.HashedPassword) {
+		return
+	}
+
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot rehash password")
+		return
+	}
+
+	_, err = server.store.UpdateUser(ctx, db.UpdateUserParams{
+		Username: user.Username,
+		HashedPassword: pgtype.Text{
+			String: hashedPassword,
+			Valid:  true,
+		},
+		ExpectedUpdatedAt: pgtype.Timestamptz{
+			Time:  user.UpdatedAt,
+			Valid: true,
+		},
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		log.Error().Err(err).Msg("cannot save rehashed password")
+	}
+}
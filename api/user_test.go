@@ -3,21 +3,34 @@ package api
 //测试创建用户接口的功能。它使用了gomock和testify/require库来模拟数据库操作和验证测试结果。
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/techschool/bank/db/mock"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"golang.org/x/crypto/bcrypt"
 )
 
 // eqCreateUserParamsMatcher是一个自定义匹配器，实现了gomock.Matcher接口。
@@ -92,6 +105,7 @@ func TestCreateUserAPI(t *testing.T) {
 					Username: user.Username,
 					FullName: user.FullName,
 					Email:    user.Email,
+					Locale:   i18n.DefaultLocale,
 				}
 				store.EXPECT().
 					CreateUser(gomock.Any(), EqCreateUserParams(arg, password)).
@@ -155,6 +169,8 @@ func TestCreateUserAPI(t *testing.T) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				// 和 gapi 一样的错误信息
+				requireFieldViolation(t, recorder.Body, "username", val.ValidateUsername("invalid-user#1"))
 			},
 		},
 		{
@@ -189,6 +205,8 @@ func TestCreateUserAPI(t *testing.T) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				// 和 gapi 一样的错误信息
+				requireFieldViolation(t, recorder.Body, "password", val.ValidatePassword("123"))
 			},
 		},
 	}
@@ -208,7 +226,7 @@ func TestCreateUserAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, nil)
 			recorder := httptest.NewRecorder()
 
 			// Marshal body data to JSON
@@ -225,13 +243,130 @@ func TestCreateUserAPI(t *testing.T) {
 	}
 }
 
+func TestCreateUserDuplicateEmailAPI(t *testing.T) {
+	user, password := randomUser(t)
+	owner, _ := randomUser(t)
+	duplicateEmail := &pgconn.PgError{Code: db.UniqueViolation, ConstraintName: "users_email_key"}
+
+	testCases := []struct {
+		name          string
+		protection    bool
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name:       "GenericSuccess",
+			protection: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(user.Email)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditLogParams) (db.AuditLog, error) {
+						require.Equal(t, util.AuditDuplicateEmailSignup, arg.Action)
+						require.Equal(t, owner.Username, arg.ResourceID)
+						require.Contains(t, string(arg.Details), user.Username)
+						return db.AuditLog{}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp userResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, user.Username, rsp.Username)
+				require.Equal(t, user.Email, rsp.Email)
+			},
+		},
+		{
+			name:       "Banker",
+			protection: true,
+			role:       util.BankerRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "ProtectionDisabled",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				CreateUser(gomock.Any(), gomock.Any()).
+				Times(1).
+				Return(db.User{}, duplicateEmail)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			server.config.EmailEnumProtection = tc.protection
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{
+				"username":  user.Username,
+				"password":  password,
+				"full_name": user.FullName,
+				"email":     user.Email,
+			})
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodPost, "/users", bytes.NewReader(data))
+			require.NoError(t, err)
+			if tc.role != "" {
+				addAuthorization(t, request, server.tokenMaker, authorizationTypeBearer, "staff", tc.role, time.Minute)
+			}
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
 func TestLoginUserAPI(t *testing.T) {
 	user, password := randomUser(t)
 
+	// 用过时的参数生成的哈希，登录成功之后应该按当前的参数重新生成
+	outdatedUser := user
+	outdatedHasher := util.PasswordHasher{Algorithm: util.PasswordHashBcrypt, BcryptCost: bcrypt.MinCost}
+	outdatedHash, err := outdatedHasher.Hash(password)
+	require.NoError(t, err)
+	outdatedUser.HashedPassword = outdatedHash
+
+	disabledUser := user
+	disabledUser.IsDisabled = true
+	pendingDeletionUser := user
+	pendingDeletionUser.DeletionScheduledAt = pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+	passwordResetUser := user
+	passwordResetUser.PasswordResetRequired = true
+
 	testCases := []struct {
 		name          string
 		body          gin.H
-		buildStubs    func(store *mockdb.MockStore)
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
 		checkResponse func(recoder *httptest.ResponseRecorder)
 	}{
 		{
@@ -240,7 +375,7 @@ func TestLoginUserAPI(t *testing.T) {
 				"username": user.Username,
 				"password": password,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
@@ -248,6 +383,97 @@ func TestLoginUserAPI(t *testing.T) {
 				store.EXPECT().
 					CreateSession(gomock.Any(), gomock.Any()).
 					Times(1)
+				store.EXPECT().
+					GetUserDevice(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UserDevice{ID: 1}, nil)
+				store.EXPECT().
+					TouchUserDevice(gomock.Any(), gomock.Any()).
+					Times(1)
+				taskDistributor.EXPECT().
+					DistributeTaskSendNewDeviceAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "NewDevice",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				session := db.Session{ID: uuid.New(), Username: user.Username}
+				device := db.UserDevice{ID: util.RandomInt(1, 1000), Username: user.Username}
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(session, nil)
+				store.EXPECT().
+					GetUserDevice(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UserDevice{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CountUserDevices(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().
+					CreateUserDevice(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(device, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskSendNewDeviceAlert(gomock.Any(), gomock.Eq(&worker.PayloadSendNewDeviceAlert{
+						Username:  user.Username,
+						SessionID: session.ID,
+						DeviceID:  device.ID,
+					}), gomock.Any()).
+					Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "RehashOutdatedPassword",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(outdatedUser, nil)
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.UpdateUserParams) (db.User, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.False(t, arg.PasswordChangedAt.Valid)
+						require.True(t, arg.ExpectedUpdatedAt.Valid)
+						require.NoError(t, util.CheckPassword(password, arg.HashedPassword.String))
+						require.False(t, util.PasswordNeedsRehash(arg.HashedPassword.String))
+						return user, nil
+					})
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(1)
+				store.EXPECT().
+					GetUserDevice(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UserDevice{ID: 1}, nil)
+				store.EXPECT().
+					TouchUserDevice(gomock.Any(), gomock.Any()).
+					Times(1)
+				taskDistributor.EXPECT().
+					DistributeTaskSendNewDeviceAlert(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
@@ -256,17 +482,27 @@ func TestLoginUserAPI(t *testing.T) {
 		{
 			name: "UserNotFound",
 			body: gin.H{
-				"username": "NotFound",
+				"username": "not_found",
 				"password": password,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(1).
 					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateFailedLogin(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateFailedLoginParams) (db.FailedLogin, error) {
+						require.Equal(t, "not_found", arg.Username)
+						require.Equal(t, util.FailedLoginUserNotFound, arg.Reason)
+						return db.FailedLogin{}, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				// 和密码错误的响应一样，不能用来猜测用户名
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, errInvalidCredentials)
 			},
 		},
 		{
@@ -275,14 +511,83 @@ func TestLoginUserAPI(t *testing.T) {
 				"username": user.Username,
 				"password": "incorrect",
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Eq(user.Username)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					CreateFailedLogin(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateFailedLoginParams) (db.FailedLogin, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, util.FailedLoginIncorrectPassword, arg.Reason)
+						return db.FailedLogin{}, nil
+					})
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, errInvalidCredentials)
+			},
+		},
+		{
+			name: "UserDisabled",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(disabledUser, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, errUserDisabled)
+			},
+		},
+		{
+			name: "AccountDeletionPending",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(pendingDeletionUser, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, errAccountDeletionPending)
+			},
+		},
+		{
+			name: "PasswordResetRequired",
+			body: gin.H{
+				"username": user.Username,
+				"password": password,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(passwordResetUser, nil)
+				store.EXPECT().
+					CreateSession(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireBodyMatchError(t, recorder.Body, errPasswordResetRequired)
 			},
 		},
 		{
@@ -291,7 +596,7 @@ func TestLoginUserAPI(t *testing.T) {
 				"username": user.Username,
 				"password": password,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -307,7 +612,7 @@ func TestLoginUserAPI(t *testing.T) {
 				"username": "invalid-user#1",
 				"password": password,
 			},
-			buildStubs: func(store *mockdb.MockStore) {
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
 				store.EXPECT().
 					GetUser(gomock.Any(), gomock.Any()).
 					Times(0)
@@ -326,9 +631,10 @@ func TestLoginUserAPI(t *testing.T) {
 			defer ctrl.Finish()
 
 			store := mockdb.NewMockStore(ctrl)
-			tc.buildStubs(store)
+			taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+			tc.buildStubs(store, taskDistributor)
 
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, taskDistributor)
 			recorder := httptest.NewRecorder()
 
 			// Marshal body data to JSON
@@ -345,6 +651,381 @@ func TestLoginUserAPI(t *testing.T) {
 	}
 }
 
+func TestUpdateUserAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	newName := util.RandomOwner()
+	newEmail := util.RandomEmail()
+
+	testCases := []struct {
+		name          string
+		username      string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: user.Username,
+			body: gin.H{
+				"full_name": newName,
+				"email":     newEmail,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					FullName: pgtype.Text{String: newName, Valid: true},
+					Email:    pgtype.Text{String: newEmail, Valid: true},
+				}
+				updated := user
+				updated.FullName = newName
+				updated.Email = newEmail
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got userResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, newName, got.FullName)
+				require.Equal(t, newEmail, got.Email)
+			},
+		},
+		{
+			name:     "PasswordResetsFlag",
+			username: user.Username,
+			body: gin.H{
+				"password": "new_secret",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangePasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ interface{}, arg db.ChangePasswordTxParams) (db.ChangePasswordTxResult, error) {
+						require.NoError(t, util.CheckPassword("new_secret", arg.HashedPassword.String))
+						require.True(t, arg.PasswordChangedAt.Valid)
+						require.Equal(t, pgtype.Bool{Bool: false, Valid: true}, arg.PasswordResetRequired)
+						require.False(t, arg.FullName.Valid)
+						// IsReused 用明文和历史里的哈希比较
+						require.True(t, arg.IsReused(arg.HashedPassword.String))
+						require.False(t, arg.IsReused(user.HashedPassword))
+						return db.ChangePasswordTxResult{User: user}, nil
+					})
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "PasswordReused",
+			username: user.Username,
+			body: gin.H{
+				"password": "new_secret",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangePasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangePasswordTxResult{}, db.ErrPasswordReused)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				requireFieldViolation(t, recorder.Body, "password", val.PasswordReusedError(0))
+			},
+		},
+		{
+			name:     "BankerUpdatesOtherUser",
+			username: user.Username,
+			body: gin.H{
+				"full_name": newName,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "banker", util.BankerRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(user, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "OtherUser",
+			username: user.Username,
+			body: gin.H{
+				"full_name": newName,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "other_user", util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "NoAuthorization",
+			username: user.Username,
+			body: gin.H{
+				"full_name": newName,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "UserNotFound",
+			username: user.Username,
+			body: gin.H{
+				"full_name": newName,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidEmail",
+			username: user.Username,
+			body: gin.H{
+				"email": "invalid-email",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "EmptyFullName",
+			username: user.Username,
+			body: gin.H{
+				"full_name": "",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, util.DepositorRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/users/%s", tc.username)
+			request, err := http.NewRequest(http.MethodPatch, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
+// verifyEmailURL 生成和验证邮件里一样的签名链接
+func verifyEmailURL(key string, emailID int64, secretCode string, expiresAt time.Time) string {
+	params := url.Values{}
+	params.Set("email_id", strconv.FormatInt(emailID, 10))
+	params.Set("secret_code", secretCode)
+	params = util.NewLinkSigner(key, util.LinkPurposeVerifyEmail).Sign(params, expiresAt)
+	return "/verify_email?" + params.Encode()
+}
+
+func TestVerifyEmailAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	emailID := util.RandomInt(1, 1000)
+	secretCode := util.RandomString(32)
+
+	testCases := []struct {
+		name          string
+		buildURL      func(key string) string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildURL: func(key string) string {
+				return verifyEmailURL(key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.VerifyEmailTxParams{
+					EmailId:    emailID,
+					SecretCode: secretCode,
+				}
+				verified := user
+				verified.IsEmailVerified = true
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.VerifyEmailTxResult{User: verified}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got verifyEmailResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.True(t, got.IsVerified)
+			},
+		},
+		{
+			name: "ExpiredLink",
+			buildURL: func(key string) string {
+				return verifyEmailURL(key, emailID, secretCode, time.Now().Add(-time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), "expired")
+			},
+		},
+		{
+			name: "InvalidSignature",
+			buildURL: func(key string) string {
+				return verifyEmailURL(util.RandomString(32), emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "AlreadyUsed",
+			buildURL: func(key string) string {
+				return verifyEmailURL(key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrVerifyEmailUsed)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Contains(t, recorder.Body.String(), db.ErrVerifyEmailUsed.Error())
+			},
+		},
+		{
+			name: "InternalError",
+			buildURL: func(key string) string {
+				return verifyEmailURL(key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "MissingSecretCode",
+			buildURL: func(key string) string {
+				return fmt.Sprintf("/verify_email?email_id=%d", emailID)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, tc.buildURL(server.config.LinkSigningKey), nil)
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
+
 // randomUser函数生成一个随机的用户和密码，用于测试中。
 func randomUser(t *testing.T) (user db.User, password string) {
 	password = util.RandomString(6)
@@ -356,11 +1037,33 @@ func randomUser(t *testing.T) (user db.User, password string) {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
+
+		AllowAliasTransfers: true,
 	}
 	return
 }
 
 // requireBodyMatchUser函数验证HTTP响应体中的用户信息是否与预期的用户信息一致，它确保返回的用户信息中没有包含敏感信息，如加密后的密码。
+func requireBodyMatchError(t *testing.T, body *bytes.Buffer, expected error) {
+	var res struct {
+		Error string `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(body.Bytes(), &res))
+	require.Equal(t, expected.Error(), res.Error)
+}
+
+func requireFieldViolation(t *testing.T, body *bytes.Buffer, field string, expected error) {
+	require.Error(t, expected)
+
+	var res struct {
+		Error           string           `json:"error"`
+		FieldViolations []fieldViolation `json:"field_violations"`
+	}
+	require.NoError(t, json.Unmarshal(body.Bytes(), &res))
+	require.Equal(t, "invalid parameters", res.Error)
+	require.Contains(t, res.FieldViolations, fieldViolation{Field: field, Description: expected.Error()})
+}
+
 func requireBodyMatchUser(t *testing.T, body *bytes.Buffer, user db.User) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
@@ -1,23 +1,125 @@
 package api
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
 )
 
 /*
-自定义验证器validCurrency 检查字段的值是否为字符串以及该字符串是否为受支持的货币代码。
-然后可以将该验证器注册到 validator 包中，并用于验证用 currency 标记注释的结构体字段
+fieldRules 是注册到 Gin 验证器里的自定义 binding 标签，每个标签对应 val 里的一个校验函数，
+和 gapi 用的是同一套规则，校验失败时的错误信息也来自 val（见 errorResponse），同样的输入两边返回同样的错误。
 
-	也就是先在server.go中将其注册到验证器实例	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
-			v.RegisterValidation("currency", validCurrency)
+	在server.go中注册到验证器实例	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+			registerFieldRules(v)
 		}
 
-然后就可以用了 Currency      string `json:"currency" binding:"required,currency"`
+然后就可以用了 Currency      string `json:"currency" binding:"currency"`
+
+这些标签对空值也会校验，所以不需要再加 required；可选的字段用指针加 omitnil。
 */
-var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
-	if currency, ok := fieldLevel.Field().Interface().(string); ok {
-		return util.IsSupportedCurrency(currency)
+var fieldRules = map[string]func(value interface{}) error{
+	"username":       stringRule(val.ValidateUsername),
+	"password":       stringRule(val.ValidatePassword),
+	"login_password": stringRule(val.ValidateLoginPassword),
+	"full_name":      stringRule(val.ValidateFullName),
+	"email_address":  stringRule(val.ValidateEmail),
+	"recipient":      stringRule(val.ValidateRecipient),
+	"currency":       stringRule(val.ValidateCurrency),
+	"category":       stringRule(val.ValidateCategory),
+	"account_number": stringRule(func(value string) error {
+		return val.ValidateAccountNumber(util.NormalizeAccountNumber(value))
+	}),
+	"amount": func(value interface{}) error {
+		amount, ok := value.(int64)
+		if !ok {
+			return errors.New("must be an integer")
+		}
+		return val.ValidateAmount(amount)
+	},
+}
+
+func stringRule(validate func(string) error) func(value interface{}) error {
+	return func(value interface{}) error {
+		s, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		return validate(s)
+	}
+}
+
+// registerFieldRules 注册 fieldRules，并让错误里的字段名使用 JSON（或者 query、URI）里的名字
+func registerFieldRules(v *validator.Validate) {
+	for tag, rule := range fieldRules {
+		rule := rule
+		v.RegisterValidation(tag, func(fieldLevel validator.FieldLevel) bool {
+			return rule(fieldLevel.Field().Interface()) == nil
+		})
+	}
+	v.RegisterTagNameFunc(requestFieldName)
+}
+
+func requestFieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+// fieldViolation 和 gapi 返回的 field_violations 格式一样
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// validationErrorResponse 把 binding 的错误转换成和 gapi 一样的 invalid parameters 响应
+func validationErrorResponse(errs validator.ValidationErrors) gin.H {
+	violations := make([]fieldViolation, 0, len(errs))
+	for _, fieldErr := range errs {
+		violations = append(violations, fieldViolation{
+			Field:       fieldErr.Field(),
+			Description: fieldErrorDescription(fieldErr),
+		})
+	}
+	return fieldViolationsResponse(violations)
+}
+
+// fieldViolationsResponse 和 gapi 的 invalidArgumentError 一样，返回 invalid parameters 和每个字段的问题
+func fieldViolationsResponse(violations []fieldViolation) gin.H {
+	return gin.H{
+		"error":            "invalid parameters",
+		"field_violations": violations,
+	}
+}
+
+func fieldErrorDescription(fieldErr validator.FieldError) string {
+	if rule, ok := fieldRules[fieldErr.Tag()]; ok {
+		if err := rule(fieldErr.Value()); err != nil {
+			return err.Error()
+		}
+	}
+
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s", fieldErr.Param())
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s", fieldErr.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fieldErr.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", fieldErr.Param())
 	}
-	return false
+	return fmt.Sprintf("is invalid (%s)", fieldErr.Tag())
 }
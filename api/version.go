@@ -0,0 +1,13 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/techschool/bank/version"
+)
+
+// getVersion 返回正在运行的服务的版本信息，和 gRPC 网关的 GET /version 一样，不需要登录
+func (server *Server) getVersion(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, version.Get())
+}
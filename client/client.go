@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/techschool/bank/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+/*
+client 包给内部的其他 Go 服务调用 bank 的 gRPC API 用，省去每个服务都要写的样板代码：
+  - 管理连接，用完调用 Close 关闭；
+  - 自动在 metadata 里带上 access token；
+  - 对幂等的 RPC 在服务暂时不可用时按指数退避重试；
+  - 把 gRPC status 转换成 *Error，调用方可以用 errors.Is 判断 ErrNotFound 等错误。
+
+Client 嵌入了 pb.SimpleBankClient，所以可以直接调用所有的 RPC：
+
+	c, err := client.New("localhost:9090", client.WithInsecure(), client.WithAccessToken(token))
+	rsp, err := c.ListAccounts(ctx, &pb.ListAccountsRequest{PageSize: 10})
+*/
+type Client struct {
+	pb.SimpleBankClient
+	conn *grpc.ClientConn
+}
+
+// TokenSource 返回调用 RPC 时要带上的 access token，返回空字符串表示不带
+type TokenSource func(ctx context.Context) (string, error)
+
+type options struct {
+	creds        credentials.TransportCredentials
+	tokenSource  TokenSource
+	maxRetries   int
+	retryBackoff time.Duration
+	dialOptions  []grpc.DialOption
+}
+
+// Option 配置 Client
+type Option func(*options)
+
+// WithInsecure 使用明文连接，只应该在本地开发或者服务网格内部使用
+func WithInsecure() Option {
+	return func(o *options) {
+		o.creds = insecure.NewCredentials()
+	}
+}
+
+// WithTransportCredentials 使用指定的 TLS 配置
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) {
+		o.creds = creds
+	}
+}
+
+// WithAccessToken 在每次调用时带上固定的 access token
+func WithAccessToken(accessToken string) Option {
+	return WithTokenSource(func(ctx context.Context) (string, error) {
+		return accessToken, nil
+	})
+}
+
+// WithTokenSource 每次调用时从 source 获取 access token，可以用来在令牌过期之前自动续期
+func WithTokenSource(source TokenSource) Option {
+	return func(o *options) {
+		o.tokenSource = source
+	}
+}
+
+// WithRetry 设置幂等 RPC 的最大重试次数和第一次重试前的等待时间，之后每次等待时间翻倍
+func WithRetry(maxRetries int, backoff time.Duration) Option {
+	return func(o *options) {
+		o.maxRetries = maxRetries
+		o.retryBackoff = backoff
+	}
+}
+
+// WithCompression 用 gzip 压缩请求，服务端也会用 gzip 压缩响应，适合拉取大量流水的服务
+func WithCompression() Option {
+	return WithDialOptions(grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
+}
+
+// WithDialOptions 追加原生的 grpc.DialOption
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 100 * time.Millisecond
+)
+
+// New 创建一个连接到 target 的 Client，默认使用系统根证书的 TLS
+func New(target string, opts ...Option) (*Client, error) {
+	o := &options{
+		creds:        credentials.NewClientTLSFromCert(nil, ""),
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(o.creds),
+		grpc.WithChainUnaryInterceptor(
+			errorInterceptor,
+			retryInterceptor(o.maxRetries, o.retryBackoff),
+			tokenInterceptor(o.tokenSource),
+		),
+	}, o.dialOptions...)
+
+	conn, err := grpc.Dial(target, dialOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot dial %s: %w", target, err)
+	}
+
+	return &Client{
+		SimpleBankClient: pb.NewSimpleBankClient(conn),
+		conn:             conn,
+	}, nil
+}
+
+// Close 关闭底层的连接
+func (client *Client) Close() error {
+	return client.conn.Close()
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServer 前 failures 次调用返回 Unavailable，并记录收到的 authorization 和请求的压缩编码
+type fakeServer struct {
+	pb.UnimplementedSimpleBankServer
+	failures      int
+	calls         int
+	authorization string
+	encoding      string
+}
+
+// TagRPC 等方法实现了 stats.Handler，用来拿到请求头里的 grpc-encoding
+func (server *fakeServer) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (server *fakeServer) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (server *fakeServer) HandleConn(context.Context, stats.ConnStats) {}
+
+func (server *fakeServer) HandleRPC(ctx context.Context, rpcStats stats.RPCStats) {
+	if header, ok := rpcStats.(*stats.InHeader); ok {
+		server.encoding = header.Compression
+	}
+}
+
+func (server *fakeServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	server.calls++
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(authorizationHeader)) > 0 {
+		server.authorization = md.Get(authorizationHeader)[0]
+	}
+
+	if server.calls <= server.failures {
+		return nil, status.Errorf(codes.Unavailable, "try again")
+	}
+	return &pb.ListAccountsResponse{Accounts: []*pb.Account{{Id: 1}}}, nil
+}
+
+func (server *fakeServer) CreateBulkTransfer(ctx context.Context, req *pb.CreateBulkTransferRequest) (*pb.CreateBulkTransferResponse, error) {
+	server.calls++
+	return nil, status.Errorf(codes.Unavailable, "try again")
+}
+
+func (server *fakeServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	badRequest := &errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+		{Field: "email", Description: "is not a valid email address"},
+	}}
+	st, _ := status.New(codes.InvalidArgument, "invalid parameters").WithDetails(badRequest)
+	return nil, st.Err()
+}
+
+func newTestClient(t *testing.T, server *fakeServer, opts ...Option) *Client {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer(grpc.StatsHandler(server))
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	opts = append([]Option{
+		WithInsecure(),
+		WithRetry(3, time.Millisecond),
+		WithDialOptions(grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		})),
+	}, opts...)
+
+	c, err := New("bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestClientAttachesAccessToken(t *testing.T) {
+	server := &fakeServer{}
+	c := newTestClient(t, server, WithAccessToken("my-token"))
+
+	rsp, err := c.ListAccounts(context.Background(), &pb.ListAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, rsp.GetAccounts(), 1)
+	require.Equal(t, "bearer my-token", server.authorization)
+}
+
+func TestClientCompression(t *testing.T) {
+	server := &fakeServer{}
+	c := newTestClient(t, server, WithCompression())
+
+	rsp, err := c.ListAccounts(context.Background(), &pb.ListAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, rsp.GetAccounts(), 1)
+	require.Equal(t, "gzip", server.encoding)
+}
+
+func TestClientRetriesIdempotentMethods(t *testing.T) {
+	server := &fakeServer{failures: 2}
+	c := newTestClient(t, server)
+
+	_, err := c.ListAccounts(context.Background(), &pb.ListAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 3, server.calls)
+
+	// 超过最大重试次数之后返回最后一次的错误
+	server = &fakeServer{failures: 10}
+	c = newTestClient(t, server)
+
+	_, err = c.ListAccounts(context.Background(), &pb.ListAccountsRequest{})
+	require.ErrorIs(t, err, ErrUnavailable)
+	require.Equal(t, 4, server.calls)
+}
+
+func TestClientDoesNotRetryNonIdempotentMethods(t *testing.T) {
+	server := &fakeServer{}
+	c := newTestClient(t, server)
+
+	_, err := c.CreateBulkTransfer(context.Background(), &pb.CreateBulkTransferRequest{})
+	require.ErrorIs(t, err, ErrUnavailable)
+	require.Equal(t, 1, server.calls)
+}
+
+func TestClientTypedErrors(t *testing.T) {
+	c := newTestClient(t, &fakeServer{})
+
+	_, err := c.CreateUser(context.Background(), &pb.CreateUserRequest{})
+	require.ErrorIs(t, err, ErrInvalidArgument)
+	require.False(t, errors.Is(err, ErrNotFound))
+
+	var clientErr *Error
+	require.ErrorAs(t, err, &clientErr)
+	require.Equal(t, codes.InvalidArgument, clientErr.Code)
+	require.Equal(t, "is not a valid email address", clientErr.FieldViolations["email"])
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
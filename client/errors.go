@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 可以用 errors.Is 判断的错误类型
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrUnavailable        = errors.New("unavailable")
+)
+
+var codeErrors = map[codes.Code]error{
+	codes.InvalidArgument:    ErrInvalidArgument,
+	codes.NotFound:           ErrNotFound,
+	codes.AlreadyExists:      ErrAlreadyExists,
+	codes.Unauthenticated:    ErrUnauthenticated,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.FailedPrecondition: ErrFailedPrecondition,
+	codes.Unavailable:        ErrUnavailable,
+}
+
+/*
+Error 是 RPC 返回的错误，保留了原始的 gRPC code 和 message，
+参数错误的时候 FieldViolations 里是每个字段的错误（字段名 -> 描述）。
+*/
+type Error struct {
+	Code            codes.Code
+	Message         string
+	FieldViolations map[string]string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Is 让 errors.Is(err, client.ErrNotFound) 这样的判断可以工作
+func (e *Error) Is(target error) bool {
+	return codeErrors[e.Code] == target
+}
+
+// GRPCStatus 让 status.FromError 仍然能拿到原始的 code 和 message
+func (e *Error) GRPCStatus() *status.Status {
+	return status.New(e.Code, e.Message)
+}
+
+func fromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	e := &Error{
+		Code:    st.Code(),
+		Message: st.Message(),
+	}
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		e.FieldViolations = make(map[string]string, len(badRequest.GetFieldViolations()))
+		for _, violation := range badRequest.GetFieldViolations() {
+			e.FieldViolations[violation.GetField()] = violation.GetDescription()
+		}
+	}
+	return e
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	authorizationBearer = "bearer"
+)
+
+// tokenInterceptor 在 metadata 里带上 access token，格式和 gapi 的 authorizeUser 要求的一样
+func tokenInterceptor(source TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if source != nil {
+			accessToken, err := source(ctx)
+			if err != nil {
+				return fmt.Errorf("cannot get access token: %w", err)
+			}
+			if accessToken != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, authorizationHeader, authorizationBearer+" "+accessToken)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+/*
+idempotentMethods 是可以安全重试的 RPC：只读的查询，以及把字段设置成固定值的更新。
+转账、创建用户这类调用重试可能会重复执行，所以不在这里，调用方要自己决定怎么处理。
+*/
+var idempotentMethods = map[string]bool{
+	"/pb.SimpleBank/SearchUsers":                   true,
+	"/pb.SimpleBank/SearchAccounts":                true,
+	"/pb.SimpleBank/ListAccounts":                  true,
+	"/pb.SimpleBank/ListTransfers":                 true,
+	"/pb.SimpleBank/ListEntries":                   true,
+	"/pb.SimpleBank/UpdateTransferMemo":            true,
+	"/pb.SimpleBank/GetSpendingSummary":            true,
+	"/pb.SimpleBank/GetNotificationPreferences":    true,
+	"/pb.SimpleBank/UpdateNotificationPreferences": true,
+	"/pb.SimpleBank/GetTransferStatus":             true,
+	"/pb.SimpleBank/ListSessions":                  true,
+	"/pb.SimpleBank/BlockSession":                  true,
+	"/pb.SimpleBank/GetOAuthURL":                   true,
+	"/pb.SimpleBank/ListCurrencies":                true,
+	"/pb.SimpleBank/ListCards":                     true,
+	"/pb.SimpleBank/FreezeCard":                    true,
+	"/pb.SimpleBank/ListKYCDocuments":              true,
+	"/pb.SimpleBank/ListPendingKYCDocuments":       true,
+	"/pb.SimpleBank/GetKYCDocumentFile":            true,
+	"/pb.SimpleBank/GetExportStatus":               true,
+	"/pb.SimpleBank/GetActivityFeed":               true,
+	"/pb.SimpleBank/ListBalanceAlerts":             true,
+	"/pb.SimpleBank/ListDisputes":                  true,
+	"/pb.SimpleBank/GetDispute":                    true,
+}
+
+// retryableCodes 是服务暂时不可用的错误，稍后重试有可能成功
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:       true,
+	codes.ResourceExhausted: true,
+	codes.Aborted:           true,
+}
+
+// retryInterceptor 对幂等的 RPC 按指数退避重试，ctx 被取消时立即停止
+func retryInterceptor(maxRetries int, backoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if !idempotentMethods[method] {
+			return err
+		}
+
+		wait := backoff
+		for attempt := 0; attempt < maxRetries && retryableCodes[status.Code(err)]; attempt++ {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(wait):
+			}
+			wait *= 2
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// errorInterceptor 把 gRPC status 转换成 *Error
+func errorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return fromStatus(invoker(ctx, method, req, reply, cc, opts...))
+}
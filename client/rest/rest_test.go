@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/gapi"
+	"github.com/techschool/bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeServer 只实现 LoginUser，用来检查生成的客户端和网关的 JSON 格式对得上
+type fakeServer struct {
+	pb.UnimplementedSimpleBankServer
+}
+
+func (server *fakeServer) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
+	if req.GetPassword() != "secret" {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	return &pb.LoginUserResponse{
+		User: &pb.User{
+			Username: req.GetUsername(),
+			Email:    req.GetUsername() + "@email.com",
+		},
+		AccessToken:          "token",
+		AccessTokenExpiresAt: timestamppb.Now(),
+	}, nil
+}
+
+// newTestGateway 和 main.go 里的网关使用相同的 JSON 选项和错误处理
+func newTestGateway(t *testing.T) *httptest.Server {
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	})
+	grpcMux := runtime.NewServeMux(jsonOption, runtime.WithErrorHandler(gapi.GatewayErrorHandler))
+
+	err := pb.RegisterSimpleBankHandlerServer(context.Background(), grpcMux, &fakeServer{})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(grpcMux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLoginUser(t *testing.T) {
+	gateway := newTestGateway(t)
+
+	c, err := NewClientWithResponses(gateway.URL)
+	require.NoError(t, err)
+
+	username := "alice"
+	password := "secret"
+	rsp, err := c.SimpleBankLoginUserWithResponse(context.Background(), SimpleBankLoginUserJSONRequestBody{
+		Username: &username,
+		Password: &password,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rsp.StatusCode())
+	require.NotNil(t, rsp.JSON200)
+	require.Equal(t, "token", *rsp.JSON200.AccessToken)
+	require.NotNil(t, rsp.JSON200.AccessTokenExpiresAt)
+	require.Equal(t, username, *rsp.JSON200.User.Username)
+	require.Equal(t, "alice@email.com", *rsp.JSON200.User.Email)
+}
+
+func TestLoginUserError(t *testing.T) {
+	gateway := newTestGateway(t)
+
+	c, err := NewClientWithResponses(gateway.URL)
+	require.NoError(t, err)
+
+	username := "alice"
+	password := "wrong"
+	rsp, err := c.SimpleBankLoginUserWithResponse(context.Background(), SimpleBankLoginUserJSONRequestBody{
+		Username: &username,
+		Password: &password,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, rsp.StatusCode())
+	require.Nil(t, rsp.JSON200)
+	require.NotNil(t, rsp.JSONDefault)
+	require.Equal(t, "NOT_FOUND", *rsp.JSONDefault.Code)
+	require.Equal(t, "user not found", *rsp.JSONDefault.Error)
+}
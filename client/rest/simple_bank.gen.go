@@ -0,0 +1,13828 @@
+// Package rest provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// PbAccount defines model for pb.Account.
+type PbAccount struct {
+	Balance   *string    `json:"balance,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Currency  *string    `json:"currency,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// IsDefault receives transfers addressed to the owner by username or email in this currency
+	IsDefault *bool `json:"is_default,omitempty"`
+
+	// Number IBAN-like account number to give to payers, e.g. SB97 1234 5678 9012 3456 without the spaces
+	Number *string `json:"number,omitempty"`
+	Owner  *string `json:"owner,omitempty"`
+}
+
+// PbAccountActivity defines model for pb.AccountActivity.
+type PbAccountActivity struct {
+	AccountId  *string `json:"account_id,omitempty"`
+	Currency   *string `json:"currency,omitempty"`
+	EntryCount *string `json:"entry_count,omitempty"`
+	Owner      *string `json:"owner,omitempty"`
+	TotalIn    *string `json:"total_in,omitempty"`
+	TotalOut   *string `json:"total_out,omitempty"`
+}
+
+// PbAccountSearchResult defines model for pb.AccountSearchResult.
+type PbAccountSearchResult struct {
+	Account       *PbAccount `json:"account,omitempty"`
+	OwnerEmail    *string    `json:"owner_email,omitempty"`
+	OwnerFullName *string    `json:"owner_full_name,omitempty"`
+	Rank          *float32   `json:"rank,omitempty"`
+}
+
+// PbActivityItem defines model for pb.ActivityItem.
+type PbActivityItem struct {
+	CardAuthorization *PbCardAuthorizationActivity `json:"card_authorization,omitempty"`
+	MoneyMovement     *PbMoneyMovementActivity     `json:"money_movement,omitempty"`
+	OccurredAt        *time.Time                   `json:"occurred_at,omitempty"`
+	SecurityEvent     *PbSecurityEventActivity     `json:"security_event,omitempty"`
+	Type              *int                         `json:"type,omitempty"`
+}
+
+// PbAddDisputeNoteRequest defines model for pb.AddDisputeNoteRequest.
+type PbAddDisputeNoteRequest struct {
+	DisputeId *string `json:"dispute_id,omitempty"`
+	Note      *string `json:"note,omitempty"`
+}
+
+// PbAddDisputeNoteResponse defines model for pb.AddDisputeNoteResponse.
+type PbAddDisputeNoteResponse struct {
+	// Dispute the first note moves an open dispute to under_review
+	Dispute *PbDispute     `json:"dispute,omitempty"`
+	Note    *PbDisputeNote `json:"note,omitempty"`
+}
+
+// PbApplyForLoanRequest defines model for pb.ApplyForLoanRequest.
+type PbApplyForLoanRequest struct {
+	// AccountId the account that receives the loan and pays the installments
+	AccountId  *string `json:"account_id,omitempty"`
+	Principal  *string `json:"principal,omitempty"`
+	TermMonths *int32  `json:"term_months,omitempty"`
+}
+
+// PbApplyForLoanResponse defines model for pb.ApplyForLoanResponse.
+type PbApplyForLoanResponse struct {
+	Loan *PbLoan `json:"loan,omitempty"`
+
+	// Schedule the estimated repayment schedule if the loan is approved today
+	Schedule *[]PbLoanPayment `json:"schedule,omitempty"`
+}
+
+// PbApproveLoanRequest defines model for pb.ApproveLoanRequest.
+type PbApproveLoanRequest struct {
+	// Approve false to reject the loan application
+	Approve *bool   `json:"approve,omitempty"`
+	LoanId  *string `json:"loan_id,omitempty"`
+}
+
+// PbApproveLoanResponse defines model for pb.ApproveLoanResponse.
+type PbApproveLoanResponse struct {
+	Loan *PbLoan `json:"loan,omitempty"`
+
+	// Schedule empty when the loan is rejected
+	Schedule *[]PbLoanPayment `json:"schedule,omitempty"`
+}
+
+// PbAuthorizeCardTransactionRequest defines model for pb.AuthorizeCardTransactionRequest.
+type PbAuthorizeCardTransactionRequest struct {
+	Amount      *string `json:"amount,omitempty"`
+	CardNumber  *string `json:"card_number,omitempty"`
+	Currency    *string `json:"currency,omitempty"`
+	ExpiryMonth *int32  `json:"expiry_month,omitempty"`
+	ExpiryYear  *int32  `json:"expiry_year,omitempty"`
+	Merchant    *string `json:"merchant,omitempty"`
+}
+
+// PbAuthorizeCardTransactionResponse defines model for pb.AuthorizeCardTransactionResponse.
+type PbAuthorizeCardTransactionResponse struct {
+	Approved *bool `json:"approved,omitempty"`
+
+	// DeclineReason why the transaction was declined, empty when approved
+	DeclineReason *string `json:"decline_reason,omitempty"`
+
+	// HoldId the hold placed on the linked account, 0 when declined
+	HoldId *string `json:"hold_id,omitempty"`
+}
+
+// PbBalanceAlert defines model for pb.BalanceAlert.
+type PbBalanceAlert struct {
+	AccountId *string    `json:"account_id,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// Kind low_balance or large_debit
+	Kind *string `json:"kind,omitempty"`
+
+	// Threshold In the smallest unit of the account currency
+	Threshold *string    `json:"threshold,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PbBalanceDiscrepancy defines model for pb.BalanceDiscrepancy.
+type PbBalanceDiscrepancy struct {
+	AccountBalance *string `json:"account_balance,omitempty"`
+	AccountId      *string `json:"account_id,omitempty"`
+	EntriesBalance *string `json:"entries_balance,omitempty"`
+}
+
+// PbBlockSessionResponse defines model for pb.BlockSessionResponse.
+type PbBlockSessionResponse struct {
+	IsBlocked *bool `json:"is_blocked,omitempty"`
+}
+
+// PbBulkTransferItem defines model for pb.BulkTransferItem.
+type PbBulkTransferItem struct {
+	// Amount 最小货币单位，比如 USD 的 1234 表示 12.34
+	Amount *string `json:"amount,omitempty"`
+
+	// AmountDecimal 也可以用十进制字符串表示金额（比如 "12.34"），不能和 amount 同时使用
+	AmountDecimal *string `json:"amount_decimal,omitempty"`
+	Category      *string `json:"category,omitempty"`
+	Memo          *string `json:"memo,omitempty"`
+	Reference     *string `json:"reference,omitempty"`
+
+	// ToAccountId the account to transfer to, either by id or by account number but not both
+	ToAccountId *string `json:"to_account_id,omitempty"`
+
+	// ToAccountNumber spaces are ignored and letters are case-insensitive
+	ToAccountNumber *string `json:"to_account_number,omitempty"`
+}
+
+// PbBulkTransferItemResult defines model for pb.BulkTransferItemResult.
+type PbBulkTransferItemResult struct {
+	Amount      *string     `json:"amount,omitempty"`
+	Error       *string     `json:"error,omitempty"`
+	Success     *bool       `json:"success,omitempty"`
+	ToAccountId *string     `json:"to_account_id,omitempty"`
+	Transfer    *PbTransfer `json:"transfer,omitempty"`
+}
+
+// PbCancelAccountDeletionResponse defines model for pb.CancelAccountDeletionResponse.
+type PbCancelAccountDeletionResponse struct {
+	IsCancelled *bool `json:"is_cancelled,omitempty"`
+}
+
+// PbCard defines model for pb.Card.
+type PbCard struct {
+	AccountId   *string    `json:"account_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	ExpiryMonth *int32     `json:"expiry_month,omitempty"`
+	ExpiryYear  *int32     `json:"expiry_year,omitempty"`
+	Id          *string    `json:"id,omitempty"`
+
+	// MaskedNumber only the last 4 digits are shown, e.g. "**** **** **** 4242"
+	MaskedNumber *string `json:"masked_number,omitempty"`
+
+	// Status active or frozen
+	Status *string `json:"status,omitempty"`
+}
+
+// PbCardAuthorizationActivity defines model for pb.CardAuthorizationActivity.
+type PbCardAuthorizationActivity struct {
+	Amount      *string `json:"amount,omitempty"`
+	CardId      *string `json:"card_id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	HoldId      *string `json:"hold_id,omitempty"`
+
+	// Status active, captured or released
+	Status *string `json:"status,omitempty"`
+
+	// TransferId The transfer created when the authorization was captured
+	TransferId *string `json:"transfer_id,omitempty"`
+}
+
+// PbCheckLedgerResponse defines model for pb.CheckLedgerResponse.
+type PbCheckLedgerResponse struct {
+	Balanced         *bool   `json:"balanced,omitempty"`
+	TransfersChecked *string `json:"transfers_checked,omitempty"`
+
+	// UnbalancedTransfers 最多返回 100 条
+	UnbalancedTransfers *[]PbUnbalancedTransfer `json:"unbalanced_transfers,omitempty"`
+}
+
+// PbCollectPaymentRequest defines model for pb.CollectPaymentRequest.
+type PbCollectPaymentRequest struct {
+	Amount    *string `json:"amount,omitempty"`
+	MandateId *string `json:"mandate_id,omitempty"`
+}
+
+// PbCollectPaymentResponse defines model for pb.CollectPaymentResponse.
+type PbCollectPaymentResponse struct {
+	Mandate  *PbMandate  `json:"mandate,omitempty"`
+	Transfer *PbTransfer `json:"transfer,omitempty"`
+}
+
+// PbCreateBalanceAlertRequest defines model for pb.CreateBalanceAlertRequest.
+type PbCreateBalanceAlertRequest struct {
+	AccountId *string `json:"account_id,omitempty"`
+
+	// Kind low_balance or large_debit
+	Kind *string `json:"kind,omitempty"`
+
+	// Threshold In the smallest unit of the account currency
+	Threshold *string `json:"threshold,omitempty"`
+}
+
+// PbCreateBalanceAlertResponse defines model for pb.CreateBalanceAlertResponse.
+type PbCreateBalanceAlertResponse struct {
+	BalanceAlert *PbBalanceAlert `json:"balance_alert,omitempty"`
+}
+
+// PbCreateBulkTransferRequest defines model for pb.CreateBulkTransferRequest.
+type PbCreateBulkTransferRequest struct {
+	BestEffort    *bool                 `json:"best_effort,omitempty"`
+	Currency      *string               `json:"currency,omitempty"`
+	FromAccountId *string               `json:"from_account_id,omitempty"`
+	Items         *[]PbBulkTransferItem `json:"items,omitempty"`
+}
+
+// PbCreateBulkTransferResponse defines model for pb.CreateBulkTransferResponse.
+type PbCreateBulkTransferResponse struct {
+	FromAccount *PbAccount                  `json:"from_account,omitempty"`
+	Results     *[]PbBulkTransferItemResult `json:"results,omitempty"`
+}
+
+// PbCreateMandateRequest defines model for pb.CreateMandateRequest.
+type PbCreateMandateRequest struct {
+	MaxAmount      *string `json:"max_amount,omitempty"`
+	PayeeAccountId *string `json:"payee_account_id,omitempty"`
+	PayerAccountId *string `json:"payer_account_id,omitempty"`
+}
+
+// PbCreateMandateResponse defines model for pb.CreateMandateResponse.
+type PbCreateMandateResponse struct {
+	Mandate *PbMandate `json:"mandate,omitempty"`
+}
+
+// PbCreateUserRequest defines model for pb.CreateUserRequest.
+type PbCreateUserRequest struct {
+	Email    *string `json:"email,omitempty"`
+	FullName *string `json:"full_name,omitempty"`
+	Password *string `json:"password,omitempty"`
+
+	// ReferralCode 可选，推荐人的推荐码（见 GetReferralCode），验证邮箱并且第一次入账之后双方都能拿到奖励
+	ReferralCode *string `json:"referral_code,omitempty"`
+	Username     *string `json:"username,omitempty"`
+}
+
+// PbCreateUserResponse defines model for pb.CreateUserResponse.
+type PbCreateUserResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbCurrency defines model for pb.Currency.
+type PbCurrency struct {
+	Code       *string `json:"code,omitempty"`
+	MinorUnits *int32  `json:"minor_units,omitempty"`
+}
+
+// PbCurrencyDeposits defines model for pb.CurrencyDeposits.
+type PbCurrencyDeposits struct {
+	AccountCount *string `json:"account_count,omitempty"`
+	Currency     *string `json:"currency,omitempty"`
+	TotalBalance *string `json:"total_balance,omitempty"`
+}
+
+// PbDailyFailedLogins defines model for pb.DailyFailedLogins.
+type PbDailyFailedLogins struct {
+	ClientIpCount *string    `json:"client_ip_count,omitempty"`
+	Day           *time.Time `json:"day,omitempty"`
+	FailedCount   *string    `json:"failed_count,omitempty"`
+	UsernameCount *string    `json:"username_count,omitempty"`
+}
+
+// PbDailyNewUsers defines model for pb.DailyNewUsers.
+type PbDailyNewUsers struct {
+	Day       *time.Time `json:"day,omitempty"`
+	UserCount *string    `json:"user_count,omitempty"`
+}
+
+// PbDailyTransferVolume defines model for pb.DailyTransferVolume.
+type PbDailyTransferVolume struct {
+	// Currency 转出账户的币种
+	Currency      *string    `json:"currency,omitempty"`
+	Day           *time.Time `json:"day,omitempty"`
+	TotalAmount   *string    `json:"total_amount,omitempty"`
+	TransferCount *string    `json:"transfer_count,omitempty"`
+}
+
+// PbDeleteBalanceAlertRequest defines model for pb.DeleteBalanceAlertRequest.
+type PbDeleteBalanceAlertRequest struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// PbDeleteBalanceAlertResponse defines model for pb.DeleteBalanceAlertResponse.
+type PbDeleteBalanceAlertResponse = map[string]interface{}
+
+// PbDeleteUserRequest defines model for pb.DeleteUserRequest.
+type PbDeleteUserRequest struct {
+	Username *string `json:"username,omitempty"`
+}
+
+// PbDeleteUserResponse defines model for pb.DeleteUserResponse.
+type PbDeleteUserResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbDisableUserRequest defines model for pb.DisableUserRequest.
+type PbDisableUserRequest struct {
+	Username *string `json:"username,omitempty"`
+}
+
+// PbDisableUserResponse defines model for pb.DisableUserResponse.
+type PbDisableUserResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbDispute defines model for pb.Dispute.
+type PbDispute struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// OpenedBy username of the user who opened the dispute
+	OpenedBy *string `json:"opened_by,omitempty"`
+	Reason   *string `json:"reason,omitempty"`
+
+	// RefundTransferId the reversal of the disputed transfer when the dispute is refunded
+	RefundTransferId *string `json:"refund_transfer_id,omitempty"`
+
+	// Resolution set by the banker when the dispute is refunded or rejected
+	Resolution *string    `json:"resolution,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	ResolvedBy *string    `json:"resolved_by,omitempty"`
+
+	// Status open, under_review, refunded or rejected
+	Status     *string    `json:"status,omitempty"`
+	TransferId *string    `json:"transfer_id,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// PbDisputeNote defines model for pb.DisputeNote.
+type PbDisputeNote struct {
+	// Author username of the banker who wrote the note
+	Author    *string    `json:"author,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	DisputeId *string    `json:"dispute_id,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	Note      *string    `json:"note,omitempty"`
+}
+
+// PbEntry defines model for pb.Entry.
+type PbEntry struct {
+	AccountId *string    `json:"account_id,omitempty"`
+	Amount    *string    `json:"amount,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// TransferId 转账产生的 entry 才有，其他情况为 0
+	TransferId *string `json:"transfer_id,omitempty"`
+}
+
+// PbErrorResponse defines model for pb.ErrorResponse.
+type PbErrorResponse struct {
+	Code            *string             `json:"code,omitempty"`
+	Error           *string             `json:"error,omitempty"`
+	FieldViolations *[]PbFieldViolation `json:"field_violations,omitempty"`
+	Message         *string             `json:"message,omitempty"`
+	RequestId       *string             `json:"request_id,omitempty"`
+}
+
+// PbExchangeOAuthCodeResponse defines model for pb.ExchangeOAuthCodeResponse.
+type PbExchangeOAuthCodeResponse struct {
+	AccessToken           *string    `json:"access_token,omitempty"`
+	AccessTokenExpiresAt  *time.Time `json:"access_token_expires_at,omitempty"`
+	IsNewUser             *bool      `json:"is_new_user,omitempty"`
+	RefreshToken          *string    `json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *time.Time `json:"refresh_token_expires_at,omitempty"`
+	SessionId             *string    `json:"session_id,omitempty"`
+	User                  *PbUser    `json:"user,omitempty"`
+}
+
+// PbExportJob defines model for pb.ExportJob.
+type PbExportJob struct {
+	AccountId   *string    `json:"account_id,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Error       *string    `json:"error,omitempty"`
+
+	// Format csv, ofx, qif or camt053
+	Format *string `json:"format,omitempty"`
+	Id     *string `json:"id,omitempty"`
+
+	// Kind transfers or entries
+	Kind          *string `json:"kind,omitempty"`
+	ProcessedRows *string `json:"processed_rows,omitempty"`
+
+	// Status pending, running, completed or failed
+	Status    *string `json:"status,omitempty"`
+	TotalRows *string `json:"total_rows,omitempty"`
+}
+
+// PbExternalPayoutRequest defines model for pb.ExternalPayoutRequest.
+type PbExternalPayoutRequest struct {
+	AccountNumber *string `json:"account_number,omitempty"`
+	Amount        *string `json:"amount,omitempty"`
+	FromAccountId *string `json:"from_account_id,omitempty"`
+
+	// RoutingNumber 9-digit ABA routing number of the receiving bank
+	RoutingNumber *string `json:"routing_number,omitempty"`
+}
+
+// PbExternalPayoutResponse defines model for pb.ExternalPayoutResponse.
+type PbExternalPayoutResponse struct {
+	// AvailableBalance balance of the account minus all active holds, including this payout and its fee
+	AvailableBalance *string             `json:"available_balance,omitempty"`
+	ExternalTransfer *PbExternalTransfer `json:"external_transfer,omitempty"`
+
+	// Fee withdrawal fee charged when the payout was created, not refunded if it is returned
+	Fee *string `json:"fee,omitempty"`
+}
+
+// PbExternalTransfer defines model for pb.ExternalTransfer.
+type PbExternalTransfer struct {
+	AccountId     *string    `json:"account_id,omitempty"`
+	AccountNumber *string    `json:"account_number,omitempty"`
+	Amount        *string    `json:"amount,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	Currency      *string    `json:"currency,omitempty"`
+	Id            *string    `json:"id,omitempty"`
+	ReturnReason  *string    `json:"return_reason,omitempty"`
+	RoutingNumber *string    `json:"routing_number,omitempty"`
+
+	// Status initiated, submitted, settled or returned
+	Status    *string    `json:"status,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PbFeatureFlag defines model for pb.FeatureFlag.
+type PbFeatureFlag struct {
+	Description       *string    `json:"description,omitempty"`
+	Enabled           *bool      `json:"enabled,omitempty"`
+	Name              *string    `json:"name,omitempty"`
+	RolloutPercentage *int32     `json:"rollout_percentage,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+	UpdatedBy         *string    `json:"updated_by,omitempty"`
+}
+
+// PbFee defines model for pb.Fee.
+type PbFee struct {
+	// Bps percentage of the amount in basis points, rounded up to the smallest unit
+	Bps      *int32  `json:"bps,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+
+	// Flat flat fee in the smallest unit of currency
+	Flat *string `json:"flat,omitempty"`
+
+	// Kind transfer or withdrawal
+	Kind *string `json:"kind,omitempty"`
+}
+
+// PbFieldViolation defines model for pb.FieldViolation.
+type PbFieldViolation struct {
+	Description *string `json:"description,omitempty"`
+	Field       *string `json:"field,omitempty"`
+}
+
+// PbForcePasswordResetRequest defines model for pb.ForcePasswordResetRequest.
+type PbForcePasswordResetRequest struct {
+	Username *string `json:"username,omitempty"`
+}
+
+// PbForcePasswordResetResponse defines model for pb.ForcePasswordResetResponse.
+type PbForcePasswordResetResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbFreezeCardRequest defines model for pb.FreezeCardRequest.
+type PbFreezeCardRequest struct {
+	CardId *string `json:"card_id,omitempty"`
+
+	// Frozen true to freeze the card, false to unfreeze it
+	Frozen *bool `json:"frozen,omitempty"`
+}
+
+// PbFreezeCardResponse defines model for pb.FreezeCardResponse.
+type PbFreezeCardResponse struct {
+	Card *PbCard `json:"card,omitempty"`
+}
+
+// PbGetAccountByNumberResponse defines model for pb.GetAccountByNumberResponse.
+type PbGetAccountByNumberResponse struct {
+	Account *PbAccount `json:"account,omitempty"`
+}
+
+// PbGetActivityFeedResponse defines model for pb.GetActivityFeedResponse.
+type PbGetActivityFeedResponse struct {
+	// Items Newest first
+	Items         *[]PbActivityItem `json:"items,omitempty"`
+	NextPageToken *string           `json:"next_page_token,omitempty"`
+}
+
+// PbGetDisputeResponse defines model for pb.GetDisputeResponse.
+type PbGetDisputeResponse struct {
+	Dispute *PbDispute `json:"dispute,omitempty"`
+
+	// Notes internal notes written by bankers, oldest first. Only returned to bankers.
+	Notes *[]PbDisputeNote `json:"notes,omitempty"`
+}
+
+// PbGetExportStatusResponse defines model for pb.GetExportStatusResponse.
+type PbGetExportStatusResponse struct {
+	// DownloadUrl Short-lived link to download the CSV file, only set when the export is completed
+	DownloadUrl *string      `json:"download_url,omitempty"`
+	ExportJob   *PbExportJob `json:"export_job,omitempty"`
+}
+
+// PbGetFeeScheduleResponse defines model for pb.GetFeeScheduleResponse.
+type PbGetFeeScheduleResponse struct {
+	Fees *[]PbFee `json:"fees,omitempty"`
+}
+
+// PbGetKYCDocumentFileResponse defines model for pb.GetKYCDocumentFileResponse.
+type PbGetKYCDocumentFileResponse struct {
+	Content     *string `json:"content,omitempty"`
+	ContentType *string `json:"content_type,omitempty"`
+
+	// DownloadUrl a short-lived URL to download the file directly from the blob store,
+	//  empty if the blob store can't sign URLs
+	DownloadUrl *string `json:"download_url,omitempty"`
+}
+
+// PbGetMigrationStatusResponse defines model for pb.GetMigrationStatusResponse.
+type PbGetMigrationStatusResponse struct {
+	Behind        *bool   `json:"behind,omitempty"`
+	Dirty         *bool   `json:"dirty,omitempty"`
+	LatestVersion *string `json:"latest_version,omitempty"`
+	Pending       *int32  `json:"pending,omitempty"`
+	Version       *string `json:"version,omitempty"`
+}
+
+// PbGetNotificationPreferencesResponse defines model for pb.GetNotificationPreferencesResponse.
+type PbGetNotificationPreferencesResponse struct {
+	Preferences *PbNotificationPreferences `json:"preferences,omitempty"`
+}
+
+// PbGetOAuthURLResponse defines model for pb.GetOAuthURLResponse.
+type PbGetOAuthURLResponse struct {
+	AuthUrl *string `json:"auth_url,omitempty"`
+	State   *string `json:"state,omitempty"`
+}
+
+// PbGetReconciliationReportResponse defines model for pb.GetReconciliationReportResponse.
+type PbGetReconciliationReportResponse struct {
+	Report *PbReconciliationReport `json:"report,omitempty"`
+}
+
+// PbGetReferralCodeResponse defines model for pb.GetReferralCodeResponse.
+type PbGetReferralCodeResponse struct {
+	// Code share this code, new users enter it as referral_code when they sign up
+	Code          *string `json:"code,omitempty"`
+	CreditedCount *string `json:"credited_count,omitempty"`
+
+	// PendingCount referred users who haven't verified their email or received their first deposit yet
+	PendingCount *string `json:"pending_count,omitempty"`
+}
+
+// PbGetServerInfoResponse defines model for pb.GetServerInfoResponse.
+type PbGetServerInfoResponse struct {
+	BuildDate   *string    `json:"build_date,omitempty"`
+	Commit      *string    `json:"commit,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	GoVersion   *string    `json:"go_version,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	Uptime      *string    `json:"uptime,omitempty"`
+	Version     *string    `json:"version,omitempty"`
+}
+
+// PbGetSpendingSummaryResponse defines model for pb.GetSpendingSummaryResponse.
+type PbGetSpendingSummaryResponse struct {
+	Summaries *[]PbSpendingSummary `json:"summaries,omitempty"`
+}
+
+// PbGetTotalDepositsResponse defines model for pb.GetTotalDepositsResponse.
+type PbGetTotalDepositsResponse struct {
+	// Deposits 按币种汇总 depositor 用户的账户余额，已经关闭的账户不算
+	Deposits *[]PbCurrencyDeposits `json:"deposits,omitempty"`
+}
+
+// PbGetTransferQuoteRequest defines model for pb.GetTransferQuoteRequest.
+type PbGetTransferQuoteRequest struct {
+	// Amount amount to send in the smallest unit of from_currency, fee included
+	Amount *string `json:"amount,omitempty"`
+
+	// AmountDecimal 也可以用十进制字符串表示金额（比如 "12.34"），不能和 amount 同时使用
+	AmountDecimal *string `json:"amount_decimal,omitempty"`
+	FromCurrency  *string `json:"from_currency,omitempty"`
+	ToCurrency    *string `json:"to_currency,omitempty"`
+}
+
+// PbGetTransferQuoteResponse defines model for pb.GetTransferQuoteResponse.
+type PbGetTransferQuoteResponse struct {
+	// Quote TransferQuote locks an exchange rate for a cross-currency transfer until expires_at
+	Quote *PbTransferQuote `json:"quote,omitempty"`
+}
+
+// PbGetTransferStatusResponse defines model for pb.GetTransferStatusResponse.
+type PbGetTransferStatusResponse struct {
+	FailureReason      *string     `json:"failure_reason,omitempty"`
+	ReversalTransferId *string     `json:"reversal_transfer_id,omitempty"`
+	Status             *string     `json:"status,omitempty"`
+	Transfer           *PbTransfer `json:"transfer,omitempty"`
+	UpdatedAt          *time.Time  `json:"updated_at,omitempty"`
+}
+
+// PbGetUserResponse defines model for pb.GetUserResponse.
+type PbGetUserResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbGetVersionResponse defines model for pb.GetVersionResponse.
+type PbGetVersionResponse struct {
+	BuildDate *string `json:"build_date,omitempty"`
+	Commit    *string `json:"commit,omitempty"`
+	GoVersion *string `json:"go_version,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// PbGetWorkerStatsResponse defines model for pb.GetWorkerStatsResponse.
+type PbGetWorkerStatsResponse struct {
+	Queues *[]PbQueueStats `json:"queues,omitempty"`
+}
+
+// PbIssueCardRequest defines model for pb.IssueCardRequest.
+type PbIssueCardRequest struct {
+	AccountId *string `json:"account_id,omitempty"`
+}
+
+// PbIssueCardResponse defines model for pb.IssueCardResponse.
+type PbIssueCardResponse struct {
+	Card *PbCard `json:"card,omitempty"`
+
+	// Number the full card number is only returned once, when the card is issued
+	Number *string `json:"number,omitempty"`
+}
+
+// PbKYCDocument defines model for pb.KYCDocument.
+type PbKYCDocument struct {
+	ContentType *string    `json:"content_type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+
+	// DocumentType passport, drivers_license, national_id or proof_of_address
+	DocumentType    *string    `json:"document_type,omitempty"`
+	Id              *string    `json:"id,omitempty"`
+	RejectionReason *string    `json:"rejection_reason,omitempty"`
+	ReviewedAt      *time.Time `json:"reviewed_at,omitempty"`
+	ReviewedBy      *string    `json:"reviewed_by,omitempty"`
+	SizeBytes       *string    `json:"size_bytes,omitempty"`
+
+	// Status pending, approved or rejected
+	Status   *string `json:"status,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// PbListAccountsResponse defines model for pb.ListAccountsResponse.
+type PbListAccountsResponse struct {
+	Accounts      *[]PbAccount `json:"accounts,omitempty"`
+	NextPageToken *string      `json:"next_page_token,omitempty"`
+}
+
+// PbListBalanceAlertsResponse defines model for pb.ListBalanceAlertsResponse.
+type PbListBalanceAlertsResponse struct {
+	BalanceAlerts *[]PbBalanceAlert `json:"balance_alerts,omitempty"`
+}
+
+// PbListCardsResponse defines model for pb.ListCardsResponse.
+type PbListCardsResponse struct {
+	Cards *[]PbCard `json:"cards,omitempty"`
+}
+
+// PbListCurrenciesResponse defines model for pb.ListCurrenciesResponse.
+type PbListCurrenciesResponse struct {
+	Currencies *[]PbCurrency `json:"currencies,omitempty"`
+}
+
+// PbListDailyFailedLoginsResponse defines model for pb.ListDailyFailedLoginsResponse.
+type PbListDailyFailedLoginsResponse struct {
+	Days *[]PbDailyFailedLogins `json:"days,omitempty"`
+}
+
+// PbListDailyNewUsersResponse defines model for pb.ListDailyNewUsersResponse.
+type PbListDailyNewUsersResponse struct {
+	Days *[]PbDailyNewUsers `json:"days,omitempty"`
+}
+
+// PbListDailyTransferVolumeResponse defines model for pb.ListDailyTransferVolumeResponse.
+type PbListDailyTransferVolumeResponse struct {
+	Volumes *[]PbDailyTransferVolume `json:"volumes,omitempty"`
+}
+
+// PbListDisputesResponse defines model for pb.ListDisputesResponse.
+type PbListDisputesResponse struct {
+	Disputes      *[]PbDispute `json:"disputes,omitempty"`
+	NextPageToken *string      `json:"next_page_token,omitempty"`
+}
+
+// PbListEntriesResponse defines model for pb.ListEntriesResponse.
+type PbListEntriesResponse struct {
+	Entries       *[]PbEntry `json:"entries,omitempty"`
+	NextPageToken *string    `json:"next_page_token,omitempty"`
+}
+
+// PbListFeatureFlagsResponse defines model for pb.ListFeatureFlagsResponse.
+type PbListFeatureFlagsResponse struct {
+	Flags *[]PbFeatureFlag `json:"flags,omitempty"`
+}
+
+// PbListKYCDocumentsResponse defines model for pb.ListKYCDocumentsResponse.
+type PbListKYCDocumentsResponse struct {
+	Documents *[]PbKYCDocument `json:"documents,omitempty"`
+	KycLevel  *int32           `json:"kyc_level,omitempty"`
+}
+
+// PbListPendingKYCDocumentsResponse defines model for pb.ListPendingKYCDocumentsResponse.
+type PbListPendingKYCDocumentsResponse struct {
+	Documents *[]PbKYCDocument `json:"documents,omitempty"`
+}
+
+// PbListSessionsResponse defines model for pb.ListSessionsResponse.
+type PbListSessionsResponse struct {
+	Sessions *[]PbSession `json:"sessions,omitempty"`
+}
+
+// PbListTopAccountsResponse defines model for pb.ListTopAccountsResponse.
+type PbListTopAccountsResponse struct {
+	Accounts *[]PbAccountActivity `json:"accounts,omitempty"`
+}
+
+// PbListTransfersResponse defines model for pb.ListTransfersResponse.
+type PbListTransfersResponse struct {
+	NextPageToken *string       `json:"next_page_token,omitempty"`
+	Transfers     *[]PbTransfer `json:"transfers,omitempty"`
+}
+
+// PbListUsersResponse defines model for pb.ListUsersResponse.
+type PbListUsersResponse struct {
+	Users *[]PbUser `json:"users,omitempty"`
+}
+
+// PbLoan defines model for pb.Loan.
+type PbLoan struct {
+	AccountId *string `json:"account_id,omitempty"`
+
+	// AnnualRateBps annual interest rate in basis points, e.g. 800 = 8%
+	AnnualRateBps *int32     `json:"annual_rate_bps,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	Currency      *string    `json:"currency,omitempty"`
+	Id            *string    `json:"id,omitempty"`
+	Principal     *string    `json:"principal,omitempty"`
+
+	// Status pending, active, rejected, delinquent or paid_off
+	Status     *string `json:"status,omitempty"`
+	TermMonths *int32  `json:"term_months,omitempty"`
+}
+
+// PbLoanPayment defines model for pb.LoanPayment.
+type PbLoanPayment struct {
+	Amount      *string    `json:"amount,omitempty"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Installment *int32     `json:"installment,omitempty"`
+	Interest    *string    `json:"interest,omitempty"`
+	Principal   *string    `json:"principal,omitempty"`
+
+	// Status scheduled or paid
+	Status *string `json:"status,omitempty"`
+}
+
+// PbLoginUserRequest defines model for pb.LoginUserRequest.
+type PbLoginUserRequest struct {
+	Password *string `json:"password,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// PbLoginUserResponse defines model for pb.LoginUserResponse.
+type PbLoginUserResponse struct {
+	AccessToken           *string    `json:"access_token,omitempty"`
+	AccessTokenExpiresAt  *time.Time `json:"access_token_expires_at,omitempty"`
+	RefreshToken          *string    `json:"refresh_token,omitempty"`
+	RefreshTokenExpiresAt *time.Time `json:"refresh_token_expires_at,omitempty"`
+	SessionId             *string    `json:"session_id,omitempty"`
+	User                  *PbUser    `json:"user,omitempty"`
+}
+
+// PbMandate defines model for pb.Mandate.
+type PbMandate struct {
+	CollectedAmount *string    `json:"collected_amount,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	Id              *string    `json:"id,omitempty"`
+	IsRevoked       *bool      `json:"is_revoked,omitempty"`
+	MaxAmount       *string    `json:"max_amount,omitempty"`
+	PayeeAccountId  *string    `json:"payee_account_id,omitempty"`
+	PayerAccountId  *string    `json:"payer_account_id,omitempty"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+}
+
+// PbMoneyMovementActivity defines model for pb.MoneyMovementActivity.
+type PbMoneyMovementActivity struct {
+	// Amount Negative for money sent, positive for money received
+	Amount                *string `json:"amount,omitempty"`
+	CounterpartyAccountId *string `json:"counterparty_account_id,omitempty"`
+	EntryId               *string `json:"entry_id,omitempty"`
+	Memo                  *string `json:"memo,omitempty"`
+
+	// TransferId 0 if the entry isn't part of a transfer
+	TransferId *string `json:"transfer_id,omitempty"`
+}
+
+// PbNotificationPreferences defines model for pb.NotificationPreferences.
+type PbNotificationPreferences struct {
+	DeviceToken  *string    `json:"device_token,omitempty"`
+	EmailEnabled *bool      `json:"email_enabled,omitempty"`
+	PhoneNumber  *string    `json:"phone_number,omitempty"`
+	PushEnabled  *bool      `json:"push_enabled,omitempty"`
+	SmsEnabled   *bool      `json:"sms_enabled,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+}
+
+// PbOpenDisputeRequest defines model for pb.OpenDisputeRequest.
+type PbOpenDisputeRequest struct {
+	// Reason e.g. "I didn't receive the goods"
+	Reason     *string `json:"reason,omitempty"`
+	TransferId *string `json:"transfer_id,omitempty"`
+}
+
+// PbOpenDisputeResponse defines model for pb.OpenDisputeResponse.
+type PbOpenDisputeResponse struct {
+	Dispute *PbDispute `json:"dispute,omitempty"`
+}
+
+// PbQueueStats defines model for pb.QueueStats.
+type PbQueueStats struct {
+	Active         *string  `json:"active,omitempty"`
+	Archived       *string  `json:"archived,omitempty"`
+	Completed      *string  `json:"completed,omitempty"`
+	FailedToday    *string  `json:"failed_today,omitempty"`
+	FailedTotal    *string  `json:"failed_total,omitempty"`
+	FailureRate    *float64 `json:"failure_rate,omitempty"`
+	LatencyMs      *string  `json:"latency_ms,omitempty"`
+	Paused         *bool    `json:"paused,omitempty"`
+	Pending        *string  `json:"pending,omitempty"`
+	ProcessedToday *string  `json:"processed_today,omitempty"`
+	ProcessedTotal *string  `json:"processed_total,omitempty"`
+	Queue          *string  `json:"queue,omitempty"`
+	Retry          *string  `json:"retry,omitempty"`
+	Scheduled      *string  `json:"scheduled,omitempty"`
+	Size           *string  `json:"size,omitempty"`
+}
+
+// PbReconciliationReport defines model for pb.ReconciliationReport.
+type PbReconciliationReport struct {
+	AccountsChecked  *string                 `json:"accounts_checked,omitempty"`
+	CreatedAt        *time.Time              `json:"created_at,omitempty"`
+	Discrepancies    *[]PbBalanceDiscrepancy `json:"discrepancies,omitempty"`
+	DiscrepancyCount *string                 `json:"discrepancy_count,omitempty"`
+	Id               *string                 `json:"id,omitempty"`
+	StartedAt        *time.Time              `json:"started_at,omitempty"`
+}
+
+// PbResendVerifyEmailRequest defines model for pb.ResendVerifyEmailRequest.
+type PbResendVerifyEmailRequest = map[string]interface{}
+
+// PbResendVerifyEmailResponse defines model for pb.ResendVerifyEmailResponse.
+type PbResendVerifyEmailResponse struct {
+	Email *string `json:"email,omitempty"`
+}
+
+// PbResolveDisputeRequest defines model for pb.ResolveDisputeRequest.
+type PbResolveDisputeRequest struct {
+	DisputeId *string `json:"dispute_id,omitempty"`
+
+	// Refund true to refund the disputed transfer, false to reject the dispute
+	Refund *bool `json:"refund,omitempty"`
+
+	// Resolution shown to the user in the email, e.g. "the merchant confirmed the duplicate charge"
+	Resolution *string `json:"resolution,omitempty"`
+}
+
+// PbResolveDisputeResponse defines model for pb.ResolveDisputeResponse.
+type PbResolveDisputeResponse struct {
+	Dispute *PbDispute `json:"dispute,omitempty"`
+
+	// Refund only set when the dispute is refunded
+	Refund *PbTransfer `json:"refund,omitempty"`
+}
+
+// PbReverseTransferRequest defines model for pb.ReverseTransferRequest.
+type PbReverseTransferRequest struct {
+	// Reason recorded in the audit log, e.g. "duplicate payment"
+	Reason     *string `json:"reason,omitempty"`
+	TransferId *string `json:"transfer_id,omitempty"`
+}
+
+// PbReverseTransferResponse defines model for pb.ReverseTransferResponse.
+type PbReverseTransferResponse struct {
+	Original *PbTransfer `json:"original,omitempty"`
+	Reversal *PbTransfer `json:"reversal,omitempty"`
+}
+
+// PbReviewKYCDocumentRequest defines model for pb.ReviewKYCDocumentRequest.
+type PbReviewKYCDocumentRequest struct {
+	// Approve false to reject the document
+	Approve    *bool   `json:"approve,omitempty"`
+	DocumentId *string `json:"document_id,omitempty"`
+
+	// RejectionReason required when the document is rejected
+	RejectionReason *string `json:"rejection_reason,omitempty"`
+}
+
+// PbReviewKYCDocumentResponse defines model for pb.ReviewKYCDocumentResponse.
+type PbReviewKYCDocumentResponse struct {
+	Document *PbKYCDocument `json:"document,omitempty"`
+
+	// KycLevel the KYC level of the document owner after the review
+	KycLevel *int32 `json:"kyc_level,omitempty"`
+}
+
+// PbRevokeMandateRequest defines model for pb.RevokeMandateRequest.
+type PbRevokeMandateRequest struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// PbRevokeMandateResponse defines model for pb.RevokeMandateResponse.
+type PbRevokeMandateResponse struct {
+	Mandate *PbMandate `json:"mandate,omitempty"`
+}
+
+// PbRevokeSessionRequest defines model for pb.RevokeSessionRequest.
+type PbRevokeSessionRequest struct {
+	SessionId *string `json:"session_id,omitempty"`
+}
+
+// PbRevokeSessionResponse defines model for pb.RevokeSessionResponse.
+type PbRevokeSessionResponse struct {
+	Session *PbSession `json:"session,omitempty"`
+}
+
+// PbRevokeTokenRequest defines model for pb.RevokeTokenRequest.
+type PbRevokeTokenRequest struct {
+	TokenId *string `json:"token_id,omitempty"`
+}
+
+// PbRevokeTokenResponse defines model for pb.RevokeTokenResponse.
+type PbRevokeTokenResponse = map[string]interface{}
+
+// PbSearchAccountsResponse defines model for pb.SearchAccountsResponse.
+type PbSearchAccountsResponse struct {
+	Results *[]PbAccountSearchResult `json:"results,omitempty"`
+}
+
+// PbSearchUsersResponse defines model for pb.SearchUsersResponse.
+type PbSearchUsersResponse struct {
+	Results *[]PbUserSearchResult `json:"results,omitempty"`
+}
+
+// PbSecurityEventActivity defines model for pb.SecurityEventActivity.
+type PbSecurityEventActivity struct {
+	City     *string `json:"city,omitempty"`
+	ClientIp *string `json:"client_ip,omitempty"`
+	Country  *string `json:"country,omitempty"`
+
+	// Event login, or the action recorded in the audit log, e.g. account.ownership_transferred
+	Event     *string `json:"event,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+}
+
+// PbSeedDataRequest defines model for pb.SeedDataRequest.
+type PbSeedDataRequest struct {
+	AccountsPerUser *int32  `json:"accounts_per_user,omitempty"`
+	Days            *int32  `json:"days,omitempty"`
+	Password        *string `json:"password,omitempty"`
+	Transfers       *int32  `json:"transfers,omitempty"`
+	Users           *int32  `json:"users,omitempty"`
+}
+
+// PbSeedDataResponse defines model for pb.SeedDataResponse.
+type PbSeedDataResponse struct {
+	Accounts  *int32 `json:"accounts,omitempty"`
+	Transfers *int32 `json:"transfers,omitempty"`
+	Users     *int32 `json:"users,omitempty"`
+}
+
+// PbSession defines model for pb.Session.
+type PbSession struct {
+	City     *string `json:"city,omitempty"`
+	ClientIp *string `json:"client_ip,omitempty"`
+
+	// Country ISO 3166-1 alpha-2 code, empty if unknown
+	Country   *string    `json:"country,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	UserAgent *string    `json:"user_agent,omitempty"`
+}
+
+// PbSetDefaultAccountRequest defines model for pb.SetDefaultAccountRequest.
+type PbSetDefaultAccountRequest struct {
+	AccountId *string `json:"account_id,omitempty"`
+}
+
+// PbSetDefaultAccountResponse defines model for pb.SetDefaultAccountResponse.
+type PbSetDefaultAccountResponse struct {
+	Account *PbAccount `json:"account,omitempty"`
+}
+
+// PbSpendingSummary defines model for pb.SpendingSummary.
+type PbSpendingSummary struct {
+	Category      *string    `json:"category,omitempty"`
+	Month         *time.Time `json:"month,omitempty"`
+	TotalAmount   *string    `json:"total_amount,omitempty"`
+	TransferCount *string    `json:"transfer_count,omitempty"`
+}
+
+// PbStartExportRequest defines model for pb.StartExportRequest.
+type PbStartExportRequest struct {
+	AccountId *string `json:"account_id,omitempty"`
+
+	// Format csv (default), or ofx, qif or camt053 to import the entries into accounting software
+	Format *string `json:"format,omitempty"`
+
+	// Kind transfers or entries
+	Kind *string `json:"kind,omitempty"`
+}
+
+// PbStartExportResponse defines model for pb.StartExportResponse.
+type PbStartExportResponse struct {
+	ExportJob *PbExportJob `json:"export_job,omitempty"`
+}
+
+// PbTransfer defines model for pb.Transfer.
+type PbTransfer struct {
+	Amount    *string    `json:"amount,omitempty"`
+	Category  *string    `json:"category,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// FeeOf ID of the transfer this fee was charged for, 0 if it isn't a fee
+	FeeOf         *string `json:"fee_of,omitempty"`
+	FromAccountId *string `json:"from_account_id,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	Memo          *string `json:"memo,omitempty"`
+	Reference     *string `json:"reference,omitempty"`
+
+	// ReversalOf ID of the transfer reversed by this transfer, 0 if it isn't a reversal
+	ReversalOf  *string `json:"reversal_of,omitempty"`
+	ToAccountId *string `json:"to_account_id,omitempty"`
+}
+
+// PbTransferAccountOwnershipRequest defines model for pb.TransferAccountOwnershipRequest.
+type PbTransferAccountOwnershipRequest struct {
+	AccountId *string `json:"account_id,omitempty"`
+	NewOwner  *string `json:"new_owner,omitempty"`
+
+	// Reason recorded in the audit log, e.g. "estate settlement"
+	Reason *string `json:"reason,omitempty"`
+}
+
+// PbTransferAccountOwnershipResponse defines model for pb.TransferAccountOwnershipResponse.
+type PbTransferAccountOwnershipResponse struct {
+	Account       *PbAccount `json:"account,omitempty"`
+	PreviousOwner *string    `json:"previous_owner,omitempty"`
+}
+
+// PbTransferQuote TransferQuote locks an exchange rate for a cross-currency transfer until expires_at
+type PbTransferQuote struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Fee in from_currency
+	Fee *string `json:"fee,omitempty"`
+
+	// FromAmount debited from the sender in from_currency, fee included
+	FromAmount   *string `json:"from_amount,omitempty"`
+	FromCurrency *string `json:"from_currency,omitempty"`
+	Id           *string `json:"id,omitempty"`
+
+	// Rate units of to_currency per unit of from_currency, e.g. "0.92"
+	Rate *string `json:"rate,omitempty"`
+
+	// ToAmount credited to the recipient in to_currency
+	ToAmount   *string `json:"to_amount,omitempty"`
+	ToCurrency *string `json:"to_currency,omitempty"`
+}
+
+// PbUnbalancedTransfer defines model for pb.UnbalancedTransfer.
+type PbUnbalancedTransfer struct {
+	Amount        *string `json:"amount,omitempty"`
+	EntriesSum    *string `json:"entries_sum,omitempty"`
+	EntryCount    *string `json:"entry_count,omitempty"`
+	FromAccountId *string `json:"from_account_id,omitempty"`
+	ToAccountId   *string `json:"to_account_id,omitempty"`
+	TransferId    *string `json:"transfer_id,omitempty"`
+}
+
+// PbUpdateBalanceAlertRequest defines model for pb.UpdateBalanceAlertRequest.
+type PbUpdateBalanceAlertRequest struct {
+	Id *string `json:"id,omitempty"`
+
+	// Threshold In the smallest unit of the account currency
+	Threshold *string `json:"threshold,omitempty"`
+}
+
+// PbUpdateBalanceAlertResponse defines model for pb.UpdateBalanceAlertResponse.
+type PbUpdateBalanceAlertResponse struct {
+	BalanceAlert *PbBalanceAlert `json:"balance_alert,omitempty"`
+}
+
+// PbUpdateFeatureFlagRequest defines model for pb.UpdateFeatureFlagRequest.
+type PbUpdateFeatureFlagRequest struct {
+	Description       *string `json:"description,omitempty"`
+	Enabled           *bool   `json:"enabled,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	RolloutPercentage *int32  `json:"rollout_percentage,omitempty"`
+}
+
+// PbUpdateFeatureFlagResponse defines model for pb.UpdateFeatureFlagResponse.
+type PbUpdateFeatureFlagResponse struct {
+	Flag *PbFeatureFlag `json:"flag,omitempty"`
+}
+
+// PbUpdateNotificationPreferencesRequest defines model for pb.UpdateNotificationPreferencesRequest.
+type PbUpdateNotificationPreferencesRequest struct {
+	DeviceToken  *string `json:"device_token,omitempty"`
+	EmailEnabled *bool   `json:"email_enabled,omitempty"`
+	PhoneNumber  *string `json:"phone_number,omitempty"`
+	PushEnabled  *bool   `json:"push_enabled,omitempty"`
+	SmsEnabled   *bool   `json:"sms_enabled,omitempty"`
+}
+
+// PbUpdateNotificationPreferencesResponse defines model for pb.UpdateNotificationPreferencesResponse.
+type PbUpdateNotificationPreferencesResponse struct {
+	Preferences *PbNotificationPreferences `json:"preferences,omitempty"`
+}
+
+// PbUpdateTransferMemoRequest defines model for pb.UpdateTransferMemoRequest.
+type PbUpdateTransferMemoRequest struct {
+	Category *string `json:"category,omitempty"`
+	Id       *string `json:"id,omitempty"`
+	Memo     *string `json:"memo,omitempty"`
+}
+
+// PbUpdateTransferMemoResponse defines model for pb.UpdateTransferMemoResponse.
+type PbUpdateTransferMemoResponse struct {
+	Transfer *PbTransfer `json:"transfer,omitempty"`
+}
+
+// PbUpdateUserRequest defines model for pb.UpdateUserRequest.
+type PbUpdateUserRequest struct {
+	// Address Set to an empty string to remove the address
+	Address *string `json:"address,omitempty"`
+
+	// AllowAliasTransfers Set to false to stop receiving transfers addressed by username or email
+	AllowAliasTransfers *bool `json:"allow_alias_transfers,omitempty"`
+
+	// DateOfBirth YYYY-MM-DD, the user must be at least 18 years old
+	DateOfBirth *string `json:"date_of_birth,omitempty"`
+	Email       *string `json:"email,omitempty"`
+
+	// ExpectedUpdatedAt updated_at of the user as last read by the client. When set, the update fails with
+	//  FAILED_PRECONDITION (HTTP 412) if the user has been changed since then.
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
+	FullName          *string    `json:"full_name,omitempty"`
+
+	// Locale Language of emails sent to the user, e.g. "en" or "zh"
+	Locale   *string `json:"locale,omitempty"`
+	Password *string `json:"password,omitempty"`
+
+	// PhoneNumber E.164 format, e.g. +14155550123. Set to an empty string to remove the phone number
+	PhoneNumber *string `json:"phone_number,omitempty"`
+
+	// UpdateMask Fields to update, e.g. paths ["full_name", "email"]. When empty, every field that is set is updated.
+	UpdateMask *string `json:"update_mask,omitempty"`
+	Username   *string `json:"username,omitempty"`
+}
+
+// PbUpdateUserResponse defines model for pb.UpdateUserResponse.
+type PbUpdateUserResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbUploadKYCDocumentRequest defines model for pb.UploadKYCDocumentRequest.
+type PbUploadKYCDocumentRequest struct {
+	// Content JPEG, PNG or PDF file. On the HTTP gateway this is base64 encoded,
+	//  or the file can be uploaded as multipart/form-data to /v1/upload_kyc_document/form
+	Content      *string `json:"content,omitempty"`
+	DocumentType *string `json:"document_type,omitempty"`
+}
+
+// PbUploadKYCDocumentResponse defines model for pb.UploadKYCDocumentResponse.
+type PbUploadKYCDocumentResponse struct {
+	Document *PbKYCDocument `json:"document,omitempty"`
+}
+
+// PbUploadUserAvatarRequest defines model for pb.UploadUserAvatarRequest.
+type PbUploadUserAvatarRequest struct {
+	// Content JPEG or PNG image, base64 encoded on the HTTP gateway
+	Content *string `json:"content,omitempty"`
+}
+
+// PbUploadUserAvatarResponse defines model for pb.UploadUserAvatarResponse.
+type PbUploadUserAvatarResponse struct {
+	User *PbUser `json:"user,omitempty"`
+}
+
+// PbUser defines model for pb.User.
+type PbUser struct {
+	Address *string `json:"address,omitempty"`
+
+	// AllowAliasTransfers whether other users may send money to this user by username or email
+	AllowAliasTransfers *bool `json:"allow_alias_transfers,omitempty"`
+
+	// AvatarUrl a short-lived URL to download the avatar from the blob store, only set in the responses of
+	//  GetUser, UpdateUser and UploadUserAvatar. Empty if the user has no avatar or the blob store can't sign URLs
+	AvatarUrl *string    `json:"avatar_url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DateOfBirth YYYY-MM-DD, empty if not set
+	DateOfBirth *string `json:"date_of_birth,omitempty"`
+
+	// DeletionScheduledAt set while a deletion requested by the user is pending, the account is deleted at this time unless cancelled
+	DeletionScheduledAt   *time.Time `json:"deletion_scheduled_at,omitempty"`
+	Email                 *string    `json:"email,omitempty"`
+	FullName              *string    `json:"full_name,omitempty"`
+	IsDisabled            *bool      `json:"is_disabled,omitempty"`
+	IsEmailVerified       *bool      `json:"is_email_verified,omitempty"`
+	Locale                *string    `json:"locale,omitempty"`
+	PasswordChangedAt     *time.Time `json:"password_changed_at,omitempty"`
+	PasswordResetRequired *bool      `json:"password_reset_required,omitempty"`
+
+	// PhoneNumber E.164 format, e.g. +14155550123
+	PhoneNumber *string `json:"phone_number,omitempty"`
+	Role        *string `json:"role,omitempty"`
+
+	// UpdatedAt changes on every update, pass it back as expected_updated_at in UpdateUser to detect concurrent changes
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Username  *string    `json:"username,omitempty"`
+}
+
+// PbUserSearchResult defines model for pb.UserSearchResult.
+type PbUserSearchResult struct {
+	Rank *float32 `json:"rank,omitempty"`
+	User *PbUser  `json:"user,omitempty"`
+}
+
+// PbVerifyEmailResponse defines model for pb.VerifyEmailResponse.
+type PbVerifyEmailResponse struct {
+	IsVerified *bool `json:"is_verified,omitempty"`
+}
+
+// PbV2Account defines model for pb.v2.Account.
+type PbV2Account struct {
+	Balance   *PbV2Money `json:"balance,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	IsDefault *bool      `json:"is_default,omitempty"`
+
+	// Number IBAN-like account number to give to payers
+	Number *string `json:"number,omitempty"`
+	Owner  *string `json:"owner,omitempty"`
+}
+
+// PbV2AccountResult defines model for pb.v2.AccountResult.
+type PbV2AccountResult struct {
+	Account   *PbV2Account `json:"account,omitempty"`
+	AccountId *string      `json:"account_id,omitempty"`
+
+	// Error NOT_FOUND or PERMISSION_DENIED
+	Error *PbV2BatchError `json:"error,omitempty"`
+}
+
+// PbV2BatchError defines model for pb.v2.BatchError.
+type PbV2BatchError struct {
+	// Code the gRPC status code name, e.g. NOT_FOUND
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// PbV2BatchGetAccountsResponse defines model for pb.v2.BatchGetAccountsResponse.
+type PbV2BatchGetAccountsResponse struct {
+	// Results one result for each requested id, in the same order as the request
+	Results *[]PbV2AccountResult `json:"results,omitempty"`
+}
+
+// PbV2Entry defines model for pb.v2.Entry.
+type PbV2Entry struct {
+	AccountId  *string    `json:"account_id,omitempty"`
+	Amount     *PbV2Money `json:"amount,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Id         *string    `json:"id,omitempty"`
+	TransferId *string    `json:"transfer_id,omitempty"`
+}
+
+// PbV2GetAccountResponse defines model for pb.v2.GetAccountResponse.
+type PbV2GetAccountResponse struct {
+	Account *PbV2Account `json:"account,omitempty"`
+}
+
+// PbV2ListAccountsResponse defines model for pb.v2.ListAccountsResponse.
+type PbV2ListAccountsResponse struct {
+	Accounts      *[]PbV2Account `json:"accounts,omitempty"`
+	NextPageToken *string        `json:"next_page_token,omitempty"`
+}
+
+// PbV2ListEntriesResponse defines model for pb.v2.ListEntriesResponse.
+type PbV2ListEntriesResponse struct {
+	Entries       *[]PbV2Entry `json:"entries,omitempty"`
+	NextPageToken *string      `json:"next_page_token,omitempty"`
+}
+
+// PbV2ListTransfersResponse defines model for pb.v2.ListTransfersResponse.
+type PbV2ListTransfersResponse struct {
+	NextPageToken *string         `json:"next_page_token,omitempty"`
+	Transfers     *[]PbV2Transfer `json:"transfers,omitempty"`
+}
+
+// PbV2Money defines model for pb.v2.Money.
+type PbV2Money struct {
+	Amount      *string `json:"amount,omitempty"`
+	AmountMinor *string `json:"amount_minor,omitempty"`
+	Currency    *string `json:"currency,omitempty"`
+}
+
+// PbV2Transfer defines model for pb.v2.Transfer.
+type PbV2Transfer struct {
+	Amount    *PbV2Money `json:"amount,omitempty"`
+	Category  *string    `json:"category,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// FeeOf ID of the transfer this fee was charged for, 0 if it isn't a fee
+	FeeOf         *string `json:"fee_of,omitempty"`
+	FromAccountId *string `json:"from_account_id,omitempty"`
+	Id            *string `json:"id,omitempty"`
+	Memo          *string `json:"memo,omitempty"`
+	Reference     *string `json:"reference,omitempty"`
+
+	// ReversalOf ID of the transfer reversed by this transfer, 0 if it isn't a reversal
+	ReversalOf  *string `json:"reversal_of,omitempty"`
+	ToAccountId *string `json:"to_account_id,omitempty"`
+}
+
+// PbV2UpdateUserRequest v2 里 update_mask 是必填的，只更新 update_mask 里列出的字段，不再根据字段是否设置来推断
+type PbV2UpdateUserRequest struct {
+	AllowAliasTransfers *bool   `json:"allow_alias_transfers,omitempty"`
+	Email               *string `json:"email,omitempty"`
+
+	// ExpectedUpdatedAt 和 v1 一样，设置之后只有用户的 updated_at 没变时才更新
+	ExpectedUpdatedAt *time.Time `json:"expected_updated_at,omitempty"`
+	FullName          *string    `json:"full_name,omitempty"`
+	Locale            *string    `json:"locale,omitempty"`
+	Password          *string    `json:"password,omitempty"`
+	UpdateMask        *string    `json:"update_mask,omitempty"`
+	Username          *string    `json:"username,omitempty"`
+}
+
+// PbV2UpdateUserResponse defines model for pb.v2.UpdateUserResponse.
+type PbV2UpdateUserResponse struct {
+	User *PbV2User `json:"user,omitempty"`
+}
+
+// PbV2User defines model for pb.v2.User.
+type PbV2User struct {
+	AllowAliasTransfers *bool      `json:"allow_alias_transfers,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	Email               *string    `json:"email,omitempty"`
+	FullName            *string    `json:"full_name,omitempty"`
+	IsEmailVerified     *bool      `json:"is_email_verified,omitempty"`
+	Locale              *string    `json:"locale,omitempty"`
+	PasswordChangedAt   *time.Time `json:"password_changed_at,omitempty"`
+	Role                *string    `json:"role,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	Username            *string    `json:"username,omitempty"`
+}
+
+// Error defines model for Error.
+type Error = PbErrorResponse
+
+// SimpleBankGetAccountByNumberParams defines parameters for SimpleBankGetAccountByNumber.
+type SimpleBankGetAccountByNumberParams struct {
+	// Number spaces are ignored and letters are case-insensitive
+	Number *string `form:"number,omitempty" json:"number,omitempty"`
+}
+
+// SimpleBankGetActivityFeedParams defines parameters for SimpleBankGetActivityFeed.
+type SimpleBankGetActivityFeedParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// AdminServiceListDailyFailedLoginsParams defines parameters for AdminServiceListDailyFailedLogins.
+type AdminServiceListDailyFailedLoginsParams struct {
+	// StartTime 时间范围见 dashboard.proto
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	EndTime   *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+}
+
+// AdminServiceListDailyNewUsersParams defines parameters for AdminServiceListDailyNewUsers.
+type AdminServiceListDailyNewUsersParams struct {
+	// StartTime 时间范围见 dashboard.proto
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	EndTime   *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+}
+
+// AdminServiceListTopAccountsParams defines parameters for AdminServiceListTopAccounts.
+type AdminServiceListTopAccountsParams struct {
+	// StartTime 时间范围见 dashboard.proto
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	EndTime   *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+
+	// Limit 默认 10 个，最多 100 个
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AdminServiceListDailyTransferVolumeParams defines parameters for AdminServiceListDailyTransferVolume.
+type AdminServiceListDailyTransferVolumeParams struct {
+	// StartTime 不传的话统计最近 30 天，时间范围最多 366 天
+	StartTime *time.Time `form:"start_time,omitempty" json:"start_time,omitempty"`
+	EndTime   *time.Time `form:"end_time,omitempty" json:"end_time,omitempty"`
+}
+
+// AdminServiceGetUserParams defines parameters for AdminServiceGetUser.
+type AdminServiceGetUserParams struct {
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// AdminServiceListUsersParams defines parameters for AdminServiceListUsers.
+type AdminServiceListUsersParams struct {
+	PageId   *int32  `form:"page_id,omitempty" json:"page_id,omitempty"`
+	PageSize *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+	Search   *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// AdminServiceGetReconciliationReportParams defines parameters for AdminServiceGetReconciliationReport.
+type AdminServiceGetReconciliationReportParams struct {
+	// ReportId 不传的话返回最近一次的报告
+	ReportId *string `form:"report_id,omitempty" json:"report_id,omitempty"`
+}
+
+// SimpleBankListBalanceAlertsParams defines parameters for SimpleBankListBalanceAlerts.
+type SimpleBankListBalanceAlertsParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// SimpleBankBlockSessionParams defines parameters for SimpleBankBlockSession.
+type SimpleBankBlockSessionParams struct {
+	SessionId *string `form:"session_id,omitempty" json:"session_id,omitempty"`
+	DeviceId  *string `form:"device_id,omitempty" json:"device_id,omitempty"`
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty"`
+}
+
+// SimpleBankCancelAccountDeletionParams defines parameters for SimpleBankCancelAccountDeletion.
+type SimpleBankCancelAccountDeletionParams struct {
+	Username  *string `form:"username,omitempty" json:"username,omitempty"`
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Signature *string `form:"signature,omitempty" json:"signature,omitempty"`
+}
+
+// SimpleBankGetDisputeParams defines parameters for SimpleBankGetDispute.
+type SimpleBankGetDisputeParams struct {
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// SimpleBankListDisputesParams defines parameters for SimpleBankListDisputes.
+type SimpleBankListDisputesParams struct {
+	// Status open, under_review, refunded or rejected. When empty, disputes in every status are listed.
+	Status    *string `form:"status,omitempty" json:"status,omitempty"`
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankGetExportStatusParams defines parameters for SimpleBankGetExportStatus.
+type SimpleBankGetExportStatusParams struct {
+	ExportId *string `form:"export_id,omitempty" json:"export_id,omitempty"`
+}
+
+// SimpleBankGetFeeScheduleParams defines parameters for SimpleBankGetFeeSchedule.
+type SimpleBankGetFeeScheduleParams struct {
+	// Currency 不填时返回所有币种的手续费
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+}
+
+// SimpleBankGetKYCDocumentFileParams defines parameters for SimpleBankGetKYCDocumentFile.
+type SimpleBankGetKYCDocumentFileParams struct {
+	DocumentId *string `form:"document_id,omitempty" json:"document_id,omitempty"`
+}
+
+// SimpleBankListAccountsParams defines parameters for SimpleBankListAccounts.
+type SimpleBankListAccountsParams struct {
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankListCardsParams defines parameters for SimpleBankListCards.
+type SimpleBankListCardsParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// SimpleBankListEntriesParams defines parameters for SimpleBankListEntries.
+type SimpleBankListEntriesParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankListKYCDocumentsParams defines parameters for SimpleBankListKYCDocuments.
+type SimpleBankListKYCDocumentsParams struct {
+	// Username bankers can list the documents of any user, defaults to the authenticated user
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// SimpleBankListPendingKYCDocumentsParams defines parameters for SimpleBankListPendingKYCDocuments.
+type SimpleBankListPendingKYCDocumentsParams struct {
+	PageId   *int32 `form:"page_id,omitempty" json:"page_id,omitempty"`
+	PageSize *int32 `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankListTransfersParams defines parameters for SimpleBankListTransfers.
+type SimpleBankListTransfersParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankExchangeOAuthCodeParams defines parameters for SimpleBankExchangeOAuthCode.
+type SimpleBankExchangeOAuthCodeParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+}
+
+// SimpleBankSearchAccountsParams defines parameters for SimpleBankSearchAccounts.
+type SimpleBankSearchAccountsParams struct {
+	Query    *string `form:"query,omitempty" json:"query,omitempty"`
+	PageId   *int32  `form:"page_id,omitempty" json:"page_id,omitempty"`
+	PageSize *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankSearchUsersParams defines parameters for SimpleBankSearchUsers.
+type SimpleBankSearchUsersParams struct {
+	Query    *string `form:"query,omitempty" json:"query,omitempty"`
+	PageId   *int32  `form:"page_id,omitempty" json:"page_id,omitempty"`
+	PageSize *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankGetSpendingSummaryParams defines parameters for SimpleBankGetSpendingSummary.
+type SimpleBankGetSpendingSummaryParams struct {
+	AccountId *string `form:"account_id,omitempty" json:"account_id,omitempty"`
+	Months    *int32  `form:"months,omitempty" json:"months,omitempty"`
+}
+
+// SimpleBankGetTransferStatusParams defines parameters for SimpleBankGetTransferStatus.
+type SimpleBankGetTransferStatusParams struct {
+	TransferId *string `form:"transfer_id,omitempty" json:"transfer_id,omitempty"`
+}
+
+// SimpleBankVerifyEmailParams defines parameters for SimpleBankVerifyEmail.
+type SimpleBankVerifyEmailParams struct {
+	EmailId    *string `form:"email_id,omitempty" json:"email_id,omitempty"`
+	SecretCode *string `form:"secret_code,omitempty" json:"secret_code,omitempty"`
+	ExpiresAt  *string `form:"expires_at,omitempty" json:"expires_at,omitempty"`
+	Signature  *string `form:"signature,omitempty" json:"signature,omitempty"`
+}
+
+// SimpleBankV2ListAccountsParams defines parameters for SimpleBankV2ListAccounts.
+type SimpleBankV2ListAccountsParams struct {
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankV2ListEntriesParams defines parameters for SimpleBankV2ListEntries.
+type SimpleBankV2ListEntriesParams struct {
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankV2ListTransfersParams defines parameters for SimpleBankV2ListTransfers.
+type SimpleBankV2ListTransfersParams struct {
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+	PageSize  *int32  `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// SimpleBankV2BatchGetAccountsParams defines parameters for SimpleBankV2BatchGetAccounts.
+type SimpleBankV2BatchGetAccountsParams struct {
+	AccountIds *[]string `form:"account_ids,omitempty" json:"account_ids,omitempty"`
+}
+
+// SimpleBankAddDisputeNoteJSONRequestBody defines body for SimpleBankAddDisputeNote for application/json ContentType.
+type SimpleBankAddDisputeNoteJSONRequestBody = PbAddDisputeNoteRequest
+
+// AdminServiceAuthorizeCardTransactionJSONRequestBody defines body for AdminServiceAuthorizeCardTransaction for application/json ContentType.
+type AdminServiceAuthorizeCardTransactionJSONRequestBody = PbAuthorizeCardTransactionRequest
+
+// AdminServiceDisableUserJSONRequestBody defines body for AdminServiceDisableUser for application/json ContentType.
+type AdminServiceDisableUserJSONRequestBody = PbDisableUserRequest
+
+// AdminServiceUpdateFeatureFlagJSONRequestBody defines body for AdminServiceUpdateFeatureFlag for application/json ContentType.
+type AdminServiceUpdateFeatureFlagJSONRequestBody = PbUpdateFeatureFlagRequest
+
+// AdminServiceForcePasswordResetJSONRequestBody defines body for AdminServiceForcePasswordReset for application/json ContentType.
+type AdminServiceForcePasswordResetJSONRequestBody = PbForcePasswordResetRequest
+
+// AdminServiceRevokeTokenJSONRequestBody defines body for AdminServiceRevokeToken for application/json ContentType.
+type AdminServiceRevokeTokenJSONRequestBody = PbRevokeTokenRequest
+
+// AdminServiceSeedDataJSONRequestBody defines body for AdminServiceSeedData for application/json ContentType.
+type AdminServiceSeedDataJSONRequestBody = PbSeedDataRequest
+
+// SimpleBankApplyForLoanJSONRequestBody defines body for SimpleBankApplyForLoan for application/json ContentType.
+type SimpleBankApplyForLoanJSONRequestBody = PbApplyForLoanRequest
+
+// SimpleBankApproveLoanJSONRequestBody defines body for SimpleBankApproveLoan for application/json ContentType.
+type SimpleBankApproveLoanJSONRequestBody = PbApproveLoanRequest
+
+// SimpleBankCollectPaymentJSONRequestBody defines body for SimpleBankCollectPayment for application/json ContentType.
+type SimpleBankCollectPaymentJSONRequestBody = PbCollectPaymentRequest
+
+// SimpleBankCreateBalanceAlertJSONRequestBody defines body for SimpleBankCreateBalanceAlert for application/json ContentType.
+type SimpleBankCreateBalanceAlertJSONRequestBody = PbCreateBalanceAlertRequest
+
+// SimpleBankCreateBulkTransferJSONRequestBody defines body for SimpleBankCreateBulkTransfer for application/json ContentType.
+type SimpleBankCreateBulkTransferJSONRequestBody = PbCreateBulkTransferRequest
+
+// SimpleBankCreateMandateJSONRequestBody defines body for SimpleBankCreateMandate for application/json ContentType.
+type SimpleBankCreateMandateJSONRequestBody = PbCreateMandateRequest
+
+// SimpleBankCreateUserJSONRequestBody defines body for SimpleBankCreateUser for application/json ContentType.
+type SimpleBankCreateUserJSONRequestBody = PbCreateUserRequest
+
+// SimpleBankDeleteBalanceAlertJSONRequestBody defines body for SimpleBankDeleteBalanceAlert for application/json ContentType.
+type SimpleBankDeleteBalanceAlertJSONRequestBody = PbDeleteBalanceAlertRequest
+
+// SimpleBankDeleteUserJSONRequestBody defines body for SimpleBankDeleteUser for application/json ContentType.
+type SimpleBankDeleteUserJSONRequestBody = PbDeleteUserRequest
+
+// SimpleBankExternalPayoutJSONRequestBody defines body for SimpleBankExternalPayout for application/json ContentType.
+type SimpleBankExternalPayoutJSONRequestBody = PbExternalPayoutRequest
+
+// SimpleBankFreezeCardJSONRequestBody defines body for SimpleBankFreezeCard for application/json ContentType.
+type SimpleBankFreezeCardJSONRequestBody = PbFreezeCardRequest
+
+// SimpleBankGetTransferQuoteJSONRequestBody defines body for SimpleBankGetTransferQuote for application/json ContentType.
+type SimpleBankGetTransferQuoteJSONRequestBody = PbGetTransferQuoteRequest
+
+// SimpleBankIssueCardJSONRequestBody defines body for SimpleBankIssueCard for application/json ContentType.
+type SimpleBankIssueCardJSONRequestBody = PbIssueCardRequest
+
+// SimpleBankLoginUserJSONRequestBody defines body for SimpleBankLoginUser for application/json ContentType.
+type SimpleBankLoginUserJSONRequestBody = PbLoginUserRequest
+
+// SimpleBankUpdateNotificationPreferencesJSONRequestBody defines body for SimpleBankUpdateNotificationPreferences for application/json ContentType.
+type SimpleBankUpdateNotificationPreferencesJSONRequestBody = PbUpdateNotificationPreferencesRequest
+
+// SimpleBankOpenDisputeJSONRequestBody defines body for SimpleBankOpenDispute for application/json ContentType.
+type SimpleBankOpenDisputeJSONRequestBody = PbOpenDisputeRequest
+
+// SimpleBankResendVerifyEmailJSONRequestBody defines body for SimpleBankResendVerifyEmail for application/json ContentType.
+type SimpleBankResendVerifyEmailJSONRequestBody = PbResendVerifyEmailRequest
+
+// SimpleBankResolveDisputeJSONRequestBody defines body for SimpleBankResolveDispute for application/json ContentType.
+type SimpleBankResolveDisputeJSONRequestBody = PbResolveDisputeRequest
+
+// SimpleBankReverseTransferJSONRequestBody defines body for SimpleBankReverseTransfer for application/json ContentType.
+type SimpleBankReverseTransferJSONRequestBody = PbReverseTransferRequest
+
+// SimpleBankReviewKYCDocumentJSONRequestBody defines body for SimpleBankReviewKYCDocument for application/json ContentType.
+type SimpleBankReviewKYCDocumentJSONRequestBody = PbReviewKYCDocumentRequest
+
+// SimpleBankRevokeMandateJSONRequestBody defines body for SimpleBankRevokeMandate for application/json ContentType.
+type SimpleBankRevokeMandateJSONRequestBody = PbRevokeMandateRequest
+
+// SimpleBankRevokeSessionJSONRequestBody defines body for SimpleBankRevokeSession for application/json ContentType.
+type SimpleBankRevokeSessionJSONRequestBody = PbRevokeSessionRequest
+
+// SimpleBankSetDefaultAccountJSONRequestBody defines body for SimpleBankSetDefaultAccount for application/json ContentType.
+type SimpleBankSetDefaultAccountJSONRequestBody = PbSetDefaultAccountRequest
+
+// SimpleBankStartExportJSONRequestBody defines body for SimpleBankStartExport for application/json ContentType.
+type SimpleBankStartExportJSONRequestBody = PbStartExportRequest
+
+// SimpleBankTransferAccountOwnershipJSONRequestBody defines body for SimpleBankTransferAccountOwnership for application/json ContentType.
+type SimpleBankTransferAccountOwnershipJSONRequestBody = PbTransferAccountOwnershipRequest
+
+// SimpleBankUpdateBalanceAlertJSONRequestBody defines body for SimpleBankUpdateBalanceAlert for application/json ContentType.
+type SimpleBankUpdateBalanceAlertJSONRequestBody = PbUpdateBalanceAlertRequest
+
+// SimpleBankUpdateTransferMemoJSONRequestBody defines body for SimpleBankUpdateTransferMemo for application/json ContentType.
+type SimpleBankUpdateTransferMemoJSONRequestBody = PbUpdateTransferMemoRequest
+
+// SimpleBankUpdateUserJSONRequestBody defines body for SimpleBankUpdateUser for application/json ContentType.
+type SimpleBankUpdateUserJSONRequestBody = PbUpdateUserRequest
+
+// SimpleBankUploadKYCDocumentJSONRequestBody defines body for SimpleBankUploadKYCDocument for application/json ContentType.
+type SimpleBankUploadKYCDocumentJSONRequestBody = PbUploadKYCDocumentRequest
+
+// SimpleBankUploadUserAvatarJSONRequestBody defines body for SimpleBankUploadUserAvatar for application/json ContentType.
+type SimpleBankUploadUserAvatarJSONRequestBody = PbUploadUserAvatarRequest
+
+// SimpleBankV2UpdateUserJSONRequestBody defines body for SimpleBankV2UpdateUser for application/json ContentType.
+type SimpleBankV2UpdateUserJSONRequestBody = PbV2UpdateUserRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// SimpleBankGetAccountByNumber request
+	SimpleBankGetAccountByNumber(ctx context.Context, params *SimpleBankGetAccountByNumberParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetActivityFeed request
+	SimpleBankGetActivityFeed(ctx context.Context, params *SimpleBankGetActivityFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankAddDisputeNoteWithBody request with any body
+	SimpleBankAddDisputeNoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankAddDisputeNote(ctx context.Context, body SimpleBankAddDisputeNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceAuthorizeCardTransactionWithBody request with any body
+	AdminServiceAuthorizeCardTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceAuthorizeCardTransaction(ctx context.Context, body AdminServiceAuthorizeCardTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListDailyFailedLogins request
+	AdminServiceListDailyFailedLogins(ctx context.Context, params *AdminServiceListDailyFailedLoginsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListDailyNewUsers request
+	AdminServiceListDailyNewUsers(ctx context.Context, params *AdminServiceListDailyNewUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListTopAccounts request
+	AdminServiceListTopAccounts(ctx context.Context, params *AdminServiceListTopAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetTotalDeposits request
+	AdminServiceGetTotalDeposits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListDailyTransferVolume request
+	AdminServiceListDailyTransferVolume(ctx context.Context, params *AdminServiceListDailyTransferVolumeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceDisableUserWithBody request with any body
+	AdminServiceDisableUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceDisableUser(ctx context.Context, body AdminServiceDisableUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListFeatureFlags request
+	AdminServiceListFeatureFlags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceUpdateFeatureFlagWithBody request with any body
+	AdminServiceUpdateFeatureFlagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceUpdateFeatureFlag(ctx context.Context, name string, body AdminServiceUpdateFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceForcePasswordResetWithBody request with any body
+	AdminServiceForcePasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceForcePasswordReset(ctx context.Context, body AdminServiceForcePasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetUser request
+	AdminServiceGetUser(ctx context.Context, params *AdminServiceGetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceCheckLedger request
+	AdminServiceCheckLedger(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceListUsers request
+	AdminServiceListUsers(ctx context.Context, params *AdminServiceListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetMigrationStatus request
+	AdminServiceGetMigrationStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetReconciliationReport request
+	AdminServiceGetReconciliationReport(ctx context.Context, params *AdminServiceGetReconciliationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceRevokeTokenWithBody request with any body
+	AdminServiceRevokeTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceRevokeToken(ctx context.Context, body AdminServiceRevokeTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceSeedDataWithBody request with any body
+	AdminServiceSeedDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdminServiceSeedData(ctx context.Context, body AdminServiceSeedDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetServerInfo request
+	AdminServiceGetServerInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdminServiceGetWorkerStats request
+	AdminServiceGetWorkerStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankApplyForLoanWithBody request with any body
+	SimpleBankApplyForLoanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankApplyForLoan(ctx context.Context, body SimpleBankApplyForLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankApproveLoanWithBody request with any body
+	SimpleBankApproveLoanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankApproveLoan(ctx context.Context, body SimpleBankApproveLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListBalanceAlerts request
+	SimpleBankListBalanceAlerts(ctx context.Context, params *SimpleBankListBalanceAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankBlockSession request
+	SimpleBankBlockSession(ctx context.Context, params *SimpleBankBlockSessionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCancelAccountDeletion request
+	SimpleBankCancelAccountDeletion(ctx context.Context, params *SimpleBankCancelAccountDeletionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCollectPaymentWithBody request with any body
+	SimpleBankCollectPaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankCollectPayment(ctx context.Context, body SimpleBankCollectPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCreateBalanceAlertWithBody request with any body
+	SimpleBankCreateBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankCreateBalanceAlert(ctx context.Context, body SimpleBankCreateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCreateBulkTransferWithBody request with any body
+	SimpleBankCreateBulkTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankCreateBulkTransfer(ctx context.Context, body SimpleBankCreateBulkTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCreateMandateWithBody request with any body
+	SimpleBankCreateMandateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankCreateMandate(ctx context.Context, body SimpleBankCreateMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankCreateUserWithBody request with any body
+	SimpleBankCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankCreateUser(ctx context.Context, body SimpleBankCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListCurrencies request
+	SimpleBankListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankDeleteBalanceAlertWithBody request with any body
+	SimpleBankDeleteBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankDeleteBalanceAlert(ctx context.Context, body SimpleBankDeleteBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankDeleteUserWithBody request with any body
+	SimpleBankDeleteUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankDeleteUser(ctx context.Context, body SimpleBankDeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetDispute request
+	SimpleBankGetDispute(ctx context.Context, params *SimpleBankGetDisputeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListDisputes request
+	SimpleBankListDisputes(ctx context.Context, params *SimpleBankListDisputesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetExportStatus request
+	SimpleBankGetExportStatus(ctx context.Context, params *SimpleBankGetExportStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankExternalPayoutWithBody request with any body
+	SimpleBankExternalPayoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankExternalPayout(ctx context.Context, body SimpleBankExternalPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetFeeSchedule request
+	SimpleBankGetFeeSchedule(ctx context.Context, params *SimpleBankGetFeeScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankFreezeCardWithBody request with any body
+	SimpleBankFreezeCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankFreezeCard(ctx context.Context, body SimpleBankFreezeCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetKYCDocumentFile request
+	SimpleBankGetKYCDocumentFile(ctx context.Context, params *SimpleBankGetKYCDocumentFileParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetTransferQuoteWithBody request with any body
+	SimpleBankGetTransferQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankGetTransferQuote(ctx context.Context, body SimpleBankGetTransferQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankIssueCardWithBody request with any body
+	SimpleBankIssueCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankIssueCard(ctx context.Context, body SimpleBankIssueCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListAccounts request
+	SimpleBankListAccounts(ctx context.Context, params *SimpleBankListAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListCards request
+	SimpleBankListCards(ctx context.Context, params *SimpleBankListCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListEntries request
+	SimpleBankListEntries(ctx context.Context, params *SimpleBankListEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListKYCDocuments request
+	SimpleBankListKYCDocuments(ctx context.Context, params *SimpleBankListKYCDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListPendingKYCDocuments request
+	SimpleBankListPendingKYCDocuments(ctx context.Context, params *SimpleBankListPendingKYCDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListTransfers request
+	SimpleBankListTransfers(ctx context.Context, params *SimpleBankListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankLoginUserWithBody request with any body
+	SimpleBankLoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankLoginUser(ctx context.Context, body SimpleBankLoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetNotificationPreferences request
+	SimpleBankGetNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUpdateNotificationPreferencesWithBody request with any body
+	SimpleBankUpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUpdateNotificationPreferences(ctx context.Context, body SimpleBankUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankExchangeOAuthCode request
+	SimpleBankExchangeOAuthCode(ctx context.Context, provider string, params *SimpleBankExchangeOAuthCodeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetOAuthURL request
+	SimpleBankGetOAuthURL(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankOpenDisputeWithBody request with any body
+	SimpleBankOpenDisputeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankOpenDispute(ctx context.Context, body SimpleBankOpenDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetReferralCode request
+	SimpleBankGetReferralCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankResendVerifyEmailWithBody request with any body
+	SimpleBankResendVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankResendVerifyEmail(ctx context.Context, body SimpleBankResendVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankResolveDisputeWithBody request with any body
+	SimpleBankResolveDisputeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankResolveDispute(ctx context.Context, body SimpleBankResolveDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankReverseTransferWithBody request with any body
+	SimpleBankReverseTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankReverseTransfer(ctx context.Context, body SimpleBankReverseTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankReviewKYCDocumentWithBody request with any body
+	SimpleBankReviewKYCDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankReviewKYCDocument(ctx context.Context, body SimpleBankReviewKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankRevokeMandateWithBody request with any body
+	SimpleBankRevokeMandateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankRevokeMandate(ctx context.Context, body SimpleBankRevokeMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankRevokeSessionWithBody request with any body
+	SimpleBankRevokeSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankRevokeSession(ctx context.Context, body SimpleBankRevokeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankSearchAccounts request
+	SimpleBankSearchAccounts(ctx context.Context, params *SimpleBankSearchAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankSearchUsers request
+	SimpleBankSearchUsers(ctx context.Context, params *SimpleBankSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankListSessions request
+	SimpleBankListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankSetDefaultAccountWithBody request with any body
+	SimpleBankSetDefaultAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankSetDefaultAccount(ctx context.Context, body SimpleBankSetDefaultAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetSpendingSummary request
+	SimpleBankGetSpendingSummary(ctx context.Context, params *SimpleBankGetSpendingSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankStartExportWithBody request with any body
+	SimpleBankStartExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankStartExport(ctx context.Context, body SimpleBankStartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankTransferAccountOwnershipWithBody request with any body
+	SimpleBankTransferAccountOwnershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankTransferAccountOwnership(ctx context.Context, body SimpleBankTransferAccountOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetTransferStatus request
+	SimpleBankGetTransferStatus(ctx context.Context, params *SimpleBankGetTransferStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUpdateBalanceAlertWithBody request with any body
+	SimpleBankUpdateBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUpdateBalanceAlert(ctx context.Context, body SimpleBankUpdateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUpdateTransferMemoWithBody request with any body
+	SimpleBankUpdateTransferMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUpdateTransferMemo(ctx context.Context, body SimpleBankUpdateTransferMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUpdateUserWithBody request with any body
+	SimpleBankUpdateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUpdateUser(ctx context.Context, body SimpleBankUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUploadKYCDocumentWithBody request with any body
+	SimpleBankUploadKYCDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUploadKYCDocument(ctx context.Context, body SimpleBankUploadKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankUploadUserAvatarWithBody request with any body
+	SimpleBankUploadUserAvatarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankUploadUserAvatar(ctx context.Context, body SimpleBankUploadUserAvatarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankVerifyEmail request
+	SimpleBankVerifyEmail(ctx context.Context, params *SimpleBankVerifyEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2ListAccounts request
+	SimpleBankV2ListAccounts(ctx context.Context, params *SimpleBankV2ListAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2GetAccount request
+	SimpleBankV2GetAccount(ctx context.Context, accountId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2ListEntries request
+	SimpleBankV2ListEntries(ctx context.Context, accountId string, params *SimpleBankV2ListEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2ListTransfers request
+	SimpleBankV2ListTransfers(ctx context.Context, accountId string, params *SimpleBankV2ListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2BatchGetAccounts request
+	SimpleBankV2BatchGetAccounts(ctx context.Context, params *SimpleBankV2BatchGetAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankV2UpdateUserWithBody request with any body
+	SimpleBankV2UpdateUserWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SimpleBankV2UpdateUser(ctx context.Context, username string, body SimpleBankV2UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SimpleBankGetVersion request
+	SimpleBankGetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) SimpleBankGetAccountByNumber(ctx context.Context, params *SimpleBankGetAccountByNumberParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetAccountByNumberRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetActivityFeed(ctx context.Context, params *SimpleBankGetActivityFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetActivityFeedRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankAddDisputeNoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankAddDisputeNoteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankAddDisputeNote(ctx context.Context, body SimpleBankAddDisputeNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankAddDisputeNoteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceAuthorizeCardTransactionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceAuthorizeCardTransactionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceAuthorizeCardTransaction(ctx context.Context, body AdminServiceAuthorizeCardTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceAuthorizeCardTransactionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListDailyFailedLogins(ctx context.Context, params *AdminServiceListDailyFailedLoginsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListDailyFailedLoginsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListDailyNewUsers(ctx context.Context, params *AdminServiceListDailyNewUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListDailyNewUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListTopAccounts(ctx context.Context, params *AdminServiceListTopAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListTopAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetTotalDeposits(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetTotalDepositsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListDailyTransferVolume(ctx context.Context, params *AdminServiceListDailyTransferVolumeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListDailyTransferVolumeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceDisableUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceDisableUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceDisableUser(ctx context.Context, body AdminServiceDisableUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceDisableUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListFeatureFlags(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListFeatureFlagsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceUpdateFeatureFlagWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceUpdateFeatureFlagRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceUpdateFeatureFlag(ctx context.Context, name string, body AdminServiceUpdateFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceUpdateFeatureFlagRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceForcePasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceForcePasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceForcePasswordReset(ctx context.Context, body AdminServiceForcePasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceForcePasswordResetRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetUser(ctx context.Context, params *AdminServiceGetUserParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetUserRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceCheckLedger(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceCheckLedgerRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceListUsers(ctx context.Context, params *AdminServiceListUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceListUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetMigrationStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetMigrationStatusRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetReconciliationReport(ctx context.Context, params *AdminServiceGetReconciliationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetReconciliationReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceRevokeTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceRevokeTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceRevokeToken(ctx context.Context, body AdminServiceRevokeTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceRevokeTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceSeedDataWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceSeedDataRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceSeedData(ctx context.Context, body AdminServiceSeedDataJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceSeedDataRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetServerInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetServerInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdminServiceGetWorkerStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdminServiceGetWorkerStatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankApplyForLoanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankApplyForLoanRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankApplyForLoan(ctx context.Context, body SimpleBankApplyForLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankApplyForLoanRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankApproveLoanWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankApproveLoanRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankApproveLoan(ctx context.Context, body SimpleBankApproveLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankApproveLoanRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListBalanceAlerts(ctx context.Context, params *SimpleBankListBalanceAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListBalanceAlertsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankBlockSession(ctx context.Context, params *SimpleBankBlockSessionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankBlockSessionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCancelAccountDeletion(ctx context.Context, params *SimpleBankCancelAccountDeletionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCancelAccountDeletionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCollectPaymentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCollectPaymentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCollectPayment(ctx context.Context, body SimpleBankCollectPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCollectPaymentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateBalanceAlertRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateBalanceAlert(ctx context.Context, body SimpleBankCreateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateBalanceAlertRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateBulkTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateBulkTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateBulkTransfer(ctx context.Context, body SimpleBankCreateBulkTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateBulkTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateMandateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateMandateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateMandate(ctx context.Context, body SimpleBankCreateMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateMandateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankCreateUser(ctx context.Context, body SimpleBankCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankCreateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListCurrencies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListCurrenciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankDeleteBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankDeleteBalanceAlertRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankDeleteBalanceAlert(ctx context.Context, body SimpleBankDeleteBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankDeleteBalanceAlertRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankDeleteUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankDeleteUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankDeleteUser(ctx context.Context, body SimpleBankDeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankDeleteUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetDispute(ctx context.Context, params *SimpleBankGetDisputeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetDisputeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListDisputes(ctx context.Context, params *SimpleBankListDisputesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListDisputesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetExportStatus(ctx context.Context, params *SimpleBankGetExportStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetExportStatusRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankExternalPayoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankExternalPayoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankExternalPayout(ctx context.Context, body SimpleBankExternalPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankExternalPayoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetFeeSchedule(ctx context.Context, params *SimpleBankGetFeeScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetFeeScheduleRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankFreezeCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankFreezeCardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankFreezeCard(ctx context.Context, body SimpleBankFreezeCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankFreezeCardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetKYCDocumentFile(ctx context.Context, params *SimpleBankGetKYCDocumentFileParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetKYCDocumentFileRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetTransferQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetTransferQuoteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetTransferQuote(ctx context.Context, body SimpleBankGetTransferQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetTransferQuoteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankIssueCardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankIssueCardRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankIssueCard(ctx context.Context, body SimpleBankIssueCardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankIssueCardRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListAccounts(ctx context.Context, params *SimpleBankListAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListCards(ctx context.Context, params *SimpleBankListCardsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListCardsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListEntries(ctx context.Context, params *SimpleBankListEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListKYCDocuments(ctx context.Context, params *SimpleBankListKYCDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListKYCDocumentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListPendingKYCDocuments(ctx context.Context, params *SimpleBankListPendingKYCDocumentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListPendingKYCDocumentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListTransfers(ctx context.Context, params *SimpleBankListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListTransfersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankLoginUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankLoginUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankLoginUser(ctx context.Context, body SimpleBankLoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankLoginUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetNotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetNotificationPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateNotificationPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateNotificationPreferences(ctx context.Context, body SimpleBankUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateNotificationPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankExchangeOAuthCode(ctx context.Context, provider string, params *SimpleBankExchangeOAuthCodeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankExchangeOAuthCodeRequest(c.Server, provider, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetOAuthURL(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetOAuthURLRequest(c.Server, provider)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankOpenDisputeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankOpenDisputeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankOpenDispute(ctx context.Context, body SimpleBankOpenDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankOpenDisputeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetReferralCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetReferralCodeRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankResendVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankResendVerifyEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankResendVerifyEmail(ctx context.Context, body SimpleBankResendVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankResendVerifyEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankResolveDisputeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankResolveDisputeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankResolveDispute(ctx context.Context, body SimpleBankResolveDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankResolveDisputeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankReverseTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankReverseTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankReverseTransfer(ctx context.Context, body SimpleBankReverseTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankReverseTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankReviewKYCDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankReviewKYCDocumentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankReviewKYCDocument(ctx context.Context, body SimpleBankReviewKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankReviewKYCDocumentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankRevokeMandateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankRevokeMandateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankRevokeMandate(ctx context.Context, body SimpleBankRevokeMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankRevokeMandateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankRevokeSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankRevokeSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankRevokeSession(ctx context.Context, body SimpleBankRevokeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankRevokeSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankSearchAccounts(ctx context.Context, params *SimpleBankSearchAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankSearchAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankSearchUsers(ctx context.Context, params *SimpleBankSearchUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankSearchUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankListSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankSetDefaultAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankSetDefaultAccountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankSetDefaultAccount(ctx context.Context, body SimpleBankSetDefaultAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankSetDefaultAccountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetSpendingSummary(ctx context.Context, params *SimpleBankGetSpendingSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetSpendingSummaryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankStartExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankStartExportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankStartExport(ctx context.Context, body SimpleBankStartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankStartExportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankTransferAccountOwnershipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankTransferAccountOwnershipRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankTransferAccountOwnership(ctx context.Context, body SimpleBankTransferAccountOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankTransferAccountOwnershipRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetTransferStatus(ctx context.Context, params *SimpleBankGetTransferStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetTransferStatusRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateBalanceAlertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateBalanceAlertRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateBalanceAlert(ctx context.Context, body SimpleBankUpdateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateBalanceAlertRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateTransferMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateTransferMemoRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateTransferMemo(ctx context.Context, body SimpleBankUpdateTransferMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateTransferMemoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUpdateUser(ctx context.Context, body SimpleBankUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUpdateUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUploadKYCDocumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUploadKYCDocumentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUploadKYCDocument(ctx context.Context, body SimpleBankUploadKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUploadKYCDocumentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUploadUserAvatarWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUploadUserAvatarRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankUploadUserAvatar(ctx context.Context, body SimpleBankUploadUserAvatarJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankUploadUserAvatarRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankVerifyEmail(ctx context.Context, params *SimpleBankVerifyEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankVerifyEmailRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2ListAccounts(ctx context.Context, params *SimpleBankV2ListAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2ListAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2GetAccount(ctx context.Context, accountId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2GetAccountRequest(c.Server, accountId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2ListEntries(ctx context.Context, accountId string, params *SimpleBankV2ListEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2ListEntriesRequest(c.Server, accountId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2ListTransfers(ctx context.Context, accountId string, params *SimpleBankV2ListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2ListTransfersRequest(c.Server, accountId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2BatchGetAccounts(ctx context.Context, params *SimpleBankV2BatchGetAccountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2BatchGetAccountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2UpdateUserWithBody(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2UpdateUserRequestWithBody(c.Server, username, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankV2UpdateUser(ctx context.Context, username string, body SimpleBankV2UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankV2UpdateUserRequest(c.Server, username, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SimpleBankGetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSimpleBankGetVersionRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSimpleBankGetAccountByNumberRequest generates requests for SimpleBankGetAccountByNumber
+func NewSimpleBankGetAccountByNumberRequest(server string, params *SimpleBankGetAccountByNumberParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/account_by_number")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Number != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "number", runtime.ParamLocationQuery, *params.Number); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankGetActivityFeedRequest generates requests for SimpleBankGetActivityFeed
+func NewSimpleBankGetActivityFeedRequest(server string, params *SimpleBankGetActivityFeedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/activity_feed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankAddDisputeNoteRequest calls the generic SimpleBankAddDisputeNote builder with application/json body
+func NewSimpleBankAddDisputeNoteRequest(server string, body SimpleBankAddDisputeNoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankAddDisputeNoteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankAddDisputeNoteRequestWithBody generates requests for SimpleBankAddDisputeNote with any type of body
+func NewSimpleBankAddDisputeNoteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/add_dispute_note")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceAuthorizeCardTransactionRequest calls the generic AdminServiceAuthorizeCardTransaction builder with application/json body
+func NewAdminServiceAuthorizeCardTransactionRequest(server string, body AdminServiceAuthorizeCardTransactionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceAuthorizeCardTransactionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminServiceAuthorizeCardTransactionRequestWithBody generates requests for AdminServiceAuthorizeCardTransaction with any type of body
+func NewAdminServiceAuthorizeCardTransactionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/authorize_card_transaction")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceListDailyFailedLoginsRequest generates requests for AdminServiceListDailyFailedLogins
+func NewAdminServiceListDailyFailedLoginsRequest(server string, params *AdminServiceListDailyFailedLoginsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/dashboard/failed_logins")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceListDailyNewUsersRequest generates requests for AdminServiceListDailyNewUsers
+func NewAdminServiceListDailyNewUsersRequest(server string, params *AdminServiceListDailyNewUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/dashboard/new_users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceListTopAccountsRequest generates requests for AdminServiceListTopAccounts
+func NewAdminServiceListTopAccountsRequest(server string, params *AdminServiceListTopAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/dashboard/top_accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceGetTotalDepositsRequest generates requests for AdminServiceGetTotalDeposits
+func NewAdminServiceGetTotalDepositsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/dashboard/total_deposits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceListDailyTransferVolumeRequest generates requests for AdminServiceListDailyTransferVolume
+func NewAdminServiceListDailyTransferVolumeRequest(server string, params *AdminServiceListDailyTransferVolumeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/dashboard/transfer_volume")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StartTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start_time", runtime.ParamLocationQuery, *params.StartTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_time", runtime.ParamLocationQuery, *params.EndTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceDisableUserRequest calls the generic AdminServiceDisableUser builder with application/json body
+func NewAdminServiceDisableUserRequest(server string, body AdminServiceDisableUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceDisableUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminServiceDisableUserRequestWithBody generates requests for AdminServiceDisableUser with any type of body
+func NewAdminServiceDisableUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/disable_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceListFeatureFlagsRequest generates requests for AdminServiceListFeatureFlags
+func NewAdminServiceListFeatureFlagsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/feature_flags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceUpdateFeatureFlagRequest calls the generic AdminServiceUpdateFeatureFlag builder with application/json body
+func NewAdminServiceUpdateFeatureFlagRequest(server string, name string, body AdminServiceUpdateFeatureFlagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceUpdateFeatureFlagRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewAdminServiceUpdateFeatureFlagRequestWithBody generates requests for AdminServiceUpdateFeatureFlag with any type of body
+func NewAdminServiceUpdateFeatureFlagRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/feature_flags/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceForcePasswordResetRequest calls the generic AdminServiceForcePasswordReset builder with application/json body
+func NewAdminServiceForcePasswordResetRequest(server string, body AdminServiceForcePasswordResetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceForcePasswordResetRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminServiceForcePasswordResetRequestWithBody generates requests for AdminServiceForcePasswordReset with any type of body
+func NewAdminServiceForcePasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/force_password_reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceGetUserRequest generates requests for AdminServiceGetUser
+func NewAdminServiceGetUserRequest(server string, params *AdminServiceGetUserParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/get_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceCheckLedgerRequest generates requests for AdminServiceCheckLedger
+func NewAdminServiceCheckLedgerRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/ledger_check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceListUsersRequest generates requests for AdminServiceListUsers
+func NewAdminServiceListUsersRequest(server string, params *AdminServiceListUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/list_users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_id", runtime.ParamLocationQuery, *params.PageId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Search != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "search", runtime.ParamLocationQuery, *params.Search); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceGetMigrationStatusRequest generates requests for AdminServiceGetMigrationStatus
+func NewAdminServiceGetMigrationStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/migration_status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceGetReconciliationReportRequest generates requests for AdminServiceGetReconciliationReport
+func NewAdminServiceGetReconciliationReportRequest(server string, params *AdminServiceGetReconciliationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/reconciliation_report")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ReportId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "report_id", runtime.ParamLocationQuery, *params.ReportId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceRevokeTokenRequest calls the generic AdminServiceRevokeToken builder with application/json body
+func NewAdminServiceRevokeTokenRequest(server string, body AdminServiceRevokeTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceRevokeTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminServiceRevokeTokenRequestWithBody generates requests for AdminServiceRevokeToken with any type of body
+func NewAdminServiceRevokeTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/revoke_token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceSeedDataRequest calls the generic AdminServiceSeedData builder with application/json body
+func NewAdminServiceSeedDataRequest(server string, body AdminServiceSeedDataJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdminServiceSeedDataRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAdminServiceSeedDataRequestWithBody generates requests for AdminServiceSeedData with any type of body
+func NewAdminServiceSeedDataRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/seed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdminServiceGetServerInfoRequest generates requests for AdminServiceGetServerInfo
+func NewAdminServiceGetServerInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/server_info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAdminServiceGetWorkerStatsRequest generates requests for AdminServiceGetWorkerStats
+func NewAdminServiceGetWorkerStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/worker_stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankApplyForLoanRequest calls the generic SimpleBankApplyForLoan builder with application/json body
+func NewSimpleBankApplyForLoanRequest(server string, body SimpleBankApplyForLoanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankApplyForLoanRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankApplyForLoanRequestWithBody generates requests for SimpleBankApplyForLoan with any type of body
+func NewSimpleBankApplyForLoanRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/apply_for_loan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankApproveLoanRequest calls the generic SimpleBankApproveLoan builder with application/json body
+func NewSimpleBankApproveLoanRequest(server string, body SimpleBankApproveLoanJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankApproveLoanRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankApproveLoanRequestWithBody generates requests for SimpleBankApproveLoan with any type of body
+func NewSimpleBankApproveLoanRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/approve_loan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankListBalanceAlertsRequest generates requests for SimpleBankListBalanceAlerts
+func NewSimpleBankListBalanceAlertsRequest(server string, params *SimpleBankListBalanceAlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/balance_alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankBlockSessionRequest generates requests for SimpleBankBlockSession
+func NewSimpleBankBlockSessionRequest(server string, params *SimpleBankBlockSessionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/block_session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SessionId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "session_id", runtime.ParamLocationQuery, *params.SessionId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DeviceId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "device_id", runtime.ParamLocationQuery, *params.DeviceId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Signature != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, *params.Signature); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankCancelAccountDeletionRequest generates requests for SimpleBankCancelAccountDeletion
+func NewSimpleBankCancelAccountDeletionRequest(server string, params *SimpleBankCancelAccountDeletionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/cancel_account_deletion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Signature != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, *params.Signature); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankCollectPaymentRequest calls the generic SimpleBankCollectPayment builder with application/json body
+func NewSimpleBankCollectPaymentRequest(server string, body SimpleBankCollectPaymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankCollectPaymentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankCollectPaymentRequestWithBody generates requests for SimpleBankCollectPayment with any type of body
+func NewSimpleBankCollectPaymentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/collect_payment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankCreateBalanceAlertRequest calls the generic SimpleBankCreateBalanceAlert builder with application/json body
+func NewSimpleBankCreateBalanceAlertRequest(server string, body SimpleBankCreateBalanceAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankCreateBalanceAlertRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankCreateBalanceAlertRequestWithBody generates requests for SimpleBankCreateBalanceAlert with any type of body
+func NewSimpleBankCreateBalanceAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/create_balance_alert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankCreateBulkTransferRequest calls the generic SimpleBankCreateBulkTransfer builder with application/json body
+func NewSimpleBankCreateBulkTransferRequest(server string, body SimpleBankCreateBulkTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankCreateBulkTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankCreateBulkTransferRequestWithBody generates requests for SimpleBankCreateBulkTransfer with any type of body
+func NewSimpleBankCreateBulkTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/create_bulk_transfer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankCreateMandateRequest calls the generic SimpleBankCreateMandate builder with application/json body
+func NewSimpleBankCreateMandateRequest(server string, body SimpleBankCreateMandateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankCreateMandateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankCreateMandateRequestWithBody generates requests for SimpleBankCreateMandate with any type of body
+func NewSimpleBankCreateMandateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/create_mandate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankCreateUserRequest calls the generic SimpleBankCreateUser builder with application/json body
+func NewSimpleBankCreateUserRequest(server string, body SimpleBankCreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankCreateUserRequestWithBody generates requests for SimpleBankCreateUser with any type of body
+func NewSimpleBankCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/create_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankListCurrenciesRequest generates requests for SimpleBankListCurrencies
+func NewSimpleBankListCurrenciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/currencies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankDeleteBalanceAlertRequest calls the generic SimpleBankDeleteBalanceAlert builder with application/json body
+func NewSimpleBankDeleteBalanceAlertRequest(server string, body SimpleBankDeleteBalanceAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankDeleteBalanceAlertRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankDeleteBalanceAlertRequestWithBody generates requests for SimpleBankDeleteBalanceAlert with any type of body
+func NewSimpleBankDeleteBalanceAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/delete_balance_alert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankDeleteUserRequest calls the generic SimpleBankDeleteUser builder with application/json body
+func NewSimpleBankDeleteUserRequest(server string, body SimpleBankDeleteUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankDeleteUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankDeleteUserRequestWithBody generates requests for SimpleBankDeleteUser with any type of body
+func NewSimpleBankDeleteUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/delete_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetDisputeRequest generates requests for SimpleBankGetDispute
+func NewSimpleBankGetDisputeRequest(server string, params *SimpleBankGetDisputeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/dispute")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListDisputesRequest generates requests for SimpleBankListDisputes
+func NewSimpleBankListDisputesRequest(server string, params *SimpleBankListDisputesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/disputes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankGetExportStatusRequest generates requests for SimpleBankGetExportStatus
+func NewSimpleBankGetExportStatusRequest(server string, params *SimpleBankGetExportStatusParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/export_status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExportId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "export_id", runtime.ParamLocationQuery, *params.ExportId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankExternalPayoutRequest calls the generic SimpleBankExternalPayout builder with application/json body
+func NewSimpleBankExternalPayoutRequest(server string, body SimpleBankExternalPayoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankExternalPayoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankExternalPayoutRequestWithBody generates requests for SimpleBankExternalPayout with any type of body
+func NewSimpleBankExternalPayoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/external_payout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetFeeScheduleRequest generates requests for SimpleBankGetFeeSchedule
+func NewSimpleBankGetFeeScheduleRequest(server string, params *SimpleBankGetFeeScheduleParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/fee_schedule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankFreezeCardRequest calls the generic SimpleBankFreezeCard builder with application/json body
+func NewSimpleBankFreezeCardRequest(server string, body SimpleBankFreezeCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankFreezeCardRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankFreezeCardRequestWithBody generates requests for SimpleBankFreezeCard with any type of body
+func NewSimpleBankFreezeCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/freeze_card")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetKYCDocumentFileRequest generates requests for SimpleBankGetKYCDocumentFile
+func NewSimpleBankGetKYCDocumentFileRequest(server string, params *SimpleBankGetKYCDocumentFileParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/get_kyc_document_file")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DocumentId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "document_id", runtime.ParamLocationQuery, *params.DocumentId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankGetTransferQuoteRequest calls the generic SimpleBankGetTransferQuote builder with application/json body
+func NewSimpleBankGetTransferQuoteRequest(server string, body SimpleBankGetTransferQuoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankGetTransferQuoteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankGetTransferQuoteRequestWithBody generates requests for SimpleBankGetTransferQuote with any type of body
+func NewSimpleBankGetTransferQuoteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/get_transfer_quote")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankIssueCardRequest calls the generic SimpleBankIssueCard builder with application/json body
+func NewSimpleBankIssueCardRequest(server string, body SimpleBankIssueCardJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankIssueCardRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankIssueCardRequestWithBody generates requests for SimpleBankIssueCard with any type of body
+func NewSimpleBankIssueCardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/issue_card")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankListAccountsRequest generates requests for SimpleBankListAccounts
+func NewSimpleBankListAccountsRequest(server string, params *SimpleBankListAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListCardsRequest generates requests for SimpleBankListCards
+func NewSimpleBankListCardsRequest(server string, params *SimpleBankListCardsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_cards")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListEntriesRequest generates requests for SimpleBankListEntries
+func NewSimpleBankListEntriesRequest(server string, params *SimpleBankListEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_entries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListKYCDocumentsRequest generates requests for SimpleBankListKYCDocuments
+func NewSimpleBankListKYCDocumentsRequest(server string, params *SimpleBankListKYCDocumentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_kyc_documents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListPendingKYCDocumentsRequest generates requests for SimpleBankListPendingKYCDocuments
+func NewSimpleBankListPendingKYCDocumentsRequest(server string, params *SimpleBankListPendingKYCDocumentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_pending_kyc_documents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_id", runtime.ParamLocationQuery, *params.PageId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListTransfersRequest generates requests for SimpleBankListTransfers
+func NewSimpleBankListTransfersRequest(server string, params *SimpleBankListTransfersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/list_transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankLoginUserRequest calls the generic SimpleBankLoginUser builder with application/json body
+func NewSimpleBankLoginUserRequest(server string, body SimpleBankLoginUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankLoginUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankLoginUserRequestWithBody generates requests for SimpleBankLoginUser with any type of body
+func NewSimpleBankLoginUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/login_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetNotificationPreferencesRequest generates requests for SimpleBankGetNotificationPreferences
+func NewSimpleBankGetNotificationPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notification_preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankUpdateNotificationPreferencesRequest calls the generic SimpleBankUpdateNotificationPreferences builder with application/json body
+func NewSimpleBankUpdateNotificationPreferencesRequest(server string, body SimpleBankUpdateNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUpdateNotificationPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUpdateNotificationPreferencesRequestWithBody generates requests for SimpleBankUpdateNotificationPreferences with any type of body
+func NewSimpleBankUpdateNotificationPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/notification_preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankExchangeOAuthCodeRequest generates requests for SimpleBankExchangeOAuthCode
+func NewSimpleBankExchangeOAuthCodeRequest(server string, provider string, params *SimpleBankExchangeOAuthCodeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/oauth/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankGetOAuthURLRequest generates requests for SimpleBankGetOAuthURL
+func NewSimpleBankGetOAuthURLRequest(server string, provider string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/oauth/%s/login", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankOpenDisputeRequest calls the generic SimpleBankOpenDispute builder with application/json body
+func NewSimpleBankOpenDisputeRequest(server string, body SimpleBankOpenDisputeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankOpenDisputeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankOpenDisputeRequestWithBody generates requests for SimpleBankOpenDispute with any type of body
+func NewSimpleBankOpenDisputeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/open_dispute")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetReferralCodeRequest generates requests for SimpleBankGetReferralCode
+func NewSimpleBankGetReferralCodeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/referral_code")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankResendVerifyEmailRequest calls the generic SimpleBankResendVerifyEmail builder with application/json body
+func NewSimpleBankResendVerifyEmailRequest(server string, body SimpleBankResendVerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankResendVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankResendVerifyEmailRequestWithBody generates requests for SimpleBankResendVerifyEmail with any type of body
+func NewSimpleBankResendVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/resend_verify_email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankResolveDisputeRequest calls the generic SimpleBankResolveDispute builder with application/json body
+func NewSimpleBankResolveDisputeRequest(server string, body SimpleBankResolveDisputeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankResolveDisputeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankResolveDisputeRequestWithBody generates requests for SimpleBankResolveDispute with any type of body
+func NewSimpleBankResolveDisputeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/resolve_dispute")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankReverseTransferRequest calls the generic SimpleBankReverseTransfer builder with application/json body
+func NewSimpleBankReverseTransferRequest(server string, body SimpleBankReverseTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankReverseTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankReverseTransferRequestWithBody generates requests for SimpleBankReverseTransfer with any type of body
+func NewSimpleBankReverseTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/reverse_transfer")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankReviewKYCDocumentRequest calls the generic SimpleBankReviewKYCDocument builder with application/json body
+func NewSimpleBankReviewKYCDocumentRequest(server string, body SimpleBankReviewKYCDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankReviewKYCDocumentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankReviewKYCDocumentRequestWithBody generates requests for SimpleBankReviewKYCDocument with any type of body
+func NewSimpleBankReviewKYCDocumentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/review_kyc_document")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankRevokeMandateRequest calls the generic SimpleBankRevokeMandate builder with application/json body
+func NewSimpleBankRevokeMandateRequest(server string, body SimpleBankRevokeMandateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankRevokeMandateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankRevokeMandateRequestWithBody generates requests for SimpleBankRevokeMandate with any type of body
+func NewSimpleBankRevokeMandateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/revoke_mandate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankRevokeSessionRequest calls the generic SimpleBankRevokeSession builder with application/json body
+func NewSimpleBankRevokeSessionRequest(server string, body SimpleBankRevokeSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankRevokeSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankRevokeSessionRequestWithBody generates requests for SimpleBankRevokeSession with any type of body
+func NewSimpleBankRevokeSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/revoke_session")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankSearchAccountsRequest generates requests for SimpleBankSearchAccounts
+func NewSimpleBankSearchAccountsRequest(server string, params *SimpleBankSearchAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/search_accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_id", runtime.ParamLocationQuery, *params.PageId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankSearchUsersRequest generates requests for SimpleBankSearchUsers
+func NewSimpleBankSearchUsersRequest(server string, params *SimpleBankSearchUsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/search_users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_id", runtime.ParamLocationQuery, *params.PageId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankListSessionsRequest generates requests for SimpleBankListSessions
+func NewSimpleBankListSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankSetDefaultAccountRequest calls the generic SimpleBankSetDefaultAccount builder with application/json body
+func NewSimpleBankSetDefaultAccountRequest(server string, body SimpleBankSetDefaultAccountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankSetDefaultAccountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankSetDefaultAccountRequestWithBody generates requests for SimpleBankSetDefaultAccount with any type of body
+func NewSimpleBankSetDefaultAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/set_default_account")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetSpendingSummaryRequest generates requests for SimpleBankGetSpendingSummary
+func NewSimpleBankGetSpendingSummaryRequest(server string, params *SimpleBankGetSpendingSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/spending_summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_id", runtime.ParamLocationQuery, *params.AccountId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Months != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "months", runtime.ParamLocationQuery, *params.Months); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankStartExportRequest calls the generic SimpleBankStartExport builder with application/json body
+func NewSimpleBankStartExportRequest(server string, body SimpleBankStartExportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankStartExportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankStartExportRequestWithBody generates requests for SimpleBankStartExport with any type of body
+func NewSimpleBankStartExportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/start_export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankTransferAccountOwnershipRequest calls the generic SimpleBankTransferAccountOwnership builder with application/json body
+func NewSimpleBankTransferAccountOwnershipRequest(server string, body SimpleBankTransferAccountOwnershipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankTransferAccountOwnershipRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankTransferAccountOwnershipRequestWithBody generates requests for SimpleBankTransferAccountOwnership with any type of body
+func NewSimpleBankTransferAccountOwnershipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/transfer_account_ownership")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetTransferStatusRequest generates requests for SimpleBankGetTransferStatus
+func NewSimpleBankGetTransferStatusRequest(server string, params *SimpleBankGetTransferStatusParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/transfer_status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TransferId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "transfer_id", runtime.ParamLocationQuery, *params.TransferId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankUpdateBalanceAlertRequest calls the generic SimpleBankUpdateBalanceAlert builder with application/json body
+func NewSimpleBankUpdateBalanceAlertRequest(server string, body SimpleBankUpdateBalanceAlertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUpdateBalanceAlertRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUpdateBalanceAlertRequestWithBody generates requests for SimpleBankUpdateBalanceAlert with any type of body
+func NewSimpleBankUpdateBalanceAlertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/update_balance_alert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankUpdateTransferMemoRequest calls the generic SimpleBankUpdateTransferMemo builder with application/json body
+func NewSimpleBankUpdateTransferMemoRequest(server string, body SimpleBankUpdateTransferMemoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUpdateTransferMemoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUpdateTransferMemoRequestWithBody generates requests for SimpleBankUpdateTransferMemo with any type of body
+func NewSimpleBankUpdateTransferMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/update_transfer_memo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankUpdateUserRequest calls the generic SimpleBankUpdateUser builder with application/json body
+func NewSimpleBankUpdateUserRequest(server string, body SimpleBankUpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUpdateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUpdateUserRequestWithBody generates requests for SimpleBankUpdateUser with any type of body
+func NewSimpleBankUpdateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/update_user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankUploadKYCDocumentRequest calls the generic SimpleBankUploadKYCDocument builder with application/json body
+func NewSimpleBankUploadKYCDocumentRequest(server string, body SimpleBankUploadKYCDocumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUploadKYCDocumentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUploadKYCDocumentRequestWithBody generates requests for SimpleBankUploadKYCDocument with any type of body
+func NewSimpleBankUploadKYCDocumentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/upload_kyc_document")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankUploadUserAvatarRequest calls the generic SimpleBankUploadUserAvatar builder with application/json body
+func NewSimpleBankUploadUserAvatarRequest(server string, body SimpleBankUploadUserAvatarJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankUploadUserAvatarRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSimpleBankUploadUserAvatarRequestWithBody generates requests for SimpleBankUploadUserAvatar with any type of body
+func NewSimpleBankUploadUserAvatarRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/upload_user_avatar")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankVerifyEmailRequest generates requests for SimpleBankVerifyEmail
+func NewSimpleBankVerifyEmailRequest(server string, params *SimpleBankVerifyEmailParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/verify_email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EmailId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email_id", runtime.ParamLocationQuery, *params.EmailId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SecretCode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "secret_code", runtime.ParamLocationQuery, *params.SecretCode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpiresAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expires_at", runtime.ParamLocationQuery, *params.ExpiresAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Signature != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "signature", runtime.ParamLocationQuery, *params.Signature); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2ListAccountsRequest generates requests for SimpleBankV2ListAccounts
+func NewSimpleBankV2ListAccountsRequest(server string, params *SimpleBankV2ListAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2GetAccountRequest generates requests for SimpleBankV2GetAccount
+func NewSimpleBankV2GetAccountRequest(server string, accountId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "account_id", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/accounts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2ListEntriesRequest generates requests for SimpleBankV2ListEntries
+func NewSimpleBankV2ListEntriesRequest(server string, accountId string, params *SimpleBankV2ListEntriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "account_id", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/accounts/%s/entries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2ListTransfersRequest generates requests for SimpleBankV2ListTransfers
+func NewSimpleBankV2ListTransfersRequest(server string, accountId string, params *SimpleBankV2ListTransfersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "account_id", runtime.ParamLocationPath, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/accounts/%s/transfers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_size", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2BatchGetAccountsRequest generates requests for SimpleBankV2BatchGetAccounts
+func NewSimpleBankV2BatchGetAccountsRequest(server string, params *SimpleBankV2BatchGetAccountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/accounts:batchGet")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AccountIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "account_ids", runtime.ParamLocationQuery, *params.AccountIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSimpleBankV2UpdateUserRequest calls the generic SimpleBankV2UpdateUser builder with application/json body
+func NewSimpleBankV2UpdateUserRequest(server string, username string, body SimpleBankV2UpdateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSimpleBankV2UpdateUserRequestWithBody(server, username, "application/json", bodyReader)
+}
+
+// NewSimpleBankV2UpdateUserRequestWithBody generates requests for SimpleBankV2UpdateUser with any type of body
+func NewSimpleBankV2UpdateUserRequestWithBody(server string, username string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "username", runtime.ParamLocationPath, username)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSimpleBankGetVersionRequest generates requests for SimpleBankGetVersion
+func NewSimpleBankGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SimpleBankGetAccountByNumberWithResponse request
+	SimpleBankGetAccountByNumberWithResponse(ctx context.Context, params *SimpleBankGetAccountByNumberParams, reqEditors ...RequestEditorFn) (*SimpleBankGetAccountByNumberResponse, error)
+
+	// SimpleBankGetActivityFeedWithResponse request
+	SimpleBankGetActivityFeedWithResponse(ctx context.Context, params *SimpleBankGetActivityFeedParams, reqEditors ...RequestEditorFn) (*SimpleBankGetActivityFeedResponse, error)
+
+	// SimpleBankAddDisputeNoteWithBodyWithResponse request with any body
+	SimpleBankAddDisputeNoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankAddDisputeNoteResponse, error)
+
+	SimpleBankAddDisputeNoteWithResponse(ctx context.Context, body SimpleBankAddDisputeNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankAddDisputeNoteResponse, error)
+
+	// AdminServiceAuthorizeCardTransactionWithBodyWithResponse request with any body
+	AdminServiceAuthorizeCardTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceAuthorizeCardTransactionResponse, error)
+
+	AdminServiceAuthorizeCardTransactionWithResponse(ctx context.Context, body AdminServiceAuthorizeCardTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceAuthorizeCardTransactionResponse, error)
+
+	// AdminServiceListDailyFailedLoginsWithResponse request
+	AdminServiceListDailyFailedLoginsWithResponse(ctx context.Context, params *AdminServiceListDailyFailedLoginsParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyFailedLoginsResponse, error)
+
+	// AdminServiceListDailyNewUsersWithResponse request
+	AdminServiceListDailyNewUsersWithResponse(ctx context.Context, params *AdminServiceListDailyNewUsersParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyNewUsersResponse, error)
+
+	// AdminServiceListTopAccountsWithResponse request
+	AdminServiceListTopAccountsWithResponse(ctx context.Context, params *AdminServiceListTopAccountsParams, reqEditors ...RequestEditorFn) (*AdminServiceListTopAccountsResponse, error)
+
+	// AdminServiceGetTotalDepositsWithResponse request
+	AdminServiceGetTotalDepositsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetTotalDepositsResponse, error)
+
+	// AdminServiceListDailyTransferVolumeWithResponse request
+	AdminServiceListDailyTransferVolumeWithResponse(ctx context.Context, params *AdminServiceListDailyTransferVolumeParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyTransferVolumeResponse, error)
+
+	// AdminServiceDisableUserWithBodyWithResponse request with any body
+	AdminServiceDisableUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceDisableUserResponse, error)
+
+	AdminServiceDisableUserWithResponse(ctx context.Context, body AdminServiceDisableUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceDisableUserResponse, error)
+
+	// AdminServiceListFeatureFlagsWithResponse request
+	AdminServiceListFeatureFlagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceListFeatureFlagsResponse, error)
+
+	// AdminServiceUpdateFeatureFlagWithBodyWithResponse request with any body
+	AdminServiceUpdateFeatureFlagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceUpdateFeatureFlagResponse, error)
+
+	AdminServiceUpdateFeatureFlagWithResponse(ctx context.Context, name string, body AdminServiceUpdateFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceUpdateFeatureFlagResponse, error)
+
+	// AdminServiceForcePasswordResetWithBodyWithResponse request with any body
+	AdminServiceForcePasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceForcePasswordResetResponse, error)
+
+	AdminServiceForcePasswordResetWithResponse(ctx context.Context, body AdminServiceForcePasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceForcePasswordResetResponse, error)
+
+	// AdminServiceGetUserWithResponse request
+	AdminServiceGetUserWithResponse(ctx context.Context, params *AdminServiceGetUserParams, reqEditors ...RequestEditorFn) (*AdminServiceGetUserResponse, error)
+
+	// AdminServiceCheckLedgerWithResponse request
+	AdminServiceCheckLedgerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceCheckLedgerResponse, error)
+
+	// AdminServiceListUsersWithResponse request
+	AdminServiceListUsersWithResponse(ctx context.Context, params *AdminServiceListUsersParams, reqEditors ...RequestEditorFn) (*AdminServiceListUsersResponse, error)
+
+	// AdminServiceGetMigrationStatusWithResponse request
+	AdminServiceGetMigrationStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetMigrationStatusResponse, error)
+
+	// AdminServiceGetReconciliationReportWithResponse request
+	AdminServiceGetReconciliationReportWithResponse(ctx context.Context, params *AdminServiceGetReconciliationReportParams, reqEditors ...RequestEditorFn) (*AdminServiceGetReconciliationReportResponse, error)
+
+	// AdminServiceRevokeTokenWithBodyWithResponse request with any body
+	AdminServiceRevokeTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceRevokeTokenResponse, error)
+
+	AdminServiceRevokeTokenWithResponse(ctx context.Context, body AdminServiceRevokeTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceRevokeTokenResponse, error)
+
+	// AdminServiceSeedDataWithBodyWithResponse request with any body
+	AdminServiceSeedDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceSeedDataResponse, error)
+
+	AdminServiceSeedDataWithResponse(ctx context.Context, body AdminServiceSeedDataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceSeedDataResponse, error)
+
+	// AdminServiceGetServerInfoWithResponse request
+	AdminServiceGetServerInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetServerInfoResponse, error)
+
+	// AdminServiceGetWorkerStatsWithResponse request
+	AdminServiceGetWorkerStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetWorkerStatsResponse, error)
+
+	// SimpleBankApplyForLoanWithBodyWithResponse request with any body
+	SimpleBankApplyForLoanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankApplyForLoanResponse, error)
+
+	SimpleBankApplyForLoanWithResponse(ctx context.Context, body SimpleBankApplyForLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankApplyForLoanResponse, error)
+
+	// SimpleBankApproveLoanWithBodyWithResponse request with any body
+	SimpleBankApproveLoanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankApproveLoanResponse, error)
+
+	SimpleBankApproveLoanWithResponse(ctx context.Context, body SimpleBankApproveLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankApproveLoanResponse, error)
+
+	// SimpleBankListBalanceAlertsWithResponse request
+	SimpleBankListBalanceAlertsWithResponse(ctx context.Context, params *SimpleBankListBalanceAlertsParams, reqEditors ...RequestEditorFn) (*SimpleBankListBalanceAlertsResponse, error)
+
+	// SimpleBankBlockSessionWithResponse request
+	SimpleBankBlockSessionWithResponse(ctx context.Context, params *SimpleBankBlockSessionParams, reqEditors ...RequestEditorFn) (*SimpleBankBlockSessionResponse, error)
+
+	// SimpleBankCancelAccountDeletionWithResponse request
+	SimpleBankCancelAccountDeletionWithResponse(ctx context.Context, params *SimpleBankCancelAccountDeletionParams, reqEditors ...RequestEditorFn) (*SimpleBankCancelAccountDeletionResponse, error)
+
+	// SimpleBankCollectPaymentWithBodyWithResponse request with any body
+	SimpleBankCollectPaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCollectPaymentResponse, error)
+
+	SimpleBankCollectPaymentWithResponse(ctx context.Context, body SimpleBankCollectPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCollectPaymentResponse, error)
+
+	// SimpleBankCreateBalanceAlertWithBodyWithResponse request with any body
+	SimpleBankCreateBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateBalanceAlertResponse, error)
+
+	SimpleBankCreateBalanceAlertWithResponse(ctx context.Context, body SimpleBankCreateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateBalanceAlertResponse, error)
+
+	// SimpleBankCreateBulkTransferWithBodyWithResponse request with any body
+	SimpleBankCreateBulkTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateBulkTransferResponse, error)
+
+	SimpleBankCreateBulkTransferWithResponse(ctx context.Context, body SimpleBankCreateBulkTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateBulkTransferResponse, error)
+
+	// SimpleBankCreateMandateWithBodyWithResponse request with any body
+	SimpleBankCreateMandateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateMandateResponse, error)
+
+	SimpleBankCreateMandateWithResponse(ctx context.Context, body SimpleBankCreateMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateMandateResponse, error)
+
+	// SimpleBankCreateUserWithBodyWithResponse request with any body
+	SimpleBankCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateUserResponse, error)
+
+	SimpleBankCreateUserWithResponse(ctx context.Context, body SimpleBankCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateUserResponse, error)
+
+	// SimpleBankListCurrenciesWithResponse request
+	SimpleBankListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankListCurrenciesResponse, error)
+
+	// SimpleBankDeleteBalanceAlertWithBodyWithResponse request with any body
+	SimpleBankDeleteBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankDeleteBalanceAlertResponse, error)
+
+	SimpleBankDeleteBalanceAlertWithResponse(ctx context.Context, body SimpleBankDeleteBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankDeleteBalanceAlertResponse, error)
+
+	// SimpleBankDeleteUserWithBodyWithResponse request with any body
+	SimpleBankDeleteUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankDeleteUserResponse, error)
+
+	SimpleBankDeleteUserWithResponse(ctx context.Context, body SimpleBankDeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankDeleteUserResponse, error)
+
+	// SimpleBankGetDisputeWithResponse request
+	SimpleBankGetDisputeWithResponse(ctx context.Context, params *SimpleBankGetDisputeParams, reqEditors ...RequestEditorFn) (*SimpleBankGetDisputeResponse, error)
+
+	// SimpleBankListDisputesWithResponse request
+	SimpleBankListDisputesWithResponse(ctx context.Context, params *SimpleBankListDisputesParams, reqEditors ...RequestEditorFn) (*SimpleBankListDisputesResponse, error)
+
+	// SimpleBankGetExportStatusWithResponse request
+	SimpleBankGetExportStatusWithResponse(ctx context.Context, params *SimpleBankGetExportStatusParams, reqEditors ...RequestEditorFn) (*SimpleBankGetExportStatusResponse, error)
+
+	// SimpleBankExternalPayoutWithBodyWithResponse request with any body
+	SimpleBankExternalPayoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankExternalPayoutResponse, error)
+
+	SimpleBankExternalPayoutWithResponse(ctx context.Context, body SimpleBankExternalPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankExternalPayoutResponse, error)
+
+	// SimpleBankGetFeeScheduleWithResponse request
+	SimpleBankGetFeeScheduleWithResponse(ctx context.Context, params *SimpleBankGetFeeScheduleParams, reqEditors ...RequestEditorFn) (*SimpleBankGetFeeScheduleResponse, error)
+
+	// SimpleBankFreezeCardWithBodyWithResponse request with any body
+	SimpleBankFreezeCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankFreezeCardResponse, error)
+
+	SimpleBankFreezeCardWithResponse(ctx context.Context, body SimpleBankFreezeCardJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankFreezeCardResponse, error)
+
+	// SimpleBankGetKYCDocumentFileWithResponse request
+	SimpleBankGetKYCDocumentFileWithResponse(ctx context.Context, params *SimpleBankGetKYCDocumentFileParams, reqEditors ...RequestEditorFn) (*SimpleBankGetKYCDocumentFileResponse, error)
+
+	// SimpleBankGetTransferQuoteWithBodyWithResponse request with any body
+	SimpleBankGetTransferQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferQuoteResponse, error)
+
+	SimpleBankGetTransferQuoteWithResponse(ctx context.Context, body SimpleBankGetTransferQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferQuoteResponse, error)
+
+	// SimpleBankIssueCardWithBodyWithResponse request with any body
+	SimpleBankIssueCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankIssueCardResponse, error)
+
+	SimpleBankIssueCardWithResponse(ctx context.Context, body SimpleBankIssueCardJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankIssueCardResponse, error)
+
+	// SimpleBankListAccountsWithResponse request
+	SimpleBankListAccountsWithResponse(ctx context.Context, params *SimpleBankListAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankListAccountsResponse, error)
+
+	// SimpleBankListCardsWithResponse request
+	SimpleBankListCardsWithResponse(ctx context.Context, params *SimpleBankListCardsParams, reqEditors ...RequestEditorFn) (*SimpleBankListCardsResponse, error)
+
+	// SimpleBankListEntriesWithResponse request
+	SimpleBankListEntriesWithResponse(ctx context.Context, params *SimpleBankListEntriesParams, reqEditors ...RequestEditorFn) (*SimpleBankListEntriesResponse, error)
+
+	// SimpleBankListKYCDocumentsWithResponse request
+	SimpleBankListKYCDocumentsWithResponse(ctx context.Context, params *SimpleBankListKYCDocumentsParams, reqEditors ...RequestEditorFn) (*SimpleBankListKYCDocumentsResponse, error)
+
+	// SimpleBankListPendingKYCDocumentsWithResponse request
+	SimpleBankListPendingKYCDocumentsWithResponse(ctx context.Context, params *SimpleBankListPendingKYCDocumentsParams, reqEditors ...RequestEditorFn) (*SimpleBankListPendingKYCDocumentsResponse, error)
+
+	// SimpleBankListTransfersWithResponse request
+	SimpleBankListTransfersWithResponse(ctx context.Context, params *SimpleBankListTransfersParams, reqEditors ...RequestEditorFn) (*SimpleBankListTransfersResponse, error)
+
+	// SimpleBankLoginUserWithBodyWithResponse request with any body
+	SimpleBankLoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankLoginUserResponse, error)
+
+	SimpleBankLoginUserWithResponse(ctx context.Context, body SimpleBankLoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankLoginUserResponse, error)
+
+	// SimpleBankGetNotificationPreferencesWithResponse request
+	SimpleBankGetNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetNotificationPreferencesResponse, error)
+
+	// SimpleBankUpdateNotificationPreferencesWithBodyWithResponse request with any body
+	SimpleBankUpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateNotificationPreferencesResponse, error)
+
+	SimpleBankUpdateNotificationPreferencesWithResponse(ctx context.Context, body SimpleBankUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateNotificationPreferencesResponse, error)
+
+	// SimpleBankExchangeOAuthCodeWithResponse request
+	SimpleBankExchangeOAuthCodeWithResponse(ctx context.Context, provider string, params *SimpleBankExchangeOAuthCodeParams, reqEditors ...RequestEditorFn) (*SimpleBankExchangeOAuthCodeResponse, error)
+
+	// SimpleBankGetOAuthURLWithResponse request
+	SimpleBankGetOAuthURLWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*SimpleBankGetOAuthURLResponse, error)
+
+	// SimpleBankOpenDisputeWithBodyWithResponse request with any body
+	SimpleBankOpenDisputeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankOpenDisputeResponse, error)
+
+	SimpleBankOpenDisputeWithResponse(ctx context.Context, body SimpleBankOpenDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankOpenDisputeResponse, error)
+
+	// SimpleBankGetReferralCodeWithResponse request
+	SimpleBankGetReferralCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetReferralCodeResponse, error)
+
+	// SimpleBankResendVerifyEmailWithBodyWithResponse request with any body
+	SimpleBankResendVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankResendVerifyEmailResponse, error)
+
+	SimpleBankResendVerifyEmailWithResponse(ctx context.Context, body SimpleBankResendVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankResendVerifyEmailResponse, error)
+
+	// SimpleBankResolveDisputeWithBodyWithResponse request with any body
+	SimpleBankResolveDisputeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankResolveDisputeResponse, error)
+
+	SimpleBankResolveDisputeWithResponse(ctx context.Context, body SimpleBankResolveDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankResolveDisputeResponse, error)
+
+	// SimpleBankReverseTransferWithBodyWithResponse request with any body
+	SimpleBankReverseTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankReverseTransferResponse, error)
+
+	SimpleBankReverseTransferWithResponse(ctx context.Context, body SimpleBankReverseTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankReverseTransferResponse, error)
+
+	// SimpleBankReviewKYCDocumentWithBodyWithResponse request with any body
+	SimpleBankReviewKYCDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankReviewKYCDocumentResponse, error)
+
+	SimpleBankReviewKYCDocumentWithResponse(ctx context.Context, body SimpleBankReviewKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankReviewKYCDocumentResponse, error)
+
+	// SimpleBankRevokeMandateWithBodyWithResponse request with any body
+	SimpleBankRevokeMandateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankRevokeMandateResponse, error)
+
+	SimpleBankRevokeMandateWithResponse(ctx context.Context, body SimpleBankRevokeMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankRevokeMandateResponse, error)
+
+	// SimpleBankRevokeSessionWithBodyWithResponse request with any body
+	SimpleBankRevokeSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankRevokeSessionResponse, error)
+
+	SimpleBankRevokeSessionWithResponse(ctx context.Context, body SimpleBankRevokeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankRevokeSessionResponse, error)
+
+	// SimpleBankSearchAccountsWithResponse request
+	SimpleBankSearchAccountsWithResponse(ctx context.Context, params *SimpleBankSearchAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankSearchAccountsResponse, error)
+
+	// SimpleBankSearchUsersWithResponse request
+	SimpleBankSearchUsersWithResponse(ctx context.Context, params *SimpleBankSearchUsersParams, reqEditors ...RequestEditorFn) (*SimpleBankSearchUsersResponse, error)
+
+	// SimpleBankListSessionsWithResponse request
+	SimpleBankListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankListSessionsResponse, error)
+
+	// SimpleBankSetDefaultAccountWithBodyWithResponse request with any body
+	SimpleBankSetDefaultAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankSetDefaultAccountResponse, error)
+
+	SimpleBankSetDefaultAccountWithResponse(ctx context.Context, body SimpleBankSetDefaultAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankSetDefaultAccountResponse, error)
+
+	// SimpleBankGetSpendingSummaryWithResponse request
+	SimpleBankGetSpendingSummaryWithResponse(ctx context.Context, params *SimpleBankGetSpendingSummaryParams, reqEditors ...RequestEditorFn) (*SimpleBankGetSpendingSummaryResponse, error)
+
+	// SimpleBankStartExportWithBodyWithResponse request with any body
+	SimpleBankStartExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankStartExportResponse, error)
+
+	SimpleBankStartExportWithResponse(ctx context.Context, body SimpleBankStartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankStartExportResponse, error)
+
+	// SimpleBankTransferAccountOwnershipWithBodyWithResponse request with any body
+	SimpleBankTransferAccountOwnershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankTransferAccountOwnershipResponse, error)
+
+	SimpleBankTransferAccountOwnershipWithResponse(ctx context.Context, body SimpleBankTransferAccountOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankTransferAccountOwnershipResponse, error)
+
+	// SimpleBankGetTransferStatusWithResponse request
+	SimpleBankGetTransferStatusWithResponse(ctx context.Context, params *SimpleBankGetTransferStatusParams, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferStatusResponse, error)
+
+	// SimpleBankUpdateBalanceAlertWithBodyWithResponse request with any body
+	SimpleBankUpdateBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateBalanceAlertResponse, error)
+
+	SimpleBankUpdateBalanceAlertWithResponse(ctx context.Context, body SimpleBankUpdateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateBalanceAlertResponse, error)
+
+	// SimpleBankUpdateTransferMemoWithBodyWithResponse request with any body
+	SimpleBankUpdateTransferMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateTransferMemoResponse, error)
+
+	SimpleBankUpdateTransferMemoWithResponse(ctx context.Context, body SimpleBankUpdateTransferMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateTransferMemoResponse, error)
+
+	// SimpleBankUpdateUserWithBodyWithResponse request with any body
+	SimpleBankUpdateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateUserResponse, error)
+
+	SimpleBankUpdateUserWithResponse(ctx context.Context, body SimpleBankUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateUserResponse, error)
+
+	// SimpleBankUploadKYCDocumentWithBodyWithResponse request with any body
+	SimpleBankUploadKYCDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUploadKYCDocumentResponse, error)
+
+	SimpleBankUploadKYCDocumentWithResponse(ctx context.Context, body SimpleBankUploadKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUploadKYCDocumentResponse, error)
+
+	// SimpleBankUploadUserAvatarWithBodyWithResponse request with any body
+	SimpleBankUploadUserAvatarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUploadUserAvatarResponse, error)
+
+	SimpleBankUploadUserAvatarWithResponse(ctx context.Context, body SimpleBankUploadUserAvatarJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUploadUserAvatarResponse, error)
+
+	// SimpleBankVerifyEmailWithResponse request
+	SimpleBankVerifyEmailWithResponse(ctx context.Context, params *SimpleBankVerifyEmailParams, reqEditors ...RequestEditorFn) (*SimpleBankVerifyEmailResponse, error)
+
+	// SimpleBankV2ListAccountsWithResponse request
+	SimpleBankV2ListAccountsWithResponse(ctx context.Context, params *SimpleBankV2ListAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListAccountsResponse, error)
+
+	// SimpleBankV2GetAccountWithResponse request
+	SimpleBankV2GetAccountWithResponse(ctx context.Context, accountId string, reqEditors ...RequestEditorFn) (*SimpleBankV2GetAccountResponse, error)
+
+	// SimpleBankV2ListEntriesWithResponse request
+	SimpleBankV2ListEntriesWithResponse(ctx context.Context, accountId string, params *SimpleBankV2ListEntriesParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListEntriesResponse, error)
+
+	// SimpleBankV2ListTransfersWithResponse request
+	SimpleBankV2ListTransfersWithResponse(ctx context.Context, accountId string, params *SimpleBankV2ListTransfersParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListTransfersResponse, error)
+
+	// SimpleBankV2BatchGetAccountsWithResponse request
+	SimpleBankV2BatchGetAccountsWithResponse(ctx context.Context, params *SimpleBankV2BatchGetAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankV2BatchGetAccountsResponse, error)
+
+	// SimpleBankV2UpdateUserWithBodyWithResponse request with any body
+	SimpleBankV2UpdateUserWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankV2UpdateUserResponse, error)
+
+	SimpleBankV2UpdateUserWithResponse(ctx context.Context, username string, body SimpleBankV2UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankV2UpdateUserResponse, error)
+
+	// SimpleBankGetVersionWithResponse request
+	SimpleBankGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetVersionResponse, error)
+}
+
+type SimpleBankGetAccountByNumberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetAccountByNumberResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetAccountByNumberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetAccountByNumberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetActivityFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetActivityFeedResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetActivityFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetActivityFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankAddDisputeNoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbAddDisputeNoteResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankAddDisputeNoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankAddDisputeNoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceAuthorizeCardTransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbAuthorizeCardTransactionResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceAuthorizeCardTransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceAuthorizeCardTransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListDailyFailedLoginsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListDailyFailedLoginsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListDailyFailedLoginsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListDailyFailedLoginsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListDailyNewUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListDailyNewUsersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListDailyNewUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListDailyNewUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListTopAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListTopAccountsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListTopAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListTopAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetTotalDepositsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetTotalDepositsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetTotalDepositsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetTotalDepositsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListDailyTransferVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListDailyTransferVolumeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListDailyTransferVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListDailyTransferVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceDisableUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbDisableUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceDisableUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceDisableUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListFeatureFlagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListFeatureFlagsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListFeatureFlagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListFeatureFlagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceUpdateFeatureFlagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUpdateFeatureFlagResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceUpdateFeatureFlagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceUpdateFeatureFlagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceForcePasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbForcePasswordResetResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceForcePasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceForcePasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceCheckLedgerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCheckLedgerResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceCheckLedgerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceCheckLedgerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceListUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListUsersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceListUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceListUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetMigrationStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetMigrationStatusResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetMigrationStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetMigrationStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetReconciliationReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetReconciliationReportResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetReconciliationReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetReconciliationReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceRevokeTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbRevokeTokenResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceRevokeTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceRevokeTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceSeedDataResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbSeedDataResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceSeedDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceSeedDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetServerInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetServerInfoResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetServerInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetServerInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdminServiceGetWorkerStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetWorkerStatsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AdminServiceGetWorkerStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdminServiceGetWorkerStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankApplyForLoanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbApplyForLoanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankApplyForLoanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankApplyForLoanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankApproveLoanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbApproveLoanResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankApproveLoanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankApproveLoanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListBalanceAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListBalanceAlertsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListBalanceAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListBalanceAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankBlockSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbBlockSessionResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankBlockSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankBlockSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCancelAccountDeletionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCancelAccountDeletionResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCancelAccountDeletionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCancelAccountDeletionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCollectPaymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCollectPaymentResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCollectPaymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCollectPaymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCreateBalanceAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCreateBalanceAlertResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCreateBalanceAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCreateBalanceAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCreateBulkTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCreateBulkTransferResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCreateBulkTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCreateBulkTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCreateMandateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCreateMandateResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCreateMandateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCreateMandateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankCreateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbCreateUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankCreateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankCreateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListCurrenciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListCurrenciesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListCurrenciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListCurrenciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankDeleteBalanceAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbDeleteBalanceAlertResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankDeleteBalanceAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankDeleteBalanceAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankDeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbDeleteUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankDeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankDeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetDisputeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetDisputeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetDisputeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetDisputeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListDisputesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListDisputesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListDisputesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListDisputesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetExportStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetExportStatusResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetExportStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetExportStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankExternalPayoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbExternalPayoutResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankExternalPayoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankExternalPayoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetFeeScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetFeeScheduleResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetFeeScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetFeeScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankFreezeCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbFreezeCardResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankFreezeCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankFreezeCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetKYCDocumentFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetKYCDocumentFileResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetKYCDocumentFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetKYCDocumentFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetTransferQuoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetTransferQuoteResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetTransferQuoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetTransferQuoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankIssueCardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbIssueCardResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankIssueCardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankIssueCardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListAccountsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListCardsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListCardsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListCardsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListCardsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListEntriesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListKYCDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListKYCDocumentsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListKYCDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListKYCDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListPendingKYCDocumentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListPendingKYCDocumentsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListPendingKYCDocumentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListPendingKYCDocumentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListTransfersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankLoginUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbLoginUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankLoginUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankLoginUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetNotificationPreferencesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUpdateNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUpdateNotificationPreferencesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUpdateNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUpdateNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankExchangeOAuthCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbExchangeOAuthCodeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankExchangeOAuthCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankExchangeOAuthCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetOAuthURLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetOAuthURLResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetOAuthURLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetOAuthURLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankOpenDisputeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbOpenDisputeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankOpenDisputeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankOpenDisputeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetReferralCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetReferralCodeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetReferralCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetReferralCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankResendVerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbResendVerifyEmailResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankResendVerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankResendVerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankResolveDisputeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbResolveDisputeResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankResolveDisputeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankResolveDisputeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankReverseTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbReverseTransferResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankReverseTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankReverseTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankReviewKYCDocumentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbReviewKYCDocumentResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankReviewKYCDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankReviewKYCDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankRevokeMandateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbRevokeMandateResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankRevokeMandateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankRevokeMandateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankRevokeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbRevokeSessionResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankRevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankRevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankSearchAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbSearchAccountsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankSearchAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankSearchAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankSearchUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbSearchUsersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankSearchUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankSearchUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbListSessionsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankSetDefaultAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbSetDefaultAccountResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankSetDefaultAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankSetDefaultAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetSpendingSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetSpendingSummaryResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetSpendingSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetSpendingSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankStartExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbStartExportResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankStartExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankStartExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankTransferAccountOwnershipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbTransferAccountOwnershipResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankTransferAccountOwnershipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankTransferAccountOwnershipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetTransferStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetTransferStatusResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetTransferStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetTransferStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUpdateBalanceAlertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUpdateBalanceAlertResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUpdateBalanceAlertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUpdateBalanceAlertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUpdateTransferMemoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUpdateTransferMemoResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUpdateTransferMemoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUpdateTransferMemoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUpdateUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUploadKYCDocumentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUploadKYCDocumentResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUploadKYCDocumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUploadKYCDocumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankUploadUserAvatarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbUploadUserAvatarResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankUploadUserAvatarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankUploadUserAvatarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankVerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbVerifyEmailResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankVerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankVerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2ListAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2ListAccountsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2ListAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2ListAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2GetAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2GetAccountResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2GetAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2GetAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2ListEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2ListEntriesResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2ListEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2ListEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2ListTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2ListTransfersResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2ListTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2ListTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2BatchGetAccountsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2BatchGetAccountsResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2BatchGetAccountsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2BatchGetAccountsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankV2UpdateUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbV2UpdateUserResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankV2UpdateUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankV2UpdateUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SimpleBankGetVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PbGetVersionResponse
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SimpleBankGetVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SimpleBankGetVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SimpleBankGetAccountByNumberWithResponse request returning *SimpleBankGetAccountByNumberResponse
+func (c *ClientWithResponses) SimpleBankGetAccountByNumberWithResponse(ctx context.Context, params *SimpleBankGetAccountByNumberParams, reqEditors ...RequestEditorFn) (*SimpleBankGetAccountByNumberResponse, error) {
+	rsp, err := c.SimpleBankGetAccountByNumber(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetAccountByNumberResponse(rsp)
+}
+
+// SimpleBankGetActivityFeedWithResponse request returning *SimpleBankGetActivityFeedResponse
+func (c *ClientWithResponses) SimpleBankGetActivityFeedWithResponse(ctx context.Context, params *SimpleBankGetActivityFeedParams, reqEditors ...RequestEditorFn) (*SimpleBankGetActivityFeedResponse, error) {
+	rsp, err := c.SimpleBankGetActivityFeed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetActivityFeedResponse(rsp)
+}
+
+// SimpleBankAddDisputeNoteWithBodyWithResponse request with arbitrary body returning *SimpleBankAddDisputeNoteResponse
+func (c *ClientWithResponses) SimpleBankAddDisputeNoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankAddDisputeNoteResponse, error) {
+	rsp, err := c.SimpleBankAddDisputeNoteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankAddDisputeNoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankAddDisputeNoteWithResponse(ctx context.Context, body SimpleBankAddDisputeNoteJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankAddDisputeNoteResponse, error) {
+	rsp, err := c.SimpleBankAddDisputeNote(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankAddDisputeNoteResponse(rsp)
+}
+
+// AdminServiceAuthorizeCardTransactionWithBodyWithResponse request with arbitrary body returning *AdminServiceAuthorizeCardTransactionResponse
+func (c *ClientWithResponses) AdminServiceAuthorizeCardTransactionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceAuthorizeCardTransactionResponse, error) {
+	rsp, err := c.AdminServiceAuthorizeCardTransactionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceAuthorizeCardTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceAuthorizeCardTransactionWithResponse(ctx context.Context, body AdminServiceAuthorizeCardTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceAuthorizeCardTransactionResponse, error) {
+	rsp, err := c.AdminServiceAuthorizeCardTransaction(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceAuthorizeCardTransactionResponse(rsp)
+}
+
+// AdminServiceListDailyFailedLoginsWithResponse request returning *AdminServiceListDailyFailedLoginsResponse
+func (c *ClientWithResponses) AdminServiceListDailyFailedLoginsWithResponse(ctx context.Context, params *AdminServiceListDailyFailedLoginsParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyFailedLoginsResponse, error) {
+	rsp, err := c.AdminServiceListDailyFailedLogins(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListDailyFailedLoginsResponse(rsp)
+}
+
+// AdminServiceListDailyNewUsersWithResponse request returning *AdminServiceListDailyNewUsersResponse
+func (c *ClientWithResponses) AdminServiceListDailyNewUsersWithResponse(ctx context.Context, params *AdminServiceListDailyNewUsersParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyNewUsersResponse, error) {
+	rsp, err := c.AdminServiceListDailyNewUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListDailyNewUsersResponse(rsp)
+}
+
+// AdminServiceListTopAccountsWithResponse request returning *AdminServiceListTopAccountsResponse
+func (c *ClientWithResponses) AdminServiceListTopAccountsWithResponse(ctx context.Context, params *AdminServiceListTopAccountsParams, reqEditors ...RequestEditorFn) (*AdminServiceListTopAccountsResponse, error) {
+	rsp, err := c.AdminServiceListTopAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListTopAccountsResponse(rsp)
+}
+
+// AdminServiceGetTotalDepositsWithResponse request returning *AdminServiceGetTotalDepositsResponse
+func (c *ClientWithResponses) AdminServiceGetTotalDepositsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetTotalDepositsResponse, error) {
+	rsp, err := c.AdminServiceGetTotalDeposits(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetTotalDepositsResponse(rsp)
+}
+
+// AdminServiceListDailyTransferVolumeWithResponse request returning *AdminServiceListDailyTransferVolumeResponse
+func (c *ClientWithResponses) AdminServiceListDailyTransferVolumeWithResponse(ctx context.Context, params *AdminServiceListDailyTransferVolumeParams, reqEditors ...RequestEditorFn) (*AdminServiceListDailyTransferVolumeResponse, error) {
+	rsp, err := c.AdminServiceListDailyTransferVolume(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListDailyTransferVolumeResponse(rsp)
+}
+
+// AdminServiceDisableUserWithBodyWithResponse request with arbitrary body returning *AdminServiceDisableUserResponse
+func (c *ClientWithResponses) AdminServiceDisableUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceDisableUserResponse, error) {
+	rsp, err := c.AdminServiceDisableUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceDisableUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceDisableUserWithResponse(ctx context.Context, body AdminServiceDisableUserJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceDisableUserResponse, error) {
+	rsp, err := c.AdminServiceDisableUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceDisableUserResponse(rsp)
+}
+
+// AdminServiceListFeatureFlagsWithResponse request returning *AdminServiceListFeatureFlagsResponse
+func (c *ClientWithResponses) AdminServiceListFeatureFlagsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceListFeatureFlagsResponse, error) {
+	rsp, err := c.AdminServiceListFeatureFlags(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListFeatureFlagsResponse(rsp)
+}
+
+// AdminServiceUpdateFeatureFlagWithBodyWithResponse request with arbitrary body returning *AdminServiceUpdateFeatureFlagResponse
+func (c *ClientWithResponses) AdminServiceUpdateFeatureFlagWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceUpdateFeatureFlagResponse, error) {
+	rsp, err := c.AdminServiceUpdateFeatureFlagWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceUpdateFeatureFlagResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceUpdateFeatureFlagWithResponse(ctx context.Context, name string, body AdminServiceUpdateFeatureFlagJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceUpdateFeatureFlagResponse, error) {
+	rsp, err := c.AdminServiceUpdateFeatureFlag(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceUpdateFeatureFlagResponse(rsp)
+}
+
+// AdminServiceForcePasswordResetWithBodyWithResponse request with arbitrary body returning *AdminServiceForcePasswordResetResponse
+func (c *ClientWithResponses) AdminServiceForcePasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceForcePasswordResetResponse, error) {
+	rsp, err := c.AdminServiceForcePasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceForcePasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceForcePasswordResetWithResponse(ctx context.Context, body AdminServiceForcePasswordResetJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceForcePasswordResetResponse, error) {
+	rsp, err := c.AdminServiceForcePasswordReset(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceForcePasswordResetResponse(rsp)
+}
+
+// AdminServiceGetUserWithResponse request returning *AdminServiceGetUserResponse
+func (c *ClientWithResponses) AdminServiceGetUserWithResponse(ctx context.Context, params *AdminServiceGetUserParams, reqEditors ...RequestEditorFn) (*AdminServiceGetUserResponse, error) {
+	rsp, err := c.AdminServiceGetUser(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetUserResponse(rsp)
+}
+
+// AdminServiceCheckLedgerWithResponse request returning *AdminServiceCheckLedgerResponse
+func (c *ClientWithResponses) AdminServiceCheckLedgerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceCheckLedgerResponse, error) {
+	rsp, err := c.AdminServiceCheckLedger(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceCheckLedgerResponse(rsp)
+}
+
+// AdminServiceListUsersWithResponse request returning *AdminServiceListUsersResponse
+func (c *ClientWithResponses) AdminServiceListUsersWithResponse(ctx context.Context, params *AdminServiceListUsersParams, reqEditors ...RequestEditorFn) (*AdminServiceListUsersResponse, error) {
+	rsp, err := c.AdminServiceListUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceListUsersResponse(rsp)
+}
+
+// AdminServiceGetMigrationStatusWithResponse request returning *AdminServiceGetMigrationStatusResponse
+func (c *ClientWithResponses) AdminServiceGetMigrationStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetMigrationStatusResponse, error) {
+	rsp, err := c.AdminServiceGetMigrationStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetMigrationStatusResponse(rsp)
+}
+
+// AdminServiceGetReconciliationReportWithResponse request returning *AdminServiceGetReconciliationReportResponse
+func (c *ClientWithResponses) AdminServiceGetReconciliationReportWithResponse(ctx context.Context, params *AdminServiceGetReconciliationReportParams, reqEditors ...RequestEditorFn) (*AdminServiceGetReconciliationReportResponse, error) {
+	rsp, err := c.AdminServiceGetReconciliationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetReconciliationReportResponse(rsp)
+}
+
+// AdminServiceRevokeTokenWithBodyWithResponse request with arbitrary body returning *AdminServiceRevokeTokenResponse
+func (c *ClientWithResponses) AdminServiceRevokeTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceRevokeTokenResponse, error) {
+	rsp, err := c.AdminServiceRevokeTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceRevokeTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceRevokeTokenWithResponse(ctx context.Context, body AdminServiceRevokeTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceRevokeTokenResponse, error) {
+	rsp, err := c.AdminServiceRevokeToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceRevokeTokenResponse(rsp)
+}
+
+// AdminServiceSeedDataWithBodyWithResponse request with arbitrary body returning *AdminServiceSeedDataResponse
+func (c *ClientWithResponses) AdminServiceSeedDataWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdminServiceSeedDataResponse, error) {
+	rsp, err := c.AdminServiceSeedDataWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceSeedDataResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdminServiceSeedDataWithResponse(ctx context.Context, body AdminServiceSeedDataJSONRequestBody, reqEditors ...RequestEditorFn) (*AdminServiceSeedDataResponse, error) {
+	rsp, err := c.AdminServiceSeedData(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceSeedDataResponse(rsp)
+}
+
+// AdminServiceGetServerInfoWithResponse request returning *AdminServiceGetServerInfoResponse
+func (c *ClientWithResponses) AdminServiceGetServerInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetServerInfoResponse, error) {
+	rsp, err := c.AdminServiceGetServerInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetServerInfoResponse(rsp)
+}
+
+// AdminServiceGetWorkerStatsWithResponse request returning *AdminServiceGetWorkerStatsResponse
+func (c *ClientWithResponses) AdminServiceGetWorkerStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AdminServiceGetWorkerStatsResponse, error) {
+	rsp, err := c.AdminServiceGetWorkerStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdminServiceGetWorkerStatsResponse(rsp)
+}
+
+// SimpleBankApplyForLoanWithBodyWithResponse request with arbitrary body returning *SimpleBankApplyForLoanResponse
+func (c *ClientWithResponses) SimpleBankApplyForLoanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankApplyForLoanResponse, error) {
+	rsp, err := c.SimpleBankApplyForLoanWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankApplyForLoanResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankApplyForLoanWithResponse(ctx context.Context, body SimpleBankApplyForLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankApplyForLoanResponse, error) {
+	rsp, err := c.SimpleBankApplyForLoan(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankApplyForLoanResponse(rsp)
+}
+
+// SimpleBankApproveLoanWithBodyWithResponse request with arbitrary body returning *SimpleBankApproveLoanResponse
+func (c *ClientWithResponses) SimpleBankApproveLoanWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankApproveLoanResponse, error) {
+	rsp, err := c.SimpleBankApproveLoanWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankApproveLoanResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankApproveLoanWithResponse(ctx context.Context, body SimpleBankApproveLoanJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankApproveLoanResponse, error) {
+	rsp, err := c.SimpleBankApproveLoan(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankApproveLoanResponse(rsp)
+}
+
+// SimpleBankListBalanceAlertsWithResponse request returning *SimpleBankListBalanceAlertsResponse
+func (c *ClientWithResponses) SimpleBankListBalanceAlertsWithResponse(ctx context.Context, params *SimpleBankListBalanceAlertsParams, reqEditors ...RequestEditorFn) (*SimpleBankListBalanceAlertsResponse, error) {
+	rsp, err := c.SimpleBankListBalanceAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListBalanceAlertsResponse(rsp)
+}
+
+// SimpleBankBlockSessionWithResponse request returning *SimpleBankBlockSessionResponse
+func (c *ClientWithResponses) SimpleBankBlockSessionWithResponse(ctx context.Context, params *SimpleBankBlockSessionParams, reqEditors ...RequestEditorFn) (*SimpleBankBlockSessionResponse, error) {
+	rsp, err := c.SimpleBankBlockSession(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankBlockSessionResponse(rsp)
+}
+
+// SimpleBankCancelAccountDeletionWithResponse request returning *SimpleBankCancelAccountDeletionResponse
+func (c *ClientWithResponses) SimpleBankCancelAccountDeletionWithResponse(ctx context.Context, params *SimpleBankCancelAccountDeletionParams, reqEditors ...RequestEditorFn) (*SimpleBankCancelAccountDeletionResponse, error) {
+	rsp, err := c.SimpleBankCancelAccountDeletion(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCancelAccountDeletionResponse(rsp)
+}
+
+// SimpleBankCollectPaymentWithBodyWithResponse request with arbitrary body returning *SimpleBankCollectPaymentResponse
+func (c *ClientWithResponses) SimpleBankCollectPaymentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCollectPaymentResponse, error) {
+	rsp, err := c.SimpleBankCollectPaymentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCollectPaymentResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankCollectPaymentWithResponse(ctx context.Context, body SimpleBankCollectPaymentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCollectPaymentResponse, error) {
+	rsp, err := c.SimpleBankCollectPayment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCollectPaymentResponse(rsp)
+}
+
+// SimpleBankCreateBalanceAlertWithBodyWithResponse request with arbitrary body returning *SimpleBankCreateBalanceAlertResponse
+func (c *ClientWithResponses) SimpleBankCreateBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankCreateBalanceAlertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateBalanceAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankCreateBalanceAlertWithResponse(ctx context.Context, body SimpleBankCreateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankCreateBalanceAlert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateBalanceAlertResponse(rsp)
+}
+
+// SimpleBankCreateBulkTransferWithBodyWithResponse request with arbitrary body returning *SimpleBankCreateBulkTransferResponse
+func (c *ClientWithResponses) SimpleBankCreateBulkTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateBulkTransferResponse, error) {
+	rsp, err := c.SimpleBankCreateBulkTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateBulkTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankCreateBulkTransferWithResponse(ctx context.Context, body SimpleBankCreateBulkTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateBulkTransferResponse, error) {
+	rsp, err := c.SimpleBankCreateBulkTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateBulkTransferResponse(rsp)
+}
+
+// SimpleBankCreateMandateWithBodyWithResponse request with arbitrary body returning *SimpleBankCreateMandateResponse
+func (c *ClientWithResponses) SimpleBankCreateMandateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateMandateResponse, error) {
+	rsp, err := c.SimpleBankCreateMandateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateMandateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankCreateMandateWithResponse(ctx context.Context, body SimpleBankCreateMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateMandateResponse, error) {
+	rsp, err := c.SimpleBankCreateMandate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateMandateResponse(rsp)
+}
+
+// SimpleBankCreateUserWithBodyWithResponse request with arbitrary body returning *SimpleBankCreateUserResponse
+func (c *ClientWithResponses) SimpleBankCreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankCreateUserResponse, error) {
+	rsp, err := c.SimpleBankCreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankCreateUserWithResponse(ctx context.Context, body SimpleBankCreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankCreateUserResponse, error) {
+	rsp, err := c.SimpleBankCreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankCreateUserResponse(rsp)
+}
+
+// SimpleBankListCurrenciesWithResponse request returning *SimpleBankListCurrenciesResponse
+func (c *ClientWithResponses) SimpleBankListCurrenciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankListCurrenciesResponse, error) {
+	rsp, err := c.SimpleBankListCurrencies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListCurrenciesResponse(rsp)
+}
+
+// SimpleBankDeleteBalanceAlertWithBodyWithResponse request with arbitrary body returning *SimpleBankDeleteBalanceAlertResponse
+func (c *ClientWithResponses) SimpleBankDeleteBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankDeleteBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankDeleteBalanceAlertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankDeleteBalanceAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankDeleteBalanceAlertWithResponse(ctx context.Context, body SimpleBankDeleteBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankDeleteBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankDeleteBalanceAlert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankDeleteBalanceAlertResponse(rsp)
+}
+
+// SimpleBankDeleteUserWithBodyWithResponse request with arbitrary body returning *SimpleBankDeleteUserResponse
+func (c *ClientWithResponses) SimpleBankDeleteUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankDeleteUserResponse, error) {
+	rsp, err := c.SimpleBankDeleteUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankDeleteUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankDeleteUserWithResponse(ctx context.Context, body SimpleBankDeleteUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankDeleteUserResponse, error) {
+	rsp, err := c.SimpleBankDeleteUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankDeleteUserResponse(rsp)
+}
+
+// SimpleBankGetDisputeWithResponse request returning *SimpleBankGetDisputeResponse
+func (c *ClientWithResponses) SimpleBankGetDisputeWithResponse(ctx context.Context, params *SimpleBankGetDisputeParams, reqEditors ...RequestEditorFn) (*SimpleBankGetDisputeResponse, error) {
+	rsp, err := c.SimpleBankGetDispute(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetDisputeResponse(rsp)
+}
+
+// SimpleBankListDisputesWithResponse request returning *SimpleBankListDisputesResponse
+func (c *ClientWithResponses) SimpleBankListDisputesWithResponse(ctx context.Context, params *SimpleBankListDisputesParams, reqEditors ...RequestEditorFn) (*SimpleBankListDisputesResponse, error) {
+	rsp, err := c.SimpleBankListDisputes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListDisputesResponse(rsp)
+}
+
+// SimpleBankGetExportStatusWithResponse request returning *SimpleBankGetExportStatusResponse
+func (c *ClientWithResponses) SimpleBankGetExportStatusWithResponse(ctx context.Context, params *SimpleBankGetExportStatusParams, reqEditors ...RequestEditorFn) (*SimpleBankGetExportStatusResponse, error) {
+	rsp, err := c.SimpleBankGetExportStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetExportStatusResponse(rsp)
+}
+
+// SimpleBankExternalPayoutWithBodyWithResponse request with arbitrary body returning *SimpleBankExternalPayoutResponse
+func (c *ClientWithResponses) SimpleBankExternalPayoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankExternalPayoutResponse, error) {
+	rsp, err := c.SimpleBankExternalPayoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankExternalPayoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankExternalPayoutWithResponse(ctx context.Context, body SimpleBankExternalPayoutJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankExternalPayoutResponse, error) {
+	rsp, err := c.SimpleBankExternalPayout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankExternalPayoutResponse(rsp)
+}
+
+// SimpleBankGetFeeScheduleWithResponse request returning *SimpleBankGetFeeScheduleResponse
+func (c *ClientWithResponses) SimpleBankGetFeeScheduleWithResponse(ctx context.Context, params *SimpleBankGetFeeScheduleParams, reqEditors ...RequestEditorFn) (*SimpleBankGetFeeScheduleResponse, error) {
+	rsp, err := c.SimpleBankGetFeeSchedule(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetFeeScheduleResponse(rsp)
+}
+
+// SimpleBankFreezeCardWithBodyWithResponse request with arbitrary body returning *SimpleBankFreezeCardResponse
+func (c *ClientWithResponses) SimpleBankFreezeCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankFreezeCardResponse, error) {
+	rsp, err := c.SimpleBankFreezeCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankFreezeCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankFreezeCardWithResponse(ctx context.Context, body SimpleBankFreezeCardJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankFreezeCardResponse, error) {
+	rsp, err := c.SimpleBankFreezeCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankFreezeCardResponse(rsp)
+}
+
+// SimpleBankGetKYCDocumentFileWithResponse request returning *SimpleBankGetKYCDocumentFileResponse
+func (c *ClientWithResponses) SimpleBankGetKYCDocumentFileWithResponse(ctx context.Context, params *SimpleBankGetKYCDocumentFileParams, reqEditors ...RequestEditorFn) (*SimpleBankGetKYCDocumentFileResponse, error) {
+	rsp, err := c.SimpleBankGetKYCDocumentFile(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetKYCDocumentFileResponse(rsp)
+}
+
+// SimpleBankGetTransferQuoteWithBodyWithResponse request with arbitrary body returning *SimpleBankGetTransferQuoteResponse
+func (c *ClientWithResponses) SimpleBankGetTransferQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferQuoteResponse, error) {
+	rsp, err := c.SimpleBankGetTransferQuoteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetTransferQuoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankGetTransferQuoteWithResponse(ctx context.Context, body SimpleBankGetTransferQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferQuoteResponse, error) {
+	rsp, err := c.SimpleBankGetTransferQuote(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetTransferQuoteResponse(rsp)
+}
+
+// SimpleBankIssueCardWithBodyWithResponse request with arbitrary body returning *SimpleBankIssueCardResponse
+func (c *ClientWithResponses) SimpleBankIssueCardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankIssueCardResponse, error) {
+	rsp, err := c.SimpleBankIssueCardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankIssueCardResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankIssueCardWithResponse(ctx context.Context, body SimpleBankIssueCardJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankIssueCardResponse, error) {
+	rsp, err := c.SimpleBankIssueCard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankIssueCardResponse(rsp)
+}
+
+// SimpleBankListAccountsWithResponse request returning *SimpleBankListAccountsResponse
+func (c *ClientWithResponses) SimpleBankListAccountsWithResponse(ctx context.Context, params *SimpleBankListAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankListAccountsResponse, error) {
+	rsp, err := c.SimpleBankListAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListAccountsResponse(rsp)
+}
+
+// SimpleBankListCardsWithResponse request returning *SimpleBankListCardsResponse
+func (c *ClientWithResponses) SimpleBankListCardsWithResponse(ctx context.Context, params *SimpleBankListCardsParams, reqEditors ...RequestEditorFn) (*SimpleBankListCardsResponse, error) {
+	rsp, err := c.SimpleBankListCards(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListCardsResponse(rsp)
+}
+
+// SimpleBankListEntriesWithResponse request returning *SimpleBankListEntriesResponse
+func (c *ClientWithResponses) SimpleBankListEntriesWithResponse(ctx context.Context, params *SimpleBankListEntriesParams, reqEditors ...RequestEditorFn) (*SimpleBankListEntriesResponse, error) {
+	rsp, err := c.SimpleBankListEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListEntriesResponse(rsp)
+}
+
+// SimpleBankListKYCDocumentsWithResponse request returning *SimpleBankListKYCDocumentsResponse
+func (c *ClientWithResponses) SimpleBankListKYCDocumentsWithResponse(ctx context.Context, params *SimpleBankListKYCDocumentsParams, reqEditors ...RequestEditorFn) (*SimpleBankListKYCDocumentsResponse, error) {
+	rsp, err := c.SimpleBankListKYCDocuments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListKYCDocumentsResponse(rsp)
+}
+
+// SimpleBankListPendingKYCDocumentsWithResponse request returning *SimpleBankListPendingKYCDocumentsResponse
+func (c *ClientWithResponses) SimpleBankListPendingKYCDocumentsWithResponse(ctx context.Context, params *SimpleBankListPendingKYCDocumentsParams, reqEditors ...RequestEditorFn) (*SimpleBankListPendingKYCDocumentsResponse, error) {
+	rsp, err := c.SimpleBankListPendingKYCDocuments(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListPendingKYCDocumentsResponse(rsp)
+}
+
+// SimpleBankListTransfersWithResponse request returning *SimpleBankListTransfersResponse
+func (c *ClientWithResponses) SimpleBankListTransfersWithResponse(ctx context.Context, params *SimpleBankListTransfersParams, reqEditors ...RequestEditorFn) (*SimpleBankListTransfersResponse, error) {
+	rsp, err := c.SimpleBankListTransfers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListTransfersResponse(rsp)
+}
+
+// SimpleBankLoginUserWithBodyWithResponse request with arbitrary body returning *SimpleBankLoginUserResponse
+func (c *ClientWithResponses) SimpleBankLoginUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankLoginUserResponse, error) {
+	rsp, err := c.SimpleBankLoginUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankLoginUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankLoginUserWithResponse(ctx context.Context, body SimpleBankLoginUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankLoginUserResponse, error) {
+	rsp, err := c.SimpleBankLoginUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankLoginUserResponse(rsp)
+}
+
+// SimpleBankGetNotificationPreferencesWithResponse request returning *SimpleBankGetNotificationPreferencesResponse
+func (c *ClientWithResponses) SimpleBankGetNotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetNotificationPreferencesResponse, error) {
+	rsp, err := c.SimpleBankGetNotificationPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetNotificationPreferencesResponse(rsp)
+}
+
+// SimpleBankUpdateNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *SimpleBankUpdateNotificationPreferencesResponse
+func (c *ClientWithResponses) SimpleBankUpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.SimpleBankUpdateNotificationPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUpdateNotificationPreferencesWithResponse(ctx context.Context, body SimpleBankUpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.SimpleBankUpdateNotificationPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateNotificationPreferencesResponse(rsp)
+}
+
+// SimpleBankExchangeOAuthCodeWithResponse request returning *SimpleBankExchangeOAuthCodeResponse
+func (c *ClientWithResponses) SimpleBankExchangeOAuthCodeWithResponse(ctx context.Context, provider string, params *SimpleBankExchangeOAuthCodeParams, reqEditors ...RequestEditorFn) (*SimpleBankExchangeOAuthCodeResponse, error) {
+	rsp, err := c.SimpleBankExchangeOAuthCode(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankExchangeOAuthCodeResponse(rsp)
+}
+
+// SimpleBankGetOAuthURLWithResponse request returning *SimpleBankGetOAuthURLResponse
+func (c *ClientWithResponses) SimpleBankGetOAuthURLWithResponse(ctx context.Context, provider string, reqEditors ...RequestEditorFn) (*SimpleBankGetOAuthURLResponse, error) {
+	rsp, err := c.SimpleBankGetOAuthURL(ctx, provider, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetOAuthURLResponse(rsp)
+}
+
+// SimpleBankOpenDisputeWithBodyWithResponse request with arbitrary body returning *SimpleBankOpenDisputeResponse
+func (c *ClientWithResponses) SimpleBankOpenDisputeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankOpenDisputeResponse, error) {
+	rsp, err := c.SimpleBankOpenDisputeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankOpenDisputeResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankOpenDisputeWithResponse(ctx context.Context, body SimpleBankOpenDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankOpenDisputeResponse, error) {
+	rsp, err := c.SimpleBankOpenDispute(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankOpenDisputeResponse(rsp)
+}
+
+// SimpleBankGetReferralCodeWithResponse request returning *SimpleBankGetReferralCodeResponse
+func (c *ClientWithResponses) SimpleBankGetReferralCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetReferralCodeResponse, error) {
+	rsp, err := c.SimpleBankGetReferralCode(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetReferralCodeResponse(rsp)
+}
+
+// SimpleBankResendVerifyEmailWithBodyWithResponse request with arbitrary body returning *SimpleBankResendVerifyEmailResponse
+func (c *ClientWithResponses) SimpleBankResendVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankResendVerifyEmailResponse, error) {
+	rsp, err := c.SimpleBankResendVerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankResendVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankResendVerifyEmailWithResponse(ctx context.Context, body SimpleBankResendVerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankResendVerifyEmailResponse, error) {
+	rsp, err := c.SimpleBankResendVerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankResendVerifyEmailResponse(rsp)
+}
+
+// SimpleBankResolveDisputeWithBodyWithResponse request with arbitrary body returning *SimpleBankResolveDisputeResponse
+func (c *ClientWithResponses) SimpleBankResolveDisputeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankResolveDisputeResponse, error) {
+	rsp, err := c.SimpleBankResolveDisputeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankResolveDisputeResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankResolveDisputeWithResponse(ctx context.Context, body SimpleBankResolveDisputeJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankResolveDisputeResponse, error) {
+	rsp, err := c.SimpleBankResolveDispute(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankResolveDisputeResponse(rsp)
+}
+
+// SimpleBankReverseTransferWithBodyWithResponse request with arbitrary body returning *SimpleBankReverseTransferResponse
+func (c *ClientWithResponses) SimpleBankReverseTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankReverseTransferResponse, error) {
+	rsp, err := c.SimpleBankReverseTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankReverseTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankReverseTransferWithResponse(ctx context.Context, body SimpleBankReverseTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankReverseTransferResponse, error) {
+	rsp, err := c.SimpleBankReverseTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankReverseTransferResponse(rsp)
+}
+
+// SimpleBankReviewKYCDocumentWithBodyWithResponse request with arbitrary body returning *SimpleBankReviewKYCDocumentResponse
+func (c *ClientWithResponses) SimpleBankReviewKYCDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankReviewKYCDocumentResponse, error) {
+	rsp, err := c.SimpleBankReviewKYCDocumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankReviewKYCDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankReviewKYCDocumentWithResponse(ctx context.Context, body SimpleBankReviewKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankReviewKYCDocumentResponse, error) {
+	rsp, err := c.SimpleBankReviewKYCDocument(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankReviewKYCDocumentResponse(rsp)
+}
+
+// SimpleBankRevokeMandateWithBodyWithResponse request with arbitrary body returning *SimpleBankRevokeMandateResponse
+func (c *ClientWithResponses) SimpleBankRevokeMandateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankRevokeMandateResponse, error) {
+	rsp, err := c.SimpleBankRevokeMandateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankRevokeMandateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankRevokeMandateWithResponse(ctx context.Context, body SimpleBankRevokeMandateJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankRevokeMandateResponse, error) {
+	rsp, err := c.SimpleBankRevokeMandate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankRevokeMandateResponse(rsp)
+}
+
+// SimpleBankRevokeSessionWithBodyWithResponse request with arbitrary body returning *SimpleBankRevokeSessionResponse
+func (c *ClientWithResponses) SimpleBankRevokeSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankRevokeSessionResponse, error) {
+	rsp, err := c.SimpleBankRevokeSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankRevokeSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankRevokeSessionWithResponse(ctx context.Context, body SimpleBankRevokeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankRevokeSessionResponse, error) {
+	rsp, err := c.SimpleBankRevokeSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankRevokeSessionResponse(rsp)
+}
+
+// SimpleBankSearchAccountsWithResponse request returning *SimpleBankSearchAccountsResponse
+func (c *ClientWithResponses) SimpleBankSearchAccountsWithResponse(ctx context.Context, params *SimpleBankSearchAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankSearchAccountsResponse, error) {
+	rsp, err := c.SimpleBankSearchAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankSearchAccountsResponse(rsp)
+}
+
+// SimpleBankSearchUsersWithResponse request returning *SimpleBankSearchUsersResponse
+func (c *ClientWithResponses) SimpleBankSearchUsersWithResponse(ctx context.Context, params *SimpleBankSearchUsersParams, reqEditors ...RequestEditorFn) (*SimpleBankSearchUsersResponse, error) {
+	rsp, err := c.SimpleBankSearchUsers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankSearchUsersResponse(rsp)
+}
+
+// SimpleBankListSessionsWithResponse request returning *SimpleBankListSessionsResponse
+func (c *ClientWithResponses) SimpleBankListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankListSessionsResponse, error) {
+	rsp, err := c.SimpleBankListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankListSessionsResponse(rsp)
+}
+
+// SimpleBankSetDefaultAccountWithBodyWithResponse request with arbitrary body returning *SimpleBankSetDefaultAccountResponse
+func (c *ClientWithResponses) SimpleBankSetDefaultAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankSetDefaultAccountResponse, error) {
+	rsp, err := c.SimpleBankSetDefaultAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankSetDefaultAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankSetDefaultAccountWithResponse(ctx context.Context, body SimpleBankSetDefaultAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankSetDefaultAccountResponse, error) {
+	rsp, err := c.SimpleBankSetDefaultAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankSetDefaultAccountResponse(rsp)
+}
+
+// SimpleBankGetSpendingSummaryWithResponse request returning *SimpleBankGetSpendingSummaryResponse
+func (c *ClientWithResponses) SimpleBankGetSpendingSummaryWithResponse(ctx context.Context, params *SimpleBankGetSpendingSummaryParams, reqEditors ...RequestEditorFn) (*SimpleBankGetSpendingSummaryResponse, error) {
+	rsp, err := c.SimpleBankGetSpendingSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetSpendingSummaryResponse(rsp)
+}
+
+// SimpleBankStartExportWithBodyWithResponse request with arbitrary body returning *SimpleBankStartExportResponse
+func (c *ClientWithResponses) SimpleBankStartExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankStartExportResponse, error) {
+	rsp, err := c.SimpleBankStartExportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankStartExportResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankStartExportWithResponse(ctx context.Context, body SimpleBankStartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankStartExportResponse, error) {
+	rsp, err := c.SimpleBankStartExport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankStartExportResponse(rsp)
+}
+
+// SimpleBankTransferAccountOwnershipWithBodyWithResponse request with arbitrary body returning *SimpleBankTransferAccountOwnershipResponse
+func (c *ClientWithResponses) SimpleBankTransferAccountOwnershipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankTransferAccountOwnershipResponse, error) {
+	rsp, err := c.SimpleBankTransferAccountOwnershipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankTransferAccountOwnershipResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankTransferAccountOwnershipWithResponse(ctx context.Context, body SimpleBankTransferAccountOwnershipJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankTransferAccountOwnershipResponse, error) {
+	rsp, err := c.SimpleBankTransferAccountOwnership(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankTransferAccountOwnershipResponse(rsp)
+}
+
+// SimpleBankGetTransferStatusWithResponse request returning *SimpleBankGetTransferStatusResponse
+func (c *ClientWithResponses) SimpleBankGetTransferStatusWithResponse(ctx context.Context, params *SimpleBankGetTransferStatusParams, reqEditors ...RequestEditorFn) (*SimpleBankGetTransferStatusResponse, error) {
+	rsp, err := c.SimpleBankGetTransferStatus(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetTransferStatusResponse(rsp)
+}
+
+// SimpleBankUpdateBalanceAlertWithBodyWithResponse request with arbitrary body returning *SimpleBankUpdateBalanceAlertResponse
+func (c *ClientWithResponses) SimpleBankUpdateBalanceAlertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankUpdateBalanceAlertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateBalanceAlertResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUpdateBalanceAlertWithResponse(ctx context.Context, body SimpleBankUpdateBalanceAlertJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateBalanceAlertResponse, error) {
+	rsp, err := c.SimpleBankUpdateBalanceAlert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateBalanceAlertResponse(rsp)
+}
+
+// SimpleBankUpdateTransferMemoWithBodyWithResponse request with arbitrary body returning *SimpleBankUpdateTransferMemoResponse
+func (c *ClientWithResponses) SimpleBankUpdateTransferMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateTransferMemoResponse, error) {
+	rsp, err := c.SimpleBankUpdateTransferMemoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateTransferMemoResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUpdateTransferMemoWithResponse(ctx context.Context, body SimpleBankUpdateTransferMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateTransferMemoResponse, error) {
+	rsp, err := c.SimpleBankUpdateTransferMemo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateTransferMemoResponse(rsp)
+}
+
+// SimpleBankUpdateUserWithBodyWithResponse request with arbitrary body returning *SimpleBankUpdateUserResponse
+func (c *ClientWithResponses) SimpleBankUpdateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUpdateUserResponse, error) {
+	rsp, err := c.SimpleBankUpdateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUpdateUserWithResponse(ctx context.Context, body SimpleBankUpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUpdateUserResponse, error) {
+	rsp, err := c.SimpleBankUpdateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUpdateUserResponse(rsp)
+}
+
+// SimpleBankUploadKYCDocumentWithBodyWithResponse request with arbitrary body returning *SimpleBankUploadKYCDocumentResponse
+func (c *ClientWithResponses) SimpleBankUploadKYCDocumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUploadKYCDocumentResponse, error) {
+	rsp, err := c.SimpleBankUploadKYCDocumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUploadKYCDocumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUploadKYCDocumentWithResponse(ctx context.Context, body SimpleBankUploadKYCDocumentJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUploadKYCDocumentResponse, error) {
+	rsp, err := c.SimpleBankUploadKYCDocument(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUploadKYCDocumentResponse(rsp)
+}
+
+// SimpleBankUploadUserAvatarWithBodyWithResponse request with arbitrary body returning *SimpleBankUploadUserAvatarResponse
+func (c *ClientWithResponses) SimpleBankUploadUserAvatarWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankUploadUserAvatarResponse, error) {
+	rsp, err := c.SimpleBankUploadUserAvatarWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUploadUserAvatarResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankUploadUserAvatarWithResponse(ctx context.Context, body SimpleBankUploadUserAvatarJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankUploadUserAvatarResponse, error) {
+	rsp, err := c.SimpleBankUploadUserAvatar(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankUploadUserAvatarResponse(rsp)
+}
+
+// SimpleBankVerifyEmailWithResponse request returning *SimpleBankVerifyEmailResponse
+func (c *ClientWithResponses) SimpleBankVerifyEmailWithResponse(ctx context.Context, params *SimpleBankVerifyEmailParams, reqEditors ...RequestEditorFn) (*SimpleBankVerifyEmailResponse, error) {
+	rsp, err := c.SimpleBankVerifyEmail(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankVerifyEmailResponse(rsp)
+}
+
+// SimpleBankV2ListAccountsWithResponse request returning *SimpleBankV2ListAccountsResponse
+func (c *ClientWithResponses) SimpleBankV2ListAccountsWithResponse(ctx context.Context, params *SimpleBankV2ListAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListAccountsResponse, error) {
+	rsp, err := c.SimpleBankV2ListAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2ListAccountsResponse(rsp)
+}
+
+// SimpleBankV2GetAccountWithResponse request returning *SimpleBankV2GetAccountResponse
+func (c *ClientWithResponses) SimpleBankV2GetAccountWithResponse(ctx context.Context, accountId string, reqEditors ...RequestEditorFn) (*SimpleBankV2GetAccountResponse, error) {
+	rsp, err := c.SimpleBankV2GetAccount(ctx, accountId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2GetAccountResponse(rsp)
+}
+
+// SimpleBankV2ListEntriesWithResponse request returning *SimpleBankV2ListEntriesResponse
+func (c *ClientWithResponses) SimpleBankV2ListEntriesWithResponse(ctx context.Context, accountId string, params *SimpleBankV2ListEntriesParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListEntriesResponse, error) {
+	rsp, err := c.SimpleBankV2ListEntries(ctx, accountId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2ListEntriesResponse(rsp)
+}
+
+// SimpleBankV2ListTransfersWithResponse request returning *SimpleBankV2ListTransfersResponse
+func (c *ClientWithResponses) SimpleBankV2ListTransfersWithResponse(ctx context.Context, accountId string, params *SimpleBankV2ListTransfersParams, reqEditors ...RequestEditorFn) (*SimpleBankV2ListTransfersResponse, error) {
+	rsp, err := c.SimpleBankV2ListTransfers(ctx, accountId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2ListTransfersResponse(rsp)
+}
+
+// SimpleBankV2BatchGetAccountsWithResponse request returning *SimpleBankV2BatchGetAccountsResponse
+func (c *ClientWithResponses) SimpleBankV2BatchGetAccountsWithResponse(ctx context.Context, params *SimpleBankV2BatchGetAccountsParams, reqEditors ...RequestEditorFn) (*SimpleBankV2BatchGetAccountsResponse, error) {
+	rsp, err := c.SimpleBankV2BatchGetAccounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2BatchGetAccountsResponse(rsp)
+}
+
+// SimpleBankV2UpdateUserWithBodyWithResponse request with arbitrary body returning *SimpleBankV2UpdateUserResponse
+func (c *ClientWithResponses) SimpleBankV2UpdateUserWithBodyWithResponse(ctx context.Context, username string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SimpleBankV2UpdateUserResponse, error) {
+	rsp, err := c.SimpleBankV2UpdateUserWithBody(ctx, username, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2UpdateUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) SimpleBankV2UpdateUserWithResponse(ctx context.Context, username string, body SimpleBankV2UpdateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*SimpleBankV2UpdateUserResponse, error) {
+	rsp, err := c.SimpleBankV2UpdateUser(ctx, username, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankV2UpdateUserResponse(rsp)
+}
+
+// SimpleBankGetVersionWithResponse request returning *SimpleBankGetVersionResponse
+func (c *ClientWithResponses) SimpleBankGetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SimpleBankGetVersionResponse, error) {
+	rsp, err := c.SimpleBankGetVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSimpleBankGetVersionResponse(rsp)
+}
+
+// ParseSimpleBankGetAccountByNumberResponse parses an HTTP response from a SimpleBankGetAccountByNumberWithResponse call
+func ParseSimpleBankGetAccountByNumberResponse(rsp *http.Response) (*SimpleBankGetAccountByNumberResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetAccountByNumberResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetAccountByNumberResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetActivityFeedResponse parses an HTTP response from a SimpleBankGetActivityFeedWithResponse call
+func ParseSimpleBankGetActivityFeedResponse(rsp *http.Response) (*SimpleBankGetActivityFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetActivityFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetActivityFeedResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankAddDisputeNoteResponse parses an HTTP response from a SimpleBankAddDisputeNoteWithResponse call
+func ParseSimpleBankAddDisputeNoteResponse(rsp *http.Response) (*SimpleBankAddDisputeNoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankAddDisputeNoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbAddDisputeNoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceAuthorizeCardTransactionResponse parses an HTTP response from a AdminServiceAuthorizeCardTransactionWithResponse call
+func ParseAdminServiceAuthorizeCardTransactionResponse(rsp *http.Response) (*AdminServiceAuthorizeCardTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceAuthorizeCardTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbAuthorizeCardTransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListDailyFailedLoginsResponse parses an HTTP response from a AdminServiceListDailyFailedLoginsWithResponse call
+func ParseAdminServiceListDailyFailedLoginsResponse(rsp *http.Response) (*AdminServiceListDailyFailedLoginsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListDailyFailedLoginsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListDailyFailedLoginsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListDailyNewUsersResponse parses an HTTP response from a AdminServiceListDailyNewUsersWithResponse call
+func ParseAdminServiceListDailyNewUsersResponse(rsp *http.Response) (*AdminServiceListDailyNewUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListDailyNewUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListDailyNewUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListTopAccountsResponse parses an HTTP response from a AdminServiceListTopAccountsWithResponse call
+func ParseAdminServiceListTopAccountsResponse(rsp *http.Response) (*AdminServiceListTopAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListTopAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListTopAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetTotalDepositsResponse parses an HTTP response from a AdminServiceGetTotalDepositsWithResponse call
+func ParseAdminServiceGetTotalDepositsResponse(rsp *http.Response) (*AdminServiceGetTotalDepositsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetTotalDepositsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetTotalDepositsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListDailyTransferVolumeResponse parses an HTTP response from a AdminServiceListDailyTransferVolumeWithResponse call
+func ParseAdminServiceListDailyTransferVolumeResponse(rsp *http.Response) (*AdminServiceListDailyTransferVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListDailyTransferVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListDailyTransferVolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceDisableUserResponse parses an HTTP response from a AdminServiceDisableUserWithResponse call
+func ParseAdminServiceDisableUserResponse(rsp *http.Response) (*AdminServiceDisableUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceDisableUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbDisableUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListFeatureFlagsResponse parses an HTTP response from a AdminServiceListFeatureFlagsWithResponse call
+func ParseAdminServiceListFeatureFlagsResponse(rsp *http.Response) (*AdminServiceListFeatureFlagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListFeatureFlagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListFeatureFlagsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceUpdateFeatureFlagResponse parses an HTTP response from a AdminServiceUpdateFeatureFlagWithResponse call
+func ParseAdminServiceUpdateFeatureFlagResponse(rsp *http.Response) (*AdminServiceUpdateFeatureFlagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceUpdateFeatureFlagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUpdateFeatureFlagResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceForcePasswordResetResponse parses an HTTP response from a AdminServiceForcePasswordResetWithResponse call
+func ParseAdminServiceForcePasswordResetResponse(rsp *http.Response) (*AdminServiceForcePasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceForcePasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbForcePasswordResetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetUserResponse parses an HTTP response from a AdminServiceGetUserWithResponse call
+func ParseAdminServiceGetUserResponse(rsp *http.Response) (*AdminServiceGetUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceCheckLedgerResponse parses an HTTP response from a AdminServiceCheckLedgerWithResponse call
+func ParseAdminServiceCheckLedgerResponse(rsp *http.Response) (*AdminServiceCheckLedgerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceCheckLedgerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCheckLedgerResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceListUsersResponse parses an HTTP response from a AdminServiceListUsersWithResponse call
+func ParseAdminServiceListUsersResponse(rsp *http.Response) (*AdminServiceListUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceListUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetMigrationStatusResponse parses an HTTP response from a AdminServiceGetMigrationStatusWithResponse call
+func ParseAdminServiceGetMigrationStatusResponse(rsp *http.Response) (*AdminServiceGetMigrationStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetMigrationStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetMigrationStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetReconciliationReportResponse parses an HTTP response from a AdminServiceGetReconciliationReportWithResponse call
+func ParseAdminServiceGetReconciliationReportResponse(rsp *http.Response) (*AdminServiceGetReconciliationReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetReconciliationReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetReconciliationReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceRevokeTokenResponse parses an HTTP response from a AdminServiceRevokeTokenWithResponse call
+func ParseAdminServiceRevokeTokenResponse(rsp *http.Response) (*AdminServiceRevokeTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceRevokeTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbRevokeTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceSeedDataResponse parses an HTTP response from a AdminServiceSeedDataWithResponse call
+func ParseAdminServiceSeedDataResponse(rsp *http.Response) (*AdminServiceSeedDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceSeedDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbSeedDataResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetServerInfoResponse parses an HTTP response from a AdminServiceGetServerInfoWithResponse call
+func ParseAdminServiceGetServerInfoResponse(rsp *http.Response) (*AdminServiceGetServerInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetServerInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetServerInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAdminServiceGetWorkerStatsResponse parses an HTTP response from a AdminServiceGetWorkerStatsWithResponse call
+func ParseAdminServiceGetWorkerStatsResponse(rsp *http.Response) (*AdminServiceGetWorkerStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AdminServiceGetWorkerStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetWorkerStatsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankApplyForLoanResponse parses an HTTP response from a SimpleBankApplyForLoanWithResponse call
+func ParseSimpleBankApplyForLoanResponse(rsp *http.Response) (*SimpleBankApplyForLoanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankApplyForLoanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbApplyForLoanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankApproveLoanResponse parses an HTTP response from a SimpleBankApproveLoanWithResponse call
+func ParseSimpleBankApproveLoanResponse(rsp *http.Response) (*SimpleBankApproveLoanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankApproveLoanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbApproveLoanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListBalanceAlertsResponse parses an HTTP response from a SimpleBankListBalanceAlertsWithResponse call
+func ParseSimpleBankListBalanceAlertsResponse(rsp *http.Response) (*SimpleBankListBalanceAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListBalanceAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListBalanceAlertsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankBlockSessionResponse parses an HTTP response from a SimpleBankBlockSessionWithResponse call
+func ParseSimpleBankBlockSessionResponse(rsp *http.Response) (*SimpleBankBlockSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankBlockSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbBlockSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCancelAccountDeletionResponse parses an HTTP response from a SimpleBankCancelAccountDeletionWithResponse call
+func ParseSimpleBankCancelAccountDeletionResponse(rsp *http.Response) (*SimpleBankCancelAccountDeletionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCancelAccountDeletionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCancelAccountDeletionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCollectPaymentResponse parses an HTTP response from a SimpleBankCollectPaymentWithResponse call
+func ParseSimpleBankCollectPaymentResponse(rsp *http.Response) (*SimpleBankCollectPaymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCollectPaymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCollectPaymentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCreateBalanceAlertResponse parses an HTTP response from a SimpleBankCreateBalanceAlertWithResponse call
+func ParseSimpleBankCreateBalanceAlertResponse(rsp *http.Response) (*SimpleBankCreateBalanceAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCreateBalanceAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCreateBalanceAlertResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCreateBulkTransferResponse parses an HTTP response from a SimpleBankCreateBulkTransferWithResponse call
+func ParseSimpleBankCreateBulkTransferResponse(rsp *http.Response) (*SimpleBankCreateBulkTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCreateBulkTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCreateBulkTransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCreateMandateResponse parses an HTTP response from a SimpleBankCreateMandateWithResponse call
+func ParseSimpleBankCreateMandateResponse(rsp *http.Response) (*SimpleBankCreateMandateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCreateMandateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCreateMandateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankCreateUserResponse parses an HTTP response from a SimpleBankCreateUserWithResponse call
+func ParseSimpleBankCreateUserResponse(rsp *http.Response) (*SimpleBankCreateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankCreateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbCreateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListCurrenciesResponse parses an HTTP response from a SimpleBankListCurrenciesWithResponse call
+func ParseSimpleBankListCurrenciesResponse(rsp *http.Response) (*SimpleBankListCurrenciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListCurrenciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListCurrenciesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankDeleteBalanceAlertResponse parses an HTTP response from a SimpleBankDeleteBalanceAlertWithResponse call
+func ParseSimpleBankDeleteBalanceAlertResponse(rsp *http.Response) (*SimpleBankDeleteBalanceAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankDeleteBalanceAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbDeleteBalanceAlertResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankDeleteUserResponse parses an HTTP response from a SimpleBankDeleteUserWithResponse call
+func ParseSimpleBankDeleteUserResponse(rsp *http.Response) (*SimpleBankDeleteUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankDeleteUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbDeleteUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetDisputeResponse parses an HTTP response from a SimpleBankGetDisputeWithResponse call
+func ParseSimpleBankGetDisputeResponse(rsp *http.Response) (*SimpleBankGetDisputeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetDisputeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetDisputeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListDisputesResponse parses an HTTP response from a SimpleBankListDisputesWithResponse call
+func ParseSimpleBankListDisputesResponse(rsp *http.Response) (*SimpleBankListDisputesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListDisputesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListDisputesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetExportStatusResponse parses an HTTP response from a SimpleBankGetExportStatusWithResponse call
+func ParseSimpleBankGetExportStatusResponse(rsp *http.Response) (*SimpleBankGetExportStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetExportStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetExportStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankExternalPayoutResponse parses an HTTP response from a SimpleBankExternalPayoutWithResponse call
+func ParseSimpleBankExternalPayoutResponse(rsp *http.Response) (*SimpleBankExternalPayoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankExternalPayoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbExternalPayoutResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetFeeScheduleResponse parses an HTTP response from a SimpleBankGetFeeScheduleWithResponse call
+func ParseSimpleBankGetFeeScheduleResponse(rsp *http.Response) (*SimpleBankGetFeeScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetFeeScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetFeeScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankFreezeCardResponse parses an HTTP response from a SimpleBankFreezeCardWithResponse call
+func ParseSimpleBankFreezeCardResponse(rsp *http.Response) (*SimpleBankFreezeCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankFreezeCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbFreezeCardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetKYCDocumentFileResponse parses an HTTP response from a SimpleBankGetKYCDocumentFileWithResponse call
+func ParseSimpleBankGetKYCDocumentFileResponse(rsp *http.Response) (*SimpleBankGetKYCDocumentFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetKYCDocumentFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetKYCDocumentFileResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetTransferQuoteResponse parses an HTTP response from a SimpleBankGetTransferQuoteWithResponse call
+func ParseSimpleBankGetTransferQuoteResponse(rsp *http.Response) (*SimpleBankGetTransferQuoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetTransferQuoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetTransferQuoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankIssueCardResponse parses an HTTP response from a SimpleBankIssueCardWithResponse call
+func ParseSimpleBankIssueCardResponse(rsp *http.Response) (*SimpleBankIssueCardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankIssueCardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbIssueCardResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListAccountsResponse parses an HTTP response from a SimpleBankListAccountsWithResponse call
+func ParseSimpleBankListAccountsResponse(rsp *http.Response) (*SimpleBankListAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListCardsResponse parses an HTTP response from a SimpleBankListCardsWithResponse call
+func ParseSimpleBankListCardsResponse(rsp *http.Response) (*SimpleBankListCardsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListCardsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListCardsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListEntriesResponse parses an HTTP response from a SimpleBankListEntriesWithResponse call
+func ParseSimpleBankListEntriesResponse(rsp *http.Response) (*SimpleBankListEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListEntriesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListKYCDocumentsResponse parses an HTTP response from a SimpleBankListKYCDocumentsWithResponse call
+func ParseSimpleBankListKYCDocumentsResponse(rsp *http.Response) (*SimpleBankListKYCDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListKYCDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListKYCDocumentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListPendingKYCDocumentsResponse parses an HTTP response from a SimpleBankListPendingKYCDocumentsWithResponse call
+func ParseSimpleBankListPendingKYCDocumentsResponse(rsp *http.Response) (*SimpleBankListPendingKYCDocumentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListPendingKYCDocumentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListPendingKYCDocumentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListTransfersResponse parses an HTTP response from a SimpleBankListTransfersWithResponse call
+func ParseSimpleBankListTransfersResponse(rsp *http.Response) (*SimpleBankListTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListTransfersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankLoginUserResponse parses an HTTP response from a SimpleBankLoginUserWithResponse call
+func ParseSimpleBankLoginUserResponse(rsp *http.Response) (*SimpleBankLoginUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankLoginUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbLoginUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetNotificationPreferencesResponse parses an HTTP response from a SimpleBankGetNotificationPreferencesWithResponse call
+func ParseSimpleBankGetNotificationPreferencesResponse(rsp *http.Response) (*SimpleBankGetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetNotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUpdateNotificationPreferencesResponse parses an HTTP response from a SimpleBankUpdateNotificationPreferencesWithResponse call
+func ParseSimpleBankUpdateNotificationPreferencesResponse(rsp *http.Response) (*SimpleBankUpdateNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUpdateNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUpdateNotificationPreferencesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankExchangeOAuthCodeResponse parses an HTTP response from a SimpleBankExchangeOAuthCodeWithResponse call
+func ParseSimpleBankExchangeOAuthCodeResponse(rsp *http.Response) (*SimpleBankExchangeOAuthCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankExchangeOAuthCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbExchangeOAuthCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetOAuthURLResponse parses an HTTP response from a SimpleBankGetOAuthURLWithResponse call
+func ParseSimpleBankGetOAuthURLResponse(rsp *http.Response) (*SimpleBankGetOAuthURLResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetOAuthURLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetOAuthURLResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankOpenDisputeResponse parses an HTTP response from a SimpleBankOpenDisputeWithResponse call
+func ParseSimpleBankOpenDisputeResponse(rsp *http.Response) (*SimpleBankOpenDisputeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankOpenDisputeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbOpenDisputeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetReferralCodeResponse parses an HTTP response from a SimpleBankGetReferralCodeWithResponse call
+func ParseSimpleBankGetReferralCodeResponse(rsp *http.Response) (*SimpleBankGetReferralCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetReferralCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetReferralCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankResendVerifyEmailResponse parses an HTTP response from a SimpleBankResendVerifyEmailWithResponse call
+func ParseSimpleBankResendVerifyEmailResponse(rsp *http.Response) (*SimpleBankResendVerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankResendVerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbResendVerifyEmailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankResolveDisputeResponse parses an HTTP response from a SimpleBankResolveDisputeWithResponse call
+func ParseSimpleBankResolveDisputeResponse(rsp *http.Response) (*SimpleBankResolveDisputeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankResolveDisputeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbResolveDisputeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankReverseTransferResponse parses an HTTP response from a SimpleBankReverseTransferWithResponse call
+func ParseSimpleBankReverseTransferResponse(rsp *http.Response) (*SimpleBankReverseTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankReverseTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbReverseTransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankReviewKYCDocumentResponse parses an HTTP response from a SimpleBankReviewKYCDocumentWithResponse call
+func ParseSimpleBankReviewKYCDocumentResponse(rsp *http.Response) (*SimpleBankReviewKYCDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankReviewKYCDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbReviewKYCDocumentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankRevokeMandateResponse parses an HTTP response from a SimpleBankRevokeMandateWithResponse call
+func ParseSimpleBankRevokeMandateResponse(rsp *http.Response) (*SimpleBankRevokeMandateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankRevokeMandateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbRevokeMandateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankRevokeSessionResponse parses an HTTP response from a SimpleBankRevokeSessionWithResponse call
+func ParseSimpleBankRevokeSessionResponse(rsp *http.Response) (*SimpleBankRevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankRevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbRevokeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankSearchAccountsResponse parses an HTTP response from a SimpleBankSearchAccountsWithResponse call
+func ParseSimpleBankSearchAccountsResponse(rsp *http.Response) (*SimpleBankSearchAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankSearchAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbSearchAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankSearchUsersResponse parses an HTTP response from a SimpleBankSearchUsersWithResponse call
+func ParseSimpleBankSearchUsersResponse(rsp *http.Response) (*SimpleBankSearchUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankSearchUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbSearchUsersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankListSessionsResponse parses an HTTP response from a SimpleBankListSessionsWithResponse call
+func ParseSimpleBankListSessionsResponse(rsp *http.Response) (*SimpleBankListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbListSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankSetDefaultAccountResponse parses an HTTP response from a SimpleBankSetDefaultAccountWithResponse call
+func ParseSimpleBankSetDefaultAccountResponse(rsp *http.Response) (*SimpleBankSetDefaultAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankSetDefaultAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbSetDefaultAccountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetSpendingSummaryResponse parses an HTTP response from a SimpleBankGetSpendingSummaryWithResponse call
+func ParseSimpleBankGetSpendingSummaryResponse(rsp *http.Response) (*SimpleBankGetSpendingSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetSpendingSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetSpendingSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankStartExportResponse parses an HTTP response from a SimpleBankStartExportWithResponse call
+func ParseSimpleBankStartExportResponse(rsp *http.Response) (*SimpleBankStartExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankStartExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbStartExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankTransferAccountOwnershipResponse parses an HTTP response from a SimpleBankTransferAccountOwnershipWithResponse call
+func ParseSimpleBankTransferAccountOwnershipResponse(rsp *http.Response) (*SimpleBankTransferAccountOwnershipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankTransferAccountOwnershipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbTransferAccountOwnershipResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetTransferStatusResponse parses an HTTP response from a SimpleBankGetTransferStatusWithResponse call
+func ParseSimpleBankGetTransferStatusResponse(rsp *http.Response) (*SimpleBankGetTransferStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetTransferStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetTransferStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUpdateBalanceAlertResponse parses an HTTP response from a SimpleBankUpdateBalanceAlertWithResponse call
+func ParseSimpleBankUpdateBalanceAlertResponse(rsp *http.Response) (*SimpleBankUpdateBalanceAlertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUpdateBalanceAlertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUpdateBalanceAlertResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUpdateTransferMemoResponse parses an HTTP response from a SimpleBankUpdateTransferMemoWithResponse call
+func ParseSimpleBankUpdateTransferMemoResponse(rsp *http.Response) (*SimpleBankUpdateTransferMemoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUpdateTransferMemoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUpdateTransferMemoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUpdateUserResponse parses an HTTP response from a SimpleBankUpdateUserWithResponse call
+func ParseSimpleBankUpdateUserResponse(rsp *http.Response) (*SimpleBankUpdateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUpdateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUploadKYCDocumentResponse parses an HTTP response from a SimpleBankUploadKYCDocumentWithResponse call
+func ParseSimpleBankUploadKYCDocumentResponse(rsp *http.Response) (*SimpleBankUploadKYCDocumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUploadKYCDocumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUploadKYCDocumentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankUploadUserAvatarResponse parses an HTTP response from a SimpleBankUploadUserAvatarWithResponse call
+func ParseSimpleBankUploadUserAvatarResponse(rsp *http.Response) (*SimpleBankUploadUserAvatarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankUploadUserAvatarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbUploadUserAvatarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankVerifyEmailResponse parses an HTTP response from a SimpleBankVerifyEmailWithResponse call
+func ParseSimpleBankVerifyEmailResponse(rsp *http.Response) (*SimpleBankVerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankVerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbVerifyEmailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2ListAccountsResponse parses an HTTP response from a SimpleBankV2ListAccountsWithResponse call
+func ParseSimpleBankV2ListAccountsResponse(rsp *http.Response) (*SimpleBankV2ListAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2ListAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2ListAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2GetAccountResponse parses an HTTP response from a SimpleBankV2GetAccountWithResponse call
+func ParseSimpleBankV2GetAccountResponse(rsp *http.Response) (*SimpleBankV2GetAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2GetAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2GetAccountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2ListEntriesResponse parses an HTTP response from a SimpleBankV2ListEntriesWithResponse call
+func ParseSimpleBankV2ListEntriesResponse(rsp *http.Response) (*SimpleBankV2ListEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2ListEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2ListEntriesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2ListTransfersResponse parses an HTTP response from a SimpleBankV2ListTransfersWithResponse call
+func ParseSimpleBankV2ListTransfersResponse(rsp *http.Response) (*SimpleBankV2ListTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2ListTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2ListTransfersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2BatchGetAccountsResponse parses an HTTP response from a SimpleBankV2BatchGetAccountsWithResponse call
+func ParseSimpleBankV2BatchGetAccountsResponse(rsp *http.Response) (*SimpleBankV2BatchGetAccountsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2BatchGetAccountsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2BatchGetAccountsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankV2UpdateUserResponse parses an HTTP response from a SimpleBankV2UpdateUserWithResponse call
+func ParseSimpleBankV2UpdateUserResponse(rsp *http.Response) (*SimpleBankV2UpdateUserResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankV2UpdateUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbV2UpdateUserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSimpleBankGetVersionResponse parses an HTTP response from a SimpleBankGetVersionWithResponse call
+func ParseSimpleBankGetVersionResponse(rsp *http.Response) (*SimpleBankGetVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SimpleBankGetVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PbGetVersionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
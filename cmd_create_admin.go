@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+)
+
+/*
+bank create-admin 直接在数据库里创建一个管理员，邮箱视为已经验证。
+注册接口只能创建普通用户，第一个管理员以前只能手动改数据库。
+用户名、姓名、邮箱和密码的规则和 CreateUser 一样，都来自 val 包。
+*/
+func newCreateAdminCommand() *cobra.Command {
+	var username, fullName, email, password string
+
+	cmd := &cobra.Command{
+		Use:   "create-admin",
+		Short: "Create an admin user",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg, err := newCreateAdminUserParams(username, fullName, email, password)
+			if err != nil {
+				return err
+			}
+
+			config := loadConfig()
+			connPool, err := db.NewConnPool(context.Background(), config.DBSource, config.DBStatementTimeout)
+			if err != nil {
+				return fmt.Errorf("cannot connect to db: %w", err)
+			}
+			defer connPool.Close()
+
+			user, err := db.NewStore(connPool).CreateAdminUser(cmd.Context(), arg)
+			if err != nil {
+				if db.ErrorCode(err) == db.UniqueViolation {
+					return fmt.Errorf("user %q or email %q already exists", username, email)
+				}
+				return fmt.Errorf("failed to create admin user: %w", err)
+			}
+
+			cmd.Printf("admin user %s created\n", user.Username)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&username, "username", "", "username of the admin")
+	cmd.Flags().StringVar(&fullName, "full-name", "", "full name of the admin")
+	cmd.Flags().StringVar(&email, "email", "", "email of the admin")
+	cmd.Flags().StringVar(&password, "password", "", "password of the admin")
+	for _, name := range []string{"username", "full-name", "email", "password"} {
+		cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
+
+func newCreateAdminUserParams(username, fullName, email, password string) (db.CreateAdminUserParams, error) {
+	// 和 CreateUser 接口用同样的规则，包括配置的密码规则
+	fields := []struct {
+		name string
+		err  error
+	}{
+		{"username", val.ValidateUsername(username)},
+		{"full_name", val.ValidateFullName(fullName)},
+		{"email", val.ValidateEmail(email)},
+		{"password", val.ValidatePassword(password)},
+	}
+	var errs []error
+	for _, field := range fields {
+		if field.err != nil {
+			errs = append(errs, fmt.Errorf("%s %w", field.name, field.err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return db.CreateAdminUserParams{}, err
+	}
+
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		return db.CreateAdminUserParams{}, err
+	}
+
+	return db.CreateAdminUserParams{
+		Username:       username,
+		HashedPassword: hashedPassword,
+		FullName:       fullName,
+		Email:          email,
+	}, nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/techschool/bank/db/migration"
+)
+
+/*
+bank migrate up|down|version|status 管理数据库结构，迁移文件默认是编译进二进制的那一份（见 db/migration），
+配置了 MIGRATION_URL 时用这个地址里的迁移文件。
+发布的时候可以先单独运行迁移，再用 bank serve --migrate=false 启动新版本。
+*/
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage database migrations",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator()
+			if err != nil {
+				return err
+			}
+			defer migrator.Close()
+
+			if err := migrator.Up(); err != nil {
+				return fmt.Errorf("failed to run migrate up: %w", err)
+			}
+			log.Info().Msg("db migrated successfully")
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "down [N]",
+		Short: "Roll back the last N migrations (default 1)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			steps, err := parseMigrateDownSteps(args)
+			if err != nil {
+				return err
+			}
+
+			migrator, err := newMigrator()
+			if err != nil {
+				return err
+			}
+			defer migrator.Close()
+
+			if err := migrator.Steps(-steps); err != nil {
+				return fmt.Errorf("failed to run migrate down: %w", err)
+			}
+			log.Info().Int("steps", steps).Msg("db migrated down successfully")
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "version",
+		Short: "Print the current migration version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator()
+			if err != nil {
+				return err
+			}
+			defer migrator.Close()
+
+			version, dirty, err := migrator.Version()
+			if errors.Is(err, migrate.ErrNilVersion) {
+				cmd.Println("no migration has been applied")
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get migration version: %w", err)
+			}
+			cmd.Printf("version: %d, dirty: %t\n", version, dirty)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "Compare the database schema with the migrations of this build",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator()
+			if err != nil {
+				return err
+			}
+			defer migrator.Close()
+
+			status, err := migrator.Status()
+			if err != nil {
+				return err
+			}
+			cmd.Printf("version: %d, latest: %d, pending: %d, dirty: %t\n",
+				status.Version, status.LatestVersion, status.Pending, status.Dirty)
+			return nil
+		},
+	})
+
+	return cmd
+}
+
+func newMigrator() (*migration.Migrator, error) {
+	config := loadConfig()
+	return migration.New(config.MigrationURL, config.DBSource)
+}
+
+// parseMigrateDownSteps 解析 migrate down 要回滚的步数，默认只回滚最后一个迁移
+func parseMigrateDownSteps(args []string) (int, error) {
+	if len(args) == 0 {
+		return 1, nil
+	}
+
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps <= 0 {
+		return 0, fmt.Errorf("invalid number of steps %q: must be a positive integer", args[0])
+	}
+	return steps, nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/techschool/bank/db/cache"
+	"github.com/techschool/bank/db/metrics"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/faultinject"
+	"github.com/techschool/bank/logging"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/version"
+	"github.com/techschool/bank/worker"
+)
+
+// configPath 是 app.env 所在的目录，所有子命令共用 --config 参数
+var configPath string
+
+func newRootCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "bank",
+		Short:   "Simple bank server, worker and operational tools",
+		Version: version.Version,
+		// 参数错误时才打印用法，运行出错时只打印错误
+		SilenceUsage: true,
+	}
+	cmd.PersistentFlags().StringVar(&configPath, "config", ".", "directory that contains app.env")
+
+	cmd.AddCommand(
+		newServeCommand(),
+		newWorkerCommand(),
+		newMigrateCommand(),
+		newCreateAdminCommand(),
+		newSeedCommand(),
+		newLoadTestCommand(),
+	)
+	return cmd
+}
+
+/*
+loadConfig 加载配置并完成每个子命令都需要的初始化：日志格式和级别、日志里的版本号、支持的货币、密码规则和密码哈希。
+配置有问题时直接退出，和之前 main 里的行为一样。
+*/
+func loadConfig() util.Config {
+	config, err := util.LoadConfig(configPath)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot load config")
+	}
+
+	logging.Setup(config, os.Stderr)
+	// 每一行日志都带上版本号，滚动发布的时候可以区分新旧版本的日志
+	log.Logger = log.With().Str("version", version.Version).Logger()
+
+	// 支持的货币和小数位数来自配置，Gin 和 gRPC 的验证器都会用到
+	currencies, err := util.ParseCurrencies(config.SupportedCurrencies)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid supported currencies")
+	}
+	util.SetSupportedCurrencies(currencies)
+
+	// 新密码的规则也是 Gin 和 gRPC 共用的
+	passwordPolicy, err := val.NewPasswordPolicy(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid password policy")
+	}
+	val.SetPasswordPolicy(passwordPolicy)
+
+	passwordHasher, err := util.NewPasswordHasher(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid password hasher")
+	}
+	util.SetPasswordHasher(passwordHasher)
+
+	if err := faultinject.Configure(config); err != nil {
+		log.Fatal().Err(err).Msg("invalid fault injection")
+	}
+	if config.FaultInjection != "" && config.Environment != util.ProductionEnvironment {
+		log.Warn().Str("fault_injection", config.FaultInjection).Msg("fault injection is enabled")
+	}
+
+	return config
+}
+
+// watchConfig 在 app.env 修改之后热更新日志级别和故障注入，限流等其他订阅者由各个子命令自己添加
+func watchConfig() *util.ConfigWatcher {
+	configWatcher := util.WatchConfig(configPath, func(err error) {
+		log.Error().Err(err).Msg("cannot reload config, keep using the old one")
+	})
+	configWatcher.Subscribe(func(config util.Config) {
+		logging.SetLevel(config)
+		if err := faultinject.Configure(config); err != nil {
+			log.Error().Err(err).Msg("invalid fault injection, keep using the old one")
+		}
+		log.Info().Str("log_level", zerolog.GlobalLevel().String()).Msg("config reloaded")
+	})
+	return configWatcher
+}
+
+/*
+connectStore 连接主库和只读副本，配置了 Redis（单节点、Sentinel 或 Cluster）之后返回 Redis 的连接参数和客户端。
+撤销的令牌、缓存和任务队列用同一个 Redis。
+所有组件共用同一个 CachedStore，这样 worker 里的修改也会让缓存失效。
+*/
+func connectStore(config util.Config) (db.Store, asynq.RedisConnOpt, redis.UniversalClient) {
+	connPool, err := db.NewConnPool(context.Background(), config.DBSource, config.DBStatementTimeout)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot connect to db")
+	}
+
+	var replicaPools []*pgxpool.Pool
+	for _, source := range strings.Split(config.DBReplicaSources, ",") {
+		source = strings.TrimSpace(source)
+		if source == "" {
+			continue
+		}
+
+		replicaPool, err := db.NewConnPool(context.Background(), source, config.DBStatementTimeout)
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot connect to db replica")
+		}
+		replicaPools = append(replicaPools, replicaPool)
+	}
+
+	store := db.NewStore(connPool, db.WithReplicas(replicaPools...), db.WithMaxTxAttempts(config.DBTxMaxAttempts))
+	// 指标包在缓存里面，只统计真正发给数据库的调用
+	store = metrics.NewMetricsStore(store, config.DBSlowQueryThreshold)
+
+	redisOpt, err := worker.NewRedisConnOpt(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid redis config")
+	}
+	redisClient := redisOpt.MakeRedisClient().(redis.UniversalClient)
+
+	if config.CacheEnabled {
+		store = cache.NewCachedStore(store, cache.NewRedisCache(redisClient), config.CacheTTL)
+	}
+
+	return store, redisOpt, redisClient
+}
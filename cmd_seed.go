@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/seed"
+	"github.com/techschool/bank/util"
+)
+
+/*
+bank seed 给本地和 staging 环境生成随机的用户、账户和历史转账（见 seed 包）。
+生产环境（ENVIRONMENT=production）里拒绝运行，避免把演示数据写进真实的数据库。
+*/
+func newSeedCommand() *cobra.Command {
+	var opts seed.Options
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Create random users, accounts and historical transfers for local and staging environments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := loadConfig()
+			if config.Environment == util.ProductionEnvironment {
+				return fmt.Errorf("refuse to seed data in the %s environment", config.Environment)
+			}
+
+			connPool, err := db.NewConnPool(context.Background(), config.DBSource, config.DBStatementTimeout)
+			if err != nil {
+				return fmt.Errorf("cannot connect to db: %w", err)
+			}
+			defer connPool.Close()
+
+			result, err := seed.Run(cmd.Context(), db.NewStore(connPool), opts)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("created %d users, %d accounts and %d transfers\n", result.Users, result.Accounts, result.Transfers)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Users, "users", 10, "number of users")
+	cmd.Flags().IntVar(&opts.AccountsPerUser, "accounts-per-user", 2, "number of accounts of each user, in different currencies")
+	cmd.Flags().IntVar(&opts.Transfers, "transfers", 200, "number of transfers between the seeded accounts")
+	cmd.Flags().IntVar(&opts.Days, "days", 90, "spread the transfers over the last number of days")
+	cmd.Flags().StringVar(&opts.Password, "password", "secret", "password of all seeded users")
+	return cmd
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/techschool/bank/gapi"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/worker"
+)
+
+/*
+bank serve 启动 gRPC 服务器和 HTTP 网关，配置了 GIN_SERVER_ADDRESS 时还启动 Gin 版本的 API（见 runGinServer）。
+
+默认和以前一样先运行数据库迁移，并且在同一个进程里运行后台任务，单机部署只需要这一个命令。
+API 和 worker 分开部署时用 --worker=false 启动 API，再用 bank worker 启动后台任务；
+迁移由 bank migrate up 在发布前单独运行时用 --migrate=false，这时只检查数据库结构，落后的话拒绝启动。
+
+收到 SIGINT 或 SIGTERM 时网关和 gRPC 服务器依次停止接受新的连接，等正在处理的请求结束（最多 shutdownTimeout），
+关闭监听器的时候删除 Unix socket 的文件，然后和 bank worker 一样停止后台任务。
+*/
+func newServeCommand() *cobra.Command {
+	var runMigration, runWorker bool
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run the gRPC server and the HTTP gateway",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			config := loadConfig()
+			configWatcher := watchConfig()
+
+			runDBMigration(config, runMigration)
+
+			store, redisOpt, redisClient := connectStore(config)
+			taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
+			taskInspector := worker.NewRedisTaskInspector(redisOpt)
+			revocationStore := token.NewRedisRevocationStore(redisClient)
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			var taskProcessor worker.TaskProcessor
+			var taskScheduler worker.TaskScheduler
+			relayDone := make(chan struct{})
+			if runWorker {
+				taskProcessor = runTaskProcessor(config, redisOpt, store)
+				taskScheduler = runTaskScheduler(config, redisOpt)
+				go func() {
+					defer close(relayDone)
+					runEventRelay(ctx, config, store)
+				}()
+			}
+
+			// gRPC 服务器和网关共用同一个 Server，网关通过 gatewayListener 调用 gRPC 服务器
+			server := newGapiServer(config, configWatcher, store, taskDistributor, taskInspector, revocationStore)
+			grpcServer := newGrpcServer(config, server)
+			gatewayListener := gapi.NewInProcessListener()
+
+			go runMetricsServer(ctx, config)
+
+			ginDone := make(chan struct{})
+			go func() {
+				defer close(ginDone)
+				runGinServer(ctx, config, store, taskDistributor, revocationStore)
+			}()
+
+			gatewayDone := make(chan struct{})
+			go func() {
+				defer close(gatewayDone)
+				runGatewayServer(ctx, config, server, grpcServer, gatewayListener, store)
+			}()
+
+			// 网关正在处理的请求还要用 gRPC 服务器，所以网关停止之后再停止 gRPC 服务器
+			grpcCtx, stopGrpc := context.WithCancel(context.Background())
+			go func() {
+				<-gatewayDone
+				stopGrpc()
+			}()
+			runGrpcServer(grpcCtx, config, grpcServer, gatewayListener)
+			<-ginDone
+
+			if runWorker {
+				taskScheduler.Shutdown()
+				taskProcessor.Shutdown()
+				<-relayDone
+			}
+			log.Info().Msg("server stopped")
+		},
+	}
+	cmd.Flags().BoolVar(&runMigration, "migrate", true, "run database migrations before starting")
+	cmd.Flags().BoolVar(&runWorker, "worker", true, "also run the task processor, scheduler and event relay in this process")
+	return cmd
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+/*
+bank worker 只运行后台任务：任务处理器、定时任务和事件转发，
+除了配置了 METRICS_SERVER_ADDRESS 时提供任务的指标（见 runMetricsServer），不监听任何端口。
+和 API 共用同一份配置和 store，可以单独部署成多个副本：
+队列里的任务由 asynq 分给各个副本，定时任务只由选举出来的 leader 放进队列，不会重复执行。
+
+收到 SIGINT 或 SIGTERM 时先停止调度器并释放 leader 锁，再等正在处理的任务完成之后退出。
+*/
+func newWorkerCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "worker",
+		Short: "Run the task processor, scheduler and event relay",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			config := loadConfig()
+			watchConfig()
+
+			store, redisOpt, _ := connectStore(config)
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			go runMetricsServer(ctx, config)
+
+			taskProcessor := runTaskProcessor(config, redisOpt, store)
+			taskScheduler := runTaskScheduler(config, redisOpt)
+
+			relayDone := make(chan struct{})
+			go func() {
+				defer close(relayDone)
+				runEventRelay(ctx, config, store)
+			}()
+
+			<-ctx.Done()
+			log.Info().Msg("shutting down worker")
+
+			taskScheduler.Shutdown()
+			taskProcessor.Shutdown()
+			<-relayDone
+			log.Info().Msg("worker stopped")
+		},
+	}
+}
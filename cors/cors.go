@@ -0,0 +1,144 @@
+package cors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+Policy 实现了 CORS（跨域资源共享），让浏览器里的前端可以跨域调用 HTTP 网关和 Gin 版本的 API。
+
+没有配置 CORS_ALLOWED_ORIGINS 的时候不处理跨域请求（浏览器会拦截），
+配置成 "*" 表示允许所有来源，但是如果同时允许携带凭证（cookie、Authorization），
+浏览器不接受 "*"，这时会把请求的 Origin 原样返回。
+
+预检请求（OPTIONS + Access-Control-Request-Method）由 Policy 直接返回 204，不会转给后面的 handler。
+*/
+
+var (
+	defaultAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+	defaultAllowedHeaders = []string{"Authorization", "Content-Type", "X-Request-ID"}
+	defaultExposedHeaders = []string{"X-Request-ID"}
+)
+
+type Policy struct {
+	allowedOrigins   map[string]bool
+	allowAllOrigins  bool
+	allowedMethods   string
+	allowedHeaders   string
+	exposedHeaders   string
+	allowCredentials bool
+	maxAge           time.Duration
+}
+
+// NewPolicy 从配置创建 Policy，列表类的配置都是逗号分隔的
+func NewPolicy(config util.Config) *Policy {
+	policy := &Policy{
+		allowedOrigins:   map[string]bool{},
+		allowedMethods:   strings.Join(splitList(config.CORSAllowedMethods, defaultAllowedMethods), ", "),
+		allowedHeaders:   strings.Join(splitList(config.CORSAllowedHeaders, defaultAllowedHeaders), ", "),
+		exposedHeaders:   strings.Join(defaultExposedHeaders, ", "),
+		allowCredentials: config.CORSAllowCredentials,
+		maxAge:           config.CORSMaxAge,
+	}
+
+	for _, origin := range splitList(config.CORSAllowedOrigins, nil) {
+		if origin == "*" {
+			policy.allowAllOrigins = true
+			continue
+		}
+		policy.allowedOrigins[strings.TrimSuffix(origin, "/")] = true
+	}
+
+	return policy
+}
+
+// Enabled 表示是否配置了允许的来源
+func (policy *Policy) Enabled() bool {
+	return policy.allowAllOrigins || len(policy.allowedOrigins) > 0
+}
+
+// AllowsOrigin 判断来源是否在 CORS_ALLOWED_ORIGINS 里，gRPC-Web 的跨域和 websocket 检查也用它
+func (policy *Policy) AllowsOrigin(origin string) bool {
+	return policy.allowAllOrigins || policy.allowedOrigins[origin]
+}
+
+// IsPreflight 判断是不是浏览器发出的预检请求
+func IsPreflight(req *http.Request) bool {
+	return req.Method == http.MethodOptions && req.Header.Get("Access-Control-Request-Method") != ""
+}
+
+/*
+Apply 根据请求的 Origin 设置 CORS 响应头，返回来源是否被允许。
+Gin 的中间件和 Handler 都用它，预检请求由调用方负责直接返回。
+*/
+func (policy *Policy) Apply(res http.ResponseWriter, req *http.Request) bool {
+	header := res.Header()
+	header.Add("Vary", "Origin")
+
+	origin := req.Header.Get("Origin")
+	if origin == "" || !policy.AllowsOrigin(origin) {
+		return false
+	}
+
+	if policy.allowAllOrigins && !policy.allowCredentials {
+		header.Set("Access-Control-Allow-Origin", "*")
+	} else {
+		header.Set("Access-Control-Allow-Origin", origin)
+	}
+	if policy.allowCredentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+
+	if IsPreflight(req) {
+		header.Add("Vary", "Access-Control-Request-Method")
+		header.Add("Vary", "Access-Control-Request-Headers")
+		header.Set("Access-Control-Allow-Methods", policy.allowedMethods)
+		header.Set("Access-Control-Allow-Headers", policy.allowedHeaders)
+		if policy.maxAge > 0 {
+			header.Set("Access-Control-Max-Age", strconv.Itoa(int(policy.maxAge.Seconds())))
+		}
+	} else {
+		header.Set("Access-Control-Expose-Headers", policy.exposedHeaders)
+	}
+
+	return true
+}
+
+// Handler 是给 net/http 用的中间件，HTTP 网关用它包装 ServeMux
+func (policy *Policy) Handler(handler http.Handler) http.Handler {
+	if !policy.Enabled() {
+		return handler
+	}
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		allowed := policy.Apply(res, req)
+		if IsPreflight(req) {
+			if allowed {
+				res.WriteHeader(http.StatusNoContent)
+			} else {
+				res.WriteHeader(http.StatusForbidden)
+			}
+			return
+		}
+		handler.ServeHTTP(res, req)
+	})
+}
+
+func splitList(value string, defaults []string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return defaults
+	}
+	return items
+}
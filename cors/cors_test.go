@@ -0,0 +1,89 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func serve(policy *Policy, req *http.Request) (*httptest.ResponseRecorder, bool) {
+	called := false
+	handler := policy.Handler(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		called = true
+		res.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder, called
+}
+
+func newRequest(method string, origin string) *http.Request {
+	req := httptest.NewRequest(method, "/v1/list_accounts", nil)
+	if origin != "" {
+		req.Header.Set("Origin", origin)
+	}
+	return req
+}
+
+func TestPolicyAllowedOrigin(t *testing.T) {
+	policy := NewPolicy(util.Config{
+		CORSAllowedOrigins:   "https://app.example.com, https://admin.example.com/",
+		CORSAllowCredentials: true,
+		CORSMaxAge:           time.Hour,
+	})
+
+	recorder, called := serve(policy, newRequest(http.MethodGet, "https://admin.example.com"))
+	require.True(t, called)
+	require.Equal(t, "https://admin.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "true", recorder.Header().Get("Access-Control-Allow-Credentials"))
+	require.Equal(t, "X-Request-ID", recorder.Header().Get("Access-Control-Expose-Headers"))
+
+	preflight := newRequest(http.MethodOptions, "https://app.example.com")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodPatch)
+	recorder, called = serve(policy, preflight)
+	require.False(t, called)
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	require.Equal(t, "GET, POST, PUT, PATCH, DELETE", recorder.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "Authorization, Content-Type, X-Request-ID", recorder.Header().Get("Access-Control-Allow-Headers"))
+	require.Equal(t, "3600", recorder.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestPolicyDisallowedOrigin(t *testing.T) {
+	policy := NewPolicy(util.Config{CORSAllowedOrigins: "https://app.example.com"})
+
+	recorder, called := serve(policy, newRequest(http.MethodGet, "https://evil.example.com"))
+	require.True(t, called)
+	require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	preflight := newRequest(http.MethodOptions, "https://evil.example.com")
+	preflight.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	recorder, called = serve(policy, preflight)
+	require.False(t, called)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func TestPolicyWildcard(t *testing.T) {
+	policy := NewPolicy(util.Config{CORSAllowedOrigins: "*", CORSAllowedMethods: "GET"})
+
+	recorder, _ := serve(policy, newRequest(http.MethodGet, "https://anything.example.com"))
+	require.Equal(t, "*", recorder.Header().Get("Access-Control-Allow-Origin"))
+
+	// 允许携带凭证时不能返回 *
+	policy = NewPolicy(util.Config{CORSAllowedOrigins: "*", CORSAllowCredentials: true})
+	recorder, _ = serve(policy, newRequest(http.MethodGet, "https://anything.example.com"))
+	require.Equal(t, "https://anything.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestPolicyDisabled(t *testing.T) {
+	policy := NewPolicy(util.Config{})
+	require.False(t, policy.Enabled())
+
+	recorder, called := serve(policy, newRequest(http.MethodGet, "https://app.example.com"))
+	require.True(t, called)
+	require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+}
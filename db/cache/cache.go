@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+/*
+Cache 是 CachedStore 使用的键值缓存，生产环境用 Redis，测试用内存实现。
+缓存的值是 JSON，缓存失败不应该影响请求，所以 CachedStore 遇到缓存错误只记日志，照常查数据库。
+*/
+type Cache interface {
+	// Get returns ErrCacheMiss when the key doesn't exist
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+var ErrCacheMiss = errors.New("cache miss")
+
+type RedisCache struct {
+	client redis.UniversalClient
+}
+
+// NewRedisCache creates a cache backed by Redis
+func NewRedisCache(client redis.UniversalClient) Cache {
+	return &RedisCache{
+		client: client,
+	}
+}
+
+func (cache *RedisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := cache.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+	return value, err
+}
+
+func (cache *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return cache.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (cache *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return cache.client.Del(ctx, keys...).Err()
+}
+
+type inMemoryEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// InMemoryCache 只在单个进程内有效，用于测试和本地开发
+type InMemoryCache struct {
+	mutex   sync.Mutex
+	entries map[string]inMemoryEntry
+}
+
+func NewInMemoryCache() *InMemoryCache {
+	return &InMemoryCache{
+		entries: make(map[string]inMemoryEntry),
+	}
+}
+
+func (cache *InMemoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(cache.entries, key)
+		return nil, ErrCacheMiss
+	}
+	return entry.value, nil
+}
+
+func (cache *InMemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.entries[key] = inMemoryEntry{value: value, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (cache *InMemoryCache) Delete(ctx context.Context, keys ...string) error {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(cache.entries, key)
+	}
+	return nil
+}
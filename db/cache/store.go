@@ -0,0 +1,339 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+CachedStore 是 db.Store 的装饰器，把读得最多的 GetAccount 和 GetUser 缓存起来（读穿透 + TTL）。
+其他方法直接交给内部的 Store。
+
+会修改账户或用户的方法执行成功之后，立即删除对应的缓存，而不是更新缓存，
+这样即使并发的读把旧值写回缓存，最多也只会旧 TTL 那么长时间。
+涉及余额的接口（转账、扣款、冲正）都会删除相关账户的缓存。
+
+注意：GetAccountForUpdate 等事务里的查询不经过缓存，余额检查总是读数据库。
+*/
+type CachedStore struct {
+	db.Store
+	cache Cache
+	ttl   time.Duration
+}
+
+// NewCachedStore wraps store with a read-through cache for accounts and users
+func NewCachedStore(store db.Store, cache Cache, ttl time.Duration) db.Store {
+	return &CachedStore{
+		Store: store,
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+func accountKey(id int64) string {
+	return fmt.Sprintf("cache:account:%d", id)
+}
+
+func userKey(username string) string {
+	return "cache:user:" + username
+}
+
+// getCached 先查缓存，没有命中再调用 load 查数据库，并把结果写进缓存
+func getCached[T any](ctx context.Context, store *CachedStore, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	var value T
+
+	data, err := store.cache.Get(ctx, key)
+	if err == nil {
+		if err = json.Unmarshal(data, &value); err == nil {
+			return value, nil
+		}
+	}
+	if err != nil && !errors.Is(err, ErrCacheMiss) {
+		log.Warn().Err(err).Str("key", key).Msg("failed to read cache")
+	}
+
+	// 写进缓存的值要从主库读，否则副本的复制延迟会让旧值在缓存里一直留到过期
+	value, err = load(db.WithPrimary(ctx))
+	if err != nil {
+		return value, err
+	}
+
+	data, err = json.Marshal(value)
+	if err == nil {
+		err = store.cache.Set(ctx, key, data, store.ttl)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("key", key).Msg("failed to write cache")
+	}
+	return value, nil
+}
+
+func (store *CachedStore) invalidate(ctx context.Context, keys ...string) {
+	if err := store.cache.Delete(ctx, keys...); err != nil {
+		log.Warn().Err(err).Strs("keys", keys).Msg("failed to invalidate cache")
+	}
+}
+
+func (store *CachedStore) invalidateAccounts(ctx context.Context, ids ...int64) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != 0 {
+			keys = append(keys, accountKey(id))
+		}
+	}
+	store.invalidate(ctx, keys...)
+}
+
+// ownerAccountIDs 在关闭用户所有账户之前查出账户 ID，关闭之后才知道要删哪些缓存
+func (store *CachedStore) ownerAccountIDs(ctx context.Context, owner string) []int64 {
+	accounts, err := store.Store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner: owner,
+		Limit: math.MaxInt32,
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("owner", owner).Msg("failed to list accounts for cache invalidation")
+		return nil
+	}
+
+	ids := make([]int64, len(accounts))
+	for i, account := range accounts {
+		ids[i] = account.ID
+	}
+	return ids
+}
+
+func (store *CachedStore) GetAccount(ctx context.Context, id int64) (db.Account, error) {
+	return getCached(ctx, store, accountKey(id), func(ctx context.Context) (db.Account, error) {
+		return store.Store.GetAccount(ctx, id)
+	})
+}
+
+func (store *CachedStore) GetUser(ctx context.Context, username string) (db.User, error) {
+	return getCached(ctx, store, userKey(username), func(ctx context.Context) (db.User, error) {
+		return store.Store.GetUser(ctx, username)
+	})
+}
+
+func (store *CachedStore) AddAccountBalance(ctx context.Context, arg db.AddAccountBalanceParams) (db.Account, error) {
+	account, err := store.Store.AddAccountBalance(ctx, arg)
+	store.invalidateAccounts(ctx, arg.ID)
+	return account, err
+}
+
+func (store *CachedStore) UpdateAccount(ctx context.Context, arg db.UpdateAccountParams) (db.Account, error) {
+	account, err := store.Store.UpdateAccount(ctx, arg)
+	store.invalidateAccounts(ctx, arg.ID)
+	return account, err
+}
+
+func (store *CachedStore) DeleteAccount(ctx context.Context, id int64) error {
+	err := store.Store.DeleteAccount(ctx, id)
+	store.invalidateAccounts(ctx, id)
+	return err
+}
+
+func (store *CachedStore) CloseUserAccounts(ctx context.Context, owner string) error {
+	ids := store.ownerAccountIDs(ctx, owner)
+	err := store.Store.CloseUserAccounts(ctx, owner)
+	store.invalidateAccounts(ctx, ids...)
+	return err
+}
+
+func (store *CachedStore) UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error) {
+	user, err := store.Store.UpdateUser(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return user, err
+}
+
+func (store *CachedStore) SoftDeleteUser(ctx context.Context, username string) (db.User, error) {
+	user, err := store.Store.SoftDeleteUser(ctx, username)
+	store.invalidate(ctx, userKey(username))
+	return user, err
+}
+
+func (store *CachedStore) AnonymizeUser(ctx context.Context, username string) (db.User, error) {
+	user, err := store.Store.AnonymizeUser(ctx, username)
+	store.invalidate(ctx, userKey(username))
+	return user, err
+}
+
+func (store *CachedStore) CancelUserDeletion(ctx context.Context, arg db.CancelUserDeletionParams) (db.User, error) {
+	user, err := store.Store.CancelUserDeletion(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return user, err
+}
+
+func (store *CachedStore) UpdateUserAvatar(ctx context.Context, arg db.UpdateUserAvatarParams) (db.User, error) {
+	user, err := store.Store.UpdateUserAvatar(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return user, err
+}
+
+func (store *CachedStore) MarkVerifyEmailReminderSent(ctx context.Context, arg db.MarkVerifyEmailReminderSentParams) (int64, error) {
+	rows, err := store.Store.MarkVerifyEmailReminderSent(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return rows, err
+}
+
+func (store *CachedStore) TransferTx(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+	result, err := store.Store.TransferTx(ctx, arg)
+	store.invalidateAccounts(ctx, arg.FromAccountID, arg.ToAccountID, arg.FeeAccountID)
+	return result, err
+}
+
+// CreateExternalTransferTx 只冻结转出金额，不改变余额，但是提现手续费会改变账户和收入账户的余额
+func (store *CachedStore) CreateExternalTransferTx(ctx context.Context, arg db.CreateExternalTransferTxParams) (db.CreateExternalTransferTxResult, error) {
+	result, err := store.Store.CreateExternalTransferTx(ctx, arg)
+	if arg.Fee > 0 {
+		store.invalidateAccounts(ctx, arg.AccountID, arg.FeeAccountID)
+	}
+	return result, err
+}
+
+func (store *CachedStore) FXTransferTx(ctx context.Context, arg db.FXTransferTxParams) (db.FXTransferTxResult, error) {
+	result, err := store.Store.FXTransferTx(ctx, arg)
+	store.invalidateAccounts(ctx, arg.FromAccountID, arg.ToAccountID, arg.FromFXAccountID, arg.ToFXAccountID)
+	return result, err
+}
+
+func (store *CachedStore) CreditReferralBonusTx(ctx context.Context, arg db.CreditReferralBonusTxParams) (db.CreditReferralBonusTxResult, error) {
+	result, err := store.Store.CreditReferralBonusTx(ctx, arg)
+	ids := []int64{arg.FundingAccountID}
+	for _, bonus := range []*db.TransferTxResult{result.ReferrerBonus, result.ReferredBonus} {
+		if bonus != nil {
+			ids = append(ids, bonus.ToAccount.ID)
+		}
+	}
+	store.invalidateAccounts(ctx, ids...)
+	return result, err
+}
+
+func (store *CachedStore) BulkTransferTx(ctx context.Context, arg db.BulkTransferTxParams) (db.BulkTransferTxResult, error) {
+	result, err := store.Store.BulkTransferTx(ctx, arg)
+
+	ids := []int64{arg.FromAccountID}
+	for _, item := range arg.Items {
+		ids = append(ids, item.ToAccountID)
+	}
+	store.invalidateAccounts(ctx, ids...)
+	return result, err
+}
+
+func (store *CachedStore) CollectPaymentTx(ctx context.Context, arg db.CollectPaymentTxParams) (db.CollectPaymentTxResult, error) {
+	result, err := store.Store.CollectPaymentTx(ctx, arg)
+	store.invalidateAccounts(ctx, result.FromAccount.ID, result.ToAccount.ID)
+	return result, err
+}
+
+func (store *CachedStore) CreatePendingTransferTx(ctx context.Context, arg db.CreatePendingTransferTxParams) (db.CreatePendingTransferTxResult, error) {
+	result, err := store.Store.CreatePendingTransferTx(ctx, arg)
+	store.invalidateAccounts(ctx, arg.FromAccountID, arg.ToAccountID)
+	return result, err
+}
+
+func (store *CachedStore) ReversePendingOperationTx(ctx context.Context, arg db.ReversePendingOperationTxParams) (db.ReversePendingOperationTxResult, error) {
+	result, err := store.Store.ReversePendingOperationTx(ctx, arg)
+	store.invalidateAccounts(ctx, result.Reversal.FromAccount.ID, result.Reversal.ToAccount.ID)
+	return result, err
+}
+
+func (store *CachedStore) ReverseTransferTx(ctx context.Context, arg db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error) {
+	result, err := store.Store.ReverseTransferTx(ctx, arg)
+	store.invalidateAccounts(ctx, result.Original.FromAccountID, result.Original.ToAccountID)
+	return result, err
+}
+
+func (store *CachedStore) ResolveDisputeTx(ctx context.Context, arg db.ResolveDisputeTxParams) (db.ResolveDisputeTxResult, error) {
+	result, err := store.Store.ResolveDisputeTx(ctx, arg)
+	if result.Refund != nil {
+		store.invalidateAccounts(ctx, result.Refund.Original.FromAccountID, result.Refund.Original.ToAccountID)
+	}
+	return result, err
+}
+
+func (store *CachedStore) CaptureHoldTx(ctx context.Context, arg db.CaptureHoldTxParams) (db.CaptureHoldTxResult, error) {
+	result, err := store.Store.CaptureHoldTx(ctx, arg)
+	store.invalidateAccounts(ctx, result.FromAccount.ID, arg.ToAccountID)
+	return result, err
+}
+
+func (store *CachedStore) AdvanceExternalTransferTx(ctx context.Context, arg db.AdvanceExternalTransferTxParams) (db.AdvanceExternalTransferTxResult, error) {
+	result, err := store.Store.AdvanceExternalTransferTx(ctx, arg)
+	if result.Settlement != nil {
+		store.invalidateAccounts(ctx, result.Settlement.FromAccount.ID, result.Settlement.ToAccount.ID)
+	}
+	return result, err
+}
+
+func (store *CachedStore) ApproveLoanTx(ctx context.Context, arg db.ApproveLoanTxParams) (db.ApproveLoanTxResult, error) {
+	result, err := store.Store.ApproveLoanTx(ctx, arg)
+	store.invalidateAccounts(ctx, arg.FundingAccountID, result.Loan.AccountID)
+	return result, err
+}
+
+func (store *CachedStore) CollectLoanPaymentTx(ctx context.Context, arg db.CollectLoanPaymentTxParams) (db.CollectLoanPaymentTxResult, error) {
+	result, err := store.Store.CollectLoanPaymentTx(ctx, arg)
+	store.invalidateAccounts(ctx, result.Debit.FromAccount.ID, result.Debit.ToAccount.ID)
+	return result, err
+}
+
+// TransferAccountOwnershipTx 之后账户缓存里还是原来的所有者，不删掉的话原来的所有者在 TTL 之内还能访问这个账户
+func (store *CachedStore) TransferAccountOwnershipTx(ctx context.Context, arg db.TransferAccountOwnershipTxParams) (db.TransferAccountOwnershipTxResult, error) {
+	result, err := store.Store.TransferAccountOwnershipTx(ctx, arg)
+	store.invalidateAccounts(ctx, arg.AccountID)
+	return result, err
+}
+
+// SetDefaultAccountTx 改了新旧两个默认账户的 is_default
+func (store *CachedStore) SetDefaultAccountTx(ctx context.Context, arg db.SetDefaultAccountTxParams) (db.SetDefaultAccountTxResult, error) {
+	result, err := store.Store.SetDefaultAccountTx(ctx, arg)
+	store.invalidateAccounts(ctx, append(result.PreviousDefaultIDs, arg.AccountID)...)
+	return result, err
+}
+
+func (store *CachedStore) UpdateUserTx(ctx context.Context, arg db.UpdateUserTxParams) (db.UpdateUserTxResult, error) {
+	result, err := store.Store.UpdateUserTx(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return result, err
+}
+
+func (store *CachedStore) ChangePasswordTx(ctx context.Context, arg db.ChangePasswordTxParams) (db.ChangePasswordTxResult, error) {
+	result, err := store.Store.ChangePasswordTx(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return result, err
+}
+
+func (store *CachedStore) VerifyEmailTx(ctx context.Context, arg db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
+	result, err := store.Store.VerifyEmailTx(ctx, arg)
+	if result.User.Username != "" {
+		store.invalidate(ctx, userKey(result.User.Username))
+	}
+	return result, err
+}
+
+func (store *CachedStore) DeleteUserTx(ctx context.Context, arg db.DeleteUserTxParams) (db.DeleteUserTxResult, error) {
+	ids := store.ownerAccountIDs(ctx, arg.Username)
+	result, err := store.Store.DeleteUserTx(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	store.invalidateAccounts(ctx, ids...)
+	return result, err
+}
+
+func (store *CachedStore) ScheduleUserDeletionTx(ctx context.Context, arg db.ScheduleUserDeletionTxParams) (db.ScheduleUserDeletionTxResult, error) {
+	result, err := store.Store.ScheduleUserDeletionTx(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return result, err
+}
+
+func (store *CachedStore) AnonymizeUserTx(ctx context.Context, arg db.AnonymizeUserTxParams) (db.AnonymizeUserTxResult, error) {
+	result, err := store.Store.AnonymizeUserTx(ctx, arg)
+	store.invalidate(ctx, userKey(arg.Username))
+	return result, err
+}
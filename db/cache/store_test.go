@@ -0,0 +1,283 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+func randomAccount() db.Account {
+	return db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    util.RandomOwner(),
+		Balance:  util.RandomMoney(),
+		Currency: util.RandomCurrency(),
+	}
+}
+
+func TestCachedStoreGetAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	account := randomAccount()
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	// 第二次读取命中缓存，所以只查一次数据库
+	store.EXPECT().
+		GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+		Times(1).
+		Return(account, nil)
+
+	for i := 0; i < 2; i++ {
+		got, err := cachedStore.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account, got)
+	}
+}
+
+func TestCachedStoreGetAccountNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	// 查不到的结果不缓存
+	store.EXPECT().
+		GetAccount(gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(db.Account{}, db.ErrRecordNotFound)
+
+	for i := 0; i < 2; i++ {
+		_, err := cachedStore.GetAccount(context.Background(), 1)
+		require.ErrorIs(t, err, db.ErrRecordNotFound)
+	}
+}
+
+func TestCachedStoreTransferTxInvalidatesAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	account1 := randomAccount()
+	account2 := randomAccount()
+	account2.ID = account1.ID + 1
+
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	updated1 := account1
+	updated1.Balance -= 10
+	updated2 := account2
+	updated2.Balance += 10
+
+	gomock.InOrder(
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil),
+		store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(updated1, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(updated2, nil),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetAccount(ctx, account1.ID)
+	require.NoError(t, err)
+	_, err = cachedStore.GetAccount(ctx, account2.ID)
+	require.NoError(t, err)
+
+	_, err = cachedStore.TransferTx(ctx, db.TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	got, err := cachedStore.GetAccount(ctx, account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated1.Balance, got.Balance)
+
+	got, err = cachedStore.GetAccount(ctx, account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated2.Balance, got.Balance)
+}
+
+func TestCachedStoreUpdateUserInvalidatesUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := db.User{
+		Username: util.RandomOwner(),
+		FullName: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+	}
+	updated := user
+	updated.FullName = util.RandomOwner()
+
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	gomock.InOrder(
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil),
+		store.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Times(1).Return(updated, nil),
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(updated, nil),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+
+	_, err = cachedStore.UpdateUser(ctx, db.UpdateUserParams{Username: user.Username})
+	require.NoError(t, err)
+
+	got, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+	require.Equal(t, updated.FullName, got.FullName)
+}
+
+func TestCachedStoreDeleteUserTxInvalidatesAccounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	account := randomAccount()
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	gomock.InOrder(
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil),
+		store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(1).Return([]db.Account{account}, nil),
+		store.EXPECT().DeleteUserTx(gomock.Any(), gomock.Any()).Times(1).Return(db.DeleteUserTxResult{}, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetAccount(ctx, account.ID)
+	require.NoError(t, err)
+
+	_, err = cachedStore.DeleteUserTx(ctx, db.DeleteUserTxParams{Username: account.Owner})
+	require.NoError(t, err)
+
+	_, err = cachedStore.GetAccount(ctx, account.ID)
+	require.ErrorIs(t, err, db.ErrRecordNotFound)
+}
+
+func TestCachedStoreTransferAccountOwnershipTxInvalidatesAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	account := randomAccount()
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	updated := account
+	updated.Owner = util.RandomOwner()
+
+	// 转移之后缓存里不能再是原来的所有者
+	gomock.InOrder(
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil),
+		store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(1).
+			Return(db.TransferAccountOwnershipTxResult{Account: updated, PreviousOwner: account.Owner}, nil),
+		store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(updated, nil),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetAccount(ctx, account.ID)
+	require.NoError(t, err)
+
+	_, err = cachedStore.TransferAccountOwnershipTx(ctx, db.TransferAccountOwnershipTxParams{
+		AccountID: account.ID,
+		NewOwner:  updated.Owner,
+	})
+	require.NoError(t, err)
+
+	got, err := cachedStore.GetAccount(ctx, account.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated.Owner, got.Owner)
+}
+
+func TestCachedStoreUserDeletionInvalidatesUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := db.User{
+		Username: util.RandomOwner(),
+		FullName: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+	}
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	scheduled := user
+	scheduled.DeletionScheduledAt = pgtype.Timestamptz{Time: scheduledAt, Valid: true}
+
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	gomock.InOrder(
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil),
+		store.EXPECT().ScheduleUserDeletionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ScheduleUserDeletionTxResult{User: scheduled}, nil),
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(scheduled, nil),
+		store.EXPECT().CancelUserDeletion(gomock.Any(), gomock.Any()).Times(1).Return(user, nil),
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+
+	// 等待删除的用户不能登录，缓存里不能还是没有 deletion_scheduled_at 的旧数据
+	_, err = cachedStore.ScheduleUserDeletionTx(ctx, db.ScheduleUserDeletionTxParams{Username: user.Username, ScheduledAt: scheduledAt})
+	require.NoError(t, err)
+
+	got, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+	require.True(t, got.DeletionScheduledAt.Valid)
+
+	_, err = cachedStore.CancelUserDeletion(ctx, db.CancelUserDeletionParams{
+		Username:            user.Username,
+		DeletionScheduledAt: scheduled.DeletionScheduledAt,
+	})
+	require.NoError(t, err)
+
+	got, err = cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+	require.False(t, got.DeletionScheduledAt.Valid)
+}
+
+func TestCachedStoreUpdateUserAvatarInvalidatesUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := db.User{
+		Username:  util.RandomOwner(),
+		FullName:  util.RandomOwner(),
+		Email:     util.RandomEmail(),
+		AvatarKey: "avatars/old.png",
+	}
+	updated := user
+	updated.AvatarKey = "avatars/new.png"
+
+	store := mockdb.NewMockStore(ctrl)
+	cachedStore := NewCachedStore(store, NewInMemoryCache(), time.Minute)
+
+	gomock.InOrder(
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil),
+		store.EXPECT().UpdateUserAvatar(gomock.Any(), gomock.Any()).Times(1).Return(updated, nil),
+		store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(updated, nil),
+	)
+
+	ctx := context.Background()
+	_, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+
+	_, err = cachedStore.UpdateUserAvatar(ctx, db.UpdateUserAvatarParams{Username: user.Username, AvatarKey: updated.AvatarKey})
+	require.NoError(t, err)
+
+	got, err := cachedStore.GetUser(ctx, user.Username)
+	require.NoError(t, err)
+	require.Equal(t, updated.AvatarKey, got.AvatarKey)
+}
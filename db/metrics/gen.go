@@ -0,0 +1,227 @@
+//go:build ignore
+
+// gen.go generates store.go: one method per db.Store method that calls the wrapped store and records metrics.
+// Run it with `go generate ./db/metrics` (or `make store-metrics`) after changing the Querier or Store interface.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const sqlcDir = "../sqlc"
+
+type method struct {
+	name    string
+	params  []string // "name type"
+	args    []string
+	results []string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, sqlcDir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg := pkgs["db"]
+
+	// 包名到 import 路径，生成的文件只引入方法签名里用到的包
+	importPaths := map[string]string{}
+	interfaces := map[string]*ast.InterfaceType{}
+	for _, file := range pkg.Files {
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := filepath.Base(path)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			importPaths[name] = path
+		}
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec := spec.(*ast.TypeSpec)
+				if iface, ok := typeSpec.Type.(*ast.InterfaceType); ok {
+					interfaces[typeSpec.Name.Name] = iface
+				}
+			}
+		}
+	}
+
+	importPaths["db"] = "github.com/techschool/bank/db/sqlc"
+	used := map[string]bool{"context": true, "time": true}
+	var methods []method
+	var collect func(name string)
+	collect = func(name string) {
+		for _, field := range interfaces[name].Methods.List {
+			funcType, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				// 嵌入的接口，比如 Store 里的 Querier
+				collect(field.Type.(*ast.Ident).Name)
+				continue
+			}
+			m := method{name: field.Names[0].Name}
+			for i, param := range funcType.Params.List {
+				typ := typeString(fset, param.Type, used)
+				names := param.Names
+				if len(names) == 0 {
+					names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+				}
+				for _, n := range names {
+					m.params = append(m.params, n.Name+" "+typ)
+					if _, ok := param.Type.(*ast.Ellipsis); ok {
+						m.args = append(m.args, n.Name+"...")
+					} else {
+						m.args = append(m.args, n.Name)
+					}
+				}
+			}
+			for _, result := range funcType.Results.List {
+				m.results = append(m.results, typeString(fset, result.Type, used))
+			}
+			methods = append(methods, m)
+		}
+	}
+	collect("Store")
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go. DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package metrics")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import (")
+	// 标准库和第三方的包分成两组，和 goimports 一样
+	var std, thirdParty []string
+	for name := range used {
+		path := importPaths[name]
+		if path == "" {
+			path = name
+		}
+		spec := strconv.Quote(path)
+		if filepath.Base(path) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(path, ".") {
+			thirdParty = append(thirdParty, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	for i, group := range [][]string{std, thirdParty} {
+		if i > 0 {
+			fmt.Fprintln(&buf)
+		}
+		sort.Slice(group, func(i, j int) bool { return importPath(group[i]) < importPath(group[j]) })
+		for _, spec := range group {
+			fmt.Fprintf(&buf, "\t%s\n", spec)
+		}
+	}
+	fmt.Fprintln(&buf, ")")
+
+	for _, m := range methods {
+		fmt.Fprintln(&buf)
+		results := strings.Join(m.results, ", ")
+		if len(m.results) > 1 {
+			results = "(" + results + ")"
+		}
+		fmt.Fprintf(&buf, "func (store *MetricsStore) %s(%s) %s {\n", m.name, strings.Join(m.params, ", "), results)
+		fmt.Fprintln(&buf, "\tstartTime := time.Now()")
+		call := fmt.Sprintf("store.store.%s(%s)", m.name, strings.Join(m.args, ", "))
+		if len(m.results) == 1 {
+			fmt.Fprintf(&buf, "\terr := %s\n", call)
+			fmt.Fprintf(&buf, "\tstore.observe(%q, startTime, 0, err)\n", m.name)
+			fmt.Fprintln(&buf, "\treturn err")
+		} else {
+			// 返回切片的方法记录行数，返回单个值的方法成功时算 1 行
+			rows := "1"
+			if strings.HasPrefix(m.results[0], "[]") {
+				rows = "len(r0)"
+			}
+			fmt.Fprintf(&buf, "\tr0, err := %s\n", call)
+			fmt.Fprintf(&buf, "\tstore.observe(%q, startTime, %s, err)\n", m.name, rows)
+			fmt.Fprintln(&buf, "\treturn r0, err")
+		}
+		fmt.Fprintln(&buf, "}")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("store.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// importPath returns the quoted path of an import spec like `db "github.com/techschool/bank/db/sqlc"`
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+// typeString prints the type with the exported identifiers of package db qualified, e.g. Account -> db.Account
+func typeString(fset *token.FileSet, expr ast.Expr, used map[string]bool) string {
+	expr = qualify(expr, used)
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+func qualify(expr ast.Expr, used map[string]bool) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(e.Name) {
+			used["db"] = true
+			return &ast.SelectorExpr{X: ast.NewIdent("db"), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.SelectorExpr:
+		used[e.X.(*ast.Ident).Name] = true
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X, used)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt, used)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key, used), Value: qualify(e.Value, used)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt, used)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params, used), Results: qualifyFields(e.Results, used)}
+	case *ast.InterfaceType:
+		return e
+	default:
+		log.Fatalf("unsupported type %T", expr)
+		return nil
+	}
+}
+
+func qualifyFields(fields *ast.FieldList, used map[string]bool) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type, used)})
+	}
+	return qualified
+}
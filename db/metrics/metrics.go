@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+MetricsStore 是 db.Store 的装饰器，按方法名（比如 GetAccount、TransferTx）把每次调用记录到 Prometheus：
+  - db_query_duration_seconds：耗时的直方图
+  - db_queries_total：按 result 统计调用次数，result 是 ok、not_found 或 error，error 除以总数就是错误率
+  - db_query_rows：返回的行数，返回切片的方法是切片的长度，返回单个值的方法成功时是 1
+
+耗时超过 DB_SLOW_QUERY_THRESHOLD 的调用会记一条 warn 日志，设置成 0 不记录。
+事务方法记录的是整个事务的耗时，事务里面的查询不会单独记录。
+
+每个方法的包装代码在 store.go 里，由 gen.go 根据 db.Store 接口生成，不需要修改 sqlc 生成的代码；
+Querier 或 Store 接口变化之后运行 make store-metrics 重新生成。
+*/
+//go:generate go run gen.go
+
+var (
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Latency of db.Store calls.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"query"})
+	queryTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_queries_total",
+		Help: "Number of db.Store calls by result.",
+	}, []string{"query", "result"})
+	queryRows = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_rows",
+		Help:    "Number of rows returned by db.Store calls.",
+		Buckets: []float64{0, 1, 5, 10, 50, 100, 500, 1000, 5000},
+	}, []string{"query"})
+)
+
+type MetricsStore struct {
+	store              db.Store
+	slowQueryThreshold time.Duration
+}
+
+// NewMetricsStore wraps store so that every call is recorded in Prometheus and slow calls are logged
+func NewMetricsStore(store db.Store, slowQueryThreshold time.Duration) db.Store {
+	return &MetricsStore{
+		store:              store,
+		slowQueryThreshold: slowQueryThreshold,
+	}
+}
+
+func (store *MetricsStore) observe(query string, startTime time.Time, rows int, err error) {
+	duration := time.Since(startTime)
+	queryDuration.WithLabelValues(query).Observe(duration.Seconds())
+
+	// 查不到记录是正常的业务结果（比如检查用户名是否已经存在），不算作错误
+	result := "ok"
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		result = "not_found"
+	case err != nil:
+		result = "error"
+	}
+	queryTotal.WithLabelValues(query, result).Inc()
+	if err == nil {
+		queryRows.WithLabelValues(query).Observe(float64(rows))
+	}
+
+	if store.slowQueryThreshold > 0 && duration >= store.slowQueryThreshold {
+		log.Warn().Str("query", query).Dur("duration", duration).Int("rows", rows).Err(err).
+			Msg("slow query")
+	}
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+func TestMetricsStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	metricsStore := NewMetricsStore(store, 0)
+
+	account := db.Account{ID: 1, Owner: "alice"}
+	store.EXPECT().GetAccount(gomock.Any(), int64(1)).Times(1).Return(account, nil)
+	store.EXPECT().GetAccount(gomock.Any(), int64(2)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+	store.EXPECT().GetAccount(gomock.Any(), int64(3)).Times(1).Return(db.Account{}, errors.New("connection reset"))
+	store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(1).Return([]db.Account{account, account, account}, nil)
+
+	ok := testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "ok"))
+	notFound := testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "not_found"))
+	failed := testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "error"))
+	rows := rowsSum(t, "ListAccounts")
+
+	ctx := context.Background()
+	result, err := metricsStore.GetAccount(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, account, result)
+
+	_, err = metricsStore.GetAccount(ctx, 2)
+	require.ErrorIs(t, err, db.ErrRecordNotFound)
+
+	_, err = metricsStore.GetAccount(ctx, 3)
+	require.ErrorContains(t, err, "connection reset")
+
+	accounts, err := metricsStore.ListAccounts(ctx, db.ListAccountsParams{Owner: "alice", Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	require.Equal(t, ok+1, testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "ok")))
+	require.Equal(t, notFound+1, testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "not_found")))
+	require.Equal(t, failed+1, testutil.ToFloat64(queryTotal.WithLabelValues("GetAccount", "error")))
+
+	// ListAccounts 返回的行数记录在 db_query_rows 里
+	require.Equal(t, rows+3, rowsSum(t, "ListAccounts"))
+}
+
+func TestMetricsStoreSlowQuery(t *testing.T) {
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetUser(gomock.Any(), "alice").
+		Times(2).
+		DoAndReturn(func(ctx context.Context, username string) (db.User, error) {
+			time.Sleep(20 * time.Millisecond)
+			return db.User{Username: username}, nil
+		})
+
+	_, err := NewMetricsStore(store, 10*time.Millisecond).GetUser(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `"query":"GetUser"`)
+	require.Contains(t, buf.String(), "slow query")
+
+	// 阈值是 0 时不记录慢查询
+	buf.Reset()
+	_, err = NewMetricsStore(store, 0).GetUser(context.Background(), "alice")
+	require.NoError(t, err)
+	require.Empty(t, buf.String())
+}
+
+// rowsSum returns the sum of the db_query_rows histogram of the query
+func rowsSum(t *testing.T, query string) float64 {
+	var metric dto.Metric
+	require.NoError(t, queryRows.WithLabelValues(query).(prometheus.Histogram).Write(&metric))
+	return metric.GetHistogram().GetSampleSum()
+}
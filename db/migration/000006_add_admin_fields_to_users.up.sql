@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "is_disabled" bool NOT NULL DEFAULT false;
+
+ALTER TABLE "users" ADD COLUMN "password_reset_required" bool NOT NULL DEFAULT false;
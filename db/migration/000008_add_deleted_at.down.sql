@@ -0,0 +1,3 @@
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "deleted_at";
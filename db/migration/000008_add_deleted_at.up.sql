@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "deleted_at" timestamptz;
+
+ALTER TABLE "accounts" ADD COLUMN "deleted_at" timestamptz;
@@ -0,0 +1,20 @@
+CREATE TABLE "mandates" (
+  "id" bigserial PRIMARY KEY,
+  "payer_account_id" bigint NOT NULL,
+  "payee_account_id" bigint NOT NULL,
+  "max_amount" bigint NOT NULL,
+  "collected_amount" bigint NOT NULL DEFAULT 0,
+  "is_revoked" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "revoked_at" timestamptz
+);
+
+CREATE INDEX ON "mandates" ("payer_account_id");
+
+CREATE INDEX ON "mandates" ("payee_account_id");
+
+COMMENT ON COLUMN "mandates"."max_amount" IS 'must be positive';
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("payer_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("payee_account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "transfers_from_account_id_category_created_at_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "reference";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "memo";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "category";
@@ -0,0 +1,7 @@
+ALTER TABLE "transfers" ADD COLUMN "category" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+CREATE INDEX ON "transfers" ("from_account_id", "category", "created_at");
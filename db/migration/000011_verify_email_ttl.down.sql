@@ -0,0 +1 @@
+ALTER TABLE "verify_emails" ALTER COLUMN "expired_at" SET DEFAULT (now() + interval '15 minutes');
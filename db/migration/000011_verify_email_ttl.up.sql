@@ -0,0 +1,2 @@
+-- 15 分钟太短了，很多用户第二天才去点邮件里的链接
+ALTER TABLE "verify_emails" ALTER COLUMN "expired_at" SET DEFAULT (now() + interval '24 hours');
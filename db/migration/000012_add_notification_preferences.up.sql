@@ -0,0 +1,11 @@
+CREATE TABLE "notification_preferences" (
+  "username" varchar PRIMARY KEY,
+  "email_enabled" bool NOT NULL DEFAULT true,
+  "sms_enabled" bool NOT NULL DEFAULT false,
+  "push_enabled" bool NOT NULL DEFAULT false,
+  "phone_number" varchar NOT NULL DEFAULT '',
+  "device_token" varchar NOT NULL DEFAULT '',
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
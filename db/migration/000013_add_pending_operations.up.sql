@@ -0,0 +1,16 @@
+CREATE TABLE "pending_operations" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "kind" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "last_error" varchar NOT NULL DEFAULT '',
+  "reversal_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "pending_operations" ("status", "created_at");
+
+ALTER TABLE "pending_operations" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "pending_operations" ADD FOREIGN KEY ("reversal_transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,14 @@
+CREATE TABLE "identities" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "provider" varchar NOT NULL,
+  "subject" varchar NOT NULL,
+  "email" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "identities" ("provider", "subject");
+
+CREATE INDEX ON "identities" ("username");
+
+ALTER TABLE "identities" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
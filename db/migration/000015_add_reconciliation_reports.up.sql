@@ -0,0 +1,22 @@
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "accounts_checked" bigint NOT NULL,
+  "discrepancy_count" bigint NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "reconciliation_discrepancies" (
+  "id" bigserial PRIMARY KEY,
+  "report_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "account_balance" bigint NOT NULL,
+  "entries_balance" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "reconciliation_discrepancies" ("report_id");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("report_id") REFERENCES "reconciliation_reports" ("id");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
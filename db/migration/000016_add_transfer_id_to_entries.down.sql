@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS "entries_balanced" ON "entries";
+DROP FUNCTION IF EXISTS check_transfer_entries();
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "transfer_id";
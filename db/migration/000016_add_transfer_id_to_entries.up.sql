@@ -0,0 +1,46 @@
+ALTER TABLE "entries" ADD COLUMN "transfer_id" bigint;
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "entries" ("transfer_id");
+
+-- 以前的 entries 没有记录 transfer_id。同一个事务里 now() 的值相同，
+-- 所以转账记录和它的两条 entries 的 created_at 完全一样，用账户、金额和时间把它们对上。
+UPDATE "entries" e
+SET "transfer_id" = t."id"
+FROM "transfers" t
+WHERE e."transfer_id" IS NULL
+  AND e."created_at" = t."created_at"
+  AND (
+    (e."account_id" = t."from_account_id" AND e."amount" = -t."amount") OR
+    (e."account_id" = t."to_account_id" AND e."amount" = t."amount")
+  );
+
+-- 属于某笔转账的 entries 在事务提交时必须正好是两条，并且金额相加为 0。
+-- 约束是延迟检查的，所以 transfer() 可以在同一个事务里先后写两条 entries。
+CREATE FUNCTION check_transfer_entries() RETURNS trigger AS $$
+DECLARE
+  entry_count bigint;
+  entry_sum bigint;
+BEGIN
+  IF NEW."transfer_id" IS NULL THEN
+    RETURN NULL;
+  END IF;
+
+  SELECT count(*), COALESCE(sum("amount"), 0) INTO entry_count, entry_sum
+  FROM "entries"
+  WHERE "transfer_id" = NEW."transfer_id";
+
+  IF entry_count <> 2 OR entry_sum <> 0 THEN
+    RAISE EXCEPTION 'transfer % must have exactly two balancing entries', NEW."transfer_id"
+      USING ERRCODE = 'check_violation';
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER "entries_balanced"
+AFTER INSERT OR UPDATE OF "transfer_id", "amount" ON "entries"
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_transfer_entries();
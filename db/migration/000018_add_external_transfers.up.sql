@@ -0,0 +1,26 @@
+CREATE TABLE "external_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "hold_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "routing_number" varchar NOT NULL,
+  "account_number" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'initiated',
+  "return_reason" varchar NOT NULL DEFAULT '',
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "external_transfers" ("account_id");
+
+CREATE INDEX ON "external_transfers" ("status", "created_at");
+
+COMMENT ON COLUMN "external_transfers"."amount" IS 'must be positive';
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS "holds" DROP COLUMN IF EXISTS "card_id";
+
+DROP TABLE IF EXISTS "cards";
@@ -0,0 +1,19 @@
+CREATE TABLE "cards" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "pan_hash" varchar UNIQUE NOT NULL,
+  "last4" varchar NOT NULL,
+  "expiry_month" int NOT NULL,
+  "expiry_year" int NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "cards" ("account_id");
+
+ALTER TABLE "cards" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD COLUMN "card_id" bigint;
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("card_id") REFERENCES "cards" ("id");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "loan_payments";
+
+DROP TABLE IF EXISTS "loans";
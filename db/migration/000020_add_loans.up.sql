@@ -0,0 +1,53 @@
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate_bps" int NOT NULL,
+  "term_months" int NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "funding_account_id" bigint,
+  "disbursement_transfer_id" bigint,
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_payments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "installment" int NOT NULL,
+  "due_date" timestamptz NOT NULL,
+  "principal" bigint NOT NULL,
+  "interest" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'scheduled',
+  "transfer_id" bigint,
+  "paid_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE INDEX ON "loans" ("status");
+
+CREATE UNIQUE INDEX ON "loan_payments" ("loan_id", "installment");
+
+CREATE INDEX ON "loan_payments" ("status", "due_date");
+
+COMMENT ON COLUMN "loans"."principal" IS 'must be positive';
+
+COMMENT ON COLUMN "loans"."annual_rate_bps" IS 'annual interest rate in basis points, 800 = 8%';
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("funding_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursement_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loan_payments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_payments" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,23 @@
+CREATE TABLE "kyc_documents" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "document_type" varchar NOT NULL,
+  "file_key" varchar NOT NULL,
+  "content_type" varchar NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "rejection_reason" varchar NOT NULL DEFAULT '',
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "kyc_documents" ("username");
+
+CREATE INDEX ON "kyc_documents" ("status", "created_at");
+
+COMMENT ON COLUMN "kyc_documents"."file_key" IS 'key of the uploaded file in the blob store';
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
@@ -0,0 +1,14 @@
+CREATE TABLE "outbox_events" (
+  "id" bigserial PRIMARY KEY,
+  "event_type" varchar NOT NULL,
+  "event_key" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "published_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "outbox_events" ("id") WHERE "published_at" IS NULL;
+
+CREATE INDEX ON "outbox_events" ("created_at");
+
+COMMENT ON COLUMN "outbox_events"."event_key" IS 'partition key, events with the same key are delivered in order';
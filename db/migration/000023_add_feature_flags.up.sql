@@ -0,0 +1,10 @@
+CREATE TABLE "feature_flags" (
+  "name" varchar PRIMARY KEY,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "rollout_percentage" integer NOT NULL DEFAULT 100 CHECK ("rollout_percentage" BETWEEN 0 AND 100),
+  "description" varchar NOT NULL DEFAULT '',
+  "updated_by" varchar NOT NULL DEFAULT '',
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "feature_flags"."rollout_percentage" IS 'percentage of users that see the feature when it is enabled';
@@ -0,0 +1,15 @@
+CREATE TABLE "user_devices" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "fingerprint" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "first_seen_at" timestamptz NOT NULL DEFAULT (now()),
+  "last_seen_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "user_devices" ("username", "fingerprint");
+
+COMMENT ON COLUMN "user_devices"."fingerprint" IS 'hash of the user agent and the network of the client ip';
+
+ALTER TABLE "user_devices" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
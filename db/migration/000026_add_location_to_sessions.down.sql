@@ -0,0 +1,2 @@
+ALTER TABLE "sessions" DROP COLUMN "city";
+ALTER TABLE "sessions" DROP COLUMN "country";
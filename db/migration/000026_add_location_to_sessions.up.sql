@@ -0,0 +1,4 @@
+ALTER TABLE "sessions" ADD COLUMN "country" varchar NOT NULL DEFAULT '';
+ALTER TABLE "sessions" ADD COLUMN "city" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "sessions"."country" IS 'ISO country code of the client ip, empty if unknown';
@@ -0,0 +1,20 @@
+CREATE TABLE "audit_logs" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "resource_type" varchar NOT NULL,
+  "resource_id" varchar NOT NULL,
+  "details" jsonb NOT NULL DEFAULT '{}',
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "country" varchar NOT NULL DEFAULT '',
+  "city" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_logs" ("resource_type", "resource_id", "created_at");
+
+CREATE INDEX ON "audit_logs" ("actor", "created_at");
+
+COMMENT ON COLUMN "audit_logs"."actor" IS 'username of the user who made the change';
+
+ALTER TABLE "audit_logs" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
@@ -0,0 +1,2 @@
+ALTER TABLE "accounts" DROP COLUMN "updated_at";
+ALTER TABLE "users" DROP COLUMN "updated_at";
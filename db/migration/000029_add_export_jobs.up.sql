@@ -0,0 +1,22 @@
+CREATE TABLE "export_jobs" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "processed_rows" bigint NOT NULL DEFAULT 0,
+  "total_rows" bigint NOT NULL DEFAULT 0,
+  "file_key" varchar NOT NULL DEFAULT '',
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz
+);
+
+CREATE INDEX ON "export_jobs" ("owner", "created_at");
+
+COMMENT ON COLUMN "export_jobs"."owner" IS 'username of the user who started the export';
+
+ALTER TABLE "export_jobs" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "export_jobs" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,2 @@
+ALTER TABLE "users" DROP COLUMN "last_verify_email_reminder_at";
+ALTER TABLE "users" DROP COLUMN "verify_email_reminders";
@@ -0,0 +1,4 @@
+ALTER TABLE "users" ADD COLUMN "verify_email_reminders" int NOT NULL DEFAULT 0;
+ALTER TABLE "users" ADD COLUMN "last_verify_email_reminder_at" timestamptz;
+
+CREATE INDEX ON "users" ("created_at") WHERE "is_email_verified" = false AND "is_disabled" = false AND "deleted_at" IS NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE "balance_alerts" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "threshold" bigint NOT NULL CHECK ("threshold" > 0),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "balance_alerts" ("account_id", "kind");
+
+COMMENT ON COLUMN "balance_alerts"."kind" IS 'low_balance or large_debit';
+
+COMMENT ON COLUMN "balance_alerts"."threshold" IS 'in the smallest unit of the account currency';
+
+ALTER TABLE "balance_alerts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "dispute_notes";
+
+DROP TABLE IF EXISTS "disputes";
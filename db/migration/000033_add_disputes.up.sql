@@ -0,0 +1,50 @@
+CREATE TABLE "disputes" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "opened_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "resolution" varchar NOT NULL DEFAULT '',
+  "resolved_by" varchar,
+  "refund_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "resolved_at" timestamptz
+);
+
+CREATE TABLE "dispute_notes" (
+  "id" bigserial PRIMARY KEY,
+  "dispute_id" bigint NOT NULL,
+  "author" varchar NOT NULL,
+  "note" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "disputes" ("opened_by");
+
+CREATE INDEX ON "disputes" ("status", "created_at");
+
+-- 一笔转账同时只能有一个没有处理完的争议，被拒绝之后可以再开一个
+CREATE UNIQUE INDEX ON "disputes" ("transfer_id") WHERE "status" IN ('open', 'under_review');
+
+CREATE INDEX ON "dispute_notes" ("dispute_id");
+
+COMMENT ON COLUMN "disputes"."opened_by" IS 'username of the user who opened the dispute';
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, under_review, refunded or rejected';
+
+COMMENT ON COLUMN "disputes"."refund_transfer_id" IS 'the reversal of the disputed transfer when the dispute is refunded';
+
+COMMENT ON COLUMN "dispute_notes"."author" IS 'username of the banker who wrote the note';
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("opened_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("refund_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "dispute_notes" ADD FOREIGN KEY ("dispute_id") REFERENCES "disputes" ("id");
+
+ALTER TABLE "dispute_notes" ADD FOREIGN KEY ("author") REFERENCES "users" ("username");
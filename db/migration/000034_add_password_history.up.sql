@@ -0,0 +1,12 @@
+CREATE TABLE "password_history" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "hashed_password" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "password_history" ("username", "id");
+
+COMMENT ON COLUMN "password_history"."hashed_password" IS 'a previous password of the user, replaced at created_at';
+
+ALTER TABLE "password_history" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
@@ -0,0 +1,17 @@
+ALTER TABLE "accounts" ADD COLUMN "number" varchar;
+
+-- 给已有的账户补上账号，格式和校验位的算法和 util.GenerateAccountNumber 一样：
+-- 'SB' 换成数字是 2811，校验位 = 98 - (16 位数字 || '281100') mod 97
+UPDATE "accounts"
+SET "number" = 'SB' || lpad((98 - mod((generated.digits || '281100')::numeric, 97))::text, 2, '0') || generated.digits
+FROM (
+  SELECT "id", lpad(floor(random() * 1e16)::bigint::text, 16, '0') AS digits
+  FROM "accounts"
+) AS generated
+WHERE "accounts"."id" = generated."id";
+
+ALTER TABLE "accounts" ALTER COLUMN "number" SET NOT NULL;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_number_key" UNIQUE ("number");
+
+COMMENT ON COLUMN "accounts"."number" IS 'IBAN-like account number given to customers, see util.GenerateAccountNumber';
@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "allow_alias_transfers" bool NOT NULL DEFAULT true;
+
+COMMENT ON COLUMN "users"."allow_alias_transfers" IS 'whether other users may send money by username or email';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "accounts_owner_currency_default_key";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "is_default";
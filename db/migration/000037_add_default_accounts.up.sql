@@ -0,0 +1,6 @@
+ALTER TABLE "accounts" ADD COLUMN "is_default" bool NOT NULL DEFAULT false;
+
+-- 每个用户每种货币最多只有一个默认账户，关闭的账户不算
+CREATE UNIQUE INDEX "accounts_owner_currency_default_key" ON "accounts" ("owner", "currency") WHERE "is_default" AND "deleted_at" IS NULL;
+
+COMMENT ON COLUMN "accounts"."is_default" IS 'receives transfers addressed to the owner by username or email in this currency';
@@ -0,0 +1,33 @@
+CREATE TABLE "transfer_quotes" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "from_amount" bigint NOT NULL,
+  "fee" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "rate" varchar NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "debit_transfer_id" bigint,
+  "credit_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "transfer_quotes" ("username");
+
+COMMENT ON COLUMN "transfer_quotes"."from_amount" IS 'debited from the sender, fee included';
+
+COMMENT ON COLUMN "transfer_quotes"."fee" IS 'in from_currency, kept in the FX account';
+
+COMMENT ON COLUMN "transfer_quotes"."rate" IS 'decimal string, units of to_currency per unit of from_currency';
+
+COMMENT ON COLUMN "transfer_quotes"."debit_transfer_id" IS 'sender account to the FX account in from_currency';
+
+COMMENT ON COLUMN "transfer_quotes"."credit_transfer_id" IS 'FX account in to_currency to the recipient account';
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("debit_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("credit_transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "transfers_fee_of_idx";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee_of";
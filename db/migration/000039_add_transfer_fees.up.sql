@@ -0,0 +1,7 @@
+ALTER TABLE "transfers" ADD COLUMN "fee_of" bigint;
+
+CREATE UNIQUE INDEX ON "transfers" ("fee_of");
+
+COMMENT ON COLUMN "transfers"."fee_of" IS 'the transfer this fee was charged for, see TRANSFER_FEES';
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fee_of") REFERENCES "transfers" ("id");
@@ -0,0 +1,38 @@
+CREATE TABLE "referral_codes" (
+  "username" varchar PRIMARY KEY,
+  "code" varchar UNIQUE NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "referrals" (
+  "id" bigserial PRIMARY KEY,
+  "referrer" varchar NOT NULL,
+  "referred" varchar UNIQUE NOT NULL,
+  "signup_ip" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "reject_reason" varchar NOT NULL DEFAULT '',
+  "referrer_transfer_id" bigint,
+  "referred_transfer_id" bigint,
+  "credited_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "referrals" ("referrer", "status");
+
+CREATE INDEX ON "referrals" ("signup_ip", "status");
+
+CREATE INDEX ON "referrals" ("status", "id");
+
+COMMENT ON COLUMN "referrals"."signup_ip" IS 'client IP of the referred user at sign up, bonuses per IP are limited';
+
+COMMENT ON COLUMN "referrals"."status" IS 'pending, credited or rejected';
+
+ALTER TABLE "referral_codes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referrer") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referred") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referrer_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referred_transfer_id") REFERENCES "transfers" ("id");
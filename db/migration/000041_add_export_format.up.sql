@@ -0,0 +1,3 @@
+ALTER TABLE "export_jobs" ADD COLUMN "format" varchar NOT NULL DEFAULT 'csv';
+
+COMMENT ON COLUMN "export_jobs"."format" IS 'csv, ofx, qif or camt053';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS "users_signup_created_at_idx";
+
+DROP INDEX IF EXISTS "entries_created_at_idx";
+
+DROP INDEX IF EXISTS "transfers_created_at_idx";
+
+DROP TABLE IF EXISTS "failed_logins";
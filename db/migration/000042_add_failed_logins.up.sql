@@ -0,0 +1,19 @@
+CREATE TABLE "failed_logins" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "failed_logins" ("created_at");
+
+CREATE INDEX ON "transfers" ("created_at");
+
+CREATE INDEX ON "entries" ("created_at");
+
+CREATE INDEX "users_signup_created_at_idx" ON "users" ("created_at");
+
+COMMENT ON COLUMN "failed_logins"."username" IS 'username in the login request, the user may not exist';
+
+COMMENT ON COLUMN "failed_logins"."reason" IS 'user_not_found or incorrect_password';
@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "deletion_scheduled_at" timestamptz;
+
+COMMENT ON COLUMN "users"."deletion_scheduled_at" IS 'when a pending account deletion requested by the user takes effect, NULL if there is none';
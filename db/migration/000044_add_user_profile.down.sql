@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS "users"
+  DROP COLUMN IF EXISTS "avatar_key",
+  DROP COLUMN IF EXISTS "address",
+  DROP COLUMN IF EXISTS "date_of_birth",
+  DROP COLUMN IF EXISTS "phone_number";
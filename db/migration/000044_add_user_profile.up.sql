@@ -0,0 +1,8 @@
+ALTER TABLE "users"
+  ADD COLUMN "phone_number" varchar NOT NULL DEFAULT '',
+  ADD COLUMN "date_of_birth" date,
+  ADD COLUMN "address" varchar NOT NULL DEFAULT '',
+  ADD COLUMN "avatar_key" varchar NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN "users"."phone_number" IS 'E.164 format, e.g. +14155550123';
+COMMENT ON COLUMN "users"."avatar_key" IS 'blob store key of the uploaded avatar, empty if there is none';
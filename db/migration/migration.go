@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+/*
+迁移文件用 go:embed 编译进二进制，部署的时候不用再带上 db/migration 目录，
+二进制和它需要的数据库结构也一定是同一个版本。
+MIGRATION_URL 不为空时（比如 file://db/migration）仍然从这个地址读取迁移文件，方便本地调试新的迁移。
+*/
+//go:embed *.sql
+var files embed.FS
+
+// Status is the schema version of the database compared with the migrations of this build
+type Status struct {
+	// Version is the last applied migration, 0 when no migration has been applied
+	Version uint
+	// Dirty means the last migration failed halfway and the schema must be fixed by hand
+	Dirty bool
+	// LatestVersion is the newest migration known to this build
+	LatestVersion uint
+	// Pending is the number of migrations that have not been applied yet
+	Pending int
+}
+
+// Behind reports whether the database needs migrations before this build can serve traffic
+func (status Status) Behind() bool {
+	return status.Dirty || status.Pending > 0
+}
+
+// Migrator wraps migrate.Migrate together with the migration source, so the status can compare both
+type Migrator struct {
+	*migrate.Migrate
+	source source.Driver
+}
+
+// New creates a Migrator using the embedded migrations, or migrationURL when it is not empty
+func New(migrationURL string, dbSource string) (*Migrator, error) {
+	sourceDriver, err := openSource(migrationURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open migration source: %w", err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("migrations", sourceDriver, dbSource)
+	if err != nil {
+		sourceDriver.Close()
+		return nil, fmt.Errorf("cannot create new migrate instance: %w", err)
+	}
+
+	return &Migrator{
+		Migrate: m,
+		source:  sourceDriver,
+	}, nil
+}
+
+func openSource(migrationURL string) (source.Driver, error) {
+	if migrationURL != "" {
+		return source.Open(migrationURL)
+	}
+	return iofs.New(files, ".")
+}
+
+// Up applies all pending migrations, it is not an error when there is nothing to apply
+func (migrator *Migrator) Up() error {
+	if err := migrator.Migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// Close closes the source and the database connections
+func (migrator *Migrator) Close() error {
+	sourceErr, dbErr := migrator.Migrate.Close()
+	return errors.Join(sourceErr, dbErr)
+}
+
+// Status compares the version recorded in the database with the migrations of this build
+func (migrator *Migrator) Status() (Status, error) {
+	var status Status
+
+	version, dirty, err := migrator.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return status, fmt.Errorf("cannot get migration version: %w", err)
+	}
+	status.Version = version
+	status.Dirty = dirty
+
+	versions, err := sourceVersions(migrator.source)
+	if err != nil {
+		return status, fmt.Errorf("cannot list migrations: %w", err)
+	}
+	for _, v := range versions {
+		if v > status.Version {
+			status.Pending++
+		}
+		status.LatestVersion = v
+	}
+
+	return status, nil
+}
+
+// CurrentStatus opens a short-lived Migrator to read the status, used by the admin API
+func CurrentStatus(migrationURL string, dbSource string) (Status, error) {
+	migrator, err := New(migrationURL, dbSource)
+	if err != nil {
+		return Status{}, err
+	}
+	defer migrator.Close()
+
+	return migrator.Status()
+}
+
+// sourceVersions returns all migration versions of the source in ascending order
+func sourceVersions(sourceDriver source.Driver) ([]uint, error) {
+	version, err := sourceDriver.First()
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []uint{version}
+	for {
+		version, err = sourceDriver.Next(version)
+		if errors.Is(err, fs.ErrNotExist) {
+			return versions, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+}
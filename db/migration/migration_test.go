@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	sourceDriver, err := iofs.New(files, ".")
+	require.NoError(t, err)
+	defer sourceDriver.Close()
+
+	versions, err := sourceVersions(sourceDriver)
+	require.NoError(t, err)
+	require.NotEmpty(t, versions)
+	require.Equal(t, uint(1), versions[0])
+
+	for i := 1; i < len(versions); i++ {
+		require.Greater(t, versions[i], versions[i-1])
+	}
+
+	// 每个迁移都要有 up 和 down 两个文件
+	entries, err := files.ReadDir(".")
+	require.NoError(t, err)
+	require.Len(t, entries, 2*len(versions))
+}
+
+func TestStatusBehind(t *testing.T) {
+	require.False(t, Status{Version: 3, LatestVersion: 3}.Behind())
+	require.True(t, Status{Version: 2, LatestVersion: 3, Pending: 1}.Behind())
+	require.True(t, Status{Version: 3, LatestVersion: 3, Dirty: true}.Behind())
+	// 数据库比二进制新（回滚发布的时候）不算落后
+	require.False(t, Status{Version: 4, LatestVersion: 3}.Behind())
+}
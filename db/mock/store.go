@@ -51,258 +51,2823 @@ func (mr *MockStoreMockRecorder) AddAccountBalance(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), arg0, arg1)
 }
 
+// AddDisputeNoteTx mocks base method.
+func (m *MockStore) AddDisputeNoteTx(arg0 context.Context, arg1 db.AddDisputeNoteTxParams) (db.AddDisputeNoteTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDisputeNoteTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AddDisputeNoteTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDisputeNoteTx indicates an expected call of AddDisputeNoteTx.
+func (mr *MockStoreMockRecorder) AddDisputeNoteTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDisputeNoteTx", reflect.TypeOf((*MockStore)(nil).AddDisputeNoteTx), arg0, arg1)
+}
+
+// AddMandateCollectedAmount mocks base method.
+func (m *MockStore) AddMandateCollectedAmount(arg0 context.Context, arg1 db.AddMandateCollectedAmountParams) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMandateCollectedAmount", arg0, arg1)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMandateCollectedAmount indicates an expected call of AddMandateCollectedAmount.
+func (mr *MockStoreMockRecorder) AddMandateCollectedAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMandateCollectedAmount", reflect.TypeOf((*MockStore)(nil).AddMandateCollectedAmount), arg0, arg1)
+}
+
+// AdvanceExternalTransferTx mocks base method.
+func (m *MockStore) AdvanceExternalTransferTx(arg0 context.Context, arg1 db.AdvanceExternalTransferTxParams) (db.AdvanceExternalTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceExternalTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AdvanceExternalTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceExternalTransferTx indicates an expected call of AdvanceExternalTransferTx.
+func (mr *MockStoreMockRecorder) AdvanceExternalTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceExternalTransferTx", reflect.TypeOf((*MockStore)(nil).AdvanceExternalTransferTx), arg0, arg1)
+}
+
+// AnonymizeUser mocks base method.
+func (m *MockStore) AnonymizeUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnonymizeUser indicates an expected call of AnonymizeUser.
+func (mr *MockStoreMockRecorder) AnonymizeUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeUser", reflect.TypeOf((*MockStore)(nil).AnonymizeUser), arg0, arg1)
+}
+
+// AnonymizeUserTx mocks base method.
+func (m *MockStore) AnonymizeUserTx(arg0 context.Context, arg1 db.AnonymizeUserTxParams) (db.AnonymizeUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AnonymizeUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnonymizeUserTx indicates an expected call of AnonymizeUserTx.
+func (mr *MockStoreMockRecorder) AnonymizeUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeUserTx", reflect.TypeOf((*MockStore)(nil).AnonymizeUserTx), arg0, arg1)
+}
+
+// ApproveLoan mocks base method.
+func (m *MockStore) ApproveLoan(arg0 context.Context, arg1 db.ApproveLoanParams) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveLoan", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveLoan indicates an expected call of ApproveLoan.
+func (mr *MockStoreMockRecorder) ApproveLoan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveLoan", reflect.TypeOf((*MockStore)(nil).ApproveLoan), arg0, arg1)
+}
+
+// ApproveLoanTx mocks base method.
+func (m *MockStore) ApproveLoanTx(arg0 context.Context, arg1 db.ApproveLoanTxParams) (db.ApproveLoanTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveLoanTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ApproveLoanTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveLoanTx indicates an expected call of ApproveLoanTx.
+func (mr *MockStoreMockRecorder) ApproveLoanTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveLoanTx", reflect.TypeOf((*MockStore)(nil).ApproveLoanTx), arg0, arg1)
+}
+
+// AuthorizeCardTx mocks base method.
+func (m *MockStore) AuthorizeCardTx(arg0 context.Context, arg1 db.AuthorizeCardTxParams) (db.AuthorizeCardTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeCardTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AuthorizeCardTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeCardTx indicates an expected call of AuthorizeCardTx.
+func (mr *MockStoreMockRecorder) AuthorizeCardTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeCardTx", reflect.TypeOf((*MockStore)(nil).AuthorizeCardTx), arg0, arg1)
+}
+
+// BackdateTransfer mocks base method.
+func (m *MockStore) BackdateTransfer(arg0 context.Context, arg1 db.BackdateTransferParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackdateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackdateTransfer indicates an expected call of BackdateTransfer.
+func (mr *MockStoreMockRecorder) BackdateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackdateTransfer", reflect.TypeOf((*MockStore)(nil).BackdateTransfer), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockUserSessions mocks base method.
+func (m *MockStore) BlockUserSessions(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUserSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUserSessions indicates an expected call of BlockUserSessions.
+func (mr *MockStoreMockRecorder) BlockUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockStore)(nil).BlockUserSessions), arg0, arg1)
+}
+
+// BulkTransferTx mocks base method.
+func (m *MockStore) BulkTransferTx(arg0 context.Context, arg1 db.BulkTransferTxParams) (db.BulkTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.BulkTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkTransferTx indicates an expected call of BulkTransferTx.
+func (mr *MockStoreMockRecorder) BulkTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTransferTx", reflect.TypeOf((*MockStore)(nil).BulkTransferTx), arg0, arg1)
+}
+
+// CancelUserDeletion mocks base method.
+func (m *MockStore) CancelUserDeletion(arg0 context.Context, arg1 db.CancelUserDeletionParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserDeletion", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUserDeletion indicates an expected call of CancelUserDeletion.
+func (mr *MockStoreMockRecorder) CancelUserDeletion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletion", reflect.TypeOf((*MockStore)(nil).CancelUserDeletion), arg0, arg1)
+}
+
+// CaptureHold mocks base method.
+func (m *MockStore) CaptureHold(arg0 context.Context, arg1 db.CaptureHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockStoreMockRecorder) CaptureHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockStore)(nil).CaptureHold), arg0, arg1)
+}
+
+// CaptureHoldTx mocks base method.
+func (m *MockStore) CaptureHoldTx(arg0 context.Context, arg1 db.CaptureHoldTxParams) (db.CaptureHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CaptureHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHoldTx indicates an expected call of CaptureHoldTx.
+func (mr *MockStoreMockRecorder) CaptureHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHoldTx", reflect.TypeOf((*MockStore)(nil).CaptureHoldTx), arg0, arg1)
+}
+
+// ChangePasswordTx mocks base method.
+func (m *MockStore) ChangePasswordTx(arg0 context.Context, arg1 db.ChangePasswordTxParams) (db.ChangePasswordTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePasswordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ChangePasswordTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePasswordTx indicates an expected call of ChangePasswordTx.
+func (mr *MockStoreMockRecorder) ChangePasswordTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePasswordTx", reflect.TypeOf((*MockStore)(nil).ChangePasswordTx), arg0, arg1)
+}
+
+// ClearDefaultAccount mocks base method.
+func (m *MockStore) ClearDefaultAccount(arg0 context.Context, arg1 db.ClearDefaultAccountParams) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDefaultAccount", arg0, arg1)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearDefaultAccount indicates an expected call of ClearDefaultAccount.
+func (mr *MockStoreMockRecorder) ClearDefaultAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDefaultAccount", reflect.TypeOf((*MockStore)(nil).ClearDefaultAccount), arg0, arg1)
+}
+
+// CloseUserAccounts mocks base method.
+func (m *MockStore) CloseUserAccounts(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseUserAccounts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseUserAccounts indicates an expected call of CloseUserAccounts.
+func (mr *MockStoreMockRecorder) CloseUserAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseUserAccounts", reflect.TypeOf((*MockStore)(nil).CloseUserAccounts), arg0, arg1)
+}
+
+// CollectLoanPaymentTx mocks base method.
+func (m *MockStore) CollectLoanPaymentTx(arg0 context.Context, arg1 db.CollectLoanPaymentTxParams) (db.CollectLoanPaymentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectLoanPaymentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CollectLoanPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectLoanPaymentTx indicates an expected call of CollectLoanPaymentTx.
+func (mr *MockStoreMockRecorder) CollectLoanPaymentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectLoanPaymentTx", reflect.TypeOf((*MockStore)(nil).CollectLoanPaymentTx), arg0, arg1)
+}
+
+// CollectPaymentTx mocks base method.
+func (m *MockStore) CollectPaymentTx(arg0 context.Context, arg1 db.CollectPaymentTxParams) (db.CollectPaymentTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectPaymentTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CollectPaymentTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectPaymentTx indicates an expected call of CollectPaymentTx.
+func (mr *MockStoreMockRecorder) CollectPaymentTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectPaymentTx", reflect.TypeOf((*MockStore)(nil).CollectPaymentTx), arg0, arg1)
+}
+
+// ConfirmPendingOperationTx mocks base method.
+func (m *MockStore) ConfirmPendingOperationTx(arg0 context.Context, arg1 db.ConfirmPendingOperationTxParams) (db.ConfirmPendingOperationTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPendingOperationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ConfirmPendingOperationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmPendingOperationTx indicates an expected call of ConfirmPendingOperationTx.
+func (mr *MockStoreMockRecorder) ConfirmPendingOperationTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPendingOperationTx", reflect.TypeOf((*MockStore)(nil).ConfirmPendingOperationTx), arg0, arg1)
+}
+
+// CountAccountEntries mocks base method.
+func (m *MockStore) CountAccountEntries(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccountEntries", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccountEntries indicates an expected call of CountAccountEntries.
+func (mr *MockStoreMockRecorder) CountAccountEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccountEntries", reflect.TypeOf((*MockStore)(nil).CountAccountEntries), arg0, arg1)
+}
+
+// CountAccountTransfers mocks base method.
+func (m *MockStore) CountAccountTransfers(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccountTransfers", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccountTransfers indicates an expected call of CountAccountTransfers.
+func (mr *MockStoreMockRecorder) CountAccountTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccountTransfers", reflect.TypeOf((*MockStore)(nil).CountAccountTransfers), arg0, arg1)
+}
+
+// CountAccountsWithBalance mocks base method.
+func (m *MockStore) CountAccountsWithBalance(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccountsWithBalance", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccountsWithBalance indicates an expected call of CountAccountsWithBalance.
+func (mr *MockStoreMockRecorder) CountAccountsWithBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccountsWithBalance", reflect.TypeOf((*MockStore)(nil).CountAccountsWithBalance), arg0, arg1)
+}
+
+// CountAllAccounts mocks base method.
+func (m *MockStore) CountAllAccounts(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllAccounts", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllAccounts indicates an expected call of CountAllAccounts.
+func (mr *MockStoreMockRecorder) CountAllAccounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllAccounts", reflect.TypeOf((*MockStore)(nil).CountAllAccounts), arg0)
+}
+
+// CountAllTransfers mocks base method.
+func (m *MockStore) CountAllTransfers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllTransfers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllTransfers indicates an expected call of CountAllTransfers.
+func (mr *MockStoreMockRecorder) CountAllTransfers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllTransfers", reflect.TypeOf((*MockStore)(nil).CountAllTransfers), arg0)
+}
+
+// CountCreditedReferralsByReferrer mocks base method.
+func (m *MockStore) CountCreditedReferralsByReferrer(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCreditedReferralsByReferrer", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCreditedReferralsByReferrer indicates an expected call of CountCreditedReferralsByReferrer.
+func (mr *MockStoreMockRecorder) CountCreditedReferralsByReferrer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCreditedReferralsByReferrer", reflect.TypeOf((*MockStore)(nil).CountCreditedReferralsByReferrer), arg0, arg1)
+}
+
+// CountCreditedReferralsBySignupIP mocks base method.
+func (m *MockStore) CountCreditedReferralsBySignupIP(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCreditedReferralsBySignupIP", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCreditedReferralsBySignupIP indicates an expected call of CountCreditedReferralsBySignupIP.
+func (mr *MockStoreMockRecorder) CountCreditedReferralsBySignupIP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCreditedReferralsBySignupIP", reflect.TypeOf((*MockStore)(nil).CountCreditedReferralsBySignupIP), arg0, arg1)
+}
+
+// CountOverdueLoanPayments mocks base method.
+func (m *MockStore) CountOverdueLoanPayments(arg0 context.Context, arg1 db.CountOverdueLoanPaymentsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOverdueLoanPayments", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOverdueLoanPayments indicates an expected call of CountOverdueLoanPayments.
+func (mr *MockStoreMockRecorder) CountOverdueLoanPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOverdueLoanPayments", reflect.TypeOf((*MockStore)(nil).CountOverdueLoanPayments), arg0, arg1)
+}
+
+// CountUnpaidLoanPayments mocks base method.
+func (m *MockStore) CountUnpaidLoanPayments(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnpaidLoanPayments", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnpaidLoanPayments indicates an expected call of CountUnpaidLoanPayments.
+func (mr *MockStoreMockRecorder) CountUnpaidLoanPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnpaidLoanPayments", reflect.TypeOf((*MockStore)(nil).CountUnpaidLoanPayments), arg0, arg1)
+}
+
+// CountUserDevices mocks base method.
+func (m *MockStore) CountUserDevices(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserDevices", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserDevices indicates an expected call of CountUserDevices.
+func (mr *MockStoreMockRecorder) CountUserDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserDevices", reflect.TypeOf((*MockStore)(nil).CountUserDevices), arg0, arg1)
+}
+
 // CreateAccount mocks base method.
 func (m *MockStore) CreateAccount(arg0 context.Context, arg1 db.CreateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), arg0, arg1)
+}
+
+// CreateAdminUser mocks base method.
+func (m *MockStore) CreateAdminUser(arg0 context.Context, arg1 db.CreateAdminUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminUser indicates an expected call of CreateAdminUser.
+func (mr *MockStoreMockRecorder) CreateAdminUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminUser", reflect.TypeOf((*MockStore)(nil).CreateAdminUser), arg0, arg1)
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockStore) CreateAuditLog(arg0 context.Context, arg1 db.CreateAuditLogParams) (db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", arg0, arg1)
+	ret0, _ := ret[0].(db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockStoreMockRecorder) CreateAuditLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockStore)(nil).CreateAuditLog), arg0, arg1)
+}
+
+// CreateBalanceAlert mocks base method.
+func (m *MockStore) CreateBalanceAlert(arg0 context.Context, arg1 db.CreateBalanceAlertParams) (db.BalanceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceAlert", arg0, arg1)
+	ret0, _ := ret[0].(db.BalanceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceAlert indicates an expected call of CreateBalanceAlert.
+func (mr *MockStoreMockRecorder) CreateBalanceAlert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceAlert", reflect.TypeOf((*MockStore)(nil).CreateBalanceAlert), arg0, arg1)
+}
+
+// CreateCard mocks base method.
+func (m *MockStore) CreateCard(arg0 context.Context, arg1 db.CreateCardParams) (db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCard", arg0, arg1)
+	ret0, _ := ret[0].(db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCard indicates an expected call of CreateCard.
+func (mr *MockStoreMockRecorder) CreateCard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCard", reflect.TypeOf((*MockStore)(nil).CreateCard), arg0, arg1)
+}
+
+// CreateDispute mocks base method.
+func (m *MockStore) CreateDispute(arg0 context.Context, arg1 db.CreateDisputeParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDispute", arg0, arg1)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDispute indicates an expected call of CreateDispute.
+func (mr *MockStoreMockRecorder) CreateDispute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDispute", reflect.TypeOf((*MockStore)(nil).CreateDispute), arg0, arg1)
+}
+
+// CreateDisputeNote mocks base method.
+func (m *MockStore) CreateDisputeNote(arg0 context.Context, arg1 db.CreateDisputeNoteParams) (db.DisputeNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDisputeNote", arg0, arg1)
+	ret0, _ := ret[0].(db.DisputeNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDisputeNote indicates an expected call of CreateDisputeNote.
+func (mr *MockStoreMockRecorder) CreateDisputeNote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisputeNote", reflect.TypeOf((*MockStore)(nil).CreateDisputeNote), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+}
+
+// CreateExportJob mocks base method.
+func (m *MockStore) CreateExportJob(arg0 context.Context, arg1 db.CreateExportJobParams) (db.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExportJob indicates an expected call of CreateExportJob.
+func (mr *MockStoreMockRecorder) CreateExportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExportJob", reflect.TypeOf((*MockStore)(nil).CreateExportJob), arg0, arg1)
+}
+
+// CreateExternalTransfer mocks base method.
+func (m *MockStore) CreateExternalTransfer(arg0 context.Context, arg1 db.CreateExternalTransferParams) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalTransfer indicates an expected call of CreateExternalTransfer.
+func (mr *MockStoreMockRecorder) CreateExternalTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalTransfer", reflect.TypeOf((*MockStore)(nil).CreateExternalTransfer), arg0, arg1)
+}
+
+// CreateExternalTransferTx mocks base method.
+func (m *MockStore) CreateExternalTransferTx(arg0 context.Context, arg1 db.CreateExternalTransferTxParams) (db.CreateExternalTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExternalTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateExternalTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExternalTransferTx indicates an expected call of CreateExternalTransferTx.
+func (mr *MockStoreMockRecorder) CreateExternalTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExternalTransferTx", reflect.TypeOf((*MockStore)(nil).CreateExternalTransferTx), arg0, arg1)
+}
+
+// CreateFailedLogin mocks base method.
+func (m *MockStore) CreateFailedLogin(arg0 context.Context, arg1 db.CreateFailedLoginParams) (db.FailedLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFailedLogin", arg0, arg1)
+	ret0, _ := ret[0].(db.FailedLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFailedLogin indicates an expected call of CreateFailedLogin.
+func (mr *MockStoreMockRecorder) CreateFailedLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFailedLogin", reflect.TypeOf((*MockStore)(nil).CreateFailedLogin), arg0, arg1)
+}
+
+// CreateHold mocks base method.
+func (m *MockStore) CreateHold(arg0 context.Context, arg1 db.CreateHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockStoreMockRecorder) CreateHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockStore)(nil).CreateHold), arg0, arg1)
+}
+
+// CreateIdentity mocks base method.
+func (m *MockStore) CreateIdentity(arg0 context.Context, arg1 db.CreateIdentityParams) (db.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIdentity indicates an expected call of CreateIdentity.
+func (mr *MockStoreMockRecorder) CreateIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdentity", reflect.TypeOf((*MockStore)(nil).CreateIdentity), arg0, arg1)
+}
+
+// CreateKYCDocument mocks base method.
+func (m *MockStore) CreateKYCDocument(arg0 context.Context, arg1 db.CreateKYCDocumentParams) (db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKYCDocument", arg0, arg1)
+	ret0, _ := ret[0].(db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKYCDocument indicates an expected call of CreateKYCDocument.
+func (mr *MockStoreMockRecorder) CreateKYCDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKYCDocument", reflect.TypeOf((*MockStore)(nil).CreateKYCDocument), arg0, arg1)
+}
+
+// CreateLoan mocks base method.
+func (m *MockStore) CreateLoan(arg0 context.Context, arg1 db.CreateLoanParams) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoan", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoan indicates an expected call of CreateLoan.
+func (mr *MockStoreMockRecorder) CreateLoan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoan", reflect.TypeOf((*MockStore)(nil).CreateLoan), arg0, arg1)
+}
+
+// CreateLoanPayment mocks base method.
+func (m *MockStore) CreateLoanPayment(arg0 context.Context, arg1 db.CreateLoanPaymentParams) (db.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoanPayment", arg0, arg1)
+	ret0, _ := ret[0].(db.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoanPayment indicates an expected call of CreateLoanPayment.
+func (mr *MockStoreMockRecorder) CreateLoanPayment(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoanPayment", reflect.TypeOf((*MockStore)(nil).CreateLoanPayment), arg0, arg1)
+}
+
+// CreateMandate mocks base method.
+func (m *MockStore) CreateMandate(arg0 context.Context, arg1 db.CreateMandateParams) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMandate", arg0, arg1)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMandate indicates an expected call of CreateMandate.
+func (mr *MockStoreMockRecorder) CreateMandate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMandate", reflect.TypeOf((*MockStore)(nil).CreateMandate), arg0, arg1)
+}
+
+// CreateOAuthUserTx mocks base method.
+func (m *MockStore) CreateOAuthUserTx(arg0 context.Context, arg1 db.CreateOAuthUserTxParams) (db.CreateOAuthUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOAuthUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateOAuthUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOAuthUserTx indicates an expected call of CreateOAuthUserTx.
+func (mr *MockStoreMockRecorder) CreateOAuthUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOAuthUserTx", reflect.TypeOf((*MockStore)(nil).CreateOAuthUserTx), arg0, arg1)
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockStore) CreateOutboxEvent(arg0 context.Context, arg1 db.CreateOutboxEventParams) (db.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockStoreMockRecorder) CreateOutboxEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockStore)(nil).CreateOutboxEvent), arg0, arg1)
+}
+
+// CreatePasswordHistory mocks base method.
+func (m *MockStore) CreatePasswordHistory(arg0 context.Context, arg1 db.CreatePasswordHistoryParams) (db.PasswordHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].(db.PasswordHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordHistory indicates an expected call of CreatePasswordHistory.
+func (mr *MockStoreMockRecorder) CreatePasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordHistory", reflect.TypeOf((*MockStore)(nil).CreatePasswordHistory), arg0, arg1)
+}
+
+// CreatePendingOperation mocks base method.
+func (m *MockStore) CreatePendingOperation(arg0 context.Context, arg1 db.CreatePendingOperationParams) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingOperation", arg0, arg1)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingOperation indicates an expected call of CreatePendingOperation.
+func (mr *MockStoreMockRecorder) CreatePendingOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingOperation", reflect.TypeOf((*MockStore)(nil).CreatePendingOperation), arg0, arg1)
+}
+
+// CreatePendingTransferTx mocks base method.
+func (m *MockStore) CreatePendingTransferTx(arg0 context.Context, arg1 db.CreatePendingTransferTxParams) (db.CreatePendingTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePendingTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreatePendingTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePendingTransferTx indicates an expected call of CreatePendingTransferTx.
+func (mr *MockStoreMockRecorder) CreatePendingTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePendingTransferTx", reflect.TypeOf((*MockStore)(nil).CreatePendingTransferTx), arg0, arg1)
+}
+
+// CreateReconciliationDiscrepancy mocks base method.
+func (m *MockStore) CreateReconciliationDiscrepancy(arg0 context.Context, arg1 db.CreateReconciliationDiscrepancyParams) (db.ReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReconciliationDiscrepancy", arg0, arg1)
+	ret0, _ := ret[0].(db.ReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReconciliationDiscrepancy indicates an expected call of CreateReconciliationDiscrepancy.
+func (mr *MockStoreMockRecorder) CreateReconciliationDiscrepancy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliationDiscrepancy", reflect.TypeOf((*MockStore)(nil).CreateReconciliationDiscrepancy), arg0, arg1)
+}
+
+// CreateReconciliationReport mocks base method.
+func (m *MockStore) CreateReconciliationReport(arg0 context.Context, arg1 db.CreateReconciliationReportParams) (db.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReconciliationReport", arg0, arg1)
+	ret0, _ := ret[0].(db.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReconciliationReport indicates an expected call of CreateReconciliationReport.
+func (mr *MockStoreMockRecorder) CreateReconciliationReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliationReport", reflect.TypeOf((*MockStore)(nil).CreateReconciliationReport), arg0, arg1)
+}
+
+// CreateReferral mocks base method.
+func (m *MockStore) CreateReferral(arg0 context.Context, arg1 db.CreateReferralParams) (db.Referral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReferral", arg0, arg1)
+	ret0, _ := ret[0].(db.Referral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReferral indicates an expected call of CreateReferral.
+func (mr *MockStoreMockRecorder) CreateReferral(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReferral", reflect.TypeOf((*MockStore)(nil).CreateReferral), arg0, arg1)
+}
+
+// CreateReferralCode mocks base method.
+func (m *MockStore) CreateReferralCode(arg0 context.Context, arg1 db.CreateReferralCodeParams) (db.ReferralCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReferralCode", arg0, arg1)
+	ret0, _ := ret[0].(db.ReferralCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReferralCode indicates an expected call of CreateReferralCode.
+func (mr *MockStoreMockRecorder) CreateReferralCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReferralCode", reflect.TypeOf((*MockStore)(nil).CreateReferralCode), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+}
+
+// CreateTransferQuote mocks base method.
+func (m *MockStore) CreateTransferQuote(arg0 context.Context, arg1 db.CreateTransferQuoteParams) (db.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferQuote", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferQuote indicates an expected call of CreateTransferQuote.
+func (mr *MockStoreMockRecorder) CreateTransferQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferQuote", reflect.TypeOf((*MockStore)(nil).CreateTransferQuote), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// CreateUserDevice mocks base method.
+func (m *MockStore) CreateUserDevice(arg0 context.Context, arg1 db.CreateUserDeviceParams) (db.UserDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserDevice", arg0, arg1)
+	ret0, _ := ret[0].(db.UserDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserDevice indicates an expected call of CreateUserDevice.
+func (mr *MockStoreMockRecorder) CreateUserDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserDevice", reflect.TypeOf((*MockStore)(nil).CreateUserDevice), arg0, arg1)
+}
+
+// CreateUserTx mocks base method.
+func (m *MockStore) CreateUserTx(arg0 context.Context, arg1 db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserTx indicates an expected call of CreateUserTx.
+func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockStoreMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), arg0, arg1)
+}
+
+// CreditReferral mocks base method.
+func (m *MockStore) CreditReferral(arg0 context.Context, arg1 db.CreditReferralParams) (db.Referral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditReferral", arg0, arg1)
+	ret0, _ := ret[0].(db.Referral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreditReferral indicates an expected call of CreditReferral.
+func (mr *MockStoreMockRecorder) CreditReferral(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditReferral", reflect.TypeOf((*MockStore)(nil).CreditReferral), arg0, arg1)
+}
+
+// CreditReferralBonusTx mocks base method.
+func (m *MockStore) CreditReferralBonusTx(arg0 context.Context, arg1 db.CreditReferralBonusTxParams) (db.CreditReferralBonusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditReferralBonusTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreditReferralBonusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreditReferralBonusTx indicates an expected call of CreditReferralBonusTx.
+func (mr *MockStoreMockRecorder) CreditReferralBonusTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditReferralBonusTx", reflect.TypeOf((*MockStore)(nil).CreditReferralBonusTx), arg0, arg1)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+}
+
+// DeleteBalanceAlert mocks base method.
+func (m *MockStore) DeleteBalanceAlert(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBalanceAlert", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBalanceAlert indicates an expected call of DeleteBalanceAlert.
+func (mr *MockStoreMockRecorder) DeleteBalanceAlert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBalanceAlert", reflect.TypeOf((*MockStore)(nil).DeleteBalanceAlert), arg0, arg1)
+}
+
+// DeleteFeatureFlag mocks base method.
+func (m *MockStore) DeleteFeatureFlag(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeatureFlag indicates an expected call of DeleteFeatureFlag.
+func (mr *MockStoreMockRecorder) DeleteFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeatureFlag", reflect.TypeOf((*MockStore)(nil).DeleteFeatureFlag), arg0, arg1)
+}
+
+// DeleteNotificationPreferences mocks base method.
+func (m *MockStore) DeleteNotificationPreferences(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotificationPreferences indicates an expected call of DeleteNotificationPreferences.
+func (mr *MockStoreMockRecorder) DeleteNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotificationPreferences", reflect.TypeOf((*MockStore)(nil).DeleteNotificationPreferences), arg0, arg1)
+}
+
+// DeleteOutboxEvents mocks base method.
+func (m *MockStore) DeleteOutboxEvents(arg0 context.Context, arg1 db.DeleteOutboxEventsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOutboxEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOutboxEvents indicates an expected call of DeleteOutboxEvents.
+func (mr *MockStoreMockRecorder) DeleteOutboxEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOutboxEvents", reflect.TypeOf((*MockStore)(nil).DeleteOutboxEvents), arg0, arg1)
+}
+
+// DeleteUserDevice mocks base method.
+func (m *MockStore) DeleteUserDevice(arg0 context.Context, arg1 db.DeleteUserDeviceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserDevice indicates an expected call of DeleteUserDevice.
+func (mr *MockStoreMockRecorder) DeleteUserDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserDevice", reflect.TypeOf((*MockStore)(nil).DeleteUserDevice), arg0, arg1)
+}
+
+// DeleteUserDevices mocks base method.
+func (m *MockStore) DeleteUserDevices(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserDevices", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserDevices indicates an expected call of DeleteUserDevices.
+func (mr *MockStoreMockRecorder) DeleteUserDevices(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserDevices", reflect.TypeOf((*MockStore)(nil).DeleteUserDevices), arg0, arg1)
+}
+
+// DeleteUserPasswordHistory mocks base method.
+func (m *MockStore) DeleteUserPasswordHistory(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserPasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserPasswordHistory indicates an expected call of DeleteUserPasswordHistory.
+func (mr *MockStoreMockRecorder) DeleteUserPasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserPasswordHistory", reflect.TypeOf((*MockStore)(nil).DeleteUserPasswordHistory), arg0, arg1)
+}
+
+// DeleteUserSessions mocks base method.
+func (m *MockStore) DeleteUserSessions(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions.
+func (mr *MockStoreMockRecorder) DeleteUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockStore)(nil).DeleteUserSessions), arg0, arg1)
+}
+
+// DeleteUserTx mocks base method.
+func (m *MockStore) DeleteUserTx(arg0 context.Context, arg1 db.DeleteUserTxParams) (db.DeleteUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DeleteUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserTx indicates an expected call of DeleteUserTx.
+func (mr *MockStoreMockRecorder) DeleteUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTx", reflect.TypeOf((*MockStore)(nil).DeleteUserTx), arg0, arg1)
+}
+
+// DeleteUserVerifyEmails mocks base method.
+func (m *MockStore) DeleteUserVerifyEmails(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserVerifyEmails", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserVerifyEmails indicates an expected call of DeleteUserVerifyEmails.
+func (mr *MockStoreMockRecorder) DeleteUserVerifyEmails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserVerifyEmails", reflect.TypeOf((*MockStore)(nil).DeleteUserVerifyEmails), arg0, arg1)
+}
+
+// FXTransferTx mocks base method.
+func (m *MockStore) FXTransferTx(arg0 context.Context, arg1 db.FXTransferTxParams) (db.FXTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FXTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FXTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FXTransferTx indicates an expected call of FXTransferTx.
+func (mr *MockStoreMockRecorder) FXTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FXTransferTx", reflect.TypeOf((*MockStore)(nil).FXTransferTx), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+}
+
+// GetAccountByNumber mocks base method.
+func (m *MockStore) GetAccountByNumber(arg0 context.Context, arg1 string) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByNumber", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByNumber indicates an expected call of GetAccountByNumber.
+func (mr *MockStoreMockRecorder) GetAccountByNumber(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNumber", reflect.TypeOf((*MockStore)(nil).GetAccountByNumber), arg0, arg1)
+}
+
+// GetAccountByOwnerCurrency mocks base method.
+func (m *MockStore) GetAccountByOwnerCurrency(arg0 context.Context, arg1 db.GetAccountByOwnerCurrencyParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountByOwnerCurrency", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountByOwnerCurrency indicates an expected call of GetAccountByOwnerCurrency.
+func (mr *MockStoreMockRecorder) GetAccountByOwnerCurrency(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByOwnerCurrency", reflect.TypeOf((*MockStore)(nil).GetAccountByOwnerCurrency), arg0, arg1)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+}
+
+// GetAccountStatement mocks base method.
+func (m *MockStore) GetAccountStatement(arg0 context.Context, arg1 int64) (db.GetAccountStatementRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountStatement", arg0, arg1)
+	ret0, _ := ret[0].(db.GetAccountStatementRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountStatement indicates an expected call of GetAccountStatement.
+func (mr *MockStoreMockRecorder) GetAccountStatement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountStatement", reflect.TypeOf((*MockStore)(nil).GetAccountStatement), arg0, arg1)
+}
+
+// GetAccountsByIDs mocks base method.
+func (m *MockStore) GetAccountsByIDs(arg0 context.Context, arg1 []int64) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountsByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountsByIDs indicates an expected call of GetAccountsByIDs.
+func (mr *MockStoreMockRecorder) GetAccountsByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountsByIDs", reflect.TypeOf((*MockStore)(nil).GetAccountsByIDs), arg0, arg1)
+}
+
+// GetBalanceAlert mocks base method.
+func (m *MockStore) GetBalanceAlert(arg0 context.Context, arg1 int64) (db.BalanceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceAlert", arg0, arg1)
+	ret0, _ := ret[0].(db.BalanceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceAlert indicates an expected call of GetBalanceAlert.
+func (mr *MockStoreMockRecorder) GetBalanceAlert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceAlert", reflect.TypeOf((*MockStore)(nil).GetBalanceAlert), arg0, arg1)
+}
+
+// GetCard mocks base method.
+func (m *MockStore) GetCard(arg0 context.Context, arg1 int64) (db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCard", arg0, arg1)
+	ret0, _ := ret[0].(db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCard indicates an expected call of GetCard.
+func (mr *MockStoreMockRecorder) GetCard(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCard", reflect.TypeOf((*MockStore)(nil).GetCard), arg0, arg1)
+}
+
+// GetCardByPanHash mocks base method.
+func (m *MockStore) GetCardByPanHash(arg0 context.Context, arg1 string) (db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardByPanHash", arg0, arg1)
+	ret0, _ := ret[0].(db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardByPanHash indicates an expected call of GetCardByPanHash.
+func (mr *MockStoreMockRecorder) GetCardByPanHash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardByPanHash", reflect.TypeOf((*MockStore)(nil).GetCardByPanHash), arg0, arg1)
+}
+
+// GetCardForUpdate mocks base method.
+func (m *MockStore) GetCardForUpdate(arg0 context.Context, arg1 int64) (db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardForUpdate indicates an expected call of GetCardForUpdate.
+func (mr *MockStoreMockRecorder) GetCardForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardForUpdate", reflect.TypeOf((*MockStore)(nil).GetCardForUpdate), arg0, arg1)
+}
+
+// GetDispute mocks base method.
+func (m *MockStore) GetDispute(arg0 context.Context, arg1 int64) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDispute", arg0, arg1)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDispute indicates an expected call of GetDispute.
+func (mr *MockStoreMockRecorder) GetDispute(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDispute", reflect.TypeOf((*MockStore)(nil).GetDispute), arg0, arg1)
+}
+
+// GetDisputeForUpdate mocks base method.
+func (m *MockStore) GetDisputeForUpdate(arg0 context.Context, arg1 int64) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisputeForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDisputeForUpdate indicates an expected call of GetDisputeForUpdate.
+func (mr *MockStoreMockRecorder) GetDisputeForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisputeForUpdate", reflect.TypeOf((*MockStore)(nil).GetDisputeForUpdate), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+}
+
+// GetExportJob mocks base method.
+func (m *MockStore) GetExportJob(arg0 context.Context, arg1 int64) (db.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportJob indicates an expected call of GetExportJob.
+func (mr *MockStoreMockRecorder) GetExportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportJob", reflect.TypeOf((*MockStore)(nil).GetExportJob), arg0, arg1)
+}
+
+// GetExternalTransfer mocks base method.
+func (m *MockStore) GetExternalTransfer(arg0 context.Context, arg1 int64) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalTransfer indicates an expected call of GetExternalTransfer.
+func (mr *MockStoreMockRecorder) GetExternalTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalTransfer", reflect.TypeOf((*MockStore)(nil).GetExternalTransfer), arg0, arg1)
+}
+
+// GetExternalTransferForUpdate mocks base method.
+func (m *MockStore) GetExternalTransferForUpdate(arg0 context.Context, arg1 int64) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalTransferForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExternalTransferForUpdate indicates an expected call of GetExternalTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetExternalTransferForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetExternalTransferForUpdate), arg0, arg1)
+}
+
+// GetFeatureFlag mocks base method.
+func (m *MockStore) GetFeatureFlag(arg0 context.Context, arg1 string) (db.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(db.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeatureFlag indicates an expected call of GetFeatureFlag.
+func (mr *MockStoreMockRecorder) GetFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeatureFlag", reflect.TypeOf((*MockStore)(nil).GetFeatureFlag), arg0, arg1)
+}
+
+// GetHeldAmount mocks base method.
+func (m *MockStore) GetHeldAmount(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldAmount", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldAmount indicates an expected call of GetHeldAmount.
+func (mr *MockStoreMockRecorder) GetHeldAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldAmount", reflect.TypeOf((*MockStore)(nil).GetHeldAmount), arg0, arg1)
+}
+
+// GetHold mocks base method.
+func (m *MockStore) GetHold(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockStoreMockRecorder) GetHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockStore)(nil).GetHold), arg0, arg1)
+}
+
+// GetHoldForUpdate mocks base method.
+func (m *MockStore) GetHoldForUpdate(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldForUpdate indicates an expected call of GetHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetHoldForUpdate), arg0, arg1)
+}
+
+// GetIdentity mocks base method.
+func (m *MockStore) GetIdentity(arg0 context.Context, arg1 db.GetIdentityParams) (db.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdentity", arg0, arg1)
+	ret0, _ := ret[0].(db.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdentity indicates an expected call of GetIdentity.
+func (mr *MockStoreMockRecorder) GetIdentity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdentity", reflect.TypeOf((*MockStore)(nil).GetIdentity), arg0, arg1)
+}
+
+// GetKYCDocument mocks base method.
+func (m *MockStore) GetKYCDocument(arg0 context.Context, arg1 int64) (db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCDocument", arg0, arg1)
+	ret0, _ := ret[0].(db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCDocument indicates an expected call of GetKYCDocument.
+func (mr *MockStoreMockRecorder) GetKYCDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCDocument", reflect.TypeOf((*MockStore)(nil).GetKYCDocument), arg0, arg1)
+}
+
+// GetKYCLevel mocks base method.
+func (m *MockStore) GetKYCLevel(arg0 context.Context, arg1 string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCLevel", arg0, arg1)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCLevel indicates an expected call of GetKYCLevel.
+func (mr *MockStoreMockRecorder) GetKYCLevel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCLevel", reflect.TypeOf((*MockStore)(nil).GetKYCLevel), arg0, arg1)
+}
+
+// GetLatestReconciliationReport mocks base method.
+func (m *MockStore) GetLatestReconciliationReport(arg0 context.Context) (db.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestReconciliationReport", arg0)
+	ret0, _ := ret[0].(db.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestReconciliationReport indicates an expected call of GetLatestReconciliationReport.
+func (mr *MockStoreMockRecorder) GetLatestReconciliationReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliationReport", reflect.TypeOf((*MockStore)(nil).GetLatestReconciliationReport), arg0)
+}
+
+// GetLoan mocks base method.
+func (m *MockStore) GetLoan(arg0 context.Context, arg1 int64) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoan", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoan indicates an expected call of GetLoan.
+func (mr *MockStoreMockRecorder) GetLoan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoan", reflect.TypeOf((*MockStore)(nil).GetLoan), arg0, arg1)
+}
+
+// GetLoanForUpdate mocks base method.
+func (m *MockStore) GetLoanForUpdate(arg0 context.Context, arg1 int64) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanForUpdate indicates an expected call of GetLoanForUpdate.
+func (mr *MockStoreMockRecorder) GetLoanForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanForUpdate", reflect.TypeOf((*MockStore)(nil).GetLoanForUpdate), arg0, arg1)
+}
+
+// GetLoanPaymentForUpdate mocks base method.
+func (m *MockStore) GetLoanPaymentForUpdate(arg0 context.Context, arg1 int64) (db.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoanPaymentForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoanPaymentForUpdate indicates an expected call of GetLoanPaymentForUpdate.
+func (mr *MockStoreMockRecorder) GetLoanPaymentForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoanPaymentForUpdate", reflect.TypeOf((*MockStore)(nil).GetLoanPaymentForUpdate), arg0, arg1)
+}
+
+// GetMandate mocks base method.
+func (m *MockStore) GetMandate(arg0 context.Context, arg1 int64) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandate", arg0, arg1)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandate indicates an expected call of GetMandate.
+func (mr *MockStoreMockRecorder) GetMandate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandate", reflect.TypeOf((*MockStore)(nil).GetMandate), arg0, arg1)
+}
+
+// GetMandateForUpdate mocks base method.
+func (m *MockStore) GetMandateForUpdate(arg0 context.Context, arg1 int64) (db.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandateForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandateForUpdate indicates an expected call of GetMandateForUpdate.
+func (mr *MockStoreMockRecorder) GetMandateForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandateForUpdate", reflect.TypeOf((*MockStore)(nil).GetMandateForUpdate), arg0, arg1)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockStore) GetNotificationPreferences(arg0 context.Context, arg1 string) (db.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(db.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockStoreMockRecorder) GetNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockStore)(nil).GetNotificationPreferences), arg0, arg1)
+}
+
+// GetPendingOperation mocks base method.
+func (m *MockStore) GetPendingOperation(arg0 context.Context, arg1 int64) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperation", arg0, arg1)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperation indicates an expected call of GetPendingOperation.
+func (mr *MockStoreMockRecorder) GetPendingOperation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperation", reflect.TypeOf((*MockStore)(nil).GetPendingOperation), arg0, arg1)
+}
+
+// GetPendingOperationByTransfer mocks base method.
+func (m *MockStore) GetPendingOperationByTransfer(arg0 context.Context, arg1 int64) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperationByTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperationByTransfer indicates an expected call of GetPendingOperationByTransfer.
+func (mr *MockStoreMockRecorder) GetPendingOperationByTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperationByTransfer", reflect.TypeOf((*MockStore)(nil).GetPendingOperationByTransfer), arg0, arg1)
+}
+
+// GetPendingOperationForUpdate mocks base method.
+func (m *MockStore) GetPendingOperationForUpdate(arg0 context.Context, arg1 int64) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingOperationForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingOperationForUpdate indicates an expected call of GetPendingOperationForUpdate.
+func (mr *MockStoreMockRecorder) GetPendingOperationForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingOperationForUpdate", reflect.TypeOf((*MockStore)(nil).GetPendingOperationForUpdate), arg0, arg1)
+}
+
+// GetReconciliationReport mocks base method.
+func (m *MockStore) GetReconciliationReport(arg0 context.Context, arg1 int64) (db.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliationReport", arg0, arg1)
+	ret0, _ := ret[0].(db.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliationReport indicates an expected call of GetReconciliationReport.
+func (mr *MockStoreMockRecorder) GetReconciliationReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliationReport", reflect.TypeOf((*MockStore)(nil).GetReconciliationReport), arg0, arg1)
+}
+
+// GetReferralCode mocks base method.
+func (m *MockStore) GetReferralCode(arg0 context.Context, arg1 string) (db.ReferralCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferralCode", arg0, arg1)
+	ret0, _ := ret[0].(db.ReferralCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferralCode indicates an expected call of GetReferralCode.
+func (mr *MockStoreMockRecorder) GetReferralCode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralCode", reflect.TypeOf((*MockStore)(nil).GetReferralCode), arg0, arg1)
+}
+
+// GetReferralCodeByUsername mocks base method.
+func (m *MockStore) GetReferralCodeByUsername(arg0 context.Context, arg1 string) (db.ReferralCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferralCodeByUsername", arg0, arg1)
+	ret0, _ := ret[0].(db.ReferralCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferralCodeByUsername indicates an expected call of GetReferralCodeByUsername.
+func (mr *MockStoreMockRecorder) GetReferralCodeByUsername(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralCodeByUsername", reflect.TypeOf((*MockStore)(nil).GetReferralCodeByUsername), arg0, arg1)
+}
+
+// GetReferralForUpdate mocks base method.
+func (m *MockStore) GetReferralForUpdate(arg0 context.Context, arg1 int64) (db.Referral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferralForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Referral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferralForUpdate indicates an expected call of GetReferralForUpdate.
+func (mr *MockStoreMockRecorder) GetReferralForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralForUpdate", reflect.TypeOf((*MockStore)(nil).GetReferralForUpdate), arg0, arg1)
+}
+
+// GetReferralStats mocks base method.
+func (m *MockStore) GetReferralStats(arg0 context.Context, arg1 string) (db.GetReferralStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReferralStats", arg0, arg1)
+	ret0, _ := ret[0].(db.GetReferralStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReferralStats indicates an expected call of GetReferralStats.
+func (mr *MockStoreMockRecorder) GetReferralStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReferralStats", reflect.TypeOf((*MockStore)(nil).GetReferralStats), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSpendingSummary mocks base method.
+func (m *MockStore) GetSpendingSummary(arg0 context.Context, arg1 db.GetSpendingSummaryParams) ([]db.GetSpendingSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingSummary", arg0, arg1)
+	ret0, _ := ret[0].([]db.GetSpendingSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingSummary indicates an expected call of GetSpendingSummary.
+func (mr *MockStoreMockRecorder) GetSpendingSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingSummary", reflect.TypeOf((*MockStore)(nil).GetSpendingSummary), arg0, arg1)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+}
+
+// GetTransferQuote mocks base method.
+func (m *MockStore) GetTransferQuote(arg0 context.Context, arg1 int64) (db.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferQuote", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferQuote indicates an expected call of GetTransferQuote.
+func (mr *MockStoreMockRecorder) GetTransferQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferQuote", reflect.TypeOf((*MockStore)(nil).GetTransferQuote), arg0, arg1)
+}
+
+// GetTransferQuoteForUpdate mocks base method.
+func (m *MockStore) GetTransferQuoteForUpdate(arg0 context.Context, arg1 int64) (db.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferQuoteForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferQuoteForUpdate indicates an expected call of GetTransferQuoteForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferQuoteForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferQuoteForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferQuoteForUpdate), arg0, arg1)
+}
+
+// GetTransferReversal mocks base method.
+func (m *MockStore) GetTransferReversal(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferReversal", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferReversal indicates an expected call of GetTransferReversal.
+func (mr *MockStoreMockRecorder) GetTransferReversal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferReversal", reflect.TypeOf((*MockStore)(nil).GetTransferReversal), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockStore) GetUserByEmail(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockStoreMockRecorder) GetUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), arg0, arg1)
+}
+
+// GetUserDevice mocks base method.
+func (m *MockStore) GetUserDevice(arg0 context.Context, arg1 db.GetUserDeviceParams) (db.UserDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDevice", arg0, arg1)
+	ret0, _ := ret[0].(db.UserDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDevice indicates an expected call of GetUserDevice.
+func (mr *MockStoreMockRecorder) GetUserDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDevice", reflect.TypeOf((*MockStore)(nil).GetUserDevice), arg0, arg1)
+}
+
+// GetUserForUpdate mocks base method.
+func (m *MockStore) GetUserForUpdate(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserForUpdate indicates an expected call of GetUserForUpdate.
+func (mr *MockStoreMockRecorder) GetUserForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserForUpdate", reflect.TypeOf((*MockStore)(nil).GetUserForUpdate), arg0, arg1)
+}
+
+// GetUsersByUsernames mocks base method.
+func (m *MockStore) GetUsersByUsernames(arg0 context.Context, arg1 []string) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByUsernames", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByUsernames indicates an expected call of GetUsersByUsernames.
+func (mr *MockStoreMockRecorder) GetUsersByUsernames(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByUsernames", reflect.TypeOf((*MockStore)(nil).GetUsersByUsernames), arg0, arg1)
+}
+
+// GetVerifyEmailForUpdate mocks base method.
+func (m *MockStore) GetVerifyEmailForUpdate(arg0 context.Context, arg1 int64) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerifyEmailForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerifyEmailForUpdate indicates an expected call of GetVerifyEmailForUpdate.
+func (mr *MockStoreMockRecorder) GetVerifyEmailForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerifyEmailForUpdate", reflect.TypeOf((*MockStore)(nil).GetVerifyEmailForUpdate), arg0, arg1)
+}
+
+// ListAccountActivity mocks base method.
+func (m *MockStore) ListAccountActivity(arg0 context.Context, arg1 db.ListAccountActivityParams) ([]db.ListAccountActivityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountActivity", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListAccountActivityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountActivity indicates an expected call of ListAccountActivity.
+func (mr *MockStoreMockRecorder) ListAccountActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountActivity", reflect.TypeOf((*MockStore)(nil).ListAccountActivity), arg0, arg1)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+}
+
+// ListActiveHolds mocks base method.
+func (m *MockStore) ListActiveHolds(arg0 context.Context, arg1 int64) ([]db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveHolds", arg0, arg1)
+	ret0, _ := ret[0].([]db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveHolds indicates an expected call of ListActiveHolds.
+func (mr *MockStoreMockRecorder) ListActiveHolds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveHolds", reflect.TypeOf((*MockStore)(nil).ListActiveHolds), arg0, arg1)
+}
+
+// ListBalanceAlerts mocks base method.
+func (m *MockStore) ListBalanceAlerts(arg0 context.Context, arg1 int64) ([]db.BalanceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceAlerts", arg0, arg1)
+	ret0, _ := ret[0].([]db.BalanceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceAlerts indicates an expected call of ListBalanceAlerts.
+func (mr *MockStoreMockRecorder) ListBalanceAlerts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceAlerts", reflect.TypeOf((*MockStore)(nil).ListBalanceAlerts), arg0, arg1)
+}
+
+// ListBalanceDiscrepancies mocks base method.
+func (m *MockStore) ListBalanceDiscrepancies(arg0 context.Context) ([]db.ListBalanceDiscrepanciesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalanceDiscrepancies", arg0)
+	ret0, _ := ret[0].([]db.ListBalanceDiscrepanciesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalanceDiscrepancies indicates an expected call of ListBalanceDiscrepancies.
+func (mr *MockStoreMockRecorder) ListBalanceDiscrepancies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalanceDiscrepancies", reflect.TypeOf((*MockStore)(nil).ListBalanceDiscrepancies), arg0)
+}
+
+// ListCards mocks base method.
+func (m *MockStore) ListCards(arg0 context.Context, arg1 int64) ([]db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCards", arg0, arg1)
+	ret0, _ := ret[0].([]db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCards indicates an expected call of ListCards.
+func (mr *MockStoreMockRecorder) ListCards(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCards", reflect.TypeOf((*MockStore)(nil).ListCards), arg0, arg1)
+}
+
+// ListDailyFailedLogins mocks base method.
+func (m *MockStore) ListDailyFailedLogins(arg0 context.Context, arg1 db.ListDailyFailedLoginsParams) ([]db.ListDailyFailedLoginsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyFailedLogins", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDailyFailedLoginsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyFailedLogins indicates an expected call of ListDailyFailedLogins.
+func (mr *MockStoreMockRecorder) ListDailyFailedLogins(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyFailedLogins", reflect.TypeOf((*MockStore)(nil).ListDailyFailedLogins), arg0, arg1)
+}
+
+// ListDailyNewUsers mocks base method.
+func (m *MockStore) ListDailyNewUsers(arg0 context.Context, arg1 db.ListDailyNewUsersParams) ([]db.ListDailyNewUsersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyNewUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDailyNewUsersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyNewUsers indicates an expected call of ListDailyNewUsers.
+func (mr *MockStoreMockRecorder) ListDailyNewUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyNewUsers", reflect.TypeOf((*MockStore)(nil).ListDailyNewUsers), arg0, arg1)
+}
+
+// ListDailyTransferVolume mocks base method.
+func (m *MockStore) ListDailyTransferVolume(arg0 context.Context, arg1 db.ListDailyTransferVolumeParams) ([]db.ListDailyTransferVolumeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyTransferVolume", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListDailyTransferVolumeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyTransferVolume indicates an expected call of ListDailyTransferVolume.
+func (mr *MockStoreMockRecorder) ListDailyTransferVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyTransferVolume", reflect.TypeOf((*MockStore)(nil).ListDailyTransferVolume), arg0, arg1)
+}
+
+// ListDisputeNotes mocks base method.
+func (m *MockStore) ListDisputeNotes(arg0 context.Context, arg1 int64) ([]db.DisputeNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputeNotes", arg0, arg1)
+	ret0, _ := ret[0].([]db.DisputeNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputeNotes indicates an expected call of ListDisputeNotes.
+func (mr *MockStoreMockRecorder) ListDisputeNotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputeNotes", reflect.TypeOf((*MockStore)(nil).ListDisputeNotes), arg0, arg1)
+}
+
+// ListDisputes mocks base method.
+func (m *MockStore) ListDisputes(arg0 context.Context, arg1 db.ListDisputesParams) ([]db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisputes", arg0, arg1)
+	ret0, _ := ret[0].([]db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisputes indicates an expected call of ListDisputes.
+func (mr *MockStoreMockRecorder) ListDisputes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisputes", reflect.TypeOf((*MockStore)(nil).ListDisputes), arg0, arg1)
+}
+
+// ListDueLoanPayments mocks base method.
+func (m *MockStore) ListDueLoanPayments(arg0 context.Context, arg1 db.ListDueLoanPaymentsParams) ([]db.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueLoanPayments", arg0, arg1)
+	ret0, _ := ret[0].([]db.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueLoanPayments indicates an expected call of ListDueLoanPayments.
+func (mr *MockStoreMockRecorder) ListDueLoanPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueLoanPayments", reflect.TypeOf((*MockStore)(nil).ListDueLoanPayments), arg0, arg1)
+}
+
+// ListEligibleReferrals mocks base method.
+func (m *MockStore) ListEligibleReferrals(arg0 context.Context, arg1 db.ListEligibleReferralsParams) ([]db.Referral, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEligibleReferrals", arg0, arg1)
+	ret0, _ := ret[0].([]db.Referral)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEligibleReferrals indicates an expected call of ListEligibleReferrals.
+func (mr *MockStoreMockRecorder) ListEligibleReferrals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEligibleReferrals", reflect.TypeOf((*MockStore)(nil).ListEligibleReferrals), arg0, arg1)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
+}
+
+// ListFeatureFlags mocks base method.
+func (m *MockStore) ListFeatureFlags(arg0 context.Context) ([]db.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeatureFlags", arg0)
+	ret0, _ := ret[0].([]db.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureFlags indicates an expected call of ListFeatureFlags.
+func (mr *MockStoreMockRecorder) ListFeatureFlags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureFlags", reflect.TypeOf((*MockStore)(nil).ListFeatureFlags), arg0)
+}
+
+// ListKYCDocuments mocks base method.
+func (m *MockStore) ListKYCDocuments(arg0 context.Context, arg1 string) ([]db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKYCDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKYCDocuments indicates an expected call of ListKYCDocuments.
+func (mr *MockStoreMockRecorder) ListKYCDocuments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKYCDocuments", reflect.TypeOf((*MockStore)(nil).ListKYCDocuments), arg0, arg1)
+}
+
+// ListLoanPayments mocks base method.
+func (m *MockStore) ListLoanPayments(arg0 context.Context, arg1 int64) ([]db.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoanPayments", arg0, arg1)
+	ret0, _ := ret[0].([]db.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoanPayments indicates an expected call of ListLoanPayments.
+func (mr *MockStoreMockRecorder) ListLoanPayments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoanPayments", reflect.TypeOf((*MockStore)(nil).ListLoanPayments), arg0, arg1)
+}
+
+// ListMonthlyAccountSummaries mocks base method.
+func (m *MockStore) ListMonthlyAccountSummaries(arg0 context.Context, arg1 db.ListMonthlyAccountSummariesParams) ([]db.ListMonthlyAccountSummariesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMonthlyAccountSummaries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListMonthlyAccountSummariesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMonthlyAccountSummaries indicates an expected call of ListMonthlyAccountSummaries.
+func (mr *MockStoreMockRecorder) ListMonthlyAccountSummaries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMonthlyAccountSummaries", reflect.TypeOf((*MockStore)(nil).ListMonthlyAccountSummaries), arg0, arg1)
+}
+
+// ListPasswordHistory mocks base method.
+func (m *MockStore) ListPasswordHistory(arg0 context.Context, arg1 db.ListPasswordHistoryParams) ([]db.PasswordHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].([]db.PasswordHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPasswordHistory indicates an expected call of ListPasswordHistory.
+func (mr *MockStoreMockRecorder) ListPasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPasswordHistory", reflect.TypeOf((*MockStore)(nil).ListPasswordHistory), arg0, arg1)
+}
+
+// ListPendingKYCDocuments mocks base method.
+func (m *MockStore) ListPendingKYCDocuments(arg0 context.Context, arg1 db.ListPendingKYCDocumentsParams) ([]db.KycDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingKYCDocuments", arg0, arg1)
+	ret0, _ := ret[0].([]db.KycDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingKYCDocuments indicates an expected call of ListPendingKYCDocuments.
+func (mr *MockStoreMockRecorder) ListPendingKYCDocuments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingKYCDocuments", reflect.TypeOf((*MockStore)(nil).ListPendingKYCDocuments), arg0, arg1)
+}
+
+// ListReconciliationDiscrepancies mocks base method.
+func (m *MockStore) ListReconciliationDiscrepancies(arg0 context.Context, arg1 int64) ([]db.ReconciliationDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReconciliationDiscrepancies", arg0, arg1)
+	ret0, _ := ret[0].([]db.ReconciliationDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliationDiscrepancies indicates an expected call of ListReconciliationDiscrepancies.
+func (mr *MockStoreMockRecorder) ListReconciliationDiscrepancies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliationDiscrepancies", reflect.TypeOf((*MockStore)(nil).ListReconciliationDiscrepancies), arg0, arg1)
+}
+
+// ListResourceAuditLogs mocks base method.
+func (m *MockStore) ListResourceAuditLogs(arg0 context.Context, arg1 db.ListResourceAuditLogsParams) ([]db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceAuditLogs indicates an expected call of ListResourceAuditLogs.
+func (mr *MockStoreMockRecorder) ListResourceAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceAuditLogs", reflect.TypeOf((*MockStore)(nil).ListResourceAuditLogs), arg0, arg1)
+}
+
+// ListStalePendingOperations mocks base method.
+func (m *MockStore) ListStalePendingOperations(arg0 context.Context, arg1 db.ListStalePendingOperationsParams) ([]db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStalePendingOperations", arg0, arg1)
+	ret0, _ := ret[0].([]db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStalePendingOperations indicates an expected call of ListStalePendingOperations.
+func (mr *MockStoreMockRecorder) ListStalePendingOperations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStalePendingOperations", reflect.TypeOf((*MockStore)(nil).ListStalePendingOperations), arg0, arg1)
+}
+
+// ListStatementEntries mocks base method.
+func (m *MockStore) ListStatementEntries(arg0 context.Context, arg1 db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatementEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListStatementEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatementEntries indicates an expected call of ListStatementEntries.
+func (mr *MockStoreMockRecorder) ListStatementEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementEntries", reflect.TypeOf((*MockStore)(nil).ListStatementEntries), arg0, arg1)
+}
+
+// ListTopAccountsByActivity mocks base method.
+func (m *MockStore) ListTopAccountsByActivity(arg0 context.Context, arg1 db.ListTopAccountsByActivityParams) ([]db.ListTopAccountsByActivityRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopAccountsByActivity", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTopAccountsByActivityRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopAccountsByActivity indicates an expected call of ListTopAccountsByActivity.
+func (mr *MockStoreMockRecorder) ListTopAccountsByActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopAccountsByActivity", reflect.TypeOf((*MockStore)(nil).ListTopAccountsByActivity), arg0, arg1)
+}
+
+// ListTotalDeposits mocks base method.
+func (m *MockStore) ListTotalDeposits(arg0 context.Context, arg1 string) ([]db.ListTotalDepositsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTotalDeposits", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTotalDepositsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTotalDeposits indicates an expected call of ListTotalDeposits.
+func (mr *MockStoreMockRecorder) ListTotalDeposits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTotalDeposits", reflect.TypeOf((*MockStore)(nil).ListTotalDeposits), arg0, arg1)
+}
+
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
+}
+
+// ListUnbalancedTransfers mocks base method.
+func (m *MockStore) ListUnbalancedTransfers(arg0 context.Context, arg1 int32) ([]db.ListUnbalancedTransfersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnbalancedTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListUnbalancedTransfersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnbalancedTransfers indicates an expected call of ListUnbalancedTransfers.
+func (mr *MockStoreMockRecorder) ListUnbalancedTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnbalancedTransfers", reflect.TypeOf((*MockStore)(nil).ListUnbalancedTransfers), arg0, arg1)
+}
+
+// ListUnpublishedOutboxEventsForUpdate mocks base method.
+func (m *MockStore) ListUnpublishedOutboxEventsForUpdate(arg0 context.Context, arg1 int32) ([]db.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnpublishedOutboxEventsForUpdate", arg0, arg1)
+	ret0, _ := ret[0].([]db.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnpublishedOutboxEventsForUpdate indicates an expected call of ListUnpublishedOutboxEventsForUpdate.
+func (mr *MockStoreMockRecorder) ListUnpublishedOutboxEventsForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublishedOutboxEventsForUpdate", reflect.TypeOf((*MockStore)(nil).ListUnpublishedOutboxEventsForUpdate), arg0, arg1)
+}
+
+// ListUnverifiedUsers mocks base method.
+func (m *MockStore) ListUnverifiedUsers(arg0 context.Context, arg1 db.ListUnverifiedUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnverifiedUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnverifiedUsers indicates an expected call of ListUnverifiedUsers.
+func (mr *MockStoreMockRecorder) ListUnverifiedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnverifiedUsers", reflect.TypeOf((*MockStore)(nil).ListUnverifiedUsers), arg0, arg1)
+}
+
+// ListUserSessions mocks base method.
+func (m *MockStore) ListUserSessions(arg0 context.Context, arg1 string) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSessions", arg0, arg1)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSessions indicates an expected call of ListUserSessions.
+func (mr *MockStoreMockRecorder) ListUserSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSessions", reflect.TypeOf((*MockStore)(nil).ListUserSessions), arg0, arg1)
+}
+
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(arg0 context.Context, arg1 db.ListUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), arg0, arg1)
+}
+
+// ListUsersDueForVerifyEmailReminder mocks base method.
+func (m *MockStore) ListUsersDueForVerifyEmailReminder(arg0 context.Context, arg1 db.ListUsersDueForVerifyEmailReminderParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersDueForVerifyEmailReminder", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersDueForVerifyEmailReminder indicates an expected call of ListUsersDueForVerifyEmailReminder.
+func (mr *MockStoreMockRecorder) ListUsersDueForVerifyEmailReminder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersDueForVerifyEmailReminder", reflect.TypeOf((*MockStore)(nil).ListUsersDueForVerifyEmailReminder), arg0, arg1)
+}
+
+// LockAccounts mocks base method.
+func (m *MockStore) LockAccounts(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccounts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccounts indicates an expected call of LockAccounts.
+func (mr *MockStoreMockRecorder) LockAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccounts", reflect.TypeOf((*MockStore)(nil).LockAccounts), arg0, arg1)
+}
+
+// MarkDefaultAccount mocks base method.
+func (m *MockStore) MarkDefaultAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDefaultAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDefaultAccount indicates an expected call of MarkDefaultAccount.
+func (mr *MockStoreMockRecorder) MarkDefaultAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultAccount", reflect.TypeOf((*MockStore)(nil).MarkDefaultAccount), arg0, arg1)
+}
+
+// MarkLoanDelinquent mocks base method.
+func (m *MockStore) MarkLoanDelinquent(arg0 context.Context, arg1 int64) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLoanDelinquent", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLoanDelinquent indicates an expected call of MarkLoanDelinquent.
+func (mr *MockStoreMockRecorder) MarkLoanDelinquent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLoanDelinquent", reflect.TypeOf((*MockStore)(nil).MarkLoanDelinquent), arg0, arg1)
+}
+
+// MarkLoanPaymentPaid mocks base method.
+func (m *MockStore) MarkLoanPaymentPaid(arg0 context.Context, arg1 db.MarkLoanPaymentPaidParams) (db.LoanPayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkLoanPaymentPaid", arg0, arg1)
+	ret0, _ := ret[0].(db.LoanPayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkLoanPaymentPaid indicates an expected call of MarkLoanPaymentPaid.
+func (mr *MockStoreMockRecorder) MarkLoanPaymentPaid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkLoanPaymentPaid", reflect.TypeOf((*MockStore)(nil).MarkLoanPaymentPaid), arg0, arg1)
+}
+
+// MarkOutboxEventsPublished mocks base method.
+func (m *MockStore) MarkOutboxEventsPublished(arg0 context.Context, arg1 []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxEventsPublished", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxEventsPublished indicates an expected call of MarkOutboxEventsPublished.
+func (mr *MockStoreMockRecorder) MarkOutboxEventsPublished(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxEventsPublished", reflect.TypeOf((*MockStore)(nil).MarkOutboxEventsPublished), arg0, arg1)
+}
+
+// MarkTransferQuoteUsed mocks base method.
+func (m *MockStore) MarkTransferQuoteUsed(arg0 context.Context, arg1 db.MarkTransferQuoteUsedParams) (db.TransferQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTransferQuoteUsed", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkTransferQuoteUsed indicates an expected call of MarkTransferQuoteUsed.
+func (mr *MockStoreMockRecorder) MarkTransferQuoteUsed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTransferQuoteUsed", reflect.TypeOf((*MockStore)(nil).MarkTransferQuoteUsed), arg0, arg1)
+}
+
+// MarkVerifyEmailReminderSent mocks base method.
+func (m *MockStore) MarkVerifyEmailReminderSent(arg0 context.Context, arg1 db.MarkVerifyEmailReminderSentParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVerifyEmailReminderSent", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkVerifyEmailReminderSent indicates an expected call of MarkVerifyEmailReminderSent.
+func (mr *MockStoreMockRecorder) MarkVerifyEmailReminderSent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerifyEmailReminderSent", reflect.TypeOf((*MockStore)(nil).MarkVerifyEmailReminderSent), arg0, arg1)
+}
+
+// PlaceHoldTx mocks base method.
+func (m *MockStore) PlaceHoldTx(arg0 context.Context, arg1 db.PlaceHoldTxParams) (db.PlaceHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PlaceHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceHoldTx indicates an expected call of PlaceHoldTx.
+func (mr *MockStoreMockRecorder) PlaceHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHoldTx", reflect.TypeOf((*MockStore)(nil).PlaceHoldTx), arg0, arg1)
+}
+
+// ReconcileBalancesTx mocks base method.
+func (m *MockStore) ReconcileBalancesTx(arg0 context.Context, arg1 db.ReconcileBalancesTxParams) (db.ReconcileBalancesTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileBalancesTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReconcileBalancesTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// ReconcileBalancesTx indicates an expected call of ReconcileBalancesTx.
+func (mr *MockStoreMockRecorder) ReconcileBalancesTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileBalancesTx", reflect.TypeOf((*MockStore)(nil).ReconcileBalancesTx), arg0, arg1)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+// RejectLoan mocks base method.
+func (m *MockStore) RejectLoan(arg0 context.Context, arg1 db.RejectLoanParams) (db.Loan, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "RejectLoan", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+// RejectLoan indicates an expected call of RejectLoan.
+func (mr *MockStoreMockRecorder) RejectLoan(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectLoan", reflect.TypeOf((*MockStore)(nil).RejectLoan), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+// RejectReferral mocks base method.
+func (m *MockStore) RejectReferral(arg0 context.Context, arg1 db.RejectReferralParams) (db.Referral, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "RejectReferral", arg0, arg1)
+	ret0, _ := ret[0].(db.Referral)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// RejectReferral indicates an expected call of RejectReferral.
+func (mr *MockStoreMockRecorder) RejectReferral(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectReferral", reflect.TypeOf((*MockStore)(nil).RejectReferral), arg0, arg1)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+// RelayOutboxEventsTx mocks base method.
+func (m *MockStore) RelayOutboxEventsTx(arg0 context.Context, arg1 int32, arg2 func([]db.OutboxEvent) error) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "RelayOutboxEventsTx", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+// RelayOutboxEventsTx indicates an expected call of RelayOutboxEventsTx.
+func (mr *MockStoreMockRecorder) RelayOutboxEventsTx(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayOutboxEventsTx", reflect.TypeOf((*MockStore)(nil).RelayOutboxEventsTx), arg0, arg1, arg2)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+// ReleaseHold mocks base method.
+func (m *MockStore) ReleaseHold(arg0 context.Context, arg1 int64) (db.Hold, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "ReleaseHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockStoreMockRecorder) ReleaseHold(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockStore)(nil).ReleaseHold), arg0, arg1)
 }
 
-// CreateUserTx mocks base method.
-func (m *MockStore) CreateUserTx(arg0 context.Context, arg1 db.CreateUserTxParams) (db.CreateUserTxResult, error) {
+// ReleaseHoldTx mocks base method.
+func (m *MockStore) ReleaseHoldTx(arg0 context.Context, arg1 db.ReleaseHoldTxParams) (db.ReleaseHoldTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserTx", arg0, arg1)
-	ret0, _ := ret[0].(db.CreateUserTxResult)
+	ret := m.ctrl.Call(m, "ReleaseHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReleaseHoldTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUserTx indicates an expected call of CreateUserTx.
-func (mr *MockStoreMockRecorder) CreateUserTx(arg0, arg1 interface{}) *gomock.Call {
+// ReleaseHoldTx indicates an expected call of ReleaseHoldTx.
+func (mr *MockStoreMockRecorder) ReleaseHoldTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserTx", reflect.TypeOf((*MockStore)(nil).CreateUserTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHoldTx", reflect.TypeOf((*MockStore)(nil).ReleaseHoldTx), arg0, arg1)
 }
 
-// CreateVerifyEmail mocks base method.
-func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+// ResolveDisputeTx mocks base method.
+func (m *MockStore) ResolveDisputeTx(arg0 context.Context, arg1 db.ResolveDisputeTxParams) (db.ResolveDisputeTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
-	ret0, _ := ret[0].(db.VerifyEmail)
+	ret := m.ctrl.Call(m, "ResolveDisputeTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ResolveDisputeTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
-func (mr *MockStoreMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+// ResolveDisputeTx indicates an expected call of ResolveDisputeTx.
+func (mr *MockStoreMockRecorder) ResolveDisputeTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDisputeTx", reflect.TypeOf((*MockStore)(nil).ResolveDisputeTx), arg0, arg1)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+// ReversePendingOperationTx mocks base method.
+func (m *MockStore) ReversePendingOperationTx(arg0 context.Context, arg1 db.ReversePendingOperationTxParams) (db.ReversePendingOperationTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ReversePendingOperationTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReversePendingOperationTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+// ReversePendingOperationTx indicates an expected call of ReversePendingOperationTx.
+func (mr *MockStoreMockRecorder) ReversePendingOperationTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReversePendingOperationTx", reflect.TypeOf((*MockStore)(nil).ReversePendingOperationTx), arg0, arg1)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ReverseTransferTx mocks base method.
+func (m *MockStore) ReverseTransferTx(arg0 context.Context, arg1 db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ReverseTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReverseTransferTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+// ReverseTransferTx indicates an expected call of ReverseTransferTx.
+func (mr *MockStoreMockRecorder) ReverseTransferTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransferTx", reflect.TypeOf((*MockStore)(nil).ReverseTransferTx), arg0, arg1)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ReviewKYCDocument mocks base method.
+func (m *MockStore) ReviewKYCDocument(arg0 context.Context, arg1 db.ReviewKYCDocumentParams) (db.KycDocument, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ReviewKYCDocument", arg0, arg1)
+	ret0, _ := ret[0].(db.KycDocument)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+// ReviewKYCDocument indicates an expected call of ReviewKYCDocument.
+func (mr *MockStoreMockRecorder) ReviewKYCDocument(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewKYCDocument", reflect.TypeOf((*MockStore)(nil).ReviewKYCDocument), arg0, arg1)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+// RevokeMandate mocks base method.
+func (m *MockStore) RevokeMandate(arg0 context.Context, arg1 int64) (db.Mandate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "RevokeMandate", arg0, arg1)
+	ret0, _ := ret[0].(db.Mandate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+// RevokeMandate indicates an expected call of RevokeMandate.
+func (mr *MockStoreMockRecorder) RevokeMandate(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeMandate", reflect.TypeOf((*MockStore)(nil).RevokeMandate), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// ScheduleUserDeletion mocks base method.
+func (m *MockStore) ScheduleUserDeletion(arg0 context.Context, arg1 db.ScheduleUserDeletionParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ScheduleUserDeletion", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// ScheduleUserDeletion indicates an expected call of ScheduleUserDeletion.
+func (mr *MockStoreMockRecorder) ScheduleUserDeletion(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserDeletion", reflect.TypeOf((*MockStore)(nil).ScheduleUserDeletion), arg0, arg1)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+// ScheduleUserDeletionTx mocks base method.
+func (m *MockStore) ScheduleUserDeletionTx(arg0 context.Context, arg1 db.ScheduleUserDeletionTxParams) (db.ScheduleUserDeletionTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ScheduleUserDeletionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduleUserDeletionTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+// ScheduleUserDeletionTx indicates an expected call of ScheduleUserDeletionTx.
+func (mr *MockStoreMockRecorder) ScheduleUserDeletionTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserDeletionTx", reflect.TypeOf((*MockStore)(nil).ScheduleUserDeletionTx), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+// SearchAccounts mocks base method.
+func (m *MockStore) SearchAccounts(arg0 context.Context, arg1 db.SearchAccountsParams) ([]db.SearchAccountsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "SearchAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchAccountsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// SearchAccounts indicates an expected call of SearchAccounts.
+func (mr *MockStoreMockRecorder) SearchAccounts(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockStore)(nil).SearchAccounts), arg0, arg1)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(arg0 context.Context, arg1 db.SearchUsersParams) ([]db.SearchUsersRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "SearchUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.SearchUsersRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), arg0, arg1)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
+// SetDefaultAccountTx mocks base method.
+func (m *MockStore) SetDefaultAccountTx(arg0 context.Context, arg1 db.SetDefaultAccountTxParams) (db.SetDefaultAccountTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "SetDefaultAccountTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SetDefaultAccountTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
+// SetDefaultAccountTx indicates an expected call of SetDefaultAccountTx.
+func (mr *MockStoreMockRecorder) SetDefaultAccountTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAccountTx", reflect.TypeOf((*MockStore)(nil).SetDefaultAccountTx), arg0, arg1)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
+// SoftDeleteUser mocks base method.
+func (m *MockStore) SoftDeleteUser(arg0 context.Context, arg1 string) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "SoftDeleteUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
+// SoftDeleteUser indicates an expected call of SoftDeleteUser.
+func (mr *MockStoreMockRecorder) SoftDeleteUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteUser", reflect.TypeOf((*MockStore)(nil).SoftDeleteUser), arg0, arg1)
+}
+
+// TouchUserDevice mocks base method.
+func (m *MockStore) TouchUserDevice(arg0 context.Context, arg1 db.TouchUserDeviceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchUserDevice", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchUserDevice indicates an expected call of TouchUserDevice.
+func (mr *MockStoreMockRecorder) TouchUserDevice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchUserDevice", reflect.TypeOf((*MockStore)(nil).TouchUserDevice), arg0, arg1)
+}
+
+// TransferAccountOwnershipTx mocks base method.
+func (m *MockStore) TransferAccountOwnershipTx(arg0 context.Context, arg1 db.TransferAccountOwnershipTxParams) (db.TransferAccountOwnershipTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferAccountOwnershipTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferAccountOwnershipTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferAccountOwnershipTx indicates an expected call of TransferAccountOwnershipTx.
+func (mr *MockStoreMockRecorder) TransferAccountOwnershipTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferAccountOwnershipTx", reflect.TypeOf((*MockStore)(nil).TransferAccountOwnershipTx), arg0, arg1)
 }
 
 // TransferTx mocks base method.
@@ -320,6 +2885,20 @@ func (mr *MockStoreMockRecorder) TransferTx(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), arg0, arg1)
 }
 
+// TrimPasswordHistory mocks base method.
+func (m *MockStore) TrimPasswordHistory(arg0 context.Context, arg1 db.TrimPasswordHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrimPasswordHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrimPasswordHistory indicates an expected call of TrimPasswordHistory.
+func (mr *MockStoreMockRecorder) TrimPasswordHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrimPasswordHistory", reflect.TypeOf((*MockStore)(nil).TrimPasswordHistory), arg0, arg1)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(arg0 context.Context, arg1 db.UpdateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -335,6 +2914,141 @@ func (mr *MockStoreMockRecorder) UpdateAccount(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), arg0, arg1)
 }
 
+// UpdateAccountOwner mocks base method.
+func (m *MockStore) UpdateAccountOwner(arg0 context.Context, arg1 db.UpdateAccountOwnerParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountOwner", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountOwner indicates an expected call of UpdateAccountOwner.
+func (mr *MockStoreMockRecorder) UpdateAccountOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountOwner", reflect.TypeOf((*MockStore)(nil).UpdateAccountOwner), arg0, arg1)
+}
+
+// UpdateBalanceAlert mocks base method.
+func (m *MockStore) UpdateBalanceAlert(arg0 context.Context, arg1 db.UpdateBalanceAlertParams) (db.BalanceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBalanceAlert", arg0, arg1)
+	ret0, _ := ret[0].(db.BalanceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBalanceAlert indicates an expected call of UpdateBalanceAlert.
+func (mr *MockStoreMockRecorder) UpdateBalanceAlert(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBalanceAlert", reflect.TypeOf((*MockStore)(nil).UpdateBalanceAlert), arg0, arg1)
+}
+
+// UpdateCardStatus mocks base method.
+func (m *MockStore) UpdateCardStatus(arg0 context.Context, arg1 db.UpdateCardStatusParams) (db.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCardStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCardStatus indicates an expected call of UpdateCardStatus.
+func (mr *MockStoreMockRecorder) UpdateCardStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCardStatus", reflect.TypeOf((*MockStore)(nil).UpdateCardStatus), arg0, arg1)
+}
+
+// UpdateDisputeStatus mocks base method.
+func (m *MockStore) UpdateDisputeStatus(arg0 context.Context, arg1 db.UpdateDisputeStatusParams) (db.Dispute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDisputeStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Dispute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDisputeStatus indicates an expected call of UpdateDisputeStatus.
+func (mr *MockStoreMockRecorder) UpdateDisputeStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisputeStatus", reflect.TypeOf((*MockStore)(nil).UpdateDisputeStatus), arg0, arg1)
+}
+
+// UpdateExportJob mocks base method.
+func (m *MockStore) UpdateExportJob(arg0 context.Context, arg1 db.UpdateExportJobParams) (db.ExportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExportJob", arg0, arg1)
+	ret0, _ := ret[0].(db.ExportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExportJob indicates an expected call of UpdateExportJob.
+func (mr *MockStoreMockRecorder) UpdateExportJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExportJob", reflect.TypeOf((*MockStore)(nil).UpdateExportJob), arg0, arg1)
+}
+
+// UpdateExternalTransferStatus mocks base method.
+func (m *MockStore) UpdateExternalTransferStatus(arg0 context.Context, arg1 db.UpdateExternalTransferStatusParams) (db.ExternalTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExternalTransferStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.ExternalTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExternalTransferStatus indicates an expected call of UpdateExternalTransferStatus.
+func (mr *MockStoreMockRecorder) UpdateExternalTransferStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExternalTransferStatus", reflect.TypeOf((*MockStore)(nil).UpdateExternalTransferStatus), arg0, arg1)
+}
+
+// UpdateLoanStatus mocks base method.
+func (m *MockStore) UpdateLoanStatus(arg0 context.Context, arg1 db.UpdateLoanStatusParams) (db.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoanStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoanStatus indicates an expected call of UpdateLoanStatus.
+func (mr *MockStoreMockRecorder) UpdateLoanStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoanStatus", reflect.TypeOf((*MockStore)(nil).UpdateLoanStatus), arg0, arg1)
+}
+
+// UpdatePendingOperationStatus mocks base method.
+func (m *MockStore) UpdatePendingOperationStatus(arg0 context.Context, arg1 db.UpdatePendingOperationStatusParams) (db.PendingOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingOperationStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.PendingOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePendingOperationStatus indicates an expected call of UpdatePendingOperationStatus.
+func (mr *MockStoreMockRecorder) UpdatePendingOperationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingOperationStatus", reflect.TypeOf((*MockStore)(nil).UpdatePendingOperationStatus), arg0, arg1)
+}
+
+// UpdateTransferMemo mocks base method.
+func (m *MockStore) UpdateTransferMemo(arg0 context.Context, arg1 db.UpdateTransferMemoParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransferMemo", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransferMemo indicates an expected call of UpdateTransferMemo.
+func (mr *MockStoreMockRecorder) UpdateTransferMemo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransferMemo", reflect.TypeOf((*MockStore)(nil).UpdateTransferMemo), arg0, arg1)
+}
+
 // UpdateUser mocks base method.
 func (m *MockStore) UpdateUser(arg0 context.Context, arg1 db.UpdateUserParams) (db.User, error) {
 	m.ctrl.T.Helper()
@@ -350,6 +3064,36 @@ func (mr *MockStoreMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), arg0, arg1)
 }
 
+// UpdateUserAvatar mocks base method.
+func (m *MockStore) UpdateUserAvatar(arg0 context.Context, arg1 db.UpdateUserAvatarParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserAvatar", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserAvatar indicates an expected call of UpdateUserAvatar.
+func (mr *MockStoreMockRecorder) UpdateUserAvatar(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserAvatar", reflect.TypeOf((*MockStore)(nil).UpdateUserAvatar), arg0, arg1)
+}
+
+// UpdateUserTx mocks base method.
+func (m *MockStore) UpdateUserTx(arg0 context.Context, arg1 db.UpdateUserTxParams) (db.UpdateUserTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateUserTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTx indicates an expected call of UpdateUserTx.
+func (mr *MockStoreMockRecorder) UpdateUserTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTx", reflect.TypeOf((*MockStore)(nil).UpdateUserTx), arg0, arg1)
+}
+
 // UpdateVerifyEmail mocks base method.
 func (m *MockStore) UpdateVerifyEmail(arg0 context.Context, arg1 db.UpdateVerifyEmailParams) (db.VerifyEmail, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +3109,36 @@ func (mr *MockStoreMockRecorder) UpdateVerifyEmail(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifyEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerifyEmail), arg0, arg1)
 }
 
+// UpsertFeatureFlag mocks base method.
+func (m *MockStore) UpsertFeatureFlag(arg0 context.Context, arg1 db.UpsertFeatureFlagParams) (db.FeatureFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFeatureFlag", arg0, arg1)
+	ret0, _ := ret[0].(db.FeatureFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertFeatureFlag indicates an expected call of UpsertFeatureFlag.
+func (mr *MockStoreMockRecorder) UpsertFeatureFlag(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFeatureFlag", reflect.TypeOf((*MockStore)(nil).UpsertFeatureFlag), arg0, arg1)
+}
+
+// UpsertNotificationPreferences mocks base method.
+func (m *MockStore) UpsertNotificationPreferences(arg0 context.Context, arg1 db.UpsertNotificationPreferencesParams) (db.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNotificationPreferences", arg0, arg1)
+	ret0, _ := ret[0].(db.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertNotificationPreferences indicates an expected call of UpsertNotificationPreferences.
+func (mr *MockStoreMockRecorder) UpsertNotificationPreferences(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNotificationPreferences", reflect.TypeOf((*MockStore)(nil).UpsertNotificationPreferences), arg0, arg1)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(arg0 context.Context, arg1 db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
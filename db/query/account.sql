@@ -2,39 +2,126 @@
 INSERT INTO accounts (
   owner,
   balance,
-  currency
+  currency,
+  number
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 ) RETURNING *;
 
 -- name: GetAccount :one
 SELECT * FROM accounts
-WHERE id = $1 LIMIT 1;
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetAccountByNumber :one
+SELECT * FROM accounts
+WHERE number = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetAccountByOwnerCurrency :one
+SELECT * FROM accounts
+WHERE owner = $1 AND currency = $2 AND deleted_at IS NULL
+ORDER BY is_default DESC, id
+LIMIT 1;
 
 -- name: GetAccountForUpdate :one
 SELECT * FROM accounts
-WHERE id = $1 LIMIT 1
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetAccountsByIDs :many
+SELECT * FROM accounts
+WHERE id = ANY(sqlc.arg(ids)::bigint[]) AND deleted_at IS NULL
+ORDER BY id;
+
+-- name: LockAccounts :exec
+SELECT id FROM accounts
+WHERE id = ANY(sqlc.arg(ids)::bigint[])
+ORDER BY id
 FOR NO KEY UPDATE;
 
 -- name: ListAccounts :many
 SELECT * FROM accounts
-WHERE OWNER = $1
+WHERE owner = sqlc.arg(owner)
+  AND id > sqlc.arg(after_id)
+  AND deleted_at IS NULL
 ORDER BY id
-LIMIT $2
-OFFSET $3;
+LIMIT sqlc.arg('limit');
 
 -- name: UpdateAccount :exec
 UPDATE accounts
-SET balance = $2
+SET balance = $2, updated_at = now()
 WHERE id = $1
 RETURNING *;
 
 -- name: AddAccountBalance :one
 UPDATE accounts
-SET balance = balance + sqlc.arg(amount)
+SET balance = balance + sqlc.arg(amount), updated_at = now()
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
 WHERE id = $1;
+
+-- name: SearchAccounts :many
+SELECT accounts.*, users.full_name, users.email, GREATEST(
+  similarity(users.username, sqlc.arg(query)),
+  similarity(users.full_name, sqlc.arg(query)),
+  similarity(users.email, sqlc.arg(query))
+)::real AS rank
+FROM accounts
+JOIN users ON users.username = accounts.owner
+WHERE
+  accounts.deleted_at IS NULL
+  AND users.deleted_at IS NULL
+  AND (
+    users.username % sqlc.arg(query)
+    OR users.full_name % sqlc.arg(query)
+    OR users.email % sqlc.arg(query)
+    OR users.username ILIKE '%' || sqlc.arg(query) || '%'
+    OR users.full_name ILIKE '%' || sqlc.arg(query) || '%'
+    OR users.email ILIKE '%' || sqlc.arg(query) || '%'
+  )
+ORDER BY rank DESC, accounts.id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: CountAccountsWithBalance :one
+SELECT count(*) FROM accounts
+WHERE owner = $1
+  AND deleted_at IS NULL
+  AND balance <> 0;
+
+-- name: CloseUserAccounts :exec
+UPDATE accounts
+SET deleted_at = now(), updated_at = now()
+WHERE owner = $1
+  AND deleted_at IS NULL;
+
+-- name: UpdateAccountOwner :one
+UPDATE accounts
+SET owner = sqlc.arg(owner), updated_at = now()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ClearDefaultAccount :many
+UPDATE accounts
+SET is_default = false, updated_at = now()
+WHERE owner = $1 AND currency = $2 AND is_default
+RETURNING id;
+
+-- name: MarkDefaultAccount :one
+UPDATE accounts
+SET is_default = true, updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListTotalDeposits :many
+SELECT
+  a.currency,
+  count(*) AS account_count,
+  COALESCE(SUM(a.balance), 0)::bigint AS total_balance
+FROM accounts a
+JOIN users u ON u.username = a.owner
+WHERE a.deleted_at IS NULL AND u.role = sqlc.arg(role)
+GROUP BY a.currency
+ORDER BY a.currency;
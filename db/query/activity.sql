@@ -0,0 +1,62 @@
+-- name: ListAccountActivity :many
+SELECT
+  kind::text AS kind,
+  item_id::text AS item_id,
+  occurred_at::timestamptz AS occurred_at,
+  amount::bigint AS amount,
+  transfer_id::bigint AS transfer_id,
+  counterparty_account_id::bigint AS counterparty_account_id,
+  card_id::bigint AS card_id,
+  status::text AS status,
+  description::text AS description,
+  user_agent::text AS user_agent,
+  client_ip::text AS client_ip,
+  country::text AS country,
+  city::text AS city
+FROM (
+  SELECT
+    CASE WHEN e.amount < 0 THEN 'transfer' ELSE 'deposit' END AS kind,
+    e.id::text AS item_id,
+    e.created_at AS occurred_at,
+    e.amount,
+    COALESCE(e.transfer_id, 0) AS transfer_id,
+    COALESCE(CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END, 0) AS counterparty_account_id,
+    0::bigint AS card_id,
+    '' AS status,
+    COALESCE(t.memo, '') AS description,
+    '' AS user_agent,
+    '' AS client_ip,
+    '' AS country,
+    '' AS city
+  FROM entries e
+  LEFT JOIN transfers t ON t.id = e.transfer_id
+  WHERE e.account_id = sqlc.arg(account_id)
+
+  UNION ALL
+
+  SELECT
+    'card_authorization', h.id::text, h.created_at, h.amount, COALESCE(h.transfer_id, 0), 0, h.card_id,
+    h.status, h.description, '', '', '', ''
+  FROM holds h
+  WHERE h.account_id = sqlc.arg(account_id) AND h.card_id IS NOT NULL
+
+  UNION ALL
+
+  SELECT
+    'security', s.id::text, s.created_at, 0, 0, 0, 0,
+    'login', '', s.user_agent, s.client_ip, s.country, s.city
+  FROM sessions s
+  WHERE s.username = sqlc.arg(owner)
+
+  UNION ALL
+
+  SELECT
+    'security', l.id::text, l.created_at, 0, 0, 0, 0,
+    l.action, '', '', l.client_ip, l.country, l.city
+  FROM audit_logs l
+  WHERE l.resource_type = 'account' AND l.resource_id = sqlc.arg(account_id)::bigint::text
+) AS activity
+WHERE sqlc.narg(before_time)::timestamptz IS NULL
+  OR (occurred_at, kind, item_id) < (sqlc.narg(before_time), sqlc.arg(before_kind)::text, sqlc.arg(before_item_id)::text)
+ORDER BY occurred_at DESC, kind DESC, item_id DESC
+LIMIT sqlc.arg('limit');
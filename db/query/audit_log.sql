@@ -0,0 +1,19 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (
+  actor,
+  action,
+  resource_type,
+  resource_id,
+  details,
+  client_ip,
+  country,
+  city
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListResourceAuditLogs :many
+SELECT * FROM audit_logs
+WHERE resource_type = $1 AND resource_id = $2
+ORDER BY created_at DESC, id DESC
+LIMIT $3;
@@ -0,0 +1,29 @@
+-- name: CreateBalanceAlert :one
+INSERT INTO balance_alerts (
+  account_id,
+  kind,
+  threshold
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetBalanceAlert :one
+SELECT * FROM balance_alerts
+WHERE id = $1 LIMIT 1;
+
+-- name: ListBalanceAlerts :many
+SELECT * FROM balance_alerts
+WHERE account_id = $1
+ORDER BY kind;
+
+-- name: UpdateBalanceAlert :one
+UPDATE balance_alerts
+SET
+  threshold = $2,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteBalanceAlert :exec
+DELETE FROM balance_alerts
+WHERE id = $1;
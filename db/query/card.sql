@@ -0,0 +1,36 @@
+-- name: CreateCard :one
+INSERT INTO cards (
+  account_id,
+  pan_hash,
+  last4,
+  expiry_month,
+  expiry_year
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetCard :one
+SELECT * FROM cards
+WHERE id = $1 LIMIT 1;
+
+-- name: GetCardByPanHash :one
+SELECT * FROM cards
+WHERE pan_hash = $1 LIMIT 1;
+
+-- name: GetCardForUpdate :one
+SELECT * FROM cards
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListCards :many
+SELECT * FROM cards
+WHERE account_id = $1
+ORDER BY id;
+
+-- name: UpdateCardStatus :one
+UPDATE cards
+SET
+  status = sqlc.arg(status),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,52 @@
+-- name: CreateDispute :one
+INSERT INTO disputes (
+  transfer_id,
+  opened_by,
+  reason
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetDispute :one
+SELECT * FROM disputes
+WHERE id = $1 LIMIT 1;
+
+-- name: GetDisputeForUpdate :one
+SELECT * FROM disputes
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListDisputes :many
+SELECT * FROM disputes
+WHERE
+  (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(opened_by)::varchar IS NULL OR opened_by = sqlc.narg(opened_by))
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateDisputeStatus :one
+UPDATE disputes
+SET
+  status = sqlc.arg(status),
+  resolution = COALESCE(sqlc.narg(resolution), resolution),
+  resolved_by = COALESCE(sqlc.narg(resolved_by), resolved_by),
+  refund_transfer_id = COALESCE(sqlc.narg(refund_transfer_id), refund_transfer_id),
+  resolved_at = COALESCE(sqlc.narg(resolved_at), resolved_at),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateDisputeNote :one
+INSERT INTO dispute_notes (
+  dispute_id,
+  author,
+  note
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListDisputeNotes :many
+SELECT * FROM dispute_notes
+WHERE dispute_id = $1
+ORDER BY id;
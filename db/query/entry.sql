@@ -1,8 +1,10 @@
+-- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  transfer_id
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -11,7 +13,86 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListEntries :many
 SELECT * FROM entries
-WHERE account_id = $1
+WHERE account_id = sqlc.arg(account_id)
+  AND id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT $2
-OFFSET $3;
\ No newline at end of file
+LIMIT sqlc.arg('limit');
+
+-- name: CountAccountEntries :one
+SELECT count(*) FROM entries
+WHERE account_id = $1;
+
+-- name: ListMonthlyAccountSummaries :many
+SELECT
+  a.id AS account_id,
+  a.owner,
+  a.currency,
+  u.full_name,
+  u.email,
+  u.locale,
+  COALESCE(SUM(e.amount) FILTER (
+    WHERE e.amount > 0 AND e.created_at < sqlc.arg(end_time)
+  ), 0)::bigint AS total_in,
+  COALESCE(-SUM(e.amount) FILTER (
+    WHERE e.amount < 0 AND e.created_at < sqlc.arg(end_time)
+  ), 0)::bigint AS total_out,
+  (a.balance - COALESCE(SUM(e.amount) FILTER (
+    WHERE e.created_at >= sqlc.arg(end_time)
+  ), 0))::bigint AS ending_balance
+FROM accounts a
+JOIN users u ON u.username = a.owner
+LEFT JOIN entries e ON e.account_id = a.id AND e.created_at >= sqlc.arg(start_time)
+WHERE a.deleted_at IS NULL
+  AND u.deleted_at IS NULL
+  AND u.is_email_verified = true
+GROUP BY a.id, u.username
+ORDER BY a.owner, a.id;
+
+-- name: GetAccountStatement :one
+SELECT
+  a.number,
+  a.currency,
+  a.created_at,
+  u.full_name,
+  a.balance AS closing_balance,
+  (a.balance - COALESCE(SUM(e.amount), 0))::bigint AS opening_balance,
+  count(e.id) AS entry_count,
+  COALESCE(MAX(e.id), 0)::bigint AS last_entry_id
+FROM accounts a
+JOIN users u ON u.username = a.owner
+LEFT JOIN entries e ON e.account_id = a.id
+WHERE a.id = $1
+GROUP BY a.id, u.username;
+
+-- name: ListStatementEntries :many
+SELECT
+  e.id,
+  e.amount,
+  e.transfer_id,
+  e.created_at,
+  COALESCE(t.memo, '')::varchar AS memo,
+  COALESCE(t.reference, '')::varchar AS reference,
+  COALESCE(CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END, 0)::bigint AS counterparty_account_id
+FROM entries e
+LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = sqlc.arg(account_id)
+  AND e.id > sqlc.arg(after_id)
+  AND e.id <= sqlc.arg(last_id)
+ORDER BY e.id
+LIMIT sqlc.arg('limit');
+
+-- name: ListTopAccountsByActivity :many
+SELECT
+  a.id AS account_id,
+  a.owner,
+  a.currency,
+  count(*) AS entry_count,
+  COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0)::bigint AS total_in,
+  COALESCE(-SUM(e.amount) FILTER (WHERE e.amount < 0), 0)::bigint AS total_out
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.created_at >= sqlc.arg(start_time)
+  AND e.created_at < sqlc.arg(end_time)
+GROUP BY a.id
+ORDER BY entry_count DESC, a.id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,26 @@
+-- name: CreateExportJob :one
+INSERT INTO export_jobs (
+  owner,
+  account_id,
+  kind,
+  format
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetExportJob :one
+SELECT * FROM export_jobs
+WHERE id = $1 LIMIT 1;
+
+-- name: UpdateExportJob :one
+UPDATE export_jobs
+SET
+  status = COALESCE(sqlc.narg(status), status),
+  processed_rows = COALESCE(sqlc.narg(processed_rows), processed_rows),
+  total_rows = COALESCE(sqlc.narg(total_rows), total_rows),
+  file_key = COALESCE(sqlc.narg(file_key), file_key),
+  error = COALESCE(sqlc.narg(error), error),
+  completed_at = COALESCE(sqlc.narg(completed_at), completed_at),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
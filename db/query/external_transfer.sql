@@ -0,0 +1,30 @@
+-- name: CreateExternalTransfer :one
+INSERT INTO external_transfers (
+  account_id,
+  hold_id,
+  amount,
+  currency,
+  routing_number,
+  account_number
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetExternalTransfer :one
+SELECT * FROM external_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetExternalTransferForUpdate :one
+SELECT * FROM external_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: UpdateExternalTransferStatus :one
+UPDATE external_transfers
+SET
+  status = sqlc.arg(status),
+  return_reason = sqlc.arg(return_reason),
+  transfer_id = COALESCE(sqlc.narg(transfer_id), transfer_id),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
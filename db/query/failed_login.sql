@@ -0,0 +1,20 @@
+-- name: CreateFailedLogin :one
+INSERT INTO failed_logins (
+  username,
+  reason,
+  client_ip
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListDailyFailedLogins :many
+SELECT
+  (date_trunc('day', created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+  count(*) AS failed_count,
+  count(DISTINCT username) AS username_count,
+  count(DISTINCT client_ip) AS client_ip_count
+FROM failed_logins
+WHERE created_at >= sqlc.arg(start_time)
+  AND created_at < sqlc.arg(end_time)
+GROUP BY day
+ORDER BY day;
@@ -0,0 +1,28 @@
+-- name: GetFeatureFlag :one
+SELECT * FROM feature_flags
+WHERE name = $1 LIMIT 1;
+
+-- name: ListFeatureFlags :many
+SELECT * FROM feature_flags
+ORDER BY name;
+
+-- name: UpsertFeatureFlag :one
+INSERT INTO feature_flags (
+  name,
+  enabled,
+  rollout_percentage,
+  description,
+  updated_by
+) VALUES (
+  $1, $2, $3, $4, $5
+) ON CONFLICT (name) DO UPDATE SET
+  enabled = EXCLUDED.enabled,
+  rollout_percentage = EXCLUDED.rollout_percentage,
+  description = EXCLUDED.description,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteFeatureFlag :exec
+DELETE FROM feature_flags
+WHERE name = $1;
@@ -0,0 +1,50 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+  account_id,
+  amount,
+  description,
+  card_id
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListActiveHolds :many
+SELECT * FROM holds
+WHERE account_id = $1
+  AND status = 'active'
+ORDER BY id;
+
+-- name: GetHeldAmount :one
+SELECT COALESCE(sum(amount), 0)::bigint AS held_amount
+FROM holds
+WHERE account_id = $1
+  AND status = 'active';
+
+-- name: ReleaseHold :one
+UPDATE holds
+SET
+  status = 'released',
+  resolved_at = now()
+WHERE id = $1
+  AND status = 'active'
+RETURNING *;
+
+-- name: CaptureHold :one
+UPDATE holds
+SET
+  status = 'captured',
+  captured_amount = sqlc.arg(captured_amount),
+  transfer_id = sqlc.arg(transfer_id),
+  resolved_at = now()
+WHERE id = sqlc.arg(id)
+  AND status = 'active'
+RETURNING *;
@@ -0,0 +1,14 @@
+-- name: CreateIdentity :one
+INSERT INTO identities (
+  username,
+  provider,
+  subject,
+  email
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetIdentity :one
+SELECT * FROM identities
+WHERE provider = $1 AND subject = $2
+LIMIT 1;
@@ -0,0 +1,45 @@
+-- name: CreateKYCDocument :one
+INSERT INTO kyc_documents (
+  username,
+  document_type,
+  file_key,
+  content_type,
+  size_bytes
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetKYCDocument :one
+SELECT * FROM kyc_documents
+WHERE id = $1 LIMIT 1;
+
+-- name: ListKYCDocuments :many
+SELECT * FROM kyc_documents
+WHERE username = $1
+ORDER BY id;
+
+-- name: ListPendingKYCDocuments :many
+SELECT * FROM kyc_documents
+WHERE status = 'pending'
+ORDER BY created_at
+LIMIT $1
+OFFSET $2;
+
+-- name: ReviewKYCDocument :one
+UPDATE kyc_documents
+SET
+  status = sqlc.arg(status),
+  rejection_reason = sqlc.arg(rejection_reason),
+  reviewed_by = sqlc.arg(reviewed_by),
+  reviewed_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: GetKYCLevel :one
+SELECT (
+  CASE WHEN bool_or(document_type IN ('passport', 'drivers_license', 'national_id')) THEN
+    CASE WHEN bool_or(document_type = 'proof_of_address') THEN 2 ELSE 1 END
+  ELSE 0 END
+)::int AS kyc_level
+FROM kyc_documents
+WHERE username = $1 AND status = 'approved';
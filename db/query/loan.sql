@@ -0,0 +1,102 @@
+-- name: CreateLoan :one
+INSERT INTO loans (
+  account_id,
+  principal,
+  currency,
+  annual_rate_bps,
+  term_months
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetLoan :one
+SELECT * FROM loans
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLoanForUpdate :one
+SELECT * FROM loans
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ApproveLoan :one
+UPDATE loans
+SET
+  status = 'active',
+  funding_account_id = sqlc.arg(funding_account_id),
+  disbursement_transfer_id = sqlc.arg(disbursement_transfer_id),
+  reviewed_by = sqlc.arg(reviewed_by),
+  reviewed_at = now(),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: RejectLoan :one
+UPDATE loans
+SET
+  status = 'rejected',
+  reviewed_by = sqlc.arg(reviewed_by),
+  reviewed_at = now(),
+  updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: UpdateLoanStatus :one
+UPDATE loans
+SET
+  status = $1,
+  updated_at = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: MarkLoanDelinquent :one
+UPDATE loans
+SET
+  status = 'delinquent',
+  updated_at = now()
+WHERE id = $1 AND status = 'active'
+RETURNING *;
+
+-- name: CreateLoanPayment :one
+INSERT INTO loan_payments (
+  loan_id,
+  installment,
+  due_date,
+  principal,
+  interest,
+  amount
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetLoanPaymentForUpdate :one
+SELECT * FROM loan_payments
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListLoanPayments :many
+SELECT * FROM loan_payments
+WHERE loan_id = $1
+ORDER BY installment;
+
+-- name: ListDueLoanPayments :many
+SELECT * FROM loan_payments
+WHERE status = 'scheduled' AND due_date <= $1 AND id > $2
+ORDER BY id
+LIMIT $3;
+
+-- name: MarkLoanPaymentPaid :one
+UPDATE loan_payments
+SET
+  status = 'paid',
+  transfer_id = $1,
+  paid_at = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: CountUnpaidLoanPayments :one
+SELECT count(*) FROM loan_payments
+WHERE loan_id = $1 AND status = 'scheduled';
+
+-- name: CountOverdueLoanPayments :one
+SELECT count(*) FROM loan_payments
+WHERE loan_id = $1 AND status = 'scheduled' AND due_date < $2;
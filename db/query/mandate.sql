@@ -0,0 +1,32 @@
+-- name: CreateMandate :one
+INSERT INTO mandates (
+  payer_account_id,
+  payee_account_id,
+  max_amount
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetMandate :one
+SELECT * FROM mandates
+WHERE id = $1 LIMIT 1;
+
+-- name: GetMandateForUpdate :one
+SELECT * FROM mandates
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: RevokeMandate :one
+UPDATE mandates
+SET
+  is_revoked = true,
+  revoked_at = now()
+WHERE id = $1
+  AND is_revoked = false
+RETURNING *;
+
+-- name: AddMandateCollectedAmount :one
+UPDATE mandates
+SET collected_amount = collected_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,28 @@
+-- name: GetNotificationPreferences :one
+SELECT * FROM notification_preferences
+WHERE username = $1 LIMIT 1;
+
+-- name: UpsertNotificationPreferences :one
+INSERT INTO notification_preferences (
+  username,
+  email_enabled,
+  sms_enabled,
+  push_enabled,
+  phone_number,
+  device_token
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (username) DO UPDATE
+SET
+  email_enabled = EXCLUDED.email_enabled,
+  sms_enabled = EXCLUDED.sms_enabled,
+  push_enabled = EXCLUDED.push_enabled,
+  phone_number = EXCLUDED.phone_number,
+  device_token = EXCLUDED.device_token,
+  updated_at = now()
+RETURNING *;
+
+-- name: DeleteNotificationPreferences :exec
+DELETE FROM notification_preferences
+WHERE username = $1;
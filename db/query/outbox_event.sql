@@ -0,0 +1,25 @@
+-- name: CreateOutboxEvent :one
+INSERT INTO outbox_events (
+  event_type,
+  event_key,
+  payload
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListUnpublishedOutboxEventsForUpdate :many
+SELECT * FROM outbox_events
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT $1
+FOR UPDATE;
+
+-- name: MarkOutboxEventsPublished :exec
+UPDATE outbox_events
+SET published_at = now()
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
+
+-- name: DeleteOutboxEvents :exec
+DELETE FROM outbox_events
+WHERE created_at < sqlc.arg(created_before)
+AND (published_at IS NOT NULL OR sqlc.arg(include_unpublished)::bool);
@@ -0,0 +1,37 @@
+-- name: CreatePendingOperation :one
+INSERT INTO pending_operations (
+  transfer_id,
+  kind
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: GetPendingOperation :one
+SELECT * FROM pending_operations
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPendingOperationForUpdate :one
+SELECT * FROM pending_operations
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetPendingOperationByTransfer :one
+SELECT * FROM pending_operations
+WHERE transfer_id = $1 LIMIT 1;
+
+-- name: UpdatePendingOperationStatus :one
+UPDATE pending_operations
+SET
+  status = sqlc.arg(status),
+  last_error = sqlc.arg(last_error),
+  reversal_transfer_id = sqlc.narg(reversal_transfer_id),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListStalePendingOperations :many
+SELECT * FROM pending_operations
+WHERE status = 'pending'
+  AND created_at < $1
+ORDER BY created_at
+LIMIT $2;
@@ -0,0 +1,70 @@
+-- name: CountAllAccounts :one
+SELECT count(*) FROM accounts;
+
+-- name: ListBalanceDiscrepancies :many
+SELECT
+  a.id AS account_id,
+  a.balance AS account_balance,
+  COALESCE(SUM(e.amount), 0)::bigint AS entries_balance
+FROM accounts a
+LEFT JOIN entries e ON e.account_id = a.id
+GROUP BY a.id
+HAVING a.balance <> COALESCE(SUM(e.amount), 0)
+ORDER BY a.id;
+
+-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (
+  accounts_checked,
+  discrepancy_count,
+  started_at
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetReconciliationReport :one
+SELECT * FROM reconciliation_reports
+WHERE id = $1 LIMIT 1;
+
+-- name: GetLatestReconciliationReport :one
+SELECT * FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateReconciliationDiscrepancy :one
+INSERT INTO reconciliation_discrepancies (
+  report_id,
+  account_id,
+  account_balance,
+  entries_balance
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListReconciliationDiscrepancies :many
+SELECT * FROM reconciliation_discrepancies
+WHERE report_id = $1
+ORDER BY account_id;
+
+-- name: CountAllTransfers :one
+SELECT count(*) FROM transfers;
+
+-- name: ListUnbalancedTransfers :many
+SELECT
+  t.id AS transfer_id,
+  t.from_account_id,
+  t.to_account_id,
+  t.amount,
+  COUNT(e.id) AS entry_count,
+  COALESCE(SUM(e.amount), 0)::bigint AS entries_sum
+FROM transfers t
+LEFT JOIN entries e ON e.transfer_id = t.id
+GROUP BY t.id
+HAVING COUNT(e.id) <> 2
+  OR COALESCE(SUM(e.amount) FILTER (
+    WHERE e.account_id = t.from_account_id AND e.amount < 0
+  ), 0) <> -t.amount
+  OR COALESCE(SUM(e.amount) FILTER (
+    WHERE e.account_id = t.to_account_id AND e.amount > 0
+  ), 0) <> t.amount
+ORDER BY t.id
+LIMIT $1;
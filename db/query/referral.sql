@@ -0,0 +1,75 @@
+-- name: CreateReferralCode :one
+INSERT INTO referral_codes (
+  username,
+  code
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: GetReferralCode :one
+SELECT * FROM referral_codes
+WHERE code = $1 LIMIT 1;
+
+-- name: GetReferralCodeByUsername :one
+SELECT * FROM referral_codes
+WHERE username = $1 LIMIT 1;
+
+-- name: CreateReferral :one
+INSERT INTO referrals (
+  referrer,
+  referred,
+  signup_ip
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetReferralForUpdate :one
+SELECT * FROM referrals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetReferralStats :one
+SELECT
+  count(*) FILTER (WHERE status = 'pending') AS pending_count,
+  count(*) FILTER (WHERE status = 'credited') AS credited_count
+FROM referrals
+WHERE referrer = $1;
+
+-- name: ListEligibleReferrals :many
+SELECT r.* FROM referrals r
+JOIN users u ON u.username = r.referred
+WHERE r.status = 'pending' AND r.id > $1
+  AND u.is_email_verified
+  AND EXISTS (
+    SELECT 1 FROM entries e
+    JOIN accounts a ON a.id = e.account_id
+    WHERE a.owner = r.referred AND e.amount > 0
+  )
+ORDER BY r.id
+LIMIT $2;
+
+-- name: CountCreditedReferralsByReferrer :one
+SELECT count(*) FROM referrals
+WHERE referrer = $1 AND status = 'credited';
+
+-- name: CountCreditedReferralsBySignupIP :one
+SELECT count(*) FROM referrals
+WHERE signup_ip = $1 AND status = 'credited';
+
+-- name: CreditReferral :one
+UPDATE referrals
+SET
+  status = 'credited',
+  referrer_transfer_id = $2,
+  referred_transfer_id = $3,
+  credited_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: RejectReferral :one
+UPDATE referrals
+SET
+  status = 'rejected',
+  reject_reason = $2
+WHERE id = $1
+RETURNING *;
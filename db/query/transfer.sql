@@ -1,20 +1,79 @@
+-- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  category,
+  memo,
+  reference,
+  reversal_of,
+  fee_of
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers
 WHERE id = $1 LIMIT 1;
 
+-- name: GetTransferReversal :one
+SELECT * FROM transfers
+WHERE reversal_of = sqlc.arg(transfer_id)::bigint LIMIT 1;
+
+-- name: CountAccountTransfers :one
+SELECT count(*) FROM transfers
+WHERE from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id);
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
-WHERE 
-    from_account_id = $1 OR
-    to_account_id = $2
+WHERE
+    (from_account_id = sqlc.arg(from_account_id) OR
+    to_account_id = sqlc.arg(to_account_id))
+    AND id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT $3
-OFFSET $4;
\ No newline at end of file
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateTransferMemo :one
+UPDATE transfers
+SET
+  memo = COALESCE(sqlc.narg(memo), memo),
+  category = COALESCE(sqlc.narg(category), category)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: GetSpendingSummary :many
+SELECT
+  date_trunc('month', created_at)::timestamptz AS month,
+  category,
+  SUM(amount)::bigint AS total_amount,
+  COUNT(*) AS transfer_count
+FROM transfers
+WHERE from_account_id = sqlc.arg(account_id)
+  AND created_at >= sqlc.arg(start_time)
+  AND created_at < sqlc.arg(end_time)
+GROUP BY month, category
+ORDER BY month, category;
+
+-- name: BackdateTransfer :exec
+WITH backdated_transfer AS (
+  UPDATE transfers
+  SET created_at = sqlc.arg(created_at)
+  WHERE id = sqlc.arg(id)
+)
+UPDATE entries
+SET created_at = sqlc.arg(created_at)
+WHERE transfer_id = sqlc.arg(id);
+
+-- name: ListDailyTransferVolume :many
+SELECT
+  (date_trunc('day', t.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+  a.currency,
+  count(*) AS transfer_count,
+  COALESCE(SUM(t.amount), 0)::bigint AS total_amount
+FROM transfers t
+JOIN accounts a ON a.id = t.from_account_id
+WHERE t.created_at >= sqlc.arg(start_time)
+  AND t.created_at < sqlc.arg(end_time)
+  AND t.fee_of IS NULL
+GROUP BY day, a.currency
+ORDER BY day, a.currency;
@@ -0,0 +1,31 @@
+-- name: CreateTransferQuote :one
+INSERT INTO transfer_quotes (
+  username,
+  from_currency,
+  to_currency,
+  from_amount,
+  fee,
+  to_amount,
+  rate,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetTransferQuote :one
+SELECT * FROM transfer_quotes
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferQuoteForUpdate :one
+SELECT * FROM transfer_quotes
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: MarkTransferQuoteUsed :one
+UPDATE transfer_quotes
+SET
+  used_at = now(),
+  debit_transfer_id = sqlc.arg(debit_transfer_id),
+  credit_transfer_id = sqlc.arg(credit_transfer_id)
+WHERE id = sqlc.arg(id) AND used_at IS NULL
+RETURNING *;
@@ -1,15 +1,56 @@
+-- name: CreateUser :one
 INSERT INTO users (
   username,
   hashed_password,
   full_name,
-  email
+  email,
+  locale
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: CreateAdminUser :one
+INSERT INTO users (
+  username,
+  hashed_password,
+  full_name,
+  email,
+  role,
+  is_email_verified
+) VALUES (
+  $1, $2, $3, $4, 'admin', true
 ) RETURNING *;
 
 -- name: GetUser :one
 SELECT * FROM users
-WHERE username = $1 LIMIT 1;
+WHERE username = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetUserForUpdate :one
+SELECT * FROM users
+WHERE username = $1 AND deleted_at IS NULL LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetUserByEmail :one
+SELECT * FROM users
+WHERE email = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: GetUsersByUsernames :many
+SELECT * FROM users
+WHERE username = ANY(sqlc.arg(usernames)::text[]) AND deleted_at IS NULL
+ORDER BY username;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE
+  deleted_at IS NULL
+  AND (
+    sqlc.narg(search)::text IS NULL
+    OR username ILIKE '%' || sqlc.narg(search) || '%'
+    OR email ILIKE '%' || sqlc.narg(search) || '%'
+  )
+ORDER BY username
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
 
 -- name: UpdateUser :one
 UPDATE users
@@ -17,7 +58,124 @@ SET
   hashed_password = COALESCE(sqlc.narg(hashed_password), hashed_password),
   password_changed_at = COALESCE(sqlc.narg(password_changed_at), password_changed_at),
   full_name = COALESCE(sqlc.narg(full_name), full_name),
-  email = COALESCE(sqlc.narg(email), email)
+  email = COALESCE(sqlc.narg(email), email),
+  is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified),
+  is_disabled = COALESCE(sqlc.narg(is_disabled), is_disabled),
+  password_reset_required = COALESCE(sqlc.narg(password_reset_required), password_reset_required),
+  locale = COALESCE(sqlc.narg(locale), locale),
+  allow_alias_transfers = COALESCE(sqlc.narg(allow_alias_transfers), allow_alias_transfers),
+  phone_number = COALESCE(sqlc.narg(phone_number), phone_number),
+  date_of_birth = COALESCE(sqlc.narg(date_of_birth), date_of_birth),
+  address = COALESCE(sqlc.narg(address), address),
+  updated_at = now()
+WHERE
+  username = sqlc.arg(username)
+  AND (sqlc.narg(expected_updated_at)::timestamptz IS NULL OR updated_at = sqlc.narg(expected_updated_at))
+RETURNING *;
+
+-- name: SearchUsers :many
+SELECT *, GREATEST(
+  similarity(username, sqlc.arg(query)),
+  similarity(full_name, sqlc.arg(query)),
+  similarity(email, sqlc.arg(query))
+)::real AS rank
+FROM users
+WHERE
+  deleted_at IS NULL
+  AND (
+    username % sqlc.arg(query)
+    OR full_name % sqlc.arg(query)
+    OR email % sqlc.arg(query)
+    OR username ILIKE '%' || sqlc.arg(query) || '%'
+    OR full_name ILIKE '%' || sqlc.arg(query) || '%'
+    OR email ILIKE '%' || sqlc.arg(query) || '%'
+  )
+ORDER BY rank DESC, username
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: SoftDeleteUser :one
+UPDATE users
+SET deleted_at = now(), updated_at = now()
+WHERE username = $1
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ScheduleUserDeletion :one
+UPDATE users
+SET deletion_scheduled_at = sqlc.arg(deletion_scheduled_at), updated_at = now()
+WHERE username = sqlc.arg(username)
+  AND deleted_at IS NULL
+  AND deletion_scheduled_at IS NULL
+RETURNING *;
+
+-- name: CancelUserDeletion :one
+UPDATE users
+SET deletion_scheduled_at = NULL, updated_at = now()
+WHERE username = sqlc.arg(username)
+  AND deletion_scheduled_at = sqlc.arg(deletion_scheduled_at)
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: AnonymizeUser :one
+UPDATE users
+SET
+  hashed_password = '',
+  full_name = 'Deleted User',
+  email = username || '@deleted.invalid',
+  phone_number = '',
+  date_of_birth = NULL,
+  address = '',
+  avatar_key = '',
+  updated_at = now()
+WHERE username = $1
+  AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: ListUsersDueForVerifyEmailReminder :many
+SELECT * FROM users
+WHERE
+  is_email_verified = false
+  AND is_disabled = false
+  AND deleted_at IS NULL
+  AND verify_email_reminders < sqlc.arg(max_reminders)::int
+  AND COALESCE(last_verify_email_reminder_at, created_at) < sqlc.arg(remind_before)
+ORDER BY created_at
+LIMIT sqlc.arg('limit');
+
+-- name: MarkVerifyEmailReminderSent :execrows
+UPDATE users
+SET
+  verify_email_reminders = verify_email_reminders + 1,
+  last_verify_email_reminder_at = now()
 WHERE
   username = sqlc.arg(username)
+  AND verify_email_reminders = sqlc.arg(verify_email_reminders)
+  AND is_email_verified = false;
+
+-- name: ListUnverifiedUsers :many
+SELECT * FROM users
+WHERE
+  is_email_verified = false
+  AND is_disabled = false
+  AND deleted_at IS NULL
+  AND created_at < sqlc.arg(created_before)
+ORDER BY created_at
+LIMIT sqlc.arg('limit');
+
+-- name: ListDailyNewUsers :many
+SELECT
+  (date_trunc('day', created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+  count(*) AS user_count
+FROM users
+WHERE created_at >= sqlc.arg(start_time)
+  AND created_at < sqlc.arg(end_time)
+GROUP BY day
+ORDER BY day;
+
+-- name: UpdateUserAvatar :one
+UPDATE users
+SET avatar_key = sqlc.arg(avatar_key), updated_at = now()
+WHERE username = sqlc.arg(username)
+  AND deleted_at IS NULL
 RETURNING *;
@@ -0,0 +1,32 @@
+-- name: GetUserDevice :one
+SELECT * FROM user_devices
+WHERE username = $1 AND fingerprint = $2 LIMIT 1;
+
+-- name: CountUserDevices :one
+SELECT count(*) FROM user_devices
+WHERE username = $1;
+
+-- name: CreateUserDevice :one
+INSERT INTO user_devices (
+  username,
+  fingerprint,
+  user_agent,
+  client_ip
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: TouchUserDevice :exec
+UPDATE user_devices
+SET user_agent = $2,
+  client_ip = $3,
+  last_seen_at = now()
+WHERE id = $1;
+
+-- name: DeleteUserDevice :exec
+DELETE FROM user_devices
+WHERE id = $1 AND username = $2;
+
+-- name: DeleteUserDevices :exec
+DELETE FROM user_devices
+WHERE username = $1;
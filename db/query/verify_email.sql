@@ -17,3 +17,12 @@ WHERE
     AND is_used = FALSE
     AND expired_at > now()
 RETURNING *;
+
+-- name: DeleteUserVerifyEmails :exec
+DELETE FROM verify_emails
+WHERE username = $1;
+
+-- name: GetVerifyEmailForUpdate :one
+SELECT * FROM verify_emails
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
@@ -7,13 +7,16 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
-SET balance = balance + $1
+SET balance = balance + $1, updated_at = now()
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default
 `
 
 type AddAccountBalanceParams struct {
@@ -30,28 +33,97 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
 	)
 	return i, err
 }
 
+const clearDefaultAccount = `-- name: ClearDefaultAccount :many
+UPDATE accounts
+SET is_default = false, updated_at = now()
+WHERE owner = $1 AND currency = $2 AND is_default
+RETURNING id
+`
+
+type ClearDefaultAccountParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) ClearDefaultAccount(ctx context.Context, arg ClearDefaultAccountParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, clearDefaultAccount, arg.Owner, arg.Currency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const closeUserAccounts = `-- name: CloseUserAccounts :exec
+UPDATE accounts
+SET deleted_at = now(), updated_at = now()
+WHERE owner = $1
+  AND deleted_at IS NULL
+`
+
+func (q *Queries) CloseUserAccounts(ctx context.Context, owner string) error {
+	_, err := q.db.Exec(ctx, closeUserAccounts, owner)
+	return err
+}
+
+const countAccountsWithBalance = `-- name: CountAccountsWithBalance :one
+SELECT count(*) FROM accounts
+WHERE owner = $1
+  AND deleted_at IS NULL
+  AND balance <> 0
+`
+
+func (q *Queries) CountAccountsWithBalance(ctx context.Context, owner string) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccountsWithBalance, owner)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (
   owner,
   balance,
-  currency
+  currency,
+  number
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, owner, balance, currency, created_at
+  $1, $2, $3, $4
+) RETURNING id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default
 `
 
 type CreateAccountParams struct {
 	Owner    string `json:"owner"`
 	Balance  int64  `json:"balance"`
 	Currency string `json:"currency"`
+	Number   string `json:"number"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRow(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRow(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Number,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -59,6 +131,10 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
 	)
 	return i, err
 }
@@ -74,8 +150,8 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts
-WHERE id = $1 LIMIT 1
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
@@ -87,13 +163,68 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getAccountByNumber = `-- name: GetAccountByNumber :one
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE number = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetAccountByNumber(ctx context.Context, number string) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByNumber, number)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const getAccountByOwnerCurrency = `-- name: GetAccountByOwnerCurrency :one
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE owner = $1 AND currency = $2 AND deleted_at IS NULL
+ORDER BY is_default DESC, id
+LIMIT 1
+`
+
+type GetAccountByOwnerCurrencyParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetAccountByOwnerCurrency(ctx context.Context, arg GetAccountByOwnerCurrencyParams) (Account, error) {
+	row := q.db.QueryRow(ctx, getAccountByOwnerCurrency, arg.Owner, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts
-WHERE id = $1 LIMIT 1
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE id = $1 AND deleted_at IS NULL LIMIT 1
 FOR NO KEY UPDATE
 `
 
@@ -106,26 +237,67 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
 	)
 	return i, err
 }
 
+const getAccountsByIDs = `-- name: GetAccountsByIDs :many
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE id = ANY($1::bigint[]) AND deleted_at IS NULL
+ORDER BY id
+`
+
+func (q *Queries) GetAccountsByIDs(ctx context.Context, ids []int64) ([]Account, error) {
+	rows, err := q.db.Query(ctx, getAccountsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.UpdatedAt,
+			&i.Number,
+			&i.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at FROM accounts
-WHERE OWNER = $1
+SELECT id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default FROM accounts
+WHERE owner = $1
+  AND id > $2
+  AND deleted_at IS NULL
 ORDER BY id
-LIMIT $2
-OFFSET $3
+LIMIT $3
 `
 
 type ListAccountsParams struct {
-	Owner  string `json:"owner"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Owner   string `json:"owner"`
+	AfterID int64  `json:"after_id"`
+	Limit   int32  `json:"limit"`
 }
 
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.Query(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listAccounts, arg.Owner, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +311,162 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.UpdatedAt,
+			&i.Number,
+			&i.IsDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTotalDeposits = `-- name: ListTotalDeposits :many
+SELECT
+  a.currency,
+  count(*) AS account_count,
+  COALESCE(SUM(a.balance), 0)::bigint AS total_balance
+FROM accounts a
+JOIN users u ON u.username = a.owner
+WHERE a.deleted_at IS NULL AND u.role = $1
+GROUP BY a.currency
+ORDER BY a.currency
+`
+
+type ListTotalDepositsRow struct {
+	Currency     string `json:"currency"`
+	AccountCount int64  `json:"account_count"`
+	TotalBalance int64  `json:"total_balance"`
+}
+
+func (q *Queries) ListTotalDeposits(ctx context.Context, role string) ([]ListTotalDepositsRow, error) {
+	rows, err := q.db.Query(ctx, listTotalDeposits, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTotalDepositsRow{}
+	for rows.Next() {
+		var i ListTotalDepositsRow
+		if err := rows.Scan(&i.Currency, &i.AccountCount, &i.TotalBalance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAccounts = `-- name: LockAccounts :exec
+SELECT id FROM accounts
+WHERE id = ANY($1::bigint[])
+ORDER BY id
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) LockAccounts(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, lockAccounts, ids)
+	return err
+}
+
+const markDefaultAccount = `-- name: MarkDefaultAccount :one
+UPDATE accounts
+SET is_default = true, updated_at = now()
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default
+`
+
+func (q *Queries) MarkDefaultAccount(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRow(ctx, markDefaultAccount, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const searchAccounts = `-- name: SearchAccounts :many
+SELECT accounts.id, accounts.owner, accounts.balance, accounts.currency, accounts.created_at, accounts.deleted_at, accounts.updated_at, accounts.number, accounts.is_default, users.full_name, users.email, GREATEST(
+  similarity(users.username, $1),
+  similarity(users.full_name, $1),
+  similarity(users.email, $1)
+)::real AS rank
+FROM accounts
+JOIN users ON users.username = accounts.owner
+WHERE
+  accounts.deleted_at IS NULL
+  AND users.deleted_at IS NULL
+  AND (
+    users.username % $1
+    OR users.full_name % $1
+    OR users.email % $1
+    OR users.username ILIKE '%' || $1 || '%'
+    OR users.full_name ILIKE '%' || $1 || '%'
+    OR users.email ILIKE '%' || $1 || '%'
+  )
+ORDER BY rank DESC, accounts.id
+LIMIT $2
+OFFSET $3
+`
+
+type SearchAccountsParams struct {
+	Query  string `json:"query"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+type SearchAccountsRow struct {
+	ID        int64              `json:"id"`
+	Owner     string             `json:"owner"`
+	Balance   int64              `json:"balance"`
+	Currency  string             `json:"currency"`
+	CreatedAt time.Time          `json:"created_at"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Number    string             `json:"number"`
+	IsDefault bool               `json:"is_default"`
+	FullName  string             `json:"full_name"`
+	Email     string             `json:"email"`
+	Rank      float32            `json:"rank"`
+}
+
+func (q *Queries) SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]SearchAccountsRow, error) {
+	rows, err := q.db.Query(ctx, searchAccounts, arg.Query, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchAccountsRow{}
+	for rows.Next() {
+		var i SearchAccountsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.UpdatedAt,
+			&i.Number,
+			&i.IsDefault,
+			&i.FullName,
+			&i.Email,
+			&i.Rank,
 		); err != nil {
 			return nil, err
 		}
@@ -152,9 +480,9 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 
 const updateAccount = `-- name: UpdateAccount :exec
 UPDATE accounts
-SET balance = $2
+SET balance = $2, updated_at = now()
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default
 `
 
 type UpdateAccountParams struct {
@@ -171,6 +499,39 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
+	)
+	return i, err
+}
+
+const updateAccountOwner = `-- name: UpdateAccountOwner :one
+UPDATE accounts
+SET owner = $1, updated_at = now()
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, owner, balance, currency, created_at, deleted_at, updated_at, number, is_default
+`
+
+type UpdateAccountOwnerParams struct {
+	Owner string `json:"owner"`
+	ID    int64  `json:"id"`
+}
+
+func (q *Queries) UpdateAccountOwner(ctx context.Context, arg UpdateAccountOwnerParams) (Account, error) {
+	row := q.db.QueryRow(ctx, updateAccountOwner, arg.Owner, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.UpdatedAt,
+		&i.Number,
+		&i.IsDefault,
 	)
 	return i, err
 }
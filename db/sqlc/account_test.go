@@ -17,7 +17,7 @@ require.Equal(t, expected, actual) 确保实际值与预期值相等，如果不
 */
 
 // 这是一个辅助函数，用于在测试中创建一个具有随机属性的账户。它使用 account.sql.go 中的 CreateAccount 方法来实际创建账户。
-func createRandomAccount(t *testing.T) Account {
+func createRandomAccount(t testing.TB) Account {
 
 	user := createRandomUser(t)
 
@@ -25,6 +25,7 @@ func createRandomAccount(t *testing.T) Account {
 		Owner:    user.Username,
 		Balance:  util.RandomMoney(),
 		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
 	}
 
 	account, err := testStore.CreateAccount(context.Background(), arg)
@@ -34,6 +35,7 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.Number, account.Number)
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
@@ -61,6 +63,51 @@ func TestGetAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
+func TestGetAccountByNumber(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2, err := testStore.GetAccountByNumber(context.Background(), account1.Number)
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+
+	_, err = testStore.GetAccountByNumber(context.Background(), util.RandomAccountNumber())
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestGetAccountByOwnerCurrency(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2, err := testStore.GetAccountByOwnerCurrency(context.Background(), GetAccountByOwnerCurrencyParams{
+		Owner:    account1.Owner,
+		Currency: account1.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+
+	_, err = testStore.GetAccountByOwnerCurrency(context.Background(), GetAccountByOwnerCurrencyParams{
+		Owner:    util.RandomOwner(),
+		Currency: account1.Currency,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestSetDefaultAccountTx(t *testing.T) {
+	account1 := createRandomAccount(t)
+	require.False(t, account1.IsDefault)
+
+	result, err := testStore.SetDefaultAccountTx(context.Background(), SetDefaultAccountTxParams{AccountID: account1.ID})
+	require.NoError(t, err)
+	require.True(t, result.Account.IsDefault)
+	require.Empty(t, result.PreviousDefaultIDs)
+
+	// 再设置一次是幂等的
+	result, err = testStore.SetDefaultAccountTx(context.Background(), SetDefaultAccountTxParams{AccountID: account1.ID})
+	require.NoError(t, err)
+	require.True(t, result.Account.IsDefault)
+	require.Equal(t, []int64{account1.ID}, result.PreviousDefaultIDs)
+
+	_, err = testStore.SetDefaultAccountTx(context.Background(), SetDefaultAccountTxParams{AccountID: 0})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
 // 这个测试用例首先创建一个账户，然后更新它的余额。它测试了 UpdateAccount 方法，并验证返回的账户信息是否反映了更新。
 func TestUpdateAccount(t *testing.T) {
 	account1 := createRandomAccount(t)
@@ -79,6 +126,7 @@ func TestUpdateAccount(t *testing.T) {
 	require.Equal(t, arg.Balance, account2.Balance)
 	require.Equal(t, account1.Currency, account2.Currency)
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
+	require.True(t, account2.UpdatedAt.After(account1.UpdatedAt))
 }
 
 // 这个测试用例创建一个账户，然后删除它，最后尝试再次检索该账户，以确认它已被删除。它测试了 DeleteAccount 方法的功能。
@@ -101,9 +149,9 @@ func TestListAccounts(t *testing.T) {
 	}
 
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Owner:   lastAccount.Owner,
+		AfterID: 0,
+		Limit:   5,
 	}
 
 	accounts, err := testStore.ListAccounts(context.Background(), arg)
@@ -115,3 +163,15 @@ func TestListAccounts(t *testing.T) {
 		require.Equal(t, lastAccount.Owner, account.Owner)
 	}
 }
+
+// GetAccountsByIDs 按 id 排序返回找得到的账户，不存在的 id 直接忽略
+func TestGetAccountsByIDs(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	accounts, err := testStore.GetAccountsByIDs(context.Background(), []int64{account2.ID, account1.ID, -1})
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	require.Equal(t, account1.ID, accounts[0].ID)
+	require.Equal(t, account2.ID, accounts[1].ID)
+}
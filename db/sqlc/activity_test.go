@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestListAccountActivity(t *testing.T) {
+	account := createAccountWithBalance(t, 1000)
+	other := createAccountWithBalance(t, 1000)
+
+	sent, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   other.ID,
+		Amount:        10,
+		Memo:          "rent",
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   account.ID,
+		Amount:        20,
+	})
+	require.NoError(t, err)
+
+	card := createRandomCard(t, account)
+	hold, err := testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account.ID,
+		Amount:      30,
+		Description: "coffee shop",
+		CardID:      pgtype.Int8{Int64: card.ID, Valid: true},
+	})
+	require.NoError(t, err)
+
+	session := createRandomSession(t, account.Owner, time.Now().Add(time.Hour))
+
+	arg := ListAccountActivityParams{
+		AccountID: account.ID,
+		Owner:     account.Owner,
+		Limit:     10,
+	}
+	items, err := testStore.ListAccountActivity(context.Background(), arg)
+	require.NoError(t, err)
+	// 转出和转入各一笔、一次刷卡授权和一次登录
+	require.Len(t, items, 4)
+
+	// 最新的在前面
+	for i := 1; i < len(items); i++ {
+		require.False(t, items[i].OccurredAt.After(items[i-1].OccurredAt))
+	}
+
+	kinds := map[string]ListAccountActivityRow{}
+	for _, item := range items {
+		kinds[item.Kind+":"+item.ItemID] = item
+	}
+
+	transfer := kinds[util.ActivityTransfer+":"+itemID(sent.FromEntry.ID)]
+	require.Equal(t, int64(-10), transfer.Amount)
+	require.Equal(t, sent.Transfer.ID, transfer.TransferID)
+	require.Equal(t, other.ID, transfer.CounterpartyAccountID)
+	require.Equal(t, "rent", transfer.Description)
+
+	authorization := kinds[util.ActivityCardAuthorization+":"+itemID(hold.Hold.ID)]
+	require.Equal(t, int64(30), authorization.Amount)
+	require.Equal(t, card.ID, authorization.CardID)
+	require.Equal(t, util.HoldActive, authorization.Status)
+	require.Equal(t, "coffee shop", authorization.Description)
+
+	login := kinds[util.ActivitySecurity+":"+session.ID.String()]
+	require.Equal(t, util.ActivityLogin, login.Status)
+	require.Equal(t, session.Country, login.Country)
+
+	// 从第二条之后继续取，不应该和第一页重叠
+	arg.Limit = 2
+	arg.BeforeTime = pgtype.Timestamptz{Time: items[1].OccurredAt, Valid: true}
+	arg.BeforeKind = items[1].Kind
+	arg.BeforeItemID = items[1].ItemID
+	nextItems, err := testStore.ListAccountActivity(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, items[2:4], nextItems)
+}
+
+func itemID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
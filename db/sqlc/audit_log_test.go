@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestTransferAccountOwnershipTx(t *testing.T) {
+	banker := createRandomUser(t)
+	account := createRandomAccount(t)
+	newOwner := createRandomUser(t)
+
+	var callbackResult TransferAccountOwnershipTxResult
+	arg := TransferAccountOwnershipTxParams{
+		AccountID: account.ID,
+		NewOwner:  newOwner.Username,
+		Reason:    "estate settlement",
+		Actor:     banker.Username,
+		ClientIp:  "203.0.113.7",
+		Country:   "CA",
+		City:      "Toronto",
+		AfterTransfer: func(result TransferAccountOwnershipTxResult) error {
+			callbackResult = result
+			return nil
+		},
+	}
+	result, err := testStore.TransferAccountOwnershipTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, result, callbackResult)
+	require.Equal(t, account.Owner, result.PreviousOwner)
+	require.Equal(t, newOwner.Username, result.Account.Owner)
+	require.Equal(t, account.Balance, result.Account.Balance)
+
+	require.Equal(t, banker.Username, result.AuditLog.Actor)
+	require.Equal(t, util.AuditAccountOwnershipTransferred, result.AuditLog.Action)
+	require.Equal(t, "CA", result.AuditLog.Country)
+
+	var details AccountOwnershipTransferredDetails
+	require.NoError(t, json.Unmarshal(result.AuditLog.Details, &details))
+	require.Equal(t, AccountOwnershipTransferredDetails{
+		PreviousOwner: account.Owner,
+		NewOwner:      newOwner.Username,
+		Reason:        arg.Reason,
+	}, details)
+
+	logs, err := testStore.ListResourceAuditLogs(context.Background(), ListResourceAuditLogsParams{
+		ResourceType: util.AuditResourceAccount,
+		ResourceID:   strconv.FormatInt(account.ID, 10),
+		Limit:        10,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, result.AuditLog.ID, logs[0].ID)
+
+	// 转给现在的所有者是错误
+	_, err = testStore.TransferAccountOwnershipTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrSameAccountOwner)
+
+	// 回调失败时整个事务回滚
+	arg.NewOwner = account.Owner
+	arg.AfterTransfer = func(result TransferAccountOwnershipTxResult) error {
+		return errors.New("cannot distribute task")
+	}
+	_, err = testStore.TransferAccountOwnershipTx(context.Background(), arg)
+	require.Error(t, err)
+
+	account2, err := testStore.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, newOwner.Username, account2.Owner)
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomBalanceAlert(t *testing.T, account Account, kind string, threshold int64) BalanceAlert {
+	arg := CreateBalanceAlertParams{
+		AccountID: account.ID,
+		Kind:      kind,
+		Threshold: threshold,
+	}
+
+	alert, err := testStore.CreateBalanceAlert(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, alert.ID)
+	require.Equal(t, arg.AccountID, alert.AccountID)
+	require.Equal(t, arg.Kind, alert.Kind)
+	require.Equal(t, arg.Threshold, alert.Threshold)
+	return alert
+}
+
+func TestBalanceAlertCRUD(t *testing.T) {
+	account := createRandomAccount(t)
+	lowBalance := createRandomBalanceAlert(t, account, util.BalanceAlertLowBalance, 100)
+	largeDebit := createRandomBalanceAlert(t, account, util.BalanceAlertLargeDebit, 500)
+
+	// 每个账户每种提醒只能有一个
+	_, err := testStore.CreateBalanceAlert(context.Background(), CreateBalanceAlertParams{
+		AccountID: account.ID,
+		Kind:      util.BalanceAlertLowBalance,
+		Threshold: 200,
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	alerts, err := testStore.ListBalanceAlerts(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int64{largeDebit.ID, lowBalance.ID}, []int64{alerts[0].ID, alerts[1].ID})
+
+	updated, err := testStore.UpdateBalanceAlert(context.Background(), UpdateBalanceAlertParams{
+		ID:        lowBalance.ID,
+		Threshold: 300,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), updated.Threshold)
+	require.True(t, updated.UpdatedAt.After(lowBalance.UpdatedAt))
+
+	err = testStore.DeleteBalanceAlert(context.Background(), lowBalance.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.GetBalanceAlert(context.Background(), lowBalance.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestTransferTxBalanceAlerts(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	account1, err := testStore.UpdateAccount(context.Background(), UpdateAccountParams{
+		ID:      account1.ID,
+		Balance: 1000,
+	})
+	require.NoError(t, err)
+
+	lowBalance := createRandomBalanceAlert(t, account1, util.BalanceAlertLowBalance, 500)
+	largeDebit := createRandomBalanceAlert(t, account1, util.BalanceAlertLargeDebit, 300)
+
+	transfer := func(amount int64) TransferTxResult {
+		result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	// 1000 -> 800：没有低于 500，扣款也小于 300
+	require.Empty(t, transfer(200).BalanceAlerts)
+
+	// 800 -> 400：低于 500，扣款 400 也超过了 300
+	result := transfer(400)
+	require.Len(t, result.BalanceAlerts, 2)
+	require.Equal(t, largeDebit.ID, result.BalanceAlerts[0].ID)
+	require.Equal(t, lowBalance.ID, result.BalanceAlerts[1].ID)
+
+	// 400 -> 300：已经低于 500 了，不重复提醒
+	require.Empty(t, transfer(100).BalanceAlerts)
+
+	// 转入方的提醒不会被触发
+	createRandomBalanceAlert(t, account2, util.BalanceAlertLargeDebit, 1)
+	require.Empty(t, transfer(100).BalanceAlerts)
+}
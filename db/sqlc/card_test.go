@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomCard(t *testing.T, account Account) Card {
+	expiry := time.Now().UTC().Add(util.CardValidity)
+	arg := CreateCardParams{
+		AccountID:   account.ID,
+		PanHash:     util.RandomString(64),
+		Last4:       "4242",
+		ExpiryMonth: int32(expiry.Month()),
+		ExpiryYear:  int32(expiry.Year()),
+	}
+
+	card, err := testStore.CreateCard(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, card.AccountID)
+	require.Equal(t, arg.PanHash, card.PanHash)
+	require.Equal(t, util.CardActive, card.Status)
+
+	return card
+}
+
+func TestCreateCard(t *testing.T) {
+	account := createAccountWithBalance(t, 0)
+	card := createRandomCard(t, account)
+
+	card2, err := testStore.GetCardByPanHash(context.Background(), card.PanHash)
+	require.NoError(t, err)
+	require.Equal(t, card.ID, card2.ID)
+
+	cards, err := testStore.ListCards(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+
+	// 同一个卡号不能发两张卡
+	_, err = testStore.CreateCard(context.Background(), CreateCardParams{
+		AccountID:   account.ID,
+		PanHash:     card.PanHash,
+		Last4:       card.Last4,
+		ExpiryMonth: card.ExpiryMonth,
+		ExpiryYear:  card.ExpiryYear,
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func TestAuthorizeCardTx(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+	card := createRandomCard(t, account)
+
+	result, err := testStore.AuthorizeCardTx(context.Background(), AuthorizeCardTxParams{
+		CardID:   card.ID,
+		Amount:   60,
+		Currency: account.Currency,
+		Merchant: "Coffee Shop",
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.ID, result.Hold.AccountID)
+	require.Equal(t, card.ID, result.Hold.CardID.Int64)
+	require.Equal(t, int64(40), result.AvailableBalance)
+
+	// 可用余额不够
+	_, err = testStore.AuthorizeCardTx(context.Background(), AuthorizeCardTxParams{
+		CardID:   card.ID,
+		Amount:   50,
+		Currency: account.Currency,
+		Merchant: "Coffee Shop",
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	_, err = testStore.AuthorizeCardTx(context.Background(), AuthorizeCardTxParams{
+		CardID:   card.ID,
+		Amount:   10,
+		Currency: util.EUR,
+		Merchant: "Coffee Shop",
+	})
+	require.ErrorIs(t, err, ErrCardCurrencyMismatch)
+
+	_, err = testStore.UpdateCardStatus(context.Background(), UpdateCardStatusParams{
+		Status: util.CardFrozen,
+		ID:     card.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.AuthorizeCardTx(context.Background(), AuthorizeCardTxParams{
+		CardID:   card.ID,
+		Amount:   10,
+		Currency: account.Currency,
+		Merchant: "Coffee Shop",
+	})
+	require.ErrorIs(t, err, ErrCardFrozen)
+}
@@ -0,0 +1,231 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+// createRandomDispute 从一个有钱的账户转 10 块到另一个账户，再对这笔转账打开一个争议
+func createRandomDispute(t *testing.T) (Dispute, Transfer) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+
+	txResult, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	arg := CreateDisputeParams{
+		TransferID: txResult.Transfer.ID,
+		OpenedBy:   account1.Owner,
+		Reason:     util.RandomString(20),
+	}
+
+	dispute, err := testStore.CreateDispute(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.TransferID, dispute.TransferID)
+	require.Equal(t, arg.OpenedBy, dispute.OpenedBy)
+	require.Equal(t, arg.Reason, dispute.Reason)
+	require.Equal(t, util.DisputeOpen, dispute.Status)
+	require.Empty(t, dispute.Resolution)
+	require.False(t, dispute.ResolvedBy.Valid)
+	require.False(t, dispute.RefundTransferID.Valid)
+	require.False(t, dispute.ResolvedAt.Valid)
+
+	return dispute, txResult.Transfer
+}
+
+func TestCreateDisputeOnlyOneUnresolved(t *testing.T) {
+	dispute, _ := createRandomDispute(t)
+	banker := createRandomUser(t)
+
+	_, err := testStore.CreateDispute(context.Background(), CreateDisputeParams{
+		TransferID: dispute.TransferID,
+		OpenedBy:   dispute.OpenedBy,
+		Reason:     "again",
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	// 被拒绝之后可以再打开一个
+	_, err = testStore.ResolveDisputeTx(context.Background(), ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     util.DisputeRejected,
+		Resolution: "the goods were delivered",
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+
+	reopened, err := testStore.CreateDispute(context.Background(), CreateDisputeParams{
+		TransferID: dispute.TransferID,
+		OpenedBy:   dispute.OpenedBy,
+		Reason:     "they were broken",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeOpen, reopened.Status)
+}
+
+func TestListDisputes(t *testing.T) {
+	dispute1, _ := createRandomDispute(t)
+	dispute2, _ := createRandomDispute(t)
+
+	disputes, err := testStore.ListDisputes(context.Background(), ListDisputesParams{
+		Status:   pgtype.Text{String: util.DisputeOpen, Valid: true},
+		OpenedBy: pgtype.Text{String: dispute1.OpenedBy, Valid: true},
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, disputes, 1)
+	require.Equal(t, dispute1.ID, disputes[0].ID)
+
+	disputes, err = testStore.ListDisputes(context.Background(), ListDisputesParams{
+		AfterID: dispute1.ID,
+		Limit:   5,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, disputes)
+	require.Equal(t, dispute2.ID, disputes[0].ID)
+
+	disputes, err = testStore.ListDisputes(context.Background(), ListDisputesParams{
+		Status:   pgtype.Text{String: util.DisputeRefunded, Valid: true},
+		OpenedBy: pgtype.Text{String: dispute1.OpenedBy, Valid: true},
+		Limit:    5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, disputes)
+}
+
+func TestAddDisputeNoteTx(t *testing.T) {
+	dispute, _ := createRandomDispute(t)
+	banker := createRandomUser(t)
+
+	result, err := testStore.AddDisputeNoteTx(context.Background(), AddDisputeNoteTxParams{
+		DisputeID: dispute.ID,
+		Author:    banker.Username,
+		Note:      "asked the merchant for a receipt",
+	})
+	require.NoError(t, err)
+	require.True(t, result.StatusChanged)
+	require.Equal(t, util.DisputeUnderReview, result.Dispute.Status)
+	require.Equal(t, dispute.ID, result.Note.DisputeID)
+	require.Equal(t, banker.Username, result.Note.Author)
+
+	// 第二条备注不改变状态
+	result, err = testStore.AddDisputeNoteTx(context.Background(), AddDisputeNoteTxParams{
+		DisputeID: dispute.ID,
+		Author:    banker.Username,
+		Note:      "no answer yet",
+	})
+	require.NoError(t, err)
+	require.False(t, result.StatusChanged)
+	require.Equal(t, util.DisputeUnderReview, result.Dispute.Status)
+
+	notes, err := testStore.ListDisputeNotes(context.Background(), dispute.ID)
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	require.Equal(t, "asked the merchant for a receipt", notes[0].Note)
+
+	_, err = testStore.ResolveDisputeTx(context.Background(), ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     util.DisputeRejected,
+		Resolution: "the merchant sent the receipt",
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.AddDisputeNoteTx(context.Background(), AddDisputeNoteTxParams{
+		DisputeID: dispute.ID,
+		Author:    banker.Username,
+		Note:      "too late",
+	})
+	require.ErrorIs(t, err, ErrDisputeResolved)
+}
+
+func TestResolveDisputeTxRefund(t *testing.T) {
+	dispute, original := createRandomDispute(t)
+	banker := createRandomUser(t)
+
+	result, err := testStore.ResolveDisputeTx(context.Background(), ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     util.DisputeRefunded,
+		Resolution: "duplicate charge",
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.Refund)
+
+	reversal := result.Refund.Reversal.Transfer
+	require.Equal(t, original.ID, reversal.ReversalOf.Int64)
+	require.Equal(t, original.ToAccountID, reversal.FromAccountID)
+
+	var reversed TransferReversedDetails
+	require.NoError(t, json.Unmarshal(result.Refund.AuditLog.Details, &reversed))
+	require.Equal(t, fmt.Sprintf("dispute #%d: duplicate charge", dispute.ID), reversed.Reason)
+
+	require.Equal(t, util.DisputeRefunded, result.Dispute.Status)
+	require.Equal(t, "duplicate charge", result.Dispute.Resolution)
+	require.Equal(t, banker.Username, result.Dispute.ResolvedBy.String)
+	require.Equal(t, reversal.ID, result.Dispute.RefundTransferID.Int64)
+	require.True(t, result.Dispute.ResolvedAt.Valid)
+
+	require.Equal(t, util.AuditDisputeResolved, result.AuditLog.Action)
+	require.Equal(t, strconv.FormatInt(dispute.ID, 10), result.AuditLog.ResourceID)
+	var details DisputeResolvedDetails
+	require.NoError(t, json.Unmarshal(result.AuditLog.Details, &details))
+	require.Equal(t, DisputeResolvedDetails{
+		TransferID:       original.ID,
+		Status:           util.DisputeRefunded,
+		Resolution:       "duplicate charge",
+		RefundTransferID: reversal.ID,
+	}, details)
+
+	// refunded 是最终状态
+	_, err = testStore.ResolveDisputeTx(context.Background(), ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     util.DisputeRejected,
+		Resolution: "changed my mind",
+		Actor:      banker.Username,
+	})
+	require.ErrorIs(t, err, ErrInvalidDisputeTransition)
+}
+
+func TestResolveDisputeTxAlreadyReversed(t *testing.T) {
+	dispute, original := createRandomDispute(t)
+	banker := createRandomUser(t)
+
+	_, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+
+	// 转账已经被冲正过了，退款失败，争议的状态不变
+	_, err = testStore.ResolveDisputeTx(context.Background(), ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     util.DisputeRefunded,
+		Resolution: "duplicate charge",
+		Actor:      banker.Username,
+	})
+	require.ErrorIs(t, err, ErrTransferAlreadyReversed)
+
+	dispute, err = testStore.GetDispute(context.Background(), dispute.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.DisputeOpen, dispute.Status)
+}
+
+func TestCanTransitionDispute(t *testing.T) {
+	require.True(t, canTransitionDispute(util.DisputeOpen, util.DisputeUnderReview))
+	require.True(t, canTransitionDispute(util.DisputeOpen, util.DisputeRefunded))
+	require.True(t, canTransitionDispute(util.DisputeUnderReview, util.DisputeRejected))
+	require.False(t, canTransitionDispute(util.DisputeUnderReview, util.DisputeOpen))
+	require.False(t, canTransitionDispute(util.DisputeRefunded, util.DisputeRejected))
+	require.False(t, canTransitionDispute(util.DisputeRejected, util.DisputeUnderReview))
+}
@@ -7,36 +7,98 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countAccountEntries = `-- name: CountAccountEntries :one
+SELECT count(*) FROM entries
+WHERE account_id = $1
+`
+
+func (q *Queries) CountAccountEntries(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccountEntries, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  transfer_id
 ) VALUES (
-  $1, $2
-) RETURNING id, account_id, amount, created_at
+  $1, $2, $3
+) RETURNING id, account_id, amount, created_at, transfer_id
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID  int64       `json:"account_id"`
+	Amount     int64       `json:"amount"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createEntry, arg.AccountID, arg.Amount, arg.TransferID)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
+	)
+	return i, err
+}
+
+const getAccountStatement = `-- name: GetAccountStatement :one
+SELECT
+  a.number,
+  a.currency,
+  a.created_at,
+  u.full_name,
+  a.balance AS closing_balance,
+  (a.balance - COALESCE(SUM(e.amount), 0))::bigint AS opening_balance,
+  count(e.id) AS entry_count,
+  COALESCE(MAX(e.id), 0)::bigint AS last_entry_id
+FROM accounts a
+JOIN users u ON u.username = a.owner
+LEFT JOIN entries e ON e.account_id = a.id
+WHERE a.id = $1
+GROUP BY a.id, u.username
+`
+
+type GetAccountStatementRow struct {
+	Number         string    `json:"number"`
+	Currency       string    `json:"currency"`
+	CreatedAt      time.Time `json:"created_at"`
+	FullName       string    `json:"full_name"`
+	ClosingBalance int64     `json:"closing_balance"`
+	OpeningBalance int64     `json:"opening_balance"`
+	EntryCount     int64     `json:"entry_count"`
+	LastEntryID    int64     `json:"last_entry_id"`
+}
+
+func (q *Queries) GetAccountStatement(ctx context.Context, id int64) (GetAccountStatementRow, error) {
+	row := q.db.QueryRow(ctx, getAccountStatement, id)
+	var i GetAccountStatementRow
+	err := row.Scan(
+		&i.Number,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.FullName,
+		&i.ClosingBalance,
+		&i.OpeningBalance,
+		&i.EntryCount,
+		&i.LastEntryID,
 	)
 	return i, err
 }
 
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,26 +110,27 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.TransferID,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, transfer_id FROM entries
 WHERE account_id = $1
+  AND id > $2
 ORDER BY id
-LIMIT $2
-OFFSET $3
+LIMIT $3
 `
 
 type ListEntriesParams struct {
 	AccountID int64 `json:"account_id"`
+	AfterID   int64 `json:"after_id"`
 	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
 }
 
 func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
-	rows, err := q.db.Query(ctx, listEntries, arg.AccountID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listEntries, arg.AccountID, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +143,208 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.TransferID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMonthlyAccountSummaries = `-- name: ListMonthlyAccountSummaries :many
+SELECT
+  a.id AS account_id,
+  a.owner,
+  a.currency,
+  u.full_name,
+  u.email,
+  u.locale,
+  COALESCE(SUM(e.amount) FILTER (
+    WHERE e.amount > 0 AND e.created_at < $1
+  ), 0)::bigint AS total_in,
+  COALESCE(-SUM(e.amount) FILTER (
+    WHERE e.amount < 0 AND e.created_at < $1
+  ), 0)::bigint AS total_out,
+  (a.balance - COALESCE(SUM(e.amount) FILTER (
+    WHERE e.created_at >= $1
+  ), 0))::bigint AS ending_balance
+FROM accounts a
+JOIN users u ON u.username = a.owner
+LEFT JOIN entries e ON e.account_id = a.id AND e.created_at >= $2
+WHERE a.deleted_at IS NULL
+  AND u.deleted_at IS NULL
+  AND u.is_email_verified = true
+GROUP BY a.id, u.username
+ORDER BY a.owner, a.id
+`
+
+type ListMonthlyAccountSummariesParams struct {
+	EndTime   time.Time `json:"end_time"`
+	StartTime time.Time `json:"start_time"`
+}
+
+type ListMonthlyAccountSummariesRow struct {
+	AccountID     int64  `json:"account_id"`
+	Owner         string `json:"owner"`
+	Currency      string `json:"currency"`
+	FullName      string `json:"full_name"`
+	Email         string `json:"email"`
+	Locale        string `json:"locale"`
+	TotalIn       int64  `json:"total_in"`
+	TotalOut      int64  `json:"total_out"`
+	EndingBalance int64  `json:"ending_balance"`
+}
+
+func (q *Queries) ListMonthlyAccountSummaries(ctx context.Context, arg ListMonthlyAccountSummariesParams) ([]ListMonthlyAccountSummariesRow, error) {
+	rows, err := q.db.Query(ctx, listMonthlyAccountSummaries, arg.EndTime, arg.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListMonthlyAccountSummariesRow{}
+	for rows.Next() {
+		var i ListMonthlyAccountSummariesRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Owner,
+			&i.Currency,
+			&i.FullName,
+			&i.Email,
+			&i.Locale,
+			&i.TotalIn,
+			&i.TotalOut,
+			&i.EndingBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatementEntries = `-- name: ListStatementEntries :many
+SELECT
+  e.id,
+  e.amount,
+  e.transfer_id,
+  e.created_at,
+  COALESCE(t.memo, '')::varchar AS memo,
+  COALESCE(t.reference, '')::varchar AS reference,
+  COALESCE(CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END, 0)::bigint AS counterparty_account_id
+FROM entries e
+LEFT JOIN transfers t ON t.id = e.transfer_id
+WHERE e.account_id = $1
+  AND e.id > $2
+  AND e.id <= $3
+ORDER BY e.id
+LIMIT $4
+`
+
+type ListStatementEntriesParams struct {
+	AccountID int64 `json:"account_id"`
+	AfterID   int64 `json:"after_id"`
+	LastID    int64 `json:"last_id"`
+	Limit     int32 `json:"limit"`
+}
+
+type ListStatementEntriesRow struct {
+	ID                    int64       `json:"id"`
+	Amount                int64       `json:"amount"`
+	TransferID            pgtype.Int8 `json:"transfer_id"`
+	CreatedAt             time.Time   `json:"created_at"`
+	Memo                  string      `json:"memo"`
+	Reference             string      `json:"reference"`
+	CounterpartyAccountID int64       `json:"counterparty_account_id"`
+}
+
+func (q *Queries) ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listStatementEntries,
+		arg.AccountID,
+		arg.AfterID,
+		arg.LastID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementEntriesRow{}
+	for rows.Next() {
+		var i ListStatementEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Amount,
+			&i.TransferID,
+			&i.CreatedAt,
+			&i.Memo,
+			&i.Reference,
+			&i.CounterpartyAccountID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopAccountsByActivity = `-- name: ListTopAccountsByActivity :many
+SELECT
+  a.id AS account_id,
+  a.owner,
+  a.currency,
+  count(*) AS entry_count,
+  COALESCE(SUM(e.amount) FILTER (WHERE e.amount > 0), 0)::bigint AS total_in,
+  COALESCE(-SUM(e.amount) FILTER (WHERE e.amount < 0), 0)::bigint AS total_out
+FROM entries e
+JOIN accounts a ON a.id = e.account_id
+WHERE e.created_at >= $1
+  AND e.created_at < $2
+GROUP BY a.id
+ORDER BY entry_count DESC, a.id
+LIMIT $3
+`
+
+type ListTopAccountsByActivityParams struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Limit     int32     `json:"limit"`
+}
+
+type ListTopAccountsByActivityRow struct {
+	AccountID  int64  `json:"account_id"`
+	Owner      string `json:"owner"`
+	Currency   string `json:"currency"`
+	EntryCount int64  `json:"entry_count"`
+	TotalIn    int64  `json:"total_in"`
+	TotalOut   int64  `json:"total_out"`
+}
+
+func (q *Queries) ListTopAccountsByActivity(ctx context.Context, arg ListTopAccountsByActivityParams) ([]ListTopAccountsByActivityRow, error) {
+	rows, err := q.db.Query(ctx, listTopAccountsByActivity, arg.StartTime, arg.EndTime, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTopAccountsByActivityRow{}
+	for rows.Next() {
+		var i ListTopAccountsByActivityRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Owner,
+			&i.Currency,
+			&i.EntryCount,
+			&i.TotalIn,
+			&i.TotalOut,
 		); err != nil {
 			return nil, err
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"github.com/techschool/bank/util"
 )
@@ -54,8 +55,8 @@ func TestListEntries(t *testing.T) {
 
 	arg := ListEntriesParams{
 		AccountID: account.ID,
+		AfterID:   0,
 		Limit:     5,
-		Offset:    5,
 	}
 
 	entries, err := testStore.ListEntries(context.Background(), arg)
@@ -66,4 +67,134 @@ func TestListEntries(t *testing.T) {
 		require.NotEmpty(t, entry)
 		require.Equal(t, arg.AccountID, entry.AccountID)
 	}
+
+	// 用上一页最后一条记录的 ID 作为游标，继续取下一页，两页之间不应该有重叠
+	arg.AfterID = entries[len(entries)-1].ID
+	nextEntries, err := testStore.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, nextEntries, 5)
+	require.Greater(t, nextEntries[0].ID, arg.AfterID)
+
+	count, err := testStore.CountAccountEntries(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), count)
+}
+
+func TestListMonthlyAccountSummaries(t *testing.T) {
+	account := createRandomAccount(t)
+
+	_, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username:        account.Owner,
+		IsEmailVerified: pgtype.Bool{Bool: true, Valid: true},
+	})
+	require.NoError(t, err)
+
+	amounts := []int64{100, -30, 50, -20}
+	for _, amount := range amounts {
+		_, err := testStore.CreateEntry(context.Background(), CreateEntryParams{
+			AccountID: account.ID,
+			Amount:    amount,
+		})
+		require.NoError(t, err)
+	}
+
+	findRow := func(rows []ListMonthlyAccountSummariesRow) ListMonthlyAccountSummariesRow {
+		for _, row := range rows {
+			if row.AccountID == account.ID {
+				return row
+			}
+		}
+		require.FailNow(t, "account not found in summaries")
+		return ListMonthlyAccountSummariesRow{}
+	}
+
+	now := time.Now()
+	rows, err := testStore.ListMonthlyAccountSummaries(context.Background(), ListMonthlyAccountSummariesParams{
+		StartTime: now.Add(-time.Hour),
+		EndTime:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	row := findRow(rows)
+	require.Equal(t, account.Owner, row.Owner)
+	require.Equal(t, int64(150), row.TotalIn)
+	require.Equal(t, int64(50), row.TotalOut)
+	require.Equal(t, account.Balance, row.EndingBalance)
+
+	// 统计区间在这些记录之前，月底余额要减去之后发生的金额
+	rows, err = testStore.ListMonthlyAccountSummaries(context.Background(), ListMonthlyAccountSummariesParams{
+		StartTime: now.Add(-2 * time.Hour),
+		EndTime:   now.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+
+	row = findRow(rows)
+	require.Zero(t, row.TotalIn)
+	require.Zero(t, row.TotalOut)
+	require.Equal(t, account.Balance-100, row.EndingBalance)
+}
+
+func TestAccountStatement(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Memo:          "rent",
+	})
+	require.NoError(t, err)
+
+	statement, err := testStore.GetAccountStatement(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Number, statement.Number)
+	require.Equal(t, int64(1), statement.EntryCount)
+	require.Equal(t, result.FromEntry.ID, statement.LastEntryID)
+	require.Equal(t, account1.Balance-10, statement.ClosingBalance)
+	require.Equal(t, account1.Balance, statement.OpeningBalance)
+
+	// 取对账单之后的分录不会出现在对账单里
+	createRandomEntry(t, account1)
+
+	entries, err := testStore.ListStatementEntries(context.Background(), ListStatementEntriesParams{
+		AccountID: account1.ID,
+		LastID:    statement.LastEntryID,
+		Limit:     5,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, int64(-10), entries[0].Amount)
+	require.Equal(t, "rent", entries[0].Memo)
+	require.Equal(t, account2.ID, entries[0].CounterpartyAccountID)
+}
+
+func TestListTopAccountsByActivity(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	first, err := testStore.CreateEntry(context.Background(), CreateEntryParams{AccountID: account1.ID, Amount: 100})
+	require.NoError(t, err)
+	_, err = testStore.CreateEntry(context.Background(), CreateEntryParams{AccountID: account1.ID, Amount: -30})
+	require.NoError(t, err)
+	last, err := testStore.CreateEntry(context.Background(), CreateEntryParams{AccountID: account2.ID, Amount: 10})
+	require.NoError(t, err)
+
+	rows, err := testStore.ListTopAccountsByActivity(context.Background(), ListTopAccountsByActivityParams{
+		StartTime: first.CreatedAt,
+		EndTime:   last.CreatedAt.Add(time.Microsecond),
+		Limit:     10,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	require.Equal(t, account1.ID, rows[0].AccountID)
+	require.Equal(t, account1.Owner, rows[0].Owner)
+	require.Equal(t, int64(2), rows[0].EntryCount)
+	require.Equal(t, int64(100), rows[0].TotalIn)
+	require.Equal(t, int64(30), rows[0].TotalOut)
+
+	require.Equal(t, account2.ID, rows[1].AccountID)
+	require.Equal(t, int64(1), rows[1].EntryCount)
+	require.Equal(t, int64(0), rows[1].TotalOut)
 }
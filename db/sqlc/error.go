@@ -8,8 +8,11 @@ import (
 )
 
 const (
-	ForeignKeyViolation = "23503"
-	UniqueViolation     = "23505"
+	ForeignKeyViolation  = "23503"
+	UniqueViolation      = "23505"
+	CheckViolation       = "23514"
+	SerializationFailure = "40001"
+	DeadlockDetected     = "40P01"
 )
 
 var ErrRecordNotFound = pgx.ErrNoRows
@@ -25,3 +28,12 @@ func ErrorCode(err error) string {
 	}
 	return ""
 }
+
+// ConstraintName returns the name of the constraint violated by err, or "" if err is not a constraint violation
+func ConstraintName(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
@@ -2,9 +2,37 @@ package db
 
 import (
 	"context"
+	"expvar"
 	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	rollbackTimeout = 5 * time.Second
+
+	defaultMaxTxAttempts = 3
+	txRetryBaseDelay     = 10 * time.Millisecond
+	txRetryMaxDelay      = 500 * time.Millisecond
 )
 
+// txRetries 按错误码统计事务重试次数，"exhausted" 是重试用完仍然失败的次数，可以通过 expvar 查看
+var txRetries = expvar.NewMap("db_tx_retries")
+
+// TxRetryStats returns a snapshot of txRetries, the load test compares two snapshots to count retries during the run
+func TxRetryStats() map[string]int64 {
+	stats := make(map[string]int64)
+	txRetries.Do(func(kv expvar.KeyValue) {
+		if v, ok := kv.Value.(*expvar.Int); ok {
+			stats[kv.Key] = v.Value()
+		}
+	})
+	return stats
+}
+
 // // execTx executes a function within a database transaction
 // //execTx 是 Store 结构体的一个方法，其主要目的是在数据库事务的上下文中安全地执行一系列数据库操作。
 // //ctx context.Context：这是 Go 语言的上下文对象，它通常用于控制函数的执行。
@@ -15,6 +43,13 @@ import (
 // // 这意味着你可以传递任何这样的函数给 execTx，这个函数会在事务中执行一些数据库操作，
 // // 并且这些操作要么全部成功，要么（在出错时）全部不执行。
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
+	return retryTx(ctx, store.maxTxAttempts, func() error {
+		return store.runTx(ctx, fn)
+	})
+}
+
+// runTx 在一个事务里执行一次 fn
+func (store *SQLStore) runTx(ctx context.Context, fn func(*Queries) error) error {
 	//这一行开始一个新的数据库事务。BeginTx 方法来自 Go 的 sql 包，用于在给定的上下文（ctx）中开始一个新的事务。
 	//如果事务成功开始，它返回一个事务对象 tx。如果出现错误，如数据库连接问题，它返回一个错误。
 	tx, err := store.connPool.Begin(ctx)
@@ -28,8 +63,12 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 	err = fn(q)
 	//如果 fn 执行成功（err == nil），则执行 tx.Commit()，这将提交事务，即所有在事务中的操作会被永久地写入数据库。
 	//如果 fn 返回错误（err != nil），则执行 tx.Rollback()，这将撤销事务，即所有在事务中的操作都不会对数据库产生影响。
+	//ctx 已经超时或被取消时也回滚，不再提交。
+	if err == nil {
+		err = ctx.Err()
+	}
 	if err != nil {
-		if rbErr := tx.Rollback(ctx); rbErr != nil {
+		if rbErr := rollbackTx(ctx, tx); rbErr != nil {
 			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
 		}
 		return err
@@ -37,3 +76,55 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	return tx.Commit(ctx)
 }
+
+// rollbackTx 不能直接用请求的 ctx：ctx 超时之后 Rollback 会立刻失败，连接会被直接关掉。
+// 这里用一个不会被取消的 ctx，并单独给回滚一个较短的超时。
+func rollbackTx(ctx context.Context, tx pgx.Tx) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+	defer cancel()
+
+	return tx.Rollback(ctx)
+}
+
+/*
+retryTx 在事务因为序列化失败（40001）或死锁（40P01）失败时重新执行整个事务。
+这两种错误说明事务已经被数据库回滚，而且和其他事务冲突，换个时间再执行一般就能成功。
+两次尝试之间的等待时间按指数增长，并加上随机抖动，避免冲突的事务又同时重试。
+
+fn 会被完整地再执行一次，所以传给 execTx 的函数不能依赖上一次执行留下的状态（比如往切片里 append 结果）。
+*/
+func retryTx(ctx context.Context, maxAttempts int, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		code := ErrorCode(err)
+		if code != SerializationFailure && code != DeadlockDetected {
+			return err
+		}
+
+		if attempt >= maxAttempts {
+			txRetries.Add("exhausted", 1)
+			return err
+		}
+
+		txRetries.Add(code, 1)
+		delay := txRetryDelay(attempt)
+		log.Warn().Err(err).Int("attempt", attempt).Dur("delay", delay).Msg("retrying transaction")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// txRetryDelay 返回第 attempt 次失败之后的等待时间（full jitter）
+func txRetryDelay(attempt int) time.Duration {
+	delay := txRetryBaseDelay << (attempt - 1)
+	if delay <= 0 || delay > txRetryMaxDelay {
+		delay = txRetryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryTx(t *testing.T) {
+	serializationErr := &pgconn.PgError{Code: SerializationFailure}
+	deadlockErr := &pgconn.PgError{Code: DeadlockDetected}
+	otherErr := errors.New("insufficient balance")
+
+	testCases := []struct {
+		name         string
+		errs         []error
+		maxAttempts  int
+		wantAttempts int
+		wantErr      error
+	}{
+		{
+			name:         "OK",
+			errs:         []error{nil},
+			maxAttempts:  3,
+			wantAttempts: 1,
+		},
+		{
+			name:         "RetrySerializationFailure",
+			errs:         []error{serializationErr, deadlockErr, nil},
+			maxAttempts:  3,
+			wantAttempts: 3,
+		},
+		{
+			name:         "Exhausted",
+			errs:         []error{serializationErr, serializationErr, serializationErr},
+			maxAttempts:  2,
+			wantAttempts: 2,
+			wantErr:      serializationErr,
+		},
+		{
+			name:         "NotRetryable",
+			errs:         []error{otherErr, nil},
+			maxAttempts:  3,
+			wantAttempts: 1,
+			wantErr:      otherErr,
+		},
+		{
+			name:         "RetryDisabled",
+			errs:         []error{deadlockErr, nil},
+			maxAttempts:  0,
+			wantAttempts: 1,
+			wantErr:      deadlockErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			err := retryTx(context.Background(), tc.maxAttempts, func() error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+
+			require.Equal(t, tc.wantAttempts, attempts)
+			if tc.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestTxRetryDelay(t *testing.T) {
+	for attempt := 1; attempt < 100; attempt++ {
+		delay := txRetryDelay(attempt)
+		require.Positive(t, delay)
+		require.LessOrEqual(t, delay, txRetryMaxDelay)
+	}
+}
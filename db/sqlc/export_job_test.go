@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomExportJob(t *testing.T, account Account) ExportJob {
+	arg := CreateExportJobParams{
+		Owner:     account.Owner,
+		AccountID: account.ID,
+		Kind:      util.ExportTransfers,
+		Format:    util.ExportCSV,
+	}
+
+	job, err := testStore.CreateExportJob(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, job.ID)
+	require.Equal(t, arg.Owner, job.Owner)
+	require.Equal(t, arg.AccountID, job.AccountID)
+	require.Equal(t, arg.Kind, job.Kind)
+	require.Equal(t, arg.Format, job.Format)
+	require.Equal(t, util.ExportPending, job.Status)
+	require.Zero(t, job.ProcessedRows)
+	require.Zero(t, job.TotalRows)
+	require.Empty(t, job.FileKey)
+	require.False(t, job.CompletedAt.Valid)
+	return job
+}
+
+func TestCreateExportJob(t *testing.T) {
+	createRandomExportJob(t, createRandomAccount(t))
+}
+
+func TestUpdateExportJob(t *testing.T) {
+	account := createRandomAccount(t)
+	job := createRandomExportJob(t, account)
+
+	// 只更新传了值的字段
+	running, err := testStore.UpdateExportJob(context.Background(), UpdateExportJobParams{
+		ID:        job.ID,
+		Status:    pgtype.Text{String: util.ExportRunning, Valid: true},
+		TotalRows: pgtype.Int8{Int64: 10, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ExportRunning, running.Status)
+	require.Equal(t, int64(10), running.TotalRows)
+	require.Zero(t, running.ProcessedRows)
+
+	progress, err := testStore.UpdateExportJob(context.Background(), UpdateExportJobParams{
+		ID:            job.ID,
+		ProcessedRows: pgtype.Int8{Int64: 4, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ExportRunning, progress.Status)
+	require.Equal(t, int64(4), progress.ProcessedRows)
+	require.Equal(t, int64(10), progress.TotalRows)
+
+	completedAt := time.Now()
+	completed, err := testStore.UpdateExportJob(context.Background(), UpdateExportJobParams{
+		ID:            job.ID,
+		Status:        pgtype.Text{String: util.ExportCompleted, Valid: true},
+		ProcessedRows: pgtype.Int8{Int64: 10, Valid: true},
+		FileKey:       pgtype.Text{String: "exports/test.csv", Valid: true},
+		CompletedAt:   pgtype.Timestamptz{Time: completedAt, Valid: true},
+	})
+	require.NoError(t, err)
+
+	job2, err := testStore.GetExportJob(context.Background(), job.ID)
+	require.NoError(t, err)
+	require.Equal(t, completed, job2)
+	require.Equal(t, util.ExportCompleted, job2.Status)
+	require.Equal(t, "exports/test.csv", job2.FileKey)
+	require.WithinDuration(t, completedAt, job2.CompletedAt.Time, time.Second)
+}
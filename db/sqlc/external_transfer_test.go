@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomExternalTransfer(t *testing.T, account Account, amount int64) CreateExternalTransferTxResult {
+	var created ExternalTransfer
+	result, err := testStore.CreateExternalTransferTx(context.Background(), CreateExternalTransferTxParams{
+		AccountID:     account.ID,
+		Amount:        amount,
+		RoutingNumber: "011000015",
+		AccountNumber: "123456789",
+		AfterCreate: func(externalTransfer ExternalTransfer) error {
+			created = externalTransfer
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, created, result.ExternalTransfer)
+	require.Equal(t, util.ExternalTransferInitiated, result.ExternalTransfer.Status)
+	require.Equal(t, account.Currency, result.ExternalTransfer.Currency)
+	require.Equal(t, result.Hold.ID, result.ExternalTransfer.HoldID)
+	return result
+}
+
+func TestExternalTransferSettled(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+	clearingAccount := createAccountWithBalance(t, 0)
+
+	created := createRandomExternalTransfer(t, account, 80)
+	require.Equal(t, int64(20), created.AvailableBalance)
+
+	// 还没有提交给清算网络，不能直接清算
+	_, err := testStore.AdvanceExternalTransferTx(context.Background(), AdvanceExternalTransferTxParams{
+		ID:                created.ExternalTransfer.ID,
+		Status:            util.ExternalTransferSettled,
+		ClearingAccountID: clearingAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrInvalidExternalTransferTransition)
+
+	result, err := testStore.AdvanceExternalTransferTx(context.Background(), AdvanceExternalTransferTxParams{
+		ID:     created.ExternalTransfer.ID,
+		Status: util.ExternalTransferSubmitted,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ExternalTransferSubmitted, result.ExternalTransfer.Status)
+	require.Nil(t, result.Settlement)
+
+	result, err = testStore.AdvanceExternalTransferTx(context.Background(), AdvanceExternalTransferTxParams{
+		ID:                created.ExternalTransfer.ID,
+		Status:            util.ExternalTransferSettled,
+		ClearingAccountID: clearingAccount.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ExternalTransferSettled, result.ExternalTransfer.Status)
+	require.NotNil(t, result.Settlement)
+	require.Equal(t, result.Settlement.Transfer.ID, result.ExternalTransfer.TransferID.Int64)
+	require.Equal(t, int64(20), result.Settlement.FromAccount.Balance)
+	require.Equal(t, int64(80), result.Settlement.ToAccount.Balance)
+
+	hold, err := testStore.GetHold(context.Background(), created.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.HoldCaptured, hold.Status)
+}
+
+func TestExternalTransferReturned(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+
+	created := createRandomExternalTransfer(t, account, 100)
+
+	// 全部余额都被冻结了，不能再转出
+	_, err := testStore.CreateExternalTransferTx(context.Background(), CreateExternalTransferTxParams{
+		AccountID:     account.ID,
+		Amount:        1,
+		RoutingNumber: "011000015",
+		AccountNumber: "123456789",
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	result, err := testStore.AdvanceExternalTransferTx(context.Background(), AdvanceExternalTransferTxParams{
+		ID:           created.ExternalTransfer.ID,
+		Status:       util.ExternalTransferReturned,
+		ReturnReason: "R02: account closed",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ExternalTransferReturned, result.ExternalTransfer.Status)
+	require.Equal(t, "R02: account closed", result.ExternalTransfer.ReturnReason)
+
+	held, err := testStore.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = testStore.AdvanceExternalTransferTx(context.Background(), AdvanceExternalTransferTxParams{
+		ID:     created.ExternalTransfer.ID,
+		Status: util.ExternalTransferSubmitted,
+	})
+	require.ErrorIs(t, err, ErrInvalidExternalTransferTransition)
+}
+
+func TestExternalTransferFee(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+	feeAccount := createAccountWithBalance(t, 0)
+
+	arg := CreateExternalTransferTxParams{
+		AccountID:     account.ID,
+		Amount:        80,
+		RoutingNumber: "011000015",
+		AccountNumber: "123456789",
+		Fee:           21,
+		FeeAccountID:  feeAccount.ID,
+	}
+
+	// 80 + 21 超过了余额，整个事务回滚，也不会冻结
+	_, err := testStore.CreateExternalTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	held, err := testStore.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	arg.Fee = 20
+	result, err := testStore.CreateExternalTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotNil(t, result.FeeTransfer)
+	require.Equal(t, int64(20), result.FeeTransfer.Amount)
+	require.Equal(t, feeAccount.ID, result.FeeTransfer.ToAccountID)
+	require.Equal(t, int64(80), result.Account.Balance)
+	require.Zero(t, result.AvailableBalance)
+}
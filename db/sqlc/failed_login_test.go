@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomFailedLogin(t *testing.T, username string, reason string) FailedLogin {
+	arg := CreateFailedLoginParams{
+		Username: username,
+		Reason:   reason,
+		ClientIp: "10.0.0.1",
+	}
+
+	failedLogin, err := testStore.CreateFailedLogin(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, failedLogin.ID)
+	require.Equal(t, arg.Username, failedLogin.Username)
+	require.Equal(t, arg.Reason, failedLogin.Reason)
+	require.Equal(t, arg.ClientIp, failedLogin.ClientIp)
+	require.NotZero(t, failedLogin.CreatedAt)
+
+	return failedLogin
+}
+
+func TestListDailyFailedLogins(t *testing.T) {
+	// 用户不存在的登录也要记下来
+	username := util.RandomOwner()
+	first := createRandomFailedLogin(t, username, util.FailedLoginUserNotFound)
+	createRandomFailedLogin(t, username, util.FailedLoginUserNotFound)
+	last := createRandomFailedLogin(t, util.RandomOwner(), util.FailedLoginIncorrectPassword)
+
+	days, err := testStore.ListDailyFailedLogins(context.Background(), ListDailyFailedLoginsParams{
+		StartTime: first.CreatedAt,
+		EndTime:   last.CreatedAt.Add(time.Microsecond),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, days)
+
+	// 三次失败可能跨过 UTC 零点，分到两天里
+	var failedCount int64
+	for _, day := range days {
+		require.Equal(t, day.Day.UTC().Truncate(24*time.Hour), day.Day.UTC())
+		require.LessOrEqual(t, day.ClientIpCount, day.UsernameCount)
+		failedCount += day.FailedCount
+	}
+	require.Equal(t, int64(3), failedCount)
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestUpsertFeatureFlag(t *testing.T) {
+	name := "test_" + util.RandomString(10)
+	defer testStore.DeleteFeatureFlag(context.Background(), name)
+
+	arg := UpsertFeatureFlagParams{
+		Name:              name,
+		Enabled:           false,
+		RolloutPercentage: 100,
+		Description:       "test flag",
+		UpdatedBy:         util.RandomOwner(),
+	}
+	flag, err := testStore.UpsertFeatureFlag(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Name, flag.Name)
+	require.False(t, flag.Enabled)
+	require.Equal(t, arg.UpdatedBy, flag.UpdatedBy)
+
+	arg.Enabled = true
+	arg.RolloutPercentage = 25
+	flag2, err := testStore.UpsertFeatureFlag(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, flag2.Enabled)
+	require.Equal(t, int32(25), flag2.RolloutPercentage)
+	require.WithinDuration(t, time.Now(), flag2.UpdatedAt, time.Second)
+
+	flag3, err := testStore.GetFeatureFlag(context.Background(), name)
+	require.NoError(t, err)
+	require.Equal(t, flag2, flag3)
+
+	flags, err := testStore.ListFeatureFlags(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, flags, flag3)
+
+	// rollout_percentage 只能是 0 到 100
+	arg.RolloutPercentage = 101
+	_, err = testStore.UpsertFeatureFlag(context.Background(), arg)
+	require.Error(t, err)
+}
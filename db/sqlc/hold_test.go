@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestPlaceHoldTx(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+
+	result, err := testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{
+		AccountID:   account.ID,
+		Amount:      70,
+		Description: "card authorization",
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.ID, result.Hold.AccountID)
+	require.Equal(t, int64(70), result.Hold.Amount)
+	require.Equal(t, util.HoldActive, result.Hold.Status)
+	require.Equal(t, int64(30), result.AvailableBalance)
+
+	// 可用余额只剩 30，不能再冻结 40
+	_, err = testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{AccountID: account.ID, Amount: 40})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	_, err = testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{AccountID: account.ID, Amount: 0})
+	require.ErrorIs(t, err, ErrInvalidHoldAmount)
+
+	held, err := testStore.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(70), held)
+
+	holds, err := testStore.ListActiveHolds(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Len(t, holds, 1)
+
+	// 转账不能动用被冻结的钱
+	toAccount := createAccountWithBalance(t, 0)
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        40,
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        30,
+	})
+	require.NoError(t, err)
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+
+	placed, err := testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{AccountID: account.ID, Amount: 100})
+	require.NoError(t, err)
+
+	result, err := testStore.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.HoldReleased, result.Hold.Status)
+	require.True(t, result.Hold.ResolvedAt.Valid)
+
+	held, err := testStore.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = testStore.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+
+	_, err = testStore.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: placed.Hold.ID + 1000000})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	account := createAccountWithBalance(t, 100)
+	toAccount := createAccountWithBalance(t, 0)
+
+	placed, err := testStore.PlaceHoldTx(context.Background(), PlaceHoldTxParams{AccountID: account.ID, Amount: 60})
+	require.NoError(t, err)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      placed.Hold.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      70,
+	})
+	require.ErrorIs(t, err, ErrHoldAmountExceeded)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      placed.Hold.ID,
+		ToAccountID: account.ID,
+	})
+	require.ErrorIs(t, err, ErrHoldSameAccount)
+
+	// 部分扣款，剩下的金额一起释放
+	result, err := testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      placed.Hold.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      45,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.HoldCaptured, result.Hold.Status)
+	require.Equal(t, int64(45), result.Hold.CapturedAmount)
+	require.Equal(t, result.Transfer.ID, result.Hold.TransferID.Int64)
+	require.Equal(t, int64(55), result.FromAccount.Balance)
+	require.Equal(t, int64(45), result.ToAccount.Balance)
+
+	held, err := testStore.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = testStore.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID:      placed.Hold.ID,
+		ToAccountID: toAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
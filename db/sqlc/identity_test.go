@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/util"
+)
+
+func TestCreateOAuthUserTx(t *testing.T) {
+	arg := CreateOAuthUserTxParams{
+		CreateUserParams: CreateUserParams{
+			Username:       util.RandomOwner(),
+			HashedPassword: util.RandomString(32),
+			FullName:       util.RandomOwner(),
+			Email:          util.RandomEmail(),
+			Locale:         i18n.DefaultLocale,
+		},
+		Provider:        "github",
+		Subject:         util.RandomString(10),
+		IsEmailVerified: true,
+	}
+
+	result, err := testStore.CreateOAuthUserTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, result.User.Username)
+	require.True(t, result.User.IsEmailVerified)
+	require.Equal(t, arg.Username, result.Identity.Username)
+
+	identity, err := testStore.GetIdentity(context.Background(), GetIdentityParams{
+		Provider: arg.Provider,
+		Subject:  arg.Subject,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Identity, identity)
+
+	// 同一个第三方身份不能绑定两次
+	arg.Username = util.RandomOwner()
+	arg.Email = util.RandomEmail()
+	_, err = testStore.CreateOAuthUserTx(context.Background(), arg)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
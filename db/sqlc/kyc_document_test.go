@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomKYCDocument(t *testing.T, user User, documentType string) KycDocument {
+	arg := CreateKYCDocumentParams{
+		Username:     user.Username,
+		DocumentType: documentType,
+		FileKey:      "kyc/" + user.Username + "/" + util.RandomString(12) + ".png",
+		ContentType:  "image/png",
+		SizeBytes:    util.RandomInt(100, 1000),
+	}
+
+	document, err := testStore.CreateKYCDocument(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, document.Username)
+	require.Equal(t, arg.DocumentType, document.DocumentType)
+	require.Equal(t, arg.FileKey, document.FileKey)
+	require.Equal(t, util.KYCDocumentPending, document.Status)
+	require.False(t, document.ReviewedBy.Valid)
+	require.False(t, document.ReviewedAt.Valid)
+	return document
+}
+
+func setKYCDocumentStatus(t *testing.T, document KycDocument, reviewer User, status string) KycDocument {
+	document, err := testStore.ReviewKYCDocument(context.Background(), ReviewKYCDocumentParams{
+		Status:     status,
+		ReviewedBy: pgtype.Text{String: reviewer.Username, Valid: true},
+		ID:         document.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, status, document.Status)
+	require.Equal(t, reviewer.Username, document.ReviewedBy.String)
+	require.True(t, document.ReviewedAt.Valid)
+	return document
+}
+
+func TestReviewKYCDocument(t *testing.T) {
+	user := createRandomUser(t)
+	banker := createRandomUser(t)
+	document := createRandomKYCDocument(t, user, util.KYCPassport)
+
+	setKYCDocumentStatus(t, document, banker, util.KYCDocumentApproved)
+
+	// 已经审核过的证件不能再审核
+	_, err := testStore.ReviewKYCDocument(context.Background(), ReviewKYCDocumentParams{
+		Status:          util.KYCDocumentRejected,
+		RejectionReason: "too late",
+		ReviewedBy:      pgtype.Text{String: banker.Username, Valid: true},
+		ID:              document.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestGetKYCLevel(t *testing.T) {
+	user := createRandomUser(t)
+	banker := createRandomUser(t)
+
+	level, err := testStore.GetKYCLevel(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, util.KYCLevelNone, level)
+
+	// 只有地址证明不算通过身份验证
+	address := createRandomKYCDocument(t, user, util.KYCProofOfAddress)
+	setKYCDocumentStatus(t, address, banker, util.KYCDocumentApproved)
+
+	level, err = testStore.GetKYCLevel(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, util.KYCLevelNone, level)
+
+	// 被拒绝的证件不算
+	rejected := createRandomKYCDocument(t, user, util.KYCPassport)
+	setKYCDocumentStatus(t, rejected, banker, util.KYCDocumentRejected)
+
+	level, err = testStore.GetKYCLevel(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, util.KYCLevelNone, level)
+
+	passport := createRandomKYCDocument(t, user, util.KYCPassport)
+	setKYCDocumentStatus(t, passport, banker, util.KYCDocumentApproved)
+
+	level, err = testStore.GetKYCLevel(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, util.KYCLevelFull, level)
+
+	documents, err := testStore.ListKYCDocuments(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, documents, 3)
+}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createPendingLoan(t *testing.T, account Account, principal int64, termMonths int32) Loan {
+	loan, err := testStore.CreateLoan(context.Background(), CreateLoanParams{
+		AccountID:     account.ID,
+		Principal:     principal,
+		Currency:      account.Currency,
+		AnnualRateBps: 1200,
+		TermMonths:    termMonths,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.LoanPending, loan.Status)
+	return loan
+}
+
+func TestApproveLoanTx(t *testing.T) {
+	funding := createAccountWithBalance(t, 100000)
+	borrower := createAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+	loan := createPendingLoan(t, borrower, 1200, 12)
+
+	result, err := testStore.ApproveLoanTx(context.Background(), ApproveLoanTxParams{
+		LoanID:           loan.ID,
+		FundingAccountID: funding.ID,
+		ReviewedBy:       banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.LoanActive, result.Loan.Status)
+	require.Equal(t, funding.ID, result.Loan.FundingAccountID.Int64)
+	require.Equal(t, banker.Username, result.Loan.ReviewedBy.String)
+	require.Equal(t, int64(1200), result.Disbursement.ToAccount.Balance)
+	require.Len(t, result.Payments, 12)
+
+	payments, err := testStore.ListLoanPayments(context.Background(), loan.ID)
+	require.NoError(t, err)
+	require.Len(t, payments, 12)
+
+	// 不能重复放款
+	_, err = testStore.ApproveLoanTx(context.Background(), ApproveLoanTxParams{
+		LoanID:           loan.ID,
+		FundingAccountID: funding.ID,
+		ReviewedBy:       banker.Username,
+	})
+	require.ErrorIs(t, err, ErrLoanNotPending)
+}
+
+func TestApproveLoanTxInsufficientFunding(t *testing.T) {
+	funding := createAccountWithBalance(t, 100)
+	borrower := createAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+	loan := createPendingLoan(t, borrower, 1200, 12)
+
+	_, err := testStore.ApproveLoanTx(context.Background(), ApproveLoanTxParams{
+		LoanID:           loan.ID,
+		FundingAccountID: funding.ID,
+		ReviewedBy:       banker.Username,
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	loan, err = testStore.GetLoan(context.Background(), loan.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.LoanPending, loan.Status)
+}
+
+func TestCollectLoanPaymentTx(t *testing.T) {
+	funding := createAccountWithBalance(t, 100000)
+	borrower := createAccountWithBalance(t, 0)
+	banker := createRandomUser(t)
+	loan := createPendingLoan(t, borrower, 1000, 2)
+
+	approved, err := testStore.ApproveLoanTx(context.Background(), ApproveLoanTxParams{
+		LoanID:           loan.ID,
+		FundingAccountID: funding.ID,
+		ReviewedBy:       banker.Username,
+	})
+	require.NoError(t, err)
+	require.Len(t, approved.Payments, 2)
+	first, second := approved.Payments[0], approved.Payments[1]
+
+	result, err := testStore.CollectLoanPaymentTx(context.Background(), CollectLoanPaymentTxParams{PaymentID: first.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.LoanPaymentPaid, result.Payment.Status)
+	require.True(t, result.Payment.TransferID.Valid)
+	require.Equal(t, util.LoanActive, result.Loan.Status)
+	require.Equal(t, 1000-first.Amount, result.Debit.FromAccount.Balance)
+
+	_, err = testStore.CollectLoanPaymentTx(context.Background(), CollectLoanPaymentTxParams{PaymentID: first.ID})
+	require.ErrorIs(t, err, ErrLoanPaymentNotScheduled)
+
+	// 借款人把钱转走之后，第二期扣不到钱
+	other := createAccountWithBalance(t, 1000)
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: borrower.ID,
+		ToAccountID:   other.ID,
+		Amount:        1000 - first.Amount,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CollectLoanPaymentTx(context.Background(), CollectLoanPaymentTxParams{PaymentID: second.ID})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	delinquent, err := testStore.MarkLoanDelinquent(context.Background(), loan.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.LoanDelinquent, delinquent.Status)
+
+	// 已经是 delinquent 的贷款不会再被标记一次
+	_, err = testStore.MarkLoanDelinquent(context.Background(), loan.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: other.ID,
+		ToAccountID:   borrower.ID,
+		Amount:        second.Amount,
+	})
+	require.NoError(t, err)
+
+	result, err = testStore.CollectLoanPaymentTx(context.Background(), CollectLoanPaymentTxParams{PaymentID: second.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.LoanPaidOff, result.Loan.Status)
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomMandate(t *testing.T, payer, payee Account, maxAmount int64) Mandate {
+	arg := CreateMandateParams{
+		PayerAccountID: payer.ID,
+		PayeeAccountID: payee.ID,
+		MaxAmount:      maxAmount,
+	}
+
+	mandate, err := testStore.CreateMandate(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, mandate)
+
+	require.Equal(t, arg.PayerAccountID, mandate.PayerAccountID)
+	require.Equal(t, arg.PayeeAccountID, mandate.PayeeAccountID)
+	require.Equal(t, arg.MaxAmount, mandate.MaxAmount)
+	require.Zero(t, mandate.CollectedAmount)
+	require.False(t, mandate.IsRevoked)
+	require.NotZero(t, mandate.CreatedAt)
+
+	return mandate
+}
+
+func createAccountWithBalance(t *testing.T, balance int64) Account {
+	user := createRandomUser(t)
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: util.USD,
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func TestRevokeMandate(t *testing.T) {
+	payer := createRandomAccount(t)
+	payee := createRandomAccount(t)
+	mandate := createRandomMandate(t, payer, payee, 100)
+
+	revoked, err := testStore.RevokeMandate(context.Background(), mandate.ID)
+	require.NoError(t, err)
+	require.True(t, revoked.IsRevoked)
+	require.True(t, revoked.RevokedAt.Valid)
+	require.WithinDuration(t, time.Now(), revoked.RevokedAt.Time, time.Second)
+
+	// 已经撤销的 mandate 不能再撤销一次
+	_, err = testStore.RevokeMandate(context.Background(), mandate.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestCollectPaymentTx(t *testing.T) {
+	payer := createAccountWithBalance(t, 1000)
+	payee := createAccountWithBalance(t, 0)
+	mandate := createRandomMandate(t, payer, payee, 100)
+
+	result, err := testStore.CollectPaymentTx(context.Background(), CollectPaymentTxParams{
+		MandateID: mandate.ID,
+		Amount:    60,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(60), result.Mandate.CollectedAmount)
+	require.Equal(t, int64(940), result.FromAccount.Balance)
+	require.Equal(t, int64(60), result.ToAccount.Balance)
+
+	_, err = testStore.CollectPaymentTx(context.Background(), CollectPaymentTxParams{
+		MandateID: mandate.ID,
+		Amount:    50,
+	})
+	require.ErrorIs(t, err, ErrMandateLimitExceeded)
+
+	_, err = testStore.RevokeMandate(context.Background(), mandate.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.CollectPaymentTx(context.Background(), CollectPaymentTxParams{
+		MandateID: mandate.ID,
+		Amount:    10,
+	})
+	require.ErrorIs(t, err, ErrMandateRevoked)
+}
+
+func TestCollectPaymentTxInsufficientBalance(t *testing.T) {
+	payer := createAccountWithBalance(t, 10)
+	payee := createAccountWithBalance(t, 0)
+	mandate := createRandomMandate(t, payer, payee, 100)
+
+	_, err := testStore.CollectPaymentTx(context.Background(), CollectPaymentTxParams{
+		MandateID: mandate.ID,
+		Amount:    50,
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	mandate, err = testStore.GetMandate(context.Background(), mandate.ID)
+	require.NoError(t, err)
+	require.Zero(t, mandate.CollectedAmount)
+}
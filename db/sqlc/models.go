@@ -8,13 +8,83 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Account struct {
-	ID        int64     `json:"id"`
-	Owner     string    `json:"owner"`
-	Balance   int64     `json:"balance"`
-	Currency  string    `json:"currency"`
+	ID        int64              `json:"id"`
+	Owner     string             `json:"owner"`
+	Balance   int64              `json:"balance"`
+	Currency  string             `json:"currency"`
+	CreatedAt time.Time          `json:"created_at"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	// IBAN-like account number given to customers, see util.GenerateAccountNumber
+	Number string `json:"number"`
+	// receives transfers addressed to the owner by username or email in this currency
+	IsDefault bool `json:"is_default"`
+}
+
+type AuditLog struct {
+	ID int64 `json:"id"`
+	// username of the user who made the change
+	Actor        string    `json:"actor"`
+	Action       string    `json:"action"`
+	ResourceType string    `json:"resource_type"`
+	ResourceID   string    `json:"resource_id"`
+	Details      []byte    `json:"details"`
+	ClientIp     string    `json:"client_ip"`
+	Country      string    `json:"country"`
+	City         string    `json:"city"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type BalanceAlert struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// low_balance or large_debit
+	Kind string `json:"kind"`
+	// in the smallest unit of the account currency
+	Threshold int64     `json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Card struct {
+	ID          int64     `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	PanHash     string    `json:"pan_hash"`
+	Last4       string    `json:"last4"`
+	ExpiryMonth int32     `json:"expiry_month"`
+	ExpiryYear  int32     `json:"expiry_year"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type Dispute struct {
+	ID         int64 `json:"id"`
+	TransferID int64 `json:"transfer_id"`
+	// username of the user who opened the dispute
+	OpenedBy string `json:"opened_by"`
+	Reason   string `json:"reason"`
+	// open, under_review, refunded or rejected
+	Status     string      `json:"status"`
+	Resolution string      `json:"resolution"`
+	ResolvedBy pgtype.Text `json:"resolved_by"`
+	// the reversal of the disputed transfer when the dispute is refunded
+	RefundTransferID pgtype.Int8        `json:"refund_transfer_id"`
+	CreatedAt        time.Time          `json:"created_at"`
+	UpdatedAt        time.Time          `json:"updated_at"`
+	ResolvedAt       pgtype.Timestamptz `json:"resolved_at"`
+}
+
+type DisputeNote struct {
+	ID        int64 `json:"id"`
+	DisputeID int64 `json:"dispute_id"`
+	// username of the banker who wrote the note
+	Author    string    `json:"author"`
+	Note      string    `json:"note"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -22,7 +92,221 @@ type Entry struct {
 	ID        int64 `json:"id"`
 	AccountID int64 `json:"account_id"`
 	// can be negative or positive
-	Amount    int64     `json:"amount"`
+	Amount     int64       `json:"amount"`
+	CreatedAt  time.Time   `json:"created_at"`
+	TransferID pgtype.Int8 `json:"transfer_id"`
+}
+
+type ExportJob struct {
+	ID int64 `json:"id"`
+	// username of the user who started the export
+	Owner         string             `json:"owner"`
+	AccountID     int64              `json:"account_id"`
+	Kind          string             `json:"kind"`
+	Status        string             `json:"status"`
+	ProcessedRows int64              `json:"processed_rows"`
+	TotalRows     int64              `json:"total_rows"`
+	FileKey       string             `json:"file_key"`
+	Error         string             `json:"error"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	CompletedAt   pgtype.Timestamptz `json:"completed_at"`
+	// csv, ofx, qif or camt053
+	Format string `json:"format"`
+}
+
+type ExternalTransfer struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	HoldID    int64 `json:"hold_id"`
+	// must be positive
+	Amount        int64       `json:"amount"`
+	Currency      string      `json:"currency"`
+	RoutingNumber string      `json:"routing_number"`
+	AccountNumber string      `json:"account_number"`
+	Status        string      `json:"status"`
+	ReturnReason  string      `json:"return_reason"`
+	TransferID    pgtype.Int8 `json:"transfer_id"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+}
+
+type FailedLogin struct {
+	ID int64 `json:"id"`
+	// username in the login request, the user may not exist
+	Username string `json:"username"`
+	// user_not_found or incorrect_password
+	Reason    string    `json:"reason"`
+	ClientIp  string    `json:"client_ip"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FeatureFlag struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	// percentage of users that see the feature when it is enabled
+	RolloutPercentage int32     `json:"rollout_percentage"`
+	Description       string    `json:"description"`
+	UpdatedBy         string    `json:"updated_by"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type Hold struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// must be positive
+	Amount         int64              `json:"amount"`
+	Status         string             `json:"status"`
+	Description    string             `json:"description"`
+	CapturedAmount int64              `json:"captured_amount"`
+	TransferID     pgtype.Int8        `json:"transfer_id"`
+	CreatedAt      time.Time          `json:"created_at"`
+	ResolvedAt     pgtype.Timestamptz `json:"resolved_at"`
+	CardID         pgtype.Int8        `json:"card_id"`
+}
+
+type Identity struct {
+	ID        int64     `json:"id"`
+	Username  string    `json:"username"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type KycDocument struct {
+	ID           int64  `json:"id"`
+	Username     string `json:"username"`
+	DocumentType string `json:"document_type"`
+	// key of the uploaded file in the blob store
+	FileKey         string             `json:"file_key"`
+	ContentType     string             `json:"content_type"`
+	SizeBytes       int64              `json:"size_bytes"`
+	Status          string             `json:"status"`
+	RejectionReason string             `json:"rejection_reason"`
+	ReviewedBy      pgtype.Text        `json:"reviewed_by"`
+	ReviewedAt      pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt       time.Time          `json:"created_at"`
+}
+
+type Loan struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// must be positive
+	Principal int64  `json:"principal"`
+	Currency  string `json:"currency"`
+	// annual interest rate in basis points, 800 = 8%
+	AnnualRateBps          int32              `json:"annual_rate_bps"`
+	TermMonths             int32              `json:"term_months"`
+	Status                 string             `json:"status"`
+	FundingAccountID       pgtype.Int8        `json:"funding_account_id"`
+	DisbursementTransferID pgtype.Int8        `json:"disbursement_transfer_id"`
+	ReviewedBy             pgtype.Text        `json:"reviewed_by"`
+	ReviewedAt             pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt              time.Time          `json:"created_at"`
+	UpdatedAt              time.Time          `json:"updated_at"`
+}
+
+type LoanPayment struct {
+	ID          int64              `json:"id"`
+	LoanID      int64              `json:"loan_id"`
+	Installment int32              `json:"installment"`
+	DueDate     time.Time          `json:"due_date"`
+	Principal   int64              `json:"principal"`
+	Interest    int64              `json:"interest"`
+	Amount      int64              `json:"amount"`
+	Status      string             `json:"status"`
+	TransferID  pgtype.Int8        `json:"transfer_id"`
+	PaidAt      pgtype.Timestamptz `json:"paid_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type Mandate struct {
+	ID             int64 `json:"id"`
+	PayerAccountID int64 `json:"payer_account_id"`
+	PayeeAccountID int64 `json:"payee_account_id"`
+	// must be positive
+	MaxAmount       int64              `json:"max_amount"`
+	CollectedAmount int64              `json:"collected_amount"`
+	IsRevoked       bool               `json:"is_revoked"`
+	CreatedAt       time.Time          `json:"created_at"`
+	RevokedAt       pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type NotificationPreference struct {
+	Username     string    `json:"username"`
+	EmailEnabled bool      `json:"email_enabled"`
+	SmsEnabled   bool      `json:"sms_enabled"`
+	PushEnabled  bool      `json:"push_enabled"`
+	PhoneNumber  string    `json:"phone_number"`
+	DeviceToken  string    `json:"device_token"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type OutboxEvent struct {
+	ID        int64  `json:"id"`
+	EventType string `json:"event_type"`
+	// partition key, events with the same key are delivered in order
+	EventKey    string             `json:"event_key"`
+	Payload     []byte             `json:"payload"`
+	PublishedAt pgtype.Timestamptz `json:"published_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type PasswordHistory struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// a previous password of the user, replaced at created_at
+	HashedPassword string    `json:"hashed_password"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type PendingOperation struct {
+	ID                 int64       `json:"id"`
+	TransferID         int64       `json:"transfer_id"`
+	Kind               string      `json:"kind"`
+	Status             string      `json:"status"`
+	LastError          string      `json:"last_error"`
+	ReversalTransferID pgtype.Int8 `json:"reversal_transfer_id"`
+	CreatedAt          time.Time   `json:"created_at"`
+	UpdatedAt          time.Time   `json:"updated_at"`
+}
+
+type ReconciliationDiscrepancy struct {
+	ID             int64     `json:"id"`
+	ReportID       int64     `json:"report_id"`
+	AccountID      int64     `json:"account_id"`
+	AccountBalance int64     `json:"account_balance"`
+	EntriesBalance int64     `json:"entries_balance"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type ReconciliationReport struct {
+	ID               int64     `json:"id"`
+	AccountsChecked  int64     `json:"accounts_checked"`
+	DiscrepancyCount int64     `json:"discrepancy_count"`
+	StartedAt        time.Time `json:"started_at"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type Referral struct {
+	ID       int64  `json:"id"`
+	Referrer string `json:"referrer"`
+	Referred string `json:"referred"`
+	// client IP of the referred user at sign up, bonuses per IP are limited
+	SignupIp string `json:"signup_ip"`
+	// pending, credited or rejected
+	Status             string             `json:"status"`
+	RejectReason       string             `json:"reject_reason"`
+	ReferrerTransferID pgtype.Int8        `json:"referrer_transfer_id"`
+	ReferredTransferID pgtype.Int8        `json:"referred_transfer_id"`
+	CreditedAt         pgtype.Timestamptz `json:"credited_at"`
+	CreatedAt          time.Time          `json:"created_at"`
+}
+
+type ReferralCode struct {
+	Username  string    `json:"username"`
+	Code      string    `json:"code"`
 	CreatedAt time.Time `json:"created_at"`
 }
 
@@ -35,6 +319,9 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// ISO country code of the client ip, empty if unknown
+	Country string `json:"country"`
+	City    string `json:"city"`
 }
 
 type Transfer struct {
@@ -44,17 +331,75 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	Category  string    `json:"category"`
+	Memo      string    `json:"memo"`
+	Reference string    `json:"reference"`
+	// the transfer reversed by this transfer, a transfer can only be reversed once
+	ReversalOf pgtype.Int8 `json:"reversal_of"`
+	// the transfer this fee was charged for, see TRANSFER_FEES
+	FeeOf pgtype.Int8 `json:"fee_of"`
+}
+
+type TransferQuote struct {
+	ID           int64  `json:"id"`
+	Username     string `json:"username"`
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	// debited from the sender, fee included
+	FromAmount int64 `json:"from_amount"`
+	// in from_currency, kept in the FX account
+	Fee      int64 `json:"fee"`
+	ToAmount int64 `json:"to_amount"`
+	// decimal string, units of to_currency per unit of from_currency
+	Rate      string             `json:"rate"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	// sender account to the FX account in from_currency
+	DebitTransferID pgtype.Int8 `json:"debit_transfer_id"`
+	// FX account in to_currency to the recipient account
+	CreditTransferID pgtype.Int8 `json:"credit_transfer_id"`
+	CreatedAt        time.Time   `json:"created_at"`
 }
 
 type User struct {
-	Username          string    `json:"username"`
-	HashedPassword    string    `json:"hashed_password"`
-	FullName          string    `json:"full_name"`
-	Email             string    `json:"email"`
-	PasswordChangedAt time.Time `json:"password_changed_at"`
-	CreatedAt         time.Time `json:"created_at"`
-	Role              string    `json:"role"`
-	IsEmailVerified   bool      `json:"is_email_verified"`
+	Username              string             `json:"username"`
+	HashedPassword        string             `json:"hashed_password"`
+	FullName              string             `json:"full_name"`
+	Email                 string             `json:"email"`
+	PasswordChangedAt     time.Time          `json:"password_changed_at"`
+	CreatedAt             time.Time          `json:"created_at"`
+	Role                  string             `json:"role"`
+	IsEmailVerified       bool               `json:"is_email_verified"`
+	IsDisabled            bool               `json:"is_disabled"`
+	PasswordResetRequired bool               `json:"password_reset_required"`
+	DeletedAt             pgtype.Timestamptz `json:"deleted_at"`
+	// language of emails sent to the user
+	Locale    string    `json:"locale"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// number of verification reminders sent
+	VerifyEmailReminders      int32              `json:"verify_email_reminders"`
+	LastVerifyEmailReminderAt pgtype.Timestamptz `json:"last_verify_email_reminder_at"`
+	// whether other users may send money by username or email
+	AllowAliasTransfers bool `json:"allow_alias_transfers"`
+	// when a pending account deletion requested by the user takes effect, NULL if there is none
+	DeletionScheduledAt pgtype.Timestamptz `json:"deletion_scheduled_at"`
+	// E.164 format, e.g. +14155550123
+	PhoneNumber string      `json:"phone_number"`
+	DateOfBirth pgtype.Date `json:"date_of_birth"`
+	Address     string      `json:"address"`
+	// blob store key of the uploaded avatar, empty if there is none
+	AvatarKey string `json:"avatar_key"`
+}
+
+type UserDevice struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	// hash of the user agent and the network of the client ip
+	Fingerprint string    `json:"fingerprint"`
+	UserAgent   string    `json:"user_agent"`
+	ClientIp    string    `json:"client_ip"`
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	LastSeenAt  time.Time `json:"last_seen_at"`
 }
 
 type VerifyEmail struct {
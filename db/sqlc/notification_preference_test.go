@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpsertNotificationPreferences(t *testing.T) {
+	user := createRandomUser(t)
+
+	_, err := testStore.GetNotificationPreferences(context.Background(), user.Username)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	arg := UpsertNotificationPreferencesParams{
+		Username:     user.Username,
+		EmailEnabled: true,
+		SmsEnabled:   true,
+		PhoneNumber:  "+14155552671",
+	}
+	preferences1, err := testStore.UpsertNotificationPreferences(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, preferences1.Username)
+	require.True(t, preferences1.SmsEnabled)
+	require.False(t, preferences1.PushEnabled)
+	require.Equal(t, arg.PhoneNumber, preferences1.PhoneNumber)
+
+	arg.PushEnabled = true
+	arg.DeviceToken = "device-token"
+	preferences2, err := testStore.UpsertNotificationPreferences(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, preferences2.PushEnabled)
+	require.Equal(t, arg.DeviceToken, preferences2.DeviceToken)
+	require.False(t, preferences2.UpdatedAt.Before(preferences1.UpdatedAt))
+
+	preferences3, err := testStore.GetNotificationPreferences(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, preferences2, preferences3)
+
+	err = testStore.DeleteNotificationPreferences(context.Background(), user.Username)
+	require.NoError(t, err)
+
+	_, err = testStore.GetNotificationPreferences(context.Background(), user.Username)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+// relayUntil 一直 relay 到 match 返回 true 或者没有未发布的事件为止，
+// 其它测试也会往 outbox 里写事件，所以要找的事件不一定在第一批里
+func relayUntil(t *testing.T, match func(event OutboxEvent) bool) (OutboxEvent, bool) {
+	for {
+		var found OutboxEvent
+		var ok bool
+		count, err := testStore.RelayOutboxEventsTx(context.Background(), 100, func(events []OutboxEvent) error {
+			for _, event := range events {
+				if match(event) {
+					found, ok = event, true
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		if ok || count == 0 {
+			return found, ok
+		}
+	}
+}
+
+func TestTransferTxCreatesOutboxEvent(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	event, ok := relayUntil(t, func(event OutboxEvent) bool {
+		if event.EventType != util.EventTransferCompleted {
+			return false
+		}
+		var payload util.TransferCompletedEvent
+		require.NoError(t, json.Unmarshal(event.Payload, &payload))
+		return payload.TransferID == result.Transfer.ID
+	})
+	require.True(t, ok)
+
+	var payload util.TransferCompletedEvent
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, account1.ID, payload.FromAccountID)
+	require.Equal(t, account2.ID, payload.ToAccountID)
+	require.Equal(t, int64(10), payload.Amount)
+	require.Equal(t, account1.Currency, payload.Currency)
+	require.False(t, event.PublishedAt.Valid)
+
+	// 已经发布的事件不会再被 relay
+	_, ok = relayUntil(t, func(other OutboxEvent) bool {
+		return other.ID == event.ID
+	})
+	require.False(t, ok)
+}
+
+func TestRelayOutboxEventsTxPublishError(t *testing.T) {
+	user := createRandomUser(t)
+	event, err := testStore.CreateOutboxEvent(context.Background(), CreateOutboxEventParams{
+		EventType: util.EventEmailVerified,
+		EventKey:  user.Username,
+		Payload:   []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	// publish 失败时事务回滚，事件还是未发布，下次会再发
+	for {
+		var seen bool
+		count, err := testStore.RelayOutboxEventsTx(context.Background(), 100, func(events []OutboxEvent) error {
+			for _, other := range events {
+				if other.ID == event.ID {
+					seen = true
+					return errors.New("broker is down")
+				}
+			}
+			return nil
+		})
+		if seen {
+			require.Error(t, err)
+			break
+		}
+		require.NoError(t, err)
+		require.NotZero(t, count)
+	}
+
+	_, ok := relayUntil(t, func(other OutboxEvent) bool {
+		return other.ID == event.ID
+	})
+	require.True(t, ok)
+}
+
+func TestDeleteOutboxEvents(t *testing.T) {
+	user := createRandomUser(t)
+	event, err := testStore.CreateOutboxEvent(context.Background(), CreateOutboxEventParams{
+		EventType: util.EventUserCreated,
+		EventKey:  user.Username,
+		Payload:   []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	// 没有发布的事件默认不删
+	err = testStore.DeleteOutboxEvents(context.Background(), DeleteOutboxEventsParams{
+		CreatedBefore: time.Now().Add(time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, ok := relayUntil(t, func(other OutboxEvent) bool {
+		return other.ID == event.ID
+	})
+	require.True(t, ok)
+
+	err = testStore.DeleteOutboxEvents(context.Background(), DeleteOutboxEventsParams{
+		CreatedBefore:      time.Now().Add(time.Minute),
+		IncludeUnpublished: true,
+	})
+	require.NoError(t, err)
+}
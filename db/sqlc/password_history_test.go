@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func changePassword(t *testing.T, username string, hashedPassword string, historySize int32) error {
+	// 测试里直接比较哈希字符串，不用真的算哈希
+	_, err := testStore.ChangePasswordTx(context.Background(), ChangePasswordTxParams{
+		UpdateUserParams: UpdateUserParams{
+			Username:       username,
+			HashedPassword: pgtype.Text{String: hashedPassword, Valid: true},
+		},
+		HistorySize: historySize,
+		IsReused: func(previous string) bool {
+			return previous == hashedPassword
+		},
+	})
+	return err
+}
+
+func TestChangePasswordTx(t *testing.T) {
+	user := createRandomUser(t)
+	first := user.HashedPassword
+	second := util.RandomString(20)
+	third := util.RandomString(20)
+	fourth := util.RandomString(20)
+
+	require.NoError(t, changePassword(t, user.Username, second, 3))
+	require.NoError(t, changePassword(t, user.Username, third, 3))
+
+	// 当前密码和最近两个旧密码都不能再用
+	require.ErrorIs(t, changePassword(t, user.Username, third, 3), ErrPasswordReused)
+	require.ErrorIs(t, changePassword(t, user.Username, second, 3), ErrPasswordReused)
+	require.ErrorIs(t, changePassword(t, user.Username, first, 3), ErrPasswordReused)
+
+	require.NoError(t, changePassword(t, user.Username, fourth, 3))
+
+	history, err := testStore.ListPasswordHistory(context.Background(), ListPasswordHistoryParams{
+		Username: user.Username,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, third, history[0].HashedPassword)
+	require.Equal(t, second, history[1].HashedPassword)
+
+	// 最早的密码已经不在历史里了
+	require.NoError(t, changePassword(t, user.Username, first, 3))
+
+	// HistorySize 为 0 时不检查，当前密码也可以再用，已有的历史会被删掉
+	require.NoError(t, changePassword(t, user.Username, first, 0))
+	history, err = testStore.ListPasswordHistory(context.Background(), ListPasswordHistoryParams{
+		Username: user.Username,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+// createFundedAccount 创建一个余额足够的转出账户，避免随机余额导致 ErrInsufficientBalance
+func createFundedAccount(t *testing.T) Account {
+	user := createRandomUser(t)
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  100,
+		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func createRandomPendingTransfer(t *testing.T, fromAccount, toAccount Account, amount int64) CreatePendingTransferTxResult {
+	result, err := testStore.CreatePendingTransferTx(context.Background(), CreatePendingTransferTxParams{
+		TransferTxParams: TransferTxParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Amount:        amount,
+		},
+		Kind: util.OperationExternalPayout,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.Transfer.ID, result.Operation.TransferID)
+	require.Equal(t, util.OperationPending, result.Operation.Status)
+	require.False(t, result.Operation.ReversalTransferID.Valid)
+
+	return result
+}
+
+func TestConfirmPendingOperationTx(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	pending := createRandomPendingTransfer(t, account1, account2, 10)
+
+	result, err := testStore.ConfirmPendingOperationTx(context.Background(), ConfirmPendingOperationTxParams{
+		OperationID: pending.Operation.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OperationConfirmed, result.Operation.Status)
+
+	// 已经确认的操作不能再撤销
+	_, err = testStore.ReversePendingOperationTx(context.Background(), ReversePendingOperationTxParams{
+		OperationID: pending.Operation.ID,
+		Reason:      "payout rejected",
+	})
+	require.ErrorIs(t, err, ErrOperationNotPending)
+}
+
+func TestReversePendingOperationTx(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	pending := createRandomPendingTransfer(t, account1, account2, 10)
+
+	result, err := testStore.ReversePendingOperationTx(context.Background(), ReversePendingOperationTxParams{
+		OperationID: pending.Operation.ID,
+		Reason:      "payout rejected",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OperationReversed, result.Operation.Status)
+	require.Equal(t, "payout rejected", result.Operation.LastError)
+	require.Equal(t, result.Reversal.Transfer.ID, result.Operation.ReversalTransferID.Int64)
+
+	reversal := result.Reversal.Transfer
+	require.Equal(t, account2.ID, reversal.FromAccountID)
+	require.Equal(t, account1.ID, reversal.ToAccountID)
+	require.Equal(t, int64(10), reversal.Amount)
+	require.Equal(t, fmt.Sprintf("reversal of transfer #%d", pending.Transfer.ID), reversal.Memo)
+
+	// 撤销之后两个账户的余额都回到原来的值
+	require.Equal(t, account1.Balance, result.Reversal.ToAccount.Balance)
+	require.Equal(t, account2.Balance, result.Reversal.FromAccount.Balance)
+
+	// 同一个操作只能撤销一次
+	_, err = testStore.ReversePendingOperationTx(context.Background(), ReversePendingOperationTxParams{
+		OperationID: pending.Operation.ID,
+	})
+	require.ErrorIs(t, err, ErrOperationNotPending)
+
+	operation, err := testStore.GetPendingOperationByTransfer(context.Background(), pending.Transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.OperationReversed, operation.Status)
+}
+
+func TestListStalePendingOperations(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	pending := createRandomPendingTransfer(t, account1, account2, 10)
+
+	operations, err := testStore.ListStalePendingOperations(context.Background(), ListStalePendingOperationsParams{
+		CreatedAt: time.Now().Add(time.Minute),
+		Limit:     1000,
+	})
+	require.NoError(t, err)
+
+	found := false
+	for _, operation := range operations {
+		require.Equal(t, util.OperationPending, operation.Status)
+		if operation.ID == pending.Operation.ID {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+/*
+NewConnPool 创建连接池，并在每个连接上设置 statement_timeout。
+context 的超时只能让客户端不再等待，PostgreSQL 那边的查询还会继续跑；
+statement_timeout 让数据库自己取消超时的语句，慢查询不会一直占着连接和锁。
+statementTimeout 为 0 时使用数据库的默认值（不限时）。
+*/
+func NewConnPool(ctx context.Context, source string, statementTimeout time.Duration) (*pgxpool.Pool, error) {
+	poolConfig, err := pgxpool.ParseConfig(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if statementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(statementTimeout.Milliseconds(), 10)
+	}
+
+	return pgxpool.NewWithConfig(ctx, poolConfig)
+}
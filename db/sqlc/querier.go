@@ -12,25 +12,183 @@ import (
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddMandateCollectedAmount(ctx context.Context, arg AddMandateCollectedAmountParams) (Mandate, error)
+	AnonymizeUser(ctx context.Context, username string) (User, error)
+	ApproveLoan(ctx context.Context, arg ApproveLoanParams) (Loan, error)
+	BackdateTransfer(ctx context.Context, arg BackdateTransferParams) error
+	BlockSession(ctx context.Context, id uuid.UUID) (Session, error)
+	BlockUserSessions(ctx context.Context, username string) error
+	CancelUserDeletion(ctx context.Context, arg CancelUserDeletionParams) (User, error)
+	CaptureHold(ctx context.Context, arg CaptureHoldParams) (Hold, error)
+	ClearDefaultAccount(ctx context.Context, arg ClearDefaultAccountParams) ([]int64, error)
+	CloseUserAccounts(ctx context.Context, owner string) error
+	CountAccountEntries(ctx context.Context, accountID int64) (int64, error)
+	CountAccountTransfers(ctx context.Context, accountID int64) (int64, error)
+	CountAccountsWithBalance(ctx context.Context, owner string) (int64, error)
+	CountAllAccounts(ctx context.Context) (int64, error)
+	CountAllTransfers(ctx context.Context) (int64, error)
+	CountCreditedReferralsByReferrer(ctx context.Context, referrer string) (int64, error)
+	CountCreditedReferralsBySignupIP(ctx context.Context, signupIp string) (int64, error)
+	CountOverdueLoanPayments(ctx context.Context, arg CountOverdueLoanPaymentsParams) (int64, error)
+	CountUnpaidLoanPayments(ctx context.Context, loanID int64) (int64, error)
+	CountUserDevices(ctx context.Context, username string) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAdminUser(ctx context.Context, arg CreateAdminUserParams) (User, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) (AuditLog, error)
+	CreateBalanceAlert(ctx context.Context, arg CreateBalanceAlertParams) (BalanceAlert, error)
+	CreateCard(ctx context.Context, arg CreateCardParams) (Card, error)
+	CreateDispute(ctx context.Context, arg CreateDisputeParams) (Dispute, error)
+	CreateDisputeNote(ctx context.Context, arg CreateDisputeNoteParams) (DisputeNote, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateExportJob(ctx context.Context, arg CreateExportJobParams) (ExportJob, error)
+	CreateExternalTransfer(ctx context.Context, arg CreateExternalTransferParams) (ExternalTransfer, error)
+	CreateFailedLogin(ctx context.Context, arg CreateFailedLoginParams) (FailedLogin, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdentity(ctx context.Context, arg CreateIdentityParams) (Identity, error)
+	CreateKYCDocument(ctx context.Context, arg CreateKYCDocumentParams) (KycDocument, error)
+	CreateLoan(ctx context.Context, arg CreateLoanParams) (Loan, error)
+	CreateLoanPayment(ctx context.Context, arg CreateLoanPaymentParams) (LoanPayment, error)
+	CreateMandate(ctx context.Context, arg CreateMandateParams) (Mandate, error)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error)
+	CreatePasswordHistory(ctx context.Context, arg CreatePasswordHistoryParams) (PasswordHistory, error)
+	CreatePendingOperation(ctx context.Context, arg CreatePendingOperationParams) (PendingOperation, error)
+	CreateReconciliationDiscrepancy(ctx context.Context, arg CreateReconciliationDiscrepancyParams) (ReconciliationDiscrepancy, error)
+	CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error)
+	CreateReferral(ctx context.Context, arg CreateReferralParams) (Referral, error)
+	CreateReferralCode(ctx context.Context, arg CreateReferralCodeParams) (ReferralCode, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
+	CreateTransferQuote(ctx context.Context, arg CreateTransferQuoteParams) (TransferQuote, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserDevice(ctx context.Context, arg CreateUserDeviceParams) (UserDevice, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
+	CreditReferral(ctx context.Context, arg CreditReferralParams) (Referral, error)
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteBalanceAlert(ctx context.Context, id int64) error
+	DeleteFeatureFlag(ctx context.Context, name string) error
+	DeleteNotificationPreferences(ctx context.Context, username string) error
+	DeleteOutboxEvents(ctx context.Context, arg DeleteOutboxEventsParams) error
+	DeleteUserDevice(ctx context.Context, arg DeleteUserDeviceParams) error
+	DeleteUserDevices(ctx context.Context, username string) error
+	DeleteUserPasswordHistory(ctx context.Context, username string) error
+	DeleteUserSessions(ctx context.Context, username string) error
+	DeleteUserVerifyEmails(ctx context.Context, username string) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountByNumber(ctx context.Context, number string) (Account, error)
+	GetAccountByOwnerCurrency(ctx context.Context, arg GetAccountByOwnerCurrencyParams) (Account, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountStatement(ctx context.Context, id int64) (GetAccountStatementRow, error)
+	GetAccountsByIDs(ctx context.Context, ids []int64) ([]Account, error)
+	GetBalanceAlert(ctx context.Context, id int64) (BalanceAlert, error)
+	GetCard(ctx context.Context, id int64) (Card, error)
+	GetCardByPanHash(ctx context.Context, panHash string) (Card, error)
+	GetCardForUpdate(ctx context.Context, id int64) (Card, error)
+	GetDispute(ctx context.Context, id int64) (Dispute, error)
+	GetDisputeForUpdate(ctx context.Context, id int64) (Dispute, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExportJob(ctx context.Context, id int64) (ExportJob, error)
+	GetExternalTransfer(ctx context.Context, id int64) (ExternalTransfer, error)
+	GetExternalTransferForUpdate(ctx context.Context, id int64) (ExternalTransfer, error)
+	GetFeatureFlag(ctx context.Context, name string) (FeatureFlag, error)
+	GetHeldAmount(ctx context.Context, accountID int64) (int64, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdentity(ctx context.Context, arg GetIdentityParams) (Identity, error)
+	GetKYCDocument(ctx context.Context, id int64) (KycDocument, error)
+	GetKYCLevel(ctx context.Context, username string) (int32, error)
+	GetLatestReconciliationReport(ctx context.Context) (ReconciliationReport, error)
+	GetLoan(ctx context.Context, id int64) (Loan, error)
+	GetLoanForUpdate(ctx context.Context, id int64) (Loan, error)
+	GetLoanPaymentForUpdate(ctx context.Context, id int64) (LoanPayment, error)
+	GetMandate(ctx context.Context, id int64) (Mandate, error)
+	GetMandateForUpdate(ctx context.Context, id int64) (Mandate, error)
+	GetNotificationPreferences(ctx context.Context, username string) (NotificationPreference, error)
+	GetPendingOperation(ctx context.Context, id int64) (PendingOperation, error)
+	GetPendingOperationByTransfer(ctx context.Context, transferID int64) (PendingOperation, error)
+	GetPendingOperationForUpdate(ctx context.Context, id int64) (PendingOperation, error)
+	GetReconciliationReport(ctx context.Context, id int64) (ReconciliationReport, error)
+	GetReferralCode(ctx context.Context, code string) (ReferralCode, error)
+	GetReferralCodeByUsername(ctx context.Context, username string) (ReferralCode, error)
+	GetReferralForUpdate(ctx context.Context, id int64) (Referral, error)
+	GetReferralStats(ctx context.Context, referrer string) (GetReferralStatsRow, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSpendingSummary(ctx context.Context, arg GetSpendingSummaryParams) ([]GetSpendingSummaryRow, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferQuote(ctx context.Context, id int64) (TransferQuote, error)
+	GetTransferQuoteForUpdate(ctx context.Context, id int64) (TransferQuote, error)
+	GetTransferReversal(ctx context.Context, transferID int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserForUpdate(ctx context.Context, username string) (User, error)
+	GetUserDevice(ctx context.Context, arg GetUserDeviceParams) (UserDevice, error)
+	GetUsersByUsernames(ctx context.Context, usernames []string) ([]User, error)
+	GetVerifyEmailForUpdate(ctx context.Context, id int64) (VerifyEmail, error)
+	ListAccountActivity(ctx context.Context, arg ListAccountActivityParams) ([]ListAccountActivityRow, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListActiveHolds(ctx context.Context, accountID int64) ([]Hold, error)
+	ListBalanceAlerts(ctx context.Context, accountID int64) ([]BalanceAlert, error)
+	ListBalanceDiscrepancies(ctx context.Context) ([]ListBalanceDiscrepanciesRow, error)
+	ListCards(ctx context.Context, accountID int64) ([]Card, error)
+	ListDailyFailedLogins(ctx context.Context, arg ListDailyFailedLoginsParams) ([]ListDailyFailedLoginsRow, error)
+	ListDailyNewUsers(ctx context.Context, arg ListDailyNewUsersParams) ([]ListDailyNewUsersRow, error)
+	ListDailyTransferVolume(ctx context.Context, arg ListDailyTransferVolumeParams) ([]ListDailyTransferVolumeRow, error)
+	ListDisputeNotes(ctx context.Context, disputeID int64) ([]DisputeNote, error)
+	ListDisputes(ctx context.Context, arg ListDisputesParams) ([]Dispute, error)
+	ListDueLoanPayments(ctx context.Context, arg ListDueLoanPaymentsParams) ([]LoanPayment, error)
+	ListEligibleReferrals(ctx context.Context, arg ListEligibleReferralsParams) ([]Referral, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error)
+	ListKYCDocuments(ctx context.Context, username string) ([]KycDocument, error)
+	ListLoanPayments(ctx context.Context, loanID int64) ([]LoanPayment, error)
+	ListMonthlyAccountSummaries(ctx context.Context, arg ListMonthlyAccountSummariesParams) ([]ListMonthlyAccountSummariesRow, error)
+	ListPasswordHistory(ctx context.Context, arg ListPasswordHistoryParams) ([]PasswordHistory, error)
+	ListPendingKYCDocuments(ctx context.Context, arg ListPendingKYCDocumentsParams) ([]KycDocument, error)
+	ListReconciliationDiscrepancies(ctx context.Context, reportID int64) ([]ReconciliationDiscrepancy, error)
+	ListResourceAuditLogs(ctx context.Context, arg ListResourceAuditLogsParams) ([]AuditLog, error)
+	ListStalePendingOperations(ctx context.Context, arg ListStalePendingOperationsParams) ([]PendingOperation, error)
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error)
+	ListTopAccountsByActivity(ctx context.Context, arg ListTopAccountsByActivityParams) ([]ListTopAccountsByActivityRow, error)
+	ListTotalDeposits(ctx context.Context, role string) ([]ListTotalDepositsRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListUnbalancedTransfers(ctx context.Context, limit int32) ([]ListUnbalancedTransfersRow, error)
+	ListUnpublishedOutboxEventsForUpdate(ctx context.Context, limit int32) ([]OutboxEvent, error)
+	ListUnverifiedUsers(ctx context.Context, arg ListUnverifiedUsersParams) ([]User, error)
+	ListUserSessions(ctx context.Context, username string) ([]Session, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListUsersDueForVerifyEmailReminder(ctx context.Context, arg ListUsersDueForVerifyEmailReminderParams) ([]User, error)
+	LockAccounts(ctx context.Context, ids []int64) error
+	MarkDefaultAccount(ctx context.Context, id int64) (Account, error)
+	MarkLoanDelinquent(ctx context.Context, id int64) (Loan, error)
+	MarkLoanPaymentPaid(ctx context.Context, arg MarkLoanPaymentPaidParams) (LoanPayment, error)
+	MarkOutboxEventsPublished(ctx context.Context, ids []int64) error
+	MarkTransferQuoteUsed(ctx context.Context, arg MarkTransferQuoteUsedParams) (TransferQuote, error)
+	MarkVerifyEmailReminderSent(ctx context.Context, arg MarkVerifyEmailReminderSentParams) (int64, error)
+	RejectLoan(ctx context.Context, arg RejectLoanParams) (Loan, error)
+	RejectReferral(ctx context.Context, arg RejectReferralParams) (Referral, error)
+	ReleaseHold(ctx context.Context, id int64) (Hold, error)
+	ReviewKYCDocument(ctx context.Context, arg ReviewKYCDocumentParams) (KycDocument, error)
+	RevokeMandate(ctx context.Context, id int64) (Mandate, error)
+	ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (User, error)
+	SearchAccounts(ctx context.Context, arg SearchAccountsParams) ([]SearchAccountsRow, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error)
+	SoftDeleteUser(ctx context.Context, username string) (User, error)
+	TouchUserDevice(ctx context.Context, arg TouchUserDeviceParams) error
+	TrimPasswordHistory(ctx context.Context, arg TrimPasswordHistoryParams) error
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountOwner(ctx context.Context, arg UpdateAccountOwnerParams) (Account, error)
+	UpdateBalanceAlert(ctx context.Context, arg UpdateBalanceAlertParams) (BalanceAlert, error)
+	UpdateCardStatus(ctx context.Context, arg UpdateCardStatusParams) (Card, error)
+	UpdateDisputeStatus(ctx context.Context, arg UpdateDisputeStatusParams) (Dispute, error)
+	UpdateExportJob(ctx context.Context, arg UpdateExportJobParams) (ExportJob, error)
+	UpdateExternalTransferStatus(ctx context.Context, arg UpdateExternalTransferStatusParams) (ExternalTransfer, error)
+	UpdateLoanStatus(ctx context.Context, arg UpdateLoanStatusParams) (Loan, error)
+	UpdatePendingOperationStatus(ctx context.Context, arg UpdatePendingOperationStatusParams) (PendingOperation, error)
+	UpdateTransferMemo(ctx context.Context, arg UpdateTransferMemoParams) (Transfer, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserAvatar(ctx context.Context, arg UpdateUserAvatarParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertFeatureFlag(ctx context.Context, arg UpsertFeatureFlagParams) (FeatureFlag, error)
+	UpsertNotificationPreferences(ctx context.Context, arg UpsertNotificationPreferencesParams) (NotificationPreference, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileBalancesTx(t *testing.T) {
+	// 直接修改余额、不写 entries，模拟一笔对不上的账
+	account := createRandomAccount(t)
+	_, err := testStore.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: 10,
+	})
+	require.NoError(t, err)
+
+	startedAt := time.Now()
+	result, err := testStore.ReconcileBalancesTx(context.Background(), ReconcileBalancesTxParams{
+		StartedAt: startedAt,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Report.ID)
+	require.Positive(t, result.Report.AccountsChecked)
+	require.Equal(t, int64(len(result.Discrepancies)), result.Report.DiscrepancyCount)
+	require.WithinDuration(t, startedAt, result.Report.StartedAt, time.Second)
+
+	var found *ReconciliationDiscrepancy
+	for i := range result.Discrepancies {
+		if result.Discrepancies[i].AccountID == account.ID {
+			found = &result.Discrepancies[i]
+		}
+	}
+	require.NotNil(t, found)
+	require.Equal(t, account.Balance+10, found.AccountBalance)
+	require.Equal(t, result.Report.ID, found.ReportID)
+
+	latest, err := testStore.GetLatestReconciliationReport(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, result.Report.ID, latest.ID)
+
+	discrepancies, err := testStore.ListReconciliationDiscrepancies(context.Background(), result.Report.ID)
+	require.NoError(t, err)
+	require.Len(t, discrepancies, len(result.Discrepancies))
+}
+
+func TestListUnbalancedTransfers(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// 没有 entries 的转账不平衡
+	transfer := createRandomTransfer(t, account1, account2)
+
+	transfers, err := testStore.ListUnbalancedTransfers(context.Background(), math.MaxInt32)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, row := range transfers {
+		ids[row.TransferID] = true
+	}
+	require.False(t, ids[result.Transfer.ID])
+	require.True(t, ids[transfer.ID])
+}
+
+func TestUnbalancedEntriesRejected(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	transfer := createRandomTransfer(t, account1, account2)
+
+	// 只有一条 entry 的转账在提交时被数据库拒绝
+	err := testStore.(*SQLStore).execTx(context.Background(), func(q *Queries) error {
+		_, err := q.CreateEntry(context.Background(), CreateEntryParams{
+			AccountID:  account1.ID,
+			Amount:     -transfer.Amount,
+			TransferID: pgtype.Int8{Int64: transfer.ID, Valid: true},
+		})
+		return err
+	})
+	require.Error(t, err)
+	require.Equal(t, CheckViolation, ErrorCode(err))
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomReferral(t *testing.T, referrer string, signupIP string) Referral {
+	referred := createRandomUser(t)
+
+	referral, err := testStore.CreateReferral(context.Background(), CreateReferralParams{
+		Referrer: referrer,
+		Referred: referred.Username,
+		SignupIp: signupIP,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, referral.ID)
+	require.Equal(t, util.ReferralPending, referral.Status)
+	require.False(t, referral.CreditedAt.Valid)
+
+	return referral
+}
+
+func createAccountForOwner(t *testing.T, owner string, currency string) Account {
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    owner,
+		Balance:  0,
+		Currency: currency,
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func TestReferralCode(t *testing.T) {
+	user := createRandomUser(t)
+	code, err := util.GenerateReferralCode()
+	require.NoError(t, err)
+
+	referralCode, err := testStore.CreateReferralCode(context.Background(), CreateReferralCodeParams{
+		Username: user.Username,
+		Code:     code,
+	})
+	require.NoError(t, err)
+
+	byCode, err := testStore.GetReferralCode(context.Background(), code)
+	require.NoError(t, err)
+	require.Equal(t, referralCode.Username, byCode.Username)
+
+	byUsername, err := testStore.GetReferralCodeByUsername(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, code, byUsername.Code)
+}
+
+func TestCreditReferralBonusTx(t *testing.T) {
+	funding := createRandomAccount(t)
+	referrer := createRandomUser(t)
+	referrerAccount := createAccountForOwner(t, referrer.Username, funding.Currency)
+	signupIP := util.RandomString(12)
+
+	arg := CreditReferralBonusTxParams{
+		Amount:           500,
+		Currency:         funding.Currency,
+		FundingAccountID: funding.ID,
+		MaxPerReferrer:   2,
+		MaxPerIP:         1,
+	}
+
+	// 被推荐人还没有这个币种的账户时保持 pending
+	referral := createRandomReferral(t, referrer.Username, signupIP)
+	arg.ReferralID = referral.ID
+	_, err := testStore.CreditReferralBonusTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrReferralNoAccount)
+
+	referredAccount := createAccountForOwner(t, referral.Referred, funding.Currency)
+	result, err := testStore.CreditReferralBonusTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.ReferralCredited, result.Referral.Status)
+	require.True(t, result.Referral.CreditedAt.Valid)
+	require.Equal(t, referrerAccount.ID, result.ReferrerBonus.ToAccount.ID)
+	require.Equal(t, referredAccount.ID, result.ReferredBonus.ToAccount.ID)
+	require.Equal(t, int64(500), result.ReferredBonus.ToAccount.Balance)
+	require.Equal(t, funding.Balance-1000, result.ReferredBonus.FromAccount.Balance)
+
+	// 不会重复发奖励
+	_, err = testStore.CreditReferralBonusTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrReferralNotPending)
+
+	// 同一个 IP 只能拿一次奖励
+	referral = createRandomReferral(t, referrer.Username, signupIP)
+	createAccountForOwner(t, referral.Referred, funding.Currency)
+	arg.ReferralID = referral.ID
+	result, err = testStore.CreditReferralBonusTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.ReferralRejected, result.Referral.Status)
+	require.Equal(t, "sign up IP bonus limit reached", result.Referral.RejectReason)
+	require.Nil(t, result.ReferrerBonus)
+
+	stats, err := testStore.GetReferralStats(context.Background(), referrer.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), stats.PendingCount)
+	require.Equal(t, int64(1), stats.CreditedCount)
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/rs/zerolog/log"
+)
+
+/*
+replicaRouter 实现了 DBTX，把只读的查询发给只读副本，其他所有语句都发给主库。
+
+判断方法很简单：sqlc 生成的 SQL 去掉 "-- name:" 注释之后，以 SELECT 开头且没有行锁（FOR UPDATE / FOR SHARE）的就是只读查询。
+事务不经过这里，execTx 总是在主库上开始事务，所以事务里的读也一定读主库。
+
+副本连接失败时，这次查询会改在主库上重试，并且这个副本在 replicaRetryInterval 之内不再使用，
+所有副本都不可用时就全部读主库。
+
+副本有复制延迟，刚写完马上要读到结果的地方用 WithPrimary(ctx) 强制读主库。
+*/
+const replicaRetryInterval = 5 * time.Second
+
+type primaryContextKey struct{}
+
+// WithPrimary returns a context that makes the store read from the primary database
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryContextKey{}, true)
+}
+
+func usePrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryContextKey{}).(bool)
+	return primary
+}
+
+type replica struct {
+	db        DBTX
+	downUntil atomic.Int64
+}
+
+func (r *replica) available(now time.Time) bool {
+	return now.UnixNano() >= r.downUntil.Load()
+}
+
+func (r *replica) markDown(now time.Time) {
+	r.downUntil.Store(now.Add(replicaRetryInterval).UnixNano())
+}
+
+type replicaRouter struct {
+	primary  DBTX
+	replicas []*replica
+	mutex    sync.Mutex
+	next     int
+}
+
+func newReplicaRouter(primary DBTX, replicas ...DBTX) *replicaRouter {
+	router := &replicaRouter{primary: primary}
+	for _, db := range replicas {
+		router.replicas = append(router.replicas, &replica{db: db})
+	}
+	return router
+}
+
+// pickReplica 按轮询选一个可用的副本，没有可用的副本时返回 nil
+func (router *replicaRouter) pickReplica(ctx context.Context, query string) *replica {
+	if len(router.replicas) == 0 || usePrimary(ctx) || !isReadOnlyQuery(query) {
+		return nil
+	}
+
+	router.mutex.Lock()
+	defer router.mutex.Unlock()
+
+	now := time.Now()
+	for i := 0; i < len(router.replicas); i++ {
+		r := router.replicas[(router.next+i)%len(router.replicas)]
+		if r.available(now) {
+			router.next = (router.next + i + 1) % len(router.replicas)
+			return r
+		}
+	}
+	return nil
+}
+
+func (router *replicaRouter) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	return router.primary.Exec(ctx, query, args...)
+}
+
+func (router *replicaRouter) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	r := router.pickReplica(ctx, query)
+	if r == nil {
+		return router.primary.Query(ctx, query, args...)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil && isConnectionError(ctx, err) {
+		log.Warn().Err(err).Msg("replica unavailable, falling back to primary")
+		r.markDown(time.Now())
+		return router.primary.Query(ctx, query, args...)
+	}
+	return rows, err
+}
+
+func (router *replicaRouter) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	r := router.pickReplica(ctx, query)
+	if r == nil {
+		return router.primary.QueryRow(ctx, query, args...)
+	}
+
+	return &fallbackRow{
+		row: r.db.QueryRow(ctx, query, args...),
+		fallback: func(err error) pgx.Row {
+			if !isConnectionError(ctx, err) {
+				return nil
+			}
+			log.Warn().Err(err).Msg("replica unavailable, falling back to primary")
+			r.markDown(time.Now())
+			return router.primary.QueryRow(ctx, query, args...)
+		},
+	}
+}
+
+// fallbackRow 的错误要到 Scan 时才知道，所以在 Scan 里决定是否改读主库
+type fallbackRow struct {
+	row      pgx.Row
+	fallback func(err error) pgx.Row
+}
+
+func (row *fallbackRow) Scan(dest ...any) error {
+	err := row.row.Scan(dest...)
+	if err == nil {
+		return nil
+	}
+
+	if primary := row.fallback(err); primary != nil {
+		return primary.Scan(dest...)
+	}
+	return err
+}
+
+func isReadOnlyQuery(query string) bool {
+	var statement strings.Builder
+	for _, line := range strings.Split(query, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		statement.WriteString(strings.ToUpper(line))
+		statement.WriteString(" ")
+	}
+
+	sql := statement.String()
+	if !strings.HasPrefix(sql, "SELECT ") {
+		return false
+	}
+	return !strings.Contains(sql, " FOR UPDATE") &&
+		!strings.Contains(sql, " FOR NO KEY UPDATE") &&
+		!strings.Contains(sql, " FOR SHARE") &&
+		!strings.Contains(sql, " FOR KEY SHARE")
+}
+
+// isConnectionError 判断错误是不是副本本身不可用（连不上、超时、副本拒绝执行），而不是查询本身的错误
+func isConnectionError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, pgx.ErrNoRows) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		// 副本上常见的 "canceling statement due to conflict with recovery"
+		return pgErr.Code == "40001" && strings.Contains(pgErr.Message, "recovery")
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		pgconn.Timeout(err) ||
+		pgconn.SafeToRetry(err)
+}
@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRow struct {
+	err error
+}
+
+func (row fakeRow) Scan(dest ...any) error {
+	return row.err
+}
+
+// fakeDBTX 只记录收到了多少次调用，不真正执行 SQL
+type fakeDBTX struct {
+	err   error
+	calls int
+}
+
+func (db *fakeDBTX) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
+	db.calls++
+	return pgconn.CommandTag{}, db.err
+}
+
+func (db *fakeDBTX) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
+	db.calls++
+	return nil, db.err
+}
+
+func (db *fakeDBTX) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
+	db.calls++
+	return fakeRow{err: db.err}
+}
+
+func TestIsReadOnlyQuery(t *testing.T) {
+	require.True(t, isReadOnlyQuery(getAccount))
+	require.True(t, isReadOnlyQuery(listAccounts))
+	require.False(t, isReadOnlyQuery(getAccountForUpdate))
+	require.False(t, isReadOnlyQuery(createAccount))
+	require.False(t, isReadOnlyQuery(addAccountBalance))
+}
+
+func TestReplicaRouter(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            context.Context
+		query          string
+		replicaErr     error
+		primaryCalls   int
+		replicaCalls   int
+		replicaHealthy bool
+	}{
+		{
+			name:           "ReadGoesToReplica",
+			ctx:            context.Background(),
+			query:          getAccount,
+			primaryCalls:   0,
+			replicaCalls:   1,
+			replicaHealthy: true,
+		},
+		{
+			name:           "WriteGoesToPrimary",
+			ctx:            context.Background(),
+			query:          addAccountBalance,
+			primaryCalls:   1,
+			replicaCalls:   0,
+			replicaHealthy: true,
+		},
+		{
+			name:           "LockingReadGoesToPrimary",
+			ctx:            context.Background(),
+			query:          getAccountForUpdate,
+			primaryCalls:   1,
+			replicaCalls:   0,
+			replicaHealthy: true,
+		},
+		{
+			name:           "WithPrimary",
+			ctx:            WithPrimary(context.Background()),
+			query:          getAccount,
+			primaryCalls:   1,
+			replicaCalls:   0,
+			replicaHealthy: true,
+		},
+		{
+			name:           "ReplicaDown",
+			ctx:            context.Background(),
+			query:          getAccount,
+			replicaErr:     &net.OpError{Op: "dial", Err: net.UnknownNetworkError("tcp")},
+			primaryCalls:   1,
+			replicaCalls:   1,
+			replicaHealthy: false,
+		},
+		{
+			name:           "NoRowsOnReplica",
+			ctx:            context.Background(),
+			query:          getAccount,
+			replicaErr:     pgx.ErrNoRows,
+			primaryCalls:   0,
+			replicaCalls:   1,
+			replicaHealthy: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			primary := &fakeDBTX{}
+			replica := &fakeDBTX{err: tc.replicaErr}
+			router := newReplicaRouter(primary, replica)
+
+			_ = router.QueryRow(tc.ctx, tc.query).Scan()
+			require.Equal(t, tc.primaryCalls, primary.calls)
+			require.Equal(t, tc.replicaCalls, replica.calls)
+			require.Equal(t, tc.replicaHealthy, router.replicas[0].available(time.Now()))
+		})
+	}
+}
+
+func TestReplicaRouterSkipsDownReplica(t *testing.T) {
+	primary := &fakeDBTX{}
+	replica := &fakeDBTX{err: &net.OpError{Op: "dial", Err: net.UnknownNetworkError("tcp")}}
+	router := newReplicaRouter(primary, replica)
+
+	_, err := router.Query(context.Background(), listAccounts)
+	require.NoError(t, err)
+	_, err = router.Query(context.Background(), listAccounts)
+	require.NoError(t, err)
+
+	// 第一次失败之后副本被标记为不可用，第二次直接读主库
+	require.Equal(t, 1, replica.calls)
+	require.Equal(t, 2, primary.calls)
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomSession(t *testing.T, username string, expiresAt time.Time) Session {
+	arg := CreateSessionParams{
+		ID:           uuid.New(),
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		IsBlocked:    false,
+		ExpiresAt:    expiresAt,
+		Country:      "AU",
+		City:         "Sydney",
+	}
+
+	session, err := testStore.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.Username, session.Username)
+	require.Equal(t, arg.Country, session.Country)
+	require.Equal(t, arg.City, session.City)
+	require.False(t, session.IsBlocked)
+
+	return session
+}
+
+func TestListUserSessions(t *testing.T) {
+	user := createRandomUser(t)
+	active := createRandomSession(t, user.Username, time.Now().Add(time.Hour))
+	createRandomSession(t, user.Username, time.Now().Add(-time.Hour))
+	blocked := createRandomSession(t, user.Username, time.Now().Add(time.Hour))
+
+	_, err := testStore.BlockSession(context.Background(), blocked.ID)
+	require.NoError(t, err)
+
+	// 过期的和被封禁的会话都不返回
+	sessions, err := testStore.ListUserSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, active.ID, sessions[0].ID)
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user.Username, time.Now().Add(time.Hour))
+
+	blocked, err := testStore.BlockSession(context.Background(), session.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	_, err = testStore.BlockSession(context.Background(), session.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
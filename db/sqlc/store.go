@@ -15,24 +15,86 @@ execTx 方法是 Store 的核心，它允许你在一个事务中安全地执行
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	BulkTransferTx(ctx context.Context, arg BulkTransferTxParams) (BulkTransferTxResult, error)
+	CollectPaymentTx(ctx context.Context, arg CollectPaymentTxParams) (CollectPaymentTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
+	CreateOAuthUserTx(ctx context.Context, arg CreateOAuthUserTxParams) (CreateOAuthUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error)
+	ChangePasswordTx(ctx context.Context, arg ChangePasswordTxParams) (ChangePasswordTxResult, error)
+	DeleteUserTx(ctx context.Context, arg DeleteUserTxParams) (DeleteUserTxResult, error)
+	ScheduleUserDeletionTx(ctx context.Context, arg ScheduleUserDeletionTxParams) (ScheduleUserDeletionTxResult, error)
+	AnonymizeUserTx(ctx context.Context, arg AnonymizeUserTxParams) (AnonymizeUserTxResult, error)
+	CreatePendingTransferTx(ctx context.Context, arg CreatePendingTransferTxParams) (CreatePendingTransferTxResult, error)
+	ConfirmPendingOperationTx(ctx context.Context, arg ConfirmPendingOperationTxParams) (ConfirmPendingOperationTxResult, error)
+	ReversePendingOperationTx(ctx context.Context, arg ReversePendingOperationTxParams) (ReversePendingOperationTxResult, error)
+	ReconcileBalancesTx(ctx context.Context, arg ReconcileBalancesTxParams) (ReconcileBalancesTxResult, error)
+	PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	CreateExternalTransferTx(ctx context.Context, arg CreateExternalTransferTxParams) (CreateExternalTransferTxResult, error)
+	AdvanceExternalTransferTx(ctx context.Context, arg AdvanceExternalTransferTxParams) (AdvanceExternalTransferTxResult, error)
+	AuthorizeCardTx(ctx context.Context, arg AuthorizeCardTxParams) (AuthorizeCardTxResult, error)
+	ApproveLoanTx(ctx context.Context, arg ApproveLoanTxParams) (ApproveLoanTxResult, error)
+	CollectLoanPaymentTx(ctx context.Context, arg CollectLoanPaymentTxParams) (CollectLoanPaymentTxResult, error)
+	RelayOutboxEventsTx(ctx context.Context, limit int32, publish func(events []OutboxEvent) error) (int, error)
+	TransferAccountOwnershipTx(ctx context.Context, arg TransferAccountOwnershipTxParams) (TransferAccountOwnershipTxResult, error)
+	SetDefaultAccountTx(ctx context.Context, arg SetDefaultAccountTxParams) (SetDefaultAccountTxResult, error)
+	FXTransferTx(ctx context.Context, arg FXTransferTxParams) (FXTransferTxResult, error)
+	CreditReferralBonusTx(ctx context.Context, arg CreditReferralBonusTxParams) (CreditReferralBonusTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	AddDisputeNoteTx(ctx context.Context, arg AddDisputeNoteTxParams) (AddDisputeNoteTxResult, error)
+	ResolveDisputeTx(ctx context.Context, arg ResolveDisputeTxParams) (ResolveDisputeTxResult, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
 // Store 是一个结构体，它嵌入了 Queries 结构体（这是由 sqlc 自动生成的，提供了一系列与数据库交互的方法）。
 // 它还包含了一个指向 sql.DB 的指针，sql.DB 是 Go 标准库中的一个结构体，用于表示数据库连接。
 type SQLStore struct {
-	connPool *pgxpool.Pool
+	connPool      *pgxpool.Pool
+	replicaPools  []*pgxpool.Pool
+	maxTxAttempts int
 	*Queries
 }
 
+// StoreOption 用来调整 NewStore 创建的 SQLStore
+type StoreOption func(store *SQLStore)
+
+// WithReplicas 传入只读副本的连接池，事务外的只读查询会发给副本（见 replicaRouter），事务和写操作仍然走主库。
+func WithReplicas(replicaPools ...*pgxpool.Pool) StoreOption {
+	return func(store *SQLStore) {
+		store.replicaPools = append(store.replicaPools, replicaPools...)
+	}
+}
+
+// WithMaxTxAttempts 设置事务遇到序列化失败或死锁时最多执行几次（包括第一次），小于 1 时不重试。
+func WithMaxTxAttempts(maxTxAttempts int) StoreOption {
+	return func(store *SQLStore) {
+		store.maxTxAttempts = maxTxAttempts
+	}
+}
+
 // NewStore 是一个函数，它创建并返回一个新的 Store 实例。
 // 它接受一个 *sql.DB（数据库连接）作为参数，并用这个连接初始化 Store 结构体中的 db 字段和 Queries 字段。
 // NewStore creates a new store
-func NewStore(connPool *pgxpool.Pool) Store {
-	return &SQLStore{
-		connPool: connPool,
-		Queries:  New(connPool),
+func NewStore(connPool *pgxpool.Pool, opts ...StoreOption) Store {
+	store := &SQLStore{
+		connPool:      connPool,
+		maxTxAttempts: defaultMaxTxAttempts,
+	}
+	for _, opt := range opts {
+		opt(store)
+	}
+
+	if len(store.replicaPools) == 0 {
+		store.Queries = New(connPool)
+		return store
+	}
+
+	replicas := make([]DBTX, len(store.replicaPools))
+	for i, pool := range store.replicaPools {
+		replicas[i] = pool
 	}
+	store.Queries = New(newReplicaRouter(connPool, replicas...))
+	return store
 }
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/util"
+)
+
+/*
+这些基准测试和其他的 db 测试一样需要真实的数据库：
+
+	go test ./db/sqlc -run '^$' -bench . -benchtime 10s
+
+除了每次操作的耗时，还会报告每次操作平均重试了几次（retries/op），
+用来比较不同的加锁顺序、隔离级别在并发时的死锁和序列化失败。
+更接近真实部署的压测用 bank loadtest 命令。
+*/
+
+// reportTxRetries 在基准测试结束时报告这段时间里事务重试的次数
+func reportTxRetries(b *testing.B, before map[string]int64) {
+	var retries int64
+	for code, n := range TxRetryStats() {
+		if code != "exhausted" {
+			retries += n - before[code]
+		}
+	}
+	b.ReportMetric(float64(retries)/float64(b.N), "retries/op")
+}
+
+// BenchmarkTransferTx 在一组账户之间随机并发转账，冲突比较少
+func BenchmarkTransferTx(b *testing.B) {
+	accounts := make([]Account, 20)
+	for i := range accounts {
+		accounts[i] = createRandomAccount(b)
+	}
+
+	before := TxRetryStats()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			i, j := rand.Intn(len(accounts)), rand.Intn(len(accounts)-1)
+			if j >= i {
+				j++
+			}
+			_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: accounts[i].ID,
+				ToAccountID:   accounts[j].ID,
+				Amount:        1,
+			})
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	b.StopTimer()
+	reportTxRetries(b, before)
+}
+
+// BenchmarkTransferTxContention 只在两个账户之间来回转账，和 TestTransferTxDeadlock 一样是最容易死锁的情况
+func BenchmarkTransferTxContention(b *testing.B) {
+	account1 := createRandomAccount(b)
+	account2 := createRandomAccount(b)
+
+	before := TxRetryStats()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		fromAccountID, toAccountID := account1.ID, account2.ID
+		if rand.Intn(2) == 1 {
+			fromAccountID, toAccountID = toAccountID, fromAccountID
+		}
+
+		for pb.Next() {
+			_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: fromAccountID,
+				ToAccountID:   toAccountID,
+				Amount:        1,
+			})
+			if err != nil {
+				b.Error(err)
+				return
+			}
+			fromAccountID, toAccountID = toAccountID, fromAccountID
+		}
+	})
+	b.StopTimer()
+	reportTxRetries(b, before)
+}
+
+func BenchmarkCreateUser(b *testing.B) {
+	hashedPassword, err := util.HashPassword(util.RandomString(6))
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			username := util.RandomString(12)
+			_, err := testStore.CreateUser(context.Background(), CreateUserParams{
+				Username:       username,
+				HashedPassword: hashedPassword,
+				FullName:       util.RandomOwner(),
+				Email:          username + "@email.com",
+				Locale:         i18n.DefaultLocale,
+			})
+			if err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
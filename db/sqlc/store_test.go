@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/faultinject"
+	"github.com/techschool/bank/util"
 )
 
 func TestTransferTx(t *testing.T) {
@@ -64,6 +66,7 @@ func TestTransferTx(t *testing.T) {
 		require.Equal(t, -amount, fromEntry.Amount)
 		require.NotZero(t, fromEntry.ID)
 		require.NotZero(t, fromEntry.CreatedAt)
+		require.Equal(t, transfer.ID, fromEntry.TransferID.Int64)
 
 		_, err = testStore.GetEntry(context.Background(), fromEntry.ID)
 		require.NoError(t, err)
@@ -74,6 +77,7 @@ func TestTransferTx(t *testing.T) {
 		require.Equal(t, amount, toEntry.Amount)
 		require.NotZero(t, toEntry.ID)
 		require.NotZero(t, toEntry.CreatedAt)
+		require.Equal(t, transfer.ID, toEntry.TransferID.Int64)
 
 		_, err = testStore.GetEntry(context.Background(), toEntry.ID)
 		require.NoError(t, err)
@@ -182,6 +186,58 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
 
+func TestTransferTxFeeDeadlock(t *testing.T) {
+	// 手续费账户的 ID 在两个账户中间：account2 -> account1 的转账按 ID 顺序锁了两个账户之后还要锁手续费账户，
+	// 同时从手续费账户转到 account2 的转账先锁手续费账户，再等 account2
+	account1 := createRandomAccount(t)
+	feeAccount := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	n := 10
+	amount := int64(10)
+	fee := int64(1)
+	errs := make(chan error)
+
+	for i := 0; i < n; i++ {
+		arg := TransferTxParams{
+			FromAccountID: account2.ID,
+			ToAccountID:   account1.ID,
+			Amount:        amount,
+			Fee:           fee,
+			FeeAccountID:  feeAccount.ID,
+		}
+		if i%2 == 1 {
+			arg = TransferTxParams{
+				FromAccountID: feeAccount.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount + fee,
+			}
+		}
+
+		go func() {
+			_, err := testStore.TransferTx(context.Background(), arg)
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+	}
+
+	// account2 转出的金额和手续费正好由手续费账户转回来
+	updatedAccount1, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	updatedFeeAccount, err := testStore.GetAccount(context.Background(), feeAccount.ID)
+	require.NoError(t, err)
+	updatedAccount2, err := testStore.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, account1.Balance+int64(n/2)*amount, updatedAccount1.Balance)
+	require.Equal(t, feeAccount.Balance-int64(n/2)*amount, updatedFeeAccount.Balance)
+	require.Equal(t, account2.Balance, updatedAccount2.Balance)
+}
+
 /**
 测试事务操作：
 store_test.go 包含了一系列测试用例，这些用例专门用于测试 Store 结构体中定义的方法，特别是那些涉及事务的操作。
@@ -195,3 +251,185 @@ store_test.go 包含了一系列测试用例，这些用例专门用于测试 St
 自动化验证：
 这些测试自动化地验证了数据库操作的正确性，确保了代码更改或数据库模式更新后，应用程序的核心功能仍然按预期工作。
 */
+
+func TestBulkTransferTx(t *testing.T) {
+	user := createRandomUser(t)
+	currency := util.RandomCurrency()
+	fromAccount, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  100,
+		Currency: currency,
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	result, err := testStore.BulkTransferTx(context.Background(), BulkTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Items: []BulkTransferItem{
+			{ToAccountID: account1.ID, Amount: 30},
+			{ToAccountID: account2.ID, Amount: 20},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	require.Equal(t, int64(50), result.Items[1].FromAccount.Balance)
+
+	// 原子模式下余额不足，整个批次回滚
+	_, err = testStore.BulkTransferTx(context.Background(), BulkTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Items: []BulkTransferItem{
+			{ToAccountID: account1.ID, Amount: 30},
+			{ToAccountID: account2.ID, Amount: 30},
+		},
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	updatedAccount, err := testStore.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(50), updatedAccount.Balance)
+
+	// BestEffort 模式下只有余额不足的那一笔失败
+	result, err = testStore.BulkTransferTx(context.Background(), BulkTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		Items: []BulkTransferItem{
+			{ToAccountID: account1.ID, Amount: 30},
+			{ToAccountID: account2.ID, Amount: 30},
+		},
+		BestEffort: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, result.Items[0].Err)
+	require.ErrorIs(t, result.Items[1].Err, ErrInsufficientBalance)
+
+	updatedAccount, err = testStore.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), updatedAccount.Balance)
+}
+
+// TestTransferTxInjectedFault 在事务中间注入错误，检查转账记录和分录都被回滚，余额没有变化
+func TestTransferTxInjectedFault(t *testing.T) {
+	t.Cleanup(faultinject.Reset)
+	faultinject.Enable()
+
+	for _, point := range []string{faultinject.AfterCreateTransfer, faultinject.BeforeCreateEntry} {
+		account1 := createRandomAccount(t)
+		account2 := createRandomAccount(t)
+
+		require.NoError(t, faultinject.Inject(point, faultinject.Fault{Times: 1}))
+		_, err := testStore.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        10,
+		})
+		require.ErrorIs(t, err, faultinject.ErrInjected)
+
+		transfers, err := testStore.CountAccountTransfers(context.Background(), account1.ID)
+		require.NoError(t, err)
+		require.Zero(t, transfers)
+
+		entries, err := testStore.CountAccountEntries(context.Background(), account1.ID)
+		require.NoError(t, err)
+		require.Zero(t, entries)
+
+		updatedAccount, err := testStore.GetAccount(context.Background(), account1.ID)
+		require.NoError(t, err)
+		require.Equal(t, account1.Balance, updatedAccount.Balance)
+	}
+}
+
+// TestTransferTxInjectedFaultTimes 检查 before_create_entry 每次转账只算一次，before_create_entry:2 正好让两次转账失败
+func TestTransferTxInjectedFaultTimes(t *testing.T) {
+	t.Cleanup(faultinject.Reset)
+	faultinject.Enable()
+
+	account1 := createAccountWithBalance(t, 100)
+	account2 := createRandomAccount(t)
+	require.NoError(t, faultinject.Inject(faultinject.BeforeCreateEntry, faultinject.Fault{Times: 2}))
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	}
+	for i := 0; i < 2; i++ {
+		_, err := testStore.TransferTx(context.Background(), arg)
+		require.ErrorIs(t, err, faultinject.ErrInjected)
+	}
+
+	result, err := testStore.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), result.FromAccount.Balance)
+}
+
+func TestTransferTxFee(t *testing.T) {
+	account1 := createAccountWithBalance(t, 100)
+	account2 := createAccountWithBalance(t, 0)
+	feeAccount := createAccountWithBalance(t, 0)
+
+	result, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        50,
+		Reference:     "INV-1",
+		Fee:           5,
+		FeeAccountID:  feeAccount.ID,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.FeeTransfer)
+	require.Equal(t, int64(5), result.FeeTransfer.Amount)
+	require.Equal(t, account1.ID, result.FeeTransfer.FromAccountID)
+	require.Equal(t, feeAccount.ID, result.FeeTransfer.ToAccountID)
+	require.Equal(t, result.Transfer.ID, result.FeeTransfer.FeeOf.Int64)
+	require.Equal(t, "INV-1", result.FeeTransfer.Reference)
+	require.Equal(t, int64(45), result.FromAccount.Balance)
+	require.Equal(t, int64(50), result.ToAccount.Balance)
+
+	updatedFeeAccount, err := testStore.GetAccount(context.Background(), feeAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), updatedFeeAccount.Balance)
+
+	// 没有手续费时不会多出一笔转账
+	result, err = testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        5,
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.FeeTransfer)
+}
+
+func TestVerifyEmailTx(t *testing.T) {
+	user := createRandomUser(t)
+
+	verifyEmail, err := testStore.CreateVerifyEmail(context.Background(), CreateVerifyEmailParams{
+		Username:   user.Username,
+		Email:      user.Email,
+		SecretCode: util.RandomString(32),
+	})
+	require.NoError(t, err)
+	require.True(t, verifyEmail.ExpiredAt.After(verifyEmail.CreatedAt))
+
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: util.RandomString(32),
+	})
+	require.ErrorIs(t, err, ErrInvalidSecretCode)
+
+	result, err := testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.NoError(t, err)
+	require.True(t, result.User.IsEmailVerified)
+	require.True(t, result.VerifyEmail.IsUsed)
+
+	// 验证码只能用一次
+	_, err = testStore.VerifyEmailTx(context.Background(), VerifyEmailTxParams{
+		EmailId:    verifyEmail.ID,
+		SecretCode: verifyEmail.SecretCode,
+	})
+	require.ErrorIs(t, err, ErrVerifyEmailUsed)
+}
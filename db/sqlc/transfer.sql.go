@@ -7,26 +7,81 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const backdateTransfer = `-- name: BackdateTransfer :exec
+WITH backdated_transfer AS (
+  UPDATE transfers
+  SET created_at = $1
+  WHERE id = $2
 )
+UPDATE entries
+SET created_at = $1
+WHERE transfer_id = $2
+`
+
+type BackdateTransferParams struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+}
+
+func (q *Queries) BackdateTransfer(ctx context.Context, arg BackdateTransferParams) error {
+	_, err := q.db.Exec(ctx, backdateTransfer, arg.CreatedAt, arg.ID)
+	return err
+}
+
+const countAccountTransfers = `-- name: CountAccountTransfers :one
+SELECT count(*) FROM transfers
+WHERE from_account_id = $1 OR to_account_id = $1
+`
+
+func (q *Queries) CountAccountTransfers(ctx context.Context, accountID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countAccountTransfers, accountID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
 
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  category,
+  memo,
+  reference,
+  reversal_of,
+  fee_of
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, from_account_id, to_account_id, amount, created_at, category, memo, reference, reversal_of, fee_of
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64       `json:"from_account_id"`
+	ToAccountID   int64       `json:"to_account_id"`
+	Amount        int64       `json:"amount"`
+	Category      string      `json:"category"`
+	Memo          string      `json:"memo"`
+	Reference     string      `json:"reference"`
+	ReversalOf    pgtype.Int8 `json:"reversal_of"`
+	FeeOf         pgtype.Int8 `json:"fee_of"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRow(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRow(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Category,
+		arg.Memo,
+		arg.Reference,
+		arg.ReversalOf,
+		arg.FeeOf,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +89,69 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Category,
+		&i.Memo,
+		&i.Reference,
+		&i.ReversalOf,
+		&i.FeeOf,
 	)
 	return i, err
 }
 
+const getSpendingSummary = `-- name: GetSpendingSummary :many
+SELECT
+  date_trunc('month', created_at)::timestamptz AS month,
+  category,
+  SUM(amount)::bigint AS total_amount,
+  COUNT(*) AS transfer_count
+FROM transfers
+WHERE from_account_id = $1
+  AND created_at >= $2
+  AND created_at < $3
+GROUP BY month, category
+ORDER BY month, category
+`
+
+type GetSpendingSummaryParams struct {
+	AccountID int64     `json:"account_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type GetSpendingSummaryRow struct {
+	Month         time.Time `json:"month"`
+	Category      string    `json:"category"`
+	TotalAmount   int64     `json:"total_amount"`
+	TransferCount int64     `json:"transfer_count"`
+}
+
+func (q *Queries) GetSpendingSummary(ctx context.Context, arg GetSpendingSummaryParams) ([]GetSpendingSummaryRow, error) {
+	rows, err := q.db.Query(ctx, getSpendingSummary, arg.AccountID, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpendingSummaryRow{}
+	for rows.Next() {
+		var i GetSpendingSummaryRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.Category,
+			&i.TotalAmount,
+			&i.TransferCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, category, memo, reference, reversal_of, fee_of FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,33 +164,113 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Category,
+		&i.Memo,
+		&i.Reference,
+		&i.ReversalOf,
+		&i.FeeOf,
 	)
 	return i, err
 }
 
+const getTransferReversal = `-- name: GetTransferReversal :one
+SELECT id, from_account_id, to_account_id, amount, created_at, category, memo, reference, reversal_of, fee_of FROM transfers
+WHERE reversal_of = $1::bigint LIMIT 1
+`
+
+func (q *Queries) GetTransferReversal(ctx context.Context, transferID int64) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferReversal, transferID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Category,
+		&i.Memo,
+		&i.Reference,
+		&i.ReversalOf,
+		&i.FeeOf,
+	)
+	return i, err
+}
+
+const listDailyTransferVolume = `-- name: ListDailyTransferVolume :many
+SELECT
+  (date_trunc('day', t.created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+  a.currency,
+  count(*) AS transfer_count,
+  COALESCE(SUM(t.amount), 0)::bigint AS total_amount
+FROM transfers t
+JOIN accounts a ON a.id = t.from_account_id
+WHERE t.created_at >= $1
+  AND t.created_at < $2
+  AND t.fee_of IS NULL
+GROUP BY day, a.currency
+ORDER BY day, a.currency
+`
+
+type ListDailyTransferVolumeParams struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type ListDailyTransferVolumeRow struct {
+	Day           time.Time `json:"day"`
+	Currency      string    `json:"currency"`
+	TransferCount int64     `json:"transfer_count"`
+	TotalAmount   int64     `json:"total_amount"`
+}
+
+func (q *Queries) ListDailyTransferVolume(ctx context.Context, arg ListDailyTransferVolumeParams) ([]ListDailyTransferVolumeRow, error) {
+	rows, err := q.db.Query(ctx, listDailyTransferVolume, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDailyTransferVolumeRow{}
+	for rows.Next() {
+		var i ListDailyTransferVolumeRow
+		if err := rows.Scan(
+			&i.Day,
+			&i.Currency,
+			&i.TransferCount,
+			&i.TotalAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
-WHERE 
-    from_account_id = $1 OR
-    to_account_id = $2
+SELECT id, from_account_id, to_account_id, amount, created_at, category, memo, reference, reversal_of, fee_of FROM transfers
+WHERE
+    (from_account_id = $1 OR
+    to_account_id = $2)
+    AND id > $3
 ORDER BY id
-LIMIT $3
-OFFSET $4
+LIMIT $4
 `
 
 type ListTransfersParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
+	AfterID       int64 `json:"after_id"`
 	Limit         int32 `json:"limit"`
-	Offset        int32 `json:"offset"`
 }
 
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
 	rows, err := q.db.Query(ctx, listTransfers,
 		arg.FromAccountID,
 		arg.ToAccountID,
+		arg.AfterID,
 		arg.Limit,
-		arg.Offset,
 	)
 	if err != nil {
 		return nil, err
@@ -93,6 +285,11 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Category,
+			&i.Memo,
+			&i.Reference,
+			&i.ReversalOf,
+			&i.FeeOf,
 		); err != nil {
 			return nil, err
 		}
@@ -103,3 +300,36 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const updateTransferMemo = `-- name: UpdateTransferMemo :one
+UPDATE transfers
+SET
+  memo = COALESCE($1, memo),
+  category = COALESCE($2, category)
+WHERE id = $3
+RETURNING id, from_account_id, to_account_id, amount, created_at, category, memo, reference, reversal_of, fee_of
+`
+
+type UpdateTransferMemoParams struct {
+	Memo     pgtype.Text `json:"memo"`
+	Category pgtype.Text `json:"category"`
+	ID       int64       `json:"id"`
+}
+
+func (q *Queries) UpdateTransferMemo(ctx context.Context, arg UpdateTransferMemoParams) (Transfer, error) {
+	row := q.db.QueryRow(ctx, updateTransferMemo, arg.Memo, arg.Category, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.Category,
+		&i.Memo,
+		&i.Reference,
+		&i.ReversalOf,
+		&i.FeeOf,
+	)
+	return i, err
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func createRandomTransferQuote(t *testing.T, username string, expiresAt time.Time) TransferQuote {
+	arg := CreateTransferQuoteParams{
+		Username:     username,
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		FromAmount:   10000,
+		Fee:          100,
+		ToAmount:     9108,
+		Rate:         "0.92",
+		ExpiresAt:    expiresAt,
+	}
+
+	quote, err := testStore.CreateTransferQuote(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, quote.ID)
+	require.Equal(t, arg.Username, quote.Username)
+	require.Equal(t, arg.FromAmount, quote.FromAmount)
+	require.Equal(t, arg.ToAmount, quote.ToAmount)
+	require.Equal(t, arg.Rate, quote.Rate)
+	require.WithinDuration(t, arg.ExpiresAt, quote.ExpiresAt, time.Second)
+	require.False(t, quote.UsedAt.Valid)
+
+	return quote
+}
+
+func TestGetTransferQuote(t *testing.T) {
+	user := createRandomUser(t)
+	quote1 := createRandomTransferQuote(t, user.Username, time.Now().Add(time.Minute))
+
+	quote2, err := testStore.GetTransferQuote(context.Background(), quote1.ID)
+	require.NoError(t, err)
+	require.Equal(t, quote1.ID, quote2.ID)
+	require.Equal(t, quote1.Fee, quote2.Fee)
+}
+
+func TestFXTransferTx(t *testing.T) {
+	fromAccount := createRandomAccount(t)
+	toAccount := createRandomAccount(t)
+	fromFXAccount := createRandomAccount(t)
+	toFXAccount := createRandomAccount(t)
+
+	quote := createRandomTransferQuote(t, fromAccount.Owner, time.Now().Add(time.Minute))
+	arg := FXTransferTxParams{
+		QuoteID:         quote.ID,
+		Username:        fromAccount.Owner,
+		FromAccountID:   fromAccount.ID,
+		ToAccountID:     toAccount.ID,
+		FromFXAccountID: fromFXAccount.ID,
+		ToFXAccountID:   toFXAccount.ID,
+		Memo:            "fx",
+	}
+
+	result, err := testStore.FXTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.Quote.UsedAt.Valid)
+	require.Equal(t, result.Debit.Transfer.ID, result.Quote.DebitTransferID.Int64)
+	require.Equal(t, result.Credit.Transfer.ID, result.Quote.CreditTransferID.Int64)
+
+	require.Equal(t, fromAccount.Balance-quote.FromAmount, result.Debit.FromAccount.Balance)
+	require.Equal(t, fromFXAccount.Balance+quote.FromAmount, result.Debit.ToAccount.Balance)
+	require.Equal(t, toFXAccount.Balance-quote.ToAmount, result.Credit.FromAccount.Balance)
+	require.Equal(t, toAccount.Balance+quote.ToAmount, result.Credit.ToAccount.Balance)
+	require.Equal(t, "fx", result.Credit.Transfer.Memo)
+
+	// 报价只能用一次
+	_, err = testStore.FXTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrQuoteUsed)
+
+	// 别人的报价和不存在的报价一样
+	otherQuote := createRandomTransferQuote(t, toAccount.Owner, time.Now().Add(time.Minute))
+	arg.QuoteID = otherQuote.ID
+	_, err = testStore.FXTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	expiredQuote := createRandomTransferQuote(t, fromAccount.Owner, time.Now().Add(-time.Second))
+	arg.QuoteID = expiredQuote.ID
+	_, err = testStore.FXTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrQuoteExpired)
+
+	// 失败的转账不会改变余额
+	account, err := testStore.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Debit.FromAccount.Balance, account.Balance)
+}
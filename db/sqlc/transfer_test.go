@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"github.com/techschool/bank/util"
 )
@@ -64,8 +65,8 @@ func TestListTransfer(t *testing.T) {
 	arg := ListTransfersParams{
 		FromAccountID: account1.ID,
 		ToAccountID:   account1.ID,
+		AfterID:       0,
 		Limit:         5,
-		Offset:        5,
 	}
 
 	transfers, err := testStore.ListTransfers(context.Background(), arg)
@@ -76,4 +77,88 @@ func TestListTransfer(t *testing.T) {
 		require.NotEmpty(t, transfer)
 		require.True(t, transfer.FromAccountID == account1.ID || transfer.ToAccountID == account1.ID)
 	}
+
+	count, err := testStore.CountAccountTransfers(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), count)
+}
+
+func TestUpdateTransferMemo(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	transfer1 := createRandomTransfer(t, account1, account2)
+
+	arg := UpdateTransferMemoParams{
+		ID:       transfer1.ID,
+		Memo:     pgtype.Text{String: "dinner", Valid: true},
+		Category: pgtype.Text{String: util.CategoryEntertainment, Valid: true},
+	}
+
+	transfer2, err := testStore.UpdateTransferMemo(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, transfer1.ID, transfer2.ID)
+	require.Equal(t, "dinner", transfer2.Memo)
+	require.Equal(t, util.CategoryEntertainment, transfer2.Category)
+
+	// 没有传的字段保持不变
+	transfer3, err := testStore.UpdateTransferMemo(context.Background(), UpdateTransferMemoParams{
+		ID:   transfer1.ID,
+		Memo: pgtype.Text{String: "team dinner", Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "team dinner", transfer3.Memo)
+	require.Equal(t, util.CategoryEntertainment, transfer3.Category)
+}
+
+func TestGetSpendingSummary(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	var total int64
+	for i := 0; i < 3; i++ {
+		transfer, err := testStore.CreateTransfer(context.Background(), CreateTransferParams{
+			FromAccountID: account1.ID,
+			ToAccountID:   account2.ID,
+			Amount:        util.RandomInt(1, 100),
+			Category:      util.CategoryGroceries,
+		})
+		require.NoError(t, err)
+		total += transfer.Amount
+	}
+	createRandomTransfer(t, account2, account1)
+
+	now := time.Now()
+	rows, err := testStore.GetSpendingSummary(context.Background(), GetSpendingSummaryParams{
+		AccountID: account1.ID,
+		StartTime: now.Add(-time.Hour),
+		EndTime:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	require.Equal(t, util.CategoryGroceries, rows[0].Category)
+	require.Equal(t, total, rows[0].TotalAmount)
+	require.Equal(t, int64(3), rows[0].TransferCount)
+}
+
+func TestListDailyTransferVolume(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+	first := createRandomTransfer(t, account1, account2)
+	last := createRandomTransfer(t, account1, account2)
+
+	volumes, err := testStore.ListDailyTransferVolume(context.Background(), ListDailyTransferVolumeParams{
+		StartTime: first.CreatedAt,
+		EndTime:   last.CreatedAt.Add(time.Microsecond),
+	})
+	require.NoError(t, err)
+
+	var transferCount, totalAmount int64
+	for _, volume := range volumes {
+		require.Equal(t, account1.Currency, volume.Currency)
+		require.Equal(t, volume.Day.UTC().Truncate(24*time.Hour), volume.Day.UTC())
+		transferCount += volume.TransferCount
+		totalAmount += volume.TotalAmount
+	}
+	require.Equal(t, int64(2), transferCount)
+	require.Equal(t, first.Amount+last.Amount, totalAmount)
 }
@@ -0,0 +1,54 @@
+package db
+
+import "context"
+
+/*
+AnonymizeUserTx 在保留期过后清除已删除用户的个人信息（PII）。
+username 是 accounts、sessions 等表的外键，所以保留下来；
+姓名、邮箱和密码会被覆盖，手机号、出生日期、地址和头像会被清空（头像文件由匿名化任务删除），验证邮件记录、通知偏好（包含手机号）、session、密码历史和登录过的设备（包含 IP、User-Agent）会被直接删除。
+只有已经软删除的用户才会被匿名化，否则返回 ErrRecordNotFound。
+*/
+type AnonymizeUserTxParams struct {
+	Username string
+}
+
+type AnonymizeUserTxResult struct {
+	User User
+}
+
+func (store *SQLStore) AnonymizeUserTx(ctx context.Context, arg AnonymizeUserTxParams) (AnonymizeUserTxResult, error) {
+	var result AnonymizeUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.AnonymizeUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteUserVerifyEmails(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteNotificationPreferences(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteUserSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteUserPasswordHistory(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return q.DeleteUserDevices(ctx, arg.Username)
+	})
+
+	return result, err
+}
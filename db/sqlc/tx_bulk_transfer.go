@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+BulkTransferTx 从同一个转出账户向多个账户转账，适合发工资这类批量付款的场景。
+
+有两种模式：
+  - 默认是原子模式：所有转账在同一个事务里执行，只要有一笔失败（包括余额不足），整个批次都会回滚；
+  - BestEffort 模式：每一笔转账各自使用一个事务，失败的那一笔会记录在对应的 Err 里，不影响其他转账。
+
+和单笔的 TransferTx 不同，批量转账会在每一笔之后检查转出账户的余额，不允许变成负数。
+*/
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+type BulkTransferItem struct {
+	ToAccountID int64  `json:"to_account_id"`
+	Amount      int64  `json:"amount"`
+	Category    string `json:"category"`
+	Memo        string `json:"memo"`
+	Reference   string `json:"reference"`
+}
+
+type BulkTransferTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	Items         []BulkTransferItem `json:"items"`
+	BestEffort    bool               `json:"best_effort"`
+}
+
+type BulkTransferItemResult struct {
+	TransferTxResult
+	Err error `json:"-"`
+}
+
+type BulkTransferTxResult struct {
+	Items []BulkTransferItemResult `json:"items"`
+}
+
+func (store *SQLStore) BulkTransferTx(ctx context.Context, arg BulkTransferTxParams) (BulkTransferTxResult, error) {
+	result := BulkTransferTxResult{
+		Items: make([]BulkTransferItemResult, len(arg.Items)),
+	}
+
+	if arg.BestEffort {
+		for i, item := range arg.Items {
+			err := store.execTx(ctx, func(q *Queries) error {
+				var err error
+				result.Items[i].TransferTxResult, err = transferWithBalanceCheck(ctx, q, arg.FromAccountID, item)
+				return err
+			})
+			if err != nil {
+				result.Items[i] = BulkTransferItemResult{Err: err}
+			}
+		}
+		return result, nil
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		for i, item := range arg.Items {
+			var err error
+			result.Items[i].TransferTxResult, err = transferWithBalanceCheck(ctx, q, arg.FromAccountID, item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return BulkTransferTxResult{}, err
+	}
+
+	return result, nil
+}
+
+func transferWithBalanceCheck(ctx context.Context, q *Queries, fromAccountID int64, item BulkTransferItem) (TransferTxResult, error) {
+	result, err := transfer(ctx, q, TransferTxParams{
+		FromAccountID: fromAccountID,
+		ToAccountID:   item.ToAccountID,
+		Amount:        item.Amount,
+		Category:      item.Category,
+		Memo:          item.Memo,
+		Reference:     item.Reference,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if err := checkAvailableBalance(ctx, q, result.FromAccount); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+AuthorizeCardTx 处理卡组织发来的授权请求：卡必须是 active 状态、没有过期，
+并且交易币种和卡绑定的账户一致，然后在绑定的账户上冻结（hold）交易金额。
+之后商户结算时再 CaptureHoldTx，交易取消时 ReleaseHoldTx。
+
+先用 FOR NO KEY UPDATE 锁住卡，这样和 FreezeCard 并发执行时，冻结之后不会再有新的授权成功。
+*/
+var (
+	ErrCardFrozen           = errors.New("card is frozen")
+	ErrCardExpired          = errors.New("card has expired")
+	ErrCardCurrencyMismatch = errors.New("transaction currency doesn't match the card account")
+)
+
+type AuthorizeCardTxParams struct {
+	CardID   int64  `json:"card_id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Merchant string `json:"merchant"`
+}
+
+type AuthorizeCardTxResult struct {
+	Card Card `json:"card"`
+	PlaceHoldTxResult
+}
+
+func (store *SQLStore) AuthorizeCardTx(ctx context.Context, arg AuthorizeCardTxParams) (AuthorizeCardTxResult, error) {
+	var result AuthorizeCardTxResult
+
+	if arg.Amount <= 0 {
+		return result, ErrInvalidHoldAmount
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Card, err = q.GetCardForUpdate(ctx, arg.CardID)
+		if err != nil {
+			return err
+		}
+
+		if result.Card.Status != util.CardActive {
+			return ErrCardFrozen
+		}
+
+		if util.IsCardExpired(result.Card.ExpiryMonth, result.Card.ExpiryYear, time.Now()) {
+			return ErrCardExpired
+		}
+
+		account, err := q.GetAccount(ctx, result.Card.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Currency != arg.Currency {
+			return ErrCardCurrencyMismatch
+		}
+
+		result.PlaceHoldTxResult, err = placeHold(ctx, q, PlaceHoldTxParams{
+			AccountID:   result.Card.AccountID,
+			Amount:      arg.Amount,
+			Description: fmt.Sprintf("card %s at %s", result.Card.Last4, arg.Merchant),
+			CardID:      pgtype.Int8{Int64: result.Card.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
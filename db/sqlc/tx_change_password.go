@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+ChangePasswordTx 在同一个事务中修改用户的密码，并记录密码历史：
+1. 锁住用户记录，防止两个修改密码的请求同时检查历史；
+2. HistorySize 大于 0 时，新密码不能和当前密码以及最近 HistorySize-1 个旧密码相同（哈希是加盐的，只能用 IsReused 逐个比较明文），重复的话返回 ErrPasswordReused，整个事务回滚；
+3. 更新用户记录（UpdateUserParams 里要设置 HashedPassword），把被替换的哈希写进 password_history；
+4. 只保留最近 HistorySize-1 条历史，HistorySize 调小或者设为 0 之后多余的旧哈希会在下次修改密码时删掉。
+*/
+var ErrPasswordReused = errors.New("password was used recently")
+
+type ChangePasswordTxParams struct {
+	UpdateUserParams
+	HistorySize int32
+	IsReused    func(hashedPassword string) bool
+}
+
+type ChangePasswordTxResult struct {
+	User User
+}
+
+func (store *SQLStore) ChangePasswordTx(ctx context.Context, arg ChangePasswordTxParams) (ChangePasswordTxResult, error) {
+	var result ChangePasswordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		user, err := q.GetUserForUpdate(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		keep := max(arg.HistorySize-1, 0)
+		if arg.HistorySize > 0 {
+			previous, err := q.ListPasswordHistory(ctx, ListPasswordHistoryParams{
+				Username: arg.Username,
+				Limit:    keep,
+			})
+			if err != nil {
+				return err
+			}
+
+			hashes := []string{user.HashedPassword}
+			for _, history := range previous {
+				hashes = append(hashes, history.HashedPassword)
+			}
+			for _, hashedPassword := range hashes {
+				if arg.IsReused(hashedPassword) {
+					return ErrPasswordReused
+				}
+			}
+		}
+
+		result.User, err = q.UpdateUser(ctx, arg.UpdateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if keep > 0 {
+			_, err = q.CreatePasswordHistory(ctx, CreatePasswordHistoryParams{
+				Username:       user.Username,
+				HashedPassword: user.HashedPassword,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return q.TrimPasswordHistory(ctx, TrimPasswordHistoryParams{
+			Username: user.Username,
+			Keep:     keep,
+		})
+	})
+
+	return result, err
+}
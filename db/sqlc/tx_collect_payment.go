@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+CollectPaymentTx 让收款方根据付款方授权的 mandate（直接扣款授权）从付款方账户扣款。
+在同一个事务里：
+1. 用 FOR NO KEY UPDATE 锁住 mandate，避免并发扣款超过授权额度；
+2. 检查 mandate 没有被撤销，并且累计扣款金额不会超过授权的 max_amount；
+3. 执行和 TransferTx 相同的转账步骤，并确认付款方的可用余额（扣除 hold 之后）不会变成负数；
+4. 累加 mandate 已经扣款的金额。
+*/
+var (
+	ErrMandateRevoked       = errors.New("mandate has been revoked")
+	ErrMandateLimitExceeded = errors.New("amount exceeds the authorized limit of the mandate")
+)
+
+type CollectPaymentTxParams struct {
+	MandateID int64 `json:"mandate_id"`
+	Amount    int64 `json:"amount"`
+}
+
+type CollectPaymentTxResult struct {
+	Mandate Mandate `json:"mandate"`
+	TransferTxResult
+}
+
+func (store *SQLStore) CollectPaymentTx(ctx context.Context, arg CollectPaymentTxParams) (CollectPaymentTxResult, error) {
+	var result CollectPaymentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		mandate, err := q.GetMandateForUpdate(ctx, arg.MandateID)
+		if err != nil {
+			return err
+		}
+
+		if mandate.IsRevoked {
+			return ErrMandateRevoked
+		}
+
+		if mandate.CollectedAmount+arg.Amount > mandate.MaxAmount {
+			return ErrMandateLimitExceeded
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: mandate.PayerAccountID,
+			ToAccountID:   mandate.PayeeAccountID,
+			Amount:        arg.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := checkAvailableBalance(ctx, q, result.FromAccount); err != nil {
+			return err
+		}
+
+		result.Mandate, err = q.AddMandateCollectedAmount(ctx, AddMandateCollectedAmountParams{
+			ID:     mandate.ID,
+			Amount: arg.Amount,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+/*
+CreateOAuthUserTx 在第一次用第三方账号登录时自动创建用户：
+同一个事务里创建用户、记录第三方身份（identities 表），
+如果提供方已经验证过邮箱，就直接把用户的邮箱标记为已验证，不用再发验证邮件。
+*/
+type CreateOAuthUserTxParams struct {
+	CreateUserParams
+	Provider        string
+	Subject         string
+	IsEmailVerified bool
+}
+
+type CreateOAuthUserTxResult struct {
+	User     User
+	Identity Identity
+}
+
+func (store *SQLStore) CreateOAuthUserTx(ctx context.Context, arg CreateOAuthUserTxParams) (CreateOAuthUserTxResult, error) {
+	var result CreateOAuthUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.CreateUser(ctx, arg.CreateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if err := insertUserCreatedEvent(ctx, q, result.User); err != nil {
+			return err
+		}
+
+		if arg.IsEmailVerified {
+			result.User, err = q.UpdateUser(ctx, UpdateUserParams{
+				Username:        result.User.Username,
+				IsEmailVerified: pgtype.Bool{Bool: true, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := insertEmailVerifiedEvent(ctx, q, result.User); err != nil {
+				return err
+			}
+		}
+
+		result.Identity, err = q.CreateIdentity(ctx, CreateIdentityParams{
+			Username: result.User.Username,
+			Provider: arg.Provider,
+			Subject:  arg.Subject,
+			Email:    arg.Email,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -2,7 +2,8 @@ package db
 
 import (
 	"context"
-	"log"
+
+	"github.com/rs/zerolog/log"
 )
 
 /*
@@ -16,6 +17,9 @@ import (
 */
 type CreateUserTxParams struct {
 	CreateUserParams
+	// Referrer 是注册时填的推荐码的主人，不为空时在同一个事务里记录一条 pending 的推荐（见 CreditReferralBonusTx）
+	Referrer    string
+	SignupIP    string
 	AfterCreate func(user User) error
 }
 
@@ -24,7 +28,6 @@ type CreateUserTxResult struct {
 }
 
 func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error) {
-	log.Println("Start CreateUserTx")
 	var result CreateUserTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
@@ -32,10 +35,25 @@ func (store *SQLStore) CreateUserTx(ctx context.Context, arg CreateUserTxParams)
 
 		result.User, err = q.CreateUser(ctx, arg.CreateUserParams)
 		if err != nil {
-			log.Printf("CreateUser error: %v", err)
+			log.Ctx(ctx).Debug().Err(err).Str("username", arg.Username).Msg("failed to create user")
+			return err
+		}
+
+		if err := insertUserCreatedEvent(ctx, q, result.User); err != nil {
 			return err
 		}
 
+		if arg.Referrer != "" {
+			_, err = q.CreateReferral(ctx, CreateReferralParams{
+				Referrer: arg.Referrer,
+				Referred: result.User.Username,
+				SignupIp: arg.SignupIP,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
 		//在事务完成后，还能够安全地发送异步任务到Redis队列中，如发送验证邮件的任务
 		//当CreateUserTx函数在数据库事务中成功创建了用户之后，它会调用AfterCreate
 		//具体这个异步体现在gapi文件夹下的rpc create user.go里面的CreateUser(ctx context.Context, req *pb.CreateUserRequest)
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+DeleteUserTx 实现用户的软删除：并不会真的删除 users 表里的记录，而是设置 deleted_at。
+在同一个事务里：
+1. 把用户标记为已删除（已经删除过的用户会返回 ErrRecordNotFound）；
+2. 检查用户名下是否还有余额不为 0 的账户，如果有就拒绝删除，整个事务回滚；
+3. 关闭（软删除）用户的所有账户，并让所有 session 失效，这样用户就无法再登录或者刷新 token；
+4. 调用 AfterDelete 回调，用来安排之后的数据匿名化任务。
+*/
+var ErrAccountBalanceNotZero = errors.New("user still has accounts with non-zero balance")
+
+type DeleteUserTxParams struct {
+	Username    string
+	AfterDelete func(user User) error
+}
+
+type DeleteUserTxResult struct {
+	User User
+}
+
+func (store *SQLStore) DeleteUserTx(ctx context.Context, arg DeleteUserTxParams) (DeleteUserTxResult, error) {
+	var result DeleteUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.SoftDeleteUser(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		count, err := q.CountAccountsWithBalance(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrAccountBalanceNotZero
+		}
+
+		err = q.CloseUserAccounts(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		err = q.BlockUserSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterDelete(result.User)
+	})
+
+	return result, err
+}
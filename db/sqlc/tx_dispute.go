@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+用户对一笔转账有异议时打开一个争议（dispute），状态机是：
+open -> under_review -> refunded 或 rejected，open 也可以直接变成 refunded 或 rejected。
+
+ 1. banker 第一次给 open 的争议添加备注时，争议变成 under_review；
+ 2. banker 处理争议时选择退款（refunded）或者拒绝（rejected），退款和 ReverseTransferTx 一样做一笔冲正转账，
+    冲正转账记录在 refund_transfer_id 里；
+ 3. refunded 和 rejected 是最终状态，不能再添加备注或者改变状态。
+
+每次状态变化都先用 FOR NO KEY UPDATE 锁住争议，两个 banker 同时处理同一个争议时只有一个能成功，
+不允许的状态变化返回 ErrInvalidDisputeTransition。
+*/
+var (
+	ErrInvalidDisputeTransition = errors.New("invalid dispute state transition")
+	ErrDisputeResolved          = errors.New("dispute has already been resolved")
+)
+
+// disputeTransitions 是每个状态可以变成的下一个状态
+var disputeTransitions = map[string][]string{
+	util.DisputeOpen:        {util.DisputeUnderReview, util.DisputeRefunded, util.DisputeRejected},
+	util.DisputeUnderReview: {util.DisputeRefunded, util.DisputeRejected},
+}
+
+type AddDisputeNoteTxParams struct {
+	DisputeID int64
+	Author    string
+	Note      string
+}
+
+type AddDisputeNoteTxResult struct {
+	Dispute Dispute
+	Note    DisputeNote
+	// StatusChanged 为 true 表示争议因为这条备注从 open 变成了 under_review
+	StatusChanged bool
+}
+
+func (store *SQLStore) AddDisputeNoteTx(ctx context.Context, arg AddDisputeNoteTxParams) (AddDisputeNoteTxResult, error) {
+	var result AddDisputeNoteTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.StatusChanged = false
+
+		var err error
+		result.Dispute, err = q.GetDisputeForUpdate(ctx, arg.DisputeID)
+		if err != nil {
+			return err
+		}
+		if util.IsDisputeResolved(result.Dispute.Status) {
+			return ErrDisputeResolved
+		}
+
+		if result.Dispute.Status == util.DisputeOpen {
+			result.Dispute, err = q.UpdateDisputeStatus(ctx, UpdateDisputeStatusParams{
+				ID:     result.Dispute.ID,
+				Status: util.DisputeUnderReview,
+			})
+			if err != nil {
+				return err
+			}
+			result.StatusChanged = true
+		}
+
+		result.Note, err = q.CreateDisputeNote(ctx, CreateDisputeNoteParams{
+			DisputeID: result.Dispute.ID,
+			Author:    arg.Author,
+			Note:      arg.Note,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// ResolveDisputeTxParams 的 Status 是 refunded 或 rejected
+type ResolveDisputeTxParams struct {
+	DisputeID  int64
+	Status     string
+	Resolution string
+	// Actor 是处理争议的 banker，ClientIp、Country、City 是他的请求来源
+	Actor    string
+	ClientIp string
+	Country  string
+	City     string
+}
+
+type ResolveDisputeTxResult struct {
+	Dispute Dispute
+	// Refund 只有退款时才有
+	Refund   *ReverseTransferTxResult
+	AuditLog AuditLog
+}
+
+// DisputeResolvedDetails is stored in the details of the audit log
+type DisputeResolvedDetails struct {
+	TransferID       int64  `json:"transfer_id"`
+	Status           string `json:"status"`
+	Resolution       string `json:"resolution"`
+	RefundTransferID int64  `json:"refund_transfer_id,omitempty"`
+}
+
+func (store *SQLStore) ResolveDisputeTx(ctx context.Context, arg ResolveDisputeTxParams) (ResolveDisputeTxResult, error) {
+	var result ResolveDisputeTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.Refund = nil
+
+		dispute, err := q.GetDisputeForUpdate(ctx, arg.DisputeID)
+		if err != nil {
+			return err
+		}
+
+		if !util.IsDisputeResolved(arg.Status) || !canTransitionDispute(dispute.Status, arg.Status) {
+			return fmt.Errorf("%w: %s -> %s", ErrInvalidDisputeTransition, dispute.Status, arg.Status)
+		}
+
+		update := UpdateDisputeStatusParams{
+			ID:         dispute.ID,
+			Status:     arg.Status,
+			Resolution: pgtype.Text{String: arg.Resolution, Valid: true},
+			ResolvedBy: pgtype.Text{String: arg.Actor, Valid: true},
+			ResolvedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		}
+
+		details := DisputeResolvedDetails{
+			TransferID: dispute.TransferID,
+			Status:     arg.Status,
+			Resolution: arg.Resolution,
+		}
+
+		if arg.Status == util.DisputeRefunded {
+			refund, err := reverseTransfer(ctx, q, ReverseTransferTxParams{
+				TransferID: dispute.TransferID,
+				Reason:     fmt.Sprintf("dispute #%d: %s", dispute.ID, arg.Resolution),
+				Actor:      arg.Actor,
+				ClientIp:   arg.ClientIp,
+				Country:    arg.Country,
+				City:       arg.City,
+			})
+			if err != nil {
+				return err
+			}
+			result.Refund = &refund
+			update.RefundTransferID = pgtype.Int8{Int64: refund.Reversal.Transfer.ID, Valid: true}
+			details.RefundTransferID = refund.Reversal.Transfer.ID
+		}
+
+		result.Dispute, err = q.UpdateDisputeStatus(ctx, update)
+		if err != nil {
+			return err
+		}
+
+		detailsJSON, err := json.Marshal(details)
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit log details: %w", err)
+		}
+
+		result.AuditLog, err = q.CreateAuditLog(ctx, CreateAuditLogParams{
+			Actor:        arg.Actor,
+			Action:       util.AuditDisputeResolved,
+			ResourceType: util.AuditResourceDispute,
+			ResourceID:   strconv.FormatInt(dispute.ID, 10),
+			Details:      detailsJSON,
+			ClientIp:     arg.ClientIp,
+			Country:      arg.Country,
+			City:         arg.City,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+func canTransitionDispute(from string, to string) bool {
+	for _, next := range disputeTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+外部转账（ACH/SEPA 这类跨行转账）不是立即完成的，状态机是：
+initiated -> submitted -> settled，initiated 或 submitted 也可以变成 returned。
+
+1. CreateExternalTransferTx 创建 initiated 状态的外部转账，并在同一个事务里冻结（hold）转出金额，这样钱还在账户里，但不能再被转走；
+2. 提交给清算网络后变成 submitted；
+3. 清算完成（settled）时把 hold 扣款到这个币种的清算账户（clearing account），钱才真正离开账户；
+4. 被对方银行退回（returned）时释放 hold，冻结的钱重新变成可用余额。
+
+提现手续费（WITHDRAWAL_FEES）在创建外部转账的时候就从账户转到收入账户，被退回时不退。
+
+每次状态变化都先用 FOR NO KEY UPDATE 锁住外部转账，不允许的状态变化返回 ErrInvalidExternalTransferTransition，
+所以重复执行的任务不会重复扣款或者重复释放。
+*/
+var ErrInvalidExternalTransferTransition = errors.New("invalid external transfer state transition")
+
+// externalTransferTransitions 是每个状态可以变成的下一个状态
+var externalTransferTransitions = map[string][]string{
+	util.ExternalTransferInitiated: {util.ExternalTransferSubmitted, util.ExternalTransferReturned},
+	util.ExternalTransferSubmitted: {util.ExternalTransferSettled, util.ExternalTransferReturned},
+}
+
+type CreateExternalTransferTxParams struct {
+	AccountID     int64  `json:"account_id"`
+	Amount        int64  `json:"amount"`
+	RoutingNumber string `json:"routing_number"`
+	AccountNumber string `json:"account_number"`
+	Fee           int64  `json:"fee"`
+	FeeAccountID  int64  `json:"fee_account_id"`
+	AfterCreate   func(externalTransfer ExternalTransfer) error
+}
+
+type CreateExternalTransferTxResult struct {
+	ExternalTransfer ExternalTransfer `json:"external_transfer"`
+	PlaceHoldTxResult
+	// FeeTransfer is the transfer of the fee to the fee account, nil if there is no fee
+	FeeTransfer *Transfer `json:"fee_transfer,omitempty"`
+}
+
+func (store *SQLStore) CreateExternalTransferTx(ctx context.Context, arg CreateExternalTransferTxParams) (CreateExternalTransferTxResult, error) {
+	var result CreateExternalTransferTxResult
+
+	if arg.Amount <= 0 {
+		return result, ErrInvalidHoldAmount
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.FeeTransfer = nil
+		var err error
+
+		result.PlaceHoldTxResult, err = placeHold(ctx, q, PlaceHoldTxParams{
+			AccountID:   arg.AccountID,
+			Amount:      arg.Amount,
+			Description: fmt.Sprintf("external transfer to %s/%s", arg.RoutingNumber, arg.AccountNumber),
+		})
+		if err != nil {
+			return err
+		}
+
+		result.ExternalTransfer, err = q.CreateExternalTransfer(ctx, CreateExternalTransferParams{
+			AccountID:     arg.AccountID,
+			HoldID:        result.Hold.ID,
+			Amount:        arg.Amount,
+			Currency:      result.Account.Currency,
+			RoutingNumber: arg.RoutingNumber,
+			AccountNumber: arg.AccountNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		if arg.Fee > 0 {
+			fee, err := transfer(ctx, q, TransferTxParams{
+				FromAccountID: arg.AccountID,
+				ToAccountID:   arg.FeeAccountID,
+				Amount:        arg.Fee,
+				Memo:          fmt.Sprintf("fee for external transfer %d", result.ExternalTransfer.ID),
+			})
+			if err != nil {
+				return err
+			}
+
+			// 手续费和冻结的金额都要从可用余额里出
+			result.AvailableBalance -= arg.Fee
+			if result.AvailableBalance < 0 {
+				return ErrInsufficientBalance
+			}
+			result.FeeTransfer = &fee.Transfer
+			result.Account = fee.FromAccount
+		}
+
+		if arg.AfterCreate == nil {
+			return nil
+		}
+		return arg.AfterCreate(result.ExternalTransfer)
+	})
+
+	return result, err
+}
+
+// AdvanceExternalTransferTxParams 的 ClearingAccountID 只在变成 settled 时需要
+type AdvanceExternalTransferTxParams struct {
+	ID                int64  `json:"id"`
+	Status            string `json:"status"`
+	ReturnReason      string `json:"return_reason"`
+	ClearingAccountID int64  `json:"clearing_account_id"`
+}
+
+type AdvanceExternalTransferTxResult struct {
+	ExternalTransfer ExternalTransfer `json:"external_transfer"`
+	// Settlement 是 settled 时从用户账户转到清算账户的那笔转账
+	Settlement *TransferTxResult `json:"settlement,omitempty"`
+}
+
+func (store *SQLStore) AdvanceExternalTransferTx(ctx context.Context, arg AdvanceExternalTransferTxParams) (AdvanceExternalTransferTxResult, error) {
+	var result AdvanceExternalTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.Settlement = nil
+
+		externalTransfer, err := q.GetExternalTransferForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if !canTransitionExternalTransfer(externalTransfer.Status, arg.Status) {
+			return fmt.Errorf("%w: %s -> %s", ErrInvalidExternalTransferTransition, externalTransfer.Status, arg.Status)
+		}
+
+		update := UpdateExternalTransferStatusParams{
+			ID:     externalTransfer.ID,
+			Status: arg.Status,
+		}
+
+		switch arg.Status {
+		case util.ExternalTransferSettled:
+			captured, err := captureActiveHold(ctx, q, CaptureHoldTxParams{
+				HoldID:      externalTransfer.HoldID,
+				ToAccountID: arg.ClearingAccountID,
+			})
+			if err != nil {
+				return err
+			}
+			result.Settlement = &captured.TransferTxResult
+			update.TransferID = pgtype.Int8{Int64: captured.Transfer.ID, Valid: true}
+		case util.ExternalTransferReturned:
+			if _, err := releaseActiveHold(ctx, q, externalTransfer.HoldID); err != nil {
+				return err
+			}
+			update.ReturnReason = arg.ReturnReason
+		}
+
+		result.ExternalTransfer, err = q.UpdateExternalTransferStatus(ctx, update)
+		return err
+	})
+
+	return result, err
+}
+
+func canTransitionExternalTransfer(from string, to string) bool {
+	for _, next := range externalTransferTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrQuoteExpired = errors.New("transfer quote has expired")
+	ErrQuoteUsed    = errors.New("transfer quote has already been used")
+)
+
+/*
+FXTransferTx 按报价（见 GetTransferQuote）把钱转到另一种货币的账户，在同一个事务里：
+1. 锁住报价，报价不属于这个用户时返回 ErrRecordNotFound，已经用过返回 ErrQuoteUsed，过期返回 ErrQuoteExpired；
+2. 从转出账户把 from_amount 转到转出货币的外汇账户（FX_ACCOUNTS），手续费留在这个账户里；
+3. 从转入货币的外汇账户把 to_amount 转到转入账户；
+4. 把报价标记为已使用，并记下两笔转账。
+
+每笔 transfer 的两条 entries 都是同一种货币，所以 000016 的借贷平衡检查仍然成立。
+账户的货币由调用方检查。
+*/
+type FXTransferTxParams struct {
+	QuoteID         int64  `json:"quote_id"`
+	Username        string `json:"username"`
+	FromAccountID   int64  `json:"from_account_id"`
+	ToAccountID     int64  `json:"to_account_id"`
+	FromFXAccountID int64  `json:"from_fx_account_id"`
+	ToFXAccountID   int64  `json:"to_fx_account_id"`
+	Category        string `json:"category"`
+	Memo            string `json:"memo"`
+	Reference       string `json:"reference"`
+}
+
+type FXTransferTxResult struct {
+	Quote  TransferQuote    `json:"quote"`
+	Debit  TransferTxResult `json:"debit"`
+	Credit TransferTxResult `json:"credit"`
+}
+
+func (store *SQLStore) FXTransferTx(ctx context.Context, arg FXTransferTxParams) (FXTransferTxResult, error) {
+	var result FXTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		quote, err := q.GetTransferQuoteForUpdate(ctx, arg.QuoteID)
+		if err != nil {
+			return err
+		}
+		if quote.Username != arg.Username {
+			return ErrRecordNotFound
+		}
+		if quote.UsedAt.Valid {
+			return ErrQuoteUsed
+		}
+		if time.Now().After(quote.ExpiresAt) {
+			return ErrQuoteExpired
+		}
+
+		result.Debit, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.FromFXAccountID,
+			Amount:        quote.FromAmount,
+			Category:      arg.Category,
+			Memo:          arg.Memo,
+			Reference:     arg.Reference,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 和 TransferTx 一样，不能动用被冻结的钱
+		held, err := q.GetHeldAmount(ctx, arg.FromAccountID)
+		if err != nil {
+			return err
+		}
+		if held > 0 && result.Debit.FromAccount.Balance-held < 0 {
+			return ErrInsufficientBalance
+		}
+
+		result.Credit, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.ToFXAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        quote.ToAmount,
+			Category:      arg.Category,
+			Memo:          arg.Memo,
+			Reference:     arg.Reference,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Quote, err = q.MarkTransferQuoteUsed(ctx, MarkTransferQuoteUsedParams{
+			ID:               quote.ID,
+			DebitTransferID:  pgtype.Int8{Int64: result.Debit.Transfer.ID, Valid: true},
+			CreditTransferID: pgtype.Int8{Int64: result.Credit.Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
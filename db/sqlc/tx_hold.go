@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+hold（预授权冻结）把账户的一部分余额保留下来，但不移动资金，比如刷卡时先冻结，结算时再扣款。
+可用余额 = 余额 - 所有 active hold 的金额。
+
+PlaceHoldTx 锁住账户后检查可用余额是否足够，然后创建 hold；
+ReleaseHoldTx 释放 hold，冻结的钱重新变成可用余额；
+CaptureHoldTx 把 hold 的全部或部分金额转到收款账户，剩下的部分一起释放。
+
+hold 只能从 active 变成 released 或 captured，之后不能再改变，所以这两个操作都先用 FOR NO KEY UPDATE 锁住 hold。
+*/
+var (
+	ErrHoldNotActive      = errors.New("hold is no longer active")
+	ErrHoldAmountExceeded = errors.New("amount exceeds the held amount")
+	ErrHoldSameAccount    = errors.New("cannot capture a hold into the held account")
+	ErrInvalidHoldAmount  = errors.New("hold amount must be positive")
+)
+
+type PlaceHoldTxParams struct {
+	AccountID   int64       `json:"account_id"`
+	Amount      int64       `json:"amount"`
+	Description string      `json:"description"`
+	CardID      pgtype.Int8 `json:"card_id"`
+}
+
+type PlaceHoldTxResult struct {
+	Hold             Hold    `json:"hold"`
+	Account          Account `json:"account"`
+	AvailableBalance int64   `json:"available_balance"`
+}
+
+func (store *SQLStore) PlaceHoldTx(ctx context.Context, arg PlaceHoldTxParams) (PlaceHoldTxResult, error) {
+	var result PlaceHoldTxResult
+
+	if arg.Amount <= 0 {
+		return result, ErrInvalidHoldAmount
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = placeHold(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// placeHold 是 PlaceHoldTx 的具体步骤，抽出来是为了让外部转账在同一个事务里冻结资金
+func placeHold(ctx context.Context, q *Queries, arg PlaceHoldTxParams) (PlaceHoldTxResult, error) {
+	var result PlaceHoldTxResult
+	var err error
+
+	// 锁住账户，和转账更新余额互斥，避免同时冻结和转出同一笔钱
+	result.Account, err = q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return result, err
+	}
+
+	held, err := q.GetHeldAmount(ctx, arg.AccountID)
+	if err != nil {
+		return result, err
+	}
+
+	if result.Account.Balance-held < arg.Amount {
+		return result, ErrInsufficientBalance
+	}
+
+	result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+		AccountID:   arg.AccountID,
+		Amount:      arg.Amount,
+		Description: arg.Description,
+		CardID:      arg.CardID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.AvailableBalance = result.Account.Balance - held - arg.Amount
+	return result, nil
+}
+
+type ReleaseHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+}
+
+type ReleaseHoldTxResult struct {
+	Hold Hold `json:"hold"`
+}
+
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Hold, err = releaseActiveHold(ctx, q, arg.HoldID)
+		return err
+	})
+
+	return result, err
+}
+
+func releaseActiveHold(ctx context.Context, q *Queries, holdID int64) (Hold, error) {
+	hold, err := q.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return hold, err
+	}
+
+	if hold.Status != util.HoldActive {
+		return hold, ErrHoldNotActive
+	}
+
+	return q.ReleaseHold(ctx, hold.ID)
+}
+
+// CaptureHoldTxParams 的 Amount 为 0 时扣除 hold 的全部金额
+type CaptureHoldTxParams struct {
+	HoldID      int64 `json:"hold_id"`
+	ToAccountID int64 `json:"to_account_id"`
+	Amount      int64 `json:"amount"`
+}
+
+type CaptureHoldTxResult struct {
+	Hold Hold `json:"hold"`
+	TransferTxResult
+}
+
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = captureActiveHold(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+func captureActiveHold(ctx context.Context, q *Queries, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+	if err != nil {
+		return result, err
+	}
+
+	if hold.Status != util.HoldActive {
+		return result, ErrHoldNotActive
+	}
+
+	if hold.AccountID == arg.ToAccountID {
+		return result, ErrHoldSameAccount
+	}
+
+	amount := arg.Amount
+	if amount == 0 {
+		amount = hold.Amount
+	}
+	if amount < 0 {
+		return result, ErrInvalidHoldAmount
+	}
+	if amount > hold.Amount {
+		return result, ErrHoldAmountExceeded
+	}
+
+	result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+		FromAccountID: hold.AccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        amount,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Hold, err = q.CaptureHold(ctx, CaptureHoldParams{
+		CapturedAmount: amount,
+		TransferID:     pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+		ID:             hold.ID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// hold 已经不再是 active，扣款之后剩下的可用余额（不包括这笔 hold）仍然不能小于 0
+	return result, checkAvailableBalance(ctx, q, result.FromAccount)
+}
+
+// checkAvailableBalance 确认转出之后账户的可用余额（余额减去 active hold 的金额）不小于 0
+func checkAvailableBalance(ctx context.Context, q *Queries, account Account) error {
+	held, err := q.GetHeldAmount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	if account.Balance-held < 0 {
+		return ErrInsufficientBalance
+	}
+	return nil
+}
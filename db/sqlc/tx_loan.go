@@ -0,0 +1,182 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+var (
+	ErrLoanNotPending          = errors.New("loan is not pending approval")
+	ErrLoanPaymentNotScheduled = errors.New("loan payment is not scheduled")
+)
+
+/*
+ApproveLoanTx 批准一笔贷款：从放贷账户（LOAN_FUNDING_ACCOUNTS 里对应币种的内部账户）
+把本金转到借款人的账户，按 util.LoanSchedule 生成还款计划，并把贷款状态改成 active。
+放款、还款计划和状态修改在同一个事务里，不会出现钱放出去了却没有还款计划的情况。
+
+先用 FOR NO KEY UPDATE 锁住贷款，两个 banker 同时批准同一笔贷款时只会放款一次。
+*/
+type ApproveLoanTxParams struct {
+	LoanID           int64  `json:"loan_id"`
+	FundingAccountID int64  `json:"funding_account_id"`
+	ReviewedBy       string `json:"reviewed_by"`
+}
+
+type ApproveLoanTxResult struct {
+	Loan         Loan             `json:"loan"`
+	Payments     []LoanPayment    `json:"payments"`
+	Disbursement TransferTxResult `json:"disbursement"`
+}
+
+func (store *SQLStore) ApproveLoanTx(ctx context.Context, arg ApproveLoanTxParams) (ApproveLoanTxResult, error) {
+	var result ApproveLoanTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		loan, err := q.GetLoanForUpdate(ctx, arg.LoanID)
+		if err != nil {
+			return err
+		}
+
+		if loan.Status != util.LoanPending {
+			return ErrLoanNotPending
+		}
+
+		result.Disbursement, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.FundingAccountID,
+			ToAccountID:   loan.AccountID,
+			Amount:        loan.Principal,
+			Memo:          fmt.Sprintf("loan #%d disbursement", loan.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		// 放贷账户的钱不够时不能放款
+		if err := checkAvailableBalance(ctx, q, result.Disbursement.FromAccount); err != nil {
+			return err
+		}
+
+		schedule := util.LoanSchedule(loan.Principal, loan.AnnualRateBps, loan.TermMonths, time.Now())
+		result.Payments = make([]LoanPayment, len(schedule))
+		for i, installment := range schedule {
+			result.Payments[i], err = q.CreateLoanPayment(ctx, CreateLoanPaymentParams{
+				LoanID:      loan.ID,
+				Installment: installment.Number,
+				DueDate:     installment.DueDate,
+				Principal:   installment.Principal,
+				Interest:    installment.Interest,
+				Amount:      installment.Amount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		result.Loan, err = q.ApproveLoan(ctx, ApproveLoanParams{
+			FundingAccountID:       pgtype.Int8{Int64: arg.FundingAccountID, Valid: true},
+			DisbursementTransferID: pgtype.Int8{Int64: result.Disbursement.Transfer.ID, Valid: true},
+			ReviewedBy:             pgtype.Text{String: arg.ReviewedBy, Valid: true},
+			ID:                     loan.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+/*
+CollectLoanPaymentTx 从借款人的账户扣一期还款，转回放贷账户，并把这一期标记为 paid。
+借款人的可用余额不够时返回 ErrInsufficientBalance，整个事务回滚，这一期还是 scheduled，
+下次定时任务运行时再试。
+
+还完最后一期，贷款变成 paid_off；
+如果贷款之前被标记为 delinquent，并且已经没有逾期未还的分期，就恢复成 active。
+*/
+type CollectLoanPaymentTxParams struct {
+	PaymentID int64 `json:"payment_id"`
+}
+
+type CollectLoanPaymentTxResult struct {
+	Loan    Loan             `json:"loan"`
+	Payment LoanPayment      `json:"payment"`
+	Debit   TransferTxResult `json:"debit"`
+}
+
+func (store *SQLStore) CollectLoanPaymentTx(ctx context.Context, arg CollectLoanPaymentTxParams) (CollectLoanPaymentTxResult, error) {
+	var result CollectLoanPaymentTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		payment, err := q.GetLoanPaymentForUpdate(ctx, arg.PaymentID)
+		if err != nil {
+			return err
+		}
+
+		if payment.Status != util.LoanPaymentScheduled {
+			return ErrLoanPaymentNotScheduled
+		}
+
+		result.Loan, err = q.GetLoanForUpdate(ctx, payment.LoanID)
+		if err != nil {
+			return err
+		}
+
+		result.Debit, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: result.Loan.AccountID,
+			ToAccountID:   result.Loan.FundingAccountID.Int64,
+			Amount:        payment.Amount,
+			Memo:          fmt.Sprintf("loan #%d installment %d", result.Loan.ID, payment.Installment),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := checkAvailableBalance(ctx, q, result.Debit.FromAccount); err != nil {
+			return err
+		}
+
+		result.Payment, err = q.MarkLoanPaymentPaid(ctx, MarkLoanPaymentPaidParams{
+			TransferID: pgtype.Int8{Int64: result.Debit.Transfer.ID, Valid: true},
+			ID:         payment.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		unpaid, err := q.CountUnpaidLoanPayments(ctx, result.Loan.ID)
+		if err != nil {
+			return err
+		}
+
+		status := result.Loan.Status
+		if unpaid == 0 {
+			status = util.LoanPaidOff
+		} else if status == util.LoanDelinquent {
+			overdue, err := q.CountOverdueLoanPayments(ctx, CountOverdueLoanPaymentsParams{
+				LoanID:  result.Loan.ID,
+				DueDate: time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+			if overdue == 0 {
+				status = util.LoanActive
+			}
+		}
+
+		if status != result.Loan.Status {
+			result.Loan, err = q.UpdateLoanStatus(ctx, UpdateLoanStatusParams{
+				Status: status,
+				ID:     result.Loan.ID,
+			})
+		}
+		return err
+	})
+
+	return result, err
+}
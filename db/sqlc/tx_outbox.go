@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+领域事件（util.EventUserCreated 等）用 transactional outbox 的方式发布：
+产生事件的事务在同一个事务里往 outbox_events 表插入一条记录，
+事务提交了事件才存在，回滚了事件也跟着消失，不会出现数据改了却没有事件，或者有事件却没有数据的情况。
+之后由 eventbus.Relay 按 id 顺序把事件发到 Kafka 或 NATS，发送成功再标记为 published。
+*/
+
+// insertOutboxEvent marshals the payload and inserts the event into the outbox in the current transaction
+func insertOutboxEvent(ctx context.Context, q *Queries, eventType string, key string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", eventType, err)
+	}
+
+	_, err = q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		EventType: eventType,
+		EventKey:  key,
+		Payload:   data,
+	})
+	return err
+}
+
+func insertUserCreatedEvent(ctx context.Context, q *Queries, user User) error {
+	return insertOutboxEvent(ctx, q, util.EventUserCreated, user.Username, util.UserCreatedEvent{
+		Username:  user.Username,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: user.CreatedAt,
+	})
+}
+
+func insertEmailVerifiedEvent(ctx context.Context, q *Queries, user User) error {
+	return insertOutboxEvent(ctx, q, util.EventEmailVerified, user.Username, util.EmailVerifiedEvent{
+		Username:   user.Username,
+		Email:      user.Email,
+		VerifiedAt: time.Now(),
+	})
+}
+
+// insertTransferCompletedEvent 的 key 是转出账户，同一个账户转出的事件按顺序送达
+func insertTransferCompletedEvent(ctx context.Context, q *Queries, result TransferTxResult) error {
+	transfer := result.Transfer
+	return insertOutboxEvent(ctx, q, util.EventTransferCompleted, strconv.FormatInt(transfer.FromAccountID, 10), util.TransferCompletedEvent{
+		TransferID:    transfer.ID,
+		FromAccountID: transfer.FromAccountID,
+		ToAccountID:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		Currency:      result.FromAccount.Currency,
+		Category:      transfer.Category,
+		Reference:     transfer.Reference,
+		CreatedAt:     transfer.CreatedAt,
+	})
+}
+
+/*
+RelayOutboxEventsTx 取出最早的一批还没有发布的事件交给 publish，publish 成功后把它们标记为已发布。
+
+取事件时用 FOR UPDATE 锁住这些行，直到 publish 返回、事务提交。
+多个实例同时运行 relay 时，后来的会等前一个提交，然后跳过已经发布的事件，
+这样事件总是按 id 顺序发布，不会被两个实例同时发送。
+publish 失败时事务回滚，这批事件下次再发；publish 成功但提交失败时会重复发送，所以消费者要按事件 ID 去重。
+*/
+func (store *SQLStore) RelayOutboxEventsTx(ctx context.Context, limit int32, publish func(events []OutboxEvent) error) (int, error) {
+	var count int
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		events, err := q.ListUnpublishedOutboxEventsForUpdate(ctx, limit)
+		if err != nil {
+			return err
+		}
+
+		count = len(events)
+		if count == 0 {
+			return nil
+		}
+
+		if err := publish(events); err != nil {
+			return err
+		}
+
+		ids := make([]int64, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		return q.MarkOutboxEventsPublished(ctx, ids)
+	})
+
+	return count, err
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+pending_operations 用 saga 的方式记录那些还需要外部系统确认的转账：
+1. CreatePendingTransferTx 在同一个事务里完成转账，并记录一条 pending 状态的操作；
+2. 外部系统确认后，ConfirmPendingOperationTx 把操作标记为 confirmed；
+3. 外部系统失败或者超时，ReversePendingOperationTx 做一笔反向转账（补偿），把钱退回转出账户，
+并把操作标记为 reversed。
+确认和撤销都会先用 FOR NO KEY UPDATE 锁住操作，只有 pending 状态的操作才能被处理，
+所以同一个操作不会被重复确认或者重复退款。
+*/
+var ErrOperationNotPending = errors.New("operation is no longer pending")
+
+type CreatePendingTransferTxParams struct {
+	TransferTxParams
+	Kind string `json:"kind"`
+}
+
+type CreatePendingTransferTxResult struct {
+	Operation PendingOperation `json:"operation"`
+	TransferTxResult
+}
+
+func (store *SQLStore) CreatePendingTransferTx(ctx context.Context, arg CreatePendingTransferTxParams) (CreatePendingTransferTxResult, error) {
+	var result CreatePendingTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.TransferTxResult, err = transfer(ctx, q, arg.TransferTxParams)
+		if err != nil {
+			return err
+		}
+
+		if err := checkAvailableBalance(ctx, q, result.FromAccount); err != nil {
+			return err
+		}
+
+		result.Operation, err = q.CreatePendingOperation(ctx, CreatePendingOperationParams{
+			TransferID: result.Transfer.ID,
+			Kind:       arg.Kind,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type ConfirmPendingOperationTxParams struct {
+	OperationID int64 `json:"operation_id"`
+}
+
+type ConfirmPendingOperationTxResult struct {
+	Operation PendingOperation `json:"operation"`
+}
+
+func (store *SQLStore) ConfirmPendingOperationTx(ctx context.Context, arg ConfirmPendingOperationTxParams) (ConfirmPendingOperationTxResult, error) {
+	var result ConfirmPendingOperationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		operation, err := q.GetPendingOperationForUpdate(ctx, arg.OperationID)
+		if err != nil {
+			return err
+		}
+
+		if operation.Status != util.OperationPending {
+			return ErrOperationNotPending
+		}
+
+		result.Operation, err = q.UpdatePendingOperationStatus(ctx, UpdatePendingOperationStatusParams{
+			ID:     operation.ID,
+			Status: util.OperationConfirmed,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+type ReversePendingOperationTxParams struct {
+	OperationID int64  `json:"operation_id"`
+	Reason      string `json:"reason"`
+}
+
+type ReversePendingOperationTxResult struct {
+	Operation PendingOperation `json:"operation"`
+	Reversal  TransferTxResult `json:"reversal"`
+	// AlreadyReversed 表示转账之前已经被冲正过了，Reversal 里只有那笔冲正转账
+	AlreadyReversed bool `json:"already_reversed"`
+}
+
+// ReversePendingOperationTx 是补偿步骤，所以不检查收款账户余额：
+// 钱必须退回去，就算收款账户因此变成负数也要由后续流程处理。
+func (store *SQLStore) ReversePendingOperationTx(ctx context.Context, arg ReversePendingOperationTxParams) (ReversePendingOperationTxResult, error) {
+	var result ReversePendingOperationTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		operation, err := q.GetPendingOperationForUpdate(ctx, arg.OperationID)
+		if err != nil {
+			return err
+		}
+
+		if operation.Status != util.OperationPending {
+			return ErrOperationNotPending
+		}
+
+		original, err := q.GetTransfer(ctx, operation.TransferID)
+		if err != nil {
+			return err
+		}
+
+		// banker 可能已经用 ReverseTransferTx 把钱退回去了，这时只更新操作的状态，不再退一次
+		reversal, err := q.GetTransferReversal(ctx, original.ID)
+		switch {
+		case err == nil:
+			result.Reversal = TransferTxResult{Transfer: reversal}
+			result.AlreadyReversed = true
+		case errors.Is(err, ErrRecordNotFound):
+			result.Reversal, err = reverse(ctx, q, original)
+			if err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		result.Operation, err = q.UpdatePendingOperationStatus(ctx, UpdatePendingOperationStatusParams{
+			ID:        operation.ID,
+			Status:    util.OperationReversed,
+			LastError: arg.Reason,
+			ReversalTransferID: pgtype.Int8{
+				Int64: result.Reversal.Transfer.ID,
+				Valid: true,
+			},
+		})
+		return err
+	})
+
+	return result, err
+}
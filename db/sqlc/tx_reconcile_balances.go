@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+/*
+ReconcileBalancesTx 用所有 entries 重新计算每个账户的余额，和 accounts.balance 对比，
+不一致的账户记录到 reconciliation_discrepancies，并生成一份 reconciliation_reports。
+
+每一笔余额变动（转账、冲正）都会同时写 entries，所以正常情况下两边应该完全相等；
+不相等说明有绕过 TransferTx 直接改余额的操作，需要人工核查。
+对比用的是一条查询，所以余额和 entries 来自同一个快照，不会被同时进行的转账干扰。
+*/
+type ReconcileBalancesTxParams struct {
+	StartedAt time.Time `json:"started_at"`
+}
+
+type ReconcileBalancesTxResult struct {
+	Report        ReconciliationReport        `json:"report"`
+	Discrepancies []ReconciliationDiscrepancy `json:"discrepancies"`
+}
+
+func (store *SQLStore) ReconcileBalancesTx(ctx context.Context, arg ReconcileBalancesTxParams) (ReconcileBalancesTxResult, error) {
+	var result ReconcileBalancesTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		accountsChecked, err := q.CountAllAccounts(ctx)
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.ListBalanceDiscrepancies(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.Report, err = q.CreateReconciliationReport(ctx, CreateReconciliationReportParams{
+			AccountsChecked:  accountsChecked,
+			DiscrepancyCount: int64(len(rows)),
+			StartedAt:        arg.StartedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		// 事务可能被重试，结果要重新生成而不是接着上一次的 append
+		result.Discrepancies = make([]ReconciliationDiscrepancy, len(rows))
+		for i, row := range rows {
+			result.Discrepancies[i], err = q.CreateReconciliationDiscrepancy(ctx, CreateReconciliationDiscrepancyParams{
+				ReportID:       result.Report.ID,
+				AccountID:      row.AccountID,
+				AccountBalance: row.AccountBalance,
+				EntriesBalance: row.EntriesBalance,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+var (
+	ErrReferralNotPending = errors.New("referral is no longer pending")
+	ErrReferralNoAccount  = errors.New("referrer or referred user has no account in the bonus currency")
+)
+
+/*
+CreditReferralBonusTx 给推荐人和被推荐人各转一笔奖励，钱来自银行的推广账户（REFERRAL_FUNDING_ACCOUNT），在同一个事务里：
+ 1. 锁住推荐记录，不是 pending 时返回 ErrReferralNotPending，所以重复执行的任务不会重复发奖励；
+ 2. 检查防刷的限制：同一个推荐人、同一个注册 IP 已经拿到奖励的推荐数达到上限时，把推荐标记为 rejected 并提交，不发奖励；
+ 3. 把奖励转到两个人这个币种的默认账户，任何一方没有这个币种的账户时返回 ErrReferralNoAccount，推荐保持 pending，以后再试；
+ 4. 把推荐标记为 credited，并记下两笔转账。
+
+被推荐人是否已经验证邮箱、有没有入账由调用方用 ListEligibleReferrals 判断。
+*/
+type CreditReferralBonusTxParams struct {
+	ReferralID       int64  `json:"referral_id"`
+	Amount           int64  `json:"amount"`
+	Currency         string `json:"currency"`
+	FundingAccountID int64  `json:"funding_account_id"`
+	// MaxPerReferrer 和 MaxPerIP 为 0 时不限制
+	MaxPerReferrer int64 `json:"max_per_referrer"`
+	MaxPerIP       int64 `json:"max_per_ip"`
+}
+
+type CreditReferralBonusTxResult struct {
+	Referral Referral `json:"referral"`
+	// ReferrerBonus 和 ReferredBonus 在推荐被拒绝时为 nil
+	ReferrerBonus *TransferTxResult `json:"referrer_bonus,omitempty"`
+	ReferredBonus *TransferTxResult `json:"referred_bonus,omitempty"`
+}
+
+func (store *SQLStore) CreditReferralBonusTx(ctx context.Context, arg CreditReferralBonusTxParams) (CreditReferralBonusTxResult, error) {
+	var result CreditReferralBonusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result.ReferrerBonus = nil
+		result.ReferredBonus = nil
+
+		referral, err := q.GetReferralForUpdate(ctx, arg.ReferralID)
+		if err != nil {
+			return err
+		}
+		if referral.Status != util.ReferralPending {
+			return ErrReferralNotPending
+		}
+
+		reason, err := referralLimitReached(ctx, q, referral, arg)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			result.Referral, err = q.RejectReferral(ctx, RejectReferralParams{
+				ID:           referral.ID,
+				RejectReason: reason,
+			})
+			return err
+		}
+
+		bonuses := make([]TransferTxResult, 0, 2)
+		for _, owner := range []string{referral.Referrer, referral.Referred} {
+			account, err := q.GetAccountByOwnerCurrency(ctx, GetAccountByOwnerCurrencyParams{
+				Owner:    owner,
+				Currency: arg.Currency,
+			})
+			if err != nil {
+				if errors.Is(err, ErrRecordNotFound) {
+					return ErrReferralNoAccount
+				}
+				return err
+			}
+
+			bonus, err := transfer(ctx, q, TransferTxParams{
+				FromAccountID: arg.FundingAccountID,
+				ToAccountID:   account.ID,
+				Amount:        arg.Amount,
+				Memo:          fmt.Sprintf("referral bonus %d", referral.ID),
+			})
+			if err != nil {
+				return err
+			}
+			bonuses = append(bonuses, bonus)
+		}
+		result.ReferrerBonus = &bonuses[0]
+		result.ReferredBonus = &bonuses[1]
+
+		result.Referral, err = q.CreditReferral(ctx, CreditReferralParams{
+			ID:                 referral.ID,
+			ReferrerTransferID: pgtype.Int8{Int64: bonuses[0].Transfer.ID, Valid: true},
+			ReferredTransferID: pgtype.Int8{Int64: bonuses[1].Transfer.ID, Valid: true},
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// referralLimitReached 返回拒绝推荐的原因，没有达到上限时返回空字符串
+func referralLimitReached(ctx context.Context, q *Queries, referral Referral, arg CreditReferralBonusTxParams) (string, error) {
+	if arg.MaxPerReferrer > 0 {
+		count, err := q.CountCreditedReferralsByReferrer(ctx, referral.Referrer)
+		if err != nil {
+			return "", err
+		}
+		if count >= arg.MaxPerReferrer {
+			return "referrer bonus limit reached", nil
+		}
+	}
+
+	if arg.MaxPerIP > 0 && referral.SignupIp != "" {
+		count, err := q.CountCreditedReferralsBySignupIP(ctx, referral.SignupIp)
+		if err != nil {
+			return "", err
+		}
+		if count >= arg.MaxPerIP {
+			return "sign up IP bonus limit reached", nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/util"
+)
+
+/*
+ReverseTransferTx 由 banker 发起退款：做一笔反向的转账，把钱从原来的转入账户退回原来的转出账户。
+ 1. 反向转账的 reversal_of 指向原来的转账，transfers 上 reversal_of 是唯一的，
+    所以同一笔转账只能被冲正一次，两个 banker 同时冲正时后提交的那个会违反唯一约束；
+ 2. 冲正转账本身不能再被冲正，要把钱再转回去就做一笔普通的转账；
+ 3. 原来的转入账户扣款之后不能变成负数（也不能动用被 hold 冻结的钱），否则返回 ErrInsufficientBalance；
+ 4. 在 audit_logs 里记录是谁、从哪里、因为什么冲正。
+*/
+var (
+	ErrTransferAlreadyReversed = errors.New("transfer has already been reversed")
+	ErrCannotReverseReversal   = errors.New("a reversal can't be reversed")
+)
+
+type ReverseTransferTxParams struct {
+	TransferID int64
+	Reason     string
+	// Actor 是执行操作的 banker，ClientIp、Country、City 是他的请求来源
+	Actor    string
+	ClientIp string
+	Country  string
+	City     string
+}
+
+type ReverseTransferTxResult struct {
+	Original Transfer
+	Reversal TransferTxResult
+	AuditLog AuditLog
+}
+
+// TransferReversedDetails is stored in the details of the audit log
+type TransferReversedDetails struct {
+	ReversalTransferID int64  `json:"reversal_transfer_id"`
+	Amount             int64  `json:"amount"`
+	Reason             string `json:"reason,omitempty"`
+}
+
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = reverseTransfer(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// reverseTransfer 是 ReverseTransferTx 在事务里做的事，ResolveDisputeTx 退款时也用它
+func reverseTransfer(ctx context.Context, q *Queries, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	var err error
+	result.Original, err = q.GetTransfer(ctx, arg.TransferID)
+	if err != nil {
+		return result, err
+	}
+	if result.Original.ReversalOf.Valid {
+		return result, ErrCannotReverseReversal
+	}
+
+	_, err = q.GetTransferReversal(ctx, result.Original.ID)
+	if err == nil {
+		return result, ErrTransferAlreadyReversed
+	}
+	if !errors.Is(err, ErrRecordNotFound) {
+		return result, err
+	}
+
+	result.Reversal, err = reverse(ctx, q, result.Original)
+	if err != nil {
+		return result, err
+	}
+
+	held, err := q.GetHeldAmount(ctx, result.Original.ToAccountID)
+	if err != nil {
+		return result, err
+	}
+	if result.Reversal.FromAccount.Balance-held < 0 {
+		return result, ErrInsufficientBalance
+	}
+
+	details, err := json.Marshal(TransferReversedDetails{
+		ReversalTransferID: result.Reversal.Transfer.ID,
+		Amount:             result.Original.Amount,
+		Reason:             arg.Reason,
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to marshal audit log details: %w", err)
+	}
+
+	result.AuditLog, err = q.CreateAuditLog(ctx, CreateAuditLogParams{
+		Actor:        arg.Actor,
+		Action:       util.AuditTransferReversed,
+		ResourceType: util.AuditResourceTransfer,
+		ResourceID:   strconv.FormatInt(result.Original.ID, 10),
+		Details:      details,
+		ClientIp:     arg.ClientIp,
+		Country:      arg.Country,
+		City:         arg.City,
+	})
+	return result, err
+}
+
+// reverse 做一笔和 original 方向相反、金额相同的转账，ReverseTransferTx 和 ReversePendingOperationTx 共用
+func reverse(ctx context.Context, q *Queries, original Transfer) (TransferTxResult, error) {
+	result, err := transfer(ctx, q, TransferTxParams{
+		FromAccountID: original.ToAccountID,
+		ToAccountID:   original.FromAccountID,
+		Amount:        original.Amount,
+		Category:      original.Category,
+		Memo:          fmt.Sprintf("reversal of transfer #%d", original.ID),
+		Reference:     original.Reference,
+		ReversalOf:    pgtype.Int8{Int64: original.ID, Valid: true},
+	})
+	if ErrorCode(err) == UniqueViolation {
+		return result, ErrTransferAlreadyReversed
+	}
+	return result, err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestReverseTransferTx(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	txResult, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Category:      util.CategoryGroceries,
+	})
+	require.NoError(t, err)
+	original := txResult.Transfer
+
+	result, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Reason:     "duplicate payment",
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+	require.Equal(t, original.ID, result.Original.ID)
+
+	reversal := result.Reversal.Transfer
+	require.Equal(t, account2.ID, reversal.FromAccountID)
+	require.Equal(t, account1.ID, reversal.ToAccountID)
+	require.Equal(t, original.Amount, reversal.Amount)
+	require.Equal(t, original.Category, reversal.Category)
+	require.Equal(t, fmt.Sprintf("reversal of transfer #%d", original.ID), reversal.Memo)
+	require.True(t, reversal.ReversalOf.Valid)
+	require.Equal(t, original.ID, reversal.ReversalOf.Int64)
+
+	// 冲正之后两个账户的余额都回到原来的值
+	require.Equal(t, account1.Balance, result.Reversal.ToAccount.Balance)
+	require.Equal(t, account2.Balance, result.Reversal.FromAccount.Balance)
+
+	require.Equal(t, util.AuditTransferReversed, result.AuditLog.Action)
+	require.Equal(t, strconv.FormatInt(original.ID, 10), result.AuditLog.ResourceID)
+	var details TransferReversedDetails
+	require.NoError(t, json.Unmarshal(result.AuditLog.Details, &details))
+	require.Equal(t, TransferReversedDetails{ReversalTransferID: reversal.ID, Amount: 10, Reason: "duplicate payment"}, details)
+
+	// 同一笔转账只能冲正一次，冲正转账本身也不能再冲正
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{TransferID: original.ID, Actor: banker.Username})
+	require.ErrorIs(t, err, ErrTransferAlreadyReversed)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{TransferID: reversal.ID, Actor: banker.Username})
+	require.ErrorIs(t, err, ErrCannotReverseReversal)
+
+	found, err := testStore.GetTransferReversal(context.Background(), original.ID)
+	require.NoError(t, err)
+	require.Equal(t, reversal.ID, found.ID)
+}
+
+func TestReverseTransferTxInsufficientBalance(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+
+	txResult, err := testStore.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	// 收款方已经把钱花掉了
+	_, err = testStore.UpdateAccount(context.Background(), UpdateAccountParams{ID: account2.ID, Balance: 5})
+	require.NoError(t, err)
+
+	_, err = testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: txResult.Transfer.ID,
+		Actor:      banker.Username,
+	})
+	require.ErrorIs(t, err, ErrInsufficientBalance)
+
+	// 事务回滚了，没有留下冲正转账
+	_, err = testStore.GetTransferReversal(context.Background(), txResult.Transfer.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestReversePendingOperationTxAlreadyReversed(t *testing.T) {
+	account1 := createFundedAccount(t)
+	account2 := createRandomAccount(t)
+	banker := createRandomUser(t)
+	pending := createRandomPendingTransfer(t, account1, account2, 10)
+
+	reversed, err := testStore.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: pending.Transfer.ID,
+		Actor:      banker.Username,
+	})
+	require.NoError(t, err)
+
+	// banker 已经退过款了，撤销操作不会再退一次
+	result, err := testStore.ReversePendingOperationTx(context.Background(), ReversePendingOperationTxParams{
+		OperationID: pending.Operation.ID,
+		Reason:      "payout rejected",
+	})
+	require.NoError(t, err)
+	require.True(t, result.AlreadyReversed)
+	require.Equal(t, util.OperationReversed, result.Operation.Status)
+	require.Equal(t, reversed.Reversal.Transfer.ID, result.Operation.ReversalTransferID.Int64)
+
+	account, err := testStore.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, account.Balance)
+}
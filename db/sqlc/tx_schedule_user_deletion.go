@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+/*
+ScheduleUserDeletionTx 处理用户自己申请的删除：账号不会马上删除，而是在 ScheduledAt 由后台任务调用 DeleteUserTx。
+在同一个事务里：
+1. 记下删除的时间（已经删除或者已经在等待删除的用户返回 ErrRecordNotFound）；
+2. 和 DeleteUserTx 一样检查余额，余额不为 0 的话现在就拒绝，而不是等到删除的时候才失败；
+3. 让所有 session 失效，等待删除期间用户不能登录，只能通过邮件里的链接取消删除（见 CancelUserDeletion）；
+4. 调用 AfterSchedule 回调，用来分发删除任务和确认邮件，分发失败时整个事务回滚。
+*/
+type ScheduleUserDeletionTxParams struct {
+	Username      string
+	ScheduledAt   time.Time
+	AfterSchedule func(user User) error
+}
+
+type ScheduleUserDeletionTxResult struct {
+	User User
+}
+
+func (store *SQLStore) ScheduleUserDeletionTx(ctx context.Context, arg ScheduleUserDeletionTxParams) (ScheduleUserDeletionTxResult, error) {
+	var result ScheduleUserDeletionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.ScheduleUserDeletion(ctx, ScheduleUserDeletionParams{
+			Username:            arg.Username,
+			DeletionScheduledAt: pgtype.Timestamptz{Time: arg.ScheduledAt, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		count, err := q.CountAccountsWithBalance(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrAccountBalanceNotZero
+		}
+
+		err = q.BlockUserSessions(ctx, arg.Username)
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterSchedule(result.User)
+	})
+
+	return result, err
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+)
+
+/*
+SetDefaultAccountTx 把账户设为所有者在这种货币下的默认账户，按用户名或邮箱给他转账时会转到默认账户，在同一个事务里：
+1. 锁住账户（已经关闭的账户返回 ErrRecordNotFound）；
+2. 清掉同一个所有者同一种货币原来的默认账户；
+3. 把这个账户标记为默认账户。
+
+(owner, currency) 上只允许一个默认账户的唯一索引是立即检查的，所以必须先清掉原来的再标记新的。
+*/
+type SetDefaultAccountTxParams struct {
+	AccountID int64
+}
+
+type SetDefaultAccountTxResult struct {
+	Account Account
+	// PreviousDefaultIDs 是被取消默认的账户，通常最多一个，账户本来就是默认账户时也会出现在这里
+	PreviousDefaultIDs []int64
+}
+
+func (store *SQLStore) SetDefaultAccountTx(ctx context.Context, arg SetDefaultAccountTxParams) (SetDefaultAccountTxResult, error) {
+	var result SetDefaultAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		result.PreviousDefaultIDs, err = q.ClearDefaultAccount(ctx, ClearDefaultAccountParams{
+			Owner:    account.Owner,
+			Currency: account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.MarkDefaultAccount(ctx, account.ID)
+		return err
+	})
+
+	return result, err
+}
@@ -1,12 +1,30 @@
 package db
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/techschool/bank/faultinject"
+	"github.com/techschool/bank/util"
+)
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	Category      string `json:"category"`
+	Memo          string `json:"memo"`
+	Reference     string `json:"reference"`
+	// ReversalOf 只有冲正转账才设置，见 ReverseTransferTx
+	ReversalOf pgtype.Int8 `json:"reversal_of"`
+	// FeeOf 只有手续费转账才设置
+	FeeOf pgtype.Int8 `json:"fee_of"`
+	// Fee 大于 0 时 TransferTx 在同一个事务里把手续费从转出账户转到 FeeAccountID（见 util.FeeSchedule），
+	// 只有 TransferTx 收手续费，批量转账、扣款这些内部调用 transfer() 的操作不收
+	Fee          int64 `json:"fee"`
+	FeeAccountID int64 `json:"fee_account_id"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -16,6 +34,10 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	// BalanceAlerts are the alerts of the from account triggered by this transfer
+	BalanceAlerts []BalanceAlert `json:"balance_alerts,omitempty"`
+	// FeeTransfer is the transfer of the fee to the fee account, nil if there is no fee
+	FeeTransfer *Transfer `json:"fee_transfer,omitempty"`
 }
 
 // TransferTx performs a money transfer from one account to the other.
@@ -33,75 +55,168 @@ func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (Tr
 	//启动事务：
 	//TransferTx 使用 execTx 方法来确保所有步骤在一个事务中执行。如果任何步骤失败，整个事务会被回滚。
 	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
+		/*
+			有手续费时一个事务要锁三个账户。transfer() 只保证同一笔转账里两个账户按 ID 从小到大加锁，
+			手续费那一笔在主转账之后才锁 FeeAccountID，和其他转账（比如从手续费账户转出）可能互相等待形成死锁，
+			所以先把三个账户按 ID 从小到大一起锁上，后面的更新不会再等待别的锁。
+		*/
+		if arg.Fee > 0 {
+			err := q.LockAccounts(ctx, []int64{arg.FromAccountID, arg.ToAccountID, arg.FeeAccountID})
+			if err != nil {
+				return err
+			}
+		}
 
-		//创建转账记录：
-		//使用 CreateTransfer 方法（由 sqlc 自动生成）创建一个转账记录。这个记录包含了转出账户、转入账户和转账金额。
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		var err error
+		result, err = transfer(ctx, q, arg)
 		if err != nil {
 			return err
 		}
 
-		//创建账户条目：
-		//使用 CreateEntry 方法（由 sqlc 自动生成）在转出账户创建一个负金额的条目，表示资金被取出。
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
+		// 手续费是单独的一笔转账，fee_of 指向原来的转账，这样两笔转账都满足 000016 的借贷平衡检查
+		if arg.Fee > 0 {
+			fee, err := transfer(ctx, q, TransferTxParams{
+				FromAccountID: arg.FromAccountID,
+				ToAccountID:   arg.FeeAccountID,
+				Amount:        arg.Fee,
+				Memo:          fmt.Sprintf("fee for transfer %d", result.Transfer.ID),
+				Reference:     arg.Reference,
+				FeeOf:         pgtype.Int8{Int64: result.Transfer.ID, Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+			result.FeeTransfer = &fee.Transfer
+			result.FromAccount = fee.FromAccount
+			result.BalanceAlerts = append(result.BalanceAlerts, fee.BalanceAlerts...)
+		}
+
+		// TransferTx 本身不检查透支，但如果转出账户上有 hold，转账不能动用被冻结的钱
+		held, err := q.GetHeldAmount(ctx, arg.FromAccountID)
 		if err != nil {
 			return err
 		}
-		/*
+		if held > 0 && result.FromAccount.Balance-held < 0 {
+			return ErrInsufficientBalance
+		}
+		return nil
+	})
 
-			在进行资金转账时，通常涉及两个账户：一个是资金转出的账户，另一个是资金转入的账户。
-			如果系统同时处理多个此类转账事务，且这些事务涉及相同的账户，就可能出现死锁。
+	//如果所有步骤都成功完成，TransferTx 返回一个包含所有操作结果的 TransferTxResult 结构体，以及 nil 错误。
+	return result, err
+}
 
-			假设有两个并发的转账操作正在执行：
+// transfer 是 TransferTx 的具体步骤，抽出来是为了让批量转账可以在同一个事务里多次调用。
+func transfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	var err error
 
-			操作 A：从账户 1 转账到账户 2。
-			操作 B：从账户 2 转账到账户 1。
-			如果没有一致的锁定顺序，可能会出现如下情况：
+	//创建转账记录：
+	//使用 CreateTransfer 方法（由 sqlc 自动生成）创建一个转账记录。这个记录包含了转出账户、转入账户和转账金额。
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		Category:      arg.Category,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		ReversalOf:    arg.ReversalOf,
+		FeeOf:         arg.FeeOf,
+	})
+	if err != nil {
+		return result, err
+	}
+	if err = faultinject.Check(faultinject.AfterCreateTransfer); err != nil {
+		return result, err
+	}
 
-			操作 A 锁定了账户 1 并准备锁定账户 2。
-			同时，操作 B 锁定了账户 2 并准备锁定账户 1。
-			在这种情况下，操作 A 等待操作 B 释放账户 2 的锁，而操作 B 等待操作 A 释放账户 1 的锁。
-			这就是死锁，因为它们都在等待对方释放资源，而没有任何一方可以继续执行。
+	//创建账户条目：
+	//使用 CreateEntry 方法（由 sqlc 自动生成）在转出账户创建一个负金额的条目，表示资金被取出。
+	//两条 entries 都记录 transfer_id，数据库在提交时会检查它们正好两条并且金额相加为 0（见 000016 migration）。
+	//故障点在两条 entries 之前只检查一次，FAULT_INJECTION 里的次数按转账计算。
+	transferID := pgtype.Int8{Int64: result.Transfer.ID, Valid: true}
+	if err = faultinject.Check(faultinject.BeforeCreateEntry); err != nil {
+		return result, err
+	}
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.FromAccountID,
+		Amount:     -arg.Amount,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return result, err
+	}
+	/*
 
-			现在，假设我们实施了一条规则，无论什么操作，都要先锁定ID较小的账户。这样的话：
+		在进行资金转账时，通常涉及两个账户：一个是资金转出的账户，另一个是资金转入的账户。
+		如果系统同时处理多个此类转账事务，且这些事务涉及相同的账户，就可能出现死锁。
 
-			操作 A 将先锁定账户 1（因为 1 < 2），然后锁定账户 2。
-			操作 B 也将尝试先锁定账户 1（因为 1 < 2），但因为操作 A 已经锁定了账户 1，所以它必须等待。
+		假设有两个并发的转账操作正在执行：
 
-			在这种情况下，操作 B 会等待操作 A 完成，并不会先锁定账户 2。操作 A 完成后，会释放账户 1 和账户 2 的锁。
-			然后操作 B 可以锁定账户 1 和账户 2，继续执行它的转账操作。通过这种方式，我们确保了不会有两个操作互相等待对方释放锁的情况发生。
-			始终按照相同的顺序获取锁意味着不存在循环等待条件
-		*/
+		操作 A：从账户 1 转账到账户 2。
+		操作 B：从账户 2 转账到账户 1。
+		如果没有一致的锁定顺序，可能会出现如下情况：
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+		操作 A 锁定了账户 1 并准备锁定账户 2。
+		同时，操作 B 锁定了账户 2 并准备锁定账户 1。
+		在这种情况下，操作 A 等待操作 B 释放账户 2 的锁，而操作 B 等待操作 A 释放账户 1 的锁。
+		这就是死锁，因为它们都在等待对方释放资源，而没有任何一方可以继续执行。
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
-		}
+		现在，假设我们实施了一条规则，无论什么操作，都要先锁定ID较小的账户。这样的话：
 
-		return nil
+		操作 A 将先锁定账户 1（因为 1 < 2），然后锁定账户 2。
+		操作 B 也将尝试先锁定账户 1（因为 1 < 2），但因为操作 A 已经锁定了账户 1，所以它必须等待。
+
+		在这种情况下，操作 B 会等待操作 A 完成，并不会先锁定账户 2。操作 A 完成后，会释放账户 1 和账户 2 的锁。
+		然后操作 B 可以锁定账户 1 和账户 2，继续执行它的转账操作。通过这种方式，我们确保了不会有两个操作互相等待对方释放锁的情况发生。
+		始终按照相同的顺序获取锁意味着不存在循环等待条件
+	*/
+
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID:  arg.ToAccountID,
+		Amount:     arg.Amount,
+		TransferID: transferID,
 	})
+	if err != nil {
+		return result, err
+	}
 
-	//如果所有步骤都成功完成，TransferTx 返回一个包含所有操作结果的 TransferTxResult 结构体，以及 nil 错误。
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	// 余额在同一个事务里更新，这里算出的扣款前后余额是准确的，并发的转账不会漏掉或者重复触发提醒
+	result.BalanceAlerts, err = triggeredBalanceAlerts(ctx, q, result.FromAccount, arg.Amount)
+	if err != nil {
+		return result, err
+	}
+
+	// 所有转账（包括批量转账、扣款、放贷和还款）都经过这里，在同一个事务里写 transfer.completed 事件
+	err = insertTransferCompletedEvent(ctx, q, result)
 	return result, err
 }
 
+// triggeredBalanceAlerts returns the alerts of the account triggered by debiting amount from it
+func triggeredBalanceAlerts(ctx context.Context, q *Queries, account Account, amount int64) ([]BalanceAlert, error) {
+	alerts, err := q.ListBalanceAlerts(ctx, account.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var triggered []BalanceAlert
+	for _, alert := range alerts {
+		if util.BalanceAlertTriggered(alert.Kind, alert.Threshold, account.Balance+amount, account.Balance) {
+			triggered = append(triggered, alert)
+		}
+	}
+	return triggered, nil
+}
+
 func addMoney(
 	ctx context.Context,
 	q *Queries,
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+TransferAccountOwnershipTx 由 banker 把账户转给另一个用户，在同一个事务里：
+1. 锁住账户（已经关闭的账户返回 ErrRecordNotFound），新的所有者就是原来的所有者时返回 ErrSameAccountOwner；
+2. 修改账户的 owner。新的所有者已经有同一种货币的账户时会违反 (owner, currency) 的唯一索引；
+3. 在 audit_logs 里记录是谁、从哪里、因为什么转移了账户；
+4. 调用 AfterTransfer 回调，用来安排通知双方的任务，回调失败整个事务回滚。
+*/
+var ErrSameAccountOwner = errors.New("account already belongs to the user")
+
+type TransferAccountOwnershipTxParams struct {
+	AccountID int64
+	NewOwner  string
+	Reason    string
+	// Actor 是执行操作的 banker，ClientIp、Country、City 是他的请求来源
+	Actor         string
+	ClientIp      string
+	Country       string
+	City          string
+	AfterTransfer func(result TransferAccountOwnershipTxResult) error
+}
+
+type TransferAccountOwnershipTxResult struct {
+	Account       Account
+	PreviousOwner string
+	AuditLog      AuditLog
+}
+
+// AccountOwnershipTransferredDetails is stored in the details of the audit log
+type AccountOwnershipTransferredDetails struct {
+	PreviousOwner string `json:"previous_owner"`
+	NewOwner      string `json:"new_owner"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+func (store *SQLStore) TransferAccountOwnershipTx(ctx context.Context, arg TransferAccountOwnershipTxParams) (TransferAccountOwnershipTxResult, error) {
+	var result TransferAccountOwnershipTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Owner == arg.NewOwner {
+			return ErrSameAccountOwner
+		}
+		result.PreviousOwner = account.Owner
+
+		result.Account, err = q.UpdateAccountOwner(ctx, UpdateAccountOwnerParams{
+			Owner: arg.NewOwner,
+			ID:    arg.AccountID,
+		})
+		if err != nil {
+			return err
+		}
+
+		details, err := json.Marshal(AccountOwnershipTransferredDetails{
+			PreviousOwner: result.PreviousOwner,
+			NewOwner:      arg.NewOwner,
+			Reason:        arg.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal audit log details: %w", err)
+		}
+
+		result.AuditLog, err = q.CreateAuditLog(ctx, CreateAuditLogParams{
+			Actor:        arg.Actor,
+			Action:       util.AuditAccountOwnershipTransferred,
+			ResourceType: util.AuditResourceAccount,
+			ResourceID:   strconv.FormatInt(arg.AccountID, 10),
+			Details:      details,
+			ClientIp:     arg.ClientIp,
+			Country:      arg.Country,
+			City:         arg.City,
+		})
+		if err != nil {
+			return err
+		}
+
+		return arg.AfterTransfer(result)
+	})
+
+	return result, err
+}
@@ -0,0 +1,38 @@
+package db
+
+import "context"
+
+/*
+UpdateUserTx 在同一个事务中更新用户记录，并且可以选择把该用户所有的 session 标记为 blocked。
+管理员禁用用户或者强制重置密码的时候，需要让已经签发的 refresh token 立即失效，
+这样用户就不能再用旧的 session 续期 access token。
+*/
+type UpdateUserTxParams struct {
+	UpdateUserParams
+	BlockSessions bool
+}
+
+type UpdateUserTxResult struct {
+	User User
+}
+
+func (store *SQLStore) UpdateUserTx(ctx context.Context, arg UpdateUserTxParams) (UpdateUserTxResult, error) {
+	var result UpdateUserTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.User, err = q.UpdateUser(ctx, arg.UpdateUserParams)
+		if err != nil {
+			return err
+		}
+
+		if arg.BlockSessions {
+			return q.BlockUserSessions(ctx, result.User.Username)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -2,10 +2,18 @@ package db
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
-	"log"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrInvalidSecretCode  = errors.New("invalid email_id or secret_code")
+	ErrVerifyEmailExpired = errors.New("verification code has expired")
+	ErrVerifyEmailUsed    = errors.New("verification code has already been used")
 )
 
 type VerifyEmailTxParams struct {
@@ -18,6 +26,12 @@ type VerifyEmailTxResult struct {
 	VerifyEmail VerifyEmail
 }
 
+/*
+验证码只能用一次，并且过了 expired_at 就失效了。
+先锁住这条 verify_emails 记录再逐项检查，这样可以返回具体的错误
+（过期了还是已经用过了），调用方可以据此提示用户重新发送验证邮件。
+并发的两个请求用同一个验证码时，第二个会在锁上等待，然后看到 is_used = true。
+*/
 func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error) {
 	var result VerifyEmailTxResult
 
@@ -26,47 +40,55 @@ func (store *SQLStore) VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParam
 
 		var err error
 
-		// 增加日志，记录事务开始
-		log.Println("Starting transaction for VerifyEmailTx with EmailId:", arg.EmailId)
+		verifyEmail, err := q.GetVerifyEmailForUpdate(ctx, arg.EmailId)
+		if err != nil {
+			if errors.Is(err, ErrRecordNotFound) {
+				return ErrInvalidSecretCode
+			}
+			return err
+		}
+
+		// 用固定时间的比较，避免通过响应时间猜出验证码
+		if subtle.ConstantTimeCompare([]byte(verifyEmail.SecretCode), []byte(arg.SecretCode)) != 1 {
+			return ErrInvalidSecretCode
+		}
+
+		if verifyEmail.IsUsed {
+			return ErrVerifyEmailUsed
+		}
 
-		// 执行更新验证邮件的操作
+		if time.Now().After(verifyEmail.ExpiredAt) {
+			return ErrVerifyEmailExpired
+		}
+
+		// 执行更新验证邮件的操作，把验证码标记为已使用
 		result.VerifyEmail, err = q.UpdateVerifyEmail(ctx, UpdateVerifyEmailParams{
 			ID:         arg.EmailId,
 			SecretCode: arg.SecretCode,
 		})
 		if err != nil {
-			// 增加日志，记录错误信息
-			log.Printf("Error updating verify email: %v\n", err)
 			return err
 		}
 
-		// 检查返回的验证邮件是否有效（是否有数据被更新）
-		if result.VerifyEmail.ID == 0 {
-			// 如果没有更新任何记录，返回错误
-			log.Printf("No verify email record updated for EmailId: %d and SecretCode: %s\n", arg.EmailId, arg.SecretCode)
-			return errors.New("invalid email_id or secret_code")
-		}
-
 		// 更新用户的验证状态
 		result.User, err = q.UpdateUser(ctx, UpdateUserParams{
 			Username:        result.VerifyEmail.Username,
 			IsEmailVerified: pgtype.Bool{Bool: true, Valid: true},
 		})
 		if err != nil {
-			// 增加日志，记录错误信息
-			log.Printf("Error updating user email verification status: %v\n", err)
 			return err
 		}
 
-		// 增加日志，记录事务成功完成
-		log.Println("Transaction completed successfully for VerifyEmailTx with EmailId:", arg.EmailId)
+		if err := insertEmailVerifiedEvent(ctx, q, result.User); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
 	// 检查整个事务是否成功
 	if err != nil {
-		// 记录事务错误
-		log.Printf("Transaction failed for VerifyEmailTx with EmailId: %d, error: %v\n", arg.EmailId, err)
+		log.Ctx(ctx).Debug().Err(err).Int64("email_id", arg.EmailId).Msg("verify email transaction failed")
 		return result, err
 	}
 
@@ -7,19 +7,163 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeUser = `-- name: AnonymizeUser :one
+UPDATE users
+SET
+  hashed_password = '',
+  full_name = 'Deleted User',
+  email = username || '@deleted.invalid',
+  phone_number = '',
+  date_of_birth = NULL,
+  address = '',
+  avatar_key = '',
+  updated_at = now()
+WHERE username = $1
+  AND deleted_at IS NOT NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+func (q *Queries) AnonymizeUser(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, anonymizeUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const cancelUserDeletion = `-- name: CancelUserDeletion :one
+UPDATE users
+SET deletion_scheduled_at = NULL, updated_at = now()
+WHERE username = $1
+  AND deletion_scheduled_at = $2
+  AND deleted_at IS NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+type CancelUserDeletionParams struct {
+	Username            string             `json:"username"`
+	DeletionScheduledAt pgtype.Timestamptz `json:"deletion_scheduled_at"`
+}
+
+func (q *Queries) CancelUserDeletion(ctx context.Context, arg CancelUserDeletionParams) (User, error) {
+	row := q.db.QueryRow(ctx, cancelUserDeletion, arg.Username, arg.DeletionScheduledAt)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const createAdminUser = `-- name: CreateAdminUser :one
+INSERT INTO users (
+  username,
+  hashed_password,
+  full_name,
+  email,
+  role,
+  is_email_verified
+) VALUES (
+  $1, $2, $3, $4, 'admin', true
+) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+type CreateAdminUserParams struct {
+	Username       string `json:"username"`
+	HashedPassword string `json:"hashed_password"`
+	FullName       string `json:"full_name"`
+	Email          string `json:"email"`
+}
+
+func (q *Queries) CreateAdminUser(ctx context.Context, arg CreateAdminUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createAdminUser,
+		arg.Username,
+		arg.HashedPassword,
+		arg.FullName,
+		arg.Email,
+	)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
   username,
   hashed_password,
   full_name,
-  email
+  email,
+  locale
 ) VALUES (
-  $1, $2, $3, $4
-) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified
+  $1, $2, $3, $4, $5
+) RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
 `
 
 type CreateUserParams struct {
@@ -27,6 +171,7 @@ type CreateUserParams struct {
 	HashedPassword string `json:"hashed_password"`
 	FullName       string `json:"full_name"`
 	Email          string `json:"email"`
+	Locale         string `json:"locale"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -35,6 +180,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.HashedPassword,
 		arg.FullName,
 		arg.Email,
+		arg.Locale,
 	)
 	var i User
 	err := row.Scan(
@@ -46,13 +192,26 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.Role,
 		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
 	)
 	return i, err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified FROM users
-WHERE username = $1 LIMIT 1
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE username = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
@@ -67,6 +226,557 @@ func (q *Queries) GetUser(ctx context.Context, username string) (User, error) {
 		&i.CreatedAt,
 		&i.Role,
 		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const getUserByEmail = `-- name: GetUserByEmail :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE email = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE username = $1 AND deleted_at IS NULL LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetUserForUpdate(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserForUpdate, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const getUsersByUsernames = `-- name: GetUsersByUsernames :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE username = ANY($1::text[]) AND deleted_at IS NULL
+ORDER BY username
+`
+
+func (q *Queries) GetUsersByUsernames(ctx context.Context, usernames []string) ([]User, error) {
+	rows, err := q.db.Query(ctx, getUsersByUsernames, usernames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.IsEmailVerified,
+			&i.IsDisabled,
+			&i.PasswordResetRequired,
+			&i.DeletedAt,
+			&i.Locale,
+			&i.UpdatedAt,
+			&i.VerifyEmailReminders,
+			&i.LastVerifyEmailReminderAt,
+			&i.AllowAliasTransfers,
+			&i.DeletionScheduledAt,
+			&i.PhoneNumber,
+			&i.DateOfBirth,
+			&i.Address,
+			&i.AvatarKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDailyNewUsers = `-- name: ListDailyNewUsers :many
+SELECT
+  (date_trunc('day', created_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC')::timestamptz AS day,
+  count(*) AS user_count
+FROM users
+WHERE created_at >= $1
+  AND created_at < $2
+GROUP BY day
+ORDER BY day
+`
+
+type ListDailyNewUsersParams struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type ListDailyNewUsersRow struct {
+	Day       time.Time `json:"day"`
+	UserCount int64     `json:"user_count"`
+}
+
+func (q *Queries) ListDailyNewUsers(ctx context.Context, arg ListDailyNewUsersParams) ([]ListDailyNewUsersRow, error) {
+	rows, err := q.db.Query(ctx, listDailyNewUsers, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListDailyNewUsersRow{}
+	for rows.Next() {
+		var i ListDailyNewUsersRow
+		if err := rows.Scan(&i.Day, &i.UserCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnverifiedUsers = `-- name: ListUnverifiedUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE
+  is_email_verified = false
+  AND is_disabled = false
+  AND deleted_at IS NULL
+  AND created_at < $1
+ORDER BY created_at
+LIMIT $2
+`
+
+type ListUnverifiedUsersParams struct {
+	CreatedBefore time.Time `json:"created_before"`
+	Limit         int32     `json:"limit"`
+}
+
+func (q *Queries) ListUnverifiedUsers(ctx context.Context, arg ListUnverifiedUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUnverifiedUsers, arg.CreatedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.IsEmailVerified,
+			&i.IsDisabled,
+			&i.PasswordResetRequired,
+			&i.DeletedAt,
+			&i.Locale,
+			&i.UpdatedAt,
+			&i.VerifyEmailReminders,
+			&i.LastVerifyEmailReminderAt,
+			&i.AllowAliasTransfers,
+			&i.DeletionScheduledAt,
+			&i.PhoneNumber,
+			&i.DateOfBirth,
+			&i.Address,
+			&i.AvatarKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE
+  deleted_at IS NULL
+  AND (
+    $1::text IS NULL
+    OR username ILIKE '%' || $1 || '%'
+    OR email ILIKE '%' || $1 || '%'
+  )
+ORDER BY username
+LIMIT $2
+OFFSET $3
+`
+
+type ListUsersParams struct {
+	Search pgtype.Text `json:"search"`
+	Limit  int32       `json:"limit"`
+	Offset int32       `json:"offset"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsers, arg.Search, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.IsEmailVerified,
+			&i.IsDisabled,
+			&i.PasswordResetRequired,
+			&i.DeletedAt,
+			&i.Locale,
+			&i.UpdatedAt,
+			&i.VerifyEmailReminders,
+			&i.LastVerifyEmailReminderAt,
+			&i.AllowAliasTransfers,
+			&i.DeletionScheduledAt,
+			&i.PhoneNumber,
+			&i.DateOfBirth,
+			&i.Address,
+			&i.AvatarKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersDueForVerifyEmailReminder = `-- name: ListUsersDueForVerifyEmailReminder :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key FROM users
+WHERE
+  is_email_verified = false
+  AND is_disabled = false
+  AND deleted_at IS NULL
+  AND verify_email_reminders < $1::int
+  AND COALESCE(last_verify_email_reminder_at, created_at) < $2
+ORDER BY created_at
+LIMIT $3
+`
+
+type ListUsersDueForVerifyEmailReminderParams struct {
+	MaxReminders int32     `json:"max_reminders"`
+	RemindBefore time.Time `json:"remind_before"`
+	Limit        int32     `json:"limit"`
+}
+
+func (q *Queries) ListUsersDueForVerifyEmailReminder(ctx context.Context, arg ListUsersDueForVerifyEmailReminderParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsersDueForVerifyEmailReminder, arg.MaxReminders, arg.RemindBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.IsEmailVerified,
+			&i.IsDisabled,
+			&i.PasswordResetRequired,
+			&i.DeletedAt,
+			&i.Locale,
+			&i.UpdatedAt,
+			&i.VerifyEmailReminders,
+			&i.LastVerifyEmailReminderAt,
+			&i.AllowAliasTransfers,
+			&i.DeletionScheduledAt,
+			&i.PhoneNumber,
+			&i.DateOfBirth,
+			&i.Address,
+			&i.AvatarKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markVerifyEmailReminderSent = `-- name: MarkVerifyEmailReminderSent :execrows
+UPDATE users
+SET
+  verify_email_reminders = verify_email_reminders + 1,
+  last_verify_email_reminder_at = now()
+WHERE
+  username = $1
+  AND verify_email_reminders = $2
+  AND is_email_verified = false
+`
+
+type MarkVerifyEmailReminderSentParams struct {
+	Username             string `json:"username"`
+	VerifyEmailReminders int32  `json:"verify_email_reminders"`
+}
+
+func (q *Queries) MarkVerifyEmailReminderSent(ctx context.Context, arg MarkVerifyEmailReminderSentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markVerifyEmailReminderSent, arg.Username, arg.VerifyEmailReminders)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :one
+UPDATE users
+SET deletion_scheduled_at = $1, updated_at = now()
+WHERE username = $2
+  AND deleted_at IS NULL
+  AND deletion_scheduled_at IS NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+type ScheduleUserDeletionParams struct {
+	DeletionScheduledAt pgtype.Timestamptz `json:"deletion_scheduled_at"`
+	Username            string             `json:"username"`
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (User, error) {
+	row := q.db.QueryRow(ctx, scheduleUserDeletion, arg.DeletionScheduledAt, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key, GREATEST(
+  similarity(username, $1),
+  similarity(full_name, $1),
+  similarity(email, $1)
+)::real AS rank
+FROM users
+WHERE
+  deleted_at IS NULL
+  AND (
+    username % $1
+    OR full_name % $1
+    OR email % $1
+    OR username ILIKE '%' || $1 || '%'
+    OR full_name ILIKE '%' || $1 || '%'
+    OR email ILIKE '%' || $1 || '%'
+  )
+ORDER BY rank DESC, username
+LIMIT $2
+OFFSET $3
+`
+
+type SearchUsersParams struct {
+	Query  string `json:"query"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+type SearchUsersRow struct {
+	Username                  string             `json:"username"`
+	HashedPassword            string             `json:"hashed_password"`
+	FullName                  string             `json:"full_name"`
+	Email                     string             `json:"email"`
+	PasswordChangedAt         time.Time          `json:"password_changed_at"`
+	CreatedAt                 time.Time          `json:"created_at"`
+	Role                      string             `json:"role"`
+	IsEmailVerified           bool               `json:"is_email_verified"`
+	IsDisabled                bool               `json:"is_disabled"`
+	PasswordResetRequired     bool               `json:"password_reset_required"`
+	DeletedAt                 pgtype.Timestamptz `json:"deleted_at"`
+	Locale                    string             `json:"locale"`
+	UpdatedAt                 time.Time          `json:"updated_at"`
+	VerifyEmailReminders      int32              `json:"verify_email_reminders"`
+	LastVerifyEmailReminderAt pgtype.Timestamptz `json:"last_verify_email_reminder_at"`
+	AllowAliasTransfers       bool               `json:"allow_alias_transfers"`
+	DeletionScheduledAt       pgtype.Timestamptz `json:"deletion_scheduled_at"`
+	PhoneNumber               string             `json:"phone_number"`
+	DateOfBirth               pgtype.Date        `json:"date_of_birth"`
+	Address                   string             `json:"address"`
+	AvatarKey                 string             `json:"avatar_key"`
+	Rank                      float32            `json:"rank"`
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error) {
+	rows, err := q.db.Query(ctx, searchUsers, arg.Query, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchUsersRow{}
+	for rows.Next() {
+		var i SearchUsersRow
+		if err := rows.Scan(
+			&i.Username,
+			&i.HashedPassword,
+			&i.FullName,
+			&i.Email,
+			&i.PasswordChangedAt,
+			&i.CreatedAt,
+			&i.Role,
+			&i.IsEmailVerified,
+			&i.IsDisabled,
+			&i.PasswordResetRequired,
+			&i.DeletedAt,
+			&i.Locale,
+			&i.UpdatedAt,
+			&i.VerifyEmailReminders,
+			&i.LastVerifyEmailReminderAt,
+			&i.AllowAliasTransfers,
+			&i.DeletionScheduledAt,
+			&i.PhoneNumber,
+			&i.DateOfBirth,
+			&i.Address,
+			&i.AvatarKey,
+			&i.Rank,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const softDeleteUser = `-- name: SoftDeleteUser :one
+UPDATE users
+SET deleted_at = now(), updated_at = now()
+WHERE username = $1
+  AND deleted_at IS NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+func (q *Queries) SoftDeleteUser(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, softDeleteUser, username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
 	)
 	return i, err
 }
@@ -78,19 +788,36 @@ SET
   password_changed_at = COALESCE($2, password_changed_at),
   full_name = COALESCE($3, full_name),
   email = COALESCE($4, email),
-  is_email_verified = COALESCE($5, is_email_verified)
+  is_email_verified = COALESCE($5, is_email_verified),
+  is_disabled = COALESCE($6, is_disabled),
+  password_reset_required = COALESCE($7, password_reset_required),
+  locale = COALESCE($8, locale),
+  allow_alias_transfers = COALESCE($9, allow_alias_transfers),
+  phone_number = COALESCE($10, phone_number),
+  date_of_birth = COALESCE($11, date_of_birth),
+  address = COALESCE($12, address),
+  updated_at = now()
 WHERE
-  username = $6
-RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified
+  username = $13
+  AND ($14::timestamptz IS NULL OR updated_at = $14)
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
 `
 
 type UpdateUserParams struct {
-	HashedPassword    pgtype.Text        `json:"hashed_password"`
-	PasswordChangedAt pgtype.Timestamptz `json:"password_changed_at"`
-	FullName          pgtype.Text        `json:"full_name"`
-	Email             pgtype.Text        `json:"email"`
-	IsEmailVerified   pgtype.Bool        `json:"is_email_verified"`
-	Username          string             `json:"username"`
+	HashedPassword        pgtype.Text        `json:"hashed_password"`
+	PasswordChangedAt     pgtype.Timestamptz `json:"password_changed_at"`
+	FullName              pgtype.Text        `json:"full_name"`
+	Email                 pgtype.Text        `json:"email"`
+	IsEmailVerified       pgtype.Bool        `json:"is_email_verified"`
+	IsDisabled            pgtype.Bool        `json:"is_disabled"`
+	PasswordResetRequired pgtype.Bool        `json:"password_reset_required"`
+	Locale                pgtype.Text        `json:"locale"`
+	AllowAliasTransfers   pgtype.Bool        `json:"allow_alias_transfers"`
+	PhoneNumber           pgtype.Text        `json:"phone_number"`
+	DateOfBirth           pgtype.Date        `json:"date_of_birth"`
+	Address               pgtype.Text        `json:"address"`
+	Username              string             `json:"username"`
+	ExpectedUpdatedAt     pgtype.Timestamptz `json:"expected_updated_at"`
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
@@ -100,7 +827,15 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		arg.FullName,
 		arg.Email,
 		arg.IsEmailVerified,
+		arg.IsDisabled,
+		arg.PasswordResetRequired,
+		arg.Locale,
+		arg.AllowAliasTransfers,
+		arg.PhoneNumber,
+		arg.DateOfBirth,
+		arg.Address,
 		arg.Username,
+		arg.ExpectedUpdatedAt,
 	)
 	var i User
 	err := row.Scan(
@@ -112,6 +847,61 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.CreatedAt,
 		&i.Role,
 		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
+	)
+	return i, err
+}
+
+const updateUserAvatar = `-- name: UpdateUserAvatar :one
+UPDATE users
+SET avatar_key = $1, updated_at = now()
+WHERE username = $2
+  AND deleted_at IS NULL
+RETURNING username, hashed_password, full_name, email, password_changed_at, created_at, role, is_email_verified, is_disabled, password_reset_required, deleted_at, locale, updated_at, verify_email_reminders, last_verify_email_reminder_at, allow_alias_transfers, deletion_scheduled_at, phone_number, date_of_birth, address, avatar_key
+`
+
+type UpdateUserAvatarParams struct {
+	AvatarKey string `json:"avatar_key"`
+	Username  string `json:"username"`
+}
+
+func (q *Queries) UpdateUserAvatar(ctx context.Context, arg UpdateUserAvatarParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserAvatar, arg.AvatarKey, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.Username,
+		&i.HashedPassword,
+		&i.FullName,
+		&i.Email,
+		&i.PasswordChangedAt,
+		&i.CreatedAt,
+		&i.Role,
+		&i.IsEmailVerified,
+		&i.IsDisabled,
+		&i.PasswordResetRequired,
+		&i.DeletedAt,
+		&i.Locale,
+		&i.UpdatedAt,
+		&i.VerifyEmailReminders,
+		&i.LastVerifyEmailReminderAt,
+		&i.AllowAliasTransfers,
+		&i.DeletionScheduledAt,
+		&i.PhoneNumber,
+		&i.DateOfBirth,
+		&i.Address,
+		&i.AvatarKey,
 	)
 	return i, err
 }
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestUserDevices(t *testing.T) {
+	user := createRandomUser(t)
+
+	count, err := testStore.CountUserDevices(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	arg := CreateUserDeviceParams{
+		Username:    user.Username,
+		Fingerprint: util.DeviceFingerprint("Mozilla/5.0", "203.0.113.7"),
+		UserAgent:   "Mozilla/5.0",
+		ClientIp:    "203.0.113.7",
+	}
+	device, err := testStore.CreateUserDevice(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Fingerprint, device.Fingerprint)
+	require.Equal(t, device.FirstSeenAt, device.LastSeenAt)
+
+	// 同一个用户的同一个指纹只能有一条记录
+	_, err = testStore.CreateUserDevice(context.Background(), arg)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	err = testStore.TouchUserDevice(context.Background(), TouchUserDeviceParams{
+		ID:        device.ID,
+		UserAgent: arg.UserAgent,
+		ClientIp:  "203.0.113.8",
+	})
+	require.NoError(t, err)
+
+	device2, err := testStore.GetUserDevice(context.Background(), GetUserDeviceParams{
+		Username:    user.Username,
+		Fingerprint: arg.Fingerprint,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "203.0.113.8", device2.ClientIp)
+	require.True(t, device2.LastSeenAt.After(device.LastSeenAt))
+
+	count, err = testStore.CountUserDevices(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	// 别人的设备删不掉
+	err = testStore.DeleteUserDevice(context.Background(), DeleteUserDeviceParams{ID: device.ID, Username: util.RandomOwner()})
+	require.NoError(t, err)
+	_, err = testStore.GetUserDevice(context.Background(), GetUserDeviceParams{Username: user.Username, Fingerprint: arg.Fingerprint})
+	require.NoError(t, err)
+
+	err = testStore.DeleteUserDevice(context.Background(), DeleteUserDeviceParams{ID: device.ID, Username: user.Username})
+	require.NoError(t, err)
+	_, err = testStore.GetUserDevice(context.Background(), GetUserDeviceParams{Username: user.Username, Fingerprint: arg.Fingerprint})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
@@ -7,10 +7,11 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/i18n"
 	"github.com/techschool/bank/util"
 )
 
-func createRandomUser(t *testing.T) User {
+func createRandomUser(t testing.TB) User {
 
 	hashedPassword, err := util.HashPassword(util.RandomString(6))
 	require.NoError(t, err)
@@ -20,6 +21,7 @@ func createRandomUser(t *testing.T) User {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
+		Locale:         i18n.DefaultLocale,
 	}
 
 	user, err := testStore.CreateUser(context.Background(), arg)
@@ -30,8 +32,10 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, arg.HashedPassword, user.HashedPassword)
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
+	require.Equal(t, arg.Locale, user.Locale)
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
+	require.True(t, user.AllowAliasTransfers)
 
 	return user
 }
@@ -54,6 +58,19 @@ func TestGetUser(t *testing.T) {
 	require.WithinDuration(t, user1.CreatedAt, user2.CreatedAt, time.Second)
 }
 
+func TestGetUsersByUsernames(t *testing.T) {
+	user1 := createRandomUser(t)
+	user2 := createRandomUser(t)
+
+	users, err := testStore.GetUsersByUsernames(context.Background(), []string{user2.Username, user1.Username, "missing"})
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+
+	usernames := []string{users[0].Username, users[1].Username}
+	require.ElementsMatch(t, []string{user1.Username, user2.Username}, usernames)
+	require.Less(t, users[0].Username, users[1].Username)
+}
+
 func TestUpdateUserOnlyFullName(t *testing.T) {
 	oldUser := createRandomUser(t)
 
@@ -92,6 +109,86 @@ func TestUpdateUserOnlyEmail(t *testing.T) {
 	require.Equal(t, oldUser.HashedPassword, updatedUser.HashedPassword)
 }
 
+func TestUpdateUserOnlyLocale(t *testing.T) {
+	oldUser := createRandomUser(t)
+
+	updatedUser, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username: oldUser.Username,
+		Locale: pgtype.Text{
+			String: i18n.Chinese,
+			Valid:  true,
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, i18n.Chinese, updatedUser.Locale)
+	require.Equal(t, oldUser.Email, updatedUser.Email)
+	require.Equal(t, oldUser.FullName, updatedUser.FullName)
+}
+
+func TestUpdateUserOnlyAllowAliasTransfers(t *testing.T) {
+	oldUser := createRandomUser(t)
+
+	updatedUser, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username: oldUser.Username,
+		AllowAliasTransfers: pgtype.Bool{
+			Bool:  false,
+			Valid: true,
+		},
+	})
+
+	require.NoError(t, err)
+	require.False(t, updatedUser.AllowAliasTransfers)
+	require.Equal(t, oldUser.Locale, updatedUser.Locale)
+	require.Equal(t, oldUser.Email, updatedUser.Email)
+}
+
+func TestUpdateUserProfile(t *testing.T) {
+	oldUser := createRandomUser(t)
+	require.Empty(t, oldUser.PhoneNumber)
+	require.False(t, oldUser.DateOfBirth.Valid)
+
+	dateOfBirth := time.Date(1990, time.May, 17, 0, 0, 0, 0, time.UTC)
+	updatedUser, err := testStore.UpdateUser(context.Background(), UpdateUserParams{
+		Username: oldUser.Username,
+		PhoneNumber: pgtype.Text{
+			String: "+6421000000",
+			Valid:  true,
+		},
+		DateOfBirth: pgtype.Date{
+			Time:  dateOfBirth,
+			Valid: true,
+		},
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "+6421000000", updatedUser.PhoneNumber)
+	require.True(t, updatedUser.DateOfBirth.Valid)
+	require.Equal(t, dateOfBirth, updatedUser.DateOfBirth.Time)
+	require.Equal(t, oldUser.Address, updatedUser.Address)
+	require.Equal(t, oldUser.Email, updatedUser.Email)
+}
+
+func TestUpdateUserAvatar(t *testing.T) {
+	oldUser := createRandomUser(t)
+	require.Empty(t, oldUser.AvatarKey)
+
+	avatarKey := "avatars/" + oldUser.Username + "/avatar.png"
+	updatedUser, err := testStore.UpdateUserAvatar(context.Background(), UpdateUserAvatarParams{
+		Username:  oldUser.Username,
+		AvatarKey: avatarKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, avatarKey, updatedUser.AvatarKey)
+	require.Equal(t, oldUser.FullName, updatedUser.FullName)
+
+	_, err = testStore.UpdateUserAvatar(context.Background(), UpdateUserAvatarParams{
+		Username:  util.RandomOwner(),
+		AvatarKey: avatarKey,
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
 func TestUpdateUserOnlyPassword(t *testing.T) {
 	oldUser := createRandomUser(t)
 
@@ -147,3 +244,261 @@ func TestUpdateUserAllFields(t *testing.T) {
 	require.NotEqual(t, oldUser.FullName, updatedUser.FullName)
 	require.Equal(t, newFullName, updatedUser.FullName)
 }
+
+// 带 ExpectedUpdatedAt 的更新只在 updated_at 没变时生效，第二次用旧的 updated_at 更新会查不到行
+func TestUpdateUserExpectedUpdatedAt(t *testing.T) {
+	oldUser := createRandomUser(t)
+
+	arg := UpdateUserParams{
+		Username: oldUser.Username,
+		FullName: pgtype.Text{
+			String: util.RandomOwner(),
+			Valid:  true,
+		},
+		ExpectedUpdatedAt: pgtype.Timestamptz{
+			Time:  oldUser.UpdatedAt,
+			Valid: true,
+		},
+	}
+	updatedUser, err := testStore.UpdateUser(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.FullName.String, updatedUser.FullName)
+	require.True(t, updatedUser.UpdatedAt.After(oldUser.UpdatedAt))
+
+	_, err = testStore.UpdateUser(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestListUsers(t *testing.T) {
+	var lastUser User
+	for i := 0; i < 5; i++ {
+		lastUser = createRandomUser(t)
+	}
+
+	users, err := testStore.ListUsers(context.Background(), ListUsersParams{
+		Search: pgtype.Text{
+			String: lastUser.Username,
+			Valid:  true,
+		},
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, users)
+
+	for _, user := range users {
+		require.Contains(t, user.Username+user.Email, lastUser.Username)
+	}
+}
+
+func TestUpdateUserTxBlocksSessions(t *testing.T) {
+	user := createRandomUser(t)
+
+	result, err := testStore.UpdateUserTx(context.Background(), UpdateUserTxParams{
+		UpdateUserParams: UpdateUserParams{
+			Username: user.Username,
+			IsDisabled: pgtype.Bool{
+				Bool:  true,
+				Valid: true,
+			},
+		},
+		BlockSessions: true,
+	})
+	require.NoError(t, err)
+	require.True(t, result.User.IsDisabled)
+	require.False(t, user.IsDisabled)
+}
+
+func TestSearchUsers(t *testing.T) {
+	user := createRandomUser(t)
+
+	rows, err := testStore.SearchUsers(context.Background(), SearchUsersParams{
+		Query:  user.Username[:4],
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, rows)
+
+	for i := 1; i < len(rows); i++ {
+		require.GreaterOrEqual(t, rows[i-1].Rank, rows[i].Rank)
+	}
+}
+
+func TestDeleteUserTx(t *testing.T) {
+	user := createRandomUser(t)
+	account, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  0,
+		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	var deletedUser User
+	result, err := testStore.DeleteUserTx(context.Background(), DeleteUserTxParams{
+		Username: user.Username,
+		AfterDelete: func(user User) error {
+			deletedUser = user
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.User.DeletedAt.Valid)
+	require.Equal(t, user.Username, deletedUser.Username)
+
+	_, err = testStore.GetUser(context.Background(), user.Username)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	_, err = testStore.GetAccount(context.Background(), account.ID)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	anonymized, err := testStore.AnonymizeUserTx(context.Background(), AnonymizeUserTxParams{
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, user.Email, anonymized.User.Email)
+	require.NotEqual(t, user.FullName, anonymized.User.FullName)
+	require.Empty(t, anonymized.User.HashedPassword)
+}
+
+func TestDeleteUserTxWithBalance(t *testing.T) {
+	user := createRandomUser(t)
+	_, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  100,
+		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.DeleteUserTx(context.Background(), DeleteUserTxParams{
+		Username: user.Username,
+		AfterDelete: func(user User) error {
+			return nil
+		},
+	})
+	require.ErrorIs(t, err, ErrAccountBalanceNotZero)
+
+	user, err = testStore.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.False(t, user.DeletedAt.Valid)
+}
+
+func TestScheduleUserDeletionTx(t *testing.T) {
+	user := createRandomUser(t)
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	var scheduledUser User
+	result, err := testStore.ScheduleUserDeletionTx(context.Background(), ScheduleUserDeletionTxParams{
+		Username:    user.Username,
+		ScheduledAt: scheduledAt,
+		AfterSchedule: func(user User) error {
+			scheduledUser = user
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, result.User.DeletionScheduledAt.Time.Equal(scheduledAt))
+	require.False(t, result.User.DeletedAt.Valid)
+	require.Equal(t, user.Username, scheduledUser.Username)
+
+	// 已经在等待删除的用户不能再申请一次
+	_, err = testStore.ScheduleUserDeletionTx(context.Background(), ScheduleUserDeletionTxParams{
+		Username:      user.Username,
+		ScheduledAt:   scheduledAt.Add(time.Hour),
+		AfterSchedule: func(user User) error { return nil },
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// 只能取消这一次删除
+	_, err = testStore.CancelUserDeletion(context.Background(), CancelUserDeletionParams{
+		Username:            user.Username,
+		DeletionScheduledAt: pgtype.Timestamptz{Time: scheduledAt.Add(time.Hour), Valid: true},
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	cancelled, err := testStore.CancelUserDeletion(context.Background(), CancelUserDeletionParams{
+		Username:            user.Username,
+		DeletionScheduledAt: result.User.DeletionScheduledAt,
+	})
+	require.NoError(t, err)
+	require.False(t, cancelled.DeletionScheduledAt.Valid)
+}
+
+func TestScheduleUserDeletionTxWithBalance(t *testing.T) {
+	user := createRandomUser(t)
+	_, err := testStore.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  100,
+		Currency: util.RandomCurrency(),
+		Number:   util.RandomAccountNumber(),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ScheduleUserDeletionTx(context.Background(), ScheduleUserDeletionTxParams{
+		Username:      user.Username,
+		ScheduledAt:   time.Now().Add(time.Hour),
+		AfterSchedule: func(user User) error { return nil },
+	})
+	require.ErrorIs(t, err, ErrAccountBalanceNotZero)
+
+	user, err = testStore.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.False(t, user.DeletionScheduledAt.Valid)
+}
+
+func TestVerifyEmailReminder(t *testing.T) {
+	user := createRandomUser(t)
+	require.Zero(t, user.VerifyEmailReminders)
+	require.False(t, user.LastVerifyEmailReminderAt.Valid)
+
+	due := func() []User {
+		users, err := testStore.ListUsersDueForVerifyEmailReminder(context.Background(), ListUsersDueForVerifyEmailReminderParams{
+			MaxReminders: 1,
+			RemindBefore: time.Now().Add(time.Minute),
+			Limit:        1000,
+		})
+		require.NoError(t, err)
+		return users
+	}
+	require.Contains(t, usernames(due()), user.Username)
+
+	rows, err := testStore.MarkVerifyEmailReminderSent(context.Background(), MarkVerifyEmailReminderSentParams{
+		Username:             user.Username,
+		VerifyEmailReminders: 0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	// 计数已经变了，同一个提醒不会再算一次
+	rows, err = testStore.MarkVerifyEmailReminderSent(context.Background(), MarkVerifyEmailReminderSentParams{
+		Username:             user.Username,
+		VerifyEmailReminders: 0,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	// 已经发了 MaxReminders 封
+	require.NotContains(t, usernames(due()), user.Username)
+
+	updatedUser, err := testStore.GetUser(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), updatedUser.VerifyEmailReminders)
+	require.True(t, updatedUser.LastVerifyEmailReminderAt.Valid)
+
+	unverified, err := testStore.ListUnverifiedUsers(context.Background(), ListUnverifiedUsersParams{
+		CreatedBefore: time.Now().Add(time.Minute),
+		Limit:         1000,
+	})
+	require.NoError(t, err)
+	require.Contains(t, usernames(unverified), user.Username)
+}
+
+func usernames(users []User) []string {
+	names := make([]string, len(users))
+	for i, user := range users {
+		names[i] = user.Username
+	}
+	return names
+}
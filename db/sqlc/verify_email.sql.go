@@ -40,6 +40,37 @@ func (q *Queries) CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailPa
 	return i, err
 }
 
+const deleteUserVerifyEmails = `-- name: DeleteUserVerifyEmails :exec
+DELETE FROM verify_emails
+WHERE username = $1
+`
+
+func (q *Queries) DeleteUserVerifyEmails(ctx context.Context, username string) error {
+	_, err := q.db.Exec(ctx, deleteUserVerifyEmails, username)
+	return err
+}
+
+const getVerifyEmailForUpdate = `-- name: GetVerifyEmailForUpdate :one
+SELECT id, username, email, secret_code, is_used, created_at, expired_at FROM verify_emails
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetVerifyEmailForUpdate(ctx context.Context, id int64) (VerifyEmail, error) {
+	row := q.db.QueryRow(ctx, getVerifyEmailForUpdate, id)
+	var i VerifyEmail
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.SecretCode,
+		&i.IsUsed,
+		&i.CreatedAt,
+		&i.ExpiredAt,
+	)
+	return i, err
+}
+
 const updateVerifyEmail = `-- name: UpdateVerifyEmail :one
 UPDATE verify_emails
 SET
@@ -0,0 +1,23 @@
+/*
+doc 把 swagger 目录（Swagger UI 的静态文件和 make proto 生成的 openapi.yaml）编译进二进制，
+网关直接从内存里提供 /swagger/ 页面，部署的时候不需要额外带上这些文件。
+*/
+package doc
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed swagger
+var swagger embed.FS
+
+// SwaggerFS returns the Swagger UI files and the OpenAPI document, rooted at the swagger directory.
+func SwaggerFS() fs.FS {
+	sub, err := fs.Sub(swagger, "swagger")
+	if err != nil {
+		// swagger 目录是编译时嵌入的，这里不会出错
+		panic(err)
+	}
+	return sub
+}
@@ -0,0 +1,23 @@
+package doc
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwaggerFS(t *testing.T) {
+	swaggerFS := SwaggerFS()
+
+	spec, err := fs.ReadFile(swaggerFS, "openapi.yaml")
+	require.NoError(t, err)
+	require.Contains(t, string(spec), "openapi: 3.")
+
+	initializer, err := fs.ReadFile(swaggerFS, "swagger-initializer.js")
+	require.NoError(t, err)
+	require.Contains(t, string(initializer), `url: "openapi.yaml"`)
+
+	_, err = fs.Stat(swaggerFS, "index.html")
+	require.NoError(t, err)
+}
@@ -9,8 +9,21 @@ CREATE TABLE "users" (
   "full_name" varchar NOT NULL,
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
+  "is_disabled" bool NOT NULL DEFAULT false,
+  "password_reset_required" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01',
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "deleted_at" timestamptz,
+  "locale" varchar NOT NULL DEFAULT 'en',
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "verify_email_reminders" int NOT NULL DEFAULT 0,
+  "last_verify_email_reminder_at" timestamptz,
+  "allow_alias_transfers" bool NOT NULL DEFAULT true,
+  "deletion_scheduled_at" timestamptz,
+  "phone_number" varchar NOT NULL DEFAULT '',
+  "date_of_birth" date,
+  "address" varchar NOT NULL DEFAULT '',
+  "avatar_key" varchar NOT NULL DEFAULT ''
 );
 
 CREATE TABLE "verify_emails" (
@@ -20,7 +33,7 @@ CREATE TABLE "verify_emails" (
   "secret_code" varchar NOT NULL,
   "is_used" bool NOT NULL DEFAULT false,
   "created_at" timestamptz NOT NULL DEFAULT (now()),
-  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '15 minutes')
+  "expired_at" timestamptz NOT NULL DEFAULT (now() + interval '24 hours')
 );
 
 CREATE TABLE "accounts" (
@@ -28,14 +41,19 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "deleted_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "number" varchar UNIQUE NOT NULL,
+  "is_default" bool NOT NULL DEFAULT false
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "transfer_id" bigint
 );
 
 CREATE TABLE "transfers" (
@@ -43,9 +61,46 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
+  "category" varchar NOT NULL DEFAULT '',
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "reversal_of" bigint,
+  "fee_of" bigint,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "mandates" (
+  "id" bigserial PRIMARY KEY,
+  "payer_account_id" bigint NOT NULL,
+  "payee_account_id" bigint NOT NULL,
+  "max_amount" bigint NOT NULL,
+  "collected_amount" bigint NOT NULL DEFAULT 0,
+  "is_revoked" bool NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "revoked_at" timestamptz
+);
+
+CREATE TABLE "notification_preferences" (
+  "username" varchar PRIMARY KEY,
+  "email_enabled" bool NOT NULL DEFAULT true,
+  "sms_enabled" bool NOT NULL DEFAULT false,
+  "push_enabled" bool NOT NULL DEFAULT false,
+  "phone_number" varchar NOT NULL DEFAULT '',
+  "device_token" varchar NOT NULL DEFAULT '',
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "pending_operations" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint UNIQUE NOT NULL,
+  "kind" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "last_error" varchar NOT NULL DEFAULT '',
+  "reversal_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
 CREATE TABLE "sessions" (
   "id" uuid PRIMARY KEY,
   "username" varchar NOT NULL,
@@ -54,33 +109,537 @@ CREATE TABLE "sessions" (
   "client_ip" varchar NOT NULL,
   "is_blocked" boolean NOT NULL DEFAULT false,
   "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "country" varchar NOT NULL DEFAULT '',
+  "city" varchar NOT NULL DEFAULT ''
+);
+
+CREATE TABLE "identities" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "provider" varchar NOT NULL,
+  "subject" varchar NOT NULL,
+  "email" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "accounts_checked" bigint NOT NULL,
+  "discrepancy_count" bigint NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "reconciliation_discrepancies" (
+  "id" bigserial PRIMARY KEY,
+  "report_id" bigint NOT NULL,
+  "account_id" bigint NOT NULL,
+  "account_balance" bigint NOT NULL,
+  "entries_balance" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "description" varchar NOT NULL DEFAULT '',
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "resolved_at" timestamptz,
+  "card_id" bigint
+);
+
+CREATE TABLE "external_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "hold_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "routing_number" varchar NOT NULL,
+  "account_number" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'initiated',
+  "return_reason" varchar NOT NULL DEFAULT '',
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "cards" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "pan_hash" varchar UNIQUE NOT NULL,
+  "last4" varchar NOT NULL,
+  "expiry_month" int NOT NULL,
+  "expiry_year" int NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loans" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "principal" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "annual_rate_bps" int NOT NULL,
+  "term_months" int NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "funding_account_id" bigint,
+  "disbursement_transfer_id" bigint,
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "loan_payments" (
+  "id" bigserial PRIMARY KEY,
+  "loan_id" bigint NOT NULL,
+  "installment" int NOT NULL,
+  "due_date" timestamptz NOT NULL,
+  "principal" bigint NOT NULL,
+  "interest" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'scheduled',
+  "transfer_id" bigint,
+  "paid_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "kyc_documents" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "document_type" varchar NOT NULL,
+  "file_key" varchar NOT NULL,
+  "content_type" varchar NOT NULL,
+  "size_bytes" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "rejection_reason" varchar NOT NULL DEFAULT '',
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "outbox_events" (
+  "id" bigserial PRIMARY KEY,
+  "event_type" varchar NOT NULL,
+  "event_key" varchar NOT NULL,
+  "payload" jsonb NOT NULL,
+  "published_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "feature_flags" (
+  "name" varchar PRIMARY KEY,
+  "enabled" boolean NOT NULL DEFAULT false,
+  "rollout_percentage" integer NOT NULL DEFAULT 100,
+  "description" varchar NOT NULL DEFAULT '',
+  "updated_by" varchar NOT NULL DEFAULT '',
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "user_devices" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "fingerprint" varchar NOT NULL,
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "first_seen_at" timestamptz NOT NULL DEFAULT (now()),
+  "last_seen_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "audit_logs" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "resource_type" varchar NOT NULL,
+  "resource_id" varchar NOT NULL,
+  "details" jsonb NOT NULL DEFAULT '{}',
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "country" varchar NOT NULL DEFAULT '',
+  "city" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "export_jobs" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "processed_rows" bigint NOT NULL DEFAULT 0,
+  "total_rows" bigint NOT NULL DEFAULT 0,
+  "file_key" varchar NOT NULL DEFAULT '',
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "completed_at" timestamptz,
+  "format" varchar NOT NULL DEFAULT 'csv'
+);
+
+CREATE TABLE "balance_alerts" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "kind" varchar NOT NULL,
+  "threshold" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "disputes" (
+  "id" bigserial PRIMARY KEY,
+  "transfer_id" bigint NOT NULL,
+  "opened_by" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "resolution" varchar NOT NULL DEFAULT '',
+  "resolved_by" varchar,
+  "refund_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "resolved_at" timestamptz
+);
+
+CREATE TABLE "dispute_notes" (
+  "id" bigserial PRIMARY KEY,
+  "dispute_id" bigint NOT NULL,
+  "author" varchar NOT NULL,
+  "note" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "password_history" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "hashed_password" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "transfer_quotes" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "from_amount" bigint NOT NULL,
+  "fee" bigint NOT NULL,
+  "to_amount" bigint NOT NULL,
+  "rate" varchar NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "debit_transfer_id" bigint,
+  "credit_transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "referral_codes" (
+  "username" varchar PRIMARY KEY,
+  "code" varchar UNIQUE NOT NULL,
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
+CREATE TABLE "referrals" (
+  "id" bigserial PRIMARY KEY,
+  "referrer" varchar NOT NULL,
+  "referred" varchar UNIQUE NOT NULL,
+  "signup_ip" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "reject_reason" varchar NOT NULL DEFAULT '',
+  "referrer_transfer_id" bigint,
+  "referred_transfer_id" bigint,
+  "credited_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "failed_logins" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX "users_signup_created_at_idx" ON "users" ("created_at");
+
 CREATE INDEX ON "accounts" ("owner");
 
 CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
 
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" ("transfer_id");
+
+CREATE INDEX ON "entries" ("created_at");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE INDEX ON "transfers" ("from_account_id", "category", "created_at");
+
+CREATE UNIQUE INDEX ON "transfers" ("reversal_of");
+
+CREATE UNIQUE INDEX ON "transfers" ("fee_of");
+
+CREATE INDEX ON "transfers" ("created_at");
+
+CREATE INDEX ON "mandates" ("payer_account_id");
+
+CREATE INDEX ON "mandates" ("payee_account_id");
+
+CREATE INDEX ON "pending_operations" ("status", "created_at");
+
+CREATE UNIQUE INDEX ON "identities" ("provider", "subject");
+
+CREATE INDEX ON "identities" ("username");
+
+CREATE INDEX ON "reconciliation_discrepancies" ("report_id");
+
+CREATE INDEX ON "holds" ("account_id", "status");
+
+CREATE INDEX ON "external_transfers" ("account_id");
+
+CREATE INDEX ON "external_transfers" ("status", "created_at");
+
+CREATE INDEX ON "cards" ("account_id");
+
+CREATE INDEX ON "loans" ("account_id");
+
+CREATE INDEX ON "loans" ("status");
+
+CREATE UNIQUE INDEX ON "loan_payments" ("loan_id", "installment");
+
+CREATE INDEX ON "loan_payments" ("status", "due_date");
+
+CREATE INDEX ON "kyc_documents" ("username");
+
+CREATE INDEX ON "kyc_documents" ("status", "created_at");
+
+CREATE INDEX ON "outbox_events" ("id") WHERE "published_at" IS NULL;
+
+CREATE INDEX ON "outbox_events" ("created_at");
+
+CREATE UNIQUE INDEX ON "user_devices" ("username", "fingerprint");
+
+CREATE INDEX ON "audit_logs" ("resource_type", "resource_id", "created_at");
+
+CREATE INDEX ON "audit_logs" ("actor", "created_at");
+
+CREATE INDEX ON "export_jobs" ("owner", "created_at");
+
+CREATE UNIQUE INDEX ON "balance_alerts" ("account_id", "kind");
+
+CREATE INDEX ON "disputes" ("opened_by");
+
+CREATE INDEX ON "disputes" ("status", "created_at");
+
+CREATE INDEX ON "dispute_notes" ("dispute_id");
+
+CREATE INDEX ON "password_history" ("username", "id");
+
+CREATE INDEX ON "transfer_quotes" ("username");
+
+CREATE INDEX ON "referrals" ("referrer", "status");
+
+CREATE INDEX ON "referrals" ("signup_ip", "status");
+
+CREATE INDEX ON "referrals" ("status", "id");
+
+CREATE INDEX ON "failed_logins" ("created_at");
+
+CREATE INDEX ON "users" ("created_at") WHERE "is_email_verified" = false AND "is_disabled" = false AND "deleted_at" IS NULL;
+
+CREATE UNIQUE INDEX ON "disputes" ("transfer_id") WHERE "status" IN ('open', 'under_review');
+
+CREATE UNIQUE INDEX "accounts_owner_currency_default_key" ON "accounts" ("owner", "currency") WHERE "is_default" AND "deleted_at" IS NULL;
+
+COMMENT ON COLUMN "users"."locale" IS 'language of emails sent to the user';
+
+COMMENT ON COLUMN "users"."verify_email_reminders" IS 'number of verification reminders sent';
+
+COMMENT ON COLUMN "users"."allow_alias_transfers" IS 'whether other users may send money by username or email';
+
+COMMENT ON COLUMN "users"."deletion_scheduled_at" IS 'when a pending account deletion requested by the user takes effect, NULL if there is none';
+
+COMMENT ON COLUMN "users"."phone_number" IS 'E.164 format, e.g. +14155550123';
+
+COMMENT ON COLUMN "users"."avatar_key" IS 'blob store key of the uploaded avatar, empty if there is none';
+
+COMMENT ON COLUMN "accounts"."number" IS 'IBAN-like account number given to customers, see util.GenerateAccountNumber';
+
+COMMENT ON COLUMN "accounts"."is_default" IS 'receives transfers addressed to the owner by username or email in this currency';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
 COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
 
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'the transfer reversed by this transfer, a transfer can only be reversed once';
+
+COMMENT ON COLUMN "transfers"."fee_of" IS 'the transfer this fee was charged for, see TRANSFER_FEES';
+
+COMMENT ON COLUMN "mandates"."max_amount" IS 'must be positive';
+
+COMMENT ON COLUMN "sessions"."country" IS 'ISO country code of the client ip, empty if unknown';
+
+COMMENT ON COLUMN "holds"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "external_transfers"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "loans"."principal" IS 'must be positive';
+
+COMMENT ON COLUMN "loans"."annual_rate_bps" IS 'annual interest rate in basis points, 800 = 8%';
+
+COMMENT ON COLUMN "kyc_documents"."file_key" IS 'key of the uploaded file in the blob store';
+
+COMMENT ON COLUMN "outbox_events"."event_key" IS 'partition key, events with the same key are delivered in order';
+
+COMMENT ON COLUMN "feature_flags"."rollout_percentage" IS 'percentage of users that see the feature when it is enabled';
+
+COMMENT ON COLUMN "user_devices"."fingerprint" IS 'hash of the user agent and the network of the client ip';
+
+COMMENT ON COLUMN "audit_logs"."actor" IS 'username of the user who made the change';
+
+COMMENT ON COLUMN "export_jobs"."owner" IS 'username of the user who started the export';
+
+COMMENT ON COLUMN "export_jobs"."format" IS 'csv, ofx, qif or camt053';
+
+COMMENT ON COLUMN "balance_alerts"."kind" IS 'low_balance or large_debit';
+
+COMMENT ON COLUMN "balance_alerts"."threshold" IS 'in the smallest unit of the account currency';
+
+COMMENT ON COLUMN "disputes"."opened_by" IS 'username of the user who opened the dispute';
+
+COMMENT ON COLUMN "disputes"."status" IS 'open, under_review, refunded or rejected';
+
+COMMENT ON COLUMN "disputes"."refund_transfer_id" IS 'the reversal of the disputed transfer when the dispute is refunded';
+
+COMMENT ON COLUMN "dispute_notes"."author" IS 'username of the banker who wrote the note';
+
+COMMENT ON COLUMN "password_history"."hashed_password" IS 'a previous password of the user, replaced at created_at';
+
+COMMENT ON COLUMN "transfer_quotes"."from_amount" IS 'debited from the sender, fee included';
+
+COMMENT ON COLUMN "transfer_quotes"."fee" IS 'in from_currency, kept in the FX account';
+
+COMMENT ON COLUMN "transfer_quotes"."rate" IS 'decimal string, units of to_currency per unit of from_currency';
+
+COMMENT ON COLUMN "transfer_quotes"."debit_transfer_id" IS 'sender account to the FX account in from_currency';
+
+COMMENT ON COLUMN "transfer_quotes"."credit_transfer_id" IS 'FX account in to_currency to the recipient account';
+
+COMMENT ON COLUMN "referrals"."signup_ip" IS 'client IP of the referred user at sign up, bonuses per IP are limited';
+
+COMMENT ON COLUMN "referrals"."status" IS 'pending, credited or rejected';
+
+COMMENT ON COLUMN "failed_logins"."username" IS 'username in the login request, the user may not exist';
+
+COMMENT ON COLUMN "failed_logins"."reason" IS 'user_not_found or incorrect_password';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
 
 ALTER TABLE "entries" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "entries" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
 ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fee_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("payer_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "mandates" ADD FOREIGN KEY ("payee_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "notification_preferences" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "pending_operations" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "pending_operations" ADD FOREIGN KEY ("reversal_transfer_id") REFERENCES "transfers" ("id");
+
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "identities" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("report_id") REFERENCES "reconciliation_reports" ("id");
+
+ALTER TABLE "reconciliation_discrepancies" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "external_transfers" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("card_id") REFERENCES "cards" ("id");
+
+ALTER TABLE "cards" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("funding_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("disbursement_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "loans" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "loan_payments" ADD FOREIGN KEY ("loan_id") REFERENCES "loans" ("id");
+
+ALTER TABLE "loan_payments" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "kyc_documents" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "user_devices" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "audit_logs" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
+
+ALTER TABLE "export_jobs" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "export_jobs" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_alerts" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("opened_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("username");
+
+ALTER TABLE "disputes" ADD FOREIGN KEY ("refund_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "dispute_notes" ADD FOREIGN KEY ("dispute_id") REFERENCES "disputes" ("id");
+
+ALTER TABLE "dispute_notes" ADD FOREIGN KEY ("author") REFERENCES "users" ("username");
+
+ALTER TABLE "password_history" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("debit_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfer_quotes" ADD FOREIGN KEY ("credit_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "referral_codes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referrer") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referred") REFERENCES "users" ("username");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referrer_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "referrals" ADD FOREIGN KEY ("referred_transfer_id") REFERENCES "transfers" ("id");
@@ -0,0 +1,79 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+eventbus 把 outbox_events 表里的领域事件（用户注册、转账完成、邮箱验证，见 util/event.go）
+发布到 Kafka 或 NATS JetStream，下游的分析系统和总账系统订阅这些事件，不用直接查询我们的数据库。
+
+事件由业务事务写进 outbox（见 db/sqlc/tx_outbox.go），Relay 在后台按顺序读出来交给 Publisher。
+投递语义是至少一次：每个事件都带着 outbox 的 ID，消费者要按 ID 去重。
+
+topic（NATS 里叫 subject）是 EVENT_TOPIC_PREFIX 加上事件类型，例如 bank.transfer.completed。
+同一个 key（用户名或者转出账户）的事件会进入同一个 Kafka 分区，保证顺序。
+*/
+
+// Event buses supported by EVENT_BUS
+const (
+	BusKafka = "kafka"
+	BusNATS  = "nats"
+)
+
+// Headers added to every published message
+const (
+	HeaderEventID   = "event-id"
+	HeaderEventType = "event-type"
+)
+
+// Event is a domain event read from the outbox
+type Event struct {
+	ID        int64
+	Type      string
+	Key       string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// Publisher sends events to a message broker. Events must be published in order.
+type Publisher interface {
+	Publish(ctx context.Context, events []Event) error
+	Close() error
+}
+
+// NewPublisher creates the publisher selected by EVENT_BUS, or nil if publishing events is disabled
+func NewPublisher(config util.Config) (Publisher, error) {
+	switch config.EventBus {
+	case "":
+		return nil, nil
+	case BusKafka:
+		var brokers []string
+		for _, broker := range strings.Split(config.KafkaBrokers, ",") {
+			if broker = strings.TrimSpace(broker); broker != "" {
+				brokers = append(brokers, broker)
+			}
+		}
+		if len(brokers) == 0 {
+			return nil, fmt.Errorf("KAFKA_BROKERS must not be empty")
+		}
+		return NewKafkaPublisher(brokers, config.EventTopicPrefix), nil
+	case BusNATS:
+		return NewNATSPublisher(config.NATSURL, config.NATSStream, config.EventTopicPrefix)
+	default:
+		return nil, fmt.Errorf("unsupported event bus %q", config.EventBus)
+	}
+}
+
+// Topic returns the Kafka topic or NATS subject of an event type
+func Topic(prefix string, eventType string) string {
+	if prefix == "" {
+		return eventType
+	}
+	return prefix + "." + eventType
+}
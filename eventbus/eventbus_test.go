@@ -0,0 +1,62 @@
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestNewPublisher(t *testing.T) {
+	publisher, err := NewPublisher(util.Config{})
+	require.NoError(t, err)
+	require.Nil(t, publisher)
+
+	publisher, err = NewPublisher(util.Config{EventBus: BusKafka, KafkaBrokers: "localhost:9092, localhost:9093"})
+	require.NoError(t, err)
+	require.IsType(t, &KafkaPublisher{}, publisher)
+	require.NoError(t, publisher.Close())
+
+	_, err = NewPublisher(util.Config{EventBus: BusKafka, KafkaBrokers: " , "})
+	require.Error(t, err)
+
+	_, err = NewPublisher(util.Config{EventBus: "rabbitmq"})
+	require.Error(t, err)
+}
+
+func TestTopic(t *testing.T) {
+	require.Equal(t, "bank.transfer.completed", Topic("bank", util.EventTransferCompleted))
+	require.Equal(t, "user.created", Topic("", util.EventUserCreated))
+}
+
+func TestKafkaMessages(t *testing.T) {
+	createdAt := time.Now().UTC()
+	events := []Event{
+		{ID: 7, Type: util.EventUserCreated, Key: "alice", Payload: []byte(`{"username":"alice"}`), CreatedAt: createdAt},
+		{ID: 8, Type: util.EventTransferCompleted, Key: "1", Payload: []byte(`{"transfer_id":3}`), CreatedAt: createdAt},
+	}
+
+	messages := kafkaMessages(events, "bank")
+	require.Len(t, messages, 2)
+	require.Equal(t, "bank.user.created", messages[0].Topic)
+	require.Equal(t, "alice", string(messages[0].Key))
+	require.Equal(t, `{"username":"alice"}`, string(messages[0].Value))
+	require.Equal(t, createdAt, messages[0].Time)
+	require.Equal(t, HeaderEventID, messages[0].Headers[0].Key)
+	require.Equal(t, "7", string(messages[0].Headers[0].Value))
+	require.Equal(t, "bank.transfer.completed", messages[1].Topic)
+	require.Equal(t, "1", string(messages[1].Key))
+}
+
+func TestNATSMessage(t *testing.T) {
+	event := Event{ID: 9, Type: util.EventEmailVerified, Key: "alice", Payload: []byte(`{"username":"alice"}`)}
+
+	msg := natsMessage(event, "bank")
+	require.Equal(t, "bank.email.verified", msg.Subject)
+	require.Equal(t, `{"username":"alice"}`, string(msg.Data))
+	require.Equal(t, "9", msg.Header.Get(nats.MsgIdHdr))
+	require.Equal(t, "9", msg.Header.Get(HeaderEventID))
+	require.Equal(t, util.EventEmailVerified, msg.Header.Get(HeaderEventType))
+}
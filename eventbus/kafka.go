@@ -0,0 +1,62 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher publishes events to Kafka, one topic per event type
+type KafkaPublisher struct {
+	writer      *kafka.Writer
+	topicPrefix string
+}
+
+func NewKafkaPublisher(brokers []string, topicPrefix string) *KafkaPublisher {
+	writer := &kafka.Writer{
+		Addr: kafka.TCP(brokers...),
+		// 按 key 选择分区，同一个 key 的事件保持顺序
+		Balancer: &kafka.Hash{},
+		// 所有副本都写入成功才算发布成功，之后才会把事件标记为已发布
+		RequiredAcks:           kafka.RequireAll,
+		AllowAutoTopicCreation: true,
+		BatchTimeout:           10 * time.Millisecond,
+	}
+
+	return &KafkaPublisher{
+		writer:      writer,
+		topicPrefix: topicPrefix,
+	}
+}
+
+func (publisher *KafkaPublisher) Publish(ctx context.Context, events []Event) error {
+	err := publisher.writer.WriteMessages(ctx, kafkaMessages(events, publisher.topicPrefix)...)
+	if err != nil {
+		return fmt.Errorf("failed to write kafka messages: %w", err)
+	}
+	return nil
+}
+
+func (publisher *KafkaPublisher) Close() error {
+	return publisher.writer.Close()
+}
+
+func kafkaMessages(events []Event, topicPrefix string) []kafka.Message {
+	messages := make([]kafka.Message, len(events))
+	for i, event := range events {
+		messages[i] = kafka.Message{
+			Topic: Topic(topicPrefix, event.Type),
+			Key:   []byte(event.Key),
+			Value: event.Payload,
+			Time:  event.CreatedAt,
+			Headers: []kafka.Header{
+				{Key: HeaderEventID, Value: []byte(strconv.FormatInt(event.ID, 10))},
+				{Key: HeaderEventType, Value: []byte(event.Type)},
+			},
+		}
+	}
+	return messages
+}
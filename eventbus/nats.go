@@ -0,0 +1,84 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nats-io/nats.go"
+)
+
+/*
+NATSPublisher 发布到 NATS JetStream，而不是普通的 NATS subject：
+普通的 publish 不知道消息有没有被保存，JetStream 会返回确认，确认之后才把事件标记为已发布。
+消息带着 Nats-Msg-Id，JetStream 会在去重窗口内丢掉 relay 重复发送的事件。
+
+stream 不存在时自动创建，保存 EVENT_TOPIC_PREFIX 下的所有 subject。
+*/
+type NATSPublisher struct {
+	conn          *nats.Conn
+	js            nats.JetStreamContext
+	subjectPrefix string
+}
+
+func NewNATSPublisher(url string, stream string, subjectPrefix string) (*NATSPublisher, error) {
+	if subjectPrefix == "" {
+		return nil, fmt.Errorf("EVENT_TOPIC_PREFIX must not be empty for NATS")
+	}
+
+	conn, err := nats.Connect(url, nats.Name("simple-bank"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to nats: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("cannot create jetstream context: %w", err)
+	}
+
+	_, err = js.StreamInfo(stream)
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = js.AddStream(&nats.StreamConfig{
+			Name:     stream,
+			Subjects: []string{subjectPrefix + ".>"},
+		})
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("cannot create nats stream %s: %w", stream, err)
+	}
+
+	return &NATSPublisher{
+		conn:          conn,
+		js:            js,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+// Publish 一个一个地发送并等待确认，前一个确认了才发下一个，保证顺序
+func (publisher *NATSPublisher) Publish(ctx context.Context, events []Event) error {
+	for _, event := range events {
+		_, err := publisher.js.PublishMsg(natsMessage(event, publisher.subjectPrefix), nats.Context(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to publish event %d: %w", event.ID, err)
+		}
+	}
+	return nil
+}
+
+func (publisher *NATSPublisher) Close() error {
+	return publisher.conn.Drain()
+}
+
+func natsMessage(event Event, subjectPrefix string) *nats.Msg {
+	msg := nats.NewMsg(Topic(subjectPrefix, event.Type))
+	msg.Data = event.Payload
+
+	id := strconv.FormatInt(event.ID, 10)
+	msg.Header.Set(nats.MsgIdHdr, id)
+	msg.Header.Set(HeaderEventID, id)
+	msg.Header.Set(HeaderEventType, event.Type)
+	return msg
+}
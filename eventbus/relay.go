@@ -0,0 +1,68 @@
+package eventbus
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+// Relay moves events from the outbox table to the event bus
+type Relay struct {
+	store     db.Store
+	publisher Publisher
+	interval  time.Duration
+	batchSize int32
+}
+
+func NewRelay(store db.Store, publisher Publisher, interval time.Duration, batchSize int32) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+/*
+Run 一直运行直到 ctx 被取消：取到满满一批事件时马上取下一批，否则等 interval 再查询。
+发布失败只记录日志，事件还在 outbox 里，等下一轮再发。
+*/
+func (relay *Relay) Run(ctx context.Context) {
+	for {
+		count, err := relay.RelayOnce(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to relay outbox events")
+		} else if count > 0 {
+			log.Debug().Int("count", count).Msg("relayed outbox events")
+		}
+
+		if err == nil && count == int(relay.batchSize) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(relay.interval):
+		}
+	}
+}
+
+// RelayOnce publishes one batch of unpublished events and returns how many were published
+func (relay *Relay) RelayOnce(ctx context.Context) (int, error) {
+	return relay.store.RelayOutboxEventsTx(ctx, relay.batchSize, func(outboxEvents []db.OutboxEvent) error {
+		events := make([]Event, len(outboxEvents))
+		for i, outboxEvent := range outboxEvents {
+			events[i] = Event{
+				ID:        outboxEvent.ID,
+				Type:      outboxEvent.EventType,
+				Key:       outboxEvent.EventKey,
+				Payload:   outboxEvent.Payload,
+				CreatedAt: outboxEvent.CreatedAt,
+			}
+		}
+		return relay.publisher.Publish(ctx, events)
+	})
+}
@@ -0,0 +1,90 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+// publisherRecorder records the published events, or fails with err
+type publisherRecorder struct {
+	events []Event
+	err    error
+}
+
+func (publisher *publisherRecorder) Publish(ctx context.Context, events []Event) error {
+	if publisher.err != nil {
+		return publisher.err
+	}
+	publisher.events = append(publisher.events, events...)
+	return nil
+}
+
+func (publisher *publisherRecorder) Close() error {
+	return nil
+}
+
+func TestRelayOnce(t *testing.T) {
+	outboxEvents := []db.OutboxEvent{
+		{ID: 1, EventType: util.EventUserCreated, EventKey: "alice", Payload: []byte(`{}`), CreatedAt: time.Now()},
+		{ID: 2, EventType: util.EventEmailVerified, EventKey: "alice", Payload: []byte(`{}`), CreatedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name       string
+		publishErr error
+		checkRelay func(t *testing.T, publisher *publisherRecorder, count int, err error)
+	}{
+		{
+			name: "OK",
+			checkRelay: func(t *testing.T, publisher *publisherRecorder, count int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, count)
+				require.Len(t, publisher.events, 2)
+				require.Equal(t, int64(1), publisher.events[0].ID)
+				require.Equal(t, util.EventUserCreated, publisher.events[0].Type)
+				require.Equal(t, "alice", publisher.events[0].Key)
+				require.Equal(t, util.EventEmailVerified, publisher.events[1].Type)
+			},
+		},
+		{
+			name:       "PublishError",
+			publishErr: errors.New("broker is down"),
+			checkRelay: func(t *testing.T, publisher *publisherRecorder, count int, err error) {
+				require.Error(t, err)
+				require.Empty(t, publisher.events)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			// 和 SQLStore 一样：publish 失败时返回错误，事件不会被标记为已发布
+			store.EXPECT().
+				RelayOutboxEventsTx(gomock.Any(), gomock.Eq(int32(10)), gomock.Any()).
+				Times(1).
+				DoAndReturn(func(ctx context.Context, limit int32, publish func([]db.OutboxEvent) error) (int, error) {
+					return len(outboxEvents), publish(outboxEvents)
+				})
+
+			publisher := &publisherRecorder{err: tc.publishErr}
+			relay := NewRelay(store, publisher, time.Second, 10)
+
+			count, err := relay.RelayOnce(context.Background())
+			tc.checkRelay(t, publisher, count, err)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+camt053Writer 写 ISO 20022 camt.053.001.02（BankToCustomerStatement），这个版本的支持最广。
+Schema 要求余额（Bal）在分录（Ntry）前面，所以期初（OPBD）和期末（CLBD）余额都在 WriteHeader 里写。
+camt 的金额都是正数，方向由 CdtDbtInd（CRDT 或 DBIT）表示，余额为负时也是这样。
+转账的 reference 写在 EndToEndId，没有时按规范写 NOTPROVIDED；备注写在非结构化的附言 Ustrd 里，最长 140 个字符。
+*/
+type camt053Writer struct {
+	w        *bufio.Writer
+	currency string
+}
+
+const camtNamespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+
+// camtText escapes value for the content of an XML element
+func camtText(value string, max int) string {
+	var builder strings.Builder
+	xml.EscapeText(&builder, []byte(singleLine(value, max)))
+	return builder.String()
+}
+
+func camtTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// camtAmount returns the absolute amount and its credit debit indicator
+func camtAmount(amount int64, currency string) (string, string) {
+	value := decimal(amount, currency)
+	if amount < 0 {
+		return strings.TrimPrefix(value, "-"), "DBIT"
+	}
+	return value, "CRDT"
+}
+
+func (writer *camt053Writer) writeBalance(code string, amount int64, at time.Time) error {
+	value, indicator := camtAmount(amount, writer.currency)
+	_, err := fmt.Fprintf(writer.w, `<Bal><Tp><CdOrPrtry><Cd>%s</Cd></CdOrPrtry></Tp><Amt Ccy="%s">%s</Amt><CdtDbtInd>%s</CdtDbtInd><Dt><DtTm>%s</DtTm></Dt></Bal>
+`, code, writer.currency, value, indicator, camtTime(at))
+	return err
+}
+
+func (writer *camt053Writer) WriteHeader(statement Statement) error {
+	writer.currency = statement.Currency
+	id := camtText(statement.ID, 35)
+
+	fmt.Fprintf(writer.w, `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="%s">
+<BkToCstmrStmt>
+<GrpHdr><MsgId>%s</MsgId><CreDtTm>%s</CreDtTm></GrpHdr>
+<Stmt>
+<Id>%s</Id>
+<CreDtTm>%s</CreDtTm>
+<FrToDt><FrDtTm>%s</FrDtTm><ToDtTm>%s</ToDtTm></FrToDt>
+<Acct><Id><Othr><Id>%s</Id></Othr></Id><Ccy>%s</Ccy><Ownr><Nm>%s</Nm></Ownr></Acct>
+`,
+		camtNamespace,
+		id, camtTime(statement.CreatedAt),
+		id,
+		camtTime(statement.CreatedAt),
+		camtTime(statement.From), camtTime(statement.To),
+		camtText(statement.AccountNumber, 34), statement.Currency, camtText(statement.Owner, 140),
+	)
+	writer.writeBalance("OPBD", statement.OpeningBalance, statement.From)
+	return writer.writeBalance("CLBD", statement.ClosingBalance, statement.To)
+}
+
+func (writer *camt053Writer) WriteEntries(entries []Entry) error {
+	for _, entry := range entries {
+		value, indicator := camtAmount(entry.Amount, writer.currency)
+		bookedAt := camtTime(entry.BookedAt)
+
+		endToEndID := "NOTPROVIDED"
+		if entry.Reference != "" {
+			endToEndID = camtText(entry.Reference, 35)
+		}
+		txID := ""
+		if entry.TransferID != 0 {
+			txID = "<TxId>" + strconv.FormatInt(entry.TransferID, 10) + "</TxId>"
+		}
+		remittance := ""
+		if entry.Memo != "" {
+			remittance = "<RmtInf><Ustrd>" + camtText(entry.Memo, 140) + "</Ustrd></RmtInf>"
+		}
+
+		_, err := fmt.Fprintf(writer.w, `<Ntry><NtryRef>%d</NtryRef><Amt Ccy="%s">%s</Amt><CdtDbtInd>%s</CdtDbtInd><Sts>BOOK</Sts>`+
+			`<BookgDt><DtTm>%s</DtTm></BookgDt><ValDt><DtTm>%s</DtTm></ValDt><AcctSvcrRef>%d</AcctSvcrRef>`+
+			`<BkTxCd><Prtry><Cd>TRANSFER</Cd></Prtry></BkTxCd>`+
+			`<NtryDtls><TxDtls><Refs><EndToEndId>%s</EndToEndId>%s</Refs>%s</TxDtls></NtryDtls>`+
+			`<AddtlNtryInf>%s</AddtlNtryInf></Ntry>
+`,
+			entry.ID, writer.currency, value, indicator,
+			bookedAt, bookedAt, entry.ID,
+			endToEndID, txID, remittance,
+			camtText(entry.description(), 500),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (writer *camt053Writer) Close() error {
+	fmt.Fprint(writer.w, "</Stmt>\n</BkToCstmrStmt>\n</Document>\n")
+	return writer.w.Flush()
+}
@@ -0,0 +1,134 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+exporter 包把一个账户的分录生成记账软件可以直接导入的对账单：
+  - ofx：OFX 2.2（XML 版本），大多数个人记账软件都支持
+  - qif：Quicken Interchange Format，老的软件只支持这个
+  - camt053：ISO 20022 camt.053.001.02 的 BankToCustomerStatement，企业的财务系统一般用这个
+
+分录可能很多，Writer 一边接收一页一页的分录一边写，不会把整个对账单放在内存里。
+camt.053 要求期初和期末余额写在所有分录前面，所以 WriteHeader 的时候就要知道余额（见 db.GetAccountStatement）。
+Writer 写在 bufio.Writer 里，写出错以后后面的写入都会返回同一个错误，所以只检查每次调用最后一次写入的错误。
+金额在数据库里是最小货币单位，写出来的时候按币种的小数位数换算成十进制（见 util.Money.Decimal）。
+*/
+
+// BankID identifies the bank in the statements, e.g. BANKID of OFX
+const BankID = "SIMPLBANK"
+
+// Statement is the header of an account statement
+type Statement struct {
+	// ID uniquely identifies the statement, it's used as the message ID of camt.053 and TRNUID of OFX
+	ID             string
+	AccountNumber  string
+	Owner          string
+	Currency       string
+	OpeningBalance int64
+	ClosingBalance int64
+	From           time.Time
+	To             time.Time
+	CreatedAt      time.Time
+}
+
+// Entry is a booked entry of the account, positive amounts are credits
+type Entry struct {
+	ID int64
+	// TransferID is 0 for entries that aren't part of a transfer, e.g. the opening deposit
+	TransferID            int64
+	Amount                int64
+	BookedAt              time.Time
+	Memo                  string
+	Reference             string
+	CounterpartyAccountID int64
+}
+
+// Writer writes a statement: WriteHeader once, WriteEntries for each page of entries, then Close
+type Writer interface {
+	WriteHeader(statement Statement) error
+	WriteEntries(entries []Entry) error
+	// Close writes the end of the statement and flushes it, it doesn't close the underlying writer
+	Close() error
+}
+
+// NewWriter returns a Writer for the export format, csv isn't a statement format and returns an error
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case util.ExportOFX:
+		return &ofxWriter{w: bufio.NewWriter(w)}, nil
+	case util.ExportQIF:
+		return &qifWriter{w: bufio.NewWriter(w)}, nil
+	case util.ExportCAMT053:
+		return &camt053Writer{w: bufio.NewWriter(w)}, nil
+	}
+	return nil, fmt.Errorf("unsupported statement format %q", format)
+}
+
+// IsStatementFormat returns true if NewWriter supports the export format
+func IsStatementFormat(format string) bool {
+	switch format {
+	case util.ExportOFX, util.ExportQIF, util.ExportCAMT053:
+		return true
+	}
+	return false
+}
+
+// Extension returns the file extension of the export format, csv for unknown formats
+func Extension(format string) string {
+	switch format {
+	case util.ExportOFX:
+		return "ofx"
+	case util.ExportQIF:
+		return "qif"
+	case util.ExportCAMT053:
+		return "xml"
+	}
+	return "csv"
+}
+
+// ContentType returns the MIME type of the export format, text/csv for unknown formats
+func ContentType(format string) string {
+	switch format {
+	case util.ExportOFX:
+		return "application/x-ofx"
+	case util.ExportQIF:
+		return "application/qif"
+	case util.ExportCAMT053:
+		return "application/xml"
+	}
+	return "text/csv"
+}
+
+// description 是记账软件里显示的收款人或者付款人，我们只知道对方的账户 ID
+func (entry Entry) description() string {
+	if entry.CounterpartyAccountID != 0 {
+		return fmt.Sprintf("Account #%d", entry.CounterpartyAccountID)
+	}
+	if entry.TransferID == 0 {
+		return "Deposit"
+	}
+	return fmt.Sprintf("Transfer #%d", entry.TransferID)
+}
+
+// decimal formats an amount in minor units of the currency, e.g. "-12.34"
+func decimal(amount int64, currency string) string {
+	return util.Money{Amount: amount, Currency: currency}.Decimal()
+}
+
+// singleLine replaces line breaks, which would end a field in QIF or break OFX readers, and truncates value to max runes
+func singleLine(value string, max int) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if utf8.RuneCountInString(value) <= max {
+		return value
+	}
+	return string([]rune(value)[:max])
+}
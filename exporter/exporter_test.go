@@ -0,0 +1,160 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+var (
+	testStatement = Statement{
+		ID:             "export-7",
+		AccountNumber:  "4000123412341234",
+		Owner:          "Alice & Bob",
+		Currency:       util.USD,
+		OpeningBalance: 10000,
+		ClosingBalance: 8550,
+		From:           time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		To:             time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC),
+		CreatedAt:      time.Date(2024, time.April, 1, 8, 30, 0, 0, time.UTC),
+	}
+	testEntries = []Entry{
+		{ID: 3, TransferID: 1, Amount: -1500, BookedAt: time.Date(2024, time.March, 2, 9, 0, 0, 0, time.UTC), Memo: "lunch\n<tea>", Reference: "INV-1", CounterpartyAccountID: 6},
+		{ID: 4, TransferID: 2, Amount: 50, BookedAt: time.Date(2024, time.March, 3, 9, 0, 0, 0, time.UTC), CounterpartyAccountID: 6},
+	}
+)
+
+func writeStatement(t *testing.T, format string) string {
+	var buf bytes.Buffer
+	writer, err := NewWriter(format, &buf)
+	require.NoError(t, err)
+
+	require.NoError(t, writer.WriteHeader(testStatement))
+	// 分页写入和一次写入的结果一样
+	require.NoError(t, writer.WriteEntries(testEntries[:1]))
+	require.NoError(t, writer.WriteEntries(testEntries[1:]))
+	require.NoError(t, writer.WriteEntries(nil))
+	require.NoError(t, writer.Close())
+	return buf.String()
+}
+
+func TestOFX(t *testing.T) {
+	content := writeStatement(t, util.ExportOFX)
+
+	require.True(t, strings.HasPrefix(content, `<?xml version="1.0"`))
+	require.Contains(t, content, "<CURDEF>USD</CURDEF>")
+	require.Contains(t, content, "<ACCTID>4000123412341234</ACCTID>")
+	require.Contains(t, content, "<DTSTART>20240301000000[0:GMT]</DTSTART>")
+	require.Contains(t, content, "<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240302090000[0:GMT]</DTPOSTED><TRNAMT>-15.00</TRNAMT><FITID>3</FITID>"+
+		"<REFNUM>INV-1</REFNUM><NAME>Account #6</NAME><MEMO>lunch &lt;tea&gt;</MEMO></STMTTRN>\n")
+	require.Contains(t, content, "<STMTTRN><TRNTYPE>CREDIT</TRNTYPE><DTPOSTED>20240303090000[0:GMT]</DTPOSTED><TRNAMT>0.50</TRNAMT><FITID>4</FITID>"+
+		"<NAME>Account #6</NAME></STMTTRN>\n")
+	require.Contains(t, content, "<LEDGERBAL><BALAMT>85.50</BALAMT><DTASOF>20240331120000[0:GMT]</DTASOF></LEDGERBAL>")
+
+	// OFX 2 是合法的 XML
+	requireWellFormedXML(t, content)
+}
+
+func TestQIF(t *testing.T) {
+	content := writeStatement(t, util.ExportQIF)
+
+	require.Equal(t, "!Type:Bank\n"+
+		"D03/01/2024\nT100.00\nPOpening Balance\nL[4000123412341234]\n^\n"+
+		"D03/02/2024\nT-15.00\nNINV-1\nPAccount #6\nMlunch <tea>\n^\n"+
+		"D03/03/2024\nT0.50\nPAccount #6\n^\n", content)
+}
+
+func TestCAMT053(t *testing.T) {
+	content := writeStatement(t, util.ExportCAMT053)
+	requireWellFormedXML(t, content)
+
+	var document struct {
+		XMLName xml.Name `xml:"urn:iso:std:iso:20022:tech:xsd:camt.053.001.02 Document"`
+		Stmt    struct {
+			ID   string `xml:"Id"`
+			Acct struct {
+				ID   string `xml:"Id>Othr>Id"`
+				Ccy  string `xml:"Ccy"`
+				Ownr string `xml:"Ownr>Nm"`
+			} `xml:"Acct"`
+			Bal []struct {
+				Cd        string `xml:"Tp>CdOrPrtry>Cd"`
+				Amt       string `xml:"Amt"`
+				CdtDbtInd string `xml:"CdtDbtInd"`
+			} `xml:"Bal"`
+			Ntry []struct {
+				NtryRef    string `xml:"NtryRef"`
+				Amt        string `xml:"Amt"`
+				CdtDbtInd  string `xml:"CdtDbtInd"`
+				EndToEndID string `xml:"NtryDtls>TxDtls>Refs>EndToEndId"`
+				TxID       string `xml:"NtryDtls>TxDtls>Refs>TxId"`
+				Ustrd      string `xml:"NtryDtls>TxDtls>RmtInf>Ustrd"`
+			} `xml:"Ntry"`
+		} `xml:"BkToCstmrStmt>Stmt"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(content), &document))
+
+	require.Equal(t, "export-7", document.Stmt.ID)
+	require.Equal(t, "4000123412341234", document.Stmt.Acct.ID)
+	require.Equal(t, util.USD, document.Stmt.Acct.Ccy)
+	require.Equal(t, "Alice & Bob", document.Stmt.Acct.Ownr)
+
+	require.Len(t, document.Stmt.Bal, 2)
+	require.Equal(t, "OPBD", document.Stmt.Bal[0].Cd)
+	require.Equal(t, "100.00", document.Stmt.Bal[0].Amt)
+	require.Equal(t, "CLBD", document.Stmt.Bal[1].Cd)
+	require.Equal(t, "85.50", document.Stmt.Bal[1].Amt)
+
+	require.Len(t, document.Stmt.Ntry, 2)
+	require.Equal(t, "3", document.Stmt.Ntry[0].NtryRef)
+	require.Equal(t, "15.00", document.Stmt.Ntry[0].Amt)
+	require.Equal(t, "DBIT", document.Stmt.Ntry[0].CdtDbtInd)
+	require.Equal(t, "INV-1", document.Stmt.Ntry[0].EndToEndID)
+	require.Equal(t, "1", document.Stmt.Ntry[0].TxID)
+	require.Equal(t, "lunch <tea>", document.Stmt.Ntry[0].Ustrd)
+	require.Equal(t, "0.50", document.Stmt.Ntry[1].Amt)
+	require.Equal(t, "CRDT", document.Stmt.Ntry[1].CdtDbtInd)
+	require.Equal(t, "NOTPROVIDED", document.Stmt.Ntry[1].EndToEndID)
+}
+
+func TestNegativeBalanceCAMT053(t *testing.T) {
+	var buf bytes.Buffer
+	writer, err := NewWriter(util.ExportCAMT053, &buf)
+	require.NoError(t, err)
+
+	statement := testStatement
+	statement.ClosingBalance = -250
+	require.NoError(t, writer.WriteHeader(statement))
+	require.NoError(t, writer.Close())
+
+	require.Contains(t, buf.String(), `<Cd>CLBD</Cd></CdOrPrtry></Tp><Amt Ccy="USD">2.50</Amt><CdtDbtInd>DBIT</CdtDbtInd>`)
+}
+
+func TestNewWriter(t *testing.T) {
+	_, err := NewWriter(util.ExportCSV, &bytes.Buffer{})
+	require.Error(t, err)
+
+	require.False(t, IsStatementFormat(util.ExportCSV))
+	require.True(t, IsStatementFormat(util.ExportCAMT053))
+	require.Equal(t, "xml", Extension(util.ExportCAMT053))
+	require.Equal(t, "csv", Extension(""))
+	require.Equal(t, "application/x-ofx", ContentType(util.ExportOFX))
+	require.Equal(t, "text/csv", ContentType(util.ExportCSV))
+}
+
+func requireWellFormedXML(t *testing.T, content string) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	for {
+		_, err := decoder.Token()
+		if err != nil {
+			require.ErrorIs(t, err, io.EOF)
+			return
+		}
+	}
+}
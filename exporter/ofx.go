@@ -0,0 +1,102 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+ofxWriter 写 OFX 2.2 的银行对账单（STMTRS）。
+FITID 用分录的 ID，记账软件用它去重，所以同一个账户导出多次再导入也不会有重复的交易。
+OFX 没有账户的期初余额，LEDGERBAL 是期末余额，写在交易列表后面。
+*/
+type ofxWriter struct {
+	w         *bufio.Writer
+	statement Statement
+}
+
+// ofxTime formats t as an OFX datetime in UTC
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405") + "[0:GMT]"
+}
+
+// ofxText escapes value for the content of an OFX element
+func ofxText(value string, max int) string {
+	var builder strings.Builder
+	xml.EscapeText(&builder, []byte(singleLine(value, max)))
+	return builder.String()
+}
+
+func (writer *ofxWriter) WriteHeader(statement Statement) error {
+	writer.statement = statement
+	_, err := fmt.Fprintf(writer.w, `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+<OFX>
+<SIGNONMSGSRSV1>
+<SONRS>
+<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<DTSERVER>%s</DTSERVER>
+<LANGUAGE>ENG</LANGUAGE>
+</SONRS>
+</SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>%s</TRNUID>
+<STATUS><CODE>0</CODE><SEVERITY>INFO</SEVERITY></STATUS>
+<STMTRS>
+<CURDEF>%s</CURDEF>
+<BANKACCTFROM><BANKID>%s</BANKID><ACCTID>%s</ACCTID><ACCTTYPE>CHECKING</ACCTTYPE></BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>%s</DTSTART>
+<DTEND>%s</DTEND>
+`,
+		ofxTime(statement.CreatedAt),
+		ofxText(statement.ID, 36),
+		statement.Currency,
+		BankID,
+		ofxText(statement.AccountNumber, 22),
+		ofxTime(statement.From),
+		ofxTime(statement.To),
+	)
+	return err
+}
+
+func (writer *ofxWriter) WriteEntries(entries []Entry) error {
+	for _, entry := range entries {
+		trnType := "CREDIT"
+		if entry.Amount < 0 {
+			trnType = "DEBIT"
+		}
+
+		fmt.Fprintf(writer.w, "<STMTTRN><TRNTYPE>%s</TRNTYPE><DTPOSTED>%s</DTPOSTED><TRNAMT>%s</TRNAMT><FITID>%d</FITID>",
+			trnType, ofxTime(entry.BookedAt), decimal(entry.Amount, writer.statement.Currency), entry.ID)
+		if entry.Reference != "" {
+			fmt.Fprintf(writer.w, "<REFNUM>%s</REFNUM>", ofxText(entry.Reference, 32))
+		}
+		fmt.Fprintf(writer.w, "<NAME>%s</NAME>", ofxText(entry.description(), 32))
+		if entry.Memo != "" {
+			fmt.Fprintf(writer.w, "<MEMO>%s</MEMO>", ofxText(entry.Memo, 255))
+		}
+		if _, err := writer.w.WriteString("</STMTTRN>\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (writer *ofxWriter) Close() error {
+	fmt.Fprintf(writer.w, `</BANKTRANLIST>
+<LEDGERBAL><BALAMT>%s</BALAMT><DTASOF>%s</DTASOF></LEDGERBAL>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`,
+		decimal(writer.statement.ClosingBalance, writer.statement.Currency),
+		ofxTime(writer.statement.To),
+	)
+	return writer.w.Flush()
+}
@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"bufio"
+	"fmt"
+)
+
+/*
+qifWriter 写 QIF 的银行账户（!Type:Bank）。QIF 没有余额，按 Quicken 的习惯，
+期初余额不为 0 时第一条记录是收款人为 "Opening Balance"、类别为 [账号] 的交易，导入后余额才对得上。
+QIF 每行是一个字段，所以备注里的换行要去掉；日期用美国格式 MM/DD/YYYY，按 UTC。
+*/
+type qifWriter struct {
+	w        *bufio.Writer
+	currency string
+}
+
+const qifDate = "01/02/2006"
+
+func (writer *qifWriter) WriteHeader(statement Statement) error {
+	writer.currency = statement.Currency
+	fmt.Fprint(writer.w, "!Type:Bank\n")
+	if statement.OpeningBalance == 0 {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(writer.w, "D%s\nT%s\nPOpening Balance\nL[%s]\n^\n",
+		statement.From.UTC().Format(qifDate),
+		decimal(statement.OpeningBalance, statement.Currency),
+		singleLine(statement.AccountNumber, 64),
+	)
+	return err
+}
+
+func (writer *qifWriter) WriteEntries(entries []Entry) error {
+	for _, entry := range entries {
+		fmt.Fprintf(writer.w, "D%s\nT%s\n", entry.BookedAt.UTC().Format(qifDate), decimal(entry.Amount, writer.currency))
+		if entry.Reference != "" {
+			fmt.Fprintf(writer.w, "N%s\n", singleLine(entry.Reference, 64))
+		}
+		fmt.Fprintf(writer.w, "P%s\n", entry.description())
+		if entry.Memo != "" {
+			fmt.Fprintf(writer.w, "M%s\n", singleLine(entry.Memo, 255))
+		}
+		if _, err := writer.w.WriteString("^\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (writer *qifWriter) Close() error {
+	return writer.w.Flush()
+}
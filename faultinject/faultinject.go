@@ -0,0 +1,176 @@
+package faultinject
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+faultinject 在代码里预先埋好的故障点（Point）上注入错误，用来验证失败时的处理逻辑，
+比如事务回滚、outbox 重新发布、asynq 任务重试，而不需要真的把数据库、Redis 或者邮件服务停掉。
+
+业务代码在故障点调用 Check，没有注入故障时返回 nil；
+没有打开故障注入时 Check 只读一个原子变量，对正常请求几乎没有开销。
+
+两种用法：
+  - 测试里调用 Enable，再用 Inject 给某个故障点注入错误，测试结束时调用 Reset。
+    注入的状态是全局的，用到它的测试不能并行执行。
+  - staging 环境通过 FAULT_INJECTION 配置，格式是逗号分隔的 point[:次数]，
+    比如 "after_create_transfer:1,send_email"，没有次数的故障一直生效。修改 app.env 之后热更新。
+
+生产环境（ENVIRONMENT=production）永远不会打开故障注入，Configure 会清空所有故障，Inject 返回 ErrDisabled。
+*/
+
+// Names of the points where faults can be injected
+const (
+	// AfterCreateTransfer fails TransferTx after the transfer row is inserted, so the transaction is rolled back
+	AfterCreateTransfer = "after_create_transfer"
+	// BeforeCreateEntry fails TransferTx before the entries are inserted, it's checked once per transfer
+	BeforeCreateEntry = "before_create_entry"
+	// EnqueueTask simulates a Redis outage when a task is distributed
+	EnqueueTask = "enqueue_task"
+	// SendEmail simulates an SMTP (or email provider) failure
+	SendEmail = "send_email"
+)
+
+// Points lists all the points that can be configured with FAULT_INJECTION
+var Points = []string{AfterCreateTransfer, BeforeCreateEntry, EnqueueTask, SendEmail}
+
+var (
+	// ErrInjected is the error returned by Check when the fault doesn't specify one
+	ErrInjected = errors.New("injected fault")
+	// ErrDisabled is returned by Inject when fault injection isn't enabled
+	ErrDisabled = errors.New("fault injection is disabled")
+)
+
+// Fault is the failure injected at a point
+type Fault struct {
+	// Err is returned by Check, ErrInjected is used if it's nil
+	Err error
+	// Times is how many times the fault is returned before it's removed, 0 means forever
+	Times int
+}
+
+var (
+	enabled atomic.Bool
+
+	mu     sync.Mutex
+	faults = map[string]*Fault{}
+)
+
+// Enable turns on fault injection in tests, servers are configured with Configure
+func Enable() {
+	enabled.Store(true)
+}
+
+// Reset removes all faults and turns off fault injection
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	enabled.Store(false)
+	faults = map[string]*Fault{}
+}
+
+// Configure replaces the faults with the ones in FAULT_INJECTION, fault injection is always off in production
+func Configure(config util.Config) error {
+	if config.Environment == util.ProductionEnvironment {
+		Reset()
+		return nil
+	}
+
+	configured, err := Parse(config.FaultInjection)
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	// 没有配置故障时关掉，Check 不用再加锁
+	enabled.Store(len(configured) > 0)
+	faults = configured
+	return nil
+}
+
+// Parse parses the value of FAULT_INJECTION
+func Parse(spec string) (map[string]*Fault, error) {
+	parsed := map[string]*Fault{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		point, times, hasTimes := strings.Cut(item, ":")
+		if !slices.Contains(Points, point) {
+			return nil, fmt.Errorf("unknown fault injection point %q", point)
+		}
+
+		fault := &Fault{}
+		if hasTimes {
+			n, err := strconv.Atoi(times)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid number of times for fault injection point %q", point)
+			}
+			fault.Times = n
+		}
+		parsed[point] = fault
+	}
+	return parsed, nil
+}
+
+// Inject makes Check return the fault at the point, replacing the previous fault
+func Inject(point string, fault Fault) error {
+	if !enabled.Load() {
+		return ErrDisabled
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	faults[point] = &fault
+	return nil
+}
+
+// Clear removes the fault at the point
+func Clear(point string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(faults, point)
+}
+
+// Check returns the error of the fault injected at the point, or nil if there is none
+func Check(point string) error {
+	if !enabled.Load() {
+		return nil
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	fault, ok := faults[point]
+	if !ok {
+		return nil
+	}
+
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			delete(faults, point)
+		}
+	}
+
+	if fault.Err != nil {
+		return fault.Err
+	}
+	return fmt.Errorf("%w at %s", ErrInjected, point)
+}
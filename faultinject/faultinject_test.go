@@ -0,0 +1,79 @@
+package faultinject
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestParse(t *testing.T) {
+	faults, err := Parse(" after_create_transfer:2, send_email ,")
+	require.NoError(t, err)
+	require.Len(t, faults, 2)
+	require.Equal(t, 2, faults[AfterCreateTransfer].Times)
+	require.Equal(t, 0, faults[SendEmail].Times)
+
+	faults, err = Parse("")
+	require.NoError(t, err)
+	require.Empty(t, faults)
+
+	_, err = Parse("drop_database")
+	require.ErrorContains(t, err, "unknown fault injection point")
+
+	_, err = Parse("send_email:0")
+	require.ErrorContains(t, err, "invalid number of times")
+
+	_, err = Parse("send_email:x")
+	require.ErrorContains(t, err, "invalid number of times")
+}
+
+func TestInject(t *testing.T) {
+	t.Cleanup(Reset)
+
+	require.ErrorIs(t, Inject(SendEmail, Fault{}), ErrDisabled)
+	require.NoError(t, Check(SendEmail))
+
+	Enable()
+	smtpErr := errors.New("smtp: 421 service not available")
+	require.NoError(t, Inject(SendEmail, Fault{Err: smtpErr}))
+	require.NoError(t, Inject(EnqueueTask, Fault{Times: 2}))
+
+	// 没有次数的故障一直生效
+	require.ErrorIs(t, Check(SendEmail), smtpErr)
+	require.ErrorIs(t, Check(SendEmail), smtpErr)
+
+	require.ErrorIs(t, Check(EnqueueTask), ErrInjected)
+	require.ErrorIs(t, Check(EnqueueTask), ErrInjected)
+	require.NoError(t, Check(EnqueueTask))
+
+	require.NoError(t, Check(AfterCreateTransfer))
+
+	Clear(SendEmail)
+	require.NoError(t, Check(SendEmail))
+
+	Reset()
+	require.ErrorIs(t, Inject(SendEmail, Fault{}), ErrDisabled)
+}
+
+func TestConfigure(t *testing.T) {
+	t.Cleanup(Reset)
+
+	require.NoError(t, Configure(util.Config{FaultInjection: "before_create_entry:1"}))
+	require.ErrorIs(t, Check(BeforeCreateEntry), ErrInjected)
+	require.NoError(t, Check(BeforeCreateEntry))
+
+	// 配置错误时保留原来的故障
+	require.NoError(t, Configure(util.Config{FaultInjection: "send_email"}))
+	require.Error(t, Configure(util.Config{FaultInjection: "send_email,unknown"}))
+	require.ErrorIs(t, Check(SendEmail), ErrInjected)
+
+	// 生产环境永远不注入故障
+	require.NoError(t, Configure(util.Config{
+		Environment:    util.ProductionEnvironment,
+		FaultInjection: "send_email",
+	}))
+	require.NoError(t, Check(SendEmail))
+	require.ErrorIs(t, Inject(SendEmail, Fault{}), ErrDisabled)
+}
@@ -0,0 +1,110 @@
+package flags
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+Flags 从 feature_flags 表读取功能开关，用来逐步上线新功能：
+  - enabled 为 false 时所有人都看不到这个功能；
+  - enabled 为 true 时，按用户名哈希到 0-99 的桶里，桶号小于 rollout_percentage 的用户能看到，
+    同一个用户每次的结果都一样，调高百分比时已经看到的用户不会又看不到。
+
+每次查询都读数据库太慢，所以所有开关缓存在内存里，超过 FEATURE_FLAG_CACHE_TTL 之后下一次查询时重新加载，
+多个实例之间最多有一个 TTL 的延迟。管理员修改开关之后调用 Invalidate，当前实例马上生效。
+重新加载失败时继续用旧的缓存；从来没有加载成功过（或者表里没有这个开关）时，功能是关闭的。
+*/
+
+// Names of the feature flags used in the code, a flag that is not in the table is disabled
+const (
+	// MultiCurrencyTransfers allows transfers between accounts of different currencies
+	MultiCurrencyTransfers = "multi_currency_transfers"
+	// TwoFactorEnforcement requires a second factor when logging in
+	TwoFactorEnforcement = "two_factor_enforcement"
+)
+
+// defaultCacheTTL 在没有配置 FEATURE_FLAG_CACHE_TTL 时使用
+const defaultCacheTTL = 30 * time.Second
+
+// Store is the part of db.Store used by Flags
+type Store interface {
+	ListFeatureFlags(ctx context.Context) ([]db.FeatureFlag, error)
+}
+
+type Flags struct {
+	store Store
+	ttl   time.Duration
+
+	mu       sync.Mutex
+	flags    map[string]db.FeatureFlag
+	loadedAt time.Time
+}
+
+// New creates Flags that cache the table for ttl
+func New(store Store, ttl time.Duration) *Flags {
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+	return &Flags{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// Enabled reports whether the feature is turned on for the user, username can be empty for requests without a user
+func (flags *Flags) Enabled(ctx context.Context, name string, username string) bool {
+	flag, ok := flags.lookup(ctx, name)
+	if !ok || !flag.Enabled {
+		return false
+	}
+	if flag.RolloutPercentage >= 100 {
+		return true
+	}
+	// 没有登录的请求不参与百分比发布
+	if username == "" {
+		return false
+	}
+	return bucket(name, username) < flag.RolloutPercentage
+}
+
+// Invalidate drops the cache so the next lookup reloads the flags from the database
+func (flags *Flags) Invalidate() {
+	flags.mu.Lock()
+	defer flags.mu.Unlock()
+	flags.loadedAt = time.Time{}
+}
+
+func (flags *Flags) lookup(ctx context.Context, name string) (db.FeatureFlag, bool) {
+	flags.mu.Lock()
+	defer flags.mu.Unlock()
+
+	if time.Since(flags.loadedAt) >= flags.ttl {
+		list, err := flags.store.ListFeatureFlags(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("cannot load feature flags, keep using the cached ones")
+		} else {
+			flags.flags = make(map[string]db.FeatureFlag, len(list))
+			for _, flag := range list {
+				flags.flags[flag.Name] = flag
+			}
+		}
+		// 失败时也更新时间，数据库出问题的时候不会每个请求都去重试
+		flags.loadedAt = time.Now()
+	}
+
+	flag, ok := flags.flags[name]
+	return flag, ok
+}
+
+// bucket 把用户分到 0-99 的桶里，开关名也参与哈希，这样不同开关的 10% 不是同一批用户
+func bucket(name string, username string) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(name + ":" + username))
+	return int32(h.Sum32() % 100)
+}
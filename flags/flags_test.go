@@ -0,0 +1,90 @@
+package flags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+func TestEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		ListFeatureFlags(gomock.Any()).
+		Times(1).
+		Return([]db.FeatureFlag{
+			{Name: "on", Enabled: true, RolloutPercentage: 100},
+			{Name: "off", Enabled: false, RolloutPercentage: 100},
+			{Name: "half", Enabled: true, RolloutPercentage: 50},
+			{Name: "none", Enabled: true, RolloutPercentage: 0},
+		}, nil)
+
+	flags := New(store, time.Minute)
+	ctx := context.Background()
+
+	require.True(t, flags.Enabled(ctx, "on", ""))
+	require.True(t, flags.Enabled(ctx, "on", "alice"))
+	require.False(t, flags.Enabled(ctx, "off", "alice"))
+	require.False(t, flags.Enabled(ctx, "missing", "alice"))
+	require.False(t, flags.Enabled(ctx, "none", "alice"))
+	require.False(t, flags.Enabled(ctx, "half", ""))
+
+	// 同一个用户的结果是固定的，大约一半的用户能看到
+	enabled := 0
+	for i := 0; i < 1000; i++ {
+		username := fmt.Sprintf("user%d", i)
+		result := flags.Enabled(ctx, "half", username)
+		require.Equal(t, result, flags.Enabled(ctx, "half", username))
+		if result {
+			enabled++
+		}
+	}
+	require.InDelta(t, 500, enabled, 100)
+}
+
+func TestCacheAndInvalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	ctx := context.Background()
+
+	gomock.InOrder(
+		store.EXPECT().
+			ListFeatureFlags(gomock.Any()).
+			Return([]db.FeatureFlag{{Name: "feature", Enabled: true, RolloutPercentage: 100}}, nil),
+		store.EXPECT().
+			ListFeatureFlags(gomock.Any()).
+			Return([]db.FeatureFlag{{Name: "feature", Enabled: false, RolloutPercentage: 100}}, nil),
+		store.EXPECT().
+			ListFeatureFlags(gomock.Any()).
+			Return(nil, errors.New("connection refused")),
+	)
+
+	flags := New(store, time.Hour)
+	require.True(t, flags.Enabled(ctx, "feature", "alice"))
+	// 缓存没过期，不会再查数据库
+	require.True(t, flags.Enabled(ctx, "feature", "alice"))
+
+	flags.Invalidate()
+	require.False(t, flags.Enabled(ctx, "feature", "alice"))
+
+	// 加载失败时继续用旧的缓存
+	flags.Invalidate()
+	require.False(t, flags.Enabled(ctx, "feature", "alice"))
+}
+
+func TestBucketDependsOnFlag(t *testing.T) {
+	different := false
+	for i := 0; i < 100 && !different; i++ {
+		username := fmt.Sprintf("user%d", i)
+		different = bucket("a", username) != bucket("b", username)
+	}
+	require.True(t, different)
+}
@@ -2,11 +2,18 @@ package gapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
+	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -24,6 +31,26 @@ authorizeUser方法用于校验gRPC请求中的授权信息。
 */
 
 func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []string) (*token.Payload, error) {
+	// 经过 gRPC 拦截器的请求已经在 authenticate 里验证过令牌，HTTP 网关直接调用的请求在这里验证
+	payload, ok := authPayloadFromContext(ctx)
+	if !ok {
+		var err error
+		payload, err = server.authenticateUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		setLoggerUser(ctx, payload)
+	}
+
+	if !hasPermission(payload.Role, accessibleRoles) {
+		return nil, fmt.Errorf("permission denied")
+	}
+
+	return payload, nil
+}
+
+// authenticateUser 验证 authorization 头里的访问令牌，不检查角色
+func (server *Server) authenticateUser(ctx context.Context) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("missing metadata")
@@ -34,7 +61,11 @@ func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []strin
 		return nil, fmt.Errorf("missing authorization header")
 	}
 
-	authHeader := values[0]
+	return server.verifyAuthorizationHeader(ctx, values[0])
+}
+
+// verifyAuthorizationHeader 解析 "Bearer <token>" 格式的头并验证令牌，gRPC 和 HTTP 的认证共用
+func (server *Server) verifyAuthorizationHeader(ctx context.Context, authHeader string) (*token.Payload, error) {
 	fields := strings.Fields(authHeader)
 	if len(fields) < 2 {
 		return nil, fmt.Errorf("invalid authorization header format")
@@ -51,13 +82,70 @@ func (server *Server) authorizeUser(ctx context.Context, accessibleRoles []strin
 		return nil, fmt.Errorf("invalid access token: %s", err)
 	}
 
-	if !hasPermission(payload.Role, accessibleRoles) {
-		return nil, fmt.Errorf("permission denied")
+	// 被管理员撤销的令牌在过期之前也不能再用
+	revoked, err := server.revocationStore.IsRevoked(ctx, payload.ID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check access token: %s", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("invalid access token: %s", token.ErrRevokedToken)
+	}
+
+	// 管理员禁用用户或者强制重置密码之前签发的令牌也不能再用
+	revoked, err = server.revocationStore.IsUserRevoked(ctx, payload.Username, payload.IssuedAt)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check access token: %s", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf("invalid access token: %s", token.ErrRevokedToken)
 	}
 
 	return payload, nil
 }
 
+type authPayloadKey struct{}
+
+/*
+authenticate 是拦截器链里的认证步骤：请求带了有效的访问令牌时，把 payload 放进 ctx，
+后面的限流按用户名计算，处理函数里的 authorizeUser 也不用再验证一次。
+
+令牌无效或者没有令牌时不拒绝请求，LoginUser 这样的公开接口不需要令牌，
+需要登录的接口由 authorizeUser 返回 Unauthenticated。
+*/
+func (server *Server) authenticate(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if payload, err := server.authenticateUser(ctx); err == nil {
+		ctx = context.WithValue(ctx, authPayloadKey{}, payload)
+		setLoggerUser(ctx, payload)
+	}
+	return handler(ctx, req)
+}
+
+/*
+HttpAuthenticate 是不经过 gRPC 拦截器的 HTTP 接口（比如 GraphQL）用的认证中间件，规则和 authenticate 一样：
+令牌有效时把 payload 放进请求的 ctx，处理函数用 AuthPayload 取出来；没有令牌或者令牌无效时不拒绝请求。
+*/
+func (server *Server) HttpAuthenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if authHeader := req.Header.Get(authorizationHeader); authHeader != "" {
+			if payload, err := server.verifyAuthorizationHeader(req.Context(), authHeader); err == nil {
+				req = req.WithContext(context.WithValue(req.Context(), authPayloadKey{}, payload))
+				setLoggerUser(req.Context(), payload)
+			}
+		}
+		handler.ServeHTTP(res, req)
+	})
+}
+
+// AuthPayload returns the payload of the access token verified by the authentication middleware.
+func AuthPayload(ctx context.Context) (*token.Payload, bool) {
+	return authPayloadFromContext(ctx)
+}
+
+func authPayloadFromContext(ctx context.Context) (*token.Payload, bool) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+	return payload, ok
+}
+
 func hasPermission(userRole string, accessibleRoles []string) bool {
 	for _, role := range accessibleRoles {
 		if userRole == role {
@@ -66,3 +154,57 @@ func hasPermission(userRole string, accessibleRoles []string) bool {
 	}
 	return false
 }
+
+/*
+authorizeAccount 查询账户并确认当前用户可以访问它：账户的所有者本人，或者 banker。
+返回的 error 已经是 gRPC status 错误，调用方可以直接返回。
+*/
+func (server *Server) authorizeAccount(ctx context.Context, authPayload *token.Payload, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return account, status.Errorf(codes.NotFound, "account not found")
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	return account, checkAccountAccess(authPayload, account)
+}
+
+// checkAccountAccess 是 authorizeAccount 里不查数据库的那部分，BatchGetAccounts 对每个账户分别调用
+func checkAccountAccess(authPayload *token.Payload, account db.Account) error {
+	if authPayload.Role != util.BankerRole && account.Owner != authPayload.Username {
+		return status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+	return nil
+}
+
+// authorizeBalanceAlert 查询余额提醒并确认当前用户可以访问它所属的账户
+func (server *Server) authorizeBalanceAlert(ctx context.Context, authPayload *token.Payload, id int64) (db.BalanceAlert, error) {
+	alert, err := server.store.GetBalanceAlert(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return alert, status.Errorf(codes.NotFound, "balance alert not found")
+		}
+		return alert, status.Errorf(codes.Internal, "failed to get balance alert: %s", err)
+	}
+
+	_, err = server.authorizeAccount(ctx, authPayload, alert.AccountID)
+	return alert, err
+}
+
+// authorizeDispute 查询争议并确认当前用户可以访问它：打开争议的用户本人，或者 banker
+func (server *Server) authorizeDispute(ctx context.Context, authPayload *token.Payload, id int64) (db.Dispute, error) {
+	dispute, err := server.store.GetDispute(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return dispute, status.Errorf(codes.NotFound, "dispute not found")
+		}
+		return dispute, status.Errorf(codes.Internal, "failed to get dispute: %s", err)
+	}
+
+	if authPayload.Role != util.BankerRole && dispute.OpenedBy != authPayload.Username {
+		return dispute, status.Errorf(codes.PermissionDenied, "dispute doesn't belong to the authenticated user")
+	}
+	return dispute, nil
+}
@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// uploadKYCDocumentPath 是 JSON 上传 KYC 证件的路径，证件内容是 base64，比原文件大三分之一
+const uploadKYCDocumentPath = "/v1/upload_kyc_document"
+
+/*
+maxBodySize 限制 HTTP 请求正文的大小（HTTP_MAX_BODY_SIZE），0 表示不限制。
+Content-Length 超过限制的请求直接返回 413；没有 Content-Length 的请求读到超过限制时停下来，
+GatewayErrorHandler 看到 requestBodyTooLarge 之后同样返回 413。
+上传 KYC 证件和头像的接口按 KYC_MAX_DOCUMENT_SIZE 和 AVATAR_MAX_SIZE 放宽，见 bodyLimit。
+*/
+func (server *Server) maxBodySize(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		limit := server.bodyLimit(req.URL.Path)
+		if limit <= 0 {
+			handler.ServeHTTP(res, req)
+			return
+		}
+		if req.ContentLength > limit {
+			http.Error(res, "request body too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		req.Body = &maxBytesBody{ReadCloser: http.MaxBytesReader(res, req.Body, limit)}
+		handler.ServeHTTP(res, req)
+	})
+}
+
+// bodyLimit 返回 path 允许的最大正文，上传 KYC 证件和头像的限制不会比 HTTP_MAX_BODY_SIZE 小
+func (server *Server) bodyLimit(path string) int64 {
+	limit := server.config.HTTPMaxBodySize
+	if limit <= 0 {
+		return 0
+	}
+
+	if documentSize := server.config.KYCMaxDocumentSize; documentSize > 0 {
+		var uploadLimit int64
+		switch path {
+		case KYCUploadFormPath:
+			uploadLimit = documentSize + multipartOverhead
+		case uploadKYCDocumentPath:
+			uploadLimit = (documentSize+2)/3*4 + multipartOverhead
+		}
+		limit = max(limit, uploadLimit)
+	}
+	if avatarSize := server.config.AvatarMaxSize; avatarSize > 0 && path == uploadUserAvatarPath {
+		limit = max(limit, (avatarSize+2)/3*4+multipartOverhead)
+	}
+	return limit
+}
+
+// maxBytesBody 记录正文有没有超过 http.MaxBytesReader 的限制
+type maxBytesBody struct {
+	io.ReadCloser
+	tooLarge bool
+}
+
+func (body *maxBytesBody) Read(p []byte) (int, error) {
+	n, err := body.ReadCloser.Read(p)
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		body.tooLarge = true
+	}
+	return n, err
+}
+
+// requestBodyTooLarge 判断处理请求失败是不是因为正文超过了 maxBodySize 的限制
+func requestBodyTooLarge(req *http.Request) bool {
+	body, ok := req.Body.(*maxBytesBody)
+	return ok && body.tooLarge
+}
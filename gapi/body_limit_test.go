@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestMaxBodySize(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	server.config.HTTPMaxBodySize = 16
+	server.config.KYCMaxDocumentSize = 1024
+
+	marshaler := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+	}
+	// 和 grpc-gateway 一样，读正文失败时把错误交给 GatewayErrorHandler
+	handler := server.maxBodySize(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if _, err := io.ReadAll(req.Body); err != nil {
+			GatewayErrorHandler(req.Context(), runtime.NewServeMux(), marshaler, res, req, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+		res.WriteHeader(http.StatusOK)
+	}))
+
+	testCases := []struct {
+		name          string
+		path          string
+		body          string
+		chunked       bool
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			path: "/v1/create_account",
+			body: strings.Repeat("a", 16),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "ContentLengthTooLarge",
+			path: "/v1/create_account",
+			body: strings.Repeat("a", 17),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+			},
+		},
+		{
+			name:    "ChunkedTooLarge",
+			path:    "/v1/create_account",
+			body:    strings.Repeat("a", 17),
+			chunked: true,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+
+				var body map[string]interface{}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				require.Equal(t, "request body too large", body["error"])
+			},
+		},
+		{
+			name: "KYCUpload",
+			path: KYCUploadFormPath,
+			body: strings.Repeat("a", 1024),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+			if tc.chunked {
+				req.ContentLength = -1
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	require.Zero(t, server.bodyLimit("/v1/create_account"))
+
+	server.config.HTTPMaxBodySize = 1 << 20
+	require.Equal(t, int64(1<<20), server.bodyLimit("/v1/create_account"))
+	require.Equal(t, int64(1<<20), server.bodyLimit(uploadKYCDocumentPath))
+
+	server.config.KYCMaxDocumentSize = 3 << 20
+	require.Equal(t, int64(3<<20+multipartOverhead), server.bodyLimit(KYCUploadFormPath))
+	require.Equal(t, int64(4<<20+multipartOverhead), server.bodyLimit(uploadKYCDocumentPath))
+}
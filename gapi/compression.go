@@ -0,0 +1,151 @@
+package gapi
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+/*
+Compress 是 HTTP 网关的压缩中间件，按照请求的 Accept-Encoding 使用 gzip 或 deflate 压缩响应。
+响应先缓存在内存里，超过 minSize 字节才开始压缩，小响应压缩反而更大，原样返回。
+minSize 小于等于 0 表示不压缩。
+*/
+func Compress(handler http.Handler, minSize int) http.Handler {
+	if minSize <= 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		encoding := negotiateEncoding(req.Header.Get("Accept-Encoding"))
+		if encoding == "" || req.Method == http.MethodHead {
+			handler.ServeHTTP(res, req)
+			return
+		}
+
+		res.Header().Add("Vary", "Accept-Encoding")
+		writer := &compressWriter{
+			ResponseWriter: res,
+			encoding:       encoding,
+			minSize:        minSize,
+			statusCode:     http.StatusOK,
+		}
+		defer writer.Close()
+
+		handler.ServeHTTP(writer, req)
+	})
+}
+
+// negotiateEncoding 选出客户端支持的编码，gzip 优先，q=0 表示不接受
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if strings.ReplaceAll(strings.TrimSpace(params), " ", "") == "q=0" {
+			continue
+		}
+		accepted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	switch {
+	case accepted["gzip"]:
+		return "gzip"
+	case accepted["deflate"]:
+		return "deflate"
+	default:
+		return ""
+	}
+}
+
+type compressWriter struct {
+	http.ResponseWriter
+	encoding    string
+	minSize     int
+	statusCode  int
+	buf         bytes.Buffer
+	compressor  io.WriteCloser
+	passthrough bool
+}
+
+func (writer *compressWriter) WriteHeader(statusCode int) {
+	writer.statusCode = statusCode
+}
+
+func (writer *compressWriter) Write(data []byte) (int, error) {
+	switch {
+	case writer.compressor != nil:
+		return writer.compressor.Write(data)
+	case writer.passthrough:
+		return writer.ResponseWriter.Write(data)
+	}
+
+	writer.buf.Write(data)
+	if writer.buf.Len() >= writer.minSize {
+		if err := writer.startCompression(); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// startCompression 写出响应头，并把缓存的内容交给压缩器
+func (writer *compressWriter) startCompression() error {
+	header := writer.Header()
+	if header.Get("Content-Encoding") != "" || writer.statusCode == http.StatusNoContent || writer.statusCode == http.StatusNotModified {
+		return writer.startPassthrough()
+	}
+
+	header.Set("Content-Encoding", writer.encoding)
+	header.Del("Content-Length")
+	writer.ResponseWriter.WriteHeader(writer.statusCode)
+
+	if writer.encoding == "gzip" {
+		writer.compressor = gzip.NewWriter(writer.ResponseWriter)
+	} else {
+		writer.compressor, _ = flate.NewWriter(writer.ResponseWriter, flate.DefaultCompression)
+	}
+
+	_, err := writer.compressor.Write(writer.buf.Bytes())
+	writer.buf.Reset()
+	return err
+}
+
+// startPassthrough 不压缩，原样写出缓存的内容
+func (writer *compressWriter) startPassthrough() error {
+	writer.passthrough = true
+	writer.ResponseWriter.WriteHeader(writer.statusCode)
+	_, err := writer.ResponseWriter.Write(writer.buf.Bytes())
+	writer.buf.Reset()
+	return err
+}
+
+// Flush 支持流式响应：还没决定是否压缩的时候，按照已经缓存的大小来决定
+func (writer *compressWriter) Flush() {
+	if writer.compressor == nil && !writer.passthrough {
+		if writer.buf.Len() >= writer.minSize {
+			writer.startCompression()
+		} else {
+			writer.startPassthrough()
+		}
+	}
+
+	if flusher, ok := writer.compressor.(interface{ Flush() error }); ok {
+		flusher.Flush()
+	}
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (writer *compressWriter) Close() error {
+	if writer.compressor != nil {
+		return writer.compressor.Close()
+	}
+	if !writer.passthrough {
+		return writer.startPassthrough()
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func serveCompressed(t *testing.T, body string, acceptEncoding string) *httptest.ResponseRecorder {
+	handler := Compress(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(http.StatusCreated)
+		io.WriteString(res, body)
+	}), 100)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/list_transfers", nil)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestCompressGzip(t *testing.T) {
+	body := strings.Repeat(`{"id":1,"amount":10},`, 50)
+
+	recorder := serveCompressed(t, body, "deflate, gzip;q=1.0")
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+	require.Equal(t, "Accept-Encoding", recorder.Header().Get("Vary"))
+	require.Less(t, recorder.Body.Len(), len(body))
+
+	reader, err := gzip.NewReader(recorder.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+}
+
+func TestCompressDeflate(t *testing.T) {
+	body := strings.Repeat("a", 500)
+
+	recorder := serveCompressed(t, body, "gzip;q=0, deflate")
+	require.Equal(t, "deflate", recorder.Header().Get("Content-Encoding"))
+
+	decoded, err := io.ReadAll(flate.NewReader(recorder.Body))
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+}
+
+func TestCompressSmallResponse(t *testing.T) {
+	recorder := serveCompressed(t, `{"id":1}`, "gzip")
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Content-Encoding"))
+	require.Equal(t, `{"id":1}`, recorder.Body.String())
+}
+
+func TestCompressNotAccepted(t *testing.T) {
+	body := strings.Repeat("a", 500)
+
+	recorder := serveCompressed(t, body, "")
+	require.Empty(t, recorder.Header().Get("Content-Encoding"))
+	require.Equal(t, body, recorder.Body.String())
+}
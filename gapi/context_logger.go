@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+/*
+每个请求都有一个自己的 logger，上面带着 request_id、trace_id、method，认证之后再加上 username。
+处理函数用 LoggerFrom(ctx) 记录日志，store 和 worker 分发这些拿到 ctx 的代码用 log.Ctx(ctx)，
+拿到的是同一个 logger，所以同一个请求的日志都能用 request_id 串起来。
+*/
+
+type requestLoggerKey struct{}
+
+// LoggerFrom returns the request-scoped logger in ctx, or the global logger when there is none
+func LoggerFrom(ctx context.Context) *zerolog.Logger {
+	if logger, ok := ctx.Value(requestLoggerKey{}).(*zerolog.Logger); ok {
+		return logger
+	}
+	return &log.Logger
+}
+
+// withRequestLogger 把 logger 同时放进 zerolog 的 ctx 和 requestLoggerKey，两边是同一个指针
+func withRequestLogger(ctx context.Context, logger zerolog.Logger) context.Context {
+	ctx = logger.WithContext(ctx)
+	return context.WithValue(ctx, requestLoggerKey{}, zerolog.Ctx(ctx))
+}
+
+/*
+setLoggerUser 在认证成功之后把用户名加到请求的 logger 上。
+logger 是在认证之前创建的，这里直接修改它，外层的访问日志也能带上用户名；没有请求的 logger 时什么都不做，
+不能改到全局的 logger。
+*/
+func setLoggerUser(ctx context.Context, payload *token.Payload) {
+	logger, ok := ctx.Value(requestLoggerKey{}).(*zerolog.Logger)
+	if !ok {
+		return
+	}
+	logger.UpdateContext(func(c zerolog.Context) zerolog.Context {
+		return c.Str("username", payload.Username)
+	})
+}
+
+/*
+ContextLogger 给每个 gRPC 请求创建一个 logger 放进 ctx。
+请求 ID 来自客户端传的 x-request-id，没有传的话生成一个，并在响应头里返回。
+放在 tracing 后面，这样 logger 上有 trace_id；放在日志拦截器前面，访问日志也用这个 logger。
+*/
+func ContextLogger(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && len(values[0]) <= 128 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	// 单元测试里直接调用拦截器时没有 stream，SetHeader 会失败，不影响处理
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	logger := log.With().
+		Str("request_id", requestID).
+		Str("method", info.FullMethod)
+	if trace, ok := util.TraceFromContext(ctx); ok {
+		logger = logger.Str("trace_id", trace.TraceID)
+	}
+
+	return handler(withRequestLogger(ctx, logger.Logger()), req)
+}
+
+// HttpContextLogger 是 HTTP 网关的 ContextLogger，放在 RequestID 和 HttpTracing 后面
+func HttpContextLogger(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		logger := log.With().
+			Str("request_id", req.Header.Get(RequestIDHeader)).
+			Str("method", req.Method)
+		if trace, ok := util.TraceFromContext(req.Context()); ok {
+			logger = logger.Str("trace_id", trace.TraceID)
+		}
+
+		handler.ServeHTTP(res, req.WithContext(withRequestLogger(req.Context(), logger.Logger())))
+	})
+}
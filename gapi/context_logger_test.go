@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestContextLogger(t *testing.T) {
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	server := newTestServer(t, nil, nil)
+	user, _ := randomUser(t, util.DepositorRole)
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/GetAccount"}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	md, _ := metadata.FromIncomingContext(ctx)
+	md.Set(RequestIDHeader, "request-123")
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	handler := chainHandler(server.UnaryInterceptors(), info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		LoggerFrom(ctx).Info().Msg("handler")
+		// store 里用的 log.Ctx 拿到的是同一个 logger
+		log.Ctx(ctx).Info().Msg("store")
+		return "ok", nil
+	})
+	_, err := handler(ctx, nil)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		require.Contains(t, line, `"request_id":"request-123"`)
+		require.Contains(t, line, `"method":"/pb.SimpleBank/GetAccount"`)
+		require.Contains(t, line, fmt.Sprintf(`"username":"%s"`, user.Username))
+		require.Contains(t, line, `"trace_id"`)
+		require.Equal(t, 1, strings.Count(line, `"username"`))
+	}
+
+	// 没有传请求 ID 时生成一个，匿名请求不带用户名
+	buf.Reset()
+	_, err = handler(context.Background(), nil)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `"request_id"`)
+	require.NotContains(t, buf.String(), `"request_id":""`)
+	require.NotContains(t, buf.String(), `"username"`)
+}
+
+func TestHttpContextLogger(t *testing.T) {
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	server := newTestServer(t, nil, nil)
+	user, _ := randomUser(t, util.BankerRole)
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	// 网关直接调用处理函数，认证在 authorizeUser 里完成，用户名在这之后才加到 logger 上
+	handler := server.HTTPHandler(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		md := metadata.MD{authorizationHeader: []string{req.Header.Get("Authorization")}}
+		ctx := metadata.NewIncomingContext(req.Context(), md)
+		_, err := server.authorizeUser(ctx, []string{util.BankerRole})
+		require.NoError(t, err)
+		LoggerFrom(ctx).Info().Msg("handler")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+	req.Header.Set(RequestIDHeader, "request-456")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		require.Contains(t, line, `"request_id":"request-456"`)
+		require.Contains(t, line, `"method":"GET"`)
+		require.Contains(t, line, fmt.Sprintf(`"username":"%s"`, user.Username))
+	}
+}
+
+func TestLoggerFromWithoutRequestLogger(t *testing.T) {
+	require.Same(t, &log.Logger, LoggerFrom(context.Background()))
+
+	// 没有请求的 logger 时不能改到全局的 logger
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	user, _ := randomUser(t, util.DepositorRole)
+	server := newTestServer(t, nil, nil)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err := server.authorizeUser(ctx, []string{util.DepositorRole})
+	require.NoError(t, err)
+
+	log.Info().Msg("global")
+	require.NotContains(t, buf.String(), "username")
+}
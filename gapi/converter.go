@@ -1,8 +1,12 @@
 package gapi
 
 import (
+	"strconv"
+
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -11,11 +15,340 @@ import (
 它主要用于在 CreateUser 和 LoginUser 方法中构造响应。
 */
 func convertUser(user db.User) *pb.User {
-	return &pb.User{
-		Username:          user.Username,
-		FullName:          user.FullName,
-		Email:             user.Email,
-		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
-		CreatedAt:         timestamppb.New(user.CreatedAt),
+	rsp := &pb.User{
+		Username:              user.Username,
+		FullName:              user.FullName,
+		Email:                 user.Email,
+		PasswordChangedAt:     timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:             timestamppb.New(user.CreatedAt),
+		Role:                  user.Role,
+		IsEmailVerified:       user.IsEmailVerified,
+		IsDisabled:            user.IsDisabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		Locale:                user.Locale,
+		UpdatedAt:             timestamppb.New(user.UpdatedAt),
+		AllowAliasTransfers:   user.AllowAliasTransfers,
+		PhoneNumber:           user.PhoneNumber,
+		Address:               user.Address,
+	}
+	if user.DateOfBirth.Valid {
+		rsp.DateOfBirth = user.DateOfBirth.Time.Format(val.DateOfBirthLayout)
+	}
+	if user.DeletionScheduledAt.Valid {
+		rsp.DeletionScheduledAt = timestamppb.New(user.DeletionScheduledAt.Time)
+	}
+	return rsp
+}
+
+func convertAccount(account db.Account) *pb.Account {
+	return &pb.Account{
+		Id:        account.ID,
+		Owner:     account.Owner,
+		Balance:   account.Balance,
+		Currency:  account.Currency,
+		CreatedAt: timestamppb.New(account.CreatedAt),
+		Number:    account.Number,
+		IsDefault: account.IsDefault,
+	}
+}
+
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	return &pb.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        transfer.Amount,
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+		Category:      transfer.Category,
+		Memo:          transfer.Memo,
+		Reference:     transfer.Reference,
+		ReversalOf:    transfer.ReversalOf.Int64,
+		FeeOf:         transfer.FeeOf.Int64,
+	}
+}
+
+func convertTransferQuote(quote db.TransferQuote) *pb.TransferQuote {
+	return &pb.TransferQuote{
+		Id:           quote.ID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		FromAmount:   quote.FromAmount,
+		Fee:          quote.Fee,
+		ToAmount:     quote.ToAmount,
+		Rate:         quote.Rate,
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+		CreatedAt:    timestamppb.New(quote.CreatedAt),
+	}
+}
+
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:         entry.ID,
+		AccountId:  entry.AccountID,
+		Amount:     entry.Amount,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		TransferId: entry.TransferID.Int64,
+	}
+}
+
+func convertMandate(mandate db.Mandate) *pb.Mandate {
+	rsp := &pb.Mandate{
+		Id:              mandate.ID,
+		PayerAccountId:  mandate.PayerAccountID,
+		PayeeAccountId:  mandate.PayeeAccountID,
+		MaxAmount:       mandate.MaxAmount,
+		CollectedAmount: mandate.CollectedAmount,
+		IsRevoked:       mandate.IsRevoked,
+		CreatedAt:       timestamppb.New(mandate.CreatedAt),
+	}
+	if mandate.RevokedAt.Valid {
+		rsp.RevokedAt = timestamppb.New(mandate.RevokedAt.Time)
+	}
+	return rsp
+}
+
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		Country:   session.Country,
+		City:      session.City,
+	}
+}
+
+func convertReconciliationReport(report db.ReconciliationReport, discrepancies []db.ReconciliationDiscrepancy) *pb.ReconciliationReport {
+	rsp := &pb.ReconciliationReport{
+		Id:               report.ID,
+		AccountsChecked:  report.AccountsChecked,
+		DiscrepancyCount: report.DiscrepancyCount,
+		Discrepancies:    make([]*pb.BalanceDiscrepancy, len(discrepancies)),
+		StartedAt:        timestamppb.New(report.StartedAt),
+		CreatedAt:        timestamppb.New(report.CreatedAt),
+	}
+	for i, discrepancy := range discrepancies {
+		rsp.Discrepancies[i] = &pb.BalanceDiscrepancy{
+			AccountId:      discrepancy.AccountID,
+			AccountBalance: discrepancy.AccountBalance,
+			EntriesBalance: discrepancy.EntriesBalance,
+		}
+	}
+	return rsp
+}
+
+func convertNotificationPreferences(preferences db.NotificationPreference) *pb.NotificationPreferences {
+	return &pb.NotificationPreferences{
+		EmailEnabled: preferences.EmailEnabled,
+		SmsEnabled:   preferences.SmsEnabled,
+		PushEnabled:  preferences.PushEnabled,
+		PhoneNumber:  preferences.PhoneNumber,
+		DeviceToken:  preferences.DeviceToken,
+		UpdatedAt:    timestamppb.New(preferences.UpdatedAt),
+	}
+}
+
+func convertCurrency(currency util.Currency) *pb.Currency {
+	return &pb.Currency{
+		Code:       currency.Code,
+		MinorUnits: int32(currency.MinorUnits),
+	}
+}
+
+func convertExternalTransfer(externalTransfer db.ExternalTransfer) *pb.ExternalTransfer {
+	return &pb.ExternalTransfer{
+		Id:            externalTransfer.ID,
+		AccountId:     externalTransfer.AccountID,
+		Amount:        externalTransfer.Amount,
+		Currency:      externalTransfer.Currency,
+		RoutingNumber: externalTransfer.RoutingNumber,
+		AccountNumber: externalTransfer.AccountNumber,
+		Status:        externalTransfer.Status,
+		ReturnReason:  externalTransfer.ReturnReason,
+		CreatedAt:     timestamppb.New(externalTransfer.CreatedAt),
+		UpdatedAt:     timestamppb.New(externalTransfer.UpdatedAt),
+	}
+}
+
+func convertCard(card db.Card) *pb.Card {
+	return &pb.Card{
+		Id:           card.ID,
+		AccountId:    card.AccountID,
+		MaskedNumber: util.MaskPAN(card.Last4),
+		ExpiryMonth:  card.ExpiryMonth,
+		ExpiryYear:   card.ExpiryYear,
+		Status:       card.Status,
+		CreatedAt:    timestamppb.New(card.CreatedAt),
+	}
+}
+
+func convertLoan(loan db.Loan) *pb.Loan {
+	return &pb.Loan{
+		Id:            loan.ID,
+		AccountId:     loan.AccountID,
+		Principal:     loan.Principal,
+		Currency:      loan.Currency,
+		AnnualRateBps: loan.AnnualRateBps,
+		TermMonths:    loan.TermMonths,
+		Status:        loan.Status,
+		CreatedAt:     timestamppb.New(loan.CreatedAt),
+	}
+}
+
+func convertLoanPayment(payment db.LoanPayment) *pb.LoanPayment {
+	return &pb.LoanPayment{
+		Installment: payment.Installment,
+		DueDate:     timestamppb.New(payment.DueDate),
+		Principal:   payment.Principal,
+		Interest:    payment.Interest,
+		Amount:      payment.Amount,
+		Status:      payment.Status,
+	}
+}
+
+// convertLoanInstallment converts an installment of an estimated schedule that isn't saved yet
+func convertLoanInstallment(installment util.LoanInstallment) *pb.LoanPayment {
+	return &pb.LoanPayment{
+		Installment: installment.Number,
+		DueDate:     timestamppb.New(installment.DueDate),
+		Principal:   installment.Principal,
+		Interest:    installment.Interest,
+		Amount:      installment.Amount,
+		Status:      util.LoanPaymentScheduled,
+	}
+}
+
+func convertKYCDocument(document db.KycDocument) *pb.KYCDocument {
+	rsp := &pb.KYCDocument{
+		Id:              document.ID,
+		Username:        document.Username,
+		DocumentType:    document.DocumentType,
+		ContentType:     document.ContentType,
+		SizeBytes:       document.SizeBytes,
+		Status:          document.Status,
+		RejectionReason: document.RejectionReason,
+		ReviewedBy:      document.ReviewedBy.String,
+		CreatedAt:       timestamppb.New(document.CreatedAt),
+	}
+	if document.ReviewedAt.Valid {
+		rsp.ReviewedAt = timestamppb.New(document.ReviewedAt.Time)
+	}
+	return rsp
+}
+
+// activityTypes 把 ListAccountActivity 返回的 kind 转成 proto 里的类型
+var activityTypes = map[string]pb.ActivityType{
+	util.ActivityTransfer:          pb.ActivityType_ACTIVITY_TYPE_TRANSFER,
+	util.ActivityDeposit:           pb.ActivityType_ACTIVITY_TYPE_DEPOSIT,
+	util.ActivityCardAuthorization: pb.ActivityType_ACTIVITY_TYPE_CARD_AUTHORIZATION,
+	util.ActivitySecurity:          pb.ActivityType_ACTIVITY_TYPE_SECURITY_EVENT,
+}
+
+// convertActivityItem 根据 kind 填充 oneof 里对应的字段
+func convertActivityItem(item db.ListAccountActivityRow) *pb.ActivityItem {
+	rsp := &pb.ActivityItem{
+		Type:       activityTypes[item.Kind],
+		OccurredAt: timestamppb.New(item.OccurredAt),
+	}
+
+	switch item.Kind {
+	case util.ActivityTransfer, util.ActivityDeposit:
+		entryID, _ := strconv.ParseInt(item.ItemID, 10, 64)
+		rsp.Details = &pb.ActivityItem_MoneyMovement{MoneyMovement: &pb.MoneyMovementActivity{
+			EntryId:               entryID,
+			TransferId:            item.TransferID,
+			Amount:                item.Amount,
+			CounterpartyAccountId: item.CounterpartyAccountID,
+			Memo:                  item.Description,
+		}}
+	case util.ActivityCardAuthorization:
+		holdID, _ := strconv.ParseInt(item.ItemID, 10, 64)
+		rsp.Details = &pb.ActivityItem_CardAuthorization{CardAuthorization: &pb.CardAuthorizationActivity{
+			HoldId:      holdID,
+			CardId:      item.CardID,
+			Amount:      item.Amount,
+			Status:      item.Status,
+			Description: item.Description,
+			TransferId:  item.TransferID,
+		}}
+	case util.ActivitySecurity:
+		rsp.Details = &pb.ActivityItem_SecurityEvent{SecurityEvent: &pb.SecurityEventActivity{
+			Event:     item.Status,
+			UserAgent: item.UserAgent,
+			ClientIp:  item.ClientIp,
+			Country:   item.Country,
+			City:      item.City,
+		}}
+	}
+	return rsp
+}
+
+func convertExportJob(job db.ExportJob) *pb.ExportJob {
+	rsp := &pb.ExportJob{
+		Id:            job.ID,
+		AccountId:     job.AccountID,
+		Kind:          job.Kind,
+		Status:        job.Status,
+		ProcessedRows: job.ProcessedRows,
+		TotalRows:     job.TotalRows,
+		Error:         job.Error,
+		CreatedAt:     timestamppb.New(job.CreatedAt),
+		Format:        job.Format,
+	}
+	if job.CompletedAt.Valid {
+		rsp.CompletedAt = timestamppb.New(job.CompletedAt.Time)
+	}
+	return rsp
+}
+
+func convertFeatureFlag(flag db.FeatureFlag) *pb.FeatureFlag {
+	return &pb.FeatureFlag{
+		Name:              flag.Name,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: flag.RolloutPercentage,
+		Description:       flag.Description,
+		UpdatedBy:         flag.UpdatedBy,
+		UpdatedAt:         timestamppb.New(flag.UpdatedAt),
+	}
+}
+
+func convertBalanceAlert(alert db.BalanceAlert) *pb.BalanceAlert {
+	return &pb.BalanceAlert{
+		Id:        alert.ID,
+		AccountId: alert.AccountID,
+		Kind:      alert.Kind,
+		Threshold: alert.Threshold,
+		CreatedAt: timestamppb.New(alert.CreatedAt),
+		UpdatedAt: timestamppb.New(alert.UpdatedAt),
+	}
+}
+
+func convertDispute(dispute db.Dispute) *pb.Dispute {
+	rsp := &pb.Dispute{
+		Id:               dispute.ID,
+		TransferId:       dispute.TransferID,
+		OpenedBy:         dispute.OpenedBy,
+		Reason:           dispute.Reason,
+		Status:           dispute.Status,
+		Resolution:       dispute.Resolution,
+		ResolvedBy:       dispute.ResolvedBy.String,
+		RefundTransferId: dispute.RefundTransferID.Int64,
+		CreatedAt:        timestamppb.New(dispute.CreatedAt),
+		UpdatedAt:        timestamppb.New(dispute.UpdatedAt),
+	}
+	if dispute.ResolvedAt.Valid {
+		rsp.ResolvedAt = timestamppb.New(dispute.ResolvedAt.Time)
+	}
+	return rsp
+}
+
+func convertDisputeNote(note db.DisputeNote) *pb.DisputeNote {
+	return &pb.DisputeNote{
+		Id:        note.ID,
+		DisputeId: note.DisputeID,
+		Author:    note.Author,
+		Note:      note.Note,
+		CreatedAt: timestamppb.New(note.CreatedAt),
 	}
 }
@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	db "github.com/techschool/bank/db/sqlc"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+/*
+这些辅助函数把数据库层的结构转换为 v2 的 proto 消息。
+v2 的金额都是 pbv2.Money，转账和流水本身没有币种，币种取自它们所属的账户
+（同一笔转账的两个账户币种一定相同）。
+*/
+func convertMoneyV2(amount int64, currency string) *pbv2.Money {
+	return &pbv2.Money{
+		Currency:    currency,
+		AmountMinor: amount,
+		Amount:      util.Money{Amount: amount, Currency: currency}.Decimal(),
+	}
+}
+
+func convertUserV2(user db.User) *pbv2.User {
+	return &pbv2.User{
+		Username:            user.Username,
+		FullName:            user.FullName,
+		Email:               user.Email,
+		Role:                user.Role,
+		IsEmailVerified:     user.IsEmailVerified,
+		PasswordChangedAt:   timestamppb.New(user.PasswordChangedAt),
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		Locale:              user.Locale,
+		UpdatedAt:           timestamppb.New(user.UpdatedAt),
+		AllowAliasTransfers: user.AllowAliasTransfers,
+	}
+}
+
+func convertAccountV2(account db.Account) *pbv2.Account {
+	return &pbv2.Account{
+		Id:        account.ID,
+		Owner:     account.Owner,
+		Balance:   convertMoneyV2(account.Balance, account.Currency),
+		CreatedAt: timestamppb.New(account.CreatedAt),
+		Number:    account.Number,
+		IsDefault: account.IsDefault,
+	}
+}
+
+func convertTransferV2(transfer db.Transfer, currency string) *pbv2.Transfer {
+	return &pbv2.Transfer{
+		Id:            transfer.ID,
+		FromAccountId: transfer.FromAccountID,
+		ToAccountId:   transfer.ToAccountID,
+		Amount:        convertMoneyV2(transfer.Amount, currency),
+		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+		Category:      transfer.Category,
+		Memo:          transfer.Memo,
+		Reference:     transfer.Reference,
+		ReversalOf:    transfer.ReversalOf.Int64,
+		FeeOf:         transfer.FeeOf.Int64,
+	}
+}
+
+func convertEntryV2(entry db.Entry, currency string) *pbv2.Entry {
+	return &pbv2.Entry{
+		Id:         entry.ID,
+		AccountId:  entry.AccountID,
+		Amount:     convertMoneyV2(entry.Amount, currency),
+		TransferId: entry.TransferID.Int64,
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+	}
+}
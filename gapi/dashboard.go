@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultDashboardRange is used when a dashboard request has no start_time
+	defaultDashboardRange = 30 * 24 * time.Hour
+	// maxDashboardRange limits how many days a dashboard query scans
+	maxDashboardRange = 366 * 24 * time.Hour
+)
+
+// dashboardRequest 是带时间范围的看板请求，见 dashboard.proto
+type dashboardRequest interface {
+	GetStartTime() *timestamppb.Timestamp
+	GetEndTime() *timestamppb.Timestamp
+}
+
+/*
+dashboardTimeRange 返回看板请求的时间范围 [start, end)。
+end_time 默认是现在，start_time 默认是 end_time 之前 30 天；范围超过 366 天或者 start 不早于 end 都返回 field violation，
+限制范围是为了让按天统计的查询只扫描有限的 created_at 索引区间。
+*/
+func dashboardTimeRange(req dashboardRequest) (start time.Time, end time.Time, violations []*errdetails.BadRequest_FieldViolation) {
+	end = time.Now()
+	if req.GetEndTime() != nil {
+		if err := req.GetEndTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_time", err))
+		}
+		end = req.GetEndTime().AsTime()
+	}
+
+	start = end.Add(-defaultDashboardRange)
+	if req.GetStartTime() != nil {
+		if err := req.GetStartTime().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("start_time", err))
+		}
+		start = req.GetStartTime().AsTime()
+	}
+
+	if violations != nil {
+		return start, end, violations
+	}
+	if !start.Before(end) {
+		violations = append(violations, fieldViolation("start_time", fmt.Errorf("must be before end_time")))
+	} else if end.Sub(start) > maxDashboardRange {
+		violations = append(violations, fieldViolation("start_time", fmt.Errorf("time range must not be longer than %d days", maxDashboardRange/(24*time.Hour))))
+	}
+	return start, end, violations
+}
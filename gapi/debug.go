@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"net/http"
+	"net/http/pprof"
+)
+
+// DebugPprofPath is where the pprof handlers are mounted on the gateway
+const DebugPprofPath = "/debug/pprof/"
+
+/*
+RegisterDebugHandlers 在网关的 mux 上注册 net/http/pprof 的处理函数，用来抓 CPU、内存和 goroutine 的 profile。
+没有用 import _ "net/http/pprof"，因为那样会注册到 http.DefaultServeMux 上，不受环境的控制；
+只有 config.DebugEnabled() 的时候 main.go 才会调用这个函数。
+*/
+func RegisterDebugHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(DebugPprofPath, pprof.Index)
+	mux.HandleFunc(DebugPprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(DebugPprofPath+"profile", pprof.Profile)
+	mux.HandleFunc(DebugPprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(DebugPprofPath+"trace", pprof.Trace)
+}
@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/worker"
+)
+
+// distributeDisputeUpdate 在争议打开或者状态变化之后异步给打开争议的用户发邮件。
+// 状态已经保存了，所以分发失败只记录日志，不影响 RPC 的返回结果。
+func (server *Server) distributeDisputeUpdate(ctx context.Context, dispute db.Dispute) {
+	taskPayload := &worker.PayloadSendDisputeUpdate{
+		DisputeID: dispute.ID,
+		Status:    dispute.Status,
+		UpdatedAt: dispute.UpdatedAt,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueDefault),
+	}
+
+	err := server.taskDistributor.DistributeTaskSendDisputeUpdate(ctx, taskPayload, opts...)
+	if err != nil {
+		LoggerFrom(ctx).Error().Err(err).Int64("dispute_id", dispute.ID).Str("status", dispute.Status).
+			Msg("failed to distribute dispute update")
+	}
+}
@@ -25,6 +25,62 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 	return statusDetails.Err()
 }
 
+// preconditionFailureError 返回 FailedPrecondition，并在 details 里告诉客户端下一步可以怎么做
+func preconditionFailureError(message string, violationType string, subject string, description string) error {
+	preconditionFailure := &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{
+				Type:        violationType,
+				Subject:     subject,
+				Description: description,
+			},
+		},
+	}
+	statusFailed := status.New(codes.FailedPrecondition, message)
+
+	statusDetails, err := statusFailed.WithDetails(preconditionFailure)
+	if err != nil {
+		return statusFailed.Err()
+	}
+
+	return statusDetails.Err()
+}
+
+// versionConflictViolation 是 versionConflictError 的类型，HTTP 网关把它转换成 412 Precondition Failed
+const versionConflictViolation = "VERSION_CONFLICT"
+
+/*
+versionConflictError 表示条件更新失败：资源在客户端读取之后被别人修改过（updated_at 和 expected_updated_at 不一样）。
+subject 是资源的名字，比如 "users/alice"。以后别的资源（比如账户）的条件更新也用这个错误。
+*/
+func versionConflictError(subject string) error {
+	return preconditionFailureError(
+		"resource has been modified since it was read",
+		versionConflictViolation,
+		subject,
+		"read the resource again and retry the update with its latest updated_at",
+	)
+}
+
+// isVersionConflict 判断 st 是不是 versionConflictError
+func isVersionConflict(st *status.Status) bool {
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, detail := range st.Details() {
+		preconditionFailure, ok := detail.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+		for _, violation := range preconditionFailure.GetViolations() {
+			if violation.GetType() == versionConflictViolation {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "unauthorized: %s", err)
 }
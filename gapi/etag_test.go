@@ -0,0 +1,118 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+)
+
+func TestETag(t *testing.T) {
+	body := `{"account":{"id":"1"}}`
+	handler := ETag(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, body)
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/v2/accounts/1", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, body, recorder.Body.String())
+
+	etag := recorder.Header().Get("ETag")
+	require.Regexp(t, `^W/"[0-9a-f]{32}"$`, etag)
+
+	// 内容没变，返回 304，没有 body
+	for _, ifNoneMatch := range []string{etag, `"other", ` + etag, "*", etag[2:]} {
+		request = httptest.NewRequest(http.MethodGet, "/v2/accounts/1", nil)
+		request.Header.Set("If-None-Match", ifNoneMatch)
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		require.Equal(t, http.StatusNotModified, recorder.Code, ifNoneMatch)
+		require.Empty(t, recorder.Body.String())
+		require.Equal(t, etag, recorder.Header().Get("ETag"))
+	}
+
+	request = httptest.NewRequest(http.MethodGet, "/v2/accounts/1", nil)
+	request.Header.Set("If-None-Match", `W/"stale"`)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, body, recorder.Body.String())
+}
+
+func TestETagSkipsErrorsAndOtherMethods(t *testing.T) {
+	handler := ETag(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet {
+			res.WriteHeader(http.StatusNotFound)
+		}
+		fmt.Fprint(res, `{"error":"account not found"}`)
+	}))
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		request := httptest.NewRequest(method, "/v2/accounts/1", nil)
+		request.Header.Set("If-None-Match", "*")
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		require.NotEqual(t, http.StatusNotModified, recorder.Code)
+		require.Empty(t, recorder.Header().Get("ETag"))
+		require.Equal(t, `{"error":"account not found"}`, recorder.Body.String())
+	}
+}
+
+func TestETagGateway(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	updatedAt := time.Date(2024, time.March, 31, 10, 30, 0, 0, time.UTC)
+	account := db.Account{ID: 1, Owner: user.Username, Balance: 100, Currency: util.USD, UpdatedAt: updatedAt}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(2).Return(account, nil)
+
+	server := newTestServer(t, store, nil)
+	mux := runtime.NewServeMux(runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher))
+	err := pbv2.RegisterSimpleBankV2HandlerServer(context.Background(), mux, NewServerV2(server))
+	require.NoError(t, err)
+	handler := ETag(mux)
+
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/v2/accounts/1", nil)
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "Sun, 31 Mar 2024 10:30:00 GMT", recorder.Header().Get("Last-Modified"))
+	etag := recorder.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	request.Header.Set("If-None-Match", etag)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusNotModified, recorder.Code)
+	require.Equal(t, "Sun, 31 Mar 2024 10:30:00 GMT", recorder.Header().Get("Last-Modified"))
+}
+
+func TestOutgoingHeaderMatcher(t *testing.T) {
+	key, ok := OutgoingHeaderMatcher(lastModifiedHeader)
+	require.True(t, ok)
+	require.Equal(t, "Last-Modified", key)
+
+	key, ok = OutgoingHeaderMatcher(util.TraceparentHeader)
+	require.True(t, ok)
+	require.Equal(t, "Grpc-Metadata-traceparent", key)
+}
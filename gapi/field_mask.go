@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+/*
+更新类的 RPC 用 google.protobuf.FieldMask 明确列出要修改的字段（update_mask）。
+客户端没有传 update_mask 的时候，为了兼容老客户端，退回到原来的做法：
+哪个 optional 字段设置了就更新哪个。
+
+fieldMask 是解析之后的结果，路径就是 proto 里的字段名，比如 "full_name"。
+*/
+type fieldMask map[string]bool
+
+// errEmptyUpdateMask 用在 update_mask 必填的 v2 接口里
+var errEmptyUpdateMask = errors.New("must list at least one field")
+
+func (mask fieldMask) has(path string) bool {
+	return mask[path]
+}
+
+// validateFieldMask 检查 update_mask 里的路径都是允许更新的字段，并且没有重复
+func validateFieldMask(mask *fieldmaskpb.FieldMask, allowed ...string) (violations []*errdetails.BadRequest_FieldViolation) {
+	allowedPaths := make(map[string]bool, len(allowed))
+	for _, path := range allowed {
+		allowedPaths[path] = true
+	}
+
+	seen := make(map[string]bool, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		if !allowedPaths[path] {
+			violations = append(violations, fieldViolation("update_mask", fmt.Errorf("cannot update field %q", path)))
+			continue
+		}
+		if seen[path] {
+			violations = append(violations, fieldViolation("update_mask", fmt.Errorf("field %q is listed more than once", path)))
+		}
+		seen[path] = true
+	}
+
+	return violations
+}
+
+// resolveFieldMask 返回要更新的字段：有 update_mask 就用它，否则用 present 里设置了的字段
+func resolveFieldMask(mask *fieldmaskpb.FieldMask, present map[string]bool) fieldMask {
+	resolved := fieldMask{}
+	if len(mask.GetPaths()) > 0 {
+		for _, path := range mask.GetPaths() {
+			resolved[path] = true
+		}
+		return resolved
+	}
+
+	for path, ok := range present {
+		if ok {
+			resolved[path] = true
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/techschool/bank/pb"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+/*
+GatewayMux 创建 HTTP 网关（grpc-gateway）：把 HTTP/JSON 请求转换成 gRPC 调用，通过 conn 发给 gRPC 服务器，
+再把 gRPC 的响应转换回 HTTP/JSON。conn 一般是 InProcessListener.Dial 创建的进程内连接，
+所以网关的请求和 gRPC 客户端的请求经过同一套拦截器（见 UnaryInterceptors）。
+*/
+func (server *Server) GatewayMux(ctx context.Context, conn *grpc.ClientConn) (*runtime.ServeMux, error) {
+	// 配置 JSON 解析器：使用 runtime.JSONPb 来自定义 JSON 的编组和解组行为，使其可以正确处理 proto 消息。
+	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{
+			UseProtoNames: true,
+		},
+		UnmarshalOptions: protojson.UnmarshalOptions{
+			DiscardUnknown: true,
+		},
+	})
+
+	// 错误统一返回 pb.ErrorResponse，格式和 Gin 版本的 errorResponse 兼容
+	errorHandler := runtime.WithErrorHandler(GatewayErrorHandler)
+
+	// 处理函数设置的 last-modified 转换成 HTTP 的 Last-Modified 头
+	headerMatcher := runtime.WithOutgoingHeaderMatcher(OutgoingHeaderMatcher)
+
+	grpcMux := runtime.NewServeMux(jsonOption, errorHandler, headerMatcher, runtime.WithMetadata(GatewayMetadata))
+
+	// 注册服务处理程序：HTTP 请求按 proto 里的 google.api.http 注解转发到相应的 gRPC 方法
+	if err := pb.RegisterSimpleBankHandler(ctx, grpcMux, conn); err != nil {
+		return nil, fmt.Errorf("cannot register handler: %w", err)
+	}
+	if err := pb.RegisterAdminServiceHandler(ctx, grpcMux, conn); err != nil {
+		return nil, fmt.Errorf("cannot register admin handler: %w", err)
+	}
+	// v2 的路由都在 /v2/ 下面，和 v1 共用同一个 mux 和中间件
+	if err := pbv2.RegisterSimpleBankV2Handler(ctx, grpcMux, conn); err != nil {
+		return nil, fmt.Errorf("cannot register v2 handler: %w", err)
+	}
+
+	// multipart/form-data 上传 KYC 证件，protoc-gen-grpc-gateway 不支持文件上传，所以单独注册
+	err := grpcMux.HandlePath(http.MethodPost, KYCUploadFormPath, server.UploadKYCDocumentForm(grpcMux, pb.NewSimpleBankClient(conn)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot register KYC upload form handler: %w", err)
+	}
+	return grpcMux, nil
+}
+
+/*
+GatewayMetadata 把 HTTP 中间件生成的请求 ID 和 trace 传给 gRPC 服务器。
+grpc-gateway 默认只转发标准的 HTTP 头（加上 grpcgateway- 前缀）和 Grpc-Metadata- 开头的头，
+不传的话 gRPC 的拦截器会重新生成，两边的日志就对不上了。
+*/
+func GatewayMetadata(ctx context.Context, req *http.Request) metadata.MD {
+	md := metadata.MD{}
+	if requestID := req.Header.Get(RequestIDHeader); requestID != "" {
+		md.Set(RequestIDHeader, requestID)
+	}
+	if trace, ok := util.TraceFromContext(ctx); ok {
+		md.Set(util.TraceparentHeader, trace.Traceparent())
+	}
+	return md
+}
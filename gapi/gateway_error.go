@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GatewayErrorHandler 替换 grpc-gateway 默认的错误格式（google.rpc.Status），
+统一返回 pb.ErrorResponse：HTTP 状态码仍然按照 gRPC 错误码转换，
+body 里带上错误码、错误信息、字段错误和请求 ID。
+路由找不到、方法不允许这类网关自己产生的错误也会走到这里。
+请求超时（见 Timeout）导致的 Internal 错误会转换成 DeadlineExceeded，也就是 504。
+条件更新的冲突（见 versionConflictError）返回 412，而不是 FailedPrecondition 默认的 400。
+请求正文超过 HTTP_MAX_BODY_SIZE（见 maxBodySize）返回 413。
+错误信息按 Accept-Language 头翻译，见 LocalizationInterceptor。
+*/
+func GatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, res http.ResponseWriter, req *http.Request, err error) {
+	st := status.Convert(timeoutError(req.Context(), err))
+	tooLarge := requestBodyTooLarge(req)
+	if tooLarge {
+		st = status.New(codes.InvalidArgument, "request body too large")
+	}
+	st = localizeStatus(i18n.Match(req.Header.Get("Accept-Language")), st)
+
+	body := &pb.ErrorResponse{
+		Error:     st.Message(),
+		Code:      code.Code(st.Code()).String(),
+		Message:   st.Message(),
+		RequestId: req.Header.Get(RequestIDHeader),
+	}
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			body.FieldViolations = append(body.FieldViolations, &pb.FieldViolation{
+				Field:       violation.GetField(),
+				Description: violation.GetDescription(),
+			})
+		}
+	}
+
+	buf, marshalErr := marshaler.Marshal(body)
+	if marshalErr != nil {
+		LoggerFrom(ctx).Error().Err(marshalErr).Msg("cannot marshal error response")
+		http.Error(res, `{"error": "failed to marshal error message"}`, http.StatusInternalServerError)
+		return
+	}
+
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	if isVersionConflict(st) {
+		httpStatus = http.StatusPreconditionFailed
+	}
+	if tooLarge {
+		httpStatus = http.StatusRequestEntityTooLarge
+	}
+
+	res.Header().Set("Content-Type", marshaler.ContentType(body))
+	res.WriteHeader(httpStatus)
+	if _, err := res.Write(buf); err != nil {
+		LoggerFrom(ctx).Error().Err(err).Msg("cannot write error response")
+	}
+}
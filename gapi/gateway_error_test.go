@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func serveGatewayError(t *testing.T, err error, requestID string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	marshaler := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+	}
+
+	handler := RequestID(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		GatewayErrorHandler(req.Context(), runtime.NewServeMux(), marshaler, res, req, err)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/list_accounts", nil)
+	if requestID != "" {
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	return recorder, body
+}
+
+func TestGatewayErrorHandler(t *testing.T) {
+	violations := []*errdetails.BadRequest_FieldViolation{
+		fieldViolation("email", errors.New("is not a valid email address")),
+	}
+
+	recorder, body := serveGatewayError(t, invalidArgumentError(violations), "req-123")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, "req-123", recorder.Header().Get(RequestIDHeader))
+
+	require.Equal(t, "invalid parameters", body["error"])
+	require.Equal(t, "invalid parameters", body["message"])
+	require.Equal(t, "INVALID_ARGUMENT", body["code"])
+	require.Equal(t, "req-123", body["request_id"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"field": "email", "description": "is not a valid email address"},
+	}, body["field_violations"])
+}
+
+func TestGatewayErrorHandlerGeneratesRequestID(t *testing.T) {
+	recorder, body := serveGatewayError(t, status.Errorf(codes.NotFound, "account not found"), "")
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+	require.Equal(t, "NOT_FOUND", body["code"])
+	require.Equal(t, "account not found", body["error"])
+	require.NotEmpty(t, body["request_id"])
+	require.Equal(t, body["request_id"], recorder.Header().Get(RequestIDHeader))
+	require.NotContains(t, body, "field_violations")
+}
+
+// 条件更新冲突返回 412，其他 FailedPrecondition 还是 400
+func TestGatewayErrorHandlerVersionConflict(t *testing.T) {
+	recorder, body := serveGatewayError(t, versionConflictError("users/alice"), "")
+	require.Equal(t, http.StatusPreconditionFailed, recorder.Code)
+	require.Equal(t, "FAILED_PRECONDITION", body["code"])
+
+	recorder, _ = serveGatewayError(t, verifyEmailExpiredError(), "")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
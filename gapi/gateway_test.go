@@ -0,0 +1,82 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/ratelimit"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// newTestGateway serves server with a gRPC server on an in-process listener and returns the gateway in front of it
+func newTestGateway(t *testing.T, server *Server) http.Handler {
+	listener := NewInProcessListener()
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(server.UnaryInterceptors()...))
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, server)
+	pbv2.RegisterSimpleBankV2Server(grpcServer, NewServerV2(server))
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := listener.Dial()
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	mux, err := server.GatewayMux(ctx, conn)
+	require.NoError(t, err)
+	return server.HTTPHandler(mux)
+}
+
+func TestGatewayRateLimit(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	server.rateLimiter = ratelimit.NewLimiter(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 1})
+	gateway := newTestGateway(t, server)
+
+	getVersion := func(remoteAddr string, forwardedFor string) int {
+		request := httptest.NewRequest(http.MethodGet, "/version", nil)
+		request.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			request.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		recorder := httptest.NewRecorder()
+		gateway.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	// 每个请求只限流一次，而且按 HTTP 客户端的 IP 计算，不是进程内连接的地址
+	require.Equal(t, http.StatusOK, getVersion("10.0.0.1:50000", ""))
+	require.Equal(t, http.StatusTooManyRequests, getVersion("10.0.0.1:50001", ""))
+	require.Equal(t, http.StatusOK, getVersion("10.0.0.2:50000", ""))
+
+	// 客户端自己传的 X-Forwarded-For 不能绕过限流
+	require.Equal(t, http.StatusTooManyRequests, getVersion("10.0.0.2:50001", "10.0.0.3"))
+}
+
+func TestClientAddress(t *testing.T) {
+	require.Empty(t, clientAddress(context.Background()))
+
+	tcpAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 50000}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+	require.Equal(t, "10.0.0.1:50000", clientAddress(ctx))
+
+	// gRPC 客户端传的 x-forwarded-for 不可信
+	md := metadata.Pairs(xForwardedForHeader, "10.0.0.2")
+	require.Equal(t, "10.0.0.1:50000", clientAddress(metadata.NewIncomingContext(ctx, md)))
+
+	// 来自网关的请求用 grpc-gateway 加在最后的 HTTP 客户端地址
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: inProcessAddr{}})
+	require.Equal(t, inProcessNetwork, clientAddress(ctx))
+	md = metadata.Pairs(xForwardedForHeader, "10.0.0.3, 10.0.0.4")
+	require.Equal(t, "10.0.0.4", clientAddress(metadata.NewIncomingContext(ctx, md)))
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+/*
+GrpcServerOptions 返回 gRPC 服务器的消息大小、keepalive 和连接管理的选项，配置是 0 的时候用 grpc-go 的默认值：
+  - GRPC_MAX_RECV_MSG_SIZE 默认 4MB，GRPC_MAX_SEND_MSG_SIZE 默认不限制，返回很多条流水这类大的响应要在客户端也调大接收的上限
+  - GRPC_MAX_CONCURRENT_STREAMS 是每个连接同时处理的请求数，默认不限制
+  - GRPC_KEEPALIVE_TIME 和 GRPC_KEEPALIVE_TIMEOUT：连接空闲多久之后服务器发 ping，等多久没有回应就断开，默认 2 小时和 20 秒
+  - GRPC_KEEPALIVE_MIN_TIME 和 GRPC_KEEPALIVE_WITHOUT_CALLS：客户端发 ping 的最小间隔（默认 5 分钟）和没有请求的时候能不能发 ping，
+    客户端违反的话服务器会用 GOAWAY 断开连接，所以要和客户端的 keepalive 配置一致
+  - GRPC_MAX_CONNECTION_IDLE：没有请求的连接多久之后关闭；GRPC_MAX_CONNECTION_AGE 让负载均衡后面的长连接定期重连，
+    到时间之后再等 GRPC_MAX_CONNECTION_AGE_GRACE 让正在处理的请求结束，默认都不限制
+
+拦截器不在这里面，见 UnaryInterceptors。
+*/
+func GrpcServerOptions(config util.Config) []grpc.ServerOption {
+	options := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     config.GRPCMaxConnectionIdle,
+			MaxConnectionAge:      config.GRPCMaxConnectionAge,
+			MaxConnectionAgeGrace: config.GRPCMaxConnectionAgeGrace,
+			Time:                  config.GRPCKeepaliveTime,
+			Timeout:               config.GRPCKeepaliveTimeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             config.GRPCKeepaliveMinTime,
+			PermitWithoutStream: config.GRPCKeepaliveWithoutCalls,
+		}),
+	}
+
+	if config.GRPCMaxRecvMsgSize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(config.GRPCMaxRecvMsgSize))
+	}
+	if config.GRPCMaxSendMsgSize > 0 {
+		options = append(options, grpc.MaxSendMsgSize(config.GRPCMaxSendMsgSize))
+	}
+	if config.GRPCMaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(uint32(config.GRPCMaxConcurrentStreams)))
+	}
+	return options
+}
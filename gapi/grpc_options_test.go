@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestGrpcClient(t *testing.T, config util.Config) pb.SimpleBankClient {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	config.TokenSymmetricKey = util.RandomString(32)
+	config.AccessTokenDuration = time.Minute
+	server, err := NewServer(config, store, nil, nil, token.NewInMemoryRevocationStore())
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	options := append(GrpcServerOptions(config), grpc.ChainUnaryInterceptor(server.UnaryInterceptors()...))
+	grpcServer := grpc.NewServer(options...)
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewSimpleBankClient(conn)
+}
+
+func TestGrpcServerOptions(t *testing.T) {
+	// 默认值不限制普通大小的请求
+	client := newTestGrpcClient(t, util.Config{})
+	_, err := client.GetVersion(context.Background(), &pb.GetVersionRequest{})
+	require.NoError(t, err)
+
+	// 请求超过 GRPC_MAX_RECV_MSG_SIZE 的时候还没有到处理函数就被拒绝了
+	client = newTestGrpcClient(t, util.Config{GRPCMaxRecvMsgSize: 1024})
+	_, err = client.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: util.RandomOwner(),
+		Password: strings.Repeat("x", 2048),
+	})
+	requireStatusCode(t, err, codes.ResourceExhausted)
+
+	client = newTestGrpcClient(t, util.Config{GRPCMaxSendMsgSize: 1})
+	_, err = client.GetVersion(context.Background(), &pb.GetVersionRequest{})
+	requireStatusCode(t, err, codes.ResourceExhausted)
+}
+
+func TestGrpcServerOptionsKeepalive(t *testing.T) {
+	config := util.Config{
+		GRPCMaxConcurrentStreams:  10,
+		GRPCKeepaliveTime:         time.Minute,
+		GRPCKeepaliveTimeout:      10 * time.Second,
+		GRPCKeepaliveMinTime:      10 * time.Second,
+		GRPCKeepaliveWithoutCalls: true,
+		GRPCMaxConnectionIdle:     time.Minute,
+		GRPCMaxConnectionAge:      time.Hour,
+		GRPCMaxConnectionAgeGrace: time.Minute,
+	}
+	require.Len(t, GrpcServerOptions(config), 3)
+
+	client := newTestGrpcClient(t, config)
+	_, err := client.GetVersion(context.Background(), &pb.GetVersionRequest{})
+	require.NoError(t, err)
+}
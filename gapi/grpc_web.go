@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/techschool/bank/cors"
+	"google.golang.org/grpc"
+)
+
+/*
+GrpcWeb 让浏览器里的前端用 gRPC-Web 客户端直接调用 gRPC 接口，不需要在前面再部署 Envoy。
+浏览器不能直接访问 gRPC 端口（需要 HTTP/2 trailer），gRPC-Web 请求发到 HTTP 网关的端口，
+在进程内转给 grpcServer 处理，所以会经过 gRPC 的全部拦截器（认证、限流、日志等），
+不再经过 HTTPHandler 里的 HTTP 中间件。其他请求照常交给 next。
+
+跨域的来源使用 CORS_ALLOWED_ORIGINS 的配置；GRPC_WEB_WEBSOCKETS 打开时客户端流和双向流通过 websocket 传输。
+*/
+func (server *Server) GrpcWeb(grpcServer *grpc.Server) func(http.Handler) http.Handler {
+	policy := cors.NewPolicy(server.config)
+
+	options := []grpcweb.Option{
+		grpcweb.WithOriginFunc(policy.AllowsOrigin),
+		grpcweb.WithWebsockets(server.config.GRPCWebWebsockets),
+		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool {
+			return policy.AllowsOrigin(req.Header.Get("Origin"))
+		}),
+	}
+	if server.config.GRPCWebPingInterval > 0 {
+		options = append(options, grpcweb.WithWebsocketPingInterval(server.config.GRPCWebPingInterval))
+	}
+
+	wrappedServer := grpcweb.WrapServer(grpcServer, options...)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if wrappedServer.IsGrpcWebRequest(req) ||
+				wrappedServer.IsAcceptableGrpcCorsRequest(req) ||
+				wrappedServer.IsGrpcWebSocketRequest(req) {
+				wrappedServer.ServeHTTP(res, req)
+				return
+			}
+			next.ServeHTTP(res, req)
+		})
+	}
+}
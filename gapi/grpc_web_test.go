@@ -0,0 +1,156 @@
+package gapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const testOrigin = "http://localhost:3000"
+
+func newTestGrpcWebHandler(t *testing.T, store *mockdb.MockStore) http.Handler {
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+		CORSAllowedOrigins:  testOrigin,
+	}
+
+	server, err := NewServer(config, store, nil, nil, token.NewInMemoryRevocationStore())
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(server.UnaryInterceptors()...))
+	pb.RegisterSimpleBankServer(grpcServer, server)
+
+	next := http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.WriteHeader(http.StatusTeapot)
+	})
+	return server.GrpcWeb(grpcServer)(next)
+}
+
+// grpcWebFrame 按 gRPC-Web 的格式编码请求：1 字节标志 + 4 字节长度 + 消息
+func grpcWebFrame(t *testing.T, msg proto.Message) []byte {
+	data, err := proto.Marshal(msg)
+	require.NoError(t, err)
+
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(data)))
+	return append(frame, data...)
+}
+
+func newGrpcWebRequest(t *testing.T, method string, msg proto.Message) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/pb.SimpleBank/"+method, bytes.NewReader(grpcWebFrame(t, msg)))
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+	req.Header.Set("Origin", testOrigin)
+	return req
+}
+
+func TestGrpcWeb(t *testing.T) {
+	testCases := []struct {
+		name          string
+		buildRequest  func(t *testing.T) *http.Request
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(t *testing.T) *http.Request {
+				return newGrpcWebRequest(t, "GetVersion", &pb.GetVersionRequest{})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, testOrigin, recorder.Header().Get("Access-Control-Allow-Origin"))
+
+				// 第一个帧是响应消息，后面是 trailer 帧
+				body := recorder.Body.Bytes()
+				require.GreaterOrEqual(t, len(body), 5)
+				require.Equal(t, byte(0), body[0])
+				length := binary.BigEndian.Uint32(body[1:5])
+
+				var rsp pb.GetVersionResponse
+				require.NoError(t, proto.Unmarshal(body[5:5+length], &rsp))
+				require.NotEmpty(t, rsp.GetGoVersion())
+				require.Contains(t, string(body[5+length:]), "grpc-status: 0")
+			},
+		},
+		{
+			name: "Unauthenticated",
+			buildRequest: func(t *testing.T) *http.Request {
+				return newGrpcWebRequest(t, "ListAccounts", &pb.ListAccountsRequest{PageSize: 5})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				// 没有消息帧的时候错误放在响应头里
+				require.Equal(t, strconv.Itoa(int(codes.Unauthenticated)), recorder.Header().Get("Grpc-Status"))
+			},
+		},
+		{
+			name: "Preflight",
+			buildRequest: func(t *testing.T) *http.Request {
+				req := httptest.NewRequest(http.MethodOptions, "/pb.SimpleBank/GetVersion", nil)
+				req.Header.Set("Origin", testOrigin)
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "x-grpc-web,content-type,authorization")
+				return req
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, testOrigin, recorder.Header().Get("Access-Control-Allow-Origin"))
+				allowedHeaders := strings.ToLower(recorder.Header().Get("Access-Control-Allow-Headers"))
+				require.Contains(t, allowedHeaders, "x-grpc-web")
+			},
+		},
+		{
+			name: "PreflightOriginNotAllowed",
+			buildRequest: func(t *testing.T) *http.Request {
+				req := httptest.NewRequest(http.MethodOptions, "/pb.SimpleBank/GetVersion", nil)
+				req.Header.Set("Origin", "http://evil.com")
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "x-grpc-web")
+				return req
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Empty(t, recorder.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name: "NotGrpcWeb",
+			buildRequest: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/v1/version", nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				// 普通的 HTTP 请求交给后面的 handler
+				require.Equal(t, http.StatusTeapot, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			handler := newTestGrpcWebHandler(t, store)
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, tc.buildRequest(t))
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
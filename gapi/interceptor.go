@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"net/http"
+
+	"github.com/techschool/bank/cors"
+	"google.golang.org/grpc"
+)
+
+/*
+UnaryInterceptors 返回 gRPC 服务器的拦截器链，所有拦截器都在这里配置，列表的顺序就是请求经过的顺序：
+
+ 1. tracing：最先生成 trace id，后面的日志都能带上它
+ 2. 请求的 logger：带上 request_id、method 和 trace_id 放进 ctx，认证之后再加上用户名，见 context_logger.go
+ 3. 本地化：按 accept-language 翻译返回的错误信息，放在日志外面，日志里还是英文
+ 4. 日志：记录的是其他拦截器处理之后的最终状态码，包括 panic 变成的 Internal
+ 5. recovery：捕获后面所有拦截器和处理函数的 panic
+ 6. metrics：统计请求数和耗时，被限流的请求也算在里面
+ 7. 认证：验证访问令牌，放进 ctx
+ 8. 限流：登录用户按用户名，其他按 IP
+ 9. 超时：只限制真正处理请求的时间
+ 10. 参数校验：按 proto 里的 (validate.rules) 注解校验，见 validation.go
+
+Gin 版本的中间件按同样的顺序在 api.Server.middlewares 里配置。
+*/
+func (server *Server) UnaryInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		TracingInterceptor,
+		ContextLogger,
+		LocalizationInterceptor,
+		NewGrpcLogger(server.config, server.geoLocator),
+		RecoveryInterceptor,
+		MetricsInterceptor,
+		server.authenticate,
+		server.rateLimit,
+		NewTimeoutInterceptor(server.config.RequestTimeout),
+		ValidationInterceptor,
+	}
+}
+
+/*
+HTTPHandler 给 HTTP 网关加上中间件，顺序和 UnaryInterceptors 对应（从外到内）。
+压缩放在最外层，日志里记录的是压缩之前的内容。网关通过进程内的连接调用 gRPC 服务器（见 GatewayMux），
+认证、限流和参数校验都由 gRPC 的拦截器完成，这里只做 HTTP 本身的事情；
+请求 ID 和 trace 通过 metadata 传给 gRPC，两边的日志可以用 request_id 串起来。
+不经过 gRPC 的接口要自己加上 HttpRateLimit。
+*/
+func (server *Server) HTTPHandler(handler http.Handler) http.Handler {
+	middlewares := []func(http.Handler) http.Handler{
+		func(next http.Handler) http.Handler {
+			return Compress(next, server.config.HTTPCompressionMinSize)
+		},
+		RequestID,
+		HttpTracing,
+		HttpContextLogger,
+		NewHttpLogger(server.geoLocator),
+		HttpRecovery,
+		HttpMetrics,
+		server.maxBodySize,
+		cors.NewPolicy(server.config).Handler,
+		func(next http.Handler) http.Handler {
+			return Timeout(next, server.config.RequestTimeout)
+		},
+	}
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,199 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/ratelimit"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// chainHandler 按 grpc.ChainUnaryInterceptor 的方式把拦截器链和处理函数串起来
+func chainHandler(interceptors []grpc.UnaryServerInterceptor, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) grpc.UnaryHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler
+}
+
+func TestUnaryInterceptors(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/GetAccount"}
+	user, _ := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		buildContext  func(t *testing.T, server *Server) context.Context
+		req           interface{}
+		handler       grpc.UnaryHandler
+		checkResponse func(t *testing.T, resp interface{}, err error)
+	}{
+		{
+			name: "Authenticated",
+			buildContext: func(t *testing.T, server *Server) context.Context {
+				return newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				payload, ok := authPayloadFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, user.Username, payload.Username)
+
+				trace, ok := util.TraceFromContext(ctx)
+				require.True(t, ok)
+				require.Len(t, trace.TraceID, 32)
+
+				_, ok = ctx.Deadline()
+				require.True(t, ok)
+				return "ok", nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "ok", resp)
+			},
+		},
+		{
+			name: "InvalidTokenIsNotRejected",
+			buildContext: func(t *testing.T, server *Server) context.Context {
+				md := metadata.MD{authorizationHeader: []string{"bearer invalid"}}
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				_, ok := authPayloadFromContext(ctx)
+				require.False(t, ok)
+				return "ok", nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Panic",
+			buildContext: func(t *testing.T, server *Server) context.Context {
+				return context.Background()
+			},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("something went wrong")
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.Nil(t, resp)
+				require.Equal(t, codes.Internal, status.Code(err))
+				require.NotContains(t, err.Error(), "something went wrong")
+			},
+		},
+		{
+			name: "ValidationFailed",
+			buildContext: func(t *testing.T, server *Server) context.Context {
+				return context.Background()
+			},
+			req: &pb.GetUserRequest{Username: "Invalid-User"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				require.Fail(t, "handler should not be called")
+				return nil, nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+				violations := status.Convert(err).Details()[0].(*errdetails.BadRequest).GetFieldViolations()
+				require.Len(t, violations, 1)
+				require.Equal(t, "username", violations[0].GetField())
+			},
+		},
+		{
+			name: "ValidationPassed",
+			buildContext: func(t *testing.T, server *Server) context.Context {
+				return context.Background()
+			},
+			req: &pb.GetUserRequest{Username: "valid_user"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+			server.config.RequestTimeout = time.Second
+
+			handler := chainHandler(server.UnaryInterceptors(), info, tc.handler)
+			resp, err := handler(tc.buildContext(t, server), tc.req)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/GetAccount"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	server := newTestServer(t, nil, nil)
+	server.rateLimiter = ratelimit.NewLimiter(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 1})
+	chain := chainHandler([]grpc.UnaryServerInterceptor{server.authenticate, server.rateLimit}, info, handler)
+
+	newPeerContext := func(ctx context.Context, ip string) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+	}
+
+	_, err := chain(newPeerContext(context.Background(), "10.0.0.1"), nil)
+	require.NoError(t, err)
+
+	_, err = chain(newPeerContext(context.Background(), "10.0.0.1"), nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// 登录用户按用户名限流，不受同一个 IP 上其他请求的影响
+	user, _ := randomUser(t, util.DepositorRole)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err = chain(newPeerContext(ctx, "10.0.0.1"), nil)
+	require.NoError(t, err)
+
+	_, err = chain(newPeerContext(ctx, "10.0.0.2"), nil)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(res http.ResponseWriter, req *http.Request) {
+		trace, ok := util.TraceFromContext(req.Context())
+		require.True(t, ok)
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID)
+		res.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/panic", func(res http.ResponseWriter, req *http.Request) {
+		panic("something went wrong")
+	})
+	handler := server.HTTPHandler(mux)
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/ok", nil)
+	request.Header.Set(util.TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Header().Get(util.TraceparentHeader), "4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NotEmpty(t, recorder.Header().Get(RequestIDHeader))
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
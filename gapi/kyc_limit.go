@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+kycTransferLimit 返回用户单笔转账的最大金额，0 表示没有限制。
+限额由 KYC_TRANSFER_LIMITS 按 KYC 等级配置，没有配置 KYC_TRANSFER_LIMITS 时不查询 KYC 等级。
+返回的 error 已经是 gRPC status 错误。
+*/
+func (server *Server) kycTransferLimit(ctx context.Context, username string) (int64, error) {
+	limits, err := util.ParseKYCTransferLimits(server.config.KYCTransferLimits)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "invalid KYC transfer limits: %s", err)
+	}
+	if len(limits) == 0 {
+		return 0, nil
+	}
+
+	level, err := server.store.GetKYCLevel(ctx, username)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get KYC level: %s", err)
+	}
+	return limits[level], nil
+}
+
+// kycLimitError is returned when the amount of a transfer exceeds the KYC transfer limit of the user
+func kycLimitError(amount int64, limit int64) error {
+	return status.Errorf(codes.FailedPrecondition, "amount %d exceeds the transfer limit %d of your KYC level, upload KYC documents to raise it", amount, limit)
+}
+
+// checkKYCTransferLimit checks a single transfer against the KYC transfer limit of the user
+func (server *Server) checkKYCTransferLimit(ctx context.Context, username string, amount int64) error {
+	limit, err := server.kycTransferLimit(ctx, username)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && amount > limit {
+		return kycLimitError(amount, limit)
+	}
+	return nil
+}
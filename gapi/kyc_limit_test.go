@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckKYCTransferLimit(t *testing.T) {
+	username := util.RandomOwner()
+
+	testCases := []struct {
+		name       string
+		limits     string
+		amount     int64
+		buildStubs func(store *mockdb.MockStore)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:   "NoLimits",
+			limits: "",
+			amount: 1000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "WithinLimit",
+			limits: "0:100,1:1000",
+			amount: 100,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(username)).Times(1).Return(util.KYCLevelNone, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "ExceedsLimit",
+			limits: "0:100,1:1000",
+			amount: 101,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(username)).Times(1).Return(util.KYCLevelNone, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:   "HigherLevel",
+			limits: "0:100,1:1000",
+			amount: 1000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(username)).Times(1).Return(util.KYCLevelIdentity, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:   "UnlimitedLevel",
+			limits: "0:100,1:1000",
+			amount: 1000000,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(username)).Times(1).Return(util.KYCLevelFull, nil)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.config.KYCTransferLimits = tc.limits
+
+			err := server.checkKYCTransferLimit(context.Background(), username, tc.amount)
+			tc.checkError(t, err)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/techschool/bank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+// KYCUploadFormPath is the HTTP path for uploading KYC documents as multipart/form-data
+const KYCUploadFormPath = "/v1/upload_kyc_document/form"
+
+// multipartOverhead 是 multipart 请求里除了文件之外的部分（边界、表单字段）允许的大小
+const multipartOverhead = 64 << 10
+
+/*
+UploadKYCDocumentForm 返回一个 grpc-gateway 的 HandlerFunc，
+让浏览器可以直接用 multipart/form-data 上传证件，不用先把文件转成 base64 的 JSON：
+  - file：证件文件
+  - document_type：证件类型
+
+它和 protoc-gen-grpc-gateway 生成的 handler 做的事情一样：把 HTTP 头转换成 gRPC metadata，
+然后通过 client 调用 UploadKYCDocument，所以拦截器、认证、校验和错误格式（GatewayErrorHandler）都和 JSON 接口一致。
+*/
+func (server *Server) UploadKYCDocumentForm(mux *runtime.ServeMux, client pb.SimpleBankClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, "/pb.SimpleBank/UploadKYCDocument", runtime.WithHTTPPathPattern(KYCUploadFormPath))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		req, err := server.parseKYCUploadForm(w, r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		var md runtime.ServerMetadata
+		rsp, err := client.UploadKYCDocument(ctx, req, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, r, rsp, mux.GetForwardResponseOptions()...)
+	}
+}
+
+// parseKYCUploadForm reads the multipart form into an UploadKYCDocumentRequest
+func (server *Server) parseKYCUploadForm(w http.ResponseWriter, r *http.Request) (*pb.UploadKYCDocumentRequest, error) {
+	maxSize := server.config.KYCMaxDocumentSize
+	if maxSize > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, maxSize+multipartOverhead)
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("file", fmt.Errorf("cannot read uploaded file: %w", err)),
+		})
+	}
+	defer file.Close()
+
+	// 多读一个字节，超过大小限制的文件交给 validateUploadKYCDocumentRequest 报错
+	reader := io.Reader(file)
+	if maxSize > 0 {
+		reader = io.LimitReader(file, maxSize+1)
+	}
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("file", fmt.Errorf("cannot read uploaded file: %w", err)),
+		})
+	}
+
+	req := &pb.UploadKYCDocumentRequest{
+		DocumentType: r.FormValue("document_type"),
+		Content:      content,
+	}
+	return req, nil
+}
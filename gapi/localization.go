@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const (
+	acceptLanguageHeader            = "accept-language"
+	grpcGatewayAcceptLanguageHeader = "grpcgateway-accept-language"
+)
+
+/*
+LocalizationInterceptor 按请求的 accept-language 翻译返回给客户端的错误信息和字段错误，见 i18n 包。
+放在日志拦截器外面，日志里记录的还是英文的错误信息。
+HTTP 网关不经过拦截器，在 GatewayErrorHandler 里按 Accept-Language 头翻译。
+*/
+func LocalizationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		if locale := requestLocale(ctx); locale != i18n.DefaultLocale {
+			err = localizeStatus(locale, status.Convert(err)).Err()
+		}
+	}
+	return resp, err
+}
+
+// requestLocale 返回请求的语言，gRPC 客户端直接传 accept-language，经过网关的请求是 grpcgateway-accept-language
+func requestLocale(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return i18n.DefaultLocale
+	}
+	return i18n.Match(append(md.Get(acceptLanguageHeader), md.Get(grpcGatewayAcceptLanguageHeader)...)...)
+}
+
+// localizeStatus 翻译错误信息和 BadRequest 里的字段错误，其他 details 保持不变
+func localizeStatus(locale string, st *status.Status) *status.Status {
+	if locale == i18n.DefaultLocale {
+		return st
+	}
+
+	localized := status.New(st.Code(), i18n.Translate(locale, st.Message()))
+
+	details := st.Details()
+	if len(details) == 0 {
+		return localized
+	}
+
+	// Details 返回的是新的对象，可以直接修改之后重新放进去
+	localizedDetails := make([]protoadapt.MessageV1, 0, len(details))
+	for _, detail := range details {
+		message, ok := detail.(protoadapt.MessageV1)
+		if !ok {
+			continue
+		}
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				violation.Description = i18n.Translate(locale, violation.GetDescription())
+			}
+		}
+		localizedDetails = append(localizedDetails, message)
+	}
+
+	withDetails, err := localized.WithDetails(localizedDetails...)
+	if err != nil {
+		return localized
+	}
+	return withDetails
+}
@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/i18n"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestLocalizationInterceptor(t *testing.T) {
+	violations := []*errdetails.BadRequest_FieldViolation{
+		fieldViolation("full_name", errors.New("must contain from 3-100 characters")),
+		fieldViolation("email", errors.New("is not a valid email address")),
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, invalidArgumentError(violations)
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/UpdateUser"}
+
+	testCases := []struct {
+		name         string
+		md           metadata.MD
+		message      string
+		descriptions []string
+	}{
+		{
+			name:         "NoHeader",
+			md:           metadata.MD{},
+			message:      "invalid parameters",
+			descriptions: []string{"must contain from 3-100 characters", "is not a valid email address"},
+		},
+		{
+			name:         "Chinese",
+			md:           metadata.Pairs(acceptLanguageHeader, "zh-CN,zh;q=0.9,en;q=0.8"),
+			message:      "参数无效",
+			descriptions: []string{"长度必须在 3-100 个字符之间", "不是有效的电子邮件地址"},
+		},
+		{
+			name:         "ChineseFromGateway",
+			md:           metadata.Pairs(grpcGatewayAcceptLanguageHeader, "zh"),
+			message:      "参数无效",
+			descriptions: []string{"长度必须在 3-100 个字符之间", "不是有效的电子邮件地址"},
+		},
+		{
+			name:         "UnsupportedLanguage",
+			md:           metadata.Pairs(acceptLanguageHeader, "fr-FR"),
+			message:      "invalid parameters",
+			descriptions: []string{"must contain from 3-100 characters", "is not a valid email address"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+			_, err := LocalizationInterceptor(ctx, nil, info, handler)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.InvalidArgument, st.Code())
+			require.Equal(t, tc.message, st.Message())
+
+			require.Len(t, st.Details(), 1)
+			badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+			require.True(t, ok)
+			require.Len(t, badRequest.GetFieldViolations(), len(tc.descriptions))
+			for i, violation := range badRequest.GetFieldViolations() {
+				require.Equal(t, violations[i].GetField(), violation.GetField())
+				require.Equal(t, tc.descriptions[i], violation.GetDescription())
+			}
+		})
+	}
+}
+
+func TestLocalizeStatusKeepsUnknownMessages(t *testing.T) {
+	st := localizeStatus(i18n.Chinese, status.New(codes.NotFound, "account not found"))
+	require.Equal(t, codes.NotFound, st.Code())
+	require.Equal(t, "account not found", st.Message())
+}
+
+func TestGatewayErrorHandlerLocalized(t *testing.T) {
+	violations := []*errdetails.BadRequest_FieldViolation{
+		fieldViolation("email", errors.New("is not a valid email address")),
+	}
+	marshaler := &runtime.JSONPb{
+		MarshalOptions: protojson.MarshalOptions{UseProtoNames: true},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/list_accounts", nil)
+	req.Header.Set("Accept-Language", "zh-CN")
+	recorder := httptest.NewRecorder()
+	GatewayErrorHandler(req.Context(), runtime.NewServeMux(), marshaler, recorder, req, invalidArgumentError(violations))
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Equal(t, "参数无效", body["message"])
+	require.Equal(t, "INVALID_ARGUMENT", body["code"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"field": "email", "description": "不是有效的电子邮件地址"},
+	}, body["field_violations"])
+}
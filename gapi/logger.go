@@ -3,12 +3,17 @@ package gapi
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
-	"github.com/rs/zerolog/log"
+	"github.com/rs/zerolog"
+	"github.com/techschool/bank/geoip"
+	"github.com/techschool/bank/util"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 //logger.go 文件中定义了两个用于记录日志的函数，一个针对 gRPC 请求，另一个针对 HTTP 请求。
@@ -29,6 +34,37 @@ func GrpcLogger(
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	return grpcLog(ctx, req, info, handler, nil, nil)
+}
+
+/*
+NewGrpcLogger 根据配置返回 gRPC 日志中间件。
+LOG_PAYLOADS 打开的时候（一般只在开发环境），日志里还会带上请求和响应的内容，
+内容会先经过 Redactor 脱敏，LOG_REDACT_FIELDS 可以追加要脱敏的字段名（逗号分隔）。
+locator 不为 nil 时日志里还会记录客户端所在的国家和城市。
+*/
+func NewGrpcLogger(config util.Config, locator geoip.Locator) grpc.UnaryServerInterceptor {
+	if !config.LogPayloads && locator == nil {
+		return GrpcLogger
+	}
+
+	var redactor *Redactor
+	if config.LogPayloads {
+		redactor = NewRedactor(strings.Split(config.LogRedactFields, ",")...)
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return grpcLog(ctx, req, info, handler, redactor, locator)
+	}
+}
+
+func grpcLog(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+	redactor *Redactor,
+	locator geoip.Locator,
 ) (resp interface{}, err error) {
 	startTime := time.Now()
 	result, err := handler(ctx, req)
@@ -39,13 +75,24 @@ func GrpcLogger(
 		statusCode = st.Code()
 	}
 
-	logger := log.Info()
+	// request_id、method 和 trace_id 在请求的 logger 上，见 ContextLogger
+	requestLogger := LoggerFrom(ctx)
+	logger := requestLogger.Info()
 	if err != nil {
-		logger = log.Error().Err(err)
+		logger = requestLogger.Error().Err(err)
+	}
+
+	if redactor != nil {
+		logger = logPayload(logger, "request", req, redactor)
+		logger = logPayload(logger, "response", result, redactor)
+	}
+
+	if locator != nil {
+		mtdt := extractMetadata(ctx, locator)
+		logger = logger.Str("client_ip", mtdt.ClientIP).Str("country", mtdt.Country).Str("city", mtdt.City)
 	}
 
 	logger.Str("protocol", "grpc").
-		Str("method", info.FullMethod).
 		Int("status_code", int(statusCode)).
 		Str("status_text", statusCode.String()).
 		Dur("duration", duration).
@@ -54,6 +101,20 @@ func GrpcLogger(
 	return result, err
 }
 
+// logPayload 把脱敏之后的 proto 消息以 JSON 的形式加到日志里
+func logPayload(logger *zerolog.Event, key string, payload interface{}, redactor *Redactor) *zerolog.Event {
+	msg, ok := payload.(proto.Message)
+	if !ok || msg == nil || !msg.ProtoReflect().IsValid() {
+		return logger
+	}
+
+	data, err := protojson.Marshal(redactor.Redact(msg))
+	if err != nil {
+		return logger
+	}
+	return logger.RawJSON(key, data)
+}
+
 // 这是一个 HTTP 响应记录器，用于拦截和记录 HTTP 响应的状态码和正文内容。
 type ResponseRecorder struct {
 	http.ResponseWriter
@@ -82,6 +143,17 @@ func (rec *ResponseRecorder) Write(body []byte) (int, error) {
 记录日志：记录请求的协议、方法、路径、状态码、文本描述和持续时间。
 */
 func HttpLogger(handler http.Handler) http.Handler {
+	return httpLog(handler, nil)
+}
+
+// NewHttpLogger 和 HttpLogger 一样，locator 不为 nil 时日志里还会记录客户端所在的国家和城市
+func NewHttpLogger(locator geoip.Locator) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return httpLog(handler, locator)
+	}
+}
+
+func httpLog(handler http.Handler, locator geoip.Locator) http.Handler {
 	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 		startTime := time.Now()
 		rec := &ResponseRecorder{
@@ -91,13 +163,22 @@ func HttpLogger(handler http.Handler) http.Handler {
 		handler.ServeHTTP(rec, req)
 		duration := time.Since(startTime)
 
-		logger := log.Info()
+		// request_id、method 和 trace_id 在请求的 logger 上，见 HttpContextLogger
+		requestLogger := LoggerFrom(req.Context())
+		logger := requestLogger.Info()
 		if rec.StatusCode != http.StatusOK {
-			logger = log.Error().Bytes("body", rec.Body)
+			logger = requestLogger.Error().Bytes("body", rec.Body)
+		}
+		if locator != nil {
+			clientIP := req.RemoteAddr
+			if forwardedFor := req.Header.Get(xForwardedForHeader); forwardedFor != "" {
+				clientIP = forwardedFor
+			}
+			location := geoip.Locate(locator, clientIP)
+			logger = logger.Str("client_ip", clientIP).Str("country", location.Country).Str("city", location.City)
 		}
 
 		logger.Str("protocol", "http").
-			Str("method", req.Method).
 			Str("path", req.RequestURI).
 			Int("status_code", rec.StatusCode).
 			Str("status_text", http.StatusText(rec.StatusCode)).
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/geoip"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGrpcLoggerPayloads(t *testing.T) {
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/LoginUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.LoginUserResponse{SessionId: "session-id", AccessToken: "v2.local.access"}, nil
+	}
+	req := &pb.LoginUserRequest{Username: "alice", Password: "secret123"}
+
+	interceptor := NewGrpcLogger(util.Config{LogPayloads: true}, nil)
+	_, err := interceptor(context.Background(), req, info, handler)
+	require.NoError(t, err)
+
+	output := buf.String()
+	require.Contains(t, output, `"username":"alice"`)
+	require.Contains(t, output, `"sessionId":"session-id"`)
+	require.Contains(t, output, redactedValue)
+	require.NotContains(t, output, "secret123")
+	require.NotContains(t, output, "v2.local.access")
+
+	// 默认不记录请求内容
+	buf.Reset()
+	interceptor = NewGrpcLogger(util.Config{}, nil)
+	_, err = interceptor(context.Background(), req, info, handler)
+	require.NoError(t, err)
+	require.NotContains(t, buf.String(), "alice")
+}
+
+// fixedLocator 把所有 IP 都定位到同一个地方
+type fixedLocator geoip.Location
+
+func (locator fixedLocator) Lookup(ip net.IP) (geoip.Location, error) {
+	return geoip.Location(locator), nil
+}
+
+func TestLoggerLocation(t *testing.T) {
+	var buf bytes.Buffer
+	defaultLogger := log.Logger
+	log.Logger = zerolog.New(&buf)
+	defer func() { log.Logger = defaultLogger }()
+
+	locator := fixedLocator{Country: "AU", City: "Sydney"}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/LoginUser"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.LoginUserResponse{}, nil
+	}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 54321},
+	})
+
+	_, err := NewGrpcLogger(util.Config{}, locator)(ctx, &pb.LoginUserRequest{}, info, handler)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), `"client_ip":"203.0.113.7:54321","country":"AU","city":"Sydney"`)
+
+	buf.Reset()
+	httpHandler := NewHttpLogger(locator)(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {}))
+	req := httptest.NewRequest(http.MethodGet, "/v1/list_sessions", nil)
+	req.Header.Set("X-Forwarded-For", "198.51.100.1, 10.0.0.1")
+	httpHandler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Contains(t, buf.String(), `"country":"AU","city":"Sydney"`)
+
+	// 没有配置 geoip 时不记录位置
+	buf.Reset()
+	_, err = NewGrpcLogger(util.Config{}, nil)(ctx, &pb.LoginUserRequest{}, info, handler)
+	require.NoError(t, err)
+	require.NotContains(t, buf.String(), "country")
+}
@@ -15,12 +15,17 @@ import (
 )
 
 func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
+	return newTestServerWithInspector(t, store, taskDistributor, nil)
+}
+
+func newTestServerWithInspector(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector) *Server {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
+		LinkSigningKey:      util.RandomString(32),
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store, taskDistributor)
+	server, err := NewServer(config, store, taskDistributor, taskInspector, token.NewInMemoryRevocationStore())
 	require.NoError(t, err)
 
 	return server
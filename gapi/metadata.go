@@ -2,7 +2,9 @@ package gapi
 
 import (
 	"context"
+	"strings"
 
+	"github.com/techschool/bank/geoip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -16,6 +18,9 @@ const (
 type Metadata struct {
 	UserAgent string
 	ClientIP  string
+	// Country 和 City 是 ClientIP 所在的地方，没有配置 GEOIP_DB_PATH 或者查不到时为空
+	Country string
+	City    string
 }
 
 /*
@@ -30,6 +35,11 @@ type Metadata struct {
 这对于日志记录、监控或安全目的特别有用，因为我们可以了解是谁在从何处调用您的服务。
 */
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
+	return extractMetadata(ctx, server.geoLocator)
+}
+
+// extractMetadata 不依赖 Server，日志拦截器也用它取客户端的地址
+func extractMetadata(ctx context.Context, locator geoip.Locator) *Metadata {
 	mtdt := &Metadata{}
 
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
@@ -59,9 +69,37 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		}
 	}
 
-	if p, ok := peer.FromContext(ctx); ok {
-		mtdt.ClientIP = p.Addr.String()
+	if address := clientAddress(ctx); address != "" {
+		mtdt.ClientIP = address
 	}
 
+	location := geoip.Locate(locator, mtdt.ClientIP)
+	mtdt.Country = location.Country
+	mtdt.City = location.City
+
 	return mtdt
 }
+
+/*
+clientAddress 返回直接连接到服务器的客户端的地址，限流和日志都用它。
+gRPC 客户端是 peer 的地址；经过网关的请求 peer 是进程内的连接（见 InProcessListener），
+grpc-gateway 会把 HTTP 连接的地址加在 x-forwarded-for 的最后，所以取最后一个。
+前面的是 HTTP 客户端自己传的，可以伪造，不能用来限流。没有 peer 的时候返回空字符串。
+*/
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() != inProcessNetwork {
+		return p.Addr.String()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(xForwardedForHeader); len(values) > 0 {
+			forwardedFor := strings.Split(values[len(values)-1], ",")
+			return strings.TrimSpace(forwardedFor[len(forwardedFor)-1])
+		}
+	}
+	return p.Addr.String()
+}
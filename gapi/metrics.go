@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"expvar"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+/*
+请求的统计数据通过 expvar 发布，在 /debug/vars 可以看到（见 RegisterMetricsHandlers）：
+grpc_requests 按 "方法 状态码" 统计 gRPC 请求数，grpc_request_duration_ms 按方法累计处理时间，
+两者相除就是平均耗时；gateway_http_requests 按 HTTP 状态码统计网关的请求数。
+*/
+var (
+	grpcRequests        = expvar.NewMap("grpc_requests")
+	grpcRequestDuration = expvar.NewMap("grpc_request_duration_ms")
+	gatewayRequests     = expvar.NewMap("gateway_http_requests")
+)
+
+// MetricsPath 是 expvar 统计数据的路径
+const MetricsPath = "/debug/vars"
+
+// PrometheusPath 是 Prometheus 指标（比如 db/metrics 记录的数据库调用）的路径
+const PrometheusPath = "/metrics"
+
+/*
+RegisterMetricsHandlers 在 mux 上注册 expvar 和 Prometheus 的处理函数。
+指标里有方法名、请求量和队列的情况，不能放在公开的网关上：main.go 把它们注册到只在内网监听的
+METRICS_SERVER_ADDRESS 上，只有 config.DebugEnabled() 的时候才同时注册到网关上，方便本地开发。
+*/
+func RegisterMetricsHandlers(mux *http.ServeMux) {
+	mux.Handle(MetricsPath, expvar.Handler())
+	mux.Handle(PrometheusPath, promhttp.Handler())
+}
+
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	startTime := time.Now()
+	resp, err := handler(ctx, req)
+
+	grpcRequests.Add(info.FullMethod+" "+status.Code(err).String(), 1)
+	grpcRequestDuration.Add(info.FullMethod, time.Since(startTime).Milliseconds())
+	return resp, err
+}
+
+// HttpMetrics 统计 HTTP 网关的请求。路径里带着 ID，不适合作为 key，所以只按状态码统计
+func HttpMetrics(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		rec := &ResponseRecorder{
+			ResponseWriter: res,
+			StatusCode:     http.StatusOK,
+		}
+		handler.ServeHTTP(rec, req)
+		gatewayRequests.Add(strconv.Itoa(rec.StatusCode), 1)
+	})
+}
@@ -0,0 +1,54 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+rateLimit 按 RATE_LIMIT_RPS / RATE_LIMIT_BURST 限流，超过限制返回 ResourceExhausted。
+已经通过 authenticate 的请求按用户名计算，其他请求按客户端 IP 计算。
+*/
+func (server *Server) rateLimit(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !server.rateLimiter.Allow(rateLimitKey(ctx)) {
+		return nil, status.Error(codes.ResourceExhausted, "too many requests, please try again later")
+	}
+	return handler(ctx, req)
+}
+
+func rateLimitKey(ctx context.Context) string {
+	if payload, ok := authPayloadFromContext(ctx); ok {
+		return "user:" + payload.Username
+	}
+	if address := clientAddress(ctx); address != "" {
+		return "ip:" + hostOf(address)
+	}
+	return "ip:unknown"
+}
+
+/*
+HttpRateLimit 是不经过 gRPC 的 HTTP 接口（GraphQL、文件下载）的限流中间件，在认证之前限流，所以只能按 IP 计算。
+网关的 API 请求由 gRPC 的 rateLimit 拦截器限流，不能再经过这个中间件，否则同一个请求会算两次。
+*/
+func (server *Server) HttpRateLimit(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if !server.rateLimiter.Allow("ip:" + hostOf(req.RemoteAddr)) {
+			http.Error(res, "too many requests, please try again later", http.StatusTooManyRequests)
+			return
+		}
+		handler.ServeHTTP(res, req)
+	})
+}
+
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
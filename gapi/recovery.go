@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+RecoveryInterceptor 捕获处理函数里的 panic，记录 panic 的内容和调用栈，
+然后给客户端返回 Internal，而不是让整个进程退出。调用栈只写日志，不返回给客户端。
+*/
+func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			LoggerFrom(ctx).Error().Str("protocol", "grpc").
+				Interface("panic", r).
+				Str("stack", string(debug.Stack())).
+				Msg("recovered from panic")
+			resp, err = nil, status.Error(codes.Internal, "internal server error")
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// HttpRecovery 是 HTTP 网关的 panic 恢复中间件，返回 500
+func HttpRecovery(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer func() {
+			if r := recover(); r != nil {
+				// http.ErrAbortHandler 是故意中断响应，交给 net/http 处理
+				if r == http.ErrAbortHandler {
+					panic(r)
+				}
+				LoggerFrom(req.Context()).Error().Str("protocol", "http").
+					Str("path", req.RequestURI).
+					Interface("panic", r).
+					Str("stack", string(debug.Stack())).
+					Msg("recovered from panic")
+				http.Error(res, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}()
+
+		handler.ServeHTTP(res, req)
+	})
+}
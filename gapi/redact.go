@@ -0,0 +1,99 @@
+package gapi
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+/*
+记录请求和响应内容之前要先脱敏，密码、验证码和令牌不能出现在日志里。
+Redactor 按字段名（proto 里的名字）匹配：
+  - sensitiveFields 里的字段名完全匹配；
+  - 以 _token、_password、_secret 结尾的字段也算敏感字段（比如 access_token、refresh_token）。
+
+脱敏是在消息的副本上做的，不会改动真正的请求和响应。
+*/
+
+const redactedValue = "[REDACTED]"
+
+var sensitiveFields = []string{
+	"password",
+	"hashed_password",
+	"secret_code",
+	"token",
+	"code",
+	"state",
+}
+
+var sensitiveSuffixes = []string{
+	"_token",
+	"_password",
+	"_secret",
+}
+
+type Redactor struct {
+	fields map[string]bool
+}
+
+// NewRedactor 创建一个 Redactor，extraFields 是在默认列表之外还要脱敏的字段
+func NewRedactor(extraFields ...string) *Redactor {
+	fields := make(map[string]bool, len(sensitiveFields)+len(extraFields))
+	for _, field := range sensitiveFields {
+		fields[field] = true
+	}
+	for _, field := range extraFields {
+		field = strings.TrimSpace(field)
+		if field != "" {
+			fields[field] = true
+		}
+	}
+	return &Redactor{fields: fields}
+}
+
+func (redactor *Redactor) isSensitive(name string) bool {
+	if redactor.fields[name] {
+		return true
+	}
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact 返回脱敏之后的消息副本
+func (redactor *Redactor) Redact(msg proto.Message) proto.Message {
+	clone := proto.Clone(msg)
+	redactor.redactMessage(clone.ProtoReflect())
+	return clone
+}
+
+func (redactor *Redactor) redactMessage(msg protoreflect.Message) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case redactor.isSensitive(string(field.Name())) && !field.IsList() && !field.IsMap():
+			switch field.Kind() {
+			case protoreflect.StringKind:
+				msg.Set(field, protoreflect.ValueOfString(redactedValue))
+			case protoreflect.BytesKind:
+				msg.Clear(field)
+			}
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redactor.redactMessage(list.Get(i).Message())
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				redactor.redactMessage(v.Message())
+				return true
+			})
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			redactor.redactMessage(value.Message())
+		}
+		return true
+	})
+}
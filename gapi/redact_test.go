@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+)
+
+func TestRedactor(t *testing.T) {
+	redactor := NewRedactor("email")
+
+	login := &pb.LoginUserResponse{
+		User:         &pb.User{Username: "alice", Email: "alice@example.com"},
+		SessionId:    "session-id",
+		AccessToken:  "v2.local.access",
+		RefreshToken: "v2.local.refresh",
+	}
+
+	redacted := redactor.Redact(login).(*pb.LoginUserResponse)
+	require.Equal(t, redactedValue, redacted.GetAccessToken())
+	require.Equal(t, redactedValue, redacted.GetRefreshToken())
+	require.Equal(t, "session-id", redacted.GetSessionId())
+	require.Equal(t, "alice", redacted.GetUser().GetUsername())
+	require.Equal(t, redactedValue, redacted.GetUser().GetEmail())
+
+	// 原来的消息不受影响
+	require.Equal(t, "v2.local.access", login.GetAccessToken())
+	require.Equal(t, "alice@example.com", login.GetUser().GetEmail())
+
+	password := "secret123"
+	update := redactor.Redact(&pb.UpdateUserRequest{Username: "alice", Password: &password}).(*pb.UpdateUserRequest)
+	require.Equal(t, redactedValue, update.GetPassword())
+
+	verify := NewRedactor().Redact(&pb.VerifyEmailRequest{EmailId: 1, SecretCode: "abc"}).(*pb.VerifyEmailRequest)
+	require.Equal(t, redactedValue, verify.GetSecretCode())
+	require.Equal(t, int64(1), verify.GetEmailId())
+}
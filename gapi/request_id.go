@@ -0,0 +1,25 @@
+package gapi
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader 是请求 ID 的 HTTP 头，客户端可以自己传，没有传的话网关会生成一个
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID 是一个 HTTP 中间件，保证每个请求都有请求 ID，并在响应头里原样返回，
+// 错误响应里的 request_id 也来自这里。
+func RequestID(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requestID := req.Header.Get(RequestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+			req.Header.Set(RequestIDHeader, requestID)
+		}
+
+		res.Header().Set(RequestIDHeader, requestID)
+		handler.ServeHTTP(res, req)
+	})
+}
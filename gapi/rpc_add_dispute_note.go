@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddDisputeNote 由 banker 给争议添加一条内部备注，open 的争议收到第一条备注之后变成 under_review 并通知用户。
+func (server *Server) AddDisputeNote(ctx context.Context, req *pb.AddDisputeNoteRequest) (*pb.AddDisputeNoteResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	txResult, err := server.store.AddDisputeNoteTx(ctx, db.AddDisputeNoteTxParams{
+		DisputeID: req.GetDisputeId(),
+		Author:    authPayload.Username,
+		Note:      req.GetNote(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "dispute not found")
+		}
+		if errors.Is(err, db.ErrDisputeResolved) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to add dispute note: %s", err)
+	}
+
+	if txResult.StatusChanged {
+		server.distributeDisputeUpdate(ctx, txResult.Dispute)
+	}
+
+	rsp := &pb.AddDisputeNoteResponse{
+		Dispute: convertDispute(txResult.Dispute),
+		Note:    convertDisputeNote(txResult.Note),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ApplyForLoan 提交一笔贷款申请，状态是 pending，要等 banker 调用 ApproveLoan 批准之后才会放款。
+年利率在申请时按当前配置（LOAN_ANNUAL_RATE_BPS）确定，之后配置变化不影响已经申请的贷款。
+
+响应里的还款计划只是预估，真正的还款计划在批准时按批准日期生成。
+*/
+func (server *Server) ApplyForLoan(ctx context.Context, req *pb.ApplyForLoanRequest) (*pb.ApplyForLoanResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	fundingAccounts, err := util.ParseCurrencyAccounts(server.config.LoanFundingAccounts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid loan funding accounts: %s", err)
+	}
+	if _, ok := fundingAccounts[account.Currency]; !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "loans are not available in %s", account.Currency)
+	}
+
+	loan, err := server.store.CreateLoan(ctx, db.CreateLoanParams{
+		AccountID:     account.ID,
+		Principal:     req.GetPrincipal(),
+		Currency:      account.Currency,
+		AnnualRateBps: server.config.LoanAnnualRateBps,
+		TermMonths:    req.GetTermMonths(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create loan: %s", err)
+	}
+
+	schedule := util.LoanSchedule(loan.Principal, loan.AnnualRateBps, loan.TermMonths, time.Now())
+
+	rsp := &pb.ApplyForLoanResponse{
+		Loan:     convertLoan(loan),
+		Schedule: make([]*pb.LoanPayment, len(schedule)),
+	}
+	for i, installment := range schedule {
+		rsp.Schedule[i] = convertLoanInstallment(installment)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ApproveLoan 由 banker 批准或拒绝一笔 pending 的贷款申请。
+批准时从对应币种的放贷账户（LOAN_FUNDING_ACCOUNTS）放款并生成还款计划，
+之后由 worker 的定时任务按计划从借款人的账户扣款（见 worker/task_collect_loan_payments.go）。
+
+banker 不能批准自己账户的贷款。
+*/
+func (server *Server) ApproveLoan(ctx context.Context, req *pb.ApproveLoanRequest) (*pb.ApproveLoanResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	loan, err := server.store.GetLoan(ctx, req.GetLoanId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "loan not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get loan: %s", err)
+	}
+
+	account, err := server.store.GetAccount(ctx, loan.AccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot review your own loan")
+	}
+
+	if !req.GetApprove() {
+		loan, err = server.store.RejectLoan(ctx, db.RejectLoanParams{
+			ReviewedBy: pgtype.Text{String: authPayload.Username, Valid: true},
+			ID:         loan.ID,
+		})
+		if err != nil {
+			// RejectLoan 只更新 pending 的贷款
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrLoanNotPending)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to reject loan: %s", err)
+		}
+
+		rsp := &pb.ApproveLoanResponse{
+			Loan: convertLoan(loan),
+		}
+		return rsp, nil
+	}
+
+	fundingAccounts, err := util.ParseCurrencyAccounts(server.config.LoanFundingAccounts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid loan funding accounts: %s", err)
+	}
+	fundingAccountID, ok := fundingAccounts[loan.Currency]
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "loans are not available in %s", loan.Currency)
+	}
+
+	txResult, err := server.store.ApproveLoanTx(ctx, db.ApproveLoanTxParams{
+		LoanID:           loan.ID,
+		FundingAccountID: fundingAccountID,
+		ReviewedBy:       authPayload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrLoanNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "loan funding account has insufficient balance")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to approve loan: %s", err)
+	}
+
+	rsp := &pb.ApproveLoanResponse{
+		Loan:     convertLoan(txResult.Loan),
+		Schedule: make([]*pb.LoanPayment, len(txResult.Payments)),
+	}
+	for i, payment := range txResult.Payments {
+		rsp.Schedule[i] = convertLoanPayment(payment)
+	}
+	return rsp, nil
+}
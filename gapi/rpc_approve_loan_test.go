@@ -0,0 +1,173 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestApproveLoanAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	borrower, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: borrower.Username, Currency: util.USD}
+	loan := db.Loan{
+		ID:            5,
+		AccountID:     account.ID,
+		Principal:     1200,
+		Currency:      util.USD,
+		AnnualRateBps: 800,
+		TermMonths:    12,
+		Status:        util.LoanPending,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ApproveLoanRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ApproveLoanResponse, err error)
+	}{
+		{
+			name: "Approve",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(loan, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ApproveLoanTxParams{
+					LoanID:           loan.ID,
+					FundingAccountID: 99,
+					ReviewedBy:       banker.Username,
+				}
+				approved := loan
+				approved.Status = util.LoanActive
+				store.EXPECT().
+					ApproveLoanTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ApproveLoanTxResult{
+						Loan:     approved,
+						Payments: []db.LoanPayment{{Installment: 1, Amount: 105}, {Installment: 2, Amount: 105}},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.LoanActive, res.GetLoan().GetStatus())
+				require.Len(t, res.GetSchedule(), 2)
+			},
+		},
+		{
+			name: "Reject",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: false},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(loan, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				rejected := loan
+				rejected.Status = util.LoanRejected
+				store.EXPECT().RejectLoan(gomock.Any(), gomock.Any()).Times(1).Return(rejected, nil)
+				store.EXPECT().ApproveLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.LoanRejected, res.GetLoan().GetStatus())
+				require.Empty(t, res.GetSchedule())
+			},
+		},
+		{
+			name: "NotPending",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(loan, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					ApproveLoanTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ApproveLoanTxResult{}, db.ErrLoanNotPending)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "OwnLoan",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(loan, nil)
+				store.EXPECT().
+					GetAccount(gomock.Any(), gomock.Eq(account.ID)).
+					Times(1).
+					Return(db.Account{ID: account.ID, Owner: banker.Username}, nil)
+				store.EXPECT().ApproveLoanTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Eq(loan.ID)).Times(1).Return(db.Loan{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "DepositorCannotApprove",
+			req:  &pb.ApproveLoanRequest{LoanId: loan.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLoan(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, borrower.Username, borrower.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveLoanResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.config.LoanFundingAccounts = "USD:99"
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ApproveLoan(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+AuthorizeCardTransaction 是给卡组织（card network）调用的内部接口，所以放在 AdminService 里。
+授权通过时在卡绑定的账户上冻结（hold）交易金额，返回 hold_id，之后商户结算时用它 capture。
+
+卡被拒绝（冻结、过期、余额不足……）不算 RPC 错误，而是返回 approved=false 和拒绝原因，
+这和真实的卡组织协议一样：授权请求本身是成功处理了的。
+*/
+func (server *Server) AuthorizeCardTransaction(ctx context.Context, req *pb.AuthorizeCardTransactionRequest) (*pb.AuthorizeCardTransactionResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAuthorizeCardTransactionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	card, err := server.store.GetCardByPanHash(ctx, util.HashPAN(server.config.CardPANKey, req.GetCardNumber()))
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return declineCardTransaction("invalid card"), nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get card: %s", err)
+	}
+
+	if card.ExpiryMonth != req.GetExpiryMonth() || card.ExpiryYear != req.GetExpiryYear() {
+		return declineCardTransaction("invalid expiry date"), nil
+	}
+
+	txResult, err := server.store.AuthorizeCardTx(ctx, db.AuthorizeCardTxParams{
+		CardID:   card.ID,
+		Amount:   req.GetAmount(),
+		Currency: req.GetCurrency(),
+		Merchant: req.GetMerchant(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrCardFrozen),
+			errors.Is(err, db.ErrCardExpired),
+			errors.Is(err, db.ErrCardCurrencyMismatch),
+			errors.Is(err, db.ErrInsufficientBalance):
+			return declineCardTransaction(err.Error()), nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authorize card transaction: %s", err)
+	}
+
+	rsp := &pb.AuthorizeCardTransactionResponse{
+		Approved: true,
+		HoldId:   txResult.Hold.ID,
+	}
+	return rsp, nil
+}
+
+func declineCardTransaction(reason string) *pb.AuthorizeCardTransactionResponse {
+	return &pb.AuthorizeCardTransactionResponse{
+		Approved:      false,
+		DeclineReason: reason,
+	}
+}
+
+func validateAuthorizeCardTransactionRequest(req *pb.AuthorizeCardTransactionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateCardNumber(req.GetCardNumber()); err != nil {
+		violations = append(violations, fieldViolation("card_number", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
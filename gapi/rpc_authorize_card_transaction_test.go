@@ -0,0 +1,210 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAuthorizeCardTransactionAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	panKey := util.RandomString(32)
+	pan, err := util.GeneratePAN(util.CardBIN, util.CardNumberLength)
+	require.NoError(t, err)
+
+	card := db.Card{
+		ID:          7,
+		AccountID:   1,
+		PanHash:     util.HashPAN(panKey, pan),
+		Last4:       pan[len(pan)-4:],
+		ExpiryMonth: 12,
+		ExpiryYear:  2030,
+		Status:      util.CardActive,
+	}
+
+	validRequest := func() *pb.AuthorizeCardTransactionRequest {
+		return &pb.AuthorizeCardTransactionRequest{
+			CardNumber:  pan,
+			ExpiryMonth: card.ExpiryMonth,
+			ExpiryYear:  card.ExpiryYear,
+			Amount:      100,
+			Currency:    util.USD,
+			Merchant:    "Coffee Shop",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AuthorizeCardTransactionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error)
+	}{
+		{
+			name: "Approved",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Eq(card.PanHash)).Times(1).Return(card, nil)
+				arg := db.AuthorizeCardTxParams{
+					CardID:   card.ID,
+					Amount:   100,
+					Currency: util.USD,
+					Merchant: "Coffee Shop",
+				}
+				store.EXPECT().
+					AuthorizeCardTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.AuthorizeCardTxResult{
+						Card:              card,
+						PlaceHoldTxResult: db.PlaceHoldTxResult{Hold: db.Hold{ID: 3}},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetApproved())
+				require.Equal(t, int64(3), res.GetHoldId())
+			},
+		},
+		{
+			name: "DeclinedFrozen",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Eq(card.PanHash)).Times(1).Return(card, nil)
+				store.EXPECT().
+					AuthorizeCardTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AuthorizeCardTxResult{}, db.ErrCardFrozen)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetApproved())
+				require.Equal(t, db.ErrCardFrozen.Error(), res.GetDeclineReason())
+			},
+		},
+		{
+			name: "DeclinedInsufficientBalance",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Eq(card.PanHash)).Times(1).Return(card, nil)
+				store.EXPECT().
+					AuthorizeCardTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AuthorizeCardTxResult{}, db.ErrInsufficientBalance)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetApproved())
+				require.Zero(t, res.GetHoldId())
+			},
+		},
+		{
+			name: "DeclinedUnknownCard",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Any()).Times(1).Return(db.Card{}, db.ErrRecordNotFound)
+				store.EXPECT().AuthorizeCardTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetApproved())
+				require.Equal(t, "invalid card", res.GetDeclineReason())
+			},
+		},
+		{
+			name: "DeclinedWrongExpiry",
+			req: &pb.AuthorizeCardTransactionRequest{
+				CardNumber:  pan,
+				ExpiryMonth: 11,
+				ExpiryYear:  card.ExpiryYear,
+				Amount:      100,
+				Currency:    util.USD,
+				Merchant:    "Coffee Shop",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Eq(card.PanHash)).Times(1).Return(card, nil)
+				store.EXPECT().AuthorizeCardTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetApproved())
+			},
+		},
+		{
+			name: "InvalidLuhn",
+			req: &pb.AuthorizeCardTransactionRequest{
+				CardNumber:  "4000000000000001",
+				ExpiryMonth: card.ExpiryMonth,
+				ExpiryYear:  card.ExpiryYear,
+				Amount:      100,
+				Currency:    util.USD,
+				Merchant:    "Coffee Shop",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NotAdmin",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetCardByPanHash(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AuthorizeCardTransactionResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.config.CardPANKey = panKey
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.AuthorizeCardTransaction(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
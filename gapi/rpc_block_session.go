@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+BlockSession 处理新设备登录提醒邮件里的“不是我本人”链接，所以不需要登录，靠链接的签名证明请求来自邮件。
+封禁会话的效果和 RevokeSession 一样；另外把这台设备的记录删掉，
+下次再从这台设备登录时还会收到提醒。
+同一个链接点了多次也只是返回会话已经被封禁。
+*/
+func (server *Server) BlockSession(ctx context.Context, req *pb.BlockSessionRequest) (*pb.BlockSessionResponse, error) {
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	params := url.Values{}
+	params.Set("session_id", req.GetSessionId())
+	params.Set("device_id", strconv.FormatInt(req.GetDeviceId(), 10))
+	params.Set(util.LinkExpiresAtParam, strconv.FormatInt(req.GetExpiresAt(), 10))
+	params.Set(util.LinkSignatureParam, req.GetSignature())
+
+	err := util.NewLinkSigner(server.config.LinkSigningKey, util.LinkPurposeBlockSession).Verify(params)
+	if err != nil {
+		if errors.Is(err, util.ErrLinkExpired) {
+			return nil, status.Errorf(codes.FailedPrecondition, "link has expired, the session has already expired")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block session link")
+	}
+
+	session, err := server.store.GetSession(ctx, uuid.MustParse(req.GetSessionId()))
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	// BlockSession 返回 ErrRecordNotFound 说明会话刚刚被另一个请求封禁了
+	if !session.IsBlocked {
+		_, err = server.store.BlockSession(ctx, session.ID)
+		if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Internal, "failed to block session: %s", err)
+		}
+
+		err = server.revocationStore.Revoke(ctx, session.ID, time.Until(session.ExpiresAt))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke refresh token: %s", err)
+		}
+	}
+
+	err = server.store.DeleteUserDevice(ctx, db.DeleteUserDeviceParams{
+		ID:       req.GetDeviceId(),
+		Username: session.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete user device: %s", err)
+	}
+
+	rsp := &pb.BlockSessionResponse{
+		IsBlocked: true,
+	}
+	return rsp, nil
+}
@@ -0,0 +1,177 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func signedBlockSessionRequest(t *testing.T, key string, sessionID uuid.UUID, deviceID int64, expiresAt time.Time) *pb.BlockSessionRequest {
+	params := url.Values{}
+	params.Set("session_id", sessionID.String())
+	params.Set("device_id", strconv.FormatInt(deviceID, 10))
+	params = util.NewLinkSigner(key, util.LinkPurposeBlockSession).Sign(params, expiresAt)
+
+	signedExpiresAt, err := strconv.ParseInt(params.Get(util.LinkExpiresAtParam), 10, 64)
+	require.NoError(t, err)
+
+	return &pb.BlockSessionRequest{
+		SessionId: sessionID.String(),
+		DeviceId:  deviceID,
+		ExpiresAt: signedExpiresAt,
+		Signature: params.Get(util.LinkSignatureParam),
+	}
+}
+
+func TestBlockSessionAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	session := db.Session{
+		ID:        uuid.New(),
+		Username:  user.Username,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	deviceID := util.RandomInt(1, 1000)
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(t *testing.T, key string) *pb.BlockSessionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				return signedBlockSessionRequest(t, key, session.ID, deviceID, session.ExpiresAt)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				blocked := session
+				blocked.IsBlocked = true
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(blocked, nil)
+				store.EXPECT().
+					DeleteUserDevice(gomock.Any(), gomock.Eq(db.DeleteUserDeviceParams{ID: deviceID, Username: user.Username})).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsBlocked())
+
+				revoked, err := server.revocationStore.IsRevoked(context.Background(), session.ID)
+				require.NoError(t, err)
+				require.True(t, revoked)
+			},
+		},
+		{
+			name: "AlreadyBlocked",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				return signedBlockSessionRequest(t, key, session.ID, deviceID, session.ExpiresAt)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				blocked := session
+				blocked.IsBlocked = true
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(blocked, nil)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().DeleteUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsBlocked())
+			},
+		},
+		{
+			name: "SessionNotFound",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				return signedBlockSessionRequest(t, key, session.ID, deviceID, session.ExpiresAt)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, db.ErrRecordNotFound)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "ExpiredLink",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				return signedBlockSessionRequest(t, key, session.ID, deviceID, time.Now().Add(-time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "TamperedSession",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				req := signedBlockSessionRequest(t, key, session.ID, deviceID, session.ExpiresAt)
+				req.SessionId = uuid.New().String()
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidSessionID",
+			buildRequest: func(t *testing.T, key string) *pb.BlockSessionRequest {
+				req := signedBlockSessionRequest(t, key, session.ID, deviceID, session.ExpiresAt)
+				req.SessionId = "invalid"
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, server *Server, res *pb.BlockSessionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			req := tc.buildRequest(t, server.config.LinkSigningKey)
+			res, err := server.BlockSession(context.Background(), req)
+			tc.checkResponse(t, server, res, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+CancelAccountDeletion 处理删除确认邮件里的取消链接。等待删除的用户不能登录，所以这里不需要登录，
+靠链接的签名证明请求来自邮件。链接的 expires_at 就是删除的时间，用它找到要取消的那次删除，
+取消之后又重新申请删除的话，旧邮件里的链接就不能取消新的删除了。
+取消之后用户可以正常登录，之前被踢下线的 session 不会恢复。
+*/
+func (server *Server) CancelAccountDeletion(ctx context.Context, req *pb.CancelAccountDeletionRequest) (*pb.CancelAccountDeletionResponse, error) {
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	params := url.Values{}
+	params.Set("username", req.GetUsername())
+	params.Set(util.LinkExpiresAtParam, strconv.FormatInt(req.GetExpiresAt(), 10))
+	params.Set(util.LinkSignatureParam, req.GetSignature())
+
+	err := util.NewLinkSigner(server.config.LinkSigningKey, util.LinkPurposeCancelAccountDeletion).Verify(params)
+	if err != nil {
+		if errors.Is(err, util.ErrLinkExpired) {
+			return nil, status.Errorf(codes.FailedPrecondition, "link has expired, the account has already been deleted")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cancel account deletion link")
+	}
+
+	_, err = server.store.CancelUserDeletion(ctx, db.CancelUserDeletionParams{
+		Username:            req.GetUsername(),
+		DeletionScheduledAt: pgtype.Timestamptz{Time: time.Unix(req.GetExpiresAt(), 0), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "account deletion is not pending, it may have already been cancelled")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel account deletion: %s", err)
+	}
+
+	rsp := &pb.CancelAccountDeletionResponse{
+		IsCancelled: true,
+	}
+	return rsp, nil
+}
+
+const accountDeletionPendingViolation = "ACCOUNT_DELETION_PENDING"
+
+// accountDeletionPendingError 在等待删除的用户登录时返回，提示用户用邮件里的链接取消删除
+func accountDeletionPendingError(username string) error {
+	return preconditionFailureError(
+		"account deletion is pending",
+		accountDeletionPendingViolation,
+		"users/"+username,
+		"open the link in the account deletion email to cancel the deletion, then sign in again",
+	)
+}
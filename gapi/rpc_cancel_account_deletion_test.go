@@ -0,0 +1,156 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func signedCancelAccountDeletionRequest(t *testing.T, key string, username string, scheduledAt time.Time) *pb.CancelAccountDeletionRequest {
+	params := url.Values{}
+	params.Set("username", username)
+	params = util.NewLinkSigner(key, util.LinkPurposeCancelAccountDeletion).Sign(params, scheduledAt)
+
+	signedExpiresAt, err := strconv.ParseInt(params.Get(util.LinkExpiresAtParam), 10, 64)
+	require.NoError(t, err)
+
+	return &pb.CancelAccountDeletionRequest{
+		Username:  username,
+		ExpiresAt: signedExpiresAt,
+		Signature: params.Get(util.LinkSignatureParam),
+	}
+}
+
+func TestCancelAccountDeletionAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(t *testing.T, key string) *pb.CancelAccountDeletionRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(t *testing.T, key string) *pb.CancelAccountDeletionRequest {
+				return signedCancelAccountDeletionRequest(t, key, user.Username, scheduledAt)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CancelUserDeletion(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CancelUserDeletionParams) (db.User, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.True(t, arg.DeletionScheduledAt.Time.Equal(scheduledAt))
+						return user, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsCancelled())
+			},
+		},
+		{
+			// 已经取消过了，或者取消之后又重新申请了删除
+			name: "NotPending",
+			buildRequest: func(t *testing.T, key string) *pb.CancelAccountDeletionRequest {
+				return signedCancelAccountDeletionRequest(t, key, user.Username, scheduledAt)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CancelUserDeletion(gomock.Any(), gomock.Eq(db.CancelUserDeletionParams{
+						Username:            user.Username,
+						DeletionScheduledAt: pgtype.Timestamptz{Time: time.Unix(scheduledAt.Unix(), 0), Valid: true},
+					})).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "ExpiredLink",
+			buildRequest: func(t *testing.T, key string) *pb.CancelAccountDeletionRequest {
+				return signedCancelAccountDeletionRequest(t, key, user.Username, time.Now().Add(-time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelUserDeletion(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "TamperedUsername",
+			buildRequest: func(t *testing.T, key string) *pb.CancelAccountDeletionRequest {
+				req := signedCancelAccountDeletionRequest(t, key, user.Username, scheduledAt)
+				req.Username = other.Username
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelUserDeletion(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "TamperedExpiresAt",
+			buildRequest: func(t *testing.T, key string) *pb.CancelAccountDeletionRequest {
+				req := signedCancelAccountDeletionRequest(t, key, user.Username, scheduledAt)
+				req.ExpiresAt++
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CancelUserDeletion(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CancelAccountDeletionResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			req := tc.buildRequest(t, server.config.LinkSigningKey)
+			res, err := server.CancelAccountDeletion(context.Background(), req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
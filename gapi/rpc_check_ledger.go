@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxUnbalancedTransfers 限制一次返回的异常转账数量，账本出问题时可能有很多条
+const maxUnbalancedTransfers = 100
+
+// CheckLedger 检查复式记账是否成立：每笔转账都要正好有两条 entries，
+// 转出账户 -amount、转入账户 +amount。新写入的 entries 由数据库约束保证，
+// 这里主要用来发现约束加上之前的历史数据，或者没有任何 entries 的转账。
+func (server *Server) CheckLedger(ctx context.Context, req *pb.CheckLedgerRequest) (*pb.CheckLedgerResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	transfersChecked, err := server.store.CountAllTransfers(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count transfers: %s", err)
+	}
+
+	transfers, err := server.store.ListUnbalancedTransfers(ctx, maxUnbalancedTransfers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list unbalanced transfers: %s", err)
+	}
+
+	rsp := &pb.CheckLedgerResponse{
+		Balanced:            len(transfers) == 0,
+		TransfersChecked:    transfersChecked,
+		UnbalancedTransfers: make([]*pb.UnbalancedTransfer, len(transfers)),
+	}
+	for i, transfer := range transfers {
+		rsp.UnbalancedTransfers[i] = &pb.UnbalancedTransfer{
+			TransferId:    transfer.TransferID,
+			FromAccountId: transfer.FromAccountID,
+			ToAccountId:   transfer.ToAccountID,
+			Amount:        transfer.Amount,
+			EntryCount:    transfer.EntryCount,
+			EntriesSum:    transfer.EntriesSum,
+		}
+	}
+	return rsp, nil
+}
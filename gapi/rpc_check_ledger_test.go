@@ -0,0 +1,119 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckLedgerAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	unbalanced := db.ListUnbalancedTransfersRow{
+		TransferID:    util.RandomInt(1, 1000),
+		FromAccountID: 1,
+		ToAccountID:   2,
+		Amount:        10,
+		EntryCount:    1,
+		EntriesSum:    -10,
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CheckLedgerResponse, err error)
+	}{
+		{
+			name: "Balanced",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountAllTransfers(gomock.Any()).Times(1).Return(int64(42), nil)
+				store.EXPECT().ListUnbalancedTransfers(gomock.Any(), gomock.Eq(int32(maxUnbalancedTransfers))).
+					Times(1).Return([]db.ListUnbalancedTransfersRow{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CheckLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetBalanced())
+				require.Equal(t, int64(42), res.GetTransfersChecked())
+				require.Empty(t, res.GetUnbalancedTransfers())
+			},
+		},
+		{
+			name: "Unbalanced",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountAllTransfers(gomock.Any()).Times(1).Return(int64(42), nil)
+				store.EXPECT().ListUnbalancedTransfers(gomock.Any(), gomock.Any()).
+					Times(1).Return([]db.ListUnbalancedTransfersRow{unbalanced}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CheckLedgerResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetBalanced())
+				require.Len(t, res.GetUnbalancedTransfers(), 1)
+
+				transfer := res.GetUnbalancedTransfers()[0]
+				require.Equal(t, unbalanced.TransferID, transfer.GetTransferId())
+				require.Equal(t, int64(1), transfer.GetEntryCount())
+				require.Equal(t, int64(-10), transfer.GetEntriesSum())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountAllTransfers(gomock.Any()).Times(1).Return(int64(0), errors.New("db is down"))
+				store.EXPECT().ListUnbalancedTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CheckLedgerResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "BankerCannotCheckLedger",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CountAllTransfers(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CheckLedgerResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CheckLedger(ctx, &pb.CheckLedgerRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+CollectPayment 由收款方调用，根据 mandate 从付款方账户扣款到收款方账户。
+mandate 是否被撤销、额度是否足够、付款方余额是否足够都在 CollectPaymentTx 的事务里检查，
+这样并发扣款的时候也不会超过授权的额度。
+*/
+func (server *Server) CollectPayment(ctx context.Context, req *pb.CollectPaymentRequest) (*pb.CollectPaymentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	mandate, err := server.store.GetMandate(ctx, req.GetMandateId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "mandate not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get mandate: %s", err)
+	}
+
+	payeeAccount, err := server.store.GetAccount(ctx, mandate.PayeeAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "payee account has been closed")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payee account: %s", err)
+	}
+
+	if payeeAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "payee account doesn't belong to the authenticated user")
+	}
+
+	txResult, err := server.store.CollectPaymentTx(ctx, db.CollectPaymentTxParams{
+		MandateID: mandate.ID,
+		Amount:    req.GetAmount(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrMandateRevoked) ||
+			errors.Is(err, db.ErrMandateLimitExceeded) ||
+			errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to collect payment: %s", err)
+	}
+
+	server.distributeTransferAlert(ctx, txResult.TransferTxResult)
+
+	rsp := &pb.CollectPaymentResponse{
+		Mandate:  convertMandate(txResult.Mandate),
+		Transfer: convertTransfer(txResult.Transfer),
+	}
+	return rsp, nil
+}
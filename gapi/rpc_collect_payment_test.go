@@ -0,0 +1,193 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCollectPaymentAPI(t *testing.T) {
+	payer, _ := randomUser(t, util.DepositorRole)
+	payee, _ := randomUser(t, util.DepositorRole)
+
+	payerAccount := db.Account{ID: 1, Owner: payer.Username, Balance: 1000, Currency: util.USD}
+	payeeAccount := db.Account{ID: 2, Owner: payee.Username, Currency: util.USD}
+	mandate := db.Mandate{
+		ID:             util.RandomInt(1, 1000),
+		PayerAccountID: payerAccount.ID,
+		PayeeAccountID: payeeAccount.ID,
+		MaxAmount:      100,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CollectPaymentRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CollectPaymentResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CollectPaymentRequest{
+				MandateId: mandate.ID,
+				Amount:    50,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).Times(1).Return(mandate, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payeeAccount.ID)).Times(1).Return(payeeAccount, nil)
+
+				collected := mandate
+				collected.CollectedAmount = 50
+				arg := db.CollectPaymentTxParams{
+					MandateID: mandate.ID,
+					Amount:    50,
+				}
+				store.EXPECT().
+					CollectPaymentTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.CollectPaymentTxResult{
+						Mandate: collected,
+						TransferTxResult: db.TransferTxResult{
+							Transfer: db.Transfer{
+								ID:            7,
+								FromAccountID: payerAccount.ID,
+								ToAccountID:   payeeAccount.ID,
+								Amount:        50,
+							},
+						},
+					}, nil)
+
+				taskDistributor.EXPECT().
+					DistributeTaskSendTransferAlert(gomock.Any(), gomock.Eq(&worker.PayloadSendTransferAlert{TransferID: 7}), gomock.Any()).
+					Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payee.Username, payee.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CollectPaymentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(50), res.GetMandate().GetCollectedAmount())
+				require.Equal(t, payerAccount.ID, res.GetTransfer().GetFromAccountId())
+				require.Equal(t, payeeAccount.ID, res.GetTransfer().GetToAccountId())
+			},
+		},
+		{
+			name: "NotPayee",
+			req: &pb.CollectPaymentRequest{
+				MandateId: mandate.ID,
+				Amount:    50,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).Times(1).Return(mandate, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payeeAccount.ID)).Times(1).Return(payeeAccount, nil)
+				store.EXPECT().CollectPaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CollectPaymentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "LimitExceeded",
+			req: &pb.CollectPaymentRequest{
+				MandateId: mandate.ID,
+				Amount:    500,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).Times(1).Return(mandate, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payeeAccount.ID)).Times(1).Return(payeeAccount, nil)
+				store.EXPECT().
+					CollectPaymentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectPaymentTxResult{}, db.ErrMandateLimitExceeded)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payee.Username, payee.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CollectPaymentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "MandateNotFound",
+			req: &pb.CollectPaymentRequest{
+				MandateId: mandate.ID,
+				Amount:    50,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetMandate(gomock.Any(), gomock.Eq(mandate.ID)).Times(1).Return(db.Mandate{}, db.ErrRecordNotFound)
+				store.EXPECT().CollectPaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payee.Username, payee.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CollectPaymentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req: &pb.CollectPaymentRequest{
+				MandateId: mandate.ID,
+				Amount:    0,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetMandate(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CollectPaymentTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payee.Username, payee.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CollectPaymentResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CollectPayment(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+CreateBalanceAlert 给账户设置一个余额提醒，之后每次从这个账户转出钱时 TransferTx 都会检查它（见 util.BalanceAlertTriggered），
+触发的提醒随转账提醒一起通知账户的所有者。
+每个账户每种提醒只能有一个，已经存在时返回 AlreadyExists，要改阈值用 UpdateBalanceAlert。
+*/
+func (server *Server) CreateBalanceAlert(ctx context.Context, req *pb.CreateBalanceAlertRequest) (*pb.CreateBalanceAlertResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateBalanceAlertRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err := server.store.CreateBalanceAlert(ctx, db.CreateBalanceAlertParams{
+		AccountID: account.ID,
+		Kind:      req.GetKind(),
+		Threshold: req.GetThreshold(),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "account already has a %s alert", req.GetKind())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create balance alert: %s", err)
+	}
+
+	rsp := &pb.CreateBalanceAlertResponse{
+		BalanceAlert: convertBalanceAlert(alert),
+	}
+	return rsp, nil
+}
+
+func validateCreateBalanceAlertRequest(req *pb.CreateBalanceAlertRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateBalanceAlertKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	return violations
+}
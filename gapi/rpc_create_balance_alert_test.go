@@ -0,0 +1,152 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateBalanceAlertAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Currency: util.USD}
+	alert := db.BalanceAlert{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Kind:      util.BalanceAlertLowBalance,
+		Threshold: 5000,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateBalanceAlertRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: alert.Kind, Threshold: alert.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateBalanceAlert(gomock.Any(), gomock.Eq(db.CreateBalanceAlertParams{
+						AccountID: account.ID,
+						Kind:      alert.Kind,
+						Threshold: alert.Threshold,
+					})).
+					Times(1).
+					Return(alert, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, alert.ID, res.GetBalanceAlert().GetId())
+				require.Equal(t, alert.Kind, res.GetBalanceAlert().GetKind())
+				require.Equal(t, alert.Threshold, res.GetBalanceAlert().GetThreshold())
+			},
+		},
+		{
+			name: "AlreadyExists",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: alert.Kind, Threshold: alert.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateBalanceAlert(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BalanceAlert{}, db.ErrUniqueViolation)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.AlreadyExists)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: alert.Kind, Threshold: alert.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "InvalidKind",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: "high_balance", Threshold: alert.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidThreshold",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: alert.Kind, Threshold: 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.CreateBalanceAlertRequest{AccountId: account.ID, Kind: alert.Kind, Threshold: alert.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateBalanceAlert(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
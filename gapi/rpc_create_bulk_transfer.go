@@ -0,0 +1,211 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 一次批量转账最多包含的转账笔数
+const maxBulkTransferItems = 100
+
+/*
+CreateBulkTransfer 从用户自己的一个账户向多个账户转账（例如发工资）。
+目标账户可以用 to_account_id 指定，也可以用收款人给的账号（to_account_number）指定。
+
+默认所有转账在一个事务里完成，任何一笔失败都会让整个请求失败；
+如果 best_effort 为 true，每一笔单独执行，响应里会返回每一笔的成功或失败状态。
+目标账户不存在、币种不一致或者金额超过 KYC 转账限额时，原子模式下直接返回错误，best_effort 模式下只把这一笔标记为失败。
+*/
+func (server *Server) CreateBulkTransfer(ctx context.Context, req *pb.CreateBulkTransferRequest) (*pb.CreateBulkTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateBulkTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "from account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get from account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	if fromAccount.Currency != req.GetCurrency() {
+		return nil, status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s",
+			fromAccount.ID, fromAccount.Currency, req.GetCurrency())
+	}
+
+	kycLimit, err := server.kycTransferLimit(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.BulkTransferItemResult, len(req.GetItems()))
+	arg := db.BulkTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		BestEffort:    req.GetBestEffort(),
+	}
+	// indexes 记录 arg.Items 中每一笔对应请求里的哪一笔，因为 best_effort 模式下无效的转账不会提交给数据库
+	indexes := make([]int, 0, len(req.GetItems()))
+
+	for i, item := range req.GetItems() {
+		// 请求已经校验过，这里只是把 amount_decimal 转换成最小货币单位
+		amount, _ := val.ValidateMoney(item.GetAmount(), item.GetAmountDecimal(), req.GetCurrency())
+		results[i] = &pb.BulkTransferItemResult{
+			ToAccountId: item.GetToAccountId(),
+			Amount:      amount,
+		}
+
+		toAccountID, err := server.bulkTransferToAccount(ctx, item, fromAccount.ID, req.GetCurrency())
+		results[i].ToAccountId = toAccountID
+		if err == nil && kycLimit > 0 && amount > kycLimit {
+			err = kycLimitError(amount, kycLimit)
+		}
+		if err != nil {
+			if !req.GetBestEffort() {
+				return nil, err
+			}
+			results[i].Error = status.Convert(err).Message()
+			continue
+		}
+
+		arg.Items = append(arg.Items, db.BulkTransferItem{
+			ToAccountID: toAccountID,
+			Amount:      amount,
+			Category:    item.GetCategory(),
+			Memo:        item.GetMemo(),
+			Reference:   item.GetReference(),
+		})
+		indexes = append(indexes, i)
+	}
+
+	txResult, err := server.store.BulkTransferTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create bulk transfer: %s", err)
+	}
+
+	for j, itemResult := range txResult.Items {
+		result := results[indexes[j]]
+		if itemResult.Err != nil {
+			result.Error = itemResult.Err.Error()
+			continue
+		}
+
+		result.Success = true
+		result.Transfer = convertTransfer(itemResult.Transfer)
+		fromAccount = itemResult.FromAccount
+
+		server.distributeTransferAlert(ctx, itemResult.TransferTxResult)
+	}
+
+	rsp := &pb.CreateBulkTransferResponse{
+		Results:     results,
+		FromAccount: convertAccount(fromAccount),
+	}
+	return rsp, nil
+}
+
+// validToAccount 检查目标账户是否存在并且币种一致，返回的 error 已经是 gRPC status 错误
+func (server *Server) validToAccount(ctx context.Context, accountID int64, currency string) error {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "account [%d] not found", accountID)
+		}
+		return status.Errorf(codes.Internal, "failed to get account [%d]: %s", accountID, err)
+	}
+
+	if account.Currency != currency {
+		return status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s",
+			account.ID, account.Currency, currency)
+	}
+
+	return nil
+}
+
+/*
+bulkTransferToAccount 返回一笔转账的目标账户 ID，并检查目标账户是否存在并且币种一致，返回的 error 已经是 gRPC status 错误。
+用 to_account_number 指定目标账户时按账号查询，错误信息里只出现账号，不暴露账户的 ID。
+*/
+func (server *Server) bulkTransferToAccount(ctx context.Context, item *pb.BulkTransferItem, fromAccountID int64, currency string) (int64, error) {
+	if item.GetToAccountNumber() == "" {
+		return item.GetToAccountId(), server.validToAccount(ctx, item.GetToAccountId(), currency)
+	}
+
+	number := util.NormalizeAccountNumber(item.GetToAccountNumber())
+	account, err := server.store.GetAccountByNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return 0, status.Errorf(codes.NotFound, "account [%s] not found", number)
+		}
+		return 0, status.Errorf(codes.Internal, "failed to get account [%s]: %s", number, err)
+	}
+
+	if account.ID == fromAccountID {
+		return 0, status.Errorf(codes.InvalidArgument, "account [%s] is the from account", number)
+	}
+	if account.Currency != currency {
+		return 0, status.Errorf(codes.InvalidArgument, "account [%s] currency mismatch: %s vs %s",
+			number, account.Currency, currency)
+	}
+
+	return account.ID, nil
+}
+
+func validateCreateBulkTransferRequest(req *pb.CreateBulkTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	for i, item := range req.GetItems() {
+		switch {
+		case item.GetToAccountNumber() != "":
+			if item.GetToAccountId() != 0 {
+				violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].to_account_number", i), fmt.Errorf("cannot be used together with to_account_id")))
+			} else if err := val.ValidateAccountNumber(util.NormalizeAccountNumber(item.GetToAccountNumber())); err != nil {
+				violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].to_account_number", i), err))
+			}
+		case item.GetToAccountId() == 0:
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].to_account_id", i), fmt.Errorf("either to_account_id or to_account_number is required")))
+		case item.GetToAccountId() == req.GetFromAccountId():
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].to_account_id", i), fmt.Errorf("must be different from from_account_id")))
+		}
+
+		if _, err := val.ValidateMoney(item.GetAmount(), item.GetAmountDecimal(), req.GetCurrency()); err != nil {
+			field := "amount"
+			if item.GetAmountDecimal() != "" {
+				field = "amount_decimal"
+			}
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].%s", i, field), err))
+		}
+
+		if err := val.ValidateCategory(item.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("items[%d].category", i), err))
+		}
+	}
+
+	return violations
+}
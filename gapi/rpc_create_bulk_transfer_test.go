@@ -0,0 +1,362 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBulkTransferAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+
+	fromAccount := db.Account{ID: 1, Owner: user.Username, Balance: 1000, Currency: util.USD}
+	toAccount1 := db.Account{ID: 2, Owner: other.Username, Currency: util.USD}
+	toAccount2 := db.Account{ID: 3, Owner: other.Username, Currency: util.USD, Number: util.RandomAccountNumber()}
+
+	// 第二笔用十进制字符串表示金额，0.20 USD 就是 20
+	items := []*pb.BulkTransferItem{
+		{ToAccountId: toAccount1.ID, Amount: 10},
+		{ToAccountId: toAccount2.ID, AmountDecimal: "0.20"},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateBulkTransferRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateBulkTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         items,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(toAccount1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount2.ID)).Times(1).Return(toAccount2, nil)
+
+				arg := db.BulkTransferTxParams{
+					FromAccountID: fromAccount.ID,
+					Items: []db.BulkTransferItem{
+						{ToAccountID: toAccount1.ID, Amount: 10},
+						{ToAccountID: toAccount2.ID, Amount: 20},
+					},
+				}
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BulkTransferTxResult{
+						Items: []db.BulkTransferItemResult{
+							{TransferTxResult: db.TransferTxResult{
+								Transfer:    db.Transfer{ID: 1},
+								FromAccount: db.Account{ID: fromAccount.ID, Balance: 990},
+							}},
+							{TransferTxResult: db.TransferTxResult{
+								Transfer:    db.Transfer{ID: 2},
+								FromAccount: db.Account{ID: fromAccount.ID, Balance: 970},
+							}},
+						},
+					}, nil)
+
+				for _, transferID := range []int64{1, 2} {
+					taskDistributor.EXPECT().
+						DistributeTaskSendTransferAlert(gomock.Any(), gomock.Eq(&worker.PayloadSendTransferAlert{TransferID: transferID}), gomock.Any()).
+						Times(1)
+				}
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetResults(), 2)
+				for _, result := range res.GetResults() {
+					require.True(t, result.GetSuccess())
+				}
+				require.Equal(t, int64(970), res.GetFromAccount().GetBalance())
+			},
+		},
+		{
+			name: "BestEffortPartialFailure",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         items,
+				BestEffort:    true,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount2.ID)).Times(1).Return(toAccount2, nil)
+
+				arg := db.BulkTransferTxParams{
+					FromAccountID: fromAccount.ID,
+					Items: []db.BulkTransferItem{
+						{ToAccountID: toAccount2.ID, Amount: 20},
+					},
+					BestEffort: true,
+				}
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BulkTransferTxResult{
+						Items: []db.BulkTransferItemResult{
+							{Err: db.ErrInsufficientBalance},
+						},
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetResults(), 2)
+				require.False(t, res.GetResults()[0].GetSuccess())
+				require.NotEmpty(t, res.GetResults()[0].GetError())
+				require.False(t, res.GetResults()[1].GetSuccess())
+				require.Equal(t, db.ErrInsufficientBalance.Error(), res.GetResults()[1].GetError())
+			},
+		},
+		{
+			name: "AtomicToAccountNotFound",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         items,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InsufficientBalance",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         items,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(toAccount1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount2.ID)).Times(1).Return(toAccount2, nil)
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.BulkTransferTxResult{}, db.ErrInsufficientBalance)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "FromAccountNotOwned",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         items,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "InvalidItems",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items: []*pb.BulkTransferItem{
+					{ToAccountId: fromAccount.ID, Amount: 10},
+					{ToAccountId: toAccount2.ID, Amount: -1},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ToAccountNumber",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items: []*pb.BulkTransferItem{
+					// 用户输入的账号可以有空格和小写字母
+					{ToAccountNumber: strings.ToLower(toAccount2.Number[:4]) + " " + toAccount2.Number[4:], Amount: 10},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Eq(toAccount2.Number)).Times(1).Return(toAccount2, nil)
+
+				arg := db.BulkTransferTxParams{
+					FromAccountID: fromAccount.ID,
+					Items:         []db.BulkTransferItem{{ToAccountID: toAccount2.ID, Amount: 10}},
+				}
+				store.EXPECT().
+					BulkTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.BulkTransferTxResult{
+						Items: []db.BulkTransferItemResult{
+							{TransferTxResult: db.TransferTxResult{
+								Transfer:    db.Transfer{ID: 1},
+								FromAccount: db.Account{ID: fromAccount.ID, Balance: 990},
+							}},
+						},
+					}, nil)
+				taskDistributor.EXPECT().DistributeTaskSendTransferAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetResults(), 1)
+				require.True(t, res.GetResults()[0].GetSuccess())
+				require.Equal(t, toAccount2.ID, res.GetResults()[0].GetToAccountId())
+			},
+		},
+		{
+			name: "ToAccountNumberNotFound",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items:         []*pb.BulkTransferItem{{ToAccountNumber: toAccount2.Number, Amount: 10}},
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, db.ErrRecordNotFound)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidToAccountNumber",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Items: []*pb.BulkTransferItem{
+					{ToAccountNumber: "SB001234567890123456", Amount: 10},
+					{ToAccountId: toAccount1.ID, ToAccountNumber: toAccount2.Number, Amount: 10},
+					{Amount: 10},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetAccountByNumber(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				violations := status.Convert(err).Details()[0].(*errdetails.BadRequest).GetFieldViolations()
+				require.Len(t, violations, 3)
+				require.Equal(t, "items[0].to_account_number", violations[0].GetField())
+				require.Equal(t, "items[1].to_account_number", violations[1].GetField())
+				require.Equal(t, "items[2].to_account_id", violations[2].GetField())
+			},
+		},
+		{
+			name: "InvalidAmountDecimalForCurrency",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.JPY,
+				Items: []*pb.BulkTransferItem{
+					{ToAccountId: toAccount1.ID, AmountDecimal: "100.5"},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BulkTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateBulkTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				violations := status.Convert(err).Details()[0].(*errdetails.BadRequest).GetFieldViolations()
+				require.Equal(t, "items[0].amount_decimal", violations[0].GetField())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateBulkTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
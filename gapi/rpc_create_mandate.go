@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+CreateMandate 由付款方调用：授权收款方账户从自己的账户扣款，累计不超过 max_amount。
+只有付款账户的所有者才能创建 mandate，两个账户的币种必须一致。
+*/
+func (server *Server) CreateMandate(ctx context.Context, req *pb.CreateMandateRequest) (*pb.CreateMandateResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateMandateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payerAccount, err := server.store.GetAccount(ctx, req.GetPayerAccountId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "payer account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get payer account: %s", err)
+	}
+
+	if payerAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "payer account doesn't belong to the authenticated user")
+	}
+
+	err = server.validToAccount(ctx, req.GetPayeeAccountId(), payerAccount.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	mandate, err := server.store.CreateMandate(ctx, db.CreateMandateParams{
+		PayerAccountID: payerAccount.ID,
+		PayeeAccountID: req.GetPayeeAccountId(),
+		MaxAmount:      req.GetMaxAmount(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create mandate: %s", err)
+	}
+
+	rsp := &pb.CreateMandateResponse{
+		Mandate: convertMandate(mandate),
+	}
+	return rsp, nil
+}
+
+func validateCreateMandateRequest(req *pb.CreateMandateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if req.GetPayeeAccountId() == req.GetPayerAccountId() {
+		violations = append(violations, fieldViolation("payee_account_id", fmt.Errorf("must be different from payer_account_id")))
+	}
+
+	return violations
+}
@@ -2,7 +2,8 @@ package gapi
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/hibiken/asynq"
@@ -25,12 +26,16 @@ import (
 这说明在用户注册流程中集成了异步任务的创建。
 */
 func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	log.Println("Start CreateUser method")
 	violations := validateCreateUserRequest(req)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
+	referrer, err := server.referrer(ctx, req.GetReferralCode())
+	if err != nil {
+		return nil, err
+	}
+
 	hashedPassword, err := util.HashPassword(req.GetPassword())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
@@ -42,7 +47,10 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 			HashedPassword: hashedPassword,
 			FullName:       req.GetFullName(),
 			Email:          req.GetEmail(),
+			Locale:         requestLocale(ctx),
 		},
+		Referrer: referrer,
+		SignupIP: server.extractMetadata(ctx).ClientIP,
 		AfterCreate: func(user db.User) error {
 			taskPayload := &worker.PayloadSendVerifyEmail{
 				Username: user.Username,
@@ -68,6 +76,9 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 
 	if err != nil {
 		if db.ErrorCode(err) == db.UniqueViolation {
+			if db.ConstraintName(err) == usersEmailKey && server.hideDuplicateEmail(ctx) {
+				return server.duplicateEmailResponse(ctx, req), nil
+			}
 			return nil, status.Errorf(codes.AlreadyExists, err.Error())
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create user: %s", err)
@@ -79,24 +90,89 @@ func (server *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 	return rsp, nil
 }
 
-func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateUsername(req.GetUsername()); err != nil {
-		violations = append(violations, fieldViolation("username", err))
+/*
+referrer 返回推荐码的主人，没有填推荐码时返回空字符串。
+推荐码不存在时返回 InvalidArgument，让用户改正或者去掉推荐码再注册，而不是悄悄地注册成没有推荐人的用户。
+*/
+func (server *Server) referrer(ctx context.Context, referralCode string) (string, error) {
+	if referralCode == "" {
+		return "", nil
 	}
 
-	if err := val.ValidatePassword(req.GetPassword()); err != nil {
-		violations = append(violations, fieldViolation("password", err))
+	code, err := server.store.GetReferralCode(ctx, util.NormalizeReferralCode(referralCode))
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("referral_code", fmt.Errorf("unknown referral code")),
+			})
+		}
+		return "", status.Errorf(codes.Internal, "failed to get referral code: %s", err)
 	}
+	return code.Username, nil
+}
 
-	if err := val.ValidateFullName(req.GetFullName()); err != nil {
-		violations = append(violations, fieldViolation("full_name", err))
+// usersEmailKey 是 users 表 email 列的唯一约束
+const usersEmailKey = "users_email_key"
+
+/*
+hideDuplicateEmail 判断邮箱已经注册时是不是要隐藏这个错误（EMAIL_ENUMERATION_PROTECTION）。
+直接返回 AlreadyExists 的话，任何人都可以用注册接口一个一个试出哪些邮箱在这里开了户，
+所以对普通调用方返回和注册成功一样的响应，真正的情况只告诉邮箱的主人（见 duplicateEmailResponse）。
+banker 用内部工具替用户注册时需要准确的错误，带 banker 的访问令牌调用时不隐藏。
+*/
+func (server *Server) hideDuplicateEmail(ctx context.Context) bool {
+	if !server.config.EmailEnumProtection {
+		return false
 	}
 
-	if err := val.ValidateEmail(req.GetEmail()); err != nil {
-		violations = append(violations, fieldViolation("email", err))
+	// CreateUser 是公开接口，不要求令牌，令牌无效时按普通调用方处理
+	payload, ok := authPayloadFromContext(ctx)
+	if !ok {
+		var err error
+		if payload, err = server.authenticateUser(ctx); err != nil {
+			return true
+		}
 	}
+	return payload.Role != util.BankerRole
+}
 
-	return violations
+/*
+duplicateEmailResponse 在邮箱已经注册时代替 AlreadyExists 返回，看起来和注册成功一样，
+但是没有创建用户，也不会发验证邮件；同时分发任务给邮箱原来的主人发邮件，并在审计日志里记录这次尝试。
+分发失败只记日志，不能因为这个让响应和注册成功不一样。
+*/
+func (server *Server) duplicateEmailResponse(ctx context.Context, req *pb.CreateUserRequest) *pb.CreateUserResponse {
+	mtdt := server.extractMetadata(ctx)
+	LoggerFrom(ctx).Info().Str("attempted_username", req.GetUsername()).
+		Msg("sign up with an email that is already registered")
+
+	taskPayload := &worker.PayloadSendAccountExistsEmail{
+		Email:             req.GetEmail(),
+		AttemptedUsername: req.GetUsername(),
+		ClientIp:          mtdt.ClientIP,
+		Country:           mtdt.Country,
+		City:              mtdt.City,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	if err := server.taskDistributor.DistributeTaskSendAccountExistsEmail(ctx, taskPayload, opts...); err != nil {
+		LoggerFrom(ctx).Error().Err(err).Msg("failed to distribute account exists email")
+	}
+
+	now := time.Now()
+	return &pb.CreateUserResponse{
+		User: convertUser(db.User{
+			Username:  req.GetUsername(),
+			Role:      util.DepositorRole,
+			FullName:  req.GetFullName(),
+			Email:     req.GetEmail(),
+			CreatedAt: now,
+			Locale:    requestLocale(ctx),
+			UpdatedAt: now,
+		}),
+	}
 }
 
 /*
@@ -144,3 +220,28 @@ func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdeta
 但它们并不直接解决所有的邮件验证问题，而是作为一个更大的用户账户管理策略的一部分。
 可能还需要考虑如何处理那些即使在多次重试后仍然未能验证的账户。
 */
+
+// validateCreateUserRequest 用 val 校验新用户的字段，规则和错误信息和 Gin 版本的 createUser 一样
+func validateCreateUserRequest(req *pb.CreateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateFullName(req.GetFullName()); err != nil {
+		violations = append(violations, fieldViolation("full_name", err))
+	}
+
+	if err := val.ValidateEmail(req.GetEmail()); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	if err := val.ValidatePassword(req.GetPassword()); err != nil {
+		violations = append(violations, fieldViolation("password", err))
+	}
+
+	if req.GetReferralCode() != "" && !util.IsValidReferralCode(util.NormalizeReferralCode(req.GetReferralCode())) {
+		violations = append(violations, fieldViolation("referral_code", fmt.Errorf("invalid referral code")))
+	}
+
+	return violations
+}
@@ -6,15 +6,20 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/techschool/bank/db/mock"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
 	"github.com/techschool/bank/pb"
 	"github.com/techschool/bank/util"
 	"github.com/techschool/bank/worker"
 	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -41,6 +46,10 @@ func (expected eqCreateUserTxParamsMatcher) Matches(x interface{}) bool {
 		return false
 	}
 
+	if expected.arg.Referrer != actualArg.Referrer {
+		return false
+	}
+
 	err = actualArg.AfterCreate(expected.user)
 	return err == nil
 }
@@ -91,6 +100,7 @@ func TestCreateUserAPI(t *testing.T) {
 						Username: user.Username,
 						FullName: user.FullName,
 						Email:    user.Email,
+						Locale:   i18n.DefaultLocale,
 					},
 				}
 				store.EXPECT().
@@ -175,6 +185,90 @@ func TestCreateUserAPI(t *testing.T) {
 				require.Equal(t, codes.AlreadyExists, st.Code())
 			},
 		},
+		{
+			name: "ReferralCode",
+			req: &pb.CreateUserRequest{
+				Username:     user.Username,
+				Password:     password,
+				FullName:     user.FullName,
+				Email:        user.Email,
+				ReferralCode: " abcd 2345 ",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetReferralCode(gomock.Any(), gomock.Eq("ABCD2345")).
+					Times(1).
+					Return(db.ReferralCode{Username: "referrer", Code: "ABCD2345"}, nil)
+
+				arg := db.CreateUserTxParams{
+					CreateUserParams: db.CreateUserParams{
+						Username: user.Username,
+						FullName: user.FullName,
+						Email:    user.Email,
+						Locale:   i18n.DefaultLocale,
+					},
+					Referrer: "referrer",
+				}
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), EqCreateUserTxParams(arg, password, user)).
+					Times(1).
+					Return(db.CreateUserTxResult{User: user}, nil)
+
+				taskDistributor.EXPECT().
+					DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name: "UnknownReferralCode",
+			req: &pb.CreateUserRequest{
+				Username:     user.Username,
+				Password:     password,
+				FullName:     user.FullName,
+				Email:        user.Email,
+				ReferralCode: "ABCD2345",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetReferralCode(gomock.Any(), gomock.Eq("ABCD2345")).
+					Times(1).
+					Return(db.ReferralCode{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+				require.Equal(t, "referral_code", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "InvalidReferralCode",
+			req: &pb.CreateUserRequest{
+				Username:     user.Username,
+				Password:     password,
+				FullName:     user.FullName,
+				Email:        user.Email,
+				ReferralCode: "ABCD0000",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetReferralCode(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					CreateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
 		{
 			name: "InvalidEmail",
 			req: &pb.CreateUserRequest{
@@ -222,6 +316,114 @@ func TestCreateUserAPI(t *testing.T) {
 	}
 }
 
+func TestCreateUserDuplicateEmail(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+	duplicateEmail := &pgconn.PgError{Code: db.UniqueViolation, ConstraintName: "users_email_key"}
+	duplicateUsername := &pgconn.PgError{Code: db.UniqueViolation, ConstraintName: "users_pkey"}
+
+	testCases := []struct {
+		name          string
+		protection    bool
+		role          string
+		createErr     error
+		distributed   int
+		checkResponse func(t *testing.T, res *pb.CreateUserResponse, err error)
+	}{
+		{
+			name:        "GenericSuccess",
+			protection:  true,
+			createErr:   duplicateEmail,
+			distributed: 1,
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.Equal(t, user.Email, res.GetUser().GetEmail())
+				require.Equal(t, util.DepositorRole, res.GetUser().GetRole())
+				require.False(t, res.GetUser().GetIsEmailVerified())
+				require.WithinDuration(t, time.Now(), res.GetUser().GetCreatedAt().AsTime(), time.Second)
+			},
+		},
+		{
+			name:        "DepositorToken",
+			protection:  true,
+			role:        util.DepositorRole,
+			createErr:   duplicateEmail,
+			distributed: 1,
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name:       "Banker",
+			protection: true,
+			role:       util.BankerRole,
+			createErr:  duplicateEmail,
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name:      "ProtectionDisabled",
+			createErr: duplicateEmail,
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+		{
+			name:       "DuplicateUsername",
+			protection: true,
+			createErr:  duplicateUsername,
+			checkResponse: func(t *testing.T, res *pb.CreateUserResponse, err error) {
+				require.Equal(t, codes.AlreadyExists, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			taskDistributor := mockwk.NewMockTaskDistributor(ctrl)
+
+			store.EXPECT().
+				CreateUserTx(gomock.Any(), gomock.Any()).
+				Times(1).
+				Return(db.CreateUserTxResult{}, tc.createErr)
+			taskDistributor.EXPECT().
+				DistributeTaskSendVerifyEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(0)
+			taskDistributor.EXPECT().
+				DistributeTaskSendAccountExistsEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+				Times(tc.distributed).
+				DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendAccountExistsEmail, opts ...asynq.Option) error {
+					require.Equal(t, user.Email, payload.Email)
+					require.Equal(t, user.Username, payload.AttemptedUsername)
+					return nil
+				})
+
+			server := newTestServer(t, store, taskDistributor)
+			server.config.EmailEnumProtection = tc.protection
+
+			ctx := context.Background()
+			if tc.role != "" {
+				ctx = newContextWithBearerToken(t, server.tokenMaker, "staff", tc.role, time.Minute)
+			}
+
+			res, err := server.CreateUser(ctx, &pb.CreateUserRequest{
+				Username: user.Username,
+				Password: password,
+				FullName: user.FullName,
+				Email:    user.Email,
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
 /*
 单元测试通常的写法思路是模拟外部依赖，然后调用要测试的函数，并验证结果是否符合预期。对于 CreateUser API 的测试，这个过程涉及以下几个步骤：
 
@@ -0,0 +1,35 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteBalanceAlert 删除余额提醒，之后的转账不会再触发它
+func (server *Server) DeleteBalanceAlert(ctx context.Context, req *pb.DeleteBalanceAlertRequest) (*pb.DeleteBalanceAlertResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	alert, err := server.authorizeBalanceAlert(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeleteBalanceAlert(ctx, alert.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete balance alert: %s", err)
+	}
+
+	return &pb.DeleteBalanceAlertResponse{}, nil
+}
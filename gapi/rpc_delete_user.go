@@ -0,0 +1,128 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+DeleteUser 软删除一个用户：用户本人可以删除自己的账号，管理员可以删除任何用户。
+删除之后用户无法再登录，名下的账户会被关闭；
+如果还有账户余额不为 0，则拒绝删除，需要先把钱转走。
+
+用户的个人信息不会马上清除，而是在保留期（USER_DATA_RETENTION）之后
+由后台的匿名化任务处理，这样在保留期内仍然可以处理纠纷或者审计。
+
+用户删除自己的账号时，如果设置了 ACCOUNT_DELETION_DELAY，账号不会马上删除，见 scheduleUserDeletion。
+管理员删除用户时总是马上删除。
+*/
+func (server *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.DepositorRole, util.BankerRole, util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if authPayload.Role != util.AdminRole && authPayload.Username != req.GetUsername() {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot delete other user")
+	}
+
+	if authPayload.Username == req.GetUsername() && server.config.AccountDeletionDelay > 0 {
+		return server.scheduleUserDeletion(ctx, req.GetUsername())
+	}
+
+	arg := db.DeleteUserTxParams{
+		Username: req.GetUsername(),
+		AfterDelete: func(user db.User) error {
+			taskPayload := &worker.PayloadAnonymizeUser{
+				Username:  user.Username,
+				AvatarKey: user.AvatarKey,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.ProcessIn(server.config.UserDataRetention),
+				asynq.Queue(worker.QueueDefault),
+			}
+
+			return server.taskDistributor.DistributeTaskAnonymizeUser(ctx, taskPayload, opts...)
+		},
+	}
+
+	txResult, err := server.store.DeleteUserTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		if errors.Is(err, db.ErrAccountBalanceNotZero) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete user: %s", err)
+	}
+
+	rsp := &pb.DeleteUserResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
+
+/*
+scheduleUserDeletion 记下 ACCOUNT_DELETION_DELAY 之后删除账号，并分发在那个时间执行的 worker.TaskDeleteUser，
+同时给用户发一封带取消链接的确认邮件。等待删除期间用户的 session 都会失效，也不能再登录，
+只能用邮件里的链接取消删除（见 CancelAccountDeletion）。
+删除的时间只精确到秒，和取消链接里的 expires_at 一致。
+*/
+func (server *Server) scheduleUserDeletion(ctx context.Context, username string) (*pb.DeleteUserResponse, error) {
+	scheduledAt := time.Now().Add(server.config.AccountDeletionDelay).Truncate(time.Second)
+
+	arg := db.ScheduleUserDeletionTxParams{
+		Username:    username,
+		ScheduledAt: scheduledAt,
+		AfterSchedule: func(user db.User) error {
+			deletePayload := &worker.PayloadDeleteUser{
+				Username:    user.Username,
+				ScheduledAt: scheduledAt,
+			}
+			err := server.taskDistributor.DistributeTaskDeleteUser(ctx, deletePayload,
+				asynq.MaxRetry(10), asynq.ProcessAt(scheduledAt), asynq.Queue(worker.QueueDefault))
+			if err != nil {
+				return err
+			}
+
+			emailPayload := &worker.PayloadSendAccountDeletionEmail{
+				Username:    user.Username,
+				ScheduledAt: scheduledAt,
+			}
+			return server.taskDistributor.DistributeTaskSendAccountDeletionEmail(ctx, emailPayload,
+				asynq.MaxRetry(10), asynq.Queue(worker.QueueCritical))
+		},
+	}
+
+	txResult, err := server.store.ScheduleUserDeletionTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found or account deletion is already pending")
+		}
+		if errors.Is(err, db.ErrAccountBalanceNotZero) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to schedule user deletion: %s", err)
+	}
+
+	rsp := &pb.DeleteUserResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
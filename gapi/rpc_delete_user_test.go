@@ -0,0 +1,340 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type eqDeleteUserTxParamsMatcher struct {
+	username string
+	user     db.User
+}
+
+func (expected eqDeleteUserTxParamsMatcher) Matches(x interface{}) bool {
+	actualArg, ok := x.(db.DeleteUserTxParams)
+	if !ok {
+		return false
+	}
+
+	if actualArg.Username != expected.username {
+		return false
+	}
+
+	err := actualArg.AfterDelete(expected.user)
+	return err == nil
+}
+
+func (e eqDeleteUserTxParamsMatcher) String() string {
+	return fmt.Sprintf("matches username %v", e.username)
+}
+
+func EqDeleteUserTxParams(username string, user db.User) gomock.Matcher {
+	return eqDeleteUserTxParamsMatcher{username, user}
+}
+
+func TestDeleteUserAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.DeleteUserRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.DeleteUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.DeleteUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), EqDeleteUserTxParams(user.Username, user)).
+					Times(1).
+					Return(db.DeleteUserTxResult{User: user}, nil)
+
+				taskPayload := &worker.PayloadAnonymizeUser{
+					Username: user.Username,
+				}
+				taskDistributor.EXPECT().
+					DistributeTaskAnonymizeUser(gomock.Any(), taskPayload, gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name: "AdminDeletesOtherUser",
+			req: &pb.DeleteUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), EqDeleteUserTxParams(user.Username, user)).
+					Times(1).
+					Return(db.DeleteUserTxResult{User: user}, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskAnonymizeUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name: "CannotDeleteOtherUser",
+			req: &pb.DeleteUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+				taskDistributor.EXPECT().
+					DistributeTaskAnonymizeUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "AccountBalanceNotZero",
+			req: &pb.DeleteUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeleteUserTxResult{}, db.ErrAccountBalanceNotZero)
+				taskDistributor.EXPECT().
+					DistributeTaskAnonymizeUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req: &pb.DeleteUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeleteUserTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.DeleteUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestScheduleUserDeletionAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	admin, _ := randomUser(t, util.AdminRole)
+	delay := 30 * 24 * time.Hour
+
+	testCases := []struct {
+		name          string
+		caller        string
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.DeleteUserResponse, err error)
+	}{
+		{
+			name:   "OK",
+			caller: user.Username,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				var scheduledAt time.Time
+				store.EXPECT().
+					ScheduleUserDeletionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ScheduleUserDeletionTxParams) (db.ScheduleUserDeletionTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.WithinDuration(t, time.Now().Add(delay), arg.ScheduledAt, time.Second)
+						require.Equal(t, arg.ScheduledAt.Truncate(time.Second), arg.ScheduledAt)
+						scheduledAt = arg.ScheduledAt
+
+						scheduled := user
+						scheduled.DeletionScheduledAt = pgtype.Timestamptz{Time: arg.ScheduledAt, Valid: true}
+						return db.ScheduleUserDeletionTxResult{User: scheduled}, arg.AfterSchedule(scheduled)
+					})
+				taskDistributor.EXPECT().
+					DistributeTaskDeleteUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, payload *worker.PayloadDeleteUser, opts ...asynq.Option) error {
+						require.Equal(t, &worker.PayloadDeleteUser{Username: user.Username, ScheduledAt: scheduledAt}, payload)
+						return nil
+					})
+				taskDistributor.EXPECT().
+					DistributeTaskSendAccountDeletionEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendAccountDeletionEmail, opts ...asynq.Option) error {
+						require.Equal(t, &worker.PayloadSendAccountDeletionEmail{Username: user.Username, ScheduledAt: scheduledAt}, payload)
+						return nil
+					})
+				store.EXPECT().DeleteUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.WithinDuration(t, time.Now().Add(delay), res.GetUser().GetDeletionScheduledAt().AsTime(), time.Second)
+			},
+		},
+		{
+			name:   "AlreadyPending",
+			caller: user.Username,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ScheduleUserDeletionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ScheduleUserDeletionTxResult{}, db.ErrRecordNotFound)
+				taskDistributor.EXPECT().
+					DistributeTaskDeleteUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name:   "AccountBalanceNotZero",
+			caller: user.Username,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ScheduleUserDeletionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ScheduleUserDeletionTxResult{}, db.ErrAccountBalanceNotZero)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			// 管理员删除别的用户时不等待
+			name:   "AdminDeletesOtherUser",
+			caller: admin.Username,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					ScheduleUserDeletionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), EqDeleteUserTxParams(user.Username, user)).
+					Times(1).
+					Return(db.DeleteUserTxResult{User: user}, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskAnonymizeUser(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.DeleteUserResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.GetUser().GetDeletionScheduledAt())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+			server.config.AccountDeletionDelay = delay
+
+			role := user.Role
+			if tc.caller == admin.Username {
+				role = admin.Role
+			}
+			ctx := newContextWithBearerToken(t, server.tokenMaker, tc.caller, role, time.Minute)
+			res, err := server.DeleteUser(ctx, &pb.DeleteUserRequest{Username: user.Username})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
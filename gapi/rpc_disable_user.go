@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+DisableUser 由管理员调用，把用户标记为 is_disabled，并在同一个事务里 block 掉这个用户的所有 session。
+被禁用的用户不能再登录（见 LoginUser），已有的 refresh token 也不能再用来续期。
+*/
+func (server *Server) DisableUser(ctx context.Context, req *pb.DisableUserRequest) (*pb.DisableUserResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	txResult, err := server.store.UpdateUserTx(ctx, db.UpdateUserTxParams{
+		UpdateUserParams: db.UpdateUserParams{
+			Username: req.GetUsername(),
+			IsDisabled: pgtype.Bool{
+				Bool:  true,
+				Valid: true,
+			},
+		},
+		BlockSessions: true,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to disable user: %s", err)
+	}
+
+	// session 被 block 之后 refresh token 不能再续期，已经签发的 access token 也要一起撤销
+	err = server.revocationStore.RevokeUser(ctx, txResult.User.Username, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens: %s", err)
+	}
+
+	rsp := &pb.DisableUserResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
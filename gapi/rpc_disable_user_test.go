@@ -0,0 +1,173 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDisableUserAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.DisableUserRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.DisableUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.DisableUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserTxParams{
+					UpdateUserParams: db.UpdateUserParams{
+						Username: user.Username,
+						IsDisabled: pgtype.Bool{
+							Bool:  true,
+							Valid: true,
+						},
+					},
+					BlockSessions: true,
+				}
+				disabledUser := user
+				disabledUser.IsDisabled = true
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateUserTxResult{User: disabledUser}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DisableUserResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.True(t, res.GetUser().GetIsDisabled())
+			},
+		},
+		{
+			name: "BankerCannotDisableUser",
+			req: &pb.DisableUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DisableUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req: &pb.DisableUserRequest{
+				Username: user.Username,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateUserTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DisableUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidUsername",
+			req: &pb.DisableUserRequest{
+				Username: "invalid-user#1",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.DisableUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.DisableUser(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestDisableUserRevokesAccessTokens(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		UpdateUserTx(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(db.UpdateUserTxResult{User: user}, nil)
+
+	server := newTestServer(t, store, nil)
+	userCtx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err := server.authenticateUser(userCtx)
+	require.NoError(t, err)
+
+	adminCtx := newContextWithBearerToken(t, server.tokenMaker, admin.Username, admin.Role, time.Minute)
+	_, err = server.DisableUser(adminCtx, &pb.DisableUserRequest{Username: user.Username})
+	require.NoError(t, err)
+
+	// 禁用之前签发给这个用户的令牌不能再用，管理员自己的令牌不受影响
+	_, err = server.authenticateUser(userCtx)
+	require.ErrorContains(t, err, token.ErrRevokedToken.Error())
+	_, err = server.authenticateUser(adminCtx)
+	require.NoError(t, err)
+}
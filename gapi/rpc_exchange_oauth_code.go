@@ -0,0 +1,207 @@
+package gapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/oauth"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+/*
+ExchangeOAuthCode 处理第三方登录的回调：先验证 state，再用授权码向提供方换取用户信息。
+如果这个第三方身份已经绑定过用户，就直接登录；
+否则自动创建一个新用户并绑定，签发的令牌和密码登录完全一样。
+
+自动创建用户要求提供方已经验证过邮箱，
+如果这个邮箱已经被密码注册的用户占用，不会自动绑定到那个用户上（避免账号被接管）。
+这时和 CreateUser 一样不能告诉调用方邮箱已经注册，只返回一个笼统的错误，并且发邮件通知邮箱的主人（见 notifyOAuthEmailTaken）。
+*/
+func (server *Server) ExchangeOAuthCode(ctx context.Context, req *pb.ExchangeOAuthCodeRequest) (*pb.ExchangeOAuthCodeResponse, error) {
+	provider, ok := server.oauthProviders[req.GetProvider()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "oauth provider %q is not supported", req.GetProvider())
+	}
+
+	violations := server.validateExchangeOAuthCodeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	info, err := provider.Exchange(ctx, req.GetCode())
+	if err != nil {
+		if errors.Is(err, oauth.ErrInvalidCode) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid authorization code")
+		}
+		return nil, status.Errorf(codes.Unavailable, "failed to exchange authorization code: %s", err)
+	}
+
+	isNewUser := false
+	user, err := server.getOAuthUser(ctx, req.GetProvider(), info.Subject)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		user, err = server.createOAuthUser(ctx, req.GetProvider(), info)
+		isNewUser = true
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.IsDisabled {
+		return nil, status.Errorf(codes.PermissionDenied, "user is disabled")
+	}
+
+	if user.DeletionScheduledAt.Valid {
+		return nil, accountDeletionPendingError(user.Username)
+	}
+
+	userSession, err := server.createUserSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ExchangeOAuthCodeResponse{
+		User:                  convertUser(user),
+		SessionId:             userSession.session.ID.String(),
+		AccessToken:           userSession.accessToken,
+		RefreshToken:          userSession.refreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(userSession.accessPayload.ExpiredAt),
+		RefreshTokenExpiresAt: timestamppb.New(userSession.refreshPayload.ExpiredAt),
+		IsNewUser:             isNewUser,
+	}
+	return rsp, nil
+}
+
+// getOAuthUser 找到第三方身份绑定的用户，没有绑定过就返回 db.ErrRecordNotFound
+func (server *Server) getOAuthUser(ctx context.Context, provider string, subject string) (db.User, error) {
+	identity, err := server.store.GetIdentity(ctx, db.GetIdentityParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.User{}, err
+		}
+		return db.User{}, status.Errorf(codes.Internal, "failed to get identity: %s", err)
+	}
+
+	user, err := server.store.GetUser(ctx, identity.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.User{}, status.Errorf(codes.NotFound, "user not found")
+		}
+		return db.User{}, status.Errorf(codes.Internal, "failed to find user")
+	}
+	return user, nil
+}
+
+func (server *Server) createOAuthUser(ctx context.Context, provider string, info *oauth.UserInfo) (db.User, error) {
+	if info.Email == "" || !info.EmailVerified {
+		return db.User{}, status.Errorf(codes.FailedPrecondition, "%s account has no verified email address", provider)
+	}
+
+	// 第三方登录的用户没有密码，随机生成一个，用户以后可以通过重置密码来设置
+	hashedPassword, err := util.HashPassword(randomPassword())
+	if err != nil {
+		return db.User{}, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+	}
+
+	fullName := info.Name
+	if fullName == "" {
+		fullName = info.Login
+	}
+
+	arg := db.CreateOAuthUserTxParams{
+		CreateUserParams: db.CreateUserParams{
+			Username:       oauthUsername(info),
+			HashedPassword: hashedPassword,
+			FullName:       fullName,
+			Email:          info.Email,
+			Locale:         requestLocale(ctx),
+		},
+		Provider:        provider,
+		Subject:         info.Subject,
+		IsEmailVerified: true,
+	}
+	txResult, err := server.store.CreateOAuthUserTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			server.notifyOAuthEmailTaken(ctx, arg)
+			return db.User{}, status.Errorf(codes.Unauthenticated, "cannot sign in with this %s account", provider)
+		}
+		return db.User{}, status.Errorf(codes.Internal, "failed to create user: %s", err)
+	}
+
+	return txResult.User, nil
+}
+
+// notifyOAuthEmailTaken 分发任务给邮箱原来的主人发邮件，和 CreateUser 的 duplicateEmailResponse 一样，分发失败只记日志
+func (server *Server) notifyOAuthEmailTaken(ctx context.Context, arg db.CreateOAuthUserTxParams) {
+	mtdt := server.extractMetadata(ctx)
+	LoggerFrom(ctx).Info().Str("provider", arg.Provider).Msg("oauth sign in with an email that is already registered")
+
+	taskPayload := &worker.PayloadSendAccountExistsEmail{
+		Email:             arg.Email,
+		AttemptedUsername: arg.Username,
+		ClientIp:          mtdt.ClientIP,
+		Country:           mtdt.Country,
+		City:              mtdt.City,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(10),
+		asynq.Queue(worker.QueueCritical),
+	}
+	if err := server.taskDistributor.DistributeTaskSendAccountExistsEmail(ctx, taskPayload, opts...); err != nil {
+		LoggerFrom(ctx).Error().Err(err).Msg("failed to distribute account exists email")
+	}
+}
+
+var invalidUsernameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// oauthUsername 根据第三方账号的登录名或者邮箱生成用户名，
+// 后面加一个随机后缀，避免和已有的用户名冲突。
+func oauthUsername(info *oauth.UserInfo) string {
+	base := info.Login
+	if base == "" {
+		base, _, _ = strings.Cut(info.Email, "@")
+	}
+
+	base = invalidUsernameChars.ReplaceAllString(strings.ToLower(base), "_")
+	base = strings.Trim(base, "_")
+	if len(base) > 50 {
+		base = base[:50]
+	}
+	if base == "" {
+		base = "user"
+	}
+
+	return base + "_" + util.RandomString(6)
+}
+
+func randomPassword() string {
+	buf := make([]byte, 32)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+func (server *Server) validateExchangeOAuthCodeRequest(req *pb.ExchangeOAuthCodeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := oauth.VerifyState([]byte(server.config.OAuthStateKey), req.GetProvider(), req.GetState(), time.Now()); err != nil {
+		violations = append(violations, fieldViolation("state", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,251 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/oauth"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeOAuthProvider 把 code 映射到固定的用户信息，不访问网络
+type fakeOAuthProvider struct {
+	users map[string]*oauth.UserInfo
+}
+
+func (provider *fakeOAuthProvider) AuthCodeURL(state string) string {
+	return "https://provider.example.com/authorize?state=" + state
+}
+
+func (provider *fakeOAuthProvider) Exchange(ctx context.Context, code string) (*oauth.UserInfo, error) {
+	info, ok := provider.users[code]
+	if !ok {
+		return nil, oauth.ErrInvalidCode
+	}
+	return info, nil
+}
+
+func TestExchangeOAuthCodeAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	existing := &oauth.UserInfo{Subject: "1001", Email: user.Email, EmailVerified: true, Login: user.Username}
+	newcomer := &oauth.UserInfo{Subject: "1002", Email: util.RandomEmail(), EmailVerified: true, Login: "New.Comer", Name: "New Comer"}
+	unverified := &oauth.UserInfo{Subject: "1003", Email: util.RandomEmail(), EmailVerified: false, Login: "unverified"}
+
+	provider := &fakeOAuthProvider{users: map[string]*oauth.UserInfo{
+		"existing-code":   existing,
+		"newcomer-code":   newcomer,
+		"unverified-code": unverified,
+	}}
+	stateKey := util.RandomString(32)
+
+	validState := func(t *testing.T) string {
+		state, err := oauth.NewState([]byte(stateKey), oauth.ProviderGitHub, time.Now())
+		require.NoError(t, err)
+		return state
+	}
+
+	testCases := []struct {
+		name          string
+		provider      string
+		code          string
+		state         func(t *testing.T) string
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		checkResponse func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error)
+	}{
+		{
+			name:     "ExistingIdentity",
+			provider: oauth.ProviderGitHub,
+			code:     "existing-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().
+					GetIdentity(gomock.Any(), gomock.Eq(db.GetIdentityParams{Provider: oauth.ProviderGitHub, Subject: existing.Subject})).
+					Times(1).
+					Return(db.Identity{Username: user.Username, Provider: oauth.ProviderGitHub, Subject: existing.Subject}, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().CreateOAuthUserTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, nil)
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{ID: 1}, nil)
+				store.EXPECT().TouchUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetIsNewUser())
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotEmpty(t, res.GetRefreshToken())
+			},
+		},
+		{
+			name:     "AutoProvisionUser",
+			provider: oauth.ProviderGitHub,
+			code:     "newcomer-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(1).Return(db.Identity{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateOAuthUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateOAuthUserTxParams) (db.CreateOAuthUserTxResult, error) {
+						require.Regexp(t, `^new_comer_[a-z]{6}$`, arg.Username)
+						require.Equal(t, newcomer.Email, arg.Email)
+						require.Equal(t, newcomer.Name, arg.FullName)
+						require.Equal(t, oauth.ProviderGitHub, arg.Provider)
+						require.Equal(t, newcomer.Subject, arg.Subject)
+						require.True(t, arg.IsEmailVerified)
+						return db.CreateOAuthUserTxResult{
+							User: db.User{Username: arg.Username, Email: arg.Email, Role: util.DepositorRole, IsEmailVerified: true},
+						}, nil
+					})
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(1).Return(db.Session{}, nil)
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{}, db.ErrRecordNotFound)
+				store.EXPECT().CountUserDevices(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().CreateUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{ID: 1}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsNewUser())
+				require.Equal(t, newcomer.Email, res.GetUser().GetEmail())
+			},
+		},
+		{
+			name:     "EmailAlreadyRegistered",
+			provider: oauth.ProviderGitHub,
+			code:     "newcomer-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(1).Return(db.Identity{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateOAuthUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateOAuthUserTxResult{}, db.ErrUniqueViolation)
+				store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().
+					DistributeTaskSendAccountExistsEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendAccountExistsEmail, opts ...asynq.Option) error {
+						require.Equal(t, newcomer.Email, payload.Email)
+						return nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				// 不能让调用方知道这个邮箱已经注册了，只有邮箱的主人会收到邮件
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.NotContains(t, status.Convert(err).Message(), "registered")
+			},
+		},
+		{
+			name:     "UnverifiedEmail",
+			provider: oauth.ProviderGitHub,
+			code:     "unverified-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(1).Return(db.Identity{}, db.ErrRecordNotFound)
+				store.EXPECT().CreateOAuthUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name:     "InvalidCode",
+			provider: oauth.ProviderGitHub,
+			code:     "bad-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+		{
+			name:     "InvalidState",
+			provider: oauth.ProviderGitHub,
+			code:     "existing-code",
+			state: func(t *testing.T) string {
+				state, err := oauth.NewState([]byte(stateKey), oauth.ProviderGoogle, time.Now())
+				require.NoError(t, err)
+				return state
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:     "UnsupportedProvider",
+			provider: "facebook",
+			code:     "existing-code",
+			state:    validState,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetIdentity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExchangeOAuthCodeResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+			server.config.OAuthStateKey = stateKey
+			server.oauthProviders = oauth.Providers{oauth.ProviderGitHub: provider}
+
+			res, err := server.ExchangeOAuthCode(context.Background(), &pb.ExchangeOAuthCodeRequest{
+				Provider: tc.provider,
+				Code:     tc.code,
+				State:    tc.state(t),
+			})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetOAuthURLAPI(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+	server.config.OAuthStateKey = util.RandomString(32)
+	server.oauthProviders = oauth.Providers{oauth.ProviderGitHub: &fakeOAuthProvider{}}
+
+	res, err := server.GetOAuthURL(context.Background(), &pb.GetOAuthURLRequest{Provider: oauth.ProviderGitHub})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("https://provider.example.com/authorize?state=%s", res.GetState()), res.GetAuthUrl())
+	require.NoError(t, oauth.VerifyState([]byte(server.config.OAuthStateKey), oauth.ProviderGitHub, res.GetState(), time.Now()))
+
+	_, err = server.GetOAuthURL(context.Background(), &pb.GetOAuthURLRequest{Provider: oauth.ProviderGoogle})
+	requireStatusCode(t, err, codes.NotFound)
+}
+
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+}
@@ -0,0 +1,136 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ExternalPayout 把钱转到其它银行的账户（模拟 ACH/SEPA 这类跨行转账）。
+请求成功时钱还没有离开账户，只是被冻结（hold），外部转账的状态是 initiated；
+之后由 worker 推进状态（见 worker/task_advance_external_transfer.go），清算完成时才真正扣款，被退回时释放冻结。
+
+只有配置了清算账户（EXTERNAL_CLEARING_ACCOUNTS）的币种才支持外部转账。
+提现手续费（WITHDRAWAL_FEES）在创建的时候就扣掉，转到收入账户（FEE_ACCOUNTS）。
+*/
+func (server *Server) ExternalPayout(ctx context.Context, req *pb.ExternalPayoutRequest) (*pb.ExternalPayoutResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateExternalPayoutRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "from account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get from account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	if err := server.checkKYCTransferLimit(ctx, authPayload.Username, req.GetAmount()); err != nil {
+		return nil, err
+	}
+
+	clearingAccounts, err := util.ParseCurrencyAccounts(server.config.ExternalClearingAccounts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid clearing accounts: %s", err)
+	}
+	if _, ok := clearingAccounts[fromAccount.Currency]; !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "external transfers are not supported for %s", fromAccount.Currency)
+	}
+
+	fee, feeAccountID, err := server.computeFee(server.config.WithdrawalFees, authPayload.Role, fromAccount.Currency, req.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateExternalTransferTxParams{
+		AccountID:     fromAccount.ID,
+		Amount:        req.GetAmount(),
+		RoutingNumber: req.GetRoutingNumber(),
+		AccountNumber: req.GetAccountNumber(),
+		Fee:           fee,
+		FeeAccountID:  feeAccountID,
+		AfterCreate: func(externalTransfer db.ExternalTransfer) error {
+			taskPayload := &worker.PayloadAdvanceExternalTransfer{
+				ExternalTransferID: externalTransfer.ID,
+				Status:             util.ExternalTransferSubmitted,
+			}
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				// 和 CreateUser 一样延迟一会儿，确保任务执行时事务已经提交
+				asynq.ProcessIn(5 * time.Second),
+				asynq.Queue(worker.QueueCritical),
+			}
+			return server.taskDistributor.DistributeTaskAdvanceExternalTransfer(ctx, taskPayload, opts...)
+		},
+	}
+
+	txResult, err := server.store.CreateExternalTransferTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create external transfer: %s", err)
+	}
+
+	rsp := &pb.ExternalPayoutResponse{
+		ExternalTransfer: convertExternalTransfer(txResult.ExternalTransfer),
+		AvailableBalance: txResult.AvailableBalance,
+		Fee:              fee,
+	}
+	return rsp, nil
+}
+
+// computeFee 按手续费规则（TRANSFER_FEES 或 WITHDRAWAL_FEES）计算手续费，并找到这个币种的收入账户（FEE_ACCOUNTS）
+func (server *Server) computeFee(fees string, role string, currency string, amount int64) (int64, int64, error) {
+	schedule, err := util.ParseFeeSchedule(fees)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "invalid fee schedule: %s", err)
+	}
+
+	fee := schedule.Fee(currency, role, amount)
+	if fee == 0 {
+		return 0, 0, nil
+	}
+
+	feeAccounts, err := util.ParseCurrencyAccounts(server.config.FeeAccounts)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Internal, "invalid fee accounts: %s", err)
+	}
+	feeAccountID, ok := feeAccounts[currency]
+	if !ok {
+		return 0, 0, status.Errorf(codes.Internal, "no fee account for %s", currency)
+	}
+	return fee, feeAccountID, nil
+}
+
+func validateExternalPayoutRequest(req *pb.ExternalPayoutRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateRoutingNumber(req.GetRoutingNumber()); err != nil {
+		violations = append(violations, fieldViolation("routing_number", err))
+	}
+
+	return violations
+}
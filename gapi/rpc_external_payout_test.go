@@ -0,0 +1,202 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestExternalPayoutAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Balance: 1000, Currency: util.USD}
+	eurAccount := db.Account{ID: 2, Owner: user.Username, Balance: 1000, Currency: util.EUR}
+	externalTransfer := db.ExternalTransfer{
+		ID:            5,
+		AccountID:     account.ID,
+		HoldID:        3,
+		Amount:        100,
+		Currency:      util.USD,
+		RoutingNumber: "011000015",
+		AccountNumber: "123456789",
+		Status:        util.ExternalTransferInitiated,
+	}
+
+	validRequest := func() *pb.ExternalPayoutRequest {
+		return &pb.ExternalPayoutRequest{
+			FromAccountId: account.ID,
+			Amount:        100,
+			RoutingNumber: "011000015",
+			AccountNumber: "123456789",
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ExternalPayoutRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ExternalPayoutResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateExternalTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateExternalTransferTxParams) (db.CreateExternalTransferTxResult, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, int64(100), arg.Amount)
+						require.Equal(t, "011000015", arg.RoutingNumber)
+						require.Equal(t, "123456789", arg.AccountNumber)
+						require.Equal(t, int64(10), arg.Fee)
+						require.Equal(t, int64(98), arg.FeeAccountID)
+
+						err := arg.AfterCreate(externalTransfer)
+						return db.CreateExternalTransferTxResult{
+							ExternalTransfer:  externalTransfer,
+							PlaceHoldTxResult: db.PlaceHoldTxResult{AvailableBalance: 900},
+						}, err
+					})
+
+				payload := &worker.PayloadAdvanceExternalTransfer{
+					ExternalTransferID: externalTransfer.ID,
+					Status:             util.ExternalTransferSubmitted,
+				}
+				taskDistributor.EXPECT().
+					DistributeTaskAdvanceExternalTransfer(gomock.Any(), gomock.Eq(payload), gomock.Any()).
+					Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, externalTransfer.ID, res.GetExternalTransfer().GetId())
+				require.Equal(t, util.ExternalTransferInitiated, res.GetExternalTransfer().GetStatus())
+				require.Equal(t, int64(900), res.GetAvailableBalance())
+				require.Equal(t, int64(10), res.GetFee())
+			},
+		},
+		{
+			name: "InsufficientBalance",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateExternalTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateExternalTransferTxResult{}, db.ErrInsufficientBalance)
+				taskDistributor.EXPECT().DistributeTaskAdvanceExternalTransfer(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "CurrencyWithoutClearingAccount",
+			req: &pb.ExternalPayoutRequest{
+				FromAccountId: eurAccount.ID,
+				Amount:        100,
+				RoutingNumber: "011000015",
+				AccountNumber: "123456789",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().CreateExternalTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "AccountNotOwned",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateExternalTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "InvalidRoutingNumber",
+			req: &pb.ExternalPayoutRequest{
+				FromAccountId: account.ID,
+				Amount:        100,
+				RoutingNumber: "011000016",
+				AccountNumber: "123456789",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "Unauthenticated",
+			req:  validRequest(),
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ExternalPayoutResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+			server.config.ExternalClearingAccounts = "USD:99"
+			server.config.WithdrawalFees = "USD:10+0"
+			server.config.FeeAccounts = "USD:98"
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ExternalPayout(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ForcePasswordReset 由管理员调用，设置 password_reset_required 并 block 掉用户现有的所有 session。
+标记没有清除之前用户不能登录（见 LoginUser），可以由 banker 通过 UpdateUser 设置新密码，修改密码之后这个标记会被清除。
+*/
+func (server *Server) ForcePasswordReset(ctx context.Context, req *pb.ForcePasswordResetRequest) (*pb.ForcePasswordResetResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	txResult, err := server.store.UpdateUserTx(ctx, db.UpdateUserTxParams{
+		UpdateUserParams: db.UpdateUserParams{
+			Username: req.GetUsername(),
+			PasswordResetRequired: pgtype.Bool{
+				Bool:  true,
+				Valid: true,
+			},
+		},
+		BlockSessions: true,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to force password reset: %s", err)
+	}
+
+	// session 被 block 之后 refresh token 不能再续期，已经签发的 access token 也要一起撤销
+	err = server.revocationStore.RevokeUser(ctx, txResult.User.Username, server.config.AccessTokenDuration)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke access tokens: %s", err)
+	}
+
+	rsp := &pb.ForcePasswordResetResponse{
+		User: convertUser(txResult.User),
+	}
+	return rsp, nil
+}
+
+const passwordResetRequiredViolation = "PASSWORD_RESET_REQUIRED"
+
+// passwordResetRequiredError 在被强制重置密码的用户登录时返回，旧密码即使正确也不能再用来登录
+func passwordResetRequiredError(username string) error {
+	return preconditionFailureError(
+		"password reset is required",
+		passwordResetRequiredViolation,
+		"users/"+username,
+		"contact the bank to set a new password, then sign in again",
+	)
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestForcePasswordResetAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, server *Server, userCtx context.Context, res *pb.ForcePasswordResetResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserTxParams{
+					UpdateUserParams: db.UpdateUserParams{
+						Username: user.Username,
+						PasswordResetRequired: pgtype.Bool{
+							Bool:  true,
+							Valid: true,
+						},
+					},
+					BlockSessions: true,
+				}
+				updatedUser := user
+				updatedUser.PasswordResetRequired = true
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.UpdateUserTxResult{User: updatedUser}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, server *Server, userCtx context.Context, res *pb.ForcePasswordResetResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetUser().GetPasswordResetRequired())
+
+				// 之前签发给这个用户的令牌被撤销
+				_, err = server.authenticateUser(userCtx)
+				require.ErrorContains(t, err, token.ErrRevokedToken.Error())
+			},
+		},
+		{
+			name: "BankerCannotForceReset",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, server *Server, userCtx context.Context, res *pb.ForcePasswordResetResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+
+				_, err = server.authenticateUser(userCtx)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "UserNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.UpdateUserTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, server *Server, userCtx context.Context, res *pb.ForcePasswordResetResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			userCtx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ForcePasswordReset(ctx, &pb.ForcePasswordResetRequest{Username: user.Username})
+			tc.checkResponse(t, server, userCtx, res, err)
+		})
+	}
+}
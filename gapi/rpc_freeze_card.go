@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+FreezeCard 冻结或解冻一张卡（frozen=false 表示解冻）。
+冻结之后 AuthorizeCardTransaction 会直接拒绝，已经冻结（hold）的金额不受影响。
+*/
+func (server *Server) FreezeCard(ctx context.Context, req *pb.FreezeCardRequest) (*pb.FreezeCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	card, err := server.store.GetCard(ctx, req.GetCardId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "card not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get card: %s", err)
+	}
+
+	if _, err := server.authorizeAccount(ctx, authPayload, card.AccountID); err != nil {
+		return nil, err
+	}
+
+	cardStatus := util.CardActive
+	if req.GetFrozen() {
+		cardStatus = util.CardFrozen
+	}
+
+	card, err = server.store.UpdateCardStatus(ctx, db.UpdateCardStatusParams{
+		Status: cardStatus,
+		ID:     card.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update card: %s", err)
+	}
+
+	rsp := &pb.FreezeCardResponse{
+		Card: convertCard(card),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetAccountByNumber 按账号查询账户，和 v2 的 GetAccount 一样，只有账户的所有者和 banker 可以查看。
+别人的账号只用来转账（见 CreateBulkTransfer 的 to_account_number），不能用这个接口查出账户的所有者和余额。
+*/
+func (server *Server) GetAccountByNumber(ctx context.Context, req *pb.GetAccountByNumberRequest) (*pb.GetAccountByNumberResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	number := util.NormalizeAccountNumber(req.GetNumber())
+	if violations == nil {
+		if err := val.ValidateAccountNumber(number); err != nil {
+			violations = append(violations, fieldViolation("number", err))
+		}
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.store.GetAccountByNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	// 别人的账户和不存在的账户返回一样的错误，不能用这个接口试出哪些账号存在
+	if checkAccountAccess(authPayload, account) != nil {
+		return nil, status.Errorf(codes.NotFound, "account not found")
+	}
+
+	rsp := &pb.GetAccountByNumberResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
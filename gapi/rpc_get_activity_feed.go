@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetActivityFeed 把账户的转出、转入、刷卡授权，以及账户所有者的登录和账户相关的审计记录合并成一个活动流，最新的在前面。
+合并和排序都在 ListAccountActivity 一条查询里完成，分页用 util.ActivityCursor，
+不能像 ListTransfers 那样只记录最后一条的 ID。
+*/
+func (server *Server) GetActivityFeed(ctx context.Context, req *pb.GetActivityFeedRequest) (*pb.GetActivityFeedResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetActivityFeedRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ListAccountActivityParams{
+		AccountID: account.ID,
+		Owner:     account.Owner,
+		Limit:     req.GetPageSize() + 1,
+	}
+	cursor, _ := util.DecodeActivityPageToken(req.GetPageToken())
+	if cursor != nil {
+		arg.BeforeTime = pgtype.Timestamptz{Time: cursor.OccurredAt, Valid: true}
+		arg.BeforeKind = cursor.Kind
+		arg.BeforeItemID = cursor.ItemID
+	}
+
+	items, err := server.store.ListAccountActivity(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list account activity: %s", err)
+	}
+
+	rsp := &pb.GetActivityFeedResponse{}
+	if int32(len(items)) > req.GetPageSize() {
+		items = items[:req.GetPageSize()]
+		last := items[len(items)-1]
+		rsp.NextPageToken = util.EncodeActivityPageToken(util.ActivityCursor{
+			OccurredAt: last.OccurredAt,
+			Kind:       last.Kind,
+			ItemID:     last.ItemID,
+		})
+	}
+
+	rsp.Items = make([]*pb.ActivityItem, 0, len(items))
+	for _, item := range items {
+		rsp.Items = append(rsp.Items, convertActivityItem(item))
+	}
+	return rsp, nil
+}
+
+func validateGetActivityFeedRequest(req *pb.GetActivityFeedRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateActivityPageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,173 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetActivityFeedAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Currency: util.USD}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	items := []db.ListAccountActivityRow{
+		{Kind: util.ActivitySecurity, ItemID: "6f1c0e4e-4b9a-4a43-9b43-8d0c6c1b2d3e", OccurredAt: now, Status: util.ActivityLogin, ClientIp: "1.2.3.4", Country: "AU"},
+		{Kind: util.ActivityCardAuthorization, ItemID: "7", OccurredAt: now.Add(-time.Minute), Amount: 30, CardID: 3, Status: util.HoldActive, Description: "coffee shop"},
+		{Kind: util.ActivityTransfer, ItemID: "12", OccurredAt: now.Add(-2 * time.Minute), Amount: -10, TransferID: 5, CounterpartyAccountID: 2, Description: "rent"},
+		{Kind: util.ActivityDeposit, ItemID: "11", OccurredAt: now.Add(-3 * time.Minute), Amount: 20, TransferID: 4, CounterpartyAccountID: 2},
+		{Kind: util.ActivityDeposit, ItemID: "9", OccurredAt: now.Add(-4 * time.Minute), Amount: 50, TransferID: 3, CounterpartyAccountID: 2},
+		{Kind: util.ActivityDeposit, ItemID: "8", OccurredAt: now.Add(-5 * time.Minute), Amount: 50, TransferID: 2, CounterpartyAccountID: 2},
+	}
+	cursor := util.ActivityCursor{OccurredAt: items[4].OccurredAt, Kind: items[4].Kind, ItemID: items[4].ItemID}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetActivityFeedRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetActivityFeedResponse, err error)
+	}{
+		{
+			name: "FirstPage",
+			req:  &pb.GetActivityFeedRequest{AccountId: account.ID, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListAccountActivity(gomock.Any(), gomock.Eq(db.ListAccountActivityParams{
+						AccountID: account.ID,
+						Owner:     user.Username,
+						Limit:     6,
+					})).
+					Times(1).
+					Return(items, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetActivityFeedResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetItems(), 5)
+				require.Equal(t, util.EncodeActivityPageToken(cursor), res.GetNextPageToken())
+
+				security := res.GetItems()[0]
+				require.Equal(t, pb.ActivityType_ACTIVITY_TYPE_SECURITY_EVENT, security.GetType())
+				require.Equal(t, util.ActivityLogin, security.GetSecurityEvent().GetEvent())
+				require.Equal(t, "AU", security.GetSecurityEvent().GetCountry())
+
+				authorization := res.GetItems()[1]
+				require.Equal(t, pb.ActivityType_ACTIVITY_TYPE_CARD_AUTHORIZATION, authorization.GetType())
+				require.Equal(t, int64(7), authorization.GetCardAuthorization().GetHoldId())
+				require.Equal(t, int64(3), authorization.GetCardAuthorization().GetCardId())
+				require.Equal(t, "coffee shop", authorization.GetCardAuthorization().GetDescription())
+
+				transfer := res.GetItems()[2]
+				require.Equal(t, pb.ActivityType_ACTIVITY_TYPE_TRANSFER, transfer.GetType())
+				require.Equal(t, int64(12), transfer.GetMoneyMovement().GetEntryId())
+				require.Equal(t, int64(-10), transfer.GetMoneyMovement().GetAmount())
+				require.Equal(t, "rent", transfer.GetMoneyMovement().GetMemo())
+				require.Nil(t, transfer.GetCardAuthorization())
+
+				deposit := res.GetItems()[3]
+				require.Equal(t, pb.ActivityType_ACTIVITY_TYPE_DEPOSIT, deposit.GetType())
+				require.Equal(t, int64(2), deposit.GetMoneyMovement().GetCounterpartyAccountId())
+			},
+		},
+		{
+			name: "NextPage",
+			req:  &pb.GetActivityFeedRequest{AccountId: account.ID, PageSize: 5, PageToken: util.EncodeActivityPageToken(cursor)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					ListAccountActivity(gomock.Any(), gomock.Eq(db.ListAccountActivityParams{
+						AccountID:    account.ID,
+						Owner:        user.Username,
+						BeforeTime:   pgtype.Timestamptz{Time: cursor.OccurredAt, Valid: true},
+						BeforeKind:   cursor.Kind,
+						BeforeItemID: cursor.ItemID,
+						Limit:        6,
+					})).
+					Times(1).
+					Return(items[5:], nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetActivityFeedResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetItems(), 1)
+				require.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			req:  &pb.GetActivityFeedRequest{AccountId: account.ID, PageSize: 5, PageToken: util.EncodePageToken(8)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListAccountActivity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetActivityFeedResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.GetActivityFeedRequest{AccountId: account.ID, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListAccountActivity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetActivityFeedResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.GetActivityFeedRequest{AccountId: account.ID, PageSize: 5},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountActivity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetActivityFeedResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetActivityFeed(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
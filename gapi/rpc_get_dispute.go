@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDispute 返回一个争议，打开争议的用户本人和 banker 可以查看。banker 写的备注是内部的，只返回给 banker。
+func (server *Server) GetDispute(ctx context.Context, req *pb.GetDisputeRequest) (*pb.GetDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	dispute, err := server.authorizeDispute(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetDisputeResponse{
+		Dispute: convertDispute(dispute),
+	}
+	if authPayload.Role != util.BankerRole {
+		return rsp, nil
+	}
+
+	notes, err := server.store.ListDisputeNotes(ctx, dispute.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list dispute notes: %s", err)
+	}
+	for _, note := range notes {
+		rsp.Notes = append(rsp.Notes, convertDisputeNote(note))
+	}
+	return rsp, nil
+}
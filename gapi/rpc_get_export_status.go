@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportURLExpiry 是导出文件下载链接的有效期，客户端每次查询状态都会拿到一个新的链接
+const exportURLExpiry = 15 * time.Minute
+
+/*
+GetExportStatus 返回导出任务的状态和进度（processed_rows / total_rows）。
+任务完成后响应里带一个短时间有效的下载链接；blob store 不支持签名链接时链接为空。
+只有发起导出的用户和 banker 可以查询。
+*/
+func (server *Server) GetExportStatus(ctx context.Context, req *pb.GetExportStatusRequest) (*pb.GetExportStatusResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	job, err := server.store.GetExportJob(ctx, req.GetExportId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "export not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get export job: %s", err)
+	}
+
+	if authPayload.Role != util.BankerRole && job.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "export doesn't belong to the authenticated user")
+	}
+
+	rsp := &pb.GetExportStatusResponse{
+		ExportJob: convertExportJob(job),
+	}
+
+	if job.Status == util.ExportCompleted && server.blobStore != nil {
+		rsp.DownloadUrl, err = server.blobStore.SignedURL(ctx, job.FileKey, exportURLExpiry)
+		if err != nil && !errors.Is(err, storage.ErrSignedURLNotSupported) {
+			return nil, status.Errorf(codes.Internal, "failed to sign export URL: %s", err)
+		}
+	}
+
+	return rsp, nil
+}
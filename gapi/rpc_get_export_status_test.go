@@ -0,0 +1,143 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetExportStatusAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	running := db.ExportJob{
+		ID:            util.RandomInt(1, 1000),
+		Owner:         user.Username,
+		AccountID:     1,
+		Kind:          util.ExportTransfers,
+		Status:        util.ExportRunning,
+		ProcessedRows: 1000,
+		TotalRows:     2500,
+		CreatedAt:     time.Now(),
+	}
+	completed := running
+	completed.Status = util.ExportCompleted
+	completed.ProcessedRows = completed.TotalRows
+	completed.FileKey = "exports/" + user.Username + "/1.csv"
+	completed.CompletedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	testCases := []struct {
+		name          string
+		job           db.ExportJob
+		buildStubs    func(store *mockdb.MockStore, job db.ExportJob)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetExportStatusResponse, err error)
+	}{
+		{
+			name: "Running",
+			job:  running,
+			buildStubs: func(store *mockdb.MockStore, job db.ExportJob) {
+				store.EXPECT().GetExportJob(gomock.Any(), gomock.Eq(job.ID)).Times(1).Return(job, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetExportStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.ExportRunning, res.GetExportJob().GetStatus())
+				require.Equal(t, int64(1000), res.GetExportJob().GetProcessedRows())
+				require.Equal(t, int64(2500), res.GetExportJob().GetTotalRows())
+				require.Empty(t, res.GetDownloadUrl())
+			},
+		},
+		{
+			name: "Completed",
+			job:  completed,
+			buildStubs: func(store *mockdb.MockStore, job db.ExportJob) {
+				store.EXPECT().GetExportJob(gomock.Any(), gomock.Eq(job.ID)).Times(1).Return(job, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetExportStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.ExportCompleted, res.GetExportJob().GetStatus())
+				require.NotNil(t, res.GetExportJob().GetCompletedAt())
+				require.Contains(t, res.GetDownloadUrl(), "http://localhost:8080/blobs/"+completed.FileKey+"?")
+			},
+		},
+		{
+			name: "PermissionDenied",
+			job:  completed,
+			buildStubs: func(store *mockdb.MockStore, job db.ExportJob) {
+				store.EXPECT().GetExportJob(gomock.Any(), gomock.Eq(job.ID)).Times(1).Return(job, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetExportStatusResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			job:  running,
+			buildStubs: func(store *mockdb.MockStore, job db.ExportJob) {
+				store.EXPECT().GetExportJob(gomock.Any(), gomock.Eq(job.ID)).Times(1).Return(db.ExportJob{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetExportStatusResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			job:  running,
+			buildStubs: func(store *mockdb.MockStore, job db.ExportJob) {
+				store.EXPECT().GetExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetExportStatusResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store, tc.job)
+			server := newTestServer(t, store, nil)
+
+			blobStore, err := storage.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, blobStore.EnableSignedURLs("http://localhost:8080", util.RandomString(32)))
+			server.blobStore = blobStore
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetExportStatus(ctx, &pb.GetExportStatusRequest{ExportId: tc.job.ID})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetFeeSchedule 返回当前用户的转账手续费（TRANSFER_FEES）和提现手续费（WITHDRAWAL_FEES），
+每个币种只返回对这个用户的角色生效的那一条规则，客户端可以在转账之前把手续费显示出来。
+没有出现在结果里的币种不收手续费，规则的格式见 util.FeeSchedule。
+*/
+func (server *Server) GetFeeSchedule(ctx context.Context, req *pb.GetFeeScheduleRequest) (*pb.GetFeeScheduleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if req.GetCurrency() != "" {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("currency", err)})
+		}
+	}
+
+	rsp := &pb.GetFeeScheduleResponse{}
+	for _, item := range []struct {
+		kind  string
+		value string
+	}{
+		{util.FeeKindTransfer, server.config.TransferFees},
+		{util.FeeKindWithdrawal, server.config.WithdrawalFees},
+	} {
+		schedule, err := util.ParseFeeSchedule(item.value)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid %s fees: %s", item.kind, err)
+		}
+
+		for _, currency := range schedule.Currencies() {
+			if req.GetCurrency() != "" && currency != req.GetCurrency() {
+				continue
+			}
+			rule, ok := schedule.Rule(currency, authPayload.Role)
+			if !ok {
+				continue
+			}
+			rsp.Fees = append(rsp.Fees, &pb.Fee{
+				Kind:     item.kind,
+				Currency: currency,
+				Flat:     rule.Flat,
+				Bps:      rule.Bps,
+			})
+		}
+	}
+	return rsp, nil
+}
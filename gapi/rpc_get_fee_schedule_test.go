@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetFeeScheduleAPI(t *testing.T) {
+	depositor, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetFeeScheduleRequest
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetFeeScheduleResponse, err error)
+	}{
+		{
+			name: "Depositor",
+			req:  &pb.GetFeeScheduleRequest{},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeeScheduleResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetFees(), 3)
+				require.Equal(t, util.FeeKindTransfer, res.GetFees()[0].GetKind())
+				require.Equal(t, util.EUR, res.GetFees()[0].GetCurrency())
+				require.Equal(t, int64(20), res.GetFees()[0].GetFlat())
+				require.Equal(t, util.USD, res.GetFees()[1].GetCurrency())
+				require.Equal(t, int32(50), res.GetFees()[1].GetBps())
+				require.Equal(t, util.FeeKindWithdrawal, res.GetFees()[2].GetKind())
+				require.Equal(t, int64(100), res.GetFees()[2].GetFlat())
+			},
+		},
+		{
+			name: "BankerRule",
+			req:  &pb.GetFeeScheduleRequest{Currency: util.USD},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeeScheduleResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetFees(), 2)
+				require.Zero(t, res.GetFees()[0].GetFlat())
+				require.Zero(t, res.GetFees()[0].GetBps())
+			},
+		},
+		{
+			name: "InvalidCurrency",
+			req:  &pb.GetFeeScheduleRequest{Currency: "XYZ"},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeeScheduleResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.GetFeeScheduleRequest{},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetFeeScheduleResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store, nil)
+			server.config.TransferFees = "USD:25+50,USD/banker:0+0,EUR:20+0"
+			server.config.WithdrawalFees = "USD:100+0"
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetFeeSchedule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kycDocumentURLExpiry 是证件下载链接的有效期，只够 banker 审核时打开一次
+const kycDocumentURLExpiry = 15 * time.Minute
+
+/*
+GetKYCDocumentFile 从 blob store 读取证件文件，banker 审核时使用，depositor 只能读取自己上传的文件。
+响应里同时带一个短时间有效的下载链接，浏览器可以直接打开，不用把 base64 的内容转回文件。
+*/
+func (server *Server) GetKYCDocumentFile(ctx context.Context, req *pb.GetKYCDocumentFileRequest) (*pb.GetKYCDocumentFileResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if server.blobStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "document uploads are not enabled")
+	}
+
+	document, err := server.store.GetKYCDocument(ctx, req.GetDocumentId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "KYC document not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get KYC document: %s", err)
+	}
+
+	if authPayload.Role != util.BankerRole && document.Username != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "KYC document doesn't belong to the authenticated user")
+	}
+
+	file, err := server.blobStore.Get(ctx, document.FileKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "KYC document file not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get KYC document file: %s", err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read KYC document file: %s", err)
+	}
+
+	downloadURL, err := server.blobStore.SignedURL(ctx, document.FileKey, kycDocumentURLExpiry)
+	if err != nil && !errors.Is(err, storage.ErrSignedURLNotSupported) {
+		return nil, status.Errorf(codes.Internal, "failed to sign KYC document URL: %s", err)
+	}
+
+	rsp := &pb.GetKYCDocumentFileResponse{
+		ContentType: document.ContentType,
+		Content:     content,
+		DownloadUrl: downloadURL,
+	}
+	return rsp, nil
+}
@@ -0,0 +1,35 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetMigrationStatus 让管理员比较数据库结构的版本和正在运行的版本编译进去的迁移，
+发布之后可以确认迁移有没有跑完，或者上一次迁移是不是失败了（dirty）。
+*/
+func (server *Server) GetMigrationStatus(ctx context.Context, req *pb.GetMigrationStatusRequest) (*pb.GetMigrationStatusResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	migrationStatus, err := server.migrationStatus()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get migration status: %s", err)
+	}
+
+	rsp := &pb.GetMigrationStatusResponse{
+		Version:       uint64(migrationStatus.Version),
+		Dirty:         migrationStatus.Dirty,
+		LatestVersion: uint64(migrationStatus.LatestVersion),
+		Pending:       int32(migrationStatus.Pending),
+		Behind:        migrationStatus.Behind(),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/db/migration"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetMigrationStatusAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	testCases := []struct {
+		name            string
+		buildContext    func(t *testing.T, tokenMaker token.Maker) context.Context
+		migrationStatus func() (migration.Status, error)
+		checkResponse   func(t *testing.T, res *pb.GetMigrationStatusResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			migrationStatus: func() (migration.Status, error) {
+				return migration.Status{Version: 20, LatestVersion: 22, Pending: 2}, nil
+			},
+			checkResponse: func(t *testing.T, res *pb.GetMigrationStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, uint64(20), res.GetVersion())
+				require.Equal(t, uint64(22), res.GetLatestVersion())
+				require.Equal(t, int32(2), res.GetPending())
+				require.False(t, res.GetDirty())
+				require.True(t, res.GetBehind())
+			},
+		},
+		{
+			name: "InternalError",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			migrationStatus: func() (migration.Status, error) {
+				return migration.Status{}, errors.New("connection refused")
+			},
+			checkResponse: func(t *testing.T, res *pb.GetMigrationStatusResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "BankerCannotGetMigrationStatus",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			migrationStatus: func() (migration.Status, error) {
+				t.Fatal("migration status should not be read")
+				return migration.Status{}, nil
+			},
+			checkResponse: func(t *testing.T, res *pb.GetMigrationStatusResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+			server.migrationStatus = tc.migrationStatus
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetMigrationStatus(ctx, &pb.GetMigrationStatusRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+)
+
+// GetNotificationPreferences 返回当前登录用户的通知偏好，没有设置过的话返回默认值（只开启邮件）
+func (server *Server) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.GetNotificationPreferencesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	preferences, err := server.getNotificationPreferences(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferences(preferences),
+	}
+	return rsp, nil
+}
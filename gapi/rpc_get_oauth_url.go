@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/techschool/bank/oauth"
+	"github.com/techschool/bank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOAuthURL 返回第三方登录页的地址，客户端把用户重定向过去即可。
+// state 已经包含在地址里了，同时单独返回一份，方便客户端在回调时自己核对。
+func (server *Server) GetOAuthURL(ctx context.Context, req *pb.GetOAuthURLRequest) (*pb.GetOAuthURLResponse, error) {
+	provider, ok := server.oauthProviders[req.GetProvider()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "oauth provider %q is not supported", req.GetProvider())
+	}
+
+	state, err := oauth.NewState([]byte(server.config.OAuthStateKey), req.GetProvider(), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create oauth state: %s", err)
+	}
+
+	rsp := &pb.GetOAuthURLResponse{
+		AuthUrl: provider.AuthCodeURL(state),
+		State:   state,
+	}
+	return rsp, nil
+}
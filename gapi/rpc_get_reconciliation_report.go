@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetReconciliationReport 让管理员查看夜间对账任务的结果（见 worker.TaskReconcileBalances），
+// 包括所有余额和 entries 对不上的账户。不传 report_id 时返回最近一次的报告。
+func (server *Server) GetReconciliationReport(ctx context.Context, req *pb.GetReconciliationReportRequest) (*pb.GetReconciliationReportResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var report db.ReconciliationReport
+	if req.ReportId != nil {
+		report, err = server.store.GetReconciliationReport(ctx, req.GetReportId())
+	} else {
+		report, err = server.store.GetLatestReconciliationReport(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "reconciliation report not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reconciliation report: %s", err)
+	}
+
+	discrepancies, err := server.store.ListReconciliationDiscrepancies(ctx, report.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reconciliation discrepancies: %s", err)
+	}
+
+	rsp := &pb.GetReconciliationReportResponse{
+		Report: convertReconciliationReport(report, discrepancies),
+	}
+	return rsp, nil
+}
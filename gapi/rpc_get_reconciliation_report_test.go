@@ -0,0 +1,147 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetReconciliationReportAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	report := db.ReconciliationReport{
+		ID:               util.RandomInt(1, 1000),
+		AccountsChecked:  10,
+		DiscrepancyCount: 1,
+		StartedAt:        time.Now().Add(-time.Minute),
+		CreatedAt:        time.Now(),
+	}
+	discrepancies := []db.ReconciliationDiscrepancy{
+		{
+			ID:             1,
+			ReportID:       report.ID,
+			AccountID:      util.RandomInt(1, 1000),
+			AccountBalance: 120,
+			EntriesBalance: 100,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetReconciliationReportRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetReconciliationReportResponse, err error)
+	}{
+		{
+			name: "Latest",
+			req:  &pb.GetReconciliationReportRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(1).Return(report, nil)
+				store.EXPECT().GetReconciliationReport(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Eq(report.ID)).
+					Times(1).Return(discrepancies, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReconciliationReportResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, report.ID, res.GetReport().GetId())
+				require.Equal(t, report.AccountsChecked, res.GetReport().GetAccountsChecked())
+				require.Len(t, res.GetReport().GetDiscrepancies(), 1)
+
+				discrepancy := res.GetReport().GetDiscrepancies()[0]
+				require.Equal(t, discrepancies[0].AccountID, discrepancy.GetAccountId())
+				require.Equal(t, int64(120), discrepancy.GetAccountBalance())
+				require.Equal(t, int64(100), discrepancy.GetEntriesBalance())
+			},
+		},
+		{
+			name: "ByID",
+			req:  &pb.GetReconciliationReportRequest{ReportId: proto.Int64(report.ID)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(0)
+				store.EXPECT().GetReconciliationReport(gomock.Any(), gomock.Eq(report.ID)).Times(1).Return(report, nil)
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Eq(report.ID)).
+					Times(1).Return(discrepancies, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReconciliationReportResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, report.ID, res.GetReport().GetId())
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.GetReconciliationReportRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).
+					Times(1).Return(db.ReconciliationReport{}, db.ErrRecordNotFound)
+				store.EXPECT().ListReconciliationDiscrepancies(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReconciliationReportResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidReportID",
+			req:  &pb.GetReconciliationReportRequest{ReportId: proto.Int64(0)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetReconciliationReport(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReconciliationReportResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "BankerCannotGetReport",
+			req:  &pb.GetReconciliationReportRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReconciliationReportResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetReconciliationReport(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
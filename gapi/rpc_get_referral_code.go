@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetReferralCode 返回当前用户的推荐码，以及用这个推荐码注册的用户里还在等待和已经发了奖励的数量。
+推荐码在第一次请求时生成，以后一直不变。
+被推荐的用户验证邮箱并且第一次入账之后，定时任务（见 worker.ProcessTaskCreditReferralBonuses）给双方各转一笔奖励。
+*/
+func (server *Server) GetReferralCode(ctx context.Context, req *pb.GetReferralCodeRequest) (*pb.GetReferralCodeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	referralCode, err := server.store.GetReferralCodeByUsername(ctx, authPayload.Username)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		referralCode, err = server.createReferralCode(ctx, authPayload.Username)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get referral code: %s", err)
+	}
+
+	stats, err := server.store.GetReferralStats(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get referral stats: %s", err)
+	}
+
+	rsp := &pb.GetReferralCodeResponse{
+		Code:          referralCode.Code,
+		PendingCount:  stats.PendingCount,
+		CreditedCount: stats.CreditedCount,
+	}
+	return rsp, nil
+}
+
+// maxReferralCodeAttempts 是生成的推荐码和已有的重复时最多重试的次数
+const maxReferralCodeAttempts = 3
+
+/*
+createReferralCode 生成一个新的推荐码。推荐码是随机的，极少数情况下会和别人的重复，这时候换一个再试；
+同一个用户的两个请求同时创建时，主键冲突的那个请求直接读另一个请求创建的推荐码。
+*/
+func (server *Server) createReferralCode(ctx context.Context, username string) (db.ReferralCode, error) {
+	for attempt := 0; ; attempt++ {
+		code, err := util.GenerateReferralCode()
+		if err != nil {
+			return db.ReferralCode{}, err
+		}
+
+		referralCode, err := server.store.CreateReferralCode(ctx, db.CreateReferralCodeParams{
+			Username: username,
+			Code:     code,
+		})
+		if db.ErrorCode(err) != db.UniqueViolation {
+			return referralCode, err
+		}
+		if db.ConstraintName(err) == referralCodesPkey {
+			return server.store.GetReferralCodeByUsername(ctx, username)
+		}
+		if attempt+1 >= maxReferralCodeAttempts {
+			return db.ReferralCode{}, err
+		}
+	}
+}
+
+// referralCodesPkey 是 referral_codes 表的主键，每个用户只有一个推荐码
+const referralCodesPkey = "referral_codes_pkey"
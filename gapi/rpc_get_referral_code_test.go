@@ -0,0 +1,127 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetReferralCodeAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	referralCode := db.ReferralCode{Username: user.Username, Code: "ABCD2345"}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetReferralCodeResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetReferralCodeByUsername(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(referralCode, nil)
+				store.EXPECT().CreateReferralCode(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					GetReferralStats(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.GetReferralStatsRow{PendingCount: 2, CreditedCount: 1}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReferralCodeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, referralCode.Code, res.GetCode())
+				require.Equal(t, int64(2), res.GetPendingCount())
+				require.Equal(t, int64(1), res.GetCreditedCount())
+			},
+		},
+		{
+			name: "CreateCode",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetReferralCodeByUsername(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.ReferralCode{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateReferralCode(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateReferralCodeParams) (db.ReferralCode, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.True(t, util.IsValidReferralCode(arg.Code))
+						return db.ReferralCode{Username: arg.Username, Code: arg.Code}, nil
+					})
+				store.EXPECT().
+					GetReferralStats(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.GetReferralStatsRow{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReferralCodeResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, util.IsValidReferralCode(res.GetCode()))
+				require.Zero(t, res.GetPendingCount())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetReferralCodeByUsername(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReferralCode{}, sql.ErrConnDone)
+				store.EXPECT().GetReferralStats(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReferralCodeResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetReferralCodeByUsername(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetReferralCodeResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetReferralCode(ctx, &pb.GetReferralCodeRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/version"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetServerInfo 让管理员查看正在运行的是哪个版本、哪个 commit 构建的服务，以及服务已经运行了多久。
+func (server *Server) GetServerInfo(ctx context.Context, req *pb.GetServerInfoRequest) (*pb.GetServerInfoResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	info := version.Get()
+
+	rsp := &pb.GetServerInfoResponse{
+		Version:     info.Version,
+		Commit:      info.Commit,
+		BuildDate:   info.BuildDate,
+		GoVersion:   info.GoVersion,
+		Environment: server.config.Environment,
+		StartedAt:   timestamppb.New(server.startedAt),
+		Uptime:      durationpb.New(time.Since(server.startedAt).Truncate(time.Second)),
+	}
+	return rsp, nil
+}
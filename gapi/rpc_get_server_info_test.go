@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetServerInfoAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	testCases := []struct {
+		name          string
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetServerInfoResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetServerInfoResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, runtime.Version(), res.GetGoVersion())
+				require.WithinDuration(t, time.Now(), res.GetStartedAt().AsTime(), time.Second)
+				require.GreaterOrEqual(t, res.GetUptime().AsDuration(), time.Duration(0))
+			},
+		},
+		{
+			name: "BankerCannotGetServerInfo",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetServerInfoResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetServerInfoResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, nil, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetServerInfo(ctx, &pb.GetServerInfoRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestRegisterDebugHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	RegisterDebugHandlers(mux)
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, DebugPprofPath+"goroutine?debug=1", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "goroutine profile")
+}
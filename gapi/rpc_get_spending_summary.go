@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetSpendingSummary 统计某个账户最近几个月（包括当月）的转出金额，按月份和分类汇总。
+// 没有分类的转账归到 "uncategorized" 里面。
+func (server *Server) GetSpendingSummary(ctx context.Context, req *pb.GetSpendingSummaryRequest) (*pb.GetSpendingSummaryResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	rows, err := server.store.GetSpendingSummary(ctx, db.GetSpendingSummaryParams{
+		AccountID: account.ID,
+		StartTime: startOfMonth.AddDate(0, -int(req.GetMonths()-1), 0),
+		EndTime:   startOfMonth.AddDate(0, 1, 0),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get spending summary: %s", err)
+	}
+
+	rsp := &pb.GetSpendingSummaryResponse{
+		Summaries: make([]*pb.SpendingSummary, 0, len(rows)),
+	}
+	for _, row := range rows {
+		category := row.Category
+		if category == "" {
+			category = util.CategoryUncategorized
+		}
+
+		rsp.Summaries = append(rsp.Summaries, &pb.SpendingSummary{
+			Month:         timestamppb.New(row.Month),
+			Category:      category,
+			TotalAmount:   row.TotalAmount,
+			TransferCount: row.TransferCount,
+		})
+	}
+	return rsp, nil
+}
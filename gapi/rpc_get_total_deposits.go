@@ -0,0 +1,36 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTotalDeposits 按币种汇总所有 depositor 用户的账户余额，给管理后台的看板用。
+// 只统计 depositor 的账户，banker 和 admin 的账户不算在存款里面，已经关闭的账户也不算。
+func (server *Server) GetTotalDeposits(ctx context.Context, req *pb.GetTotalDepositsRequest) (*pb.GetTotalDepositsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	rows, err := server.store.ListTotalDeposits(ctx, util.DepositorRole)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list total deposits: %s", err)
+	}
+
+	rsp := &pb.GetTotalDepositsResponse{
+		Deposits: make([]*pb.CurrencyDeposits, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rsp.Deposits = append(rsp.Deposits, &pb.CurrencyDeposits{
+			Currency:     row.Currency,
+			AccountCount: row.AccountCount,
+			TotalBalance: row.TotalBalance,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,98 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetTotalDepositsAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	rows := []db.ListTotalDepositsRow{
+		{Currency: util.EUR, AccountCount: 2, TotalBalance: 300},
+		{Currency: util.USD, AccountCount: 5, TotalBalance: 1200},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTotalDepositsResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTotalDeposits(gomock.Any(), gomock.Eq(util.DepositorRole)).
+					Times(1).
+					Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTotalDepositsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetDeposits(), 2)
+				require.Equal(t, util.USD, res.GetDeposits()[1].GetCurrency())
+				require.Equal(t, int64(5), res.GetDeposits()[1].GetAccountCount())
+				require.Equal(t, int64(1200), res.GetDeposits()[1].GetTotalBalance())
+			},
+		},
+		{
+			name: "InternalError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTotalDeposits(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTotalDepositsResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "BankerCannotGetDeposits",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTotalDeposits(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTotalDepositsResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetTotalDeposits(ctx, &pb.GetTotalDepositsRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+GetTransferQuote 返回外币转账的汇率、手续费和到账金额，并把报价存下来。
+创建转账时带上报价的 ID（quote_id），在 FX_QUOTE_TTL 之内按报价的汇率转账，报价只能用一次，也只有请求报价的用户可以用。
+汇率来自 FX_RATES，两种货币都要在 FX_ACCOUNTS 里有外汇账户才能报价，换算规则见 util.QuoteFX。
+*/
+func (server *Server) GetTransferQuote(ctx context.Context, req *pb.GetTransferQuoteRequest) (*pb.GetTransferQuoteResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetTransferQuoteRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// 请求已经校验过，这里只是把 amount_decimal 转换成最小货币单位
+	amount, _ := val.ValidateMoney(req.GetAmount(), req.GetAmountDecimal(), req.GetFromCurrency())
+
+	rates, err := util.ParseFXRates(server.config.FXRates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid exchange rates: %s", err)
+	}
+	fxAccounts, err := util.ParseCurrencyAccounts(server.config.FXAccounts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid FX accounts: %s", err)
+	}
+	if fxAccounts[req.GetFromCurrency()] == 0 || fxAccounts[req.GetToCurrency()] == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", util.ErrUnsupportedCurrencyPair)
+	}
+
+	quote, err := util.QuoteFX(rates, server.config.FXFeeBps, util.Money{Amount: amount, Currency: req.GetFromCurrency()}, req.GetToCurrency())
+	if err != nil {
+		if errors.Is(err, util.ErrUnsupportedCurrencyPair) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, util.ErrInvalidAmount) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("amount", err)})
+		}
+		return nil, status.Errorf(codes.Internal, "failed to quote transfer: %s", err)
+	}
+
+	transferQuote, err := server.store.CreateTransferQuote(ctx, db.CreateTransferQuoteParams{
+		Username:     authPayload.Username,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		FromAmount:   quote.FromAmount,
+		Fee:          quote.Fee,
+		ToAmount:     quote.ToAmount,
+		Rate:         quote.Rate,
+		ExpiresAt:    time.Now().Add(server.config.FXQuoteTTL),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create transfer quote: %s", err)
+	}
+
+	rsp := &pb.GetTransferQuoteResponse{
+		Quote: convertTransferQuote(transferQuote),
+	}
+	return rsp, nil
+}
+
+func validateGetTransferQuoteRequest(req *pb.GetTransferQuoteRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	} else if _, err := val.ValidateMoney(req.GetAmount(), req.GetAmountDecimal(), req.GetFromCurrency()); err != nil {
+		field := "amount"
+		if req.GetAmountDecimal() != "" {
+			field = "amount_decimal"
+		}
+		violations = append(violations, fieldViolation(field, err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	} else if req.GetToCurrency() == req.GetFromCurrency() {
+		violations = append(violations, fieldViolation("to_currency", fmt.Errorf("must be different from from_currency")))
+	}
+
+	return violations
+}
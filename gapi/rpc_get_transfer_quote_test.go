@@ -0,0 +1,211 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTransferQuoteAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetTransferQuoteRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTransferQuoteResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Amount:       10000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTransferQuote(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateTransferQuoteParams) (db.TransferQuote, error) {
+						// FX_FEE_BPS=100：手续费 1.00 USD，剩下的 99.00 USD 按 0.92 换成 91.08 EUR
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, int64(10000), arg.FromAmount)
+						require.Equal(t, int64(100), arg.Fee)
+						require.Equal(t, int64(9108), arg.ToAmount)
+						require.Equal(t, "0.92", arg.Rate)
+						require.WithinDuration(t, time.Now().Add(30*time.Second), arg.ExpiresAt, time.Second)
+						return db.TransferQuote{
+							ID:           1,
+							Username:     arg.Username,
+							FromCurrency: arg.FromCurrency,
+							ToCurrency:   arg.ToCurrency,
+							FromAmount:   arg.FromAmount,
+							Fee:          arg.Fee,
+							ToAmount:     arg.ToAmount,
+							Rate:         arg.Rate,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), res.GetQuote().GetId())
+				require.Equal(t, int64(9108), res.GetQuote().GetToAmount())
+				require.Equal(t, "0.92", res.GetQuote().GetRate())
+			},
+		},
+		{
+			name: "AmountDecimal",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency:  util.EUR,
+				ToCurrency:    util.USD,
+				AmountDecimal: "92.00",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateTransferQuote(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateTransferQuoteParams) (db.TransferQuote, error) {
+						require.Equal(t, int64(9200), arg.FromAmount)
+						require.Equal(t, int64(92), arg.Fee)
+						require.Equal(t, int64(9900), arg.ToAmount)
+						return db.TransferQuote{ID: 2, FromAmount: arg.FromAmount, ToAmount: arg.ToAmount}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(9900), res.GetQuote().GetToAmount())
+			},
+		},
+		{
+			name: "UnsupportedPair",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.JPY,
+				Amount:       10000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTransferQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "SameCurrency",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.USD,
+				Amount:       10000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTransferQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+				require.Equal(t, "to_currency", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "AmountTooSmall",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Amount:       1,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTransferQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidAmountDecimal",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency:  util.USD,
+				ToCurrency:    util.EUR,
+				AmountDecimal: "1.234",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTransferQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+				require.Equal(t, "amount_decimal", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.GetTransferQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+				Amount:       10000,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateTransferQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			// USD/JPY 有汇率但是没有 JPY 的外汇账户
+			server.config.FXRates = "USD/EUR:0.92,USD/JPY:150"
+			server.config.FXAccounts = "USD:1,EUR:2"
+			server.config.FXFeeBps = 100
+			server.config.FXQuoteTTL = 30 * time.Second
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetTransferQuote(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
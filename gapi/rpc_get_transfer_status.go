@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetTransferStatus 返回转账在 saga 里的状态：pending、confirmed 或 reversed。
+// 没有记录在 pending_operations 里的转账是普通的即时转账，状态直接是 completed。
+// 转出方、转入方以及 banker 都可以查询。
+func (server *Server) GetTransferStatus(ctx context.Context, req *pb.GetTransferStatusRequest) (*pb.GetTransferStatusResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	err = server.authorizeTransfer(ctx, authPayload, transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetTransferStatusResponse{
+		Transfer:  convertTransfer(transfer),
+		Status:    util.TransferCompleted,
+		UpdatedAt: timestamppb.New(transfer.CreatedAt),
+	}
+
+	operation, err := server.store.GetPendingOperationByTransfer(ctx, transfer.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return rsp, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get pending operation: %s", err)
+	}
+
+	rsp.Status = operation.Status
+	rsp.FailureReason = operation.LastError
+	rsp.ReversalTransferId = operation.ReversalTransferID.Int64
+	rsp.UpdatedAt = timestamppb.New(operation.UpdatedAt)
+	return rsp, nil
+}
+
+// authorizeTransfer 确认当前用户是转出方或者转入方账户的主人，或者是 banker
+func (server *Server) authorizeTransfer(ctx context.Context, authPayload *token.Payload, transfer db.Transfer) error {
+	if authPayload.Role == util.BankerRole {
+		return nil
+	}
+
+	for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+		account, err := server.store.GetAccount(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				continue
+			}
+			return status.Errorf(codes.Internal, "failed to get account: %s", err)
+		}
+
+		if account.Owner == authPayload.Username {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "transfer doesn't belong to the authenticated user")
+}
@@ -0,0 +1,185 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetTransferStatusAPI(t *testing.T) {
+	sender, _ := randomUser(t, util.DepositorRole)
+	receiver, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	fromAccount := db.Account{ID: 1, Owner: sender.Username, Currency: util.USD}
+	toAccount := db.Account{ID: 2, Owner: receiver.Username, Currency: util.USD}
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        10,
+		CreatedAt:     time.Now(),
+	}
+
+	reversed := db.PendingOperation{
+		ID:                 util.RandomInt(1, 1000),
+		TransferID:         transfer.ID,
+		Kind:               util.OperationExternalPayout,
+		Status:             util.OperationReversed,
+		LastError:          "operation timed out",
+		ReversalTransferID: pgtype.Int8{Int64: transfer.ID + 1, Valid: true},
+		UpdatedAt:          time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetTransferStatusRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTransferStatusResponse, err error)
+	}{
+		{
+			name: "Completed",
+			req:  &pb.GetTransferStatusRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					GetPendingOperationByTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(db.PendingOperation{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.TransferCompleted, res.GetStatus())
+				require.Equal(t, transfer.ID, res.GetTransfer().GetId())
+				require.Zero(t, res.GetReversalTransferId())
+			},
+		},
+		{
+			name: "ReversedForReceiver",
+			req:  &pb.GetTransferStatusRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					GetPendingOperationByTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(reversed, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, receiver.Username, receiver.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.OperationReversed, res.GetStatus())
+				require.Equal(t, reversed.LastError, res.GetFailureReason())
+				require.Equal(t, reversed.ReversalTransferID.Int64, res.GetReversalTransferId())
+			},
+		},
+		{
+			name: "Banker",
+			req:  &pb.GetTransferStatusRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().
+					GetPendingOperationByTransfer(gomock.Any(), gomock.Eq(transfer.ID)).
+					Times(1).
+					Return(reversed, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.OperationReversed, res.GetStatus())
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.GetTransferStatusRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().GetPendingOperationByTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "TransferNotFound",
+			req:  &pb.GetTransferStatusRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, db.ErrRecordNotFound)
+				store.EXPECT().GetPendingOperationByTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidTransferID",
+			req:  &pb.GetTransferStatusRequest{TransferId: 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTransferStatusResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetTransferStatus(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/version"
+)
+
+// GetVersion 返回正在运行的服务的版本、commit 和构建时间，不需要登录，
+// 部署之后可以用 GET /version 确认新版本已经上线。
+func (server *Server) GetVersion(ctx context.Context, req *pb.GetVersionRequest) (*pb.GetVersionResponse, error) {
+	info := version.Get()
+
+	rsp := &pb.GetVersionResponse{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildDate: info.BuildDate,
+		GoVersion: info.GoVersion,
+	}
+	return rsp, nil
+}
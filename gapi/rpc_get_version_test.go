@@ -0,0 +1,23 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/version"
+)
+
+func TestGetVersionAPI(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+
+	// 不需要登录
+	res, err := server.GetVersion(context.Background(), &pb.GetVersionRequest{})
+	require.NoError(t, err)
+
+	info := version.Get()
+	require.Equal(t, info.Version, res.GetVersion())
+	require.Equal(t, info.Commit, res.GetCommit())
+	require.Equal(t, info.GoVersion, res.GetGoVersion())
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetWorkerStats 让管理员查看后台任务队列的情况，比如有多少任务在等待、重试或者已经归档，
+// 以及今天处理成功和失败的数量。
+func (server *Server) GetWorkerStats(ctx context.Context, req *pb.GetWorkerStatsRequest) (*pb.GetWorkerStatsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	stats, err := server.taskInspector.GetQueueStats()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get queue stats: %s", err)
+	}
+
+	rsp := &pb.GetWorkerStatsResponse{
+		Queues: make([]*pb.QueueStats, len(stats)),
+	}
+	for i, queueStats := range stats {
+		rsp.Queues[i] = convertQueueStats(queueStats)
+	}
+	return rsp, nil
+}
+
+func convertQueueStats(stats worker.QueueStats) *pb.QueueStats {
+	return &pb.QueueStats{
+		Queue:          stats.Queue,
+		Size:           int64(stats.Size),
+		Pending:        int64(stats.Pending),
+		Active:         int64(stats.Active),
+		Scheduled:      int64(stats.Scheduled),
+		Retry:          int64(stats.Retry),
+		Archived:       int64(stats.Archived),
+		Completed:      int64(stats.Completed),
+		ProcessedToday: int64(stats.Processed),
+		FailedToday:    int64(stats.Failed),
+		ProcessedTotal: int64(stats.ProcessedTotal),
+		FailedTotal:    int64(stats.FailedTotal),
+		FailureRate:    stats.FailureRate(),
+		LatencyMs:      stats.Latency.Milliseconds(),
+		Paused:         stats.Paused,
+	}
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetWorkerStatsAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	stats := []worker.QueueStats{
+		{
+			Queue:     worker.QueueCritical,
+			Size:      3,
+			Pending:   2,
+			Retry:     1,
+			Processed: 10,
+			Failed:    2,
+			Latency:   1500 * time.Millisecond,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(inspector *mockwk.MockTaskInspector)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetWorkerStatsResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetQueueStats().Times(1).Return(stats, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetWorkerStatsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetQueues(), 1)
+
+				queue := res.GetQueues()[0]
+				require.Equal(t, worker.QueueCritical, queue.GetQueue())
+				require.Equal(t, int64(2), queue.GetPending())
+				require.Equal(t, int64(1), queue.GetRetry())
+				require.Equal(t, int64(10), queue.GetProcessedToday())
+				require.InDelta(t, 0.2, queue.GetFailureRate(), 1e-9)
+				require.Equal(t, int64(1500), queue.GetLatencyMs())
+			},
+		},
+		{
+			name: "BankerCannotGetWorkerStats",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetQueueStats().Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetWorkerStatsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InspectorError",
+			buildStubs: func(inspector *mockwk.MockTaskInspector) {
+				inspector.EXPECT().GetQueueStats().Times(1).Return(nil, errors.New("redis is down"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetWorkerStatsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			inspectorCtrl := gomock.NewController(t)
+			defer inspectorCtrl.Finish()
+			inspector := mockwk.NewMockTaskInspector(inspectorCtrl)
+
+			tc.buildStubs(inspector)
+			server := newTestServerWithInspector(t, nil, nil, inspector)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetWorkerStats(ctx, &pb.GetWorkerStatsRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
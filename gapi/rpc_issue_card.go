@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// issueCardAttempts 是卡号冲突（pan_hash 唯一索引）时最多重试的次数
+const issueCardAttempts = 3
+
+/*
+IssueCard 给账户发一张虚拟借记卡。
+数据库里只保存卡号的 HMAC（pan_hash）和后四位，完整卡号只在这次响应里返回一次，之后都是打码显示。
+*/
+func (server *Server) IssueCard(ctx context.Context, req *pb.IssueCardRequest) (*pb.IssueCardResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := time.Now().UTC().Add(util.CardValidity)
+
+	for attempt := 0; attempt < issueCardAttempts; attempt++ {
+		pan, err := util.GeneratePAN(util.CardBIN, util.CardNumberLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate card number: %s", err)
+		}
+
+		card, err := server.store.CreateCard(ctx, db.CreateCardParams{
+			AccountID:   account.ID,
+			PanHash:     util.HashPAN(server.config.CardPANKey, pan),
+			Last4:       pan[len(pan)-4:],
+			ExpiryMonth: int32(expiry.Month()),
+			ExpiryYear:  int32(expiry.Year()),
+		})
+		if err != nil {
+			// 随机生成的卡号和已有的卡撞了，换一个重新生成
+			if db.ErrorCode(err) == db.UniqueViolation {
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "failed to create card: %s", err)
+		}
+
+		rsp := &pb.IssueCardResponse{
+			Card:   convertCard(card),
+			Number: pan,
+		}
+		return rsp, nil
+	}
+
+	return nil, status.Errorf(codes.Internal, "failed to generate a unique card number")
+}
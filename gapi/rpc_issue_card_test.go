@@ -0,0 +1,143 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestIssueCardAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Balance: 1000, Currency: util.USD}
+
+	testCases := []struct {
+		name          string
+		req           *pb.IssueCardRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.IssueCardResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.IssueCardRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateCard(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateCardParams) (db.Card, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Len(t, arg.Last4, 4)
+						require.NotEmpty(t, arg.PanHash)
+						return db.Card{
+							ID:          7,
+							AccountID:   arg.AccountID,
+							PanHash:     arg.PanHash,
+							Last4:       arg.Last4,
+							ExpiryMonth: arg.ExpiryMonth,
+							ExpiryYear:  arg.ExpiryYear,
+							Status:      util.CardActive,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.IssueCardResponse, err error) {
+				require.NoError(t, err)
+				number := res.GetNumber()
+				require.Len(t, number, util.CardNumberLength)
+				require.True(t, util.IsValidLuhn(number))
+				require.Equal(t, util.MaskPAN(number[len(number)-4:]), res.GetCard().GetMaskedNumber())
+				require.NotContains(t, res.GetCard().GetMaskedNumber(), number[:12])
+			},
+		},
+		{
+			name: "RetryOnDuplicateNumber",
+			req:  &pb.IssueCardRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				gomock.InOrder(
+					store.EXPECT().CreateCard(gomock.Any(), gomock.Any()).Times(1).Return(db.Card{}, db.ErrUniqueViolation),
+					store.EXPECT().CreateCard(gomock.Any(), gomock.Any()).Times(1).Return(db.Card{ID: 7, Last4: "4242"}, nil),
+				)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.IssueCardResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(7), res.GetCard().GetId())
+			},
+		},
+		{
+			name: "AccountNotOwned",
+			req:  &pb.IssueCardRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateCard(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.IssueCardResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "InvalidAccountID",
+			req:  &pb.IssueCardRequest{AccountId: 0},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.IssueCardResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "Unauthenticated",
+			req:  &pb.IssueCardRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.IssueCardResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.config.CardPANKey = util.RandomString(32)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.IssueCard(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
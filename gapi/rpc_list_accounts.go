@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ListAccounts 列出当前登录用户的账户，使用游标分页：
+客户端第一次请求时不传 page_token，之后把上一次响应里的 next_page_token 原样传回来即可。
+next_page_token 为空说明已经是最后一页。
+*/
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accounts, nextPageToken, err := server.listAccounts(ctx, authPayload.Username, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListAccountsResponse{
+		Accounts:      make([]*pb.Account, 0, len(accounts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccount(account))
+	}
+	return rsp, nil
+}
+
+// listAccounts 是 v1 和 v2 的 ListAccounts 共用的查询逻辑，返回这一页的账户和下一页的 page_token
+func (server *Server) listAccounts(ctx context.Context, owner string, pageToken string, pageSize int32) ([]db.Account, string, error) {
+	// page_token 在调用之前已经校验过了，这里不会出错
+	afterID, _ := util.DecodePageToken(pageToken)
+
+	// 多查一条，用来判断后面还有没有数据
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:   owner,
+		AfterID: afterID,
+		Limit:   pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	accounts, nextPageToken := util.Paginate(accounts, pageSize, func(account db.Account) int64 {
+		return account.ID
+	})
+
+	// 这一页里最后修改的账户决定整页的 Last-Modified
+	var lastModified time.Time
+	for _, account := range accounts {
+		if account.UpdatedAt.After(lastModified) {
+			lastModified = account.UpdatedAt
+		}
+	}
+	if !lastModified.IsZero() {
+		setLastModified(ctx, lastModified)
+	}
+	return accounts, nextPageToken, nil
+}
+
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBalanceAlerts 返回账户的所有余额提醒，每种最多一个，所以不分页
+func (server *Server) ListBalanceAlerts(ctx context.Context, req *pb.ListBalanceAlertsRequest) (*pb.ListBalanceAlertsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := server.store.ListBalanceAlerts(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list balance alerts: %s", err)
+	}
+
+	rsp := &pb.ListBalanceAlertsResponse{}
+	for _, alert := range alerts {
+		rsp.BalanceAlerts = append(rsp.BalanceAlerts, convertBalanceAlert(alert))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,22 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+)
+
+// ListCurrencies 返回服务器当前支持的货币和每种货币的小数位数，不需要登录。
+// 列表来自配置 SUPPORTED_CURRENCIES，客户端可以用它来渲染下拉框和格式化金额。
+func (server *Server) ListCurrencies(ctx context.Context, req *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	currencies := util.SupportedCurrencies()
+
+	rsp := &pb.ListCurrenciesResponse{
+		Currencies: make([]*pb.Currency, len(currencies)),
+	}
+	for i, currency := range currencies {
+		rsp.Currencies[i] = convertCurrency(currency)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,25 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+)
+
+func TestListCurrenciesAPI(t *testing.T) {
+	server := newTestServer(t, nil, nil)
+
+	res, err := server.ListCurrencies(context.Background(), &pb.ListCurrenciesRequest{})
+	require.NoError(t, err)
+
+	currencies := util.SupportedCurrencies()
+	require.Len(t, res.GetCurrencies(), len(currencies))
+	for i, currency := range currencies {
+		require.Equal(t, currency.Code, res.GetCurrencies()[i].GetCode())
+		require.Equal(t, int32(currency.MinorUnits), res.GetCurrencies()[i].GetMinorUnits())
+	}
+	require.Contains(t, res.GetCurrencies(), &pb.Currency{Code: util.JPY, MinorUnits: 0})
+}
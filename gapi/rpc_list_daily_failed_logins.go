@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+/*
+ListDailyFailedLogins 按 UTC 的自然日统计失败的登录（LoginUser 和 Gin 的 /users/login 记下的 failed_logins），
+同时返回涉及的用户名和 IP 的个数：很多用户名对应少数 IP 一般是撞库，一个用户名对应很多 IP 一般是针对某个用户的猜密码。
+*/
+func (server *Server) ListDailyFailedLogins(ctx context.Context, req *pb.ListDailyFailedLoginsRequest) (*pb.ListDailyFailedLoginsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	startTime, endTime, violations := dashboardTimeRange(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.ListDailyFailedLogins(ctx, db.ListDailyFailedLoginsParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list daily failed logins: %s", err)
+	}
+
+	rsp := &pb.ListDailyFailedLoginsResponse{
+		Days: make([]*pb.DailyFailedLogins, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rsp.Days = append(rsp.Days, &pb.DailyFailedLogins{
+			Day:           timestamppb.New(row.Day),
+			FailedCount:   row.FailedCount,
+			UsernameCount: row.UsernameCount,
+			ClientIpCount: row.ClientIpCount,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,90 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListDailyFailedLoginsAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListDailyFailedLoginsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListDailyFailedLoginsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListDailyFailedLoginsRequest{
+				StartTime: timestamppb.New(day),
+				EndTime:   timestamppb.New(day.AddDate(0, 0, 1)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListDailyFailedLoginsParams{
+					StartTime: day,
+					EndTime:   day.AddDate(0, 0, 1),
+				}
+				store.EXPECT().
+					ListDailyFailedLogins(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListDailyFailedLoginsRow{{Day: day, FailedCount: 12, UsernameCount: 10, ClientIpCount: 1}}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyFailedLoginsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetDays(), 1)
+				require.Equal(t, day, res.GetDays()[0].GetDay().AsTime())
+				require.Equal(t, int64(12), res.GetDays()[0].GetFailedCount())
+				require.Equal(t, int64(1), res.GetDays()[0].GetClientIpCount())
+			},
+		},
+		{
+			name: "BankerCannotListFailedLogins",
+			req:  &pb.ListDailyFailedLoginsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyFailedLogins(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyFailedLoginsResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListDailyFailedLogins(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListDailyNewUsers 按 UTC 的自然日统计注册的用户数，后来删除了的用户也算在注册的那一天
+func (server *Server) ListDailyNewUsers(ctx context.Context, req *pb.ListDailyNewUsersRequest) (*pb.ListDailyNewUsersResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	startTime, endTime, violations := dashboardTimeRange(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.ListDailyNewUsers(ctx, db.ListDailyNewUsersParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list daily new users: %s", err)
+	}
+
+	rsp := &pb.ListDailyNewUsersResponse{
+		Days: make([]*pb.DailyNewUsers, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rsp.Days = append(rsp.Days, &pb.DailyNewUsers{
+			Day:       timestamppb.New(row.Day),
+			UserCount: row.UserCount,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListDailyNewUsersAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListDailyNewUsersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListDailyNewUsersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListDailyNewUsersRequest{
+				StartTime: timestamppb.New(day),
+				EndTime:   timestamppb.New(day.AddDate(0, 0, 1)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListDailyNewUsersParams{
+					StartTime: day,
+					EndTime:   day.AddDate(0, 0, 1),
+				}
+				store.EXPECT().
+					ListDailyNewUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.ListDailyNewUsersRow{{Day: day, UserCount: 7}}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyNewUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetDays(), 1)
+				require.Equal(t, day, res.GetDays()[0].GetDay().AsTime())
+				require.Equal(t, int64(7), res.GetDays()[0].GetUserCount())
+			},
+		},
+		{
+			name: "BankerCannotListNewUsers",
+			req:  &pb.ListDailyNewUsersRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyNewUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyNewUsersResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListDailyNewUsers(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
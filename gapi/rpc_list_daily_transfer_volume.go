@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListDailyTransferVolume 按 UTC 的自然日和转出账户的币种统计转账笔数和金额。
+// 手续费是单独的一笔转账（fee_of 不为空），不算在转账量里面。
+func (server *Server) ListDailyTransferVolume(ctx context.Context, req *pb.ListDailyTransferVolumeRequest) (*pb.ListDailyTransferVolumeResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	startTime, endTime, violations := dashboardTimeRange(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.ListDailyTransferVolume(ctx, db.ListDailyTransferVolumeParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list daily transfer volume: %s", err)
+	}
+
+	rsp := &pb.ListDailyTransferVolumeResponse{
+		Volumes: make([]*pb.DailyTransferVolume, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rsp.Volumes = append(rsp.Volumes, &pb.DailyTransferVolume{
+			Day:           timestamppb.New(row.Day),
+			Currency:      row.Currency,
+			TransferCount: row.TransferCount,
+			TotalAmount:   row.TotalAmount,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,170 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListDailyTransferVolumeAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	startTime := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	rows := []db.ListDailyTransferVolumeRow{
+		{Day: startTime, Currency: util.EUR, TransferCount: 1, TotalAmount: 50},
+		{Day: startTime, Currency: util.USD, TransferCount: 3, TotalAmount: 700},
+		{Day: startTime.AddDate(0, 0, 2), Currency: util.USD, TransferCount: 2, TotalAmount: 20},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListDailyTransferVolumeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListDailyTransferVolumeRequest{
+				StartTime: timestamppb.New(startTime),
+				EndTime:   timestamppb.New(endTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListDailyTransferVolumeParams{
+					StartTime: startTime,
+					EndTime:   endTime,
+				}
+				store.EXPECT().
+					ListDailyTransferVolume(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(rows, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetVolumes(), 3)
+				// 没有转账的日期不返回
+				require.Equal(t, startTime.AddDate(0, 0, 2), res.GetVolumes()[2].GetDay().AsTime())
+				require.Equal(t, int64(700), res.GetVolumes()[1].GetTotalAmount())
+			},
+		},
+		{
+			name: "DefaultRange",
+			req:  &pb.ListDailyTransferVolumeRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListDailyTransferVolume(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ListDailyTransferVolumeParams) ([]db.ListDailyTransferVolumeRow, error) {
+						require.WithinDuration(t, time.Now(), arg.EndTime, time.Second)
+						require.Equal(t, defaultDashboardRange, arg.EndTime.Sub(arg.StartTime))
+						return []db.ListDailyTransferVolumeRow{}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetVolumes())
+			},
+		},
+		{
+			name: "StartAfterEnd",
+			req: &pb.ListDailyTransferVolumeRequest{
+				StartTime: timestamppb.New(endTime),
+				EndTime:   timestamppb.New(startTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyTransferVolume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+				require.Equal(t, "start_time", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "RangeTooLong",
+			req: &pb.ListDailyTransferVolumeRequest{
+				StartTime: timestamppb.New(endTime.AddDate(-2, 0, 0)),
+				EndTime:   timestamppb.New(endTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyTransferVolume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidEndTime",
+			req: &pb.ListDailyTransferVolumeRequest{
+				EndTime: &timestamppb.Timestamp{Seconds: 1, Nanos: -1},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyTransferVolume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+				badRequest := status.Convert(err).Details()[0].(*errdetails.BadRequest)
+				require.Equal(t, "end_time", badRequest.GetFieldViolations()[0].GetField())
+			},
+		},
+		{
+			name: "DepositorCannotListVolume",
+			req:  &pb.ListDailyTransferVolumeRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListDailyTransferVolume(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListDailyTransferVolumeResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListDailyTransferVolume(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
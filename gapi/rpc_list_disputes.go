@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListDisputes 列出争议，可以按状态过滤，分页方式和 ListAccounts 一样。banker 能看到所有的争议，其他用户只能看到自己提出的。
+func (server *Server) ListDisputes(ctx context.Context, req *pb.ListDisputesRequest) (*pb.ListDisputesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListDisputesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	afterID, _ := util.DecodePageToken(req.GetPageToken())
+	arg := db.ListDisputesParams{
+		Status: pgtype.Text{
+			String: req.GetStatus(),
+			Valid:  req.GetStatus() != "",
+		},
+		AfterID: afterID,
+		Limit:   req.GetPageSize() + 1,
+	}
+	if authPayload.Role != util.BankerRole {
+		arg.OpenedBy = pgtype.Text{String: authPayload.Username, Valid: true}
+	}
+
+	disputes, err := server.store.ListDisputes(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list disputes: %s", err)
+	}
+
+	disputes, nextPageToken := util.Paginate(disputes, req.GetPageSize(), func(dispute db.Dispute) int64 {
+		return dispute.ID
+	})
+
+	rsp := &pb.ListDisputesResponse{
+		Disputes:      make([]*pb.Dispute, 0, len(disputes)),
+		NextPageToken: nextPageToken,
+	}
+	for _, dispute := range disputes {
+		rsp.Disputes = append(rsp.Disputes, convertDispute(dispute))
+	}
+	return rsp, nil
+}
+
+func validateListDisputesRequest(req *pb.ListDisputesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if req.GetStatus() != "" {
+		if err := val.ValidateDisputeStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListEntries 列出某个账户的资金流水，分页方式和 ListAccounts 一样。
+func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListEntriesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextPageToken, err := server.listEntries(ctx, account, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListEntriesResponse{
+		Entries:       make([]*pb.Entry, 0, len(entries)),
+		NextPageToken: nextPageToken,
+	}
+	for _, entry := range entries {
+		rsp.Entries = append(rsp.Entries, convertEntry(entry))
+	}
+	return rsp, nil
+}
+
+// listEntries 是 v1 和 v2 的 ListEntries 共用的查询逻辑
+func (server *Server) listEntries(ctx context.Context, account db.Account, pageToken string, pageSize int32) ([]db.Entry, string, error) {
+	afterID, _ := util.DecodePageToken(pageToken)
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID: account.ID,
+		AfterID:   afterID,
+		Limit:     pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	entries, nextPageToken := util.Paginate(entries, pageSize, func(entry db.Entry) int64 {
+		return entry.ID
+	})
+	return entries, nextPageToken, nil
+}
+
+func validateListEntriesRequest(req *pb.ListEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
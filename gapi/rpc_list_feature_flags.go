@@ -0,0 +1,31 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListFeatureFlags 直接读数据库而不是 flags 的缓存，管理员看到的总是最新的值
+func (server *Server) ListFeatureFlags(ctx context.Context, req *pb.ListFeatureFlagsRequest) (*pb.ListFeatureFlagsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	featureFlags, err := server.store.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list feature flags: %s", err)
+	}
+
+	rsp := &pb.ListFeatureFlagsResponse{
+		Flags: make([]*pb.FeatureFlag, 0, len(featureFlags)),
+	}
+	for _, flag := range featureFlags {
+		rsp.Flags = append(rsp.Flags, convertFeatureFlag(flag))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListKYCDocuments 返回一个用户的所有 KYC 证件和当前的 KYC 等级，depositor 只能查看自己的证件
+func (server *Server) ListKYCDocuments(ctx context.Context, req *pb.ListKYCDocumentsRequest) (*pb.ListKYCDocumentsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	username := req.GetUsername()
+	if username == "" {
+		username = authPayload.Username
+	}
+
+	if authPayload.Role != util.BankerRole && username != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot list other user's KYC documents")
+	}
+
+	documents, err := server.store.ListKYCDocuments(ctx, username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list KYC documents: %s", err)
+	}
+
+	level, err := server.store.GetKYCLevel(ctx, username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get KYC level: %s", err)
+	}
+
+	rsp := &pb.ListKYCDocumentsResponse{
+		Documents: make([]*pb.KYCDocument, len(documents)),
+		KycLevel:  level,
+	}
+	for i, document := range documents {
+		rsp.Documents[i] = convertKYCDocument(document)
+	}
+	return rsp, nil
+}
+
+// ListPendingKYCDocuments 按上传时间返回等待审核的 KYC 证件（banker only）
+func (server *Server) ListPendingKYCDocuments(ctx context.Context, req *pb.ListPendingKYCDocumentsRequest) (*pb.ListPendingKYCDocumentsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	documents, err := server.store.ListPendingKYCDocuments(ctx, db.ListPendingKYCDocumentsParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list pending KYC documents: %s", err)
+	}
+
+	rsp := &pb.ListPendingKYCDocumentsResponse{
+		Documents: make([]*pb.KYCDocument, len(documents)),
+	}
+	for i, document := range documents {
+		rsp.Documents[i] = convertKYCDocument(document)
+	}
+	return rsp, nil
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTopAccountsLimit is used when ListTopAccounts has no limit
+const defaultTopAccountsLimit = 10
+
+// ListTopAccounts 返回时间范围内 entries 最多的账户，以及转入和转出的总金额（转出是正数）
+func (server *Server) ListTopAccounts(ctx context.Context, req *pb.ListTopAccountsRequest) (*pb.ListTopAccountsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	startTime, endTime, rangeViolations := dashboardTimeRange(req)
+	violations = append(violations, rangeViolations...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = defaultTopAccountsLimit
+	}
+
+	rows, err := server.store.ListTopAccountsByActivity(ctx, db.ListTopAccountsByActivityParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list top accounts: %s", err)
+	}
+
+	rsp := &pb.ListTopAccountsResponse{
+		Accounts: make([]*pb.AccountActivity, 0, len(rows)),
+	}
+	for _, row := range rows {
+		rsp.Accounts = append(rsp.Accounts, &pb.AccountActivity{
+			AccountId:  row.AccountID,
+			Owner:      row.Owner,
+			Currency:   row.Currency,
+			EntryCount: row.EntryCount,
+			TotalIn:    row.TotalIn,
+			TotalOut:   row.TotalOut,
+		})
+	}
+	return rsp, nil
+}
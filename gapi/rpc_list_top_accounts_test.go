@@ -0,0 +1,124 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestListTopAccountsAPI(t *testing.T) {
+	admin, _ := randomUser(t, util.AdminRole)
+	row := db.ListTopAccountsByActivityRow{
+		AccountID:  util.RandomInt(1, 1000),
+		Owner:      util.RandomOwner(),
+		Currency:   util.USD,
+		EntryCount: 42,
+		TotalIn:    1000,
+		TotalOut:   400,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListTopAccountsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListTopAccountsResponse, err error)
+	}{
+		{
+			name: "DefaultLimit",
+			req:  &pb.ListTopAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTopAccountsByActivity(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ListTopAccountsByActivityParams) ([]db.ListTopAccountsByActivityRow, error) {
+						require.Equal(t, int32(defaultTopAccountsLimit), arg.Limit)
+						return []db.ListTopAccountsByActivityRow{row}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTopAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), 1)
+				require.Equal(t, row.AccountID, res.GetAccounts()[0].GetAccountId())
+				require.Equal(t, row.Owner, res.GetAccounts()[0].GetOwner())
+				require.Equal(t, int64(42), res.GetAccounts()[0].GetEntryCount())
+				require.Equal(t, int64(400), res.GetAccounts()[0].GetTotalOut())
+			},
+		},
+		{
+			name: "Limit",
+			req:  &pb.ListTopAccountsRequest{Limit: 3},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTopAccountsByActivity(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ListTopAccountsByActivityParams) ([]db.ListTopAccountsByActivityRow, error) {
+						require.Equal(t, int32(3), arg.Limit)
+						return []db.ListTopAccountsByActivityRow{}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTopAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res.GetAccounts())
+			},
+		},
+		{
+			name: "LimitTooLarge",
+			req:  &pb.ListTopAccountsRequest{Limit: 101},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTopAccountsByActivity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTopAccountsResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.ListTopAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTopAccountsByActivity(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListTopAccountsResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListTopAccounts(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
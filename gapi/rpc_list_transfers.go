@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTransfers 列出某个账户转入和转出的转账记录，分页方式和 ListAccounts 一样。
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, nextPageToken, err := server.listTransfers(ctx, account, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ListTransfersResponse{
+		Transfers:     make([]*pb.Transfer, 0, len(transfers)),
+		NextPageToken: nextPageToken,
+	}
+	for _, transfer := range transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer))
+	}
+	return rsp, nil
+}
+
+// listTransfers 是 v1 和 v2 的 ListTransfers 共用的查询逻辑
+func (server *Server) listTransfers(ctx context.Context, account db.Account, pageToken string, pageSize int32) ([]db.Transfer, string, error) {
+	afterID, _ := util.DecodePageToken(pageToken)
+
+	transfers, err := server.store.ListTransfers(ctx, db.ListTransfersParams{
+		FromAccountID: account.ID,
+		ToAccountID:   account.ID,
+		AfterID:       afterID,
+		Limit:         pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	transfers, nextPageToken := util.Paginate(transfers, pageSize, func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	return transfers, nextPageToken, nil
+}
+
+func validateListTransfersRequest(req *pb.ListTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,50 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ListUsers 只允许 admin 角色调用，按 username 排序分页返回用户列表。
+如果请求里带了 search，就用它对 username 和 email 做模糊匹配（ILIKE）。
+*/
+func (server *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListUsersParams{
+		Search: pgtype.Text{
+			String: req.GetSearch(),
+			Valid:  req.Search != nil,
+		},
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	}
+
+	users, err := server.store.ListUsers(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %s", err)
+	}
+
+	rsp := &pb.ListUsersResponse{
+		Users: make([]*pb.User, 0, len(users)),
+	}
+	for _, user := range users {
+		rsp.Users = append(rsp.Users, convertUser(user))
+	}
+	return rsp, nil
+}
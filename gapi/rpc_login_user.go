@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
 	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/worker"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,6 +21,9 @@ import (
 处理用户登录请求。它检查提供的用户名和密码，验证用户是否存在且密码是否正确。
 如果验证通过，方法将为用户创建新的访问令牌和刷新令牌，并创建一个新的会话记录。
 这个方法返回用户信息和令牌，以便客户端可以使用它们来进行后续的认证和授权。
+
+用户不存在和密码错误返回同一个 Unauthenticated 错误，用户不存在时也用一个假的哈希比较一次密码（util.CheckDummyPassword），
+两种情况的响应和耗时都一样，不能用来猜测哪些用户名已经注册。具体的原因只记在日志里。
 */
 func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
 
@@ -29,16 +35,125 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 	user, err := server.store.GetUser(ctx, req.Username)
 	if err != nil {
 		if errors.Is(err, db.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "user not found")
+			util.CheckDummyPassword(req.GetPassword())
+			LoggerFrom(ctx).Info().Str("login_username", req.GetUsername()).Msg("login failed: user not found")
+			server.recordFailedLogin(ctx, req.GetUsername(), util.FailedLoginUserNotFound)
+			return nil, invalidCredentialsError()
 		}
 		return nil, status.Errorf(codes.Internal, "failed to find user")
 	}
 
 	err = util.CheckPassword(req.Password, user.HashedPassword)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "incorrect password")
+		LoggerFrom(ctx).Info().Str("login_username", req.GetUsername()).Msg("login failed: incorrect password")
+		server.recordFailedLogin(ctx, req.GetUsername(), util.FailedLoginIncorrectPassword)
+		return nil, invalidCredentialsError()
+	}
+
+	if user.IsDisabled {
+		return nil, status.Errorf(codes.PermissionDenied, "user is disabled")
+	}
+
+	if user.DeletionScheduledAt.Valid {
+		return nil, accountDeletionPendingError(user.Username)
+	}
+
+	if user.PasswordResetRequired {
+		return nil, passwordResetRequiredError(user.Username)
+	}
+
+	server.rehashPassword(ctx, user, req.GetPassword())
+
+	userSession, err := server.createUserSession(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.LoginUserResponse{
+		User:                  convertUser(user),
+		SessionId:             userSession.session.ID.String(),
+		AccessToken:           userSession.accessToken,
+		RefreshToken:          userSession.refreshToken,
+		AccessTokenExpiresAt:  timestamppb.New(userSession.accessPayload.ExpiredAt),
+		RefreshTokenExpiresAt: timestamppb.New(userSession.refreshPayload.ExpiredAt),
+	}
+	return rsp, nil
+}
+
+// invalidCredentialsError 是用户不存在和密码错误共用的错误，和 Gin 版本的 loginUser 一样
+func invalidCredentialsError() error {
+	return status.Errorf(codes.Unauthenticated, "invalid username or password")
+}
+
+// recordFailedLogin 记下失败的登录给管理后台统计（ListDailyFailedLogins），失败只记日志，不影响登录的响应
+func (server *Server) recordFailedLogin(ctx context.Context, username string, reason string) {
+	_, err := server.store.CreateFailedLogin(ctx, db.CreateFailedLoginParams{
+		Username: username,
+		Reason:   reason,
+		ClientIp: server.extractMetadata(ctx).ClientIP,
+	})
+	if err != nil {
+		LoggerFrom(ctx).Error().Err(err).Msg("cannot record failed login")
+	}
+}
+
+// validateLoginUserRequest 和 Gin 版本的 loginUser 一样，密码只检查长度，不按密码规则检查
+func validateLoginUserRequest(req *pb.LoginUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidateLoginPassword(req.GetPassword()); err != nil {
+		violations = append(violations, fieldViolation("password", err))
+	}
+
+	return violations
+}
+
+/*
+rehashPassword 在登录成功之后检查密码哈希的算法和参数是不是当前配置的（PASSWORD_HASH_ALGORITHM 等），
+不是的话用刚验证过的明文密码重新生成哈希。只换哈希，不改 password_changed_at，已经签发的令牌不受影响；
+用 updated_at 做乐观锁，避免覆盖同时修改的新密码。失败只记日志，不影响登录。
+*/
+func (server *Server) rehashPassword(ctx context.Context, user db.User, password string) {
+	if !util.PasswordNeedsRehash(user.HashedPassword) {
+		return
+	}
+
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		LoggerFrom(ctx).Error().Err(err).Msg("cannot rehash password")
+		return
+	}
+
+	_, err = server.store.UpdateUser(ctx, db.UpdateUserParams{
+		Username: user.Username,
+		HashedPassword: pgtype.Text{
+			String: hashedPassword,
+			Valid:  true,
+		},
+		ExpectedUpdatedAt: pgtype.Timestamptz{
+			Time:  user.UpdatedAt,
+			Valid: true,
+		},
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		LoggerFrom(ctx).Error().Err(err).Msg("cannot save rehashed password")
 	}
+}
 
+// userSession 是登录成功之后签发的令牌和对应的会话
+type userSession struct {
+	session        db.Session
+	accessToken    string
+	accessPayload  *token.Payload
+	refreshToken   string
+	refreshPayload *token.Payload
+}
+
+// createUserSession 为用户签发 access token 和 refresh token，并创建会话。
+// 密码登录和第三方登录都用它，返回的错误已经是 gRPC status。
+func (server *Server) createUserSession(ctx context.Context, user db.User) (*userSession, error) {
 	accessToken, accessPayload, err := server.tokenMaker.CreateToken(
 		user.Username,
 		user.Role,
@@ -71,30 +186,20 @@ func (server *Server) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (
 		ClientIp:     mtdt.ClientIP,
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		Country:      mtdt.Country,
+		City:         mtdt.City,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create session")
 	}
 
-	rsp := &pb.LoginUserResponse{
-		User:                  convertUser(user),
-		SessionId:             session.ID.String(),
-		AccessToken:           accessToken,
-		RefreshToken:          refreshToken,
-		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
-		RefreshTokenExpiresAt: timestamppb.New(refreshPayload.ExpiredAt),
-	}
-	return rsp, nil
-}
+	worker.CheckNewDevice(ctx, server.store, server.taskDistributor, session)
 
-func validateLoginUserRequest(req *pb.LoginUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateUsername(req.GetUsername()); err != nil {
-		violations = append(violations, fieldViolation("username", err))
-	}
-
-	if err := val.ValidatePassword(req.GetPassword()); err != nil {
-		violations = append(violations, fieldViolation("password", err))
-	}
-
-	return violations
+	return &userSession{
+		session:        session,
+		accessToken:    accessToken,
+		accessPayload:  accessPayload,
+		refreshToken:   refreshToken,
+		refreshPayload: refreshPayload,
+	}, nil
 }
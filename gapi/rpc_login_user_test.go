@@ -0,0 +1,144 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginUserInvalidCredentials(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name       string
+		req        *pb.LoginUserRequest
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name: "UserNotFound",
+			req: &pb.LoginUserRequest{
+				Username: "not_found",
+				Password: password,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq("not_found")).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateFailedLogin(gomock.Any(), db.CreateFailedLoginParams{
+						Username: "not_found",
+						Reason:   util.FailedLoginUserNotFound,
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "IncorrectPassword",
+			req: &pb.LoginUserRequest{
+				Username: user.Username,
+				Password: "incorrect",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					CreateFailedLogin(gomock.Any(), db.CreateFailedLoginParams{
+						Username: user.Username,
+						Reason:   util.FailedLoginIncorrectPassword,
+					}).
+					Times(1)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+			server := newTestServer(t, store, nil)
+			res, err := server.LoginUser(context.Background(), tc.req)
+			require.Nil(t, res)
+
+			// 两种情况返回完全一样的错误
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, codes.Unauthenticated, st.Code())
+			require.Equal(t, status.Convert(invalidCredentialsError()).Message(), st.Message())
+		})
+	}
+}
+
+func TestLoginUserDeletionPending(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+	user.DeletionScheduledAt = pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	res, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	require.Nil(t, res)
+
+	// 等待删除的用户只能用邮件里的链接取消删除
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 1)
+	violations := st.Details()[0].(*errdetails.PreconditionFailure).GetViolations()
+	require.Equal(t, accountDeletionPendingViolation, violations[0].GetType())
+}
+
+func TestLoginUserPasswordResetRequired(t *testing.T) {
+	user, password := randomUser(t, util.DepositorRole)
+	user.PasswordResetRequired = true
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+	store.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	res, err := server.LoginUser(context.Background(), &pb.LoginUserRequest{
+		Username: user.Username,
+		Password: password,
+	})
+	require.Nil(t, res)
+
+	// 密码正确也不签发令牌，要先由 banker 设置新密码
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.FailedPrecondition, st.Code())
+	require.Len(t, st.Details(), 1)
+	violations := st.Details()[0].(*errdetails.PreconditionFailure).GetViolations()
+	require.Equal(t, passwordResetRequiredViolation, violations[0].GetType())
+}
@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+OpenDispute 由转出账户的所有者对一笔转账提出争议，争议由 banker 审核之后退款或者拒绝（见 db.ResolveDisputeTx）。
+一笔转账同时只能有一个没有处理完的争议，已经有了时返回 AlreadyExists；被拒绝之后可以再提一次。
+冲正转账不能提出争议，已经被冲正的转账也不用再提。
+*/
+func (server *Server) OpenDispute(ctx context.Context, req *pb.OpenDisputeRequest) (*pb.OpenDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	_, err = server.authorizeAccount(ctx, authPayload, transfer.FromAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transfer.ReversalOf.Valid {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrCannotReverseReversal)
+	}
+
+	_, err = server.store.GetTransferReversal(ctx, transfer.ID)
+	if err == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s", db.ErrTransferAlreadyReversed)
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer reversal: %s", err)
+	}
+
+	dispute, err := server.store.CreateDispute(ctx, db.CreateDisputeParams{
+		TransferID: transfer.ID,
+		OpenedBy:   authPayload.Username,
+		Reason:     req.GetReason(),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "transfer already has an unresolved dispute")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create dispute: %s", err)
+	}
+
+	server.distributeDisputeUpdate(ctx, dispute)
+
+	rsp := &pb.OpenDisputeResponse{
+		Dispute: convertDispute(dispute),
+	}
+	return rsp, nil
+}
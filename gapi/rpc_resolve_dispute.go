@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ResolveDispute 由 banker 处理一个 open 或 under_review 的争议：
+  - refund 为 true 时退款，和 ReverseTransfer 一样把钱从原来的转入账户转回去，并通知转账的双方；
+  - refund 为 false 时拒绝，争议被拒绝之后用户可以对同一笔转账再提一次。
+
+两种结果都会给用户发邮件并记录在 audit_logs 里。banker 不能处理自己提出的争议。
+*/
+func (server *Server) ResolveDispute(ctx context.Context, req *pb.ResolveDisputeRequest) (*pb.ResolveDisputeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	dispute, err := server.authorizeDispute(ctx, authPayload, req.GetDisputeId())
+	if err != nil {
+		return nil, err
+	}
+
+	if dispute.OpenedBy == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot resolve your own dispute")
+	}
+
+	disputeStatus := util.DisputeRejected
+	if req.GetRefund() {
+		disputeStatus = util.DisputeRefunded
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	txResult, err := server.store.ResolveDisputeTx(ctx, db.ResolveDisputeTxParams{
+		DisputeID:  dispute.ID,
+		Status:     disputeStatus,
+		Resolution: req.GetResolution(),
+		Actor:      authPayload.Username,
+		ClientIp:   mtdt.ClientIP,
+		Country:    mtdt.Country,
+		City:       mtdt.City,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidDisputeTransition) ||
+			errors.Is(err, db.ErrTransferAlreadyReversed) ||
+			errors.Is(err, db.ErrCannotReverseReversal) ||
+			errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to resolve dispute: %s", err)
+	}
+
+	server.distributeDisputeUpdate(ctx, txResult.Dispute)
+
+	rsp := &pb.ResolveDisputeResponse{
+		Dispute: convertDispute(txResult.Dispute),
+	}
+	if txResult.Refund != nil {
+		server.distributeTransferAlert(ctx, txResult.Refund.Reversal)
+		rsp.Refund = convertTransfer(txResult.Refund.Reversal.Transfer)
+	}
+	return rsp, nil
+}
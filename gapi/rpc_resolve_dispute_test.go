@@ -0,0 +1,228 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestResolveDisputeAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	depositor, _ := randomUser(t, util.DepositorRole)
+
+	original := db.Transfer{ID: 7, FromAccountID: 1, ToAccountID: 2, Amount: 50}
+	reversal := db.Transfer{
+		ID:            8,
+		FromAccountID: original.ToAccountID,
+		ToAccountID:   original.FromAccountID,
+		Amount:        original.Amount,
+		ReversalOf:    pgtype.Int8{Int64: original.ID, Valid: true},
+	}
+	dispute := db.Dispute{
+		ID:         3,
+		TransferID: original.ID,
+		OpenedBy:   depositor.Username,
+		Reason:     "charged twice",
+		Status:     util.DisputeUnderReview,
+	}
+	resolved := func(status string) db.Dispute {
+		result := dispute
+		result.Status = status
+		result.Resolution = "duplicate charge"
+		result.ResolvedBy = pgtype.Text{String: banker.Username, Valid: true}
+		result.ResolvedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		result.UpdatedAt = result.ResolvedAt.Time
+		if status == util.DisputeRefunded {
+			result.RefundTransferID = pgtype.Int8{Int64: reversal.ID, Valid: true}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ResolveDisputeRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ResolveDisputeResponse, err error)
+	}{
+		{
+			name: "Refund",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				refunded := resolved(util.DisputeRefunded)
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().
+					ResolveDisputeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ResolveDisputeTxParams) (db.ResolveDisputeTxResult, error) {
+						require.Equal(t, dispute.ID, arg.DisputeID)
+						require.Equal(t, util.DisputeRefunded, arg.Status)
+						require.Equal(t, "duplicate charge", arg.Resolution)
+						require.Equal(t, banker.Username, arg.Actor)
+						return db.ResolveDisputeTxResult{
+							Dispute: refunded,
+							Refund: &db.ReverseTransferTxResult{
+								Original: original,
+								Reversal: db.TransferTxResult{Transfer: reversal},
+							},
+						}, nil
+					})
+				taskDistributor.EXPECT().
+					DistributeTaskSendDisputeUpdate(gomock.Any(), gomock.Eq(&worker.PayloadSendDisputeUpdate{
+						DisputeID: dispute.ID,
+						Status:    util.DisputeRefunded,
+						UpdatedAt: refunded.UpdatedAt,
+					}), gomock.Any()).
+					Times(1)
+				taskDistributor.EXPECT().
+					DistributeTaskSendTransferAlert(gomock.Any(), gomock.Eq(&worker.PayloadSendTransferAlert{TransferID: reversal.ID}), gomock.Any()).
+					Times(1)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.DisputeRefunded, res.GetDispute().GetStatus())
+				require.Equal(t, reversal.ID, res.GetDispute().GetRefundTransferId())
+				require.Equal(t, banker.Username, res.GetDispute().GetResolvedBy())
+				require.NotNil(t, res.GetDispute().GetResolvedAt())
+				require.Equal(t, reversal.ID, res.GetRefund().GetId())
+				require.Equal(t, original.ID, res.GetRefund().GetReversalOf())
+			},
+		},
+		{
+			name: "Reject",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().
+					ResolveDisputeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ResolveDisputeTxParams) (db.ResolveDisputeTxResult, error) {
+						require.Equal(t, util.DisputeRejected, arg.Status)
+						return db.ResolveDisputeTxResult{Dispute: resolved(util.DisputeRejected)}, nil
+					})
+				taskDistributor.EXPECT().DistributeTaskSendDisputeUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+				taskDistributor.EXPECT().DistributeTaskSendTransferAlert(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.DisputeRejected, res.GetDispute().GetStatus())
+				require.Zero(t, res.GetDispute().GetRefundTransferId())
+				require.Nil(t, res.GetRefund())
+			},
+		},
+		{
+			name: "AlreadyResolved",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(dispute, nil)
+				store.EXPECT().
+					ResolveDisputeTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ResolveDisputeTxResult{}, fmt.Errorf("%w: rejected -> refunded", db.ErrInvalidDisputeTransition))
+				taskDistributor.EXPECT().DistributeTaskSendDisputeUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "OwnDispute",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				own := dispute
+				own.OpenedBy = banker.Username
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(own, nil)
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Eq(dispute.ID)).Times(1).Return(db.Dispute{}, db.ErrRecordNotFound)
+				store.EXPECT().ResolveDisputeTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "MissingResolution",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req:  &pb.ResolveDisputeRequest{DisputeId: dispute.ID, Refund: true, Resolution: "duplicate charge"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetDispute(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, depositor.Username, depositor.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ResolveDisputeResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ResolveDispute(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ReverseTransfer 由 banker 调用，给一笔转账退款：从原来的转入账户把同样的金额转回原来的转出账户。
+冲正转账的 reversal_of 指向原来的转账，同一笔转账只能冲正一次；原来的转入账户余额不够时不能冲正。
+冲正记录在 audit_logs 里，和普通转账一样通知双方（见 worker/task_send_transfer_alert.go）。
+*/
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	txResult, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID: req.GetTransferId(),
+		Reason:     req.GetReason(),
+		Actor:      authPayload.Username,
+		ClientIp:   mtdt.ClientIP,
+		Country:    mtdt.Country,
+		City:       mtdt.City,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		if errors.Is(err, db.ErrTransferAlreadyReversed) ||
+			errors.Is(err, db.ErrCannotReverseReversal) ||
+			errors.Is(err, db.ErrInsufficientBalance) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reverse transfer: %s", err)
+	}
+
+	server.distributeTransferAlert(ctx, txResult.Reversal)
+
+	rsp := &pb.ReverseTransferResponse{
+		Original: convertTransfer(txResult.Original),
+		Reversal: convertTransfer(txResult.Reversal.Transfer),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,91 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+ReviewKYCDocument 由 banker 批准或拒绝一份 pending 的 KYC 证件，拒绝时必须给出原因。
+用户的 KYC 等级由已经批准的证件计算出来（见 GetKYCLevel），不单独保存，
+所以批准之后新的转账限额（KYC_TRANSFER_LIMITS）马上生效。
+
+banker 不能审核自己的证件。
+*/
+func (server *Server) ReviewKYCDocument(ctx context.Context, req *pb.ReviewKYCDocumentRequest) (*pb.ReviewKYCDocumentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewKYCDocumentRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	document, err := server.store.GetKYCDocument(ctx, req.GetDocumentId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "KYC document not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get KYC document: %s", err)
+	}
+
+	if document.Username == authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot review your own KYC document")
+	}
+
+	arg := db.ReviewKYCDocumentParams{
+		Status:     util.KYCDocumentApproved,
+		ReviewedBy: pgtype.Text{String: authPayload.Username, Valid: true},
+		ID:         document.ID,
+	}
+	if !req.GetApprove() {
+		arg.Status = util.KYCDocumentRejected
+		arg.RejectionReason = req.GetRejectionReason()
+	}
+
+	document, err = server.store.ReviewKYCDocument(ctx, arg)
+	if err != nil {
+		// ReviewKYCDocument 只更新 pending 的证件
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "KYC document has already been reviewed")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to review KYC document: %s", err)
+	}
+
+	level, err := server.store.GetKYCLevel(ctx, document.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get KYC level: %s", err)
+	}
+
+	rsp := &pb.ReviewKYCDocumentResponse{
+		Document: convertKYCDocument(document),
+		KycLevel: level,
+	}
+	return rsp, nil
+}
+
+func validateReviewKYCDocumentRequest(req *pb.ReviewKYCDocumentRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if !req.GetApprove() {
+		if err := val.ValidateRejectionReason(req.GetRejectionReason()); err != nil {
+			violations = append(violations, fieldViolation("rejection_reason", err))
+		}
+	} else if req.GetRejectionReason() != "" {
+		violations = append(violations, fieldViolation("rejection_reason", fmt.Errorf("must be empty when the document is approved")))
+	}
+
+	return violations
+}
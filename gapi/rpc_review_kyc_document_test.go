@@ -0,0 +1,188 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReviewKYCDocumentAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	user, _ := randomUser(t, util.DepositorRole)
+
+	document := db.KycDocument{
+		ID:           7,
+		Username:     user.Username,
+		DocumentType: util.KYCPassport,
+		FileKey:      "kyc/" + user.Username + "/passport.png",
+		ContentType:  "image/png",
+		SizeBytes:    100,
+		Status:       util.KYCDocumentPending,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReviewKYCDocumentRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error)
+	}{
+		{
+			name: "Approve",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Eq(document.ID)).Times(1).Return(document, nil)
+
+				approved := document
+				approved.Status = util.KYCDocumentApproved
+				store.EXPECT().
+					ReviewKYCDocument(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ReviewKYCDocumentParams) (db.KycDocument, error) {
+						require.Equal(t, util.KYCDocumentApproved, arg.Status)
+						require.Empty(t, arg.RejectionReason)
+						require.Equal(t, banker.Username, arg.ReviewedBy.String)
+						require.Equal(t, document.ID, arg.ID)
+						return approved, nil
+					})
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(util.KYCLevelIdentity, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KYCDocumentApproved, res.GetDocument().GetStatus())
+				require.Equal(t, util.KYCLevelIdentity, res.GetKycLevel())
+			},
+		},
+		{
+			name: "Reject",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, RejectionReason: "photo is blurry"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Eq(document.ID)).Times(1).Return(document, nil)
+
+				arg := db.ReviewKYCDocumentParams{
+					Status:          util.KYCDocumentRejected,
+					RejectionReason: "photo is blurry",
+					ReviewedBy:      pgtype.Text{String: banker.Username, Valid: true},
+					ID:              document.ID,
+				}
+				rejected := document
+				rejected.Status = util.KYCDocumentRejected
+				rejected.RejectionReason = arg.RejectionReason
+				store.EXPECT().ReviewKYCDocument(gomock.Any(), gomock.Eq(arg)).Times(1).Return(rejected, nil)
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(util.KYCLevelNone, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KYCDocumentRejected, res.GetDocument().GetStatus())
+				require.Equal(t, "photo is blurry", res.GetDocument().GetRejectionReason())
+				require.Equal(t, util.KYCLevelNone, res.GetKycLevel())
+			},
+		},
+		{
+			name: "RejectWithoutReason",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "AlreadyReviewed",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Eq(document.ID)).Times(1).Return(document, nil)
+				store.EXPECT().
+					ReviewKYCDocument(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.KycDocument{}, db.ErrRecordNotFound)
+				store.EXPECT().GetKYCLevel(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "OwnDocument",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				own := document
+				own.Username = banker.Username
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Eq(document.ID)).Times(1).Return(own, nil)
+				store.EXPECT().ReviewKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Eq(document.ID)).Times(1).Return(db.KycDocument{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "DepositorCannotReview",
+			req:  &pb.ReviewKYCDocumentRequest{DocumentId: document.ID, Approve: true},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReviewKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ReviewKYCDocument(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
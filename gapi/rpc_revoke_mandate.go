@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeMandate 由付款方调用，撤销之后收款方就不能再通过这个 mandate 扣款了。
+func (server *Server) RevokeMandate(ctx context.Context, req *pb.RevokeMandateRequest) (*pb.RevokeMandateResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	mandate, err := server.store.GetMandate(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "mandate not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get mandate: %s", err)
+	}
+
+	payerAccount, err := server.store.GetAccount(ctx, mandate.PayerAccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get payer account: %s", err)
+	}
+
+	if payerAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "mandate doesn't belong to the authenticated user")
+	}
+
+	mandate, err = server.store.RevokeMandate(ctx, mandate.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "mandate has already been revoked")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke mandate: %s", err)
+	}
+
+	rsp := &pb.RevokeMandateResponse{
+		Mandate: convertMandate(mandate),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+RevokeSession 让用户把某一台设备踢下线。
+会话被封禁之后，这个会话的 refresh token 就不能再换新的 access token 了；
+会话 ID 就是 refresh token 的 ID，所以顺便把它加进撤销列表，一直保留到会话过期。
+*/
+func (server *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	sessionID := uuid.MustParse(req.GetSessionId())
+
+	session, err := server.store.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	// 别人的会话也当作不存在，免得泄露会话 ID 是否有效
+	if session.Username != authPayload.Username {
+		return nil, status.Errorf(codes.NotFound, "session not found")
+	}
+
+	session, err = server.store.BlockSession(ctx, session.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "session has already been revoked")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %s", err)
+	}
+
+	err = server.revocationStore.Revoke(ctx, session.ID, time.Until(session.ExpiresAt))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke refresh token: %s", err)
+	}
+
+	rsp := &pb.RevokeSessionResponse{
+		Session: convertSession(session),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,40 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeToken 让管理员在令牌过期之前撤销它，比如令牌泄露的时候。
+// 管理员只知道令牌 ID，不知道具体的过期时间，所以按照令牌最长的有效期来保存撤销记录。
+func (server *Server) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	tokenID := uuid.MustParse(req.GetTokenId())
+
+	ttl := server.config.AccessTokenDuration
+	if server.config.RefreshTokenDuration > ttl {
+		ttl = server.config.RefreshTokenDuration
+	}
+
+	err = server.revocationStore.Revoke(ctx, tokenID, ttl)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke token: %s", err)
+	}
+
+	LoggerFrom(ctx).Info().Str("admin", authPayload.Username).Str("token_id", tokenID.String()).Msg("revoked token")
+	return &pb.RevokeTokenResponse{}, nil
+}
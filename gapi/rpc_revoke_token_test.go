@@ -0,0 +1,95 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeTokenAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	server := newTestServer(t, nil, nil)
+	adminCtx := newContextWithBearerToken(t, server.tokenMaker, admin.Username, admin.Role, time.Minute)
+
+	accessToken, payload, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+	userCtx := newContextWithAccessToken(accessToken)
+
+	_, err = server.authorizeUser(userCtx, []string{util.DepositorRole})
+	require.NoError(t, err)
+
+	_, err = server.RevokeToken(adminCtx, &pb.RevokeTokenRequest{TokenId: payload.ID.String()})
+	require.NoError(t, err)
+
+	// 撤销之后同一个令牌不能再用，其他令牌不受影响
+	_, err = server.authorizeUser(userCtx, []string{util.DepositorRole})
+	require.ErrorContains(t, err, token.ErrRevokedToken.Error())
+
+	otherCtx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err = server.authorizeUser(otherCtx, []string{util.DepositorRole})
+	require.NoError(t, err)
+}
+
+func TestRevokeTokenAPIErrors(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	server := newTestServer(t, nil, nil)
+
+	testCases := []struct {
+		name         string
+		req          *pb.RevokeTokenRequest
+		buildContext func(t *testing.T, tokenMaker token.Maker) context.Context
+		code         codes.Code
+	}{
+		{
+			name: "DepositorCannotRevokeToken",
+			req:  &pb.RevokeTokenRequest{TokenId: "4e1a2b1c-2f7e-4f3b-9c39-6b8f1f0c1d2e"},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "InvalidTokenID",
+			req:  &pb.RevokeTokenRequest{TokenId: "not-a-uuid"},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := tc.buildContext(t, server.tokenMaker)
+			_, err := server.RevokeToken(ctx, tc.req)
+			require.Error(t, err)
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tc.code, st.Code())
+		})
+	}
+}
+
+func newContextWithAccessToken(accessToken string) context.Context {
+	md := metadata.MD{
+		authorizationHeader: []string{
+			fmt.Sprintf("%s %s", authorizationBearer, accessToken),
+		},
+	}
+	return metadata.NewIncomingContext(context.Background(), md)
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchAccounts 和 SearchUsers 类似，只是按账户所有者的信息做模糊匹配，返回账户以及所有者的姓名和邮箱。
+func (server *Server) SearchAccounts(ctx context.Context, req *pb.SearchAccountsRequest) (*pb.SearchAccountsResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole, util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.SearchAccounts(ctx, db.SearchAccountsParams{
+		Query:  req.GetQuery(),
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search accounts: %s", err)
+	}
+
+	rsp := &pb.SearchAccountsResponse{
+		Results: make([]*pb.AccountSearchResult, 0, len(rows)),
+	}
+	for _, row := range rows {
+		account := db.Account{
+			ID:        row.ID,
+			Owner:     row.Owner,
+			Balance:   row.Balance,
+			Currency:  row.Currency,
+			CreatedAt: row.CreatedAt,
+		}
+		rsp.Results = append(rsp.Results, &pb.AccountSearchResult{
+			Account:       convertAccount(account),
+			OwnerFullName: row.FullName,
+			OwnerEmail:    row.Email,
+			Rank:          row.Rank,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+SearchUsers 让 banker 通过部分用户名、全名或者邮箱查找客户。
+数据库里用 pg_trgm 的 trigram 索引做模糊匹配，结果按相似度（rank）从高到低排序并分页返回。
+*/
+func (server *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.BankerRole, util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rows, err := server.store.SearchUsers(ctx, db.SearchUsersParams{
+		Query:  req.GetQuery(),
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %s", err)
+	}
+
+	rsp := &pb.SearchUsersResponse{
+		Results: make([]*pb.UserSearchResult, 0, len(rows)),
+	}
+	for _, row := range rows {
+		user := db.User{
+			Username:              row.Username,
+			HashedPassword:        row.HashedPassword,
+			FullName:              row.FullName,
+			Email:                 row.Email,
+			PasswordChangedAt:     row.PasswordChangedAt,
+			CreatedAt:             row.CreatedAt,
+			Role:                  row.Role,
+			IsEmailVerified:       row.IsEmailVerified,
+			IsDisabled:            row.IsDisabled,
+			PasswordResetRequired: row.PasswordResetRequired,
+			UpdatedAt:             row.UpdatedAt,
+		}
+		rsp.Results = append(rsp.Results, &pb.UserSearchResult{
+			User: convertUser(user),
+			Rank: row.Rank,
+		})
+	}
+	return rsp, nil
+}
@@ -0,0 +1,127 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchUsersAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.SearchUsersRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SearchUsersResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SearchUsersRequest{
+				Query:    user.Username[:4],
+				PageId:   2,
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchUsersParams{
+					Query:  user.Username[:4],
+					Limit:  5,
+					Offset: 5,
+				}
+				row := db.SearchUsersRow{
+					Username: user.Username,
+					FullName: user.FullName,
+					Email:    user.Email,
+					Role:     user.Role,
+					Rank:     0.5,
+				}
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.SearchUsersRow{row}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetResults(), 1)
+				require.Equal(t, user.Username, res.GetResults()[0].GetUser().GetUsername())
+				require.Equal(t, float32(0.5), res.GetResults()[0].GetRank())
+			},
+		},
+		{
+			name: "DepositorCannotSearch",
+			req: &pb.SearchUsersRequest{
+				Query:    user.Username[:4],
+				PageId:   1,
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidQuery",
+			req: &pb.SearchUsersRequest{
+				Query:    "a",
+				PageId:   1,
+				PageSize: 5,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					SearchUsers(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SearchUsersResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SearchUsers(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/seed"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+SeedData 让前端开发在本地环境里一键生成演示数据，和 bank seed 命令做的事情一样（见 seed 包）。
+只在开发环境（ENVIRONMENT=development）里可用，其他环境返回 PermissionDenied，staging 用 bank seed 命令。
+*/
+func (server *Server) SeedData(ctx context.Context, req *pb.SeedDataRequest) (*pb.SeedDataResponse, error) {
+	_, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if !server.config.DebugEnabled() {
+		return nil, status.Errorf(codes.PermissionDenied, "seeding data is only available in the %s environment", util.DevelopmentEnvironment)
+	}
+
+	if violations := validateSeedDataRequest(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := seed.Run(ctx, server.store, seed.Options{
+		Users:           int(req.GetUsers()),
+		AccountsPerUser: int(req.GetAccountsPerUser()),
+		Transfers:       int(req.GetTransfers()),
+		Days:            int(req.GetDays()),
+		Password:        req.GetPassword(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to seed data: %s", err)
+	}
+
+	rsp := &pb.SeedDataResponse{
+		Users:     int32(result.Users),
+		Accounts:  int32(result.Accounts),
+		Transfers: int32(result.Transfers),
+	}
+	return rsp, nil
+}
+
+func validateSeedDataRequest(req *pb.SeedDataRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if violations = validateProto(req); violations != nil {
+		return violations
+	}
+
+	// 每个用户每种货币只能有一个账户
+	if n := len(util.SupportedCurrencies()); int(req.GetAccountsPerUser()) > n {
+		violations = append(violations, fieldViolation("accounts_per_user", fmt.Errorf("must not exceed the %d supported currencies", n)))
+	}
+
+	return violations
+}
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+SetDefaultAccount 把账户设为所有者在这种货币下的默认账户，别人按用户名或邮箱转账时转到这个账户。
+原来的默认账户在同一个事务里被取消（见 db.SetDefaultAccountTx），只有账户的所有者和 banker 可以设置。
+*/
+func (server *Server) SetDefaultAccount(ctx context.Context, req *pb.SetDefaultAccountRequest) (*pb.SetDefaultAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.SetDefaultAccountTx(ctx, db.SetDefaultAccountTxParams{
+		AccountID: req.GetAccountId(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set default account: %s", err)
+	}
+
+	rsp := &pb.SetDefaultAccountResponse{
+		Account: convertAccount(result.Account),
+	}
+	return rsp, nil
+}
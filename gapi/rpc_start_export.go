@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+StartExport 把账户的全部转账或者分录导出成 CSV 文件。
+分录还可以用 format 导出成 OFX、QIF 或者 camt.053 的对账单，导入到记账软件里。
+导出可能要很久，所以这里只创建 export_jobs 记录并分发 worker 任务（见 worker/task_generate_export.go），
+马上返回状态为 pending 的任务，客户端用 GetExportStatus 查询进度，完成后拿到下载链接。
+账户的所有者和 banker 可以导出，导出任务属于发起导出的用户。
+*/
+func (server *Server) StartExport(ctx context.Context, req *pb.StartExportRequest) (*pb.StartExportResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateStartExportRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if server.blobStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "exports are not enabled")
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := server.store.CreateExportJob(ctx, db.CreateExportJobParams{
+		Owner:     authPayload.Username,
+		AccountID: account.ID,
+		Kind:      req.GetKind(),
+		Format:    exportFormat(req),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create export job: %s", err)
+	}
+
+	taskPayload := &worker.PayloadGenerateExport{
+		ExportJobID: job.ID,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Timeout(30 * time.Minute),
+	}
+	err = server.taskDistributor.DistributeTaskGenerateExport(ctx, taskPayload, opts...)
+	if err != nil {
+		// 任务没有分发出去就不会有人处理，标记为失败，否则客户端会一直看到 pending
+		_, updateErr := server.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+			ID:     job.ID,
+			Status: pgtype.Text{String: util.ExportFailed, Valid: true},
+			Error:  pgtype.Text{String: "failed to schedule export", Valid: true},
+		})
+		if updateErr != nil {
+			LoggerFrom(ctx).Error().Err(updateErr).Int64("export_job_id", job.ID).Msg("failed to mark export job as failed")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to distribute export task: %s", err)
+	}
+
+	rsp := &pb.StartExportResponse{
+		ExportJob: convertExportJob(job),
+	}
+	return rsp, nil
+}
+
+func validateStartExportRequest(req *pb.StartExportRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateExportKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	format := exportFormat(req)
+	if err := val.ValidateExportFormat(format); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	} else if format != util.ExportCSV && req.GetKind() != util.ExportEntries {
+		violations = append(violations, fieldViolation("format", fmt.Errorf("must be %s when kind is %s", util.ExportCSV, req.GetKind())))
+	}
+
+	return violations
+}
+
+// exportFormat 返回导出文件的格式，没有指定时是 CSV
+func exportFormat(req *pb.StartExportRequest) string {
+	if req.GetFormat() == "" {
+		return util.ExportCSV
+	}
+	return req.GetFormat()
+}
@@ -0,0 +1,259 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+)
+
+func TestStartExportAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Currency: util.USD}
+	job := db.ExportJob{
+		ID:        util.RandomInt(1, 1000),
+		Owner:     user.Username,
+		AccountID: account.ID,
+		Kind:      util.ExportTransfers,
+		Status:    util.ExportPending,
+		CreatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.StartExportRequest
+		noBlobStore   bool
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.StartExportResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateExportJob(gomock.Any(), gomock.Eq(db.CreateExportJobParams{
+						Owner:     user.Username,
+						AccountID: account.ID,
+						Kind:      util.ExportTransfers,
+						Format:    util.ExportCSV,
+					})).
+					Times(1).
+					Return(job, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskGenerateExport(gomock.Any(), gomock.Eq(&worker.PayloadGenerateExport{ExportJobID: job.ID}), gomock.Any()).
+					Times(1).
+					Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, job.ID, res.GetExportJob().GetId())
+				require.Equal(t, util.ExportPending, res.GetExportJob().GetStatus())
+				require.Nil(t, res.GetExportJob().GetCompletedAt())
+			},
+		},
+		{
+			name: "BankerExportsOtherAccount",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportEntries},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				// 导出任务属于发起导出的 banker
+				store.EXPECT().
+					CreateExportJob(gomock.Any(), gomock.Eq(db.CreateExportJobParams{
+						Owner:     banker.Username,
+						AccountID: account.ID,
+						Kind:      util.ExportEntries,
+						Format:    util.ExportCSV,
+					})).
+					Times(1).
+					Return(job, nil)
+				taskDistributor.EXPECT().DistributeTaskGenerateExport(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+				taskDistributor.EXPECT().DistributeTaskGenerateExport(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "StatementFormat",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportEntries, Format: util.ExportCAMT053},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					CreateExportJob(gomock.Any(), gomock.Eq(db.CreateExportJobParams{
+						Owner:     user.Username,
+						AccountID: account.ID,
+						Kind:      util.ExportEntries,
+						Format:    util.ExportCAMT053,
+					})).
+					Times(1).
+					Return(db.ExportJob{ID: job.ID, Kind: util.ExportEntries, Format: util.ExportCAMT053}, nil)
+				taskDistributor.EXPECT().DistributeTaskGenerateExport(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.ExportCAMT053, res.GetExportJob().GetFormat())
+			},
+		},
+		{
+			name: "StatementFormatForTransfers",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers, Format: util.ExportOFX},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidFormat",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportEntries, Format: "xlsx"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidKind",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: "cards"},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:        "NoBlobStore",
+			req:         &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers},
+			noBlobStore: true,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "DistributeError",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(1).Return(job, nil)
+				taskDistributor.EXPECT().
+					DistributeTaskGenerateExport(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(fmt.Errorf("redis is down"))
+				store.EXPECT().
+					UpdateExportJob(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.UpdateExportJobParams) (db.ExportJob, error) {
+						require.Equal(t, job.ID, arg.ID)
+						require.Equal(t, util.ExportFailed, arg.Status.String)
+						return job, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.StartExportRequest{AccountId: account.ID, Kind: util.ExportTransfers},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().CreateExportJob(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.StartExportResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+			if !tc.noBlobStore {
+				blobStore, err := storage.NewLocalStore(t.TempDir())
+				require.NoError(t, err)
+				server.blobStore = blobStore
+			}
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.StartExport(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+TransferAccountOwnership 由 banker 或管理员把账户转给另一个用户，比如处理遗产或者公司合并。
+账户的余额、流水和卡都跟着账户走，只有 owner 变了。
+转移记录在 audit_logs 里（谁、从哪个 IP、因为什么），并在同一个事务里给双方各分发一封通知邮件。
+
+authorizeAccount 用的是 CachedStore 里缓存的账户，CachedStore.TransferAccountOwnershipTx 会把它删掉，
+所以原来的所有者已经登录的会话马上就不能再访问这个账户了。
+*/
+func (server *Server) TransferAccountOwnership(ctx context.Context, req *pb.TransferAccountOwnershipRequest) (*pb.TransferAccountOwnershipResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// GetUser 查不到已经删除的用户
+	newOwner, err := server.store.GetUser(ctx, req.GetNewOwner())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "new owner not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get new owner: %s", err)
+	}
+	if newOwner.IsDisabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "new owner is disabled")
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	txResult, err := server.store.TransferAccountOwnershipTx(ctx, db.TransferAccountOwnershipTxParams{
+		AccountID: req.GetAccountId(),
+		NewOwner:  newOwner.Username,
+		Reason:    req.GetReason(),
+		Actor:     authPayload.Username,
+		ClientIp:  mtdt.ClientIP,
+		Country:   mtdt.Country,
+		City:      mtdt.City,
+		AfterTransfer: func(result db.TransferAccountOwnershipTxResult) error {
+			opts := []asynq.Option{
+				asynq.MaxRetry(10),
+				asynq.ProcessIn(10 * time.Second),
+				asynq.Queue(worker.QueueDefault),
+			}
+			for _, username := range []string{result.PreviousOwner, result.Account.Owner} {
+				taskPayload := &worker.PayloadSendAccountOwnershipNotice{
+					Username:      username,
+					AccountID:     result.Account.ID,
+					Currency:      result.Account.Currency,
+					PreviousOwner: result.PreviousOwner,
+					NewOwner:      result.Account.Owner,
+					TransferredAt: result.AuditLog.CreatedAt,
+				}
+				err := server.taskDistributor.DistributeTaskSendAccountOwnershipNotice(ctx, taskPayload, opts...)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		if errors.Is(err, db.ErrSameAccountOwner) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		// accounts 上 (owner, currency) 是唯一的
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.FailedPrecondition, "new owner already has an account in this currency")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer account ownership: %s", err)
+	}
+
+	rsp := &pb.TransferAccountOwnershipResponse{
+		Account:       convertAccount(txResult.Account),
+		PreviousOwner: txResult.PreviousOwner,
+	}
+	return rsp, nil
+}
@@ -0,0 +1,234 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/worker"
+	mockwk "github.com/techschool/bank/worker/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransferAccountOwnershipAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	previousOwner, _ := randomUser(t, util.DepositorRole)
+	newOwner, _ := randomUser(t, util.DepositorRole)
+
+	account := db.Account{ID: 7, Owner: previousOwner.Username, Balance: 100, Currency: util.USD}
+	transferred := account
+	transferred.Owner = newOwner.Username
+
+	req := &pb.TransferAccountOwnershipRequest{
+		AccountId: account.ID,
+		NewOwner:  newOwner.Username,
+		Reason:    "estate settlement",
+	}
+
+	// 模拟事务：调用 AfterTransfer，回调失败时和真正的事务一样返回错误
+	runTx := func(ctx context.Context, arg db.TransferAccountOwnershipTxParams) (db.TransferAccountOwnershipTxResult, error) {
+		result := db.TransferAccountOwnershipTxResult{
+			Account:       transferred,
+			PreviousOwner: previousOwner.Username,
+			AuditLog:      db.AuditLog{ID: 1, Actor: arg.Actor, CreatedAt: time.Now()},
+		}
+		if err := arg.AfterTransfer(result); err != nil {
+			return db.TransferAccountOwnershipTxResult{}, err
+		}
+		return result, nil
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.TransferAccountOwnershipRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(newOwner.Username)).Times(1).Return(newOwner, nil)
+				store.EXPECT().
+					TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.TransferAccountOwnershipTxParams) (db.TransferAccountOwnershipTxResult, error) {
+						require.Equal(t, account.ID, arg.AccountID)
+						require.Equal(t, newOwner.Username, arg.NewOwner)
+						require.Equal(t, banker.Username, arg.Actor)
+						require.Equal(t, req.Reason, arg.Reason)
+						return runTx(ctx, arg)
+					})
+
+				// 双方各收到一封邮件
+				for _, username := range []string{previousOwner.Username, newOwner.Username} {
+					username := username
+					taskDistributor.EXPECT().
+						DistributeTaskSendAccountOwnershipNotice(gomock.Any(), gomock.Any(), gomock.Any()).
+						Times(1).
+						DoAndReturn(func(ctx context.Context, payload *worker.PayloadSendAccountOwnershipNotice, opts ...asynq.Option) error {
+							require.Equal(t, username, payload.Username)
+							return nil
+						})
+				}
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, newOwner.Username, res.GetAccount().GetOwner())
+				require.Equal(t, previousOwner.Username, res.GetPreviousOwner())
+			},
+		},
+		{
+			name: "Admin",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(newOwner, nil)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(runTx)
+				taskDistributor.EXPECT().DistributeTaskSendAccountOwnershipNotice(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, "root", util.AdminRole, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "DepositorNotAllowed",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, previousOwner.Username, previousOwner.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.TransferAccountOwnershipRequest{AccountId: account.ID, NewOwner: newOwner.Username},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NewOwnerNotFound",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "NewOwnerDisabled",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				disabled := newOwner
+				disabled.IsDisabled = true
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(disabled, nil)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(newOwner, nil)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferAccountOwnershipTxResult{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name: "SameOwner",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(newOwner, nil)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferAccountOwnershipTxResult{}, db.ErrSameAccountOwner)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+		{
+			name: "NewOwnerHasCurrency",
+			req:  req,
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(newOwner, nil)
+				store.EXPECT().TransferAccountOwnershipTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferAccountOwnershipTxResult{}, &pgconn.PgError{Code: db.UniqueViolation})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferAccountOwnershipResponse, err error) {
+				require.Equal(t, codes.FailedPrecondition, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+			server := newTestServer(t, store, taskDistributor)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.TransferAccountOwnership(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
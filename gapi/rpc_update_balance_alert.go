@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateBalanceAlert 修改余额提醒的阈值，提醒的种类不能改，要换种类就删掉再创建
+func (server *Server) UpdateBalanceAlert(ctx context.Context, req *pb.UpdateBalanceAlertRequest) (*pb.UpdateBalanceAlertResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	alert, err := server.authorizeBalanceAlert(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	alert, err = server.store.UpdateBalanceAlert(ctx, db.UpdateBalanceAlertParams{
+		ID:        alert.ID,
+		Threshold: req.GetThreshold(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "balance alert not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update balance alert: %s", err)
+	}
+
+	rsp := &pb.UpdateBalanceAlertResponse{
+		BalanceAlert: convertBalanceAlert(alert),
+	}
+	return rsp, nil
+}
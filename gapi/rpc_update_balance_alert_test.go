@@ -0,0 +1,137 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateBalanceAlertAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Currency: util.USD}
+	alert := db.BalanceAlert{
+		ID:        util.RandomInt(1, 1000),
+		AccountID: account.ID,
+		Kind:      util.BalanceAlertLargeDebit,
+		Threshold: 5000,
+	}
+	updated := alert
+	updated.Threshold = 20000
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateBalanceAlertRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UpdateBalanceAlertRequest{Id: alert.ID, Threshold: updated.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBalanceAlert(gomock.Any(), gomock.Eq(alert.ID)).Times(1).Return(alert, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().
+					UpdateBalanceAlert(gomock.Any(), gomock.Eq(db.UpdateBalanceAlertParams{
+						ID:        alert.ID,
+						Threshold: updated.Threshold,
+					})).
+					Times(1).
+					Return(updated, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, updated.Threshold, res.GetBalanceAlert().GetThreshold())
+			},
+		},
+		{
+			name: "BankerUpdatesOtherAccount",
+			req:  &pb.UpdateBalanceAlertRequest{Id: alert.ID, Threshold: updated.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBalanceAlert(gomock.Any(), gomock.Eq(alert.ID)).Times(1).Return(alert, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateBalanceAlert(gomock.Any(), gomock.Any()).Times(1).Return(updated, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PermissionDenied",
+			req:  &pb.UpdateBalanceAlertRequest{Id: alert.ID, Threshold: updated.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBalanceAlert(gomock.Any(), gomock.Eq(alert.ID)).Times(1).Return(alert, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, other.Username, other.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.UpdateBalanceAlertRequest{Id: alert.ID, Threshold: updated.Threshold},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBalanceAlert(gomock.Any(), gomock.Eq(alert.ID)).Times(1).Return(db.BalanceAlert{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidThreshold",
+			req:  &pb.UpdateBalanceAlertRequest{Id: alert.ID, Threshold: -1},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBalanceAlert(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateBalanceAlertResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateBalanceAlert(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
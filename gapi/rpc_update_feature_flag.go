@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+UpdateFeatureFlag 由管理员调用，创建或者整体替换一个功能开关，并记录是谁改的。
+当前实例的缓存马上失效，其他实例在 FEATURE_FLAG_CACHE_TTL 之内生效（见 flags 包）。
+*/
+func (server *Server) UpdateFeatureFlag(ctx context.Context, req *pb.UpdateFeatureFlagRequest) (*pb.UpdateFeatureFlagResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.AdminRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	flag, err := server.store.UpsertFeatureFlag(ctx, db.UpsertFeatureFlagParams{
+		Name:              req.GetName(),
+		Enabled:           req.GetEnabled(),
+		RolloutPercentage: req.GetRolloutPercentage(),
+		Description:       req.GetDescription(),
+		UpdatedBy:         authPayload.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update feature flag: %s", err)
+	}
+	server.featureFlags.Invalidate()
+
+	rsp := &pb.UpdateFeatureFlagResponse{
+		Flag: convertFeatureFlag(flag),
+	}
+	return rsp, nil
+}
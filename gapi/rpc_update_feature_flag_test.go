@@ -0,0 +1,177 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/flags"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateFeatureFlagAPI(t *testing.T) {
+	banker, _ := randomUser(t, util.BankerRole)
+	admin, _ := randomUser(t, util.AdminRole)
+
+	flag := db.FeatureFlag{
+		Name:              flags.MultiCurrencyTransfers,
+		Enabled:           true,
+		RolloutPercentage: 10,
+		Description:       "transfers between accounts of different currencies",
+		UpdatedBy:         admin.Username,
+		UpdatedAt:         time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateFeatureFlagRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.UpdateFeatureFlagRequest{
+				Name:              flag.Name,
+				Enabled:           flag.Enabled,
+				RolloutPercentage: flag.RolloutPercentage,
+				Description:       flag.Description,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpsertFeatureFlagParams{
+					Name:              flag.Name,
+					Enabled:           flag.Enabled,
+					RolloutPercentage: flag.RolloutPercentage,
+					Description:       flag.Description,
+					UpdatedBy:         admin.Username,
+				}
+				store.EXPECT().
+					UpsertFeatureFlag(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(flag, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, flag.Name, res.GetFlag().GetName())
+				require.True(t, res.GetFlag().GetEnabled())
+				require.Equal(t, flag.RolloutPercentage, res.GetFlag().GetRolloutPercentage())
+				require.Equal(t, admin.Username, res.GetFlag().GetUpdatedBy())
+			},
+		},
+		{
+			name: "BankerCannotUpdateFlag",
+			req: &pb.UpdateFeatureFlagRequest{
+				Name:    flag.Name,
+				Enabled: true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertFeatureFlag(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+		{
+			name: "InvalidName",
+			req: &pb.UpdateFeatureFlagRequest{
+				Name:    "Multi-Currency",
+				Enabled: true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertFeatureFlag(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidRolloutPercentage",
+			req: &pb.UpdateFeatureFlagRequest{
+				Name:              flag.Name,
+				Enabled:           true,
+				RolloutPercentage: 101,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertFeatureFlag(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req: &pb.UpdateFeatureFlagRequest{
+				Name:              flag.Name,
+				RolloutPercentage: 100,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpsertFeatureFlag(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.FeatureFlag{}, errors.New("connection refused"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateFeatureFlagResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateFeatureFlag(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
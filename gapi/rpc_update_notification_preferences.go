@@ -0,0 +1,93 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateNotificationPreferences 修改当前登录用户接收提醒的渠道，没有传的字段保持不变。
+// 开启短信必须有手机号，开启推送必须有设备的 token。
+func (server *Server) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.UpdateNotificationPreferencesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	preferences, err := server.getNotificationPreferences(ctx, authPayload.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpsertNotificationPreferencesParams{
+		Username:     authPayload.Username,
+		EmailEnabled: preferences.EmailEnabled,
+		SmsEnabled:   preferences.SmsEnabled,
+		PushEnabled:  preferences.PushEnabled,
+		PhoneNumber:  preferences.PhoneNumber,
+		DeviceToken:  preferences.DeviceToken,
+	}
+	if req.EmailEnabled != nil {
+		arg.EmailEnabled = req.GetEmailEnabled()
+	}
+	if req.SmsEnabled != nil {
+		arg.SmsEnabled = req.GetSmsEnabled()
+	}
+	if req.PushEnabled != nil {
+		arg.PushEnabled = req.GetPushEnabled()
+	}
+	if req.PhoneNumber != nil {
+		arg.PhoneNumber = req.GetPhoneNumber()
+	}
+	if req.DeviceToken != nil {
+		arg.DeviceToken = req.GetDeviceToken()
+	}
+
+	if arg.SmsEnabled && arg.PhoneNumber == "" {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("sms_enabled", fmt.Errorf("requires a phone number")),
+		})
+	}
+	if arg.PushEnabled && arg.DeviceToken == "" {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("push_enabled", fmt.Errorf("requires a device token")),
+		})
+	}
+
+	preferences, err = server.store.UpsertNotificationPreferences(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %s", err)
+	}
+
+	rsp := &pb.UpdateNotificationPreferencesResponse{
+		Preferences: convertNotificationPreferences(preferences),
+	}
+	return rsp, nil
+}
+
+// getNotificationPreferences 读取用户的通知偏好，没有记录的时候返回默认值
+func (server *Server) getNotificationPreferences(ctx context.Context, username string) (db.NotificationPreference, error) {
+	preferences, err := server.store.GetNotificationPreferences(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return db.NotificationPreference{
+				Username:     username,
+				EmailEnabled: true,
+			}, nil
+		}
+		return preferences, status.Errorf(codes.Internal, "failed to get notification preferences: %s", err)
+	}
+	return preferences, nil
+}
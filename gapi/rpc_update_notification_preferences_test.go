@@ -0,0 +1,183 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateNotificationPreferencesAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	phoneNumber := "+14155552671"
+	invalidPhoneNumber := "4155552671"
+	enabled := true
+	disabled := false
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateNotificationPreferencesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.UpdateNotificationPreferencesRequest{
+				EmailEnabled: &disabled,
+				SmsEnabled:   &enabled,
+				PhoneNumber:  &phoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetNotificationPreferences(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+				arg := db.UpsertNotificationPreferencesParams{
+					Username:     user.Username,
+					EmailEnabled: false,
+					SmsEnabled:   true,
+					PhoneNumber:  phoneNumber,
+				}
+				store.EXPECT().
+					UpsertNotificationPreferences(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.NotificationPreference{
+						Username:    user.Username,
+						SmsEnabled:  true,
+						PhoneNumber: phoneNumber,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetPreferences().GetEmailEnabled())
+				require.True(t, res.GetPreferences().GetSmsEnabled())
+				require.Equal(t, phoneNumber, res.GetPreferences().GetPhoneNumber())
+			},
+		},
+		{
+			name: "KeepExistingFields",
+			req: &pb.UpdateNotificationPreferencesRequest{
+				EmailEnabled: &enabled,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				existing := db.NotificationPreference{
+					Username:    user.Username,
+					SmsEnabled:  true,
+					PhoneNumber: phoneNumber,
+				}
+				store.EXPECT().
+					GetNotificationPreferences(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(existing, nil)
+
+				arg := db.UpsertNotificationPreferencesParams{
+					Username:     user.Username,
+					EmailEnabled: true,
+					SmsEnabled:   true,
+					PhoneNumber:  phoneNumber,
+				}
+				store.EXPECT().
+					UpsertNotificationPreferences(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.NotificationPreference{}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "SMSWithoutPhoneNumber",
+			req: &pb.UpdateNotificationPreferencesRequest{
+				SmsEnabled: &enabled,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetNotificationPreferences(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+				store.EXPECT().UpsertNotificationPreferences(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidPhoneNumber",
+			req: &pb.UpdateNotificationPreferencesRequest{
+				PhoneNumber: &invalidPhoneNumber,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpsertNotificationPreferences(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.UpdateNotificationPreferencesRequest{
+				EmailEnabled: &enabled,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpsertNotificationPreferences(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateNotificationPreferencesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateNotificationPreferences(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
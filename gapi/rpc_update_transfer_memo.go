@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateTransferMemo 让转出账户的主人在转账之后补充或修改备注和分类，
+// 和 UpdateUser 一样，没有传的字段保持不变。
+func (server *Server) UpdateTransferMemo(ctx context.Context, req *pb.UpdateTransferMemoRequest) (*pb.UpdateTransferMemoResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateTransferMemoRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "transfer doesn't belong to the authenticated user")
+	}
+
+	arg := db.UpdateTransferMemoParams{
+		ID: transfer.ID,
+		Memo: pgtype.Text{
+			String: req.GetMemo(),
+			Valid:  req.Memo != nil,
+		},
+		Category: pgtype.Text{
+			String: req.GetCategory(),
+			Valid:  req.Category != nil,
+		},
+	}
+
+	transfer, err = server.store.UpdateTransferMemo(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update transfer: %s", err)
+	}
+
+	rsp := &pb.UpdateTransferMemoResponse{
+		Transfer: convertTransfer(transfer),
+	}
+	return rsp, nil
+}
+
+func validateUpdateTransferMemoRequest(req *pb.UpdateTransferMemoRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if req.Category != nil {
+		if err := val.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateTransferMemoAPI(t *testing.T) {
+	sender, _ := randomUser(t, util.DepositorRole)
+	receiver, _ := randomUser(t, util.DepositorRole)
+
+	fromAccount := db.Account{ID: 1, Owner: sender.Username, Currency: util.USD}
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   2,
+		Amount:        10,
+	}
+
+	newMemo := "march rent"
+	newCategory := util.CategoryRent
+	invalidCategory := "gambling"
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateTransferMemoRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateTransferMemoResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.UpdateTransferMemoRequest{
+				Id:       transfer.ID,
+				Memo:     &newMemo,
+				Category: &newCategory,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+
+				arg := db.UpdateTransferMemoParams{
+					ID:       transfer.ID,
+					Memo:     pgtype.Text{String: newMemo, Valid: true},
+					Category: pgtype.Text{String: newCategory, Valid: true},
+				}
+				updated := transfer
+				updated.Memo = newMemo
+				updated.Category = newCategory
+				store.EXPECT().
+					UpdateTransferMemo(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updated, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateTransferMemoResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, newMemo, res.GetTransfer().GetMemo())
+				require.Equal(t, newCategory, res.GetTransfer().GetCategory())
+			},
+		},
+		{
+			name: "NotSender",
+			req: &pb.UpdateTransferMemoRequest{
+				Id:   transfer.ID,
+				Memo: &newMemo,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().UpdateTransferMemo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, receiver.Username, receiver.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateTransferMemoResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "TransferNotFound",
+			req: &pb.UpdateTransferMemoRequest{
+				Id:   transfer.ID,
+				Memo: &newMemo,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, db.ErrRecordNotFound)
+				store.EXPECT().UpdateTransferMemo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateTransferMemoResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "InvalidCategory",
+			req: &pb.UpdateTransferMemoRequest{
+				Id:       transfer.ID,
+				Category: &invalidCategory,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UpdateTransferMemo(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateTransferMemoResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateTransferMemo(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
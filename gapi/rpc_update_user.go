@@ -3,10 +3,10 @@ package gapi
 import (
 	"context"
 	"errors"
-	"log"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/pb"
 	"github.com/techschool/bank/util"
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 /*
@@ -33,7 +34,6 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 	if err != nil {
 		return nil, unauthenticatedError(err)
 	}
-	log.Printf("UpdateUser called with request: %v", req)
 
 	/*
 		验证请求: validateUpdateUserRequest 函数检查请求是否有效，
@@ -50,28 +50,150 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
 	}
 
+	user, err := server.updateUser(ctx, userUpdate{
+		username: req.GetUsername(),
+		fullName: req.GetFullName(),
+		email:    req.GetEmail(),
+		password: req.GetPassword(),
+		locale:   req.GetLocale(),
+		mask:     updateUserFieldMask(req),
+
+		allowAliasTransfers: req.GetAllowAliasTransfers(),
+
+		phoneNumber: req.GetPhoneNumber(),
+		dateOfBirth: req.GetDateOfBirth(),
+		address:     req.GetAddress(),
+
+		expectedUpdatedAt: req.GetExpectedUpdatedAt(),
+	})
+	if err != nil {
+		log.Ctx(ctx).Debug().Err(err).Str("username", req.GetUsername()).Msg("failed to update user")
+		return nil, err
+	}
+
 	/*
-		更新操作: 方法构建了一个 db.UpdateUserParams 结构体，
-		用于将请求数据转换为数据库操作所需的格式。
-		如果请求中包含了密码，它会先进行哈希处理。
+		响应: 如果用户更新成功，它会返回一个 *pb.UpdateUserResponse，其中包含了更新后的用户信息。
 	*/
+	rsp := &pb.UpdateUserResponse{
+		User: server.convertUserProfile(ctx, user),
+	}
+	return rsp, nil
+}
+
+// updateUserFields 是 v1 和 v2 的 UpdateUser 都允许出现在 update_mask 里的字段
+var updateUserFields = []string{"full_name", "email", "password", "locale", "allow_alias_transfers"}
+
+// updateUserFieldsV1 加上只有 v1 的 UpdateUser 才有的用户资料字段
+var updateUserFieldsV1 = append([]string{"phone_number", "date_of_birth", "address"}, updateUserFields...)
+
+// updateUserFieldMask 返回这次要更新的字段，没有 update_mask 的时候按 optional 字段是否设置来推断
+func updateUserFieldMask(req *pb.UpdateUserRequest) fieldMask {
+	return resolveFieldMask(req.GetUpdateMask(), map[string]bool{
+		"full_name": req.FullName != nil,
+		"email":     req.Email != nil,
+		"password":  req.Password != nil,
+		"locale":    req.Locale != nil,
+
+		"allow_alias_transfers": req.AllowAliasTransfers != nil,
+
+		"phone_number":  req.PhoneNumber != nil,
+		"date_of_birth": req.DateOfBirth != nil,
+		"address":       req.Address != nil,
+	})
+}
+
+// 这是一个辅助函数，用于验证传入的 UpdateUserRequest。它检查每个字段是否符合特定的验证规则，比如用户名是否为空或格式错误，密码是否符合安全要求等。
+// update_mask 里列出的字段即使没有设置也会被校验，避免把字段更新成空值。
+func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	// 要更新哪些字段由更新掩码决定，所以这几个字段的规则不写在 proto 里
+	if fieldViolations := validateFieldMask(req.GetUpdateMask(), updateUserFieldsV1...); fieldViolations != nil {
+		return append(violations, fieldViolations...)
+	}
+
+	mask := updateUserFieldMask(req)
+	violations = append(violations, validateUserUpdate(mask, req.GetFullName(), req.GetEmail(), req.GetPassword(), req.GetLocale())...)
+	return append(violations, validateUserProfileUpdate(mask, req.GetPhoneNumber(), req.GetDateOfBirth(), req.GetAddress())...)
+}
+
+// userUpdate 是 v1 和 v2 的 UpdateUser 共用的参数，只有 mask 里的字段会被更新
+type userUpdate struct {
+	username string
+	fullName string
+	email    string
+	password string
+	locale   string
+	mask     fieldMask
+
+	allowAliasTransfers bool
+
+	// 用户资料只有 v1 的 UpdateUser 可以修改，dateOfBirth 是 YYYY-MM-DD
+	phoneNumber string
+	dateOfBirth string
+	address     string
 
+	// expectedUpdatedAt 为 nil 时不检查用户有没有被同时修改
+	expectedUpdatedAt *timestamppb.Timestamp
+}
+
+/*
+updateUser 是 v1 和 v2 的 UpdateUser 共用的更新逻辑：
+构建 db.UpdateUserParams，把请求数据转换为数据库操作所需的格式，
+如果要更新密码，会先进行哈希处理，并清除强制重置密码的标记。
+返回的错误已经是 gRPC 的 status error。
+*/
+func (server *Server) updateUser(ctx context.Context, update userUpdate) (db.User, error) {
 	arg := db.UpdateUserParams{
-		Username: req.GetUsername(),
+		Username: update.username,
 		FullName: pgtype.Text{
-			String: req.GetFullName(),
-			Valid:  req.FullName != nil,
+			String: update.fullName,
+			Valid:  update.mask.has("full_name"),
 		},
 		Email: pgtype.Text{
-			String: req.GetEmail(),
-			Valid:  req.Email != nil,
+			String: update.email,
+			Valid:  update.mask.has("email"),
+		},
+		Locale: pgtype.Text{
+			String: update.locale,
+			Valid:  update.mask.has("locale"),
+		},
+		AllowAliasTransfers: pgtype.Bool{
+			Bool:  update.allowAliasTransfers,
+			Valid: update.mask.has("allow_alias_transfers"),
+		},
+		PhoneNumber: pgtype.Text{
+			String: update.phoneNumber,
+			Valid:  update.mask.has("phone_number"),
+		},
+		Address: pgtype.Text{
+			String: update.address,
+			Valid:  update.mask.has("address"),
 		},
 	}
 
-	if req.Password != nil {
-		hashedPassword, err := util.HashPassword(req.GetPassword())
+	if update.mask.has("date_of_birth") {
+		dateOfBirth, err := time.Parse(val.DateOfBirthLayout, update.dateOfBirth)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to hash password: %s", err)
+			return db.User{}, status.Errorf(codes.InvalidArgument, "invalid date of birth: %s", err)
+		}
+		arg.DateOfBirth = pgtype.Date{
+			Time:  dateOfBirth,
+			Valid: true,
+		}
+	}
+
+	if update.expectedUpdatedAt != nil {
+		arg.ExpectedUpdatedAt = pgtype.Timestamptz{
+			Time:  update.expectedUpdatedAt.AsTime(),
+			Valid: true,
+		}
+	}
+
+	if update.mask.has("password") {
+		hashedPassword, err := util.HashPassword(update.password)
+		if err != nil {
+			return db.User{}, status.Errorf(codes.Internal, "failed to hash password: %s", err)
 		}
 
 		arg.HashedPassword = pgtype.Text{
@@ -83,55 +205,109 @@ func (server *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			Time:  time.Now(),
 			Valid: true,
 		}
-	}
 
-	/*
-		数据库更新: 调用 server.store.UpdateUser 方法来执行数据库更新操作。
-		store 是 Server 结构体的一个字段，它实现了数据库操作的接口。
-	*/
+		arg.PasswordResetRequired = pgtype.Bool{
+			Bool:  false,
+			Valid: true,
+		}
+	}
 
-	user, err := server.store.UpdateUser(ctx, arg)
+	user, err := server.saveUserUpdate(ctx, arg, update)
 	if err != nil {
-		log.Printf("UpdateUser error: %v", err)
+		if errors.Is(err, db.ErrPasswordReused) {
+			violation := fieldViolation("password", val.PasswordReusedError(server.config.PasswordHistorySize))
+			return user, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{violation})
+		}
 		if errors.Is(err, db.ErrRecordNotFound) {
-			return nil, status.Errorf(codes.NotFound, "user not found")
+			if arg.ExpectedUpdatedAt.Valid {
+				return user, server.checkUserVersion(ctx, update.username)
+			}
+			return user, status.Errorf(codes.NotFound, "user not found")
 		}
-		return nil, status.Errorf(codes.Internal, "failed to update user: %s", err)
+		return user, status.Errorf(codes.Internal, "failed to update user: %s", err)
 	}
+	return user, nil
+}
 
-	/*
-		响应: 如果用户更新成功，它会返回一个 *pb.UpdateUserResponse，其中包含了更新后的用户信息。
-	*/
-	log.Println("UpdateUser method completed successfully")
-	rsp := &pb.UpdateUserResponse{
-		User: convertUser(user),
+// saveUserUpdate 修改密码时用 ChangePasswordTx 检查并记录密码历史（PASSWORD_HISTORY_SIZE），其他字段直接更新
+func (server *Server) saveUserUpdate(ctx context.Context, arg db.UpdateUserParams, update userUpdate) (db.User, error) {
+	if !update.mask.has("password") {
+		return server.store.UpdateUser(ctx, arg)
 	}
-	return rsp, nil
+
+	result, err := server.store.ChangePasswordTx(ctx, db.ChangePasswordTxParams{
+		UpdateUserParams: arg,
+		HistorySize:      server.config.PasswordHistorySize,
+		IsReused: func(hashedPassword string) bool {
+			return util.CheckPassword(update.password, hashedPassword) == nil
+		},
+	})
+	return result.User, err
 }
 
-// 这是一个辅助函数，用于验证传入的 UpdateUserRequest。它检查每个字段是否符合特定的验证规则，比如用户名是否为空或格式错误，密码是否符合安全要求等。
-func validateUpdateUserRequest(req *pb.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateUsername(req.GetUsername()); err != nil {
-		violations = append(violations, fieldViolation("username", err))
+/*
+checkUserVersion 在带 expected_updated_at 的更新没有改到任何行时调用，区分两种情况：
+用户不存在返回 NotFound，用户存在说明 updated_at 已经变了，返回 versionConflictError。
+*/
+func (server *Server) checkUserVersion(ctx context.Context, username string) error {
+	_, err := server.store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return status.Errorf(codes.NotFound, "user not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get user: %s", err)
 	}
+	return versionConflictError("users/" + username)
+}
 
-	if req.Password != nil {
-		if err := val.ValidatePassword(req.GetPassword()); err != nil {
+// validateUserUpdate 按 mask 校验要更新的字段，v1 和 v2 共用
+func validateUserUpdate(mask fieldMask, fullName string, email string, password string, locale string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if mask.has("password") {
+		if err := val.ValidatePassword(password); err != nil {
 			violations = append(violations, fieldViolation("password", err))
 		}
 	}
 
-	if req.FullName != nil {
-		if err := val.ValidateFullName(req.GetFullName()); err != nil {
+	if mask.has("full_name") {
+		if err := val.ValidateFullName(fullName); err != nil {
 			violations = append(violations, fieldViolation("full_name", err))
 		}
 	}
 
-	if req.Email != nil {
-		if err := val.ValidateEmail(req.GetEmail()); err != nil {
+	if mask.has("email") {
+		if err := val.ValidateEmail(email); err != nil {
 			violations = append(violations, fieldViolation("email", err))
 		}
 	}
 
+	if mask.has("locale") {
+		if err := val.ValidateLocale(locale); err != nil {
+			violations = append(violations, fieldViolation("locale", err))
+		}
+	}
+
+	return violations
+}
+
+// validateUserProfileUpdate 按 mask 校验要更新的用户资料，规则见 val/profile.go
+func validateUserProfileUpdate(mask fieldMask, phoneNumber string, dateOfBirth string, address string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if mask.has("phone_number") {
+		if err := val.ValidatePhoneNumber(phoneNumber); err != nil {
+			violations = append(violations, fieldViolation("phone_number", err))
+		}
+	}
+
+	if mask.has("date_of_birth") {
+		if _, err := val.ValidateDateOfBirth(dateOfBirth); err != nil {
+			violations = append(violations, fieldViolation("date_of_birth", err))
+		}
+	}
+
+	if mask.has("address") {
+		if err := val.ValidateAddress(address); err != nil {
+			violations = append(violations, fieldViolation("address", err))
+		}
+	}
+
 	return violations
 }
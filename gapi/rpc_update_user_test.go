@@ -11,21 +11,32 @@ import (
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/techschool/bank/db/mock"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
 	"github.com/techschool/bank/pb"
 	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestUpdateUserAPI(t *testing.T) {
 	user, _ := randomUser(t, util.DepositorRole)
+	user.UpdatedAt = time.Date(2024, time.March, 31, 10, 30, 0, 0, time.UTC)
 	other, _ := randomUser(t, util.DepositorRole)
 	banker, _ := randomUser(t, util.BankerRole)
 
 	newName := util.RandomOwner()
 	newEmail := util.RandomEmail()
 	invalidEmail := "invalid-email"
+	dateOfBirth := time.Date(1990, time.May, 17, 0, 0, 0, 0, time.UTC)
+	newLocale := i18n.Chinese
+	invalidLocale := "fr"
+	newPassword := util.RandomString(8)
 
 	testCases := []struct {
 		name          string
@@ -170,6 +181,221 @@ func TestUpdateUserAPI(t *testing.T) {
 				require.Equal(t, codes.InvalidArgument, st.Code())
 			},
 		},
+		{
+			name: "ChangePassword",
+			req: &pb.UpdateUserRequest{
+				Username: user.Username,
+				Password: &newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+				store.EXPECT().
+					ChangePasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.ChangePasswordTxParams) (db.ChangePasswordTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.True(t, arg.PasswordChangedAt.Valid)
+						require.True(t, arg.IsReused(arg.HashedPassword.String))
+						require.False(t, arg.IsReused(user.HashedPassword))
+						return db.ChangePasswordTxResult{User: user}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+			},
+		},
+		{
+			name: "PasswordReused",
+			req: &pb.UpdateUserRequest{
+				Username: user.Username,
+				Password: &newPassword,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ChangePasswordTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ChangePasswordTxResult{}, db.ErrPasswordReused)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+
+				violations := st.Details()[0].(*errdetails.BadRequest).GetFieldViolations()
+				require.Len(t, violations, 1)
+				require.Equal(t, "password", violations[0].GetField())
+				require.Equal(t, val.PasswordReusedError(0).Error(), violations[0].GetDescription())
+			},
+		},
+		{
+			name: "UpdateLocale",
+			req: &pb.UpdateUserRequest{
+				Username: user.Username,
+				Locale:   &newLocale,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					Locale: pgtype.Text{
+						String: newLocale,
+						Valid:  true,
+					},
+				}
+				updatedUser := user
+				updatedUser.Locale = newLocale
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedUser, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, newLocale, res.GetUser().GetLocale())
+			},
+		},
+		{
+			name: "DisableAliasTransfers",
+			req: &pb.UpdateUserRequest{
+				Username:            user.Username,
+				AllowAliasTransfers: proto.Bool(false),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					AllowAliasTransfers: pgtype.Bool{
+						Bool:  false,
+						Valid: true,
+					},
+				}
+				updatedUser := user
+				updatedUser.AllowAliasTransfers = false
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedUser, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetUser().GetAllowAliasTransfers())
+			},
+		},
+		{
+			name: "UpdateProfile",
+			req: &pb.UpdateUserRequest{
+				Username:    user.Username,
+				PhoneNumber: proto.String("+6421000000"),
+				DateOfBirth: proto.String(dateOfBirth.Format(val.DateOfBirthLayout)),
+				Address:     proto.String("1 Queen Street, Auckland"),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					PhoneNumber: pgtype.Text{
+						String: "+6421000000",
+						Valid:  true,
+					},
+					DateOfBirth: pgtype.Date{
+						Time:  dateOfBirth,
+						Valid: true,
+					},
+					Address: pgtype.Text{
+						String: "1 Queen Street, Auckland",
+						Valid:  true,
+					},
+				}
+				updatedUser := user
+				updatedUser.PhoneNumber = arg.PhoneNumber.String
+				updatedUser.DateOfBirth = arg.DateOfBirth
+				updatedUser.Address = arg.Address.String
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedUser, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				updatedUser := res.GetUser()
+				require.Equal(t, "+6421000000", updatedUser.GetPhoneNumber())
+				require.Equal(t, dateOfBirth.Format(val.DateOfBirthLayout), updatedUser.GetDateOfBirth())
+				require.Equal(t, "1 Queen Street, Auckland", updatedUser.GetAddress())
+			},
+		},
+		{
+			name: "InvalidPhoneNumber",
+			req: &pb.UpdateUserRequest{
+				Username:    user.Username,
+				PhoneNumber: proto.String("021000000"),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "UnderageDateOfBirth",
+			req: &pb.UpdateUserRequest{
+				Username:    user.Username,
+				DateOfBirth: proto.String(time.Now().AddDate(-val.MinimumAge+1, 0, 0).Format(val.DateOfBirthLayout)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "UnsupportedLocale",
+			req: &pb.UpdateUserRequest{
+				Username: user.Username,
+				Locale:   &invalidLocale,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
 		{
 			name: "ExpiredToken",
 			req: &pb.UpdateUserRequest{
@@ -218,6 +444,172 @@ func TestUpdateUserAPI(t *testing.T) {
 				require.Equal(t, codes.Unauthenticated, st.Code())
 			},
 		},
+		{
+			name: "UpdateMaskIgnoresUnlistedFields",
+			req: &pb.UpdateUserRequest{
+				Username:   user.Username,
+				FullName:   &newName,
+				Email:      &newEmail,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email"}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// full_name 不在 update_mask 里，Valid 为 false，不会被更新
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					FullName: pgtype.Text{
+						String: newName,
+						Valid:  false,
+					},
+					Email: pgtype.Text{
+						String: newEmail,
+						Valid:  true,
+					},
+				}
+				updatedUser := user
+				updatedUser.Email = newEmail
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedUser, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.FullName, res.GetUser().GetFullName())
+				require.Equal(t, newEmail, res.GetUser().GetEmail())
+			},
+		},
+		{
+			name: "UpdateMaskWithUnknownField",
+			req: &pb.UpdateUserRequest{
+				Username:   user.Username,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "UpdateMaskedFieldNotSet",
+			req: &pb.UpdateUserRequest{
+				Username:   user.Username,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"full_name"}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ExpectedUpdatedAt",
+			req: &pb.UpdateUserRequest{
+				Username:          user.Username,
+				FullName:          &newName,
+				ExpectedUpdatedAt: timestamppb.New(user.UpdatedAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateUserParams{
+					Username: user.Username,
+					FullName: pgtype.Text{
+						String: newName,
+						Valid:  true,
+					},
+					ExpectedUpdatedAt: pgtype.Timestamptz{
+						Time:  user.UpdatedAt,
+						Valid: true,
+					},
+				}
+				updatedUser := user
+				updatedUser.FullName = newName
+				updatedUser.UpdatedAt = user.UpdatedAt.Add(time.Second)
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(updatedUser, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetUser().GetUpdatedAt().AsTime().After(user.UpdatedAt))
+			},
+		},
+		{
+			name: "VersionConflict",
+			req: &pb.UpdateUserRequest{
+				Username:          user.Username,
+				FullName:          &newName,
+				ExpectedUpdatedAt: timestamppb.New(user.UpdatedAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				// 用户存在，只是 updated_at 已经变了
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+				require.True(t, isVersionConflict(st))
+			},
+		},
+		{
+			name: "VersionCheckUserNotFound",
+			req: &pb.UpdateUserRequest{
+				Username:          user.Username,
+				FullName:          &newName,
+				ExpectedUpdatedAt: timestamppb.New(user.UpdatedAt),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateUserResponse, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// kycContentTypes 是 KYC 证件允许的文件类型和保存时使用的扩展名
+var kycContentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+/*
+UploadKYCDocument 上传一份 KYC 证件，文件保存在 blob store 里（见 storage 包），
+数据库里只保存文件的 key，证件的状态是 pending，等待 banker 审核（ReviewKYCDocument）。
+
+文件类型不相信客户端，而是用 http.DetectContentType 根据文件内容判断，只接受 JPEG、PNG 和 PDF。
+文件大小不能超过 KYC_MAX_DOCUMENT_SIZE，gRPC 默认的消息上限是 4MB，所以这个值要比 4MB 小一点。
+
+HTTP 客户端除了用 JSON（content 是 base64 编码）调用之外，
+也可以用 multipart/form-data 上传到 /v1/upload_kyc_document/form（见 kyc_upload_form.go）。
+*/
+func (server *Server) UploadKYCDocument(ctx context.Context, req *pb.UploadKYCDocumentRequest) (*pb.UploadKYCDocumentResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUploadKYCDocumentRequest(req, server.config.KYCMaxDocumentSize)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if server.blobStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "document uploads are not enabled")
+	}
+
+	content := req.GetContent()
+	contentType := http.DetectContentType(content)
+	ext, ok := kycContentTypes[contentType]
+	if !ok {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("unsupported file type %s: must be a JPEG, PNG or PDF file", contentType)))
+		return nil, invalidArgumentError(violations)
+	}
+
+	fileKey := fmt.Sprintf("kyc/%s/%s%s", authPayload.Username, uuid.NewString(), ext)
+	err = server.blobStore.Put(ctx, fileKey, bytes.NewReader(content), int64(len(content)), contentType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store document: %s", err)
+	}
+
+	document, err := server.store.CreateKYCDocument(ctx, db.CreateKYCDocumentParams{
+		Username:     authPayload.Username,
+		DocumentType: req.GetDocumentType(),
+		FileKey:      fileKey,
+		ContentType:  contentType,
+		SizeBytes:    int64(len(content)),
+	})
+	if err != nil {
+		// 数据库里没有记录的文件不会再被用到，删掉它；删除失败也只是留下一个没用的文件
+		if err := server.blobStore.Delete(ctx, fileKey); err != nil {
+			LoggerFrom(ctx).Error().Err(err).Str("key", fileKey).Msg("failed to delete orphaned KYC document")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create KYC document: %s", err)
+	}
+
+	rsp := &pb.UploadKYCDocumentResponse{
+		Document: convertKYCDocument(document),
+	}
+	return rsp, nil
+}
+
+func validateUploadKYCDocumentRequest(req *pb.UploadKYCDocumentRequest, maxSize int64) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidateKYCDocumentType(req.GetDocumentType()); err != nil {
+		violations = append(violations, fieldViolation("document_type", err))
+	}
+
+	size := int64(len(req.GetContent()))
+	if size == 0 {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("must not be empty")))
+	} else if maxSize > 0 && size > maxSize {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("must not be larger than %d bytes", maxSize)))
+	}
+
+	return violations
+}
@@ -0,0 +1,227 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+// pngHeader is enough for http.DetectContentType to detect a PNG file
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestUploadKYCDocumentAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	testCases := []struct {
+		name          string
+		req           *pb.UploadKYCDocumentRequest
+		noBlobStore   bool
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UploadKYCDocumentRequest{DocumentType: util.KYCPassport, Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateKYCDocument(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateKYCDocumentParams) (db.KycDocument, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, util.KYCPassport, arg.DocumentType)
+						require.Equal(t, "image/png", arg.ContentType)
+						require.Equal(t, int64(len(pngHeader)), arg.SizeBytes)
+						require.Regexp(t, fmt.Sprintf(`^kyc/%s/.+\.png$`, user.Username), arg.FileKey)
+
+						return db.KycDocument{
+							ID:           1,
+							Username:     arg.Username,
+							DocumentType: arg.DocumentType,
+							FileKey:      arg.FileKey,
+							ContentType:  arg.ContentType,
+							SizeBytes:    arg.SizeBytes,
+							Status:       util.KYCDocumentPending,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.KYCDocumentPending, res.GetDocument().GetStatus())
+				require.Equal(t, "image/png", res.GetDocument().GetContentType())
+			},
+		},
+		{
+			name: "UnsupportedFileType",
+			req:  &pb.UploadKYCDocumentRequest{DocumentType: util.KYCPassport, Content: []byte("just some text")},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "TooLarge",
+			req:  &pb.UploadKYCDocumentRequest{DocumentType: util.KYCPassport, Content: append(pngHeader, make([]byte, 1024)...)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidDocumentType",
+			req:  &pb.UploadKYCDocumentRequest{DocumentType: "selfie", Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:        "NoBlobStore",
+			req:         &pb.UploadKYCDocumentRequest{DocumentType: util.KYCPassport, Content: pngHeader},
+			noBlobStore: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.UploadKYCDocumentRequest{DocumentType: util.KYCPassport, Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateKYCDocument(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.UploadKYCDocumentResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestKYCServer(t, store)
+			server.config.KYCMaxDocumentSize = 1024
+			if tc.noBlobStore {
+				server.blobStore = nil
+			}
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UploadKYCDocument(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestUploadKYCDocumentForm(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		CreateKYCDocument(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.CreateKYCDocumentParams) (db.KycDocument, error) {
+			require.Equal(t, user.Username, arg.Username)
+			require.Equal(t, util.KYCProofOfAddress, arg.DocumentType)
+			return db.KycDocument{ID: 1, Username: arg.Username, FileKey: arg.FileKey, Status: util.KYCDocumentPending}, nil
+		})
+
+	server := newTestKYCServer(t, store)
+	server.config.KYCMaxDocumentSize = 1024
+
+	gateway := newTestGateway(t, server)
+
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writer.WriteField("document_type", util.KYCProofOfAddress))
+	part, err := writer.CreateFormFile("file", "bill.png")
+	require.NoError(t, err)
+	_, err = part.Write(pngHeader)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	request := httptest.NewRequest(http.MethodPost, KYCUploadFormPath, body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	recorder := httptest.NewRecorder()
+	gateway.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var rsp struct {
+		Document struct {
+			ID     string `json:"id"`
+			Status string `json:"status"`
+		} `json:"document"`
+	}
+	data, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &rsp))
+	require.Equal(t, "1", rsp.Document.ID)
+	require.Equal(t, util.KYCDocumentPending, rsp.Document.Status)
+}
+
+// newTestKYCServer creates a test server that stores uploaded files in a temporary directory
+func newTestKYCServer(t *testing.T, store db.Store) *Server {
+	server := newTestServer(t, store, nil)
+
+	blobStore, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	server.blobStore = blobStore
+
+	return server
+}
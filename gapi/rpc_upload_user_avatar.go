@@ -0,0 +1,137 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadUserAvatarPath 是上传头像的路径，头像内容是 base64，比原文件大三分之一
+const uploadUserAvatarPath = "/v1/upload_user_avatar"
+
+// avatarURLExpiry 是响应里头像链接的有效期，客户端过期之后重新获取用户信息
+const avatarURLExpiry = time.Hour
+
+// avatarContentTypes 是头像允许的文件类型和保存时使用的扩展名
+var avatarContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+/*
+UploadUserAvatar 上传或者替换登录用户的头像。和 KYC 证件一样，文件保存在 blob store 里，
+users 表里只保存文件的 key，文件类型根据内容判断，大小不能超过 AVATAR_MAX_SIZE。
+替换头像之后旧的文件会被删掉；用户被匿名化时头像也会被删掉（见 worker.PayloadAnonymizeUser）。
+
+blob store 的文件不是公开的，响应里的 avatar_url 是一个短时间有效的下载链接，见 convertUserProfile。
+*/
+func (server *Server) UploadUserAvatar(ctx context.Context, req *pb.UploadUserAvatarRequest) (*pb.UploadUserAvatarResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUploadUserAvatarRequest(req, server.config.AvatarMaxSize)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if server.blobStore == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "avatar uploads are not enabled")
+	}
+
+	content := req.GetContent()
+	contentType := http.DetectContentType(content)
+	ext, ok := avatarContentTypes[contentType]
+	if !ok {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("unsupported file type %s: must be a JPEG or PNG image", contentType)))
+		return nil, invalidArgumentError(violations)
+	}
+
+	user, err := server.store.GetUser(ctx, authPayload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	// 每次上传用新的 key，浏览器和 CDN 不会缓存到旧的头像
+	avatarKey := fmt.Sprintf("avatars/%s/%s%s", user.Username, uuid.NewString(), ext)
+	err = server.blobStore.Put(ctx, avatarKey, bytes.NewReader(content), int64(len(content)), contentType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store avatar: %s", err)
+	}
+
+	updated, err := server.store.UpdateUserAvatar(ctx, db.UpdateUserAvatarParams{
+		Username:  user.Username,
+		AvatarKey: avatarKey,
+	})
+	if err != nil {
+		server.deleteAvatar(ctx, avatarKey)
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update avatar: %s", err)
+	}
+
+	if user.AvatarKey != "" {
+		server.deleteAvatar(ctx, user.AvatarKey)
+	}
+
+	rsp := &pb.UploadUserAvatarResponse{
+		User: server.convertUserProfile(ctx, updated),
+	}
+	return rsp, nil
+}
+
+func validateUploadUserAvatarRequest(req *pb.UploadUserAvatarRequest, maxSize int64) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	size := int64(len(req.GetContent()))
+	if size == 0 {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("must not be empty")))
+	} else if maxSize > 0 && size > maxSize {
+		violations = append(violations, fieldViolation("content", fmt.Errorf("must not be larger than %d bytes", maxSize)))
+	}
+
+	return violations
+}
+
+// deleteAvatar 删除用不到的头像文件，删除失败也只是留下一个没用的文件
+func (server *Server) deleteAvatar(ctx context.Context, avatarKey string) {
+	if err := server.blobStore.Delete(ctx, avatarKey); err != nil {
+		LoggerFrom(ctx).Error().Err(err).Str("key", avatarKey).Msg("failed to delete unused avatar")
+	}
+}
+
+// convertUserProfile 和 convertUser 一样，另外给头像签一个 avatarURLExpiry 有效的下载链接
+func (server *Server) convertUserProfile(ctx context.Context, user db.User) *pb.User {
+	rsp := convertUser(user)
+	if user.AvatarKey == "" || server.blobStore == nil {
+		return rsp
+	}
+
+	avatarURL, err := server.blobStore.SignedURL(ctx, user.AvatarKey, avatarURLExpiry)
+	if err != nil {
+		// 头像链接不是必须的，签名失败时返回没有头像链接的用户
+		if !errors.Is(err, storage.ErrSignedURLNotSupported) {
+			LoggerFrom(ctx).Error().Err(err).Str("key", user.AvatarKey).Msg("failed to sign avatar URL")
+		}
+		return rsp
+	}
+	rsp.AvatarUrl = avatarURL
+	return rsp
+}
@@ -0,0 +1,216 @@
+package gapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUploadUserAvatarAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	oldAvatarKey := fmt.Sprintf("avatars/%s/old.png", user.Username)
+
+	testCases := []struct {
+		name          string
+		req           *pb.UploadUserAvatarRequest
+		noBlobStore   bool
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.UploadUserAvatarRequest{Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					UpdateUserAvatar(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.UpdateUserAvatarParams) (db.User, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Regexp(t, fmt.Sprintf(`^avatars/%s/.+\.png$`, user.Username), arg.AvatarKey)
+
+						updated := user
+						updated.AvatarKey = arg.AvatarKey
+						return updated, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, user.Username, res.GetUser().GetUsername())
+				require.True(t, strings.HasPrefix(res.GetUser().GetAvatarUrl(), "https://files.example.com"+storage.LocalBlobPath))
+			},
+		},
+		{
+			// 替换头像之后旧的文件被删掉
+			name: "ReplaceAvatar",
+			req:  &pb.UploadUserAvatarRequest{Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				existing := user
+				existing.AvatarKey = oldAvatarKey
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(existing, nil)
+				store.EXPECT().
+					UpdateUserAvatar(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.UpdateUserAvatarParams) (db.User, error) {
+						updated := user
+						updated.AvatarKey = arg.AvatarKey
+						return updated, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				require.NoError(t, err)
+				_, err = blobStore.Get(context.Background(), oldAvatarKey)
+				require.ErrorIs(t, err, storage.ErrNotFound)
+			},
+		},
+		{
+			// 保存 key 失败时删掉刚上传的文件，旧的头像不动
+			name: "UpdateFailed",
+			req:  &pb.UploadUserAvatarRequest{Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				existing := user
+				existing.AvatarKey = oldAvatarKey
+				store.EXPECT().
+					GetUser(gomock.Any(), gomock.Eq(user.Username)).
+					Times(1).
+					Return(existing, nil)
+				store.EXPECT().
+					UpdateUserAvatar(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+				file, err := blobStore.Get(context.Background(), oldAvatarKey)
+				require.NoError(t, err)
+				file.Close()
+			},
+		},
+		{
+			name: "UnsupportedFileType",
+			req:  &pb.UploadUserAvatarRequest{Content: []byte("just some text")},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserAvatar(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "TooLarge",
+			req:  &pb.UploadUserAvatarRequest{Content: append(pngHeader, make([]byte, 1024)...)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserAvatar(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name:        "NoBlobStore",
+			req:         &pb.UploadUserAvatarRequest{Content: pngHeader},
+			noBlobStore: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserAvatar(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.UploadUserAvatarRequest{Content: pngHeader},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateUserAvatar(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, blobStore *storage.LocalStore, res *pb.UploadUserAvatarResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+			server.config.AvatarMaxSize = 1024
+
+			blobStore, err := storage.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, blobStore.EnableSignedURLs("https://files.example.com", util.RandomString(32)))
+			err = blobStore.Put(context.Background(), oldAvatarKey, bytes.NewReader(pngHeader), int64(len(pngHeader)), "image/png")
+			require.NoError(t, err)
+			if !tc.noBlobStore {
+				server.blobStore = blobStore
+			}
+
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UploadUserAvatar(ctx, tc.req)
+			tc.checkResponse(t, blobStore, res, err)
+		})
+	}
+}
+
+func TestConvertUserProfile(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	user.AvatarKey = fmt.Sprintf("avatars/%s/avatar.png", user.Username)
+	server := newTestKYCServer(t, nil)
+
+	// 没有配置签名的 blob store 不返回头像链接
+	require.Empty(t, server.convertUserProfile(context.Background(), user).GetAvatarUrl())
+
+	blobStore := server.blobStore.(*storage.LocalStore)
+	require.NoError(t, blobStore.EnableSignedURLs("https://files.example.com", util.RandomString(32)))
+	avatarURL := server.convertUserProfile(context.Background(), user).GetAvatarUrl()
+	require.True(t, strings.HasPrefix(avatarURL, "https://files.example.com"+storage.LocalBlobPath+user.AvatarKey), avatarURL)
+
+	user.AvatarKey = ""
+	require.Empty(t, server.convertUserProfile(context.Background(), user).GetAvatarUrl())
+}
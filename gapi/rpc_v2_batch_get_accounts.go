@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+BatchGetAccounts 用一次查询（GetAccountsByIDs）返回多个账户，客户端的首页不用再一个一个地调用 GetAccount。
+每个账户单独检查权限：不存在或者不属于当前用户的账户在结果里带一个错误，其他账户照常返回，
+而不是像 GetAccount 那样让整个请求失败。错误的格式和 GetAccount 返回的一样，也会按 accept-language 翻译。
+一次最多 50 个账户，由请求的校验规则限制。
+*/
+func (server *ServerV2) BatchGetAccounts(ctx context.Context, req *pbv2.BatchGetAccountsRequest) (*pbv2.BatchGetAccountsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accounts, err := server.store.GetAccountsByIDs(ctx, req.GetAccountIds())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get accounts: %s", err)
+	}
+
+	found := make(map[int64]int, len(accounts))
+	for i, account := range accounts {
+		found[account.ID] = i
+	}
+
+	locale := requestLocale(ctx)
+	rsp := &pbv2.BatchGetAccountsResponse{
+		Results: make([]*pbv2.AccountResult, len(req.GetAccountIds())),
+	}
+	for i, id := range req.GetAccountIds() {
+		result := &pbv2.AccountResult{AccountId: id}
+		rsp.Results[i] = result
+
+		j, ok := found[id]
+		if !ok {
+			result.Result = batchError(locale, status.New(codes.NotFound, "account not found"))
+			continue
+		}
+		if err := checkAccountAccess(authPayload, accounts[j]); err != nil {
+			result.Result = batchError(locale, status.Convert(err))
+			continue
+		}
+		result.Result = &pbv2.AccountResult_Account{Account: convertAccountV2(accounts[j])}
+	}
+	return rsp, nil
+}
+
+func batchError(locale string, st *status.Status) *pbv2.AccountResult_Error {
+	st = localizeStatus(locale, st)
+	return &pbv2.AccountResult_Error{
+		Error: &pbv2.BatchError{
+			Code:    code.Code(st.Code()).String(),
+			Message: st.Message(),
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchGetAccountsAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	other, _ := randomUser(t, util.DepositorRole)
+	banker, _ := randomUser(t, util.BankerRole)
+
+	account1 := db.Account{ID: 1, Owner: user.Username, Balance: 100, Currency: util.USD}
+	account2 := db.Account{ID: 2, Owner: user.Username, Balance: 250, Currency: util.EUR}
+	otherAccount := db.Account{ID: 3, Owner: other.Username, Balance: 50, Currency: util.USD}
+
+	// 4 不存在
+	ids := []int64{2, 3, 4, 1}
+
+	testCases := []struct {
+		name          string
+		req           *pbv2.BatchGetAccountsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error)
+	}{
+		{
+			name: "PartialResults",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: ids},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Eq(ids)).
+					Times(1).
+					Return([]db.Account{account1, account2, otherAccount}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.NoError(t, err)
+				results := res.GetResults()
+				require.Len(t, results, len(ids))
+
+				// 结果的顺序和请求一样
+				for i, id := range ids {
+					require.Equal(t, id, results[i].GetAccountId())
+				}
+				require.Equal(t, int64(250), results[0].GetAccount().GetBalance().GetAmountMinor())
+				require.Equal(t, "PERMISSION_DENIED", results[1].GetError().GetCode())
+				require.Nil(t, results[1].GetAccount())
+				require.Equal(t, "NOT_FOUND", results[2].GetError().GetCode())
+				require.Equal(t, "account not found", results[2].GetError().GetMessage())
+				require.Equal(t, user.Username, results[3].GetAccount().GetOwner())
+			},
+		},
+		{
+			name: "Banker",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: []int64{1, 3}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{account1, otherAccount}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, banker.Username, banker.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.NoError(t, err)
+				for _, result := range res.GetResults() {
+					require.NotNil(t, result.GetAccount())
+				}
+			},
+		},
+		{
+			name: "LocalizedError",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: []int64{3}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Account{otherAccount}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+				md, _ := metadata.FromIncomingContext(ctx)
+				md = metadata.Join(md, metadata.Pairs(acceptLanguageHeader, "zh-CN"))
+				return metadata.NewIncomingContext(ctx, md)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.NoError(t, err)
+				message := res.GetResults()[0].GetError().GetMessage()
+				require.Equal(t, i18n.Translate(i18n.Chinese, "account doesn't belong to the authenticated user"), message)
+			},
+		},
+		{
+			name: "TooManyIDs",
+			req: func() *pbv2.BatchGetAccountsRequest {
+				req := &pbv2.BatchGetAccountsRequest{}
+				for i := int64(1); i <= 51; i++ {
+					req.AccountIds = append(req.AccountIds, i)
+				}
+				return req
+			}(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountsByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "DuplicateIDs",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: []int64{1, 1}},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountsByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.Equal(t, codes.InvalidArgument, status.Code(err))
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: ids},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccountsByIDs(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.Equal(t, codes.Unauthenticated, status.Code(err))
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pbv2.BatchGetAccountsRequest{AccountIds: ids},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pbv2.BatchGetAccountsResponse, err error) {
+				require.Equal(t, codes.Internal, status.Code(err))
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := NewServerV2(newTestServer(t, store, nil))
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.BatchGetAccounts(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
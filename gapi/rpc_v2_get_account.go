@@ -0,0 +1,32 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+)
+
+// GetAccount 返回当前用户的一个账户，banker 可以查看任何人的账户。v1 没有这个 RPC。
+func (server *ServerV2) GetAccount(ctx context.Context, req *pbv2.GetAccountRequest) (*pbv2.GetAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateProto(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	setLastModified(ctx, account.UpdatedAt)
+	rsp := &pbv2.GetAccountResponse{
+		Account: convertAccountV2(account),
+	}
+	return rsp, nil
+}
@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ListAccounts 和 v1 一样列出当前登录用户的账户，只是余额用 Money 表示
+func (server *ServerV2) ListAccounts(ctx context.Context, req *pbv2.ListAccountsRequest) (*pbv2.ListAccountsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListAccountsRequestV2(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	accounts, nextPageToken, err := server.listAccounts(ctx, authPayload.Username, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbv2.ListAccountsResponse{
+		Accounts:      make([]*pbv2.Account, 0, len(accounts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, account := range accounts {
+		rsp.Accounts = append(rsp.Accounts, convertAccountV2(account))
+	}
+	return rsp, nil
+}
+
+func validateListAccountsRequestV2(req *pbv2.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
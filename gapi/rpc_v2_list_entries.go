@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ListEntries 和 v1 一样列出某个账户的资金流水，金额的币种就是这个账户的币种
+func (server *ServerV2) ListEntries(ctx context.Context, req *pbv2.ListEntriesRequest) (*pbv2.ListEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListEntriesRequestV2(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	entries, nextPageToken, err := server.listEntries(ctx, account, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbv2.ListEntriesResponse{
+		Entries:       make([]*pbv2.Entry, 0, len(entries)),
+		NextPageToken: nextPageToken,
+	}
+	for _, entry := range entries {
+		rsp.Entries = append(rsp.Entries, convertEntryV2(entry, account.Currency))
+	}
+	return rsp, nil
+}
+
+func validateListEntriesRequestV2(req *pbv2.ListEntriesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
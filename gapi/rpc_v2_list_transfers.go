@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ListTransfers 和 v1 一样列出某个账户的转账记录，金额的币种就是这个账户的币种
+func (server *ServerV2) ListTransfers(ctx context.Context, req *pbv2.ListTransfersRequest) (*pbv2.ListTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransfersRequestV2(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, authPayload, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, nextPageToken, err := server.listTransfers(ctx, account, req.GetPageToken(), req.GetPageSize())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbv2.ListTransfersResponse{
+		Transfers:     make([]*pbv2.Transfer, 0, len(transfers)),
+		NextPageToken: nextPageToken,
+	}
+	for _, transfer := range transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransferV2(transfer, account.Currency))
+	}
+	return rsp, nil
+}
+
+func validateListTransfersRequestV2(req *pbv2.ListTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+
+	if err := val.ValidatePageToken(req.GetPageToken()); err != nil {
+		violations = append(violations, fieldViolation("page_token", err))
+	}
+
+	return violations
+}
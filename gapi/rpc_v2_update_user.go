@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+UpdateUser 是 v2 的更新用户接口，和 v1 的区别是 update_mask 必须传（proto 里的 required 规则），
+只有 update_mask 里列出的字段会被更新，不会再根据字段是否设置来推断。
+更新的逻辑和 v1 共用 updateUser。
+*/
+func (server *ServerV2) UpdateUser(ctx context.Context, req *pbv2.UpdateUserRequest) (*pbv2.UpdateUserResponse, error) {
+	authPayload, err := server.authorizeUser(ctx, []string{util.BankerRole, util.DepositorRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateUserRequestV2(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if authPayload.Role != util.BankerRole && authPayload.Username != req.GetUsername() {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot update other user's info")
+	}
+
+	user, err := server.updateUser(ctx, userUpdate{
+		username: req.GetUsername(),
+		fullName: req.GetFullName(),
+		email:    req.GetEmail(),
+		password: req.GetPassword(),
+		locale:   req.GetLocale(),
+		mask:     resolveFieldMask(req.GetUpdateMask(), nil),
+
+		allowAliasTransfers: req.GetAllowAliasTransfers(),
+
+		expectedUpdatedAt: req.GetExpectedUpdatedAt(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pbv2.UpdateUserResponse{
+		User: convertUserV2(user),
+	}
+	return rsp, nil
+}
+
+func validateUpdateUserRequestV2(req *pbv2.UpdateUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateProto(req)
+	if violations != nil {
+		return violations
+	}
+
+	if fieldViolations := validateFieldMask(req.GetUpdateMask(), updateUserFields...); fieldViolations != nil {
+		return fieldViolations
+	}
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return append(violations, fieldViolation("update_mask", errEmptyUpdateMask))
+	}
+
+	return validateUserUpdate(resolveFieldMask(req.GetUpdateMask(), nil), req.GetFullName(), req.GetEmail(), req.GetPassword(), req.GetLocale())
+}
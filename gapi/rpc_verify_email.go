@@ -2,26 +2,56 @@ package gapi
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strconv"
 
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/pb"
-	"github.com/techschool/bank/val"
-	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"github.com/techschool/bank/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
-	violations := validateVerifyEmailRequest(req)
+	violations := validateProto(req)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
 
+	// 链接里的签名和过期时间在查数据库之前先检查
+	params := url.Values{}
+	params.Set("email_id", strconv.FormatInt(req.GetEmailId(), 10))
+	params.Set("secret_code", req.GetSecretCode())
+	params.Set(util.LinkExpiresAtParam, strconv.FormatInt(req.GetExpiresAt(), 10))
+	params.Set(util.LinkSignatureParam, req.GetSignature())
+
+	err := util.NewLinkSigner(server.config.LinkSigningKey, util.LinkPurposeVerifyEmail).Verify(params)
+	if err != nil {
+		if errors.Is(err, util.ErrLinkExpired) {
+			return nil, verifyEmailExpiredError()
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid verification link")
+	}
+
 	txResult, err := server.store.VerifyEmailTx(ctx, db.VerifyEmailTxParams{
 		EmailId:    req.GetEmailId(),
 		SecretCode: req.GetSecretCode(),
 	})
 	if err != nil {
+		switch {
+		case errors.Is(err, db.ErrInvalidSecretCode):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid verification link")
+		case errors.Is(err, db.ErrVerifyEmailExpired):
+			return nil, verifyEmailExpiredError()
+		case errors.Is(err, db.ErrVerifyEmailUsed):
+			return nil, preconditionFailureError(
+				"verification code has already been used",
+				verifyEmailUsedViolation,
+				"email_id",
+				"this link has already been used, sign in to check whether your email is verified",
+			)
+		}
 		return nil, status.Errorf(codes.Internal, "failed to verify email")
 	}
 
@@ -31,14 +61,17 @@ func (server *Server) VerifyEmail(ctx context.Context, req *pb.VerifyEmailReques
 	return rsp, nil
 }
 
-func validateVerifyEmailRequest(req *pb.VerifyEmailRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-	if err := val.ValidateEmailId(req.GetEmailId()); err != nil {
-		violations = append(violations, fieldViolation("email_id", err))
-	}
-
-	if err := val.ValidateSecretCode(req.GetSecretCode()); err != nil {
-		violations = append(violations, fieldViolation("secret_code", err))
-	}
+const (
+	verifyEmailExpiredViolation = "VERIFY_EMAIL_EXPIRED"
+	verifyEmailUsedViolation    = "VERIFY_EMAIL_USED"
+)
 
-	return violations
+// verifyEmailExpiredError 提示用户登录之后调用 ResendVerifyEmail 重新获取验证邮件
+func verifyEmailExpiredError() error {
+	return preconditionFailureError(
+		"verification link has expired",
+		verifyEmailExpiredViolation,
+		"email_id",
+		"sign in and call POST /v1/resend_verify_email to receive a new verification email",
+	)
 }
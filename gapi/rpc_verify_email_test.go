@@ -0,0 +1,203 @@
+package gapi
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/util"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func signedVerifyEmailRequest(t *testing.T, key string, emailID int64, secretCode string, expiresAt time.Time) *pb.VerifyEmailRequest {
+	params := url.Values{}
+	params.Set("email_id", strconv.FormatInt(emailID, 10))
+	params.Set("secret_code", secretCode)
+	params = util.NewLinkSigner(key, util.LinkPurposeVerifyEmail).Sign(params, expiresAt)
+
+	signedExpiresAt, err := strconv.ParseInt(params.Get(util.LinkExpiresAtParam), 10, 64)
+	require.NoError(t, err)
+
+	return &pb.VerifyEmailRequest{
+		EmailId:    emailID,
+		SecretCode: secretCode,
+		ExpiresAt:  signedExpiresAt,
+		Signature:  params.Get(util.LinkSignatureParam),
+	}
+}
+
+func TestVerifyEmailAPI(t *testing.T) {
+	user, _ := randomUser(t, util.DepositorRole)
+	emailID := util.RandomInt(1, 1000)
+	secretCode := util.RandomString(32)
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(t *testing.T, key string) *pb.VerifyEmailRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.VerifyEmailResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.VerifyEmailTxParams{
+					EmailId:    emailID,
+					SecretCode: secretCode,
+				}
+				verified := user
+				verified.IsEmailVerified = true
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.VerifyEmailTxResult{User: verified}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetIsVerified())
+			},
+		},
+		{
+			name: "CodeExpired",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrVerifyEmailExpired)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+
+				require.Len(t, st.Details(), 1)
+				failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+				require.True(t, ok)
+				require.Equal(t, verifyEmailExpiredViolation, failure.GetViolations()[0].GetType())
+			},
+		},
+		{
+			name: "CodeUsed",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrVerifyEmailUsed)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+
+				require.Len(t, st.Details(), 1)
+				failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+				require.True(t, ok)
+				require.Equal(t, verifyEmailUsedViolation, failure.GetViolations()[0].GetType())
+			},
+		},
+		{
+			name: "InvalidSecretCode",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					VerifyEmailTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.VerifyEmailTxResult{}, db.ErrInvalidSecretCode)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "ExpiredLink",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(-time.Minute))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().VerifyEmailTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "TamperedExpiry",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				req := signedVerifyEmailRequest(t, key, emailID, secretCode, time.Now().Add(-time.Minute))
+				req.ExpiresAt = time.Now().Add(time.Hour).Unix()
+				return req
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().VerifyEmailTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "MissingSignature",
+			buildRequest: func(t *testing.T, key string) *pb.VerifyEmailRequest {
+				return &pb.VerifyEmailRequest{
+					EmailId:    emailID,
+					SecretCode: secretCode,
+				}
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().VerifyEmailTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.VerifyEmailResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+			server := newTestServer(t, store, nil)
+
+			req := tc.buildRequest(t, server.config.LinkSigningKey)
+			res, err := server.VerifyEmail(context.Background(), req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
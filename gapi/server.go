@@ -2,9 +2,16 @@ package gapi
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/techschool/bank/db/migration"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/flags"
+	"github.com/techschool/bank/geoip"
+	"github.com/techschool/bank/oauth"
 	"github.com/techschool/bank/pb"
+	"github.com/techschool/bank/ratelimit"
+	"github.com/techschool/bank/storage"
 	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
 	"github.com/techschool/bank/worker"
@@ -20,25 +27,67 @@ NewServer 函数是创建新的 gRPC Server 的构造函数。
 // Server serves gRPC requests for our banking service.
 type Server struct {
 	pb.UnimplementedSimpleBankServer
+	pb.UnimplementedAdminServiceServer
 	config          util.Config
 	store           db.Store
 	tokenMaker      token.Maker
 	taskDistributor worker.TaskDistributor
+	taskInspector   worker.TaskInspector
+	revocationStore token.RevocationStore
+	oauthProviders  oauth.Providers
+	blobStore       storage.BlobStore
+	rateLimiter     *ratelimit.Limiter
+	featureFlags    *flags.Flags
+	// geoLocator 为 nil 时会话和日志里不记录国家和城市
+	geoLocator geoip.Locator
+	startedAt  time.Time
+	// migrationStatus 读取数据库结构的版本，测试里替换成固定的结果
+	migrationStatus func() (migration.Status, error)
 }
 
 // NewServer creates a new gRPC server.
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
-	tokenMaker, err := token.NewPasetoMaker(config.TokenSymmetricKey)
+func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector, revocationStore token.RevocationStore) (*Server, error) {
+	tokenMaker, err := token.NewMaker(config)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 
+	blobStore, err := storage.NewBlobStore(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create blob store: %w", err)
+	}
+
+	geoLocator, err := geoip.NewLocator(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create geoip locator: %w", err)
+	}
+
 	server := &Server{
 		config:          config,
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributor: taskDistributor,
+		taskInspector:   taskInspector,
+		revocationStore: revocationStore,
+		oauthProviders:  oauth.NewProviders(config),
+		blobStore:       blobStore,
+		rateLimiter:     ratelimit.NewLimiter(config),
+		featureFlags:    flags.New(store, config.FeatureFlagCacheTTL),
+		geoLocator:      geoLocator,
+		startedAt:       time.Now(),
+		migrationStatus: func() (migration.Status, error) {
+			return migration.CurrentStatus(config.MigrationURL, config.DBSource)
+		},
+	}
+
+	if len(server.oauthProviders) > 0 && len(config.OAuthStateKey) < 32 {
+		return nil, fmt.Errorf("OAUTH_STATE_KEY must have at least 32 characters when OAuth login is enabled")
 	}
 
 	return server, nil
 }
+
+// ReloadConfig applies the settings that can change without a restart, see util.ConfigWatcher
+func (server *Server) ReloadConfig(config util.Config) {
+	server.rateLimiter.Update(config)
+}
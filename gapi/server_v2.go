@@ -0,0 +1,25 @@
+package gapi
+
+import (
+	pbv2 "github.com/techschool/bank/pb/v2"
+)
+
+/*
+ServerV2 实现了 v2 版本的 pbv2.SimpleBankV2Server，和 v1 的 Server 注册在同一个 gRPC 服务器和网关上。
+它嵌入了 *Server，所以配置、数据库存储、令牌生成器和拦截器都和 v1 共用；
+v1 和 v2 的 RPC 名字有重复（比如 ListAccounts），而 Go 的一个类型不能有两个同名方法，所以 v2 单独用一个类型。
+
+v2 的处理函数只负责校验请求和转换响应，查询和更新的逻辑调用 v1 里的 listAccounts、updateUser 等共用函数，
+两个版本访问 store 的代码是同一份。
+*/
+
+// ServerV2 serves gRPC requests for version 2 of our banking service.
+type ServerV2 struct {
+	pbv2.UnimplementedSimpleBankV2Server
+	*Server
+}
+
+// NewServerV2 creates a v2 server that shares everything with the given v1 server.
+func NewServerV2(server *Server) *ServerV2 {
+	return &ServerV2{Server: server}
+}
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/*
+NewTimeoutInterceptor 给每个请求的 ctx 加上超时（REQUEST_TIMEOUT），
+这个 ctx 会一直传到 pgx，查询超时后处理函数就会返回，而不是一直挂着。
+客户端自己带了更短的 deadline 时以客户端的为准。
+
+处理函数一般把数据库错误包装成 Internal，超时的时候这里改成 DeadlineExceeded，
+客户端才知道可以重试。timeout 为 0 时不加超时。
+*/
+func NewTimeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, timeoutError(ctx, err)
+		}
+		return resp, nil
+	}
+}
+
+// Timeout 是 HTTP 网关用的超时中间件。网关直接调用 Server 的方法，不经过 gRPC 拦截器，
+// 所以在这里给请求的 ctx 加上超时，错误码的转换在 GatewayErrorHandler 里做。
+func Timeout(handler http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+
+		handler.ServeHTTP(res, req.WithContext(ctx))
+	})
+}
+
+func timeoutError(ctx context.Context, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	if code := status.Code(err); code == codes.Internal || code == codes.Unknown {
+		return status.Error(codes.DeadlineExceeded, "request timed out")
+	}
+	return err
+}
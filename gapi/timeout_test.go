@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/GetAccount"}
+
+	testCases := []struct {
+		name          string
+		timeout       time.Duration
+		handler       grpc.UnaryHandler
+		checkResponse func(t *testing.T, resp interface{}, err error)
+	}{
+		{
+			name:    "OK",
+			timeout: time.Second,
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				deadline, ok := ctx.Deadline()
+				require.True(t, ok)
+				require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+				return "ok", nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "ok", resp)
+			},
+		},
+		{
+			name:    "DeadlineExceeded",
+			timeout: 10 * time.Millisecond,
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				<-ctx.Done()
+				return nil, status.Errorf(codes.Internal, "failed to get account: %s", ctx.Err())
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+			},
+		},
+		{
+			name:    "KeepOtherCodes",
+			timeout: 10 * time.Millisecond,
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				<-ctx.Done()
+				return nil, status.Errorf(codes.NotFound, "account not found")
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.Equal(t, codes.NotFound, status.Code(err))
+			},
+		},
+		{
+			name:    "NoTimeout",
+			timeout: 0,
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				_, ok := ctx.Deadline()
+				if ok {
+					return nil, fmt.Errorf("unexpected deadline")
+				}
+				return "ok", nil
+			},
+			checkResponse: func(t *testing.T, resp interface{}, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			interceptor := NewTimeoutInterceptor(tc.timeout)
+			resp, err := interceptor(context.Background(), nil, info, tc.handler)
+			tc.checkResponse(t, resp, err)
+		})
+	}
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	handler := Timeout(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		deadline, hasDeadline = req.Context().Deadline()
+	}), time.Second)
+
+	request := httptest.NewRequest(http.MethodGet, "/v1/accounts", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	require.True(t, hasDeadline)
+	require.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+}
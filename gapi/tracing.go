@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/techschool/bank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+/*
+TracingInterceptor 按 W3C Trace Context 读取请求里的 traceparent，为这次处理生成一个 span，
+放进 ctx 并写回响应头。日志里的 trace_id 来自这里，同一个调用链上的服务可以用它串起日志。
+*/
+func TracingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var parent string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(util.TraceparentHeader); len(values) > 0 {
+			parent = values[0]
+		}
+	}
+
+	trace := util.NewTraceContext(parent)
+	ctx = util.ContextWithTrace(ctx, trace)
+	// 单元测试里直接调用拦截器时没有 stream，SetHeader 会失败，不影响处理
+	_ = grpc.SetHeader(ctx, metadata.Pairs(util.TraceparentHeader, trace.Traceparent()))
+
+	return handler(ctx, req)
+}
+
+// HttpTracing 是 HTTP 网关的 tracing 中间件
+func HttpTracing(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		trace := util.NewTraceContext(req.Header.Get(util.TraceparentHeader))
+		res.Header().Set(util.TraceparentHeader, trace.Traceparent())
+		handler.ServeHTTP(res, req.WithContext(util.ContextWithTrace(req.Context(), trace)))
+	})
+}
@@ -0,0 +1,28 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/hibiken/asynq"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/worker"
+)
+
+// distributeTransferAlert 转账成功之后异步通知转出方和转入方，以及这笔转账触发的余额提醒。
+// 钱已经转过去了，所以分发失败只记录日志，不影响 RPC 的返回结果。
+func (server *Server) distributeTransferAlert(ctx context.Context, result db.TransferTxResult) {
+	transferID := result.Transfer.ID
+	taskPayload := &worker.PayloadSendTransferAlert{
+		TransferID:    transferID,
+		BalanceAlerts: result.BalanceAlerts,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Queue(worker.QueueDefault),
+	}
+
+	err := server.taskDistributor.DistributeTaskSendTransferAlert(ctx, taskPayload, opts...)
+	if err != nil {
+		LoggerFrom(ctx).Error().Err(err).Int64("transfer_id", transferID).Msg("failed to distribute transfer alert")
+	}
+}
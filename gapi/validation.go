@@ -0,0 +1,96 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+)
+
+/*
+请求消息里简单的规则（长度、正则、范围、ID 必须是正数等）写在 proto 文件的 (validate.rules) 注解里，
+由 protoc-gen-validate 生成 Validate/ValidateAll 方法（pb/*.pb.validate.go）。
+依赖配置、数据库或者多个字段的规则（货币、金额、分页 token、更新掩码等）还是在 val 和各个 validateXRequest 里检查。
+
+新的 RPC 只要在 proto 里写好注解，经过 ValidationInterceptor 就会自动校验。
+HTTP 网关直接调用 Server 的方法，不经过拦截器，所以处理函数里也要调用 validateProto。
+*/
+
+// protoValidator 由 protoc-gen-validate 生成，ValidateAll 返回所有不符合规则的字段
+type protoValidator interface {
+	ValidateAll() error
+}
+
+// protoValidationError 是 protoc-gen-validate 生成的每个 XValidationError 的公共方法
+type protoValidationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// protoMultiError 是 protoc-gen-validate 生成的 XMultiError 的公共方法
+type protoMultiError interface {
+	AllErrors() []error
+}
+
+func ValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if violations := validateProto(req); violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+	return handler(ctx, req)
+}
+
+// validateProto 按 proto 里的注解校验请求，错误转换成和手写校验一样的 field violation
+func validateProto(req interface{}) []*errdetails.BadRequest_FieldViolation {
+	v, ok := req.(protoValidator)
+	if !ok {
+		return nil
+	}
+	return protoViolations("", v.ValidateAll())
+}
+
+func protoViolations(prefix string, err error) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err == nil {
+		return nil
+	}
+
+	if multi, ok := err.(protoMultiError); ok {
+		for _, err := range multi.AllErrors() {
+			violations = append(violations, protoViolations(prefix, err)...)
+		}
+		return violations
+	}
+
+	validationErr, ok := err.(protoValidationError)
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{fieldViolation(strings.TrimSuffix(prefix, "."), err)}
+	}
+
+	// 嵌套消息（比如批量转账的 items[0]）的错误在 Cause 里，字段名拼成 items[0].to_account_id
+	field := prefix + protoFieldName(validationErr.Field())
+	if cause := validationErr.Cause(); cause != nil {
+		if nested := protoViolations(field+".", cause); nested != nil {
+			return nested
+		}
+	}
+
+	return []*errdetails.BadRequest_FieldViolation{fieldViolation(field, fmt.Errorf("%s", validationErr.Reason()))}
+}
+
+// protoFieldName 把生成代码里的 Go 字段名（AccountId、Items[0]）转换回 proto 的字段名（account_id、items[0]）
+func protoFieldName(goName string) string {
+	var name strings.Builder
+	for i, r := range goName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				name.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		name.WriteRune(r)
+	}
+	return name.String()
+}
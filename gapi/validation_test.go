@@ -0,0 +1,88 @@
+package gapi
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/pb"
+)
+
+func TestValidateProto(t *testing.T) {
+	testCases := []struct {
+		name   string
+		req    interface{}
+		fields []string
+	}{
+		{
+			name: "OK",
+			req: &pb.ApplyForLoanRequest{
+				AccountId:  1,
+				Principal:  1000,
+				TermMonths: 12,
+			},
+		},
+		{
+			name: "AllViolations",
+			req: &pb.ApplyForLoanRequest{
+				AccountId:  0,
+				Principal:  -1,
+				TermMonths: 361,
+			},
+			fields: []string{"account_id", "principal", "term_months"},
+		},
+		{
+			name: "NestedMessage",
+			req: &pb.CreateBulkTransferRequest{
+				FromAccountId: 1,
+				Currency:      "USD",
+				Items: []*pb.BulkTransferItem{
+					{ToAccountId: 2, Amount: 10},
+					{ToAccountId: 3, Amount: 10, Memo: strings.Repeat("a", 256)},
+				},
+			},
+			fields: []string{"items[1].memo"},
+		},
+		{
+			name:   "RepeatedField",
+			req:    &pb.CreateBulkTransferRequest{FromAccountId: 1, Currency: "USD"},
+			fields: []string{"items"},
+		},
+		{
+			name: "IgnoreEmpty",
+			req:  &pb.UpdateNotificationPreferencesRequest{PhoneNumber: new(string)},
+		},
+		{
+			name: "OptionalField",
+			req: &pb.GetReconciliationReportRequest{
+				ReportId: func() *int64 { id := int64(-1); return &id }(),
+			},
+			fields: []string{"report_id"},
+		},
+		{
+			name: "NotValidator",
+			req:  "not a proto message",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			violations := validateProto(tc.req)
+
+			fields := make([]string, len(violations))
+			for i, violation := range violations {
+				fields[i] = violation.GetField()
+				require.NotEmpty(t, violation.GetDescription())
+			}
+			require.ElementsMatch(t, tc.fields, fields)
+		})
+	}
+}
+
+func TestProtoFieldName(t *testing.T) {
+	require.Equal(t, "account_id", protoFieldName("AccountId"))
+	require.Equal(t, "items[0]", protoFieldName("Items[0]"))
+	require.Equal(t, "id", protoFieldName("Id"))
+}
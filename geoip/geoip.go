@@ -0,0 +1,92 @@
+package geoip
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/oschwald/geoip2-golang"
+	"github.com/techschool/bank/util"
+)
+
+/*
+geoip 包根据客户端的 IP 查出所在的国家和城市，用来丰富会话和请求日志，
+让用户在 ListSessions 里能看出哪个会话是从陌生的地方登录的。
+
+数据来自 MaxMind 的 GeoLite2-City 或 GeoIP2-City 数据库文件（.mmdb），路径由 GEOIP_DB_PATH 配置。
+没有配置时 NewLocator 返回 nil，会话和日志里的国家和城市都是空的。
+*/
+
+// Location is where a client IP is located, empty fields mean unknown
+type Location struct {
+	// Country is the ISO 3166-1 alpha-2 country code, for example "US"
+	Country string
+	// City is the English name of the city
+	City string
+}
+
+// Locator looks up the location of IP addresses
+type Locator interface {
+	Lookup(ip net.IP) (Location, error)
+}
+
+// NewLocator creates the locator selected by the config, or nil if GEOIP_DB_PATH is not set
+func NewLocator(config util.Config) (Locator, error) {
+	if config.GeoIPDBPath == "" {
+		return nil, nil
+	}
+
+	locator, err := NewMaxMindLocator(config.GeoIPDBPath)
+	if err != nil {
+		return nil, err
+	}
+	return locator, nil
+}
+
+// MaxMindLocator reads locations from a MaxMind city database
+type MaxMindLocator struct {
+	reader *geoip2.Reader
+}
+
+func NewMaxMindLocator(path string) (*MaxMindLocator, error) {
+	reader, err := geoip2.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open geoip database: %w", err)
+	}
+	return &MaxMindLocator{reader: reader}, nil
+}
+
+func (locator *MaxMindLocator) Lookup(ip net.IP) (Location, error) {
+	record, err := locator.reader.City(ip)
+	if err != nil {
+		return Location{}, fmt.Errorf("failed to look up ip: %w", err)
+	}
+
+	return Location{
+		Country: record.Country.IsoCode,
+		City:    record.City.Names["en"],
+	}, nil
+}
+
+// Close closes the database file
+func (locator *MaxMindLocator) Close() error {
+	return locator.reader.Close()
+}
+
+// Locate returns the location of a client IP as stored on sessions (see util.ParseClientIP).
+// Private IPs, invalid IPs and lookup errors all give an empty location.
+func Locate(locator Locator, clientIP string) Location {
+	if locator == nil {
+		return Location{}
+	}
+
+	ip := util.ParseClientIP(clientIP)
+	if ip == nil || ip.IsPrivate() || ip.IsLoopback() {
+		return Location{}
+	}
+
+	location, err := locator.Lookup(ip)
+	if err != nil {
+		return Location{}
+	}
+	return location
+}
@@ -0,0 +1,49 @@
+package geoip
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+type fakeLocator struct {
+	locations map[string]Location
+}
+
+func (locator *fakeLocator) Lookup(ip net.IP) (Location, error) {
+	location, ok := locator.locations[ip.String()]
+	if !ok {
+		return Location{}, errors.New("not found")
+	}
+	return location, nil
+}
+
+func TestLocate(t *testing.T) {
+	locator := &fakeLocator{locations: map[string]Location{
+		"203.0.113.7": {Country: "AU", City: "Sydney"},
+		"10.0.0.1":    {Country: "US", City: "Boston"},
+	}}
+
+	require.Equal(t, Location{Country: "AU", City: "Sydney"}, Locate(locator, "203.0.113.7:54321"))
+	require.Equal(t, Location{Country: "AU", City: "Sydney"}, Locate(locator, "203.0.113.7, 10.0.0.1"))
+
+	// 内网地址、无效的地址和查不到的地址都当作未知
+	require.Empty(t, Locate(locator, "10.0.0.1"))
+	require.Empty(t, Locate(locator, "127.0.0.1:8080"))
+	require.Empty(t, Locate(locator, "unknown"))
+	require.Empty(t, Locate(locator, "198.51.100.1"))
+
+	require.Empty(t, Locate(nil, "203.0.113.7"))
+}
+
+func TestNewLocator(t *testing.T) {
+	locator, err := NewLocator(util.Config{})
+	require.NoError(t, err)
+	require.Nil(t, locator)
+
+	_, err = NewLocator(util.Config{GeoIPDBPath: t.TempDir() + "/missing.mmdb"})
+	require.Error(t, err)
+}
@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+一个查询里经常要重复查同一类数据，比如列出 10 笔转账时每笔都要查转出和转入的账户。
+loaders 把同一个请求里几毫秒内的查询合并成一次 GetAccountsByIDs / GetUsersByUsernames，
+同一个 ID 在一个请求里只查一次。每个 HTTP 请求创建新的 loaders，缓存不会跨请求，也不会返回过期的数据。
+*/
+type loaders struct {
+	accountByID    *dataloader.Loader[int64, db.Account]
+	userByUsername *dataloader.Loader[string, db.User]
+}
+
+const (
+	loaderWait          = 2 * time.Millisecond
+	loaderBatchCapacity = 100
+)
+
+func newLoaders(store db.Store) *loaders {
+	return &loaders{
+		accountByID: dataloader.NewBatchedLoader(
+			batchAccounts(store),
+			dataloader.WithWait[int64, db.Account](loaderWait),
+			dataloader.WithBatchCapacity[int64, db.Account](loaderBatchCapacity),
+		),
+		userByUsername: dataloader.NewBatchedLoader(
+			batchUsers(store),
+			dataloader.WithWait[string, db.User](loaderWait),
+			dataloader.WithBatchCapacity[string, db.User](loaderBatchCapacity),
+		),
+	}
+}
+
+// batchAccounts 返回的结果必须和 ids 一一对应，查不到的账户返回 db.ErrRecordNotFound
+func batchAccounts(store db.Store) dataloader.BatchFunc[int64, db.Account] {
+	return func(ctx context.Context, ids []int64) []*dataloader.Result[db.Account] {
+		results := make([]*dataloader.Result[db.Account], len(ids))
+
+		accounts, err := store.GetAccountsByIDs(ctx, ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[db.Account]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[int64]db.Account, len(accounts))
+		for _, account := range accounts {
+			byID[account.ID] = account
+		}
+		for i, id := range ids {
+			account, ok := byID[id]
+			if !ok {
+				results[i] = &dataloader.Result[db.Account]{Error: db.ErrRecordNotFound}
+				continue
+			}
+			results[i] = &dataloader.Result[db.Account]{Data: account}
+		}
+		return results
+	}
+}
+
+func batchUsers(store db.Store) dataloader.BatchFunc[string, db.User] {
+	return func(ctx context.Context, usernames []string) []*dataloader.Result[db.User] {
+		results := make([]*dataloader.Result[db.User], len(usernames))
+
+		users, err := store.GetUsersByUsernames(ctx, usernames)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[db.User]{Error: err}
+			}
+			return results
+		}
+
+		byUsername := make(map[string]db.User, len(users))
+		for _, user := range users {
+			byUsername[user.Username] = user
+		}
+		for i, username := range usernames {
+			user, ok := byUsername[username]
+			if !ok {
+				results[i] = &dataloader.Result[db.User]{Error: db.ErrRecordNotFound}
+				continue
+			}
+			results[i] = &dataloader.Result[db.User]{Data: user}
+		}
+		return results
+	}
+}
+
+type loadersKey struct{}
+
+// withLoaders 给每个请求创建新的 loaders
+func withLoaders(store db.Store, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx := context.WithValue(req.Context(), loadersKey{}, newLoaders(store))
+		handler.ServeHTTP(res, req.WithContext(ctx))
+	})
+}
+
+func loadersFromContext(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func loadAccount(ctx context.Context, id int64) (db.Account, error) {
+	return loadersFromContext(ctx).accountByID.Load(ctx, id)()
+}
+
+func loadUser(ctx context.Context, username string) (db.User, error) {
+	return loadersFromContext(ctx).userByUsername.Load(ctx, username)()
+}
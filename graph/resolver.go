@@ -0,0 +1,159 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/gapi"
+	"github.com/techschool/bank/graph/model"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+/*
+graph 包是给前端用的 GraphQL 接口，查询用户、账户、转账和分录，直接读 db.Store。
+schema 在 schema.graphqls 里，改完以后运行 make gqlgen 重新生成 generated.go 和 schema.resolvers.go。
+
+认证和 gRPC 网关共用 gapi.Server.HttpAuthenticate，这里只用 gapi.AuthPayload 取出当前用户。
+*/
+
+// Path is where the GraphQL endpoint is served on the HTTP gateway.
+const Path = "/graphql"
+
+// complexityLimit 限制一个查询最多能展开多少个字段，防止嵌套很深的查询拖垮数据库
+const complexityLimit = 500
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// Resolver holds the dependencies of the resolvers.
+type Resolver struct {
+	store db.Store
+}
+
+// NewHandler creates the GraphQL HTTP handler. Wrap it with gapi.Server.HttpAuthenticate to authenticate requests.
+func NewHandler(store db.Store) http.Handler {
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{store: store}}))
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+
+	return withLoaders(store, srv)
+}
+
+// 错误的 extensions.code 和网关错误响应里的 code 使用相同的名字
+func newError(code string, format string, args ...interface{}) error {
+	err := gqlerror.Errorf(format, args...)
+	err.Extensions = map[string]interface{}{"code": code}
+	return err
+}
+
+func unauthenticatedError() error {
+	return newError("UNAUTHENTICATED", "unauthorized: missing or invalid access token")
+}
+
+func permissionDeniedError(format string, args ...interface{}) error {
+	return newError("PERMISSION_DENIED", format, args...)
+}
+
+func invalidArgumentError(format string, args ...interface{}) error {
+	return newError("INVALID_ARGUMENT", format, args...)
+}
+
+func internalError(format string, args ...interface{}) error {
+	return newError("INTERNAL", format, args...)
+}
+
+func currentUser(ctx context.Context) (*token.Payload, error) {
+	payload, ok := gapi.AuthPayload(ctx)
+	if !ok {
+		return nil, unauthenticatedError()
+	}
+	return payload, nil
+}
+
+// 和 gRPC 接口的规则一样：用户本人，或者 banker 和 admin
+func canAccessUser(payload *token.Payload, username string) bool {
+	return payload.Username == username || payload.Role == util.BankerRole || payload.Role == util.AdminRole
+}
+
+// 和 gapi 的 checkAccountAccess 一样：账户的所有者本人，或者 banker
+func canAccessAccount(payload *token.Payload, account db.Account) bool {
+	return payload.Role == util.BankerRole || account.Owner == payload.Username
+}
+
+func newMoney(amount int64, currency string) *model.Money {
+	return &model.Money{
+		Currency:    currency,
+		AmountMinor: amount,
+		Amount:      util.Money{Amount: amount, Currency: currency}.Decimal(),
+	}
+}
+
+// pageParams 把 first / after 转换成查询用的 after_id 和 LIMIT（多查一条，见 util.Paginate）
+func pageParams(first *int, after *string) (int32, int64, error) {
+	pageSize := defaultPageSize
+	if first != nil {
+		pageSize = *first
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		return 0, 0, invalidArgumentError("first must be between 1 and %d", maxPageSize)
+	}
+
+	afterID := int64(0)
+	if after != nil {
+		var err error
+		afterID, err = util.DecodePageToken(*after)
+		if err != nil {
+			return 0, 0, invalidArgumentError("%s", err)
+		}
+	}
+
+	return int32(pageSize), afterID, nil
+}
+
+func pointers[T any](items []T) []*T {
+	result := make([]*T, len(items))
+	for i := range items {
+		result[i] = &items[i]
+	}
+	return result
+}
+
+// accountCurrency 给转账和分录的金额找到币种，不检查权限，只用于格式化金额
+func accountCurrency(ctx context.Context, accountID int64) (string, error) {
+	account, err := loadAccount(ctx, accountID)
+	if err != nil {
+		return "", internalError("failed to get account: %s", err)
+	}
+	return account.Currency, nil
+}
+
+// visibleAccount 返回当前用户可以看到的账户，转账另一方的账户返回 nil
+func visibleAccount(ctx context.Context, accountID int64) (*db.Account, error) {
+	payload, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := loadAccount(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, internalError("failed to get account: %s", err)
+	}
+	if !canAccessAccount(payload, account) {
+		return nil, nil
+	}
+	return &account, nil
+}
@@ -0,0 +1,289 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/gapi"
+	"github.com/techschool/bank/token"
+	"github.com/techschool/bank/util"
+)
+
+type graphqlResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// newTestHandler 和 main.go 一样用 gapi.Server.HttpAuthenticate 认证请求
+func newTestHandler(t *testing.T, store db.Store) (http.Handler, token.Maker) {
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+	}
+
+	server, err := gapi.NewServer(config, store, nil, nil, token.NewInMemoryRevocationStore())
+	require.NoError(t, err)
+
+	tokenMaker, err := token.NewMaker(config)
+	require.NoError(t, err)
+
+	return server.HttpAuthenticate(NewHandler(store)), tokenMaker
+}
+
+func doQuery(t *testing.T, handler http.Handler, accessToken string, query string) graphqlResponse {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, Path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var res graphqlResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	return res
+}
+
+func randomUser(role string) db.User {
+	return db.User{
+		Username: util.RandomOwner(),
+		FullName: util.RandomOwner(),
+		Email:    util.RandomEmail(),
+		Role:     role,
+	}
+}
+
+func TestQueryMe(t *testing.T) {
+	user := randomUser(util.DepositorRole)
+	account := db.Account{ID: 1, Owner: user.Username, Balance: 1234, Currency: util.USD}
+
+	testCases := []struct {
+		name          string
+		withToken     bool
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res graphqlResponse)
+	}{
+		{
+			name:      "OK",
+			withToken: true,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUsersByUsernames(gomock.Any(), gomock.Eq([]string{user.Username})).
+					Times(1).
+					Return([]db.User{user}, nil)
+				store.EXPECT().
+					ListAccounts(gomock.Any(), gomock.Eq(db.ListAccountsParams{
+						Owner: user.Username,
+						Limit: defaultPageSize + 1,
+					})).
+					Times(1).
+					Return([]db.Account{account}, nil)
+			},
+			checkResponse: func(t *testing.T, res graphqlResponse) {
+				require.Empty(t, res.Errors)
+
+				me := res.Data["me"].(map[string]interface{})
+				require.Equal(t, user.Username, me["username"])
+				require.Equal(t, user.Email, me["email"])
+
+				accounts := me["accounts"].(map[string]interface{})
+				require.Empty(t, accounts["nextPageToken"])
+
+				nodes := accounts["nodes"].([]interface{})
+				require.Len(t, nodes, 1)
+				balance := nodes[0].(map[string]interface{})["balance"].(map[string]interface{})
+				require.Equal(t, "12.34", balance["amount"])
+				require.Equal(t, float64(1234), balance["amountMinor"])
+				require.Equal(t, util.USD, balance["currency"])
+			},
+		},
+		{
+			name:      "Unauthenticated",
+			withToken: false,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUsersByUsernames(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res graphqlResponse) {
+				require.Nil(t, res.Data)
+				require.Len(t, res.Errors, 1)
+				require.Equal(t, "UNAUTHENTICATED", res.Errors[0].Extensions["code"])
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			handler, tokenMaker := newTestHandler(t, store)
+
+			accessToken := ""
+			if tc.withToken {
+				var err error
+				accessToken, _, err = tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+				require.NoError(t, err)
+			}
+
+			res := doQuery(t, handler, accessToken, `{
+				me {
+					username
+					email
+					accounts { nodes { id balance { amount amountMinor currency } } nextPageToken }
+				}
+			}`)
+			tc.checkResponse(t, res)
+		})
+	}
+}
+
+func TestQueryAccount(t *testing.T) {
+	user := randomUser(util.DepositorRole)
+	other := randomUser(util.DepositorRole)
+
+	account := db.Account{ID: 1, Owner: user.Username, Balance: 100, Currency: util.USD}
+	otherAccount := db.Account{ID: 2, Owner: other.Username, Balance: 50, Currency: util.USD}
+	transfers := []db.Transfer{
+		{ID: 10, FromAccountID: account.ID, ToAccountID: otherAccount.ID, Amount: 25},
+		{ID: 11, FromAccountID: otherAccount.ID, ToAccountID: account.ID, Amount: 5},
+	}
+
+	query := func(id int64) string {
+		return fmt.Sprintf(`{
+			account(id: %d) {
+				id
+				owner { username }
+				transfers(first: 1) {
+					nodes { id fromAccount { id } toAccount { id } amount { amount } }
+					nextPageToken
+				}
+			}
+		}`, id)
+	}
+
+	testCases := []struct {
+		name          string
+		accountID     int64
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res graphqlResponse)
+	}{
+		{
+			name:      "OK",
+			accountID: account.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				// 第一次只查 account(id)，转账里的两个账户合并成一次查询，account 已经在缓存里
+				gomock.InOrder(
+					store.EXPECT().
+						GetAccountsByIDs(gomock.Any(), gomock.Eq([]int64{account.ID})).
+						Times(1).
+						Return([]db.Account{account}, nil),
+					store.EXPECT().
+						GetAccountsByIDs(gomock.Any(), gomock.Eq([]int64{otherAccount.ID})).
+						Times(1).
+						Return([]db.Account{otherAccount}, nil),
+				)
+				store.EXPECT().
+					GetUsersByUsernames(gomock.Any(), gomock.Eq([]string{user.Username})).
+					Times(1).
+					Return([]db.User{user}, nil)
+				store.EXPECT().
+					ListTransfers(gomock.Any(), gomock.Eq(db.ListTransfersParams{
+						FromAccountID: account.ID,
+						ToAccountID:   account.ID,
+						Limit:         2,
+					})).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkResponse: func(t *testing.T, res graphqlResponse) {
+				require.Empty(t, res.Errors)
+
+				result := res.Data["account"].(map[string]interface{})
+				require.Equal(t, float64(account.ID), result["id"])
+				require.Equal(t, user.Username, result["owner"].(map[string]interface{})["username"])
+
+				connection := result["transfers"].(map[string]interface{})
+				require.Equal(t, util.EncodePageToken(transfers[0].ID), connection["nextPageToken"])
+
+				nodes := connection["nodes"].([]interface{})
+				require.Len(t, nodes, 1)
+				transfer := nodes[0].(map[string]interface{})
+				require.Equal(t, float64(account.ID), transfer["fromAccount"].(map[string]interface{})["id"])
+				// 对方的账户不返回
+				require.Nil(t, transfer["toAccount"])
+				require.Equal(t, "0.25", transfer["amount"].(map[string]interface{})["amount"])
+			},
+		},
+		{
+			name:      "PermissionDenied",
+			accountID: otherAccount.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Eq([]int64{otherAccount.ID})).
+					Times(1).
+					Return([]db.Account{otherAccount}, nil)
+				store.EXPECT().ListTransfers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res graphqlResponse) {
+				require.Nil(t, res.Data["account"])
+				require.Len(t, res.Errors, 1)
+				require.Equal(t, "PERMISSION_DENIED", res.Errors[0].Extensions["code"])
+			},
+		},
+		{
+			name:      "NotFound",
+			accountID: 3,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetAccountsByIDs(gomock.Any(), gomock.Eq([]int64{3})).
+					Times(1).
+					Return([]db.Account{}, nil)
+			},
+			checkResponse: func(t *testing.T, res graphqlResponse) {
+				require.Empty(t, res.Errors)
+				require.Nil(t, res.Data["account"])
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			handler, tokenMaker := newTestHandler(t, store)
+			accessToken, _, err := tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+			require.NoError(t, err)
+
+			res := doQuery(t, handler, accessToken, query(tc.accountID))
+			tc.checkResponse(t, res)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.45
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/graph/model"
+	"github.com/techschool/bank/util"
+)
+
+// Owner is the resolver for the owner field.
+func (r *accountResolver) Owner(ctx context.Context, obj *db.Account) (*db.User, error) {
+	user, err := loadUser(ctx, obj.Owner)
+	if err != nil {
+		return nil, internalError("failed to get user: %s", err)
+	}
+	return &user, nil
+}
+
+// Balance is the resolver for the balance field.
+func (r *accountResolver) Balance(ctx context.Context, obj *db.Account) (*model.Money, error) {
+	return newMoney(obj.Balance, obj.Currency), nil
+}
+
+// Transfers is the resolver for the transfers field.
+func (r *accountResolver) Transfers(ctx context.Context, obj *db.Account, first *int, after *string) (*model.TransferConnection, error) {
+	pageSize, afterID, err := pageParams(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := r.store.ListTransfers(ctx, db.ListTransfersParams{
+		FromAccountID: obj.ID,
+		ToAccountID:   obj.ID,
+		AfterID:       afterID,
+		Limit:         pageSize + 1,
+	})
+	if err != nil {
+		return nil, internalError("failed to list transfers: %s", err)
+	}
+
+	transfers, nextPageToken := util.Paginate(transfers, pageSize, func(transfer db.Transfer) int64 {
+		return transfer.ID
+	})
+	return &model.TransferConnection{
+		Nodes:         pointers(transfers),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Entries is the resolver for the entries field.
+func (r *accountResolver) Entries(ctx context.Context, obj *db.Account, first *int, after *string) (*model.EntryConnection, error) {
+	pageSize, afterID, err := pageParams(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := r.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID: obj.ID,
+		AfterID:   afterID,
+		Limit:     pageSize + 1,
+	})
+	if err != nil {
+		return nil, internalError("failed to list entries: %s", err)
+	}
+
+	entries, nextPageToken := util.Paginate(entries, pageSize, func(entry db.Entry) int64 {
+		return entry.ID
+	})
+	return &model.EntryConnection{
+		Nodes:         pointers(entries),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Account is the resolver for the account field.
+func (r *entryResolver) Account(ctx context.Context, obj *db.Entry) (*db.Account, error) {
+	account, err := loadAccount(ctx, obj.AccountID)
+	if err != nil {
+		return nil, internalError("failed to get account: %s", err)
+	}
+	return &account, nil
+}
+
+// Amount is the resolver for the amount field.
+func (r *entryResolver) Amount(ctx context.Context, obj *db.Entry) (*model.Money, error) {
+	currency, err := accountCurrency(ctx, obj.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	return newMoney(obj.Amount, currency), nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*db.User, error) {
+	payload, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := loadUser(ctx, payload.Username)
+	if err != nil {
+		return nil, internalError("failed to get user: %s", err)
+	}
+	return &user, nil
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, username string) (*db.User, error) {
+	payload, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canAccessUser(payload, username) {
+		return nil, permissionDeniedError("cannot get other user's info")
+	}
+
+	user, err := loadUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, internalError("failed to get user: %s", err)
+	}
+	return &user, nil
+}
+
+// Account is the resolver for the account field.
+func (r *queryResolver) Account(ctx context.Context, id int64) (*db.Account, error) {
+	payload, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := loadAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, internalError("failed to get account: %s", err)
+	}
+	if !canAccessAccount(payload, account) {
+		return nil, permissionDeniedError("account doesn't belong to the authenticated user")
+	}
+	return &account, nil
+}
+
+// Transfer is the resolver for the transfer field.
+func (r *queryResolver) Transfer(ctx context.Context, id int64) (*db.Transfer, error) {
+	payload, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := r.store.GetTransfer(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, internalError("failed to get transfer: %s", err)
+	}
+
+	// 转出或者转入的任意一个账户属于当前用户就可以查看
+	for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+		account, err := loadAccount(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				continue
+			}
+			return nil, internalError("failed to get account: %s", err)
+		}
+		if canAccessAccount(payload, account) {
+			return &transfer, nil
+		}
+	}
+	return nil, permissionDeniedError("transfer doesn't belong to the authenticated user")
+}
+
+// FromAccount is the resolver for the fromAccount field.
+func (r *transferResolver) FromAccount(ctx context.Context, obj *db.Transfer) (*db.Account, error) {
+	return visibleAccount(ctx, obj.FromAccountID)
+}
+
+// ToAccount is the resolver for the toAccount field.
+func (r *transferResolver) ToAccount(ctx context.Context, obj *db.Transfer) (*db.Account, error) {
+	return visibleAccount(ctx, obj.ToAccountID)
+}
+
+// Amount is the resolver for the amount field.
+func (r *transferResolver) Amount(ctx context.Context, obj *db.Transfer) (*model.Money, error) {
+	// 转账两边的币种相同，转出账户被删除时用转入账户的币种
+	currency, err := accountCurrency(ctx, obj.FromAccountID)
+	if err != nil {
+		currency, err = accountCurrency(ctx, obj.ToAccountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newMoney(obj.Amount, currency), nil
+}
+
+// Accounts is the resolver for the accounts field.
+func (r *userResolver) Accounts(ctx context.Context, obj *db.User, first *int, after *string) (*model.AccountConnection, error) {
+	pageSize, afterID, err := pageParams(first, after)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := r.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:   obj.Username,
+		AfterID: afterID,
+		Limit:   pageSize + 1,
+	})
+	if err != nil {
+		return nil, internalError("failed to list accounts: %s", err)
+	}
+
+	accounts, nextPageToken := util.Paginate(accounts, pageSize, func(account db.Account) int64 {
+		return account.ID
+	})
+	return &model.AccountConnection{
+		Nodes:         pointers(accounts),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// Account returns AccountResolver implementation.
+func (r *Resolver) Account() AccountResolver { return &accountResolver{r} }
+
+// Entry returns EntryResolver implementation.
+func (r *Resolver) Entry() EntryResolver { return &entryResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Transfer returns TransferResolver implementation.
+func (r *Resolver) Transfer() TransferResolver { return &transferResolver{r} }
+
+// User returns UserResolver implementation.
+func (r *Resolver) User() UserResolver { return &userResolver{r} }
+
+type accountResolver struct{ *Resolver }
+type entryResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type transferResolver struct{ *Resolver }
+type userResolver struct{ *Resolver }
@@ -0,0 +1,70 @@
+package i18n
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+/*
+i18n 决定一个请求或者一个用户用哪种语言，并翻译发给客户端的错误信息。
+
+语言的来源按优先级：
+  - API 的错误信息：请求的 Accept-Language 头；
+  - 邮件：users.locale，注册时按注册请求的 Accept-Language 设置，之后用户可以在 UpdateUser 里修改。
+
+不支持的语言都退回到 DefaultLocale。邮件正文的翻译在 mail/templates 里，这里只管错误信息，见 messages.go。
+*/
+
+// Supported locales
+const (
+	English = "en"
+	Chinese = "zh"
+
+	DefaultLocale = English
+)
+
+// locales 和 tags 一一对应，第一个是匹配不到时的默认语言
+var (
+	locales = []string{English, Chinese}
+	tags    = []language.Tag{language.English, language.Chinese}
+	matcher = language.NewMatcher(tags)
+)
+
+// Locales returns all supported locales, the default one first
+func Locales() []string {
+	return append([]string(nil), locales...)
+}
+
+// IsSupported reports whether locale is one of the supported locales
+func IsSupported(locale string) bool {
+	for _, supported := range locales {
+		if locale == supported {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Match 返回最合适的语言，每个参数可以是一个 Accept-Language 头（"zh-CN,zh;q=0.9,en;q=0.8"）
+或者一个语言标签（"zh"、"en-US"），按顺序使用第一个能匹配上的，都匹配不上时返回 DefaultLocale。
+*/
+func Match(preferences ...string) string {
+	for _, preference := range preferences {
+		if strings.TrimSpace(preference) == "" {
+			continue
+		}
+
+		desired, _, err := language.ParseAcceptLanguage(preference)
+		if err != nil || len(desired) == 0 {
+			continue
+		}
+
+		_, index, confidence := matcher.Match(desired...)
+		if confidence != language.No {
+			return locales[index]
+		}
+	}
+	return DefaultLocale
+}
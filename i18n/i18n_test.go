@@ -0,0 +1,60 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		preferences []string
+		locale      string
+	}{
+		{nil, DefaultLocale},
+		{[]string{""}, DefaultLocale},
+		{[]string{"zh-CN,zh;q=0.9,en;q=0.8"}, Chinese},
+		{[]string{"en-US,en;q=0.9,zh;q=0.8"}, English},
+		{[]string{"zh-TW"}, Chinese},
+		{[]string{"fr-FR"}, DefaultLocale},
+		{[]string{"fr-FR", "zh"}, Chinese},
+		{[]string{"not a language;;", "zh"}, Chinese},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.locale, Match(tc.preferences...), "preferences %q", tc.preferences)
+	}
+}
+
+func TestIsSupported(t *testing.T) {
+	require.True(t, IsSupported(English))
+	require.True(t, IsSupported(Chinese))
+	require.False(t, IsSupported("fr"))
+	require.False(t, IsSupported(""))
+	require.Equal(t, DefaultLocale, Locales()[0])
+}
+
+func TestTranslate(t *testing.T) {
+	testCases := []struct {
+		locale   string
+		msg      string
+		expected string
+	}{
+		{Chinese, "invalid parameters", "参数无效"},
+		{Chinese, "is not a valid email address", "不是有效的电子邮件地址"},
+		{Chinese, "must contain from 3-100 characters", "长度必须在 3-100 个字符之间"},
+		{Chinese, "is not a valid USD amount", "不是有效的 USD 金额"},
+		{Chinese, "value must be inside range [5, 50]", "必须在 5 到 50 之间"},
+		{Chinese, "value must be greater than 0", "必须大于 0"},
+		{Chinese, "value must be greater than or equal to 1", "必须大于或等于 1"},
+		{Chinese, `value does not match regex pattern "^[a-z0-9_]+$"`, `格式不正确，必须匹配 "^[a-z0-9_]+$"`},
+		// 没有翻译的信息保持原样
+		{Chinese, "account not found", "account not found"},
+		{English, "must contain from 3-100 characters", "must contain from 3-100 characters"},
+		{"fr", "invalid parameters", "invalid parameters"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, Translate(tc.locale, tc.msg))
+	}
+}
@@ -0,0 +1,171 @@
+package i18n
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+/*
+错误信息在代码里都是英文的，val 包、protoc-gen-validate 生成的校验和 gapi 里的 fmt.Errorf 都是这样，
+所以翻译放在返回给客户端之前的最后一步（gRPC 拦截器和 HTTP 网关的错误处理），不用把语言传进每一个校验函数。
+
+messages 的 key 是英文的格式字符串，翻译可以用 %[n]d 调整参数的顺序。
+Translate 先找完全相同的 key，再用格式字符串生成的正则匹配带参数的信息，取出参数之后用目标语言重新格式化。
+格式字符串里只支持 %d 和 %s。
+*/
+
+var messages = map[string]map[string]string{
+	Chinese: {
+		// gapi
+		"invalid parameters": "参数无效",
+
+		// val
+		"must contain from %d-%d characters":                      "长度必须在 %d-%d 个字符之间",
+		"must contain only letters or spaces":                     "只能包含字母或空格",
+		"is not a valid email address":                            "不是有效的电子邮件地址",
+		"is not a valid page token":                               "不是有效的分页 token",
+		"is not a supported currency":                             "不是支持的货币",
+		"must be a positive integer":                              "必须是正整数",
+		"cannot be used together with amount":                     "不能和 amount 同时使用",
+		"cannot be parsed without a supported currency":           "没有支持的货币时无法解析",
+		"is not a valid %s amount":                                "不是有效的 %s 金额",
+		"must be a positive amount":                               "必须是正数金额",
+		"must contain exactly 9 digits":                           "必须是 9 位数字",
+		"has an invalid checksum":                                 "校验位不正确",
+		"is not a supported category":                             "不是支持的分类",
+		"must contain from 12-19 digits":                          "必须是 12-19 位数字",
+		"is not a valid card number":                              "不是有效的卡号",
+		"unsupported KYC document type":                           "不支持的 KYC 证件类型",
+		"is not a supported locale":                               "不是支持的语言",
+		"must not exceed the %d supported currencies":             "不能超过支持的 %d 种货币",
+		"must be different from from_account_id":                  "不能和 from_account_id 相同",
+		"must be different from payer_account_id":                 "不能和 payer_account_id 相同",
+		"must be empty when the document is approved":             "证件审核通过时必须为空",
+		"must not be empty":                                       "不能为空",
+		"must not be larger than %d bytes":                        "不能超过 %d 字节",
+		"requires a phone number":                                 "需要先设置手机号码",
+		"requires a device token":                                 "需要先注册设备",
+		"cannot update field \"%s\"":                              "不能修改字段 \"%s\"",
+		"field \"%s\" is listed more than once":                   "字段 \"%s\" 重复出现",
+		"value is required":                                       "不能为空",
+		"value must be greater than %d":                           "必须大于 %d",
+		"value must be greater than or equal to %d":               "必须大于或等于 %d",
+		"value must be inside range [%d, %d]":                     "必须在 %d 到 %d 之间",
+		"value must be inside range (%d, %d]":                     "必须大于 %d 且不超过 %d",
+		"value length must be %d runes":                           "长度必须是 %d 个字符",
+		"value length must be at most %d runes":                   "长度不能超过 %d 个字符",
+		"value length must be between %d and %d runes, inclusive": "长度必须在 %d 到 %d 个字符之间",
+		"value must contain between %d and %d items, inclusive":   "必须包含 %d 到 %d 项",
+		"value must be a valid email address":                     "不是有效的电子邮件地址",
+		"value must be a valid UUID":                              "不是有效的 UUID",
+		"value does not match regex pattern \"%s\"":               "格式不正确，必须匹配 \"%s\"",
+	},
+}
+
+type pattern struct {
+	format string
+	regexp *regexp.Regexp
+	// verbs 是每个参数的类型，'d' 或者 's'
+	verbs []byte
+}
+
+var (
+	printers = make(map[string]*message.Printer)
+	// exact 是没有参数的信息，patterns 是带参数的信息
+	exact    = make(map[string]bool)
+	patterns []pattern
+)
+
+var verbRegexp = regexp.MustCompile(`%[ds]`)
+
+func init() {
+	builder := catalog.NewBuilder(catalog.Fallback(language.English))
+	formats := make(map[string]bool)
+
+	for i, locale := range locales {
+		for format, translation := range messages[locale] {
+			if err := builder.SetString(tags[i], format, translation); err != nil {
+				panic(fmt.Sprintf("invalid %s translation of %q: %s", locale, format, err))
+			}
+			formats[format] = true
+		}
+		printers[locale] = message.NewPrinter(tags[i], message.Catalog(builder))
+	}
+
+	for format := range formats {
+		if !verbRegexp.MatchString(format) {
+			exact[format] = true
+			continue
+		}
+		patterns = append(patterns, compilePattern(format))
+	}
+}
+
+// compilePattern 把 "must contain from %d-%d characters" 变成 ^must contain from (-?\d+)-(-?\d+) characters$
+func compilePattern(format string) pattern {
+	p := pattern{format: format}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+	last := 0
+	for _, loc := range verbRegexp.FindAllStringIndex(format, -1) {
+		expr.WriteString(regexp.QuoteMeta(format[last:loc[0]]))
+		verb := format[loc[0]+1]
+		if verb == 'd' {
+			expr.WriteString(`(-?\d+)`)
+		} else {
+			expr.WriteString(`(.+?)`)
+		}
+		p.verbs = append(p.verbs, verb)
+		last = loc[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(format[last:]))
+	expr.WriteString("$")
+
+	p.regexp = regexp.MustCompile(expr.String())
+	return p
+}
+
+func (p pattern) match(msg string) ([]any, bool) {
+	groups := p.regexp.FindStringSubmatch(msg)
+	if groups == nil {
+		return nil, false
+	}
+
+	args := make([]any, len(p.verbs))
+	for i, verb := range p.verbs {
+		args[i] = groups[i+1]
+		if verb == 'd' {
+			n, err := strconv.ParseInt(groups[i+1], 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			args[i] = n
+		}
+	}
+	return args, true
+}
+
+// Translate translates an English message to locale, messages without a translation are returned unchanged
+func Translate(locale string, msg string) string {
+	printer, ok := printers[locale]
+	if !ok || locale == English {
+		return msg
+	}
+
+	if exact[msg] {
+		return printer.Sprintf(msg)
+	}
+	for _, p := range patterns {
+		if args, ok := p.match(msg); ok {
+			return printer.Sprintf(p.format, args...)
+		}
+	}
+	return msg
+}
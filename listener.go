@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+// shutdownTimeout 是收到 SIGTERM 之后等待正在处理的请求的最长时间，超过之后直接断开连接
+const shutdownTimeout = 30 * time.Second
+
+/*
+listen 在配置的所有地址上监听（见 util.ParseListenAddresses），有一个失败就关闭已经创建的监听器。
+Unix socket 的文件在监听器关闭的时候由 net 包删除；进程被 kill -9 之类的方式结束时文件会留下来，
+所以监听之前先删掉没有进程在用的旧文件，还有进程在监听的话报错，避免两个实例抢同一个 socket。
+*/
+func listen(value string) ([]net.Listener, error) {
+	addresses, err := util.ParseListenAddresses(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var listeners []net.Listener
+	for _, address := range addresses {
+		if address.Network == "unix" {
+			err = removeStaleSocket(address.Address)
+		}
+		var listener net.Listener
+		if err == nil {
+			listener, err = net.Listen(address.Network, address.Address)
+		}
+		if err != nil {
+			for _, listener := range listeners {
+				listener.Close()
+			}
+			return nil, fmt.Errorf("cannot listen on %s: %w", address, err)
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// removeStaleSocket removes the socket file left by a previous process, it fails if the socket is still in use
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode().Type() != os.ModeSocket {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is in use by another process", path)
+	}
+	return os.Remove(path)
+}
+
+/*
+serveListeners 在每个监听器上运行 serve，直到 ctx 结束或者有一个 serve 出错，然后调用 shutdown 停止服务器，
+等所有的 serve 都返回之后再返回。shutdown 会关闭所有的监听器，这之后 serve 返回的错误
+（http.ErrServerClosed 之类）不算错误，只返回 shutdown 之前的错误。
+*/
+func serveListeners(ctx context.Context, listeners []net.Listener, serve func(net.Listener) error, shutdown func()) error {
+	errs := make(chan error, len(listeners))
+	for _, listener := range listeners {
+		go func(listener net.Listener) {
+			errs <- serve(listener)
+		}(listener)
+	}
+
+	var err error
+	remaining := len(listeners)
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+		remaining--
+	}
+
+	shutdown()
+	for ; remaining > 0; remaining-- {
+		<-errs
+	}
+	return err
+}
+
+// listenerAddresses returns the addresses of the listeners for logging
+func listenerAddresses(listeners []net.Listener) []string {
+	addresses := make([]string, 0, len(listeners))
+	for _, listener := range listeners {
+		addresses = append(addresses, listener.Addr().Network()+"://"+listener.Addr().String())
+	}
+	return addresses
+}
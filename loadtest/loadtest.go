@@ -0,0 +1,210 @@
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+loadtest 用多个 worker 并发地执行同一个操作（比如 TransferTx），统计延迟分布和错误，
+用来验证 TransferTx 的加锁顺序在高并发、热点账户的情况下会不会频繁死锁或者重试。
+
+Run 不关心操作具体做什么，场景见 scenario.go。
+报告里的 TxRetries 是这段时间里 db 包的事务重试次数（db.TxRetryStats），
+所以只有在同一个进程里直接访问数据库时才有意义。
+*/
+
+// Options controls the concurrency and the length of a run
+type Options struct {
+	Workers int
+	// Duration stops the run after this long, 0 means only Requests is used
+	Duration time.Duration
+	// Requests stops the run after this many operations, 0 means only Duration is used
+	Requests int
+}
+
+func (opts Options) validate() error {
+	if opts.Workers <= 0 {
+		return errors.New("number of workers must be positive")
+	}
+	if opts.Duration < 0 || opts.Requests < 0 {
+		return errors.New("duration and number of requests must not be negative")
+	}
+	if opts.Duration == 0 && opts.Requests == 0 {
+		return errors.New("either duration or number of requests is required")
+	}
+	return nil
+}
+
+// Operation is one request of the load test
+type Operation func(ctx context.Context) error
+
+// Report summarizes a run
+type Report struct {
+	Operations int
+	Errors     int
+	// ErrorCodes counts errors by Postgres error code, "other" for errors without a code
+	ErrorCodes map[string]int
+	// TxRetries counts transaction retries by Postgres error code during the run, see db.TxRetryStats
+	TxRetries map[string]int64
+	Elapsed   time.Duration
+	P50       time.Duration
+	P95       time.Duration
+	P99       time.Duration
+	Max       time.Duration
+}
+
+// Throughput is the number of operations per second
+func (report Report) Throughput() float64 {
+	if report.Elapsed <= 0 {
+		return 0
+	}
+	return float64(report.Operations) / report.Elapsed.Seconds()
+}
+
+// Print writes a human readable summary
+func (report Report) Print(w io.Writer) {
+	fmt.Fprintf(w, "operations: %d, errors: %d, elapsed: %s, throughput: %.1f ops/s\n",
+		report.Operations, report.Errors, report.Elapsed.Truncate(time.Millisecond), report.Throughput())
+	fmt.Fprintf(w, "latency p50: %s, p95: %s, p99: %s, max: %s\n", report.P50, report.P95, report.P99, report.Max)
+	for _, code := range sortedKeys(report.ErrorCodes) {
+		fmt.Fprintf(w, "error %s: %d\n", code, report.ErrorCodes[code])
+	}
+	for _, code := range sortedKeys(report.TxRetries) {
+		fmt.Fprintf(w, "tx retry %s: %d\n", code, report.TxRetries[code])
+	}
+}
+
+/*
+Run 启动 opts.Workers 个 goroutine 不停地执行 op，直到运行了 opts.Duration、
+一共执行了 opts.Requests 次或者 ctx 被取消。
+每个 worker 自己记录延迟，结束之后再合并排序计算百分位，不会因为统计本身引入锁竞争。
+*/
+func Run(ctx context.Context, opts Options, op Operation) (Report, error) {
+	if err := opts.validate(); err != nil {
+		return Report{}, err
+	}
+
+	if opts.Duration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Duration)
+		defer cancel()
+	}
+
+	// 按次数运行时，每个 worker 从 requests 里领一个名额
+	var requests chan struct{}
+	if opts.Requests > 0 {
+		requests = make(chan struct{}, opts.Requests)
+		for i := 0; i < opts.Requests; i++ {
+			requests <- struct{}{}
+		}
+		close(requests)
+	}
+
+	type workerResult struct {
+		latencies []time.Duration
+		errors    map[string]int
+	}
+	results := make([]workerResult, opts.Workers)
+
+	retriesBefore := db.TxRetryStats()
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func(result *workerResult) {
+			defer wg.Done()
+			result.errors = make(map[string]int)
+
+			for ctx.Err() == nil {
+				if requests != nil {
+					if _, ok := <-requests; !ok {
+						return
+					}
+				}
+
+				opStart := time.Now()
+				err := op(ctx)
+				// 运行时间到了，正在执行的操作被取消，不算错误
+				if err != nil && ctx.Err() != nil {
+					return
+				}
+				result.latencies = append(result.latencies, time.Since(opStart))
+				if err != nil {
+					result.errors[errorCode(err)]++
+				}
+			}
+		}(&results[i])
+	}
+	wg.Wait()
+
+	report := Report{
+		Elapsed:    time.Since(start),
+		ErrorCodes: make(map[string]int),
+		TxRetries:  make(map[string]int64),
+	}
+
+	var latencies []time.Duration
+	for _, result := range results {
+		latencies = append(latencies, result.latencies...)
+		for code, n := range result.errors {
+			report.ErrorCodes[code] += n
+			report.Errors += n
+		}
+	}
+	report.Operations = len(latencies)
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	report.P50 = percentile(latencies, 50)
+	report.P95 = percentile(latencies, 95)
+	report.P99 = percentile(latencies, 99)
+	if len(latencies) > 0 {
+		report.Max = latencies[len(latencies)-1]
+	}
+
+	for code, n := range db.TxRetryStats() {
+		if diff := n - retriesBefore[code]; diff > 0 {
+			report.TxRetries[code] = diff
+		}
+	}
+
+	return report, nil
+}
+
+// percentile uses the nearest-rank method on sorted latencies
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func errorCode(err error) string {
+	if code := db.ErrorCode(err); code != "" {
+		return code
+	}
+	return "other"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,119 @@
+package loadtest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+func TestRunRequests(t *testing.T) {
+	var calls int32
+	report, err := Run(context.Background(), Options{Workers: 4, Requests: 100}, func(ctx context.Context) error {
+		n := atomic.AddInt32(&calls, 1)
+		switch {
+		case n%10 == 0:
+			return &pgconn.PgError{Code: db.DeadlockDetected}
+		case n%25 == 0:
+			return errors.New("connection reset")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 100, report.Operations)
+	require.Equal(t, int32(100), calls)
+	require.Equal(t, 10, report.ErrorCodes[db.DeadlockDetected])
+	require.Equal(t, 2, report.ErrorCodes["other"])
+	require.Equal(t, 12, report.Errors)
+	require.LessOrEqual(t, report.P50, report.P95)
+	require.LessOrEqual(t, report.P95, report.P99)
+	require.LessOrEqual(t, report.P99, report.Max)
+
+	var out bytes.Buffer
+	report.Print(&out)
+	require.Contains(t, out.String(), "operations: 100, errors: 12")
+	require.Contains(t, out.String(), "error 40P01: 10")
+}
+
+func TestRunDuration(t *testing.T) {
+	report, err := Run(context.Background(), Options{Workers: 2, Duration: 50 * time.Millisecond}, func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+			return nil
+		}
+	})
+	require.NoError(t, err)
+	require.Positive(t, report.Operations)
+	// 时间到了被取消的操作不算错误
+	require.Zero(t, report.Errors)
+	require.GreaterOrEqual(t, report.Elapsed, 50*time.Millisecond)
+	require.Positive(t, report.Throughput())
+}
+
+func TestRunInvalidOptions(t *testing.T) {
+	op := func(ctx context.Context) error { return nil }
+	for _, opts := range []Options{
+		{Workers: 0, Requests: 1},
+		{Workers: 1},
+		{Workers: 1, Requests: -1},
+	} {
+		_, err := Run(context.Background(), opts, op)
+		require.Error(t, err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		latencies[i] = time.Duration(i+1) * time.Millisecond
+	}
+	require.Equal(t, 50*time.Millisecond, percentile(latencies, 50))
+	require.Equal(t, 95*time.Millisecond, percentile(latencies, 95))
+	require.Equal(t, 99*time.Millisecond, percentile(latencies, 99))
+	require.Equal(t, time.Duration(0), percentile(nil, 50))
+	require.Equal(t, time.Second, percentile([]time.Duration{time.Second}, 99))
+}
+
+func TestTransferScenario(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(2).Return(db.User{Username: "loadtest_user"}, nil)
+	var accountID int64
+	store.EXPECT().
+		CreateAccount(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+			accountID++
+			return db.Account{ID: accountID}, nil
+		})
+	store.EXPECT().
+		TransferTx(gomock.Any(), gomock.Any()).
+		Times(10).
+		DoAndReturn(func(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+			require.NotEqual(t, arg.FromAccountID, arg.ToAccountID)
+			return db.TransferTxResult{}, nil
+		})
+
+	op, err := NewScenario(context.Background(), store, ScenarioTransfer, 2)
+	require.NoError(t, err)
+
+	report, err := Run(context.Background(), Options{Workers: 2, Requests: 10}, op)
+	require.NoError(t, err)
+	require.Equal(t, 10, report.Operations)
+
+	_, err = NewScenario(context.Background(), store, ScenarioTransfer, 1)
+	require.Error(t, err)
+	_, err = NewScenario(context.Background(), store, "unknown", 2)
+	require.Error(t, err)
+}
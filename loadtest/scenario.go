@@ -0,0 +1,102 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/util"
+)
+
+// Scenarios supported by NewScenario
+const (
+	// ScenarioTransfer transfers between a fixed set of accounts, fewer accounts means more lock contention
+	ScenarioTransfer = "transfer"
+	// ScenarioCreateUser creates a new user in every operation
+	ScenarioCreateUser = "create_user"
+)
+
+// UsernamePrefix is the prefix of all users created by the load test
+const UsernamePrefix = "loadtest_"
+
+// loadTestBalance 足够大，压测期间转账不会因为余额不足而失败
+const loadTestBalance = 1_000_000_000
+
+/*
+NewScenario 准备场景需要的数据并返回每次要执行的操作。
+transfer 场景先创建 accounts 个同一币种的账户，然后每次在其中随机两个账户之间转 1 个最小单位：
+accounts 为 2 时所有转账都在同一对账户上来回，是死锁最容易出现的情况。
+*/
+func NewScenario(ctx context.Context, store db.Store, name string, accounts int) (Operation, error) {
+	// bcrypt 很慢，所有用户共用同一个哈希，压测的是数据库而不是密码哈希
+	hashedPassword, err := util.HashPassword(util.RandomString(12))
+	if err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case ScenarioTransfer:
+		if accounts < 2 {
+			return nil, fmt.Errorf("transfer scenario needs at least 2 accounts")
+		}
+		return newTransferScenario(ctx, store, hashedPassword, accounts)
+	case ScenarioCreateUser:
+		return func(ctx context.Context) error {
+			_, err := createUser(ctx, store, hashedPassword)
+			return err
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown scenario %q, must be one of %s, %s", name, ScenarioTransfer, ScenarioCreateUser)
+	}
+}
+
+func newTransferScenario(ctx context.Context, store db.Store, hashedPassword string, n int) (Operation, error) {
+	accountIDs := make([]int64, n)
+	for i := range accountIDs {
+		user, err := createUser(ctx, store, hashedPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		account, err := store.CreateAccount(ctx, db.CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  loadTestBalance,
+			Currency: util.USD,
+			Number:   util.RandomAccountNumber(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create account: %w", err)
+		}
+		accountIDs[i] = account.ID
+	}
+
+	return func(ctx context.Context) error {
+		i, j := rand.Intn(len(accountIDs)), rand.Intn(len(accountIDs)-1)
+		if j >= i {
+			j++
+		}
+		_, err := store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID: accountIDs[i],
+			ToAccountID:   accountIDs[j],
+			Amount:        1,
+		})
+		return err
+	}, nil
+}
+
+func createUser(ctx context.Context, store db.Store, hashedPassword string) (db.User, error) {
+	username := UsernamePrefix + util.RandomString(12)
+	user, err := store.CreateUser(ctx, db.CreateUserParams{
+		Username:       username,
+		HashedPassword: hashedPassword,
+		FullName:       "Load Test",
+		Email:          username + "@email.com",
+		Locale:         i18n.DefaultLocale,
+	})
+	if err != nil {
+		return user, fmt.Errorf("cannot create user: %w", err)
+	}
+	return user, nil
+}
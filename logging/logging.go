@@ -0,0 +1,87 @@
+package logging
+
+import (
+	"io"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/util"
+)
+
+/*
+logging 根据配置设置全局的 zerolog 日志，每个环境的默认值不同：
+
+	LOG_FORMAT    json 或 console，没有配置时开发环境用方便阅读的彩色 console 格式，其他环境用 JSON
+	LOG_LEVEL     trace、debug、info、warn 或 error，没有配置时开发环境用 debug，其他环境用 info
+	LOG_SAMPLING  info 及以下级别的日志每 N 条只保留 1 条，0 或 1 不采样；warn 和 error 永远不会被丢掉
+	LOG_CALLER    每一行日志带上输出它的文件和行号
+
+修改 app.env 之后只有日志级别会热更新（见 SetLevel），格式、采样和 caller 需要重启。
+
+Setup 之后没有绑定 logger 的 ctx 用 log.Ctx(ctx) 拿到的也是全局的 logger，
+所以业务代码统一用 log.Ctx(ctx) 记录日志，请求里绑定了 logger 时自动带上请求的字段。
+*/
+
+// Values of LOG_FORMAT
+const (
+	FormatJSON    = "json"
+	FormatConsole = "console"
+)
+
+// Setup replaces the global logger with one built from the config and sets the global level
+func Setup(config util.Config, out io.Writer) {
+	log.Logger = New(config, out)
+	SetLevel(config)
+	zerolog.DefaultContextLogger = &log.Logger
+}
+
+// New returns a logger that writes to out in the format, sampling and caller setting of the config
+func New(config util.Config, out io.Writer) zerolog.Logger {
+	if Format(config) == FormatConsole {
+		out = zerolog.ConsoleWriter{Out: out}
+	}
+
+	logger := zerolog.New(out).With().Timestamp().Logger()
+	if config.LogCaller {
+		logger = logger.With().Caller().Logger()
+	}
+	if config.LogSampling > 1 {
+		sampler := &zerolog.BasicSampler{N: uint32(config.LogSampling)}
+		logger = logger.Sample(zerolog.LevelSampler{
+			TraceSampler: sampler,
+			DebugSampler: sampler,
+			InfoSampler:  sampler,
+		})
+	}
+	return logger
+}
+
+// SetLevel sets the global level to LOG_LEVEL, or the default level of the environment when it's empty
+func SetLevel(config util.Config) {
+	zerolog.SetGlobalLevel(Level(config))
+}
+
+// Level returns the level configured by LOG_LEVEL, or the default level of the environment when it's empty
+func Level(config util.Config) zerolog.Level {
+	if config.LogLevel != "" {
+		// LOG_LEVEL 已经在 config.Validate 里校验过了
+		if level, err := zerolog.ParseLevel(config.LogLevel); err == nil {
+			return level
+		}
+	}
+	if config.DebugEnabled() {
+		return zerolog.DebugLevel
+	}
+	return zerolog.InfoLevel
+}
+
+// Format returns the format configured by LOG_FORMAT, or the default format of the environment when it's empty
+func Format(config util.Config) string {
+	if config.LogFormat != "" {
+		return config.LogFormat
+	}
+	if config.DebugEnabled() {
+		return FormatConsole
+	}
+	return FormatJSON
+}
@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestDefaultsPerEnvironment(t *testing.T) {
+	development := util.Config{Environment: util.DevelopmentEnvironment}
+	require.Equal(t, FormatConsole, Format(development))
+	require.Equal(t, zerolog.DebugLevel, Level(development))
+
+	production := util.Config{Environment: util.ProductionEnvironment}
+	require.Equal(t, FormatJSON, Format(production))
+	require.Equal(t, zerolog.InfoLevel, Level(production))
+
+	production.LogFormat = FormatConsole
+	production.LogLevel = "warn"
+	require.Equal(t, FormatConsole, Format(production))
+	require.Equal(t, zerolog.WarnLevel, Level(production))
+}
+
+func TestNewJSONWithCaller(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(util.Config{Environment: util.ProductionEnvironment, LogCaller: true}, &buf)
+	logger.Info().Str("username", "alice").Msg("hello")
+
+	var line map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+	require.Equal(t, "hello", line["message"])
+	require.Equal(t, "alice", line["username"])
+	require.Contains(t, line["caller"], "logging_test.go")
+	require.NotEmpty(t, line["time"])
+}
+
+func TestNewConsole(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(util.Config{Environment: util.DevelopmentEnvironment}, &buf)
+	logger.Info().Msg("hello")
+
+	require.Contains(t, buf.String(), "hello")
+	require.False(t, json.Valid(buf.Bytes()))
+}
+
+func TestNewSampling(t *testing.T) {
+	var buf bytes.Buffer
+	logger := New(util.Config{Environment: util.ProductionEnvironment, LogSampling: 5}, &buf)
+	for i := 0; i < 10; i++ {
+		logger.Info().Msg("sampled")
+		logger.Error().Msg("kept")
+	}
+
+	// info 每 5 条保留 1 条，error 全部保留
+	require.Equal(t, 2, strings.Count(buf.String(), "sampled"))
+	require.Equal(t, 10, strings.Count(buf.String(), "kept"))
+}
+
+func TestSetupContextLogger(t *testing.T) {
+	defaultLogger := log.Logger
+	defaultContextLogger := zerolog.DefaultContextLogger
+	defaultLevel := zerolog.GlobalLevel()
+	defer func() {
+		log.Logger = defaultLogger
+		zerolog.DefaultContextLogger = defaultContextLogger
+		zerolog.SetGlobalLevel(defaultLevel)
+	}()
+
+	var buf bytes.Buffer
+	Setup(util.Config{Environment: util.ProductionEnvironment, LogLevel: "debug"}, &buf)
+	require.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
+
+	// ctx 里没有绑定 logger 时 log.Ctx 用全局的 logger
+	log.Ctx(context.Background()).Debug().Msg("from context")
+	require.Contains(t, buf.String(), "from context")
+}
@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/techschool/bank/storage"
+)
+
+/*
+BlobAttachmentSender 让附件从 blob store 读取，而不是从本机的文件系统读取，
+这样生成附件的服务（比如 worker 生成的对账单）和发送邮件的进程不需要在同一台机器上。
+
+attachFiles 里是 blob 的 key，发送之前先下载到一个临时目录，再交给真正的 EmailSender 发送，
+发送完删除临时目录。附件的文件名是 key 的最后一段。
+*/
+type BlobAttachmentSender struct {
+	sender EmailSender
+	store  storage.BlobStore
+}
+
+// NewBlobAttachmentSender wraps an EmailSender so that attachFiles are read from the blob store
+func NewBlobAttachmentSender(sender EmailSender, store storage.BlobStore) EmailSender {
+	return &BlobAttachmentSender{
+		sender: sender,
+		store:  store,
+	}
+}
+
+func (sender *BlobAttachmentSender) SendEmail(
+	subject string,
+	content string,
+	textContent string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	if len(attachFiles) == 0 {
+		return sender.sender.SendEmail(subject, content, textContent, to, cc, bcc, nil)
+	}
+
+	dir, err := os.MkdirTemp("", "attachments-*")
+	if err != nil {
+		return fmt.Errorf("failed to create attachment directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := make([]string, len(attachFiles))
+	for i, key := range attachFiles {
+		// 每个附件放在单独的子目录里，不同 key 的文件名相同时不会互相覆盖
+		files[i] = filepath.Join(dir, fmt.Sprint(i), path.Base(key))
+		if err := sender.download(key, files[i]); err != nil {
+			return fmt.Errorf("failed to attach file %s: %w", key, err)
+		}
+	}
+
+	return sender.sender.SendEmail(subject, content, textContent, to, cc, bcc, files)
+}
+
+func (sender *BlobAttachmentSender) download(key string, name string) error {
+	reader, err := sender.store.Get(context.Background(), key)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/storage"
+)
+
+// attachmentRecorder records the content of the attachments it was asked to send
+type attachmentRecorder struct {
+	names    []string
+	contents []string
+}
+
+func (recorder *attachmentRecorder) SendEmail(subject, content, textContent string, to, cc, bcc, attachFiles []string) error {
+	for _, f := range attachFiles {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return err
+		}
+		recorder.names = append(recorder.names, filepath.Base(f))
+		recorder.contents = append(recorder.contents, string(data))
+	}
+	return nil
+}
+
+func TestBlobAttachmentSender(t *testing.T) {
+	store, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, store.Put(ctx, "statements/alice/2024-03.csv", strings.NewReader("march"), 5, "text/csv"))
+	require.NoError(t, store.Put(ctx, "statements/bob/2024-03.csv", strings.NewReader("bob"), 3, "text/csv"))
+
+	recorder := &attachmentRecorder{}
+	sender := NewBlobAttachmentSender(recorder, store)
+
+	err = sender.SendEmail("subject", "content", "", []string{"alice@example.com"}, nil, nil,
+		[]string{"statements/alice/2024-03.csv", "statements/bob/2024-03.csv"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2024-03.csv", "2024-03.csv"}, recorder.names)
+	require.Equal(t, []string{"march", "bob"}, recorder.contents)
+
+	err = sender.SendEmail("subject", "content", "", []string{"alice@example.com"}, nil, nil,
+		[]string{"statements/alice/2024-04.csv"})
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
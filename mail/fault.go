@@ -0,0 +1,34 @@
+package mail
+
+import "github.com/techschool/bank/faultinject"
+
+/*
+FaultInjectingSender 在发送之前检查 faultinject.SendEmail 故障点，
+用来在测试和 staging 环境里模拟 SMTP（或者其他邮件服务）出错，验证发送邮件的任务会被 asynq 重试。
+没有注入故障时直接交给真正的 EmailSender 发送。
+*/
+type FaultInjectingSender struct {
+	sender EmailSender
+}
+
+// NewFaultInjectingSender wraps an EmailSender so that faults injected at faultinject.SendEmail are returned
+func NewFaultInjectingSender(sender EmailSender) EmailSender {
+	return &FaultInjectingSender{
+		sender: sender,
+	}
+}
+
+func (sender *FaultInjectingSender) SendEmail(
+	subject string,
+	content string,
+	textContent string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	if err := faultinject.Check(faultinject.SendEmail); err != nil {
+		return err
+	}
+	return sender.sender.SendEmail(subject, content, textContent, to, cc, bcc, attachFiles)
+}
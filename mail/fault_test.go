@@ -0,0 +1,24 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/faultinject"
+)
+
+func TestFaultInjectingSender(t *testing.T) {
+	t.Cleanup(faultinject.Reset)
+
+	recorder := &attachmentRecorder{}
+	sender := NewFaultInjectingSender(recorder)
+
+	faultinject.Enable()
+	require.NoError(t, faultinject.Inject(faultinject.SendEmail, faultinject.Fault{Times: 1}))
+
+	err := sender.SendEmail("subject", "content", "", []string{"alice@example.com"}, nil, nil, nil)
+	require.ErrorIs(t, err, faultinject.ErrInjected)
+
+	err = sender.SendEmail("subject", "content", "", []string{"alice@example.com"}, nil, nil, nil)
+	require.NoError(t, err)
+}
@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"fmt"
+
+	"github.com/techschool/bank/util"
+)
+
+// Supported values of EMAIL_PROVIDER
+const (
+	ProviderGmail    = "gmail"
+	ProviderSMTP     = "smtp"
+	ProviderSendGrid = "sendgrid"
+	ProviderSES      = "ses"
+)
+
+// NewEmailSender creates the EmailSender selected by config.EmailProvider.
+// Gmail is used when no provider is configured.
+func NewEmailSender(config util.Config) (EmailSender, error) {
+	switch config.EmailProvider {
+	case "", ProviderGmail:
+		return NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword), nil
+	case ProviderSMTP:
+		if !IsSupportedTLSMode(config.SMTPTLSMode) {
+			return nil, fmt.Errorf("unsupported smtp tls mode: %q", config.SMTPTLSMode)
+		}
+		return NewSMTPSender(SMTPConfig{
+			Host:     config.SMTPHost,
+			Port:     config.SMTPPort,
+			Username: config.SMTPUsername,
+			Password: config.SMTPPassword,
+			TLSMode:  config.SMTPTLSMode,
+		}, config.EmailSenderName, config.EmailSenderAddress), nil
+	case ProviderSendGrid:
+		return NewSendGridSender(config.SendGridAPIKey, config.EmailSenderName, config.EmailSenderAddress), nil
+	case ProviderSES:
+		return NewSESSender(config.SESRegion, config.SMTPUsername, config.SMTPPassword,
+			config.EmailSenderName, config.EmailSenderAddress), nil
+	default:
+		return nil, fmt.Errorf("unsupported email provider: %q", config.EmailProvider)
+	}
+}
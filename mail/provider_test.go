@@ -0,0 +1,95 @@
+package mail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestNewEmailSender(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      util.Config
+		checkSender func(t *testing.T, sender EmailSender, err error)
+	}{
+		{
+			name:   "DefaultGmail",
+			config: util.Config{},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.NoError(t, err)
+				smtpSender, ok := sender.(*SMTPSender)
+				require.True(t, ok)
+				require.Equal(t, gmailSMTPHost, smtpSender.config.Host)
+			},
+		},
+		{
+			name: "SMTP",
+			config: util.Config{
+				EmailProvider: ProviderSMTP,
+				SMTPHost:      "localhost",
+				SMTPPort:      1025,
+				SMTPTLSMode:   TLSModeNone,
+			},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.NoError(t, err)
+				smtpSender, ok := sender.(*SMTPSender)
+				require.True(t, ok)
+				require.Equal(t, "localhost", smtpSender.config.Host)
+				require.Equal(t, 1025, smtpSender.config.Port)
+			},
+		},
+		{
+			name: "InvalidTLSMode",
+			config: util.Config{
+				EmailProvider: ProviderSMTP,
+				SMTPTLSMode:   "ssl",
+			},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "SES",
+			config: util.Config{
+				EmailProvider: ProviderSES,
+				SESRegion:     "us-east-1",
+			},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.NoError(t, err)
+				smtpSender, ok := sender.(*SMTPSender)
+				require.True(t, ok)
+				require.Equal(t, "email-smtp.us-east-1.amazonaws.com", smtpSender.config.Host)
+			},
+		},
+		{
+			name: "SendGrid",
+			config: util.Config{
+				EmailProvider:  ProviderSendGrid,
+				SendGridAPIKey: "key",
+			},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &SendGridSender{}, sender)
+			},
+		},
+		{
+			name: "UnsupportedProvider",
+			config: util.Config{
+				EmailProvider: "mailgun",
+			},
+			checkSender: func(t *testing.T, sender EmailSender, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			sender, err := NewEmailSender(tc.config)
+			tc.checkSender(t, sender, err)
+		})
+	}
+}
@@ -1,35 +1,24 @@
 package mail
 
-import (
-	"fmt"
-	"net/smtp"
-
-	"github.com/jordan-wright/email"
-)
-
 /*
-定义了GmailSender结构体：它包含发送者的姓名、邮箱地址和密码。
-
-实现了EmailSender接口：GmailSender有一个SendEmail方法，
-该方法接收邮件主题、内容、收件人列表、抄送列表、密送列表和附件列表作为参数。
+EmailSender 是发送邮件的统一接口，worker 只依赖这个接口，不关心具体用哪家服务。
 
-构造邮件：使用github.com/jordan-wright/email包来构建邮件内容，
-包括发件人、主题、正文、收件人、抄送、密送和附件。
+目前有这几种实现：
+  - SMTPSender：通用的 SMTP 发送器，可以配置 host、port 和 TLS 模式
+  - NewGmailSender：用 Gmail 的 SMTP 服务器预先配置好的 SMTPSender
+  - SESSender：通过 Amazon SES 的 SMTP 接口发送，也是基于 SMTPSender
+  - SendGridSender：调用 SendGrid 的 v3 HTTP API
 
-发送邮件：使用smtp包和PlainAuth进行SMTP认证，并调用e.Send方法发送邮件。
+具体用哪一个由配置里的 EMAIL_PROVIDER 决定，见 NewEmailSender。
+BlobAttachmentSender 可以包在任何一个实现外面，让附件从 blob store 读取（见 attachment.go）。
 
-这个GmailSender的实现允许你使用一个Gmail账户通过SMTP来发送电子邮件，
-它可以用在需要邮件通知功能的Go应用程序中，如用户注册后发送验证邮件。
+SendEmail 接收邮件主题、HTML内容、纯文本内容（可以为空）、收件人列表、抄送列表、密送列表和附件列表作为参数。
 */
-const (
-	smtpAuthAddress   = "smtp.gmail.com"
-	smtpServerAddress = "smtp.gmail.com:587"
-)
-
 type EmailSender interface {
 	SendEmail(
 		subject string,
 		content string,
+		textContent string,
 		to []string,
 		cc []string,
 		bcc []string,
@@ -37,45 +26,20 @@ type EmailSender interface {
 	) error
 }
 
-type GmailSender struct {
-	name              string
-	fromEmailAddress  string
-	fromEmailPassword string
-}
+const (
+	gmailSMTPHost = "smtp.gmail.com"
+	gmailSMTPPort = 587
+)
 
+// NewGmailSender 使用 Gmail 账户和应用专用密码通过 SMTP 发送邮件
 func NewGmailSender(name string, fromEmailAddress string, fromEmailPassword string) EmailSender {
-	return &GmailSender{
-		name:              name,
-		fromEmailAddress:  fromEmailAddress,
-		fromEmailPassword: fromEmailPassword,
-	}
-}
-
-func (sender *GmailSender) SendEmail(
-	subject string,
-	content string,
-	to []string,
-	cc []string,
-	bcc []string,
-	attachFiles []string,
-) error {
-	e := email.NewEmail()
-	e.From = fmt.Sprintf("%s <%s>", sender.name, sender.fromEmailAddress)
-	e.Subject = subject
-	e.HTML = []byte(content)
-	e.To = to
-	e.Cc = cc
-	e.Bcc = bcc
-
-	for _, f := range attachFiles {
-		_, err := e.AttachFile(f)
-		if err != nil {
-			return fmt.Errorf("failed to attach file %s: %w", f, err)
-		}
-	}
-
-	smtpAuth := smtp.PlainAuth("", sender.fromEmailAddress, sender.fromEmailPassword, smtpAuthAddress)
-	return e.Send(smtpServerAddress, smtpAuth)
+	return NewSMTPSender(SMTPConfig{
+		Host:     gmailSMTPHost,
+		Port:     gmailSMTPPort,
+		Username: fromEmailAddress,
+		Password: fromEmailPassword,
+		TLSMode:  TLSModeStartTLS,
+	}, name, fromEmailAddress)
 }
 
 /*
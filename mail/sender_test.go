@@ -33,9 +33,10 @@ func TestSendEmailWithGmail(t *testing.T) {
 	<h1>Hello world</h1>
 	<p>This is a test message from <a href="http://techschool.guru">Tech School</a></p>
 	`
+	textContent := "Hello world\nThis is a test message from Tech School"
 	to := []string{"hezhihong98@gmail.com"}
 	attachFiles := []string{"../README.md"}
 
-	err = sender.SendEmail(subject, content, to, nil, nil, attachFiles)
+	err = sender.SendEmail(subject, content, textContent, to, nil, nil, attachFiles)
 	require.NoError(t, err)
 }
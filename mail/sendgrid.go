@@ -0,0 +1,153 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+/*
+SendGridSender 调用 SendGrid 的 v3 mail/send 接口发送邮件，
+用 API key 认证，不需要 SMTP。
+
+SendGrid 要求 content 里 text/plain 必须放在 text/html 前面，
+附件需要 base64 编码后放在请求体里。
+*/
+
+const (
+	sendGridBaseURL        = "https://api.sendgrid.com"
+	sendGridRequestTimeout = 10 * time.Second
+)
+
+type SendGridSender struct {
+	apiKey           string
+	baseURL          string
+	name             string
+	fromEmailAddress string
+	client           *http.Client
+}
+
+func NewSendGridSender(apiKey string, name string, fromEmailAddress string) EmailSender {
+	return &SendGridSender{
+		apiKey:           apiKey,
+		baseURL:          sendGridBaseURL,
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+		client:           &http.Client{Timeout: sendGridRequestTimeout},
+	}
+}
+
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type sendGridPersonalization struct {
+	To  []sendGridAddress `json:"to"`
+	Cc  []sendGridAddress `json:"cc,omitempty"`
+	Bcc []sendGridAddress `json:"bcc,omitempty"`
+}
+
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendGridAttachment struct {
+	Content  string `json:"content"`
+	Filename string `json:"filename"`
+	Type     string `json:"type,omitempty"`
+}
+
+type sendGridMessage struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+	Attachments      []sendGridAttachment      `json:"attachments,omitempty"`
+}
+
+func (sender *SendGridSender) SendEmail(
+	subject string,
+	content string,
+	textContent string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	msg := sendGridMessage{
+		Personalizations: []sendGridPersonalization{{
+			To:  sendGridAddresses(to),
+			Cc:  sendGridAddresses(cc),
+			Bcc: sendGridAddresses(bcc),
+		}},
+		From: sendGridAddress{
+			Email: sender.fromEmailAddress,
+			Name:  sender.name,
+		},
+		Subject: subject,
+	}
+
+	if textContent != "" {
+		msg.Content = append(msg.Content, sendGridContent{Type: "text/plain", Value: textContent})
+	}
+	msg.Content = append(msg.Content, sendGridContent{Type: "text/html", Value: content})
+
+	for _, f := range attachFiles {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return fmt.Errorf("failed to attach file %s: %w", f, err)
+		}
+
+		msg.Attachments = append(msg.Attachments, sendGridAttachment{
+			Content:  base64.StdEncoding.EncodeToString(data),
+			Filename: filepath.Base(f),
+			Type:     mime.TypeByExtension(filepath.Ext(f)),
+		})
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sendgrid message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, sender.baseURL+"/v3/mail/send", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create sendgrid request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+sender.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := sender.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call sendgrid: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("sendgrid returned status %d: %s", rsp.StatusCode, errBody)
+	}
+	return nil
+}
+
+func sendGridAddresses(emails []string) []sendGridAddress {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	addresses := make([]sendGridAddress, len(emails))
+	for i, email := range emails {
+		addresses[i] = sendGridAddress{Email: email}
+	}
+	return addresses
+}
@@ -0,0 +1,52 @@
+package mail
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEmailWithSendGrid(t *testing.T) {
+	var msg sendGridMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v3/mail/send", r.URL.Path)
+		require.Equal(t, "Bearer test-key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sender := NewSendGridSender("test-key", "Simple Bank", "bank@example.com").(*SendGridSender)
+	sender.baseURL = server.URL
+
+	err := sender.SendEmail("A test email", "<h1>Hello</h1>", "Hello", []string{"to@example.com"}, nil, nil, []string{"../README.md"})
+	require.NoError(t, err)
+
+	require.Equal(t, "bank@example.com", msg.From.Email)
+	require.Len(t, msg.Personalizations, 1)
+	require.Equal(t, "to@example.com", msg.Personalizations[0].To[0].Email)
+	require.Empty(t, msg.Personalizations[0].Cc)
+	require.Equal(t, []sendGridContent{
+		{Type: "text/plain", Value: "Hello"},
+		{Type: "text/html", Value: "<h1>Hello</h1>"},
+	}, msg.Content)
+	require.Len(t, msg.Attachments, 1)
+	require.Equal(t, "README.md", msg.Attachments[0].Filename)
+}
+
+func TestSendEmailWithSendGridError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errors":[{"message":"invalid api key"}]}`))
+	}))
+	defer server.Close()
+
+	sender := NewSendGridSender("bad-key", "Simple Bank", "bank@example.com").(*SendGridSender)
+	sender.baseURL = server.URL
+
+	err := sender.SendEmail("A test email", "<h1>Hello</h1>", "", []string{"to@example.com"}, nil, nil, nil)
+	require.ErrorContains(t, err, "invalid api key")
+}
@@ -0,0 +1,23 @@
+package mail
+
+import "fmt"
+
+/*
+Amazon SES 除了 HTTP API 之外也提供 SMTP 接口，
+这里直接复用 SMTPSender，只需要根据 region 算出 SMTP 服务器地址。
+
+注意用户名和密码要用 SES 控制台里生成的 SMTP credentials，
+不是 AWS 的 access key。
+*/
+
+const sesSMTPPort = 587
+
+func NewSESSender(region string, smtpUsername string, smtpPassword string, name string, fromEmailAddress string) EmailSender {
+	return NewSMTPSender(SMTPConfig{
+		Host:     fmt.Sprintf("email-smtp.%s.amazonaws.com", region),
+		Port:     sesSMTPPort,
+		Username: smtpUsername,
+		Password: smtpPassword,
+		TLSMode:  TLSModeStartTLS,
+	}, name, fromEmailAddress)
+}
@@ -0,0 +1,107 @@
+package mail
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+
+	"github.com/jordan-wright/email"
+)
+
+/*
+SMTPSender 可以连接任意的 SMTP 服务器。
+
+TLS 模式有三种：
+  - none：先用明文连接，服务器支持的话再自动升级到 STARTTLS（本地测试用的 mailhog 之类）
+  - starttls：必须通过 STARTTLS 升级成加密连接，一般是 587 端口
+  - tls：一开始就用 TLS 连接（implicit TLS），一般是 465 端口
+
+没有配置用户名的时候不做 SMTP 认证。
+*/
+
+// Supported TLS modes of SMTPSender
+const (
+	TLSModeNone     = "none"
+	TLSModeStartTLS = "starttls"
+	TLSModeTLS      = "tls"
+)
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	TLSMode  string
+}
+
+type SMTPSender struct {
+	config           SMTPConfig
+	name             string
+	fromEmailAddress string
+}
+
+func NewSMTPSender(config SMTPConfig, name string, fromEmailAddress string) EmailSender {
+	return &SMTPSender{
+		config:           config,
+		name:             name,
+		fromEmailAddress: fromEmailAddress,
+	}
+}
+
+// IsSupportedTLSMode returns true if the TLS mode can be used by SMTPSender.
+func IsSupportedTLSMode(mode string) bool {
+	switch mode {
+	case TLSModeNone, TLSModeStartTLS, TLSModeTLS:
+		return true
+	}
+	return false
+}
+
+func (sender *SMTPSender) SendEmail(
+	subject string,
+	content string,
+	textContent string,
+	to []string,
+	cc []string,
+	bcc []string,
+	attachFiles []string,
+) error {
+	e := email.NewEmail()
+	e.From = fmt.Sprintf("%s <%s>", sender.name, sender.fromEmailAddress)
+	e.Subject = subject
+	e.HTML = []byte(content)
+	if textContent != "" {
+		e.Text = []byte(textContent)
+	}
+	e.To = to
+	e.Cc = cc
+	e.Bcc = bcc
+
+	for _, f := range attachFiles {
+		_, err := e.AttachFile(f)
+		if err != nil {
+			return fmt.Errorf("failed to attach file %s: %w", f, err)
+		}
+	}
+
+	var auth smtp.Auth
+	if sender.config.Username != "" {
+		auth = smtp.PlainAuth("", sender.config.Username, sender.config.Password, sender.config.Host)
+	}
+
+	addr := net.JoinHostPort(sender.config.Host, strconv.Itoa(sender.config.Port))
+	tlsConfig := &tls.Config{ServerName: sender.config.Host}
+
+	switch sender.config.TLSMode {
+	case TLSModeNone:
+		return e.Send(addr, auth)
+	case TLSModeStartTLS:
+		return e.SendWithStartTLS(addr, auth, tlsConfig)
+	case TLSModeTLS:
+		return e.SendWithTLS(addr, auth, tlsConfig)
+	default:
+		return fmt.Errorf("unsupported smtp tls mode: %s", sender.config.TLSMode)
+	}
+}
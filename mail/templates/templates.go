@@ -0,0 +1,287 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/techschool/bank/i18n"
+)
+
+/*
+这个包负责渲染所有发给用户的邮件，模板文件通过 embed 打包进二进制里。
+
+每种邮件有两个模板：
+  - <name>.html 用 html/template 渲染，会自动转义用户输入（比如 full name）
+  - <name>.txt  用 text/template 渲染，作为 text/plain 的备选内容，给不显示 HTML 的邮件客户端用
+
+翻译之后的模板叫 <name>.<locale>.html 和 <name>.<locale>.txt（比如 verify_email.zh.html），
+邮件标题在 subjects 里按语言区分，找不到对应语言的模板或者标题时退回到 DefaultLocale。
+语言的列表和用户的语言见 i18n 包。
+*/
+
+//go:embed *.html *.txt
+var files embed.FS
+
+// Names of all supported email templates
+const (
+	VerifyEmail   = "verify_email"
+	PasswordReset = "password_reset"
+	Statement     = "statement"
+	// ReconciliationReport 发给运维，不是发给用户的
+	ReconciliationReport = "reconciliation_report"
+	LoanDelinquent       = "loan_delinquent"
+	NewDeviceAlert       = "new_device_alert"
+	// AccountOwnershipTransferred 同时发给账户原来的所有者和新的所有者
+	AccountOwnershipTransferred = "account_ownership_transferred"
+	// VerifyEmailReminder 发给注册之后一段时间还没有验证邮箱的用户
+	VerifyEmailReminder = "verify_email_reminder"
+	// DisputeUpdated 在争议打开和状态变化时发给打开争议的用户
+	DisputeUpdated = "dispute_updated"
+	// AccountExists 在有人用已经注册过的邮箱注册时发给这个邮箱原来的主人
+	AccountExists = "account_exists"
+	// AccountDeletionScheduled 在用户申请删除账号之后发送，里面有取消删除的链接
+	AccountDeletionScheduled = "account_deletion_scheduled"
+)
+
+const DefaultLocale = i18n.DefaultLocale
+
+var subjects = map[string]map[string]string{
+	i18n.English: {
+		VerifyEmail:                 "Welcome to Simple Bank",
+		VerifyEmailReminder:         "Please verify your Simple Bank email address",
+		PasswordReset:               "Reset your Simple Bank password",
+		Statement:                   "Your Simple Bank summary for {{.Month}}",
+		ReconciliationReport:        "[Simple Bank] Reconciliation report #{{.ReportID}}: {{len .Discrepancies}} discrepancies",
+		LoanDelinquent:              "Your Simple Bank loan #{{.LoanID}} is past due",
+		NewDeviceAlert:              "New sign-in to your Simple Bank account",
+		AccountOwnershipTransferred: "Ownership of Simple Bank account #{{.AccountID}} has changed",
+		DisputeUpdated:              "Update on your Simple Bank dispute #{{.DisputeID}}",
+		AccountExists:               "You already have a Simple Bank account",
+		AccountDeletionScheduled:    "Your Simple Bank account will be deleted",
+	},
+	i18n.Chinese: {
+		VerifyEmail:                 "欢迎使用 Simple Bank",
+		VerifyEmailReminder:         "请验证您的 Simple Bank 电子邮件地址",
+		PasswordReset:               "重置您的 Simple Bank 密码",
+		Statement:                   "您的 Simple Bank {{.Month}} 账户汇总",
+		LoanDelinquent:              "您的 Simple Bank 贷款 #{{.LoanID}} 已逾期",
+		NewDeviceAlert:              "您的 Simple Bank 账户在新设备上登录",
+		AccountOwnershipTransferred: "Simple Bank 账户 #{{.AccountID}} 的所有者已变更",
+		DisputeUpdated:              "您的 Simple Bank 争议 #{{.DisputeID}} 有新进展",
+		AccountExists:               "您已经有一个 Simple Bank 账户",
+		AccountDeletionScheduled:    "您的 Simple Bank 账户将被删除",
+	},
+}
+
+// dateLayouts 是邮件里日期和月份的格式，英文的月份名不适合直接放进中文邮件
+var dateLayouts = map[string]struct{ date, month, time string }{
+	i18n.English: {date: "January 2, 2006", month: "January 2006", time: "January 2, 2006 15:04 MST"},
+	i18n.Chinese: {date: "2006年1月2日", month: "2006年1月", time: "2006年1月2日 15:04 MST"},
+}
+
+// FormatDate formats a date like "March 31, 2024" in the given locale
+func FormatDate(t time.Time, locale string) string {
+	layout, ok := dateLayouts[locale]
+	if !ok {
+		layout = dateLayouts[DefaultLocale]
+	}
+	return t.Format(layout.date)
+}
+
+// FormatTime formats a point in time like "March 31, 2024 10:30 UTC" in the given locale
+func FormatTime(t time.Time, locale string) string {
+	layout, ok := dateLayouts[locale]
+	if !ok {
+		layout = dateLayouts[DefaultLocale]
+	}
+	return t.Format(layout.time)
+}
+
+// FormatMonth formats a month like "March 2024" in the given locale
+func FormatMonth(t time.Time, locale string) string {
+	layout, ok := dateLayouts[locale]
+	if !ok {
+		layout = dateLayouts[DefaultLocale]
+	}
+	return t.Format(layout.month)
+}
+
+type VerifyEmailData struct {
+	FullName  string
+	VerifyURL string
+	// DeepLinkURL 是打开手机 app 的链接，可以为空。
+	// 自定义的 scheme 会被 html/template 当成不安全的 URL 过滤掉，所以要用 template.URL 标记成可信的
+	DeepLinkURL htmltemplate.URL
+}
+
+type VerifyEmailReminderData struct {
+	VerifyEmailData
+	// DisableDate 是账户因为没有验证邮箱而被停用的日期，不会自动停用时为空
+	DisableDate string
+}
+
+type PasswordResetData struct {
+	FullName string
+	ResetURL string
+}
+
+type StatementData struct {
+	FullName string
+	Month    string
+	Accounts []StatementAccount
+	// DownloadURL 是 CSV 对账单的下载链接，没有保存对账单时为空
+	DownloadURL string
+}
+
+type StatementAccount struct {
+	AccountID     int64
+	Currency      string
+	TotalIn       int64
+	TotalOut      int64
+	EndingBalance int64
+}
+
+type ReconciliationReportData struct {
+	ReportID        int64
+	AccountsChecked int64
+	StartedAt       string
+	Discrepancies   []ReconciliationDiscrepancy
+}
+
+type ReconciliationDiscrepancy struct {
+	AccountID      int64
+	AccountBalance int64
+	EntriesBalance int64
+}
+
+type LoanDelinquentData struct {
+	FullName    string
+	LoanID      int64
+	Installment int32
+	AmountDue   string
+	DueDate     string
+	AccountID   int64
+}
+
+type NewDeviceAlertData struct {
+	FullName  string
+	LoginTime string
+	UserAgent string
+	ClientIP  string
+	// Location 是 IP 所在的城市和国家，比如 "Sydney, AU"，查不到时为空
+	Location string
+	// BlockURL 是“不是我本人”的链接，打开之后这次登录的会话会被封禁
+	BlockURL string
+}
+
+type AccountOwnershipTransferredData struct {
+	FullName      string
+	AccountID     int64
+	Currency      string
+	PreviousOwner string
+	NewOwner      string
+	TransferTime  string
+	// Received 为 true 时邮件是发给新的所有者的
+	Received bool
+}
+
+type DisputeUpdatedData struct {
+	FullName   string
+	DisputeID  int64
+	TransferID int64
+	// Status 是争议现在的状态，见 util.DisputeOpen 等常量
+	Status     string
+	Resolution string
+	UpdateTime string
+}
+
+type AccountExistsData struct {
+	FullName string
+	// Username 是这个邮箱已经注册的用户名，不是这次尝试注册的用户名
+	Username string
+}
+
+type AccountDeletionScheduledData struct {
+	FullName string
+	// DeletionTime 是账号被删除的时间，在这之前打开 CancelURL 可以取消删除
+	DeletionTime string
+	CancelURL    string
+}
+
+// Email is a rendered email, ready to be sent by a mail.EmailSender
+type Email struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.ParseFS(files, "*.html"))
+	textTemplates = texttemplate.Must(texttemplate.ParseFS(files, "*.txt"))
+)
+
+// Render renders the subject, HTML body and plain text body of the named email.
+func Render(name string, locale string, data any) (*Email, error) {
+	subject, err := renderSubject(name, locale, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var html bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&html, localizedName(name, locale, ".html"), data); err != nil {
+		return nil, fmt.Errorf("failed to render html template %s: %w", name, err)
+	}
+
+	var text bytes.Buffer
+	if err := textTemplates.ExecuteTemplate(&text, localizedName(name, locale, ".txt"), data); err != nil {
+		return nil, fmt.Errorf("failed to render text template %s: %w", name, err)
+	}
+
+	email := &Email{
+		Subject: subject,
+		HTML:    html.String(),
+		Text:    text.String(),
+	}
+	return email, nil
+}
+
+// localizedName 返回 locale 对应的模板文件名，没有翻译时用默认语言的模板
+func localizedName(name string, locale string, ext string) string {
+	localized := name + "." + locale + ext
+	switch {
+	case ext == ".html" && htmlTemplates.Lookup(localized) != nil,
+		ext == ".txt" && textTemplates.Lookup(localized) != nil:
+		return localized
+	}
+	return name + ext
+}
+
+func renderSubject(name string, locale string, data any) (string, error) {
+	localized, ok := subjects[locale]
+	if !ok {
+		localized = subjects[DefaultLocale]
+	}
+
+	format, ok := localized[name]
+	if !ok {
+		format, ok = subjects[DefaultLocale][name]
+		if !ok {
+			return "", fmt.Errorf("unknown email template %s", name)
+		}
+	}
+
+	tmpl, err := texttemplate.New("subject").Parse(format)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse subject of %s: %w", name, err)
+	}
+
+	var subject bytes.Buffer
+	if err := tmpl.Execute(&subject, data); err != nil {
+		return "", fmt.Errorf("failed to render subject of %s: %w", name, err)
+	}
+	return subject.String(), nil
+}
@@ -0,0 +1,291 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/i18n"
+)
+
+func TestRenderVerifyEmail(t *testing.T) {
+	data := VerifyEmailData{
+		FullName:  "<b>Alice</b>",
+		VerifyURL: "https://bank.example.com/verify?id=1&code=abc",
+	}
+
+	email, err := Render(VerifyEmail, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "Welcome to Simple Bank", email.Subject)
+
+	// HTML 部分要转义用户输入，纯文本部分保持原样
+	require.Contains(t, email.HTML, "&lt;b&gt;Alice&lt;/b&gt;")
+	require.Contains(t, email.HTML, `href="https://bank.example.com/verify?id=1&amp;code=abc"`)
+	require.Contains(t, email.Text, "<b>Alice</b>")
+	require.Contains(t, email.Text, data.VerifyURL)
+	require.NotContains(t, email.HTML, "Simple Bank app")
+}
+
+func TestRenderVerifyEmailWithDeepLink(t *testing.T) {
+	data := VerifyEmailData{
+		FullName:    "Alice",
+		VerifyURL:   "https://bank.example.com/verify?id=1",
+		DeepLinkURL: "simplebank://verify?id=1",
+	}
+
+	email, err := Render(VerifyEmail, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Contains(t, email.HTML, `href="simplebank://verify?id=1"`)
+	require.Contains(t, email.Text, "simplebank://verify?id=1")
+}
+
+func TestRenderStatement(t *testing.T) {
+	data := StatementData{
+		FullName: "Alice",
+		Month:    "March 2024",
+		Accounts: []StatementAccount{
+			{AccountID: 1, Currency: "USD", TotalIn: 150, TotalOut: 50, EndingBalance: 1100},
+			{AccountID: 2, Currency: "EUR", TotalIn: 0, TotalOut: 20, EndingBalance: 80},
+		},
+	}
+
+	email, err := Render(Statement, "zh", data)
+	require.NoError(t, err)
+	require.Equal(t, "您的 Simple Bank March 2024 账户汇总", email.Subject)
+	require.Contains(t, email.HTML, "<td>1100</td>")
+	require.Contains(t, email.Text, "账户 2（EUR）")
+}
+
+func TestRenderStatementWithDownloadURL(t *testing.T) {
+	data := StatementData{
+		FullName:    "Alice",
+		Month:       "March 2024",
+		Accounts:    []StatementAccount{{AccountID: 1, Currency: "USD", EndingBalance: 1100}},
+		DownloadURL: "https://example.com/blobs/statements/alice/2024-03.csv?signature=abc&expires=1",
+	}
+
+	email, err := Render(Statement, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Contains(t, email.HTML, `href="https://example.com/blobs/statements/alice/2024-03.csv?signature=abc&amp;expires=1"`)
+	require.Contains(t, email.Text, "https://example.com/blobs/statements/alice/2024-03.csv?signature=abc&expires=1")
+}
+
+func TestRenderReconciliationReport(t *testing.T) {
+	data := ReconciliationReportData{
+		ReportID:        7,
+		AccountsChecked: 100,
+		StartedAt:       "2024-03-01T02:00:00Z",
+		Discrepancies: []ReconciliationDiscrepancy{
+			{AccountID: 3, AccountBalance: 120, EntriesBalance: 100},
+		},
+	}
+
+	// 没有中文标题时使用英文标题
+	email, err := Render(ReconciliationReport, "zh", data)
+	require.NoError(t, err)
+	require.Equal(t, "[Simple Bank] Reconciliation report #7: 1 discrepancies", email.Subject)
+	require.Contains(t, email.HTML, "<td>3</td><td>120</td><td>100</td>")
+	require.Contains(t, email.Text, "Entries balance: 100")
+}
+
+func TestRenderLoanDelinquent(t *testing.T) {
+	data := LoanDelinquentData{
+		FullName:    "Alice",
+		LoanID:      9,
+		Installment: 2,
+		AmountDue:   "88.85 USD",
+		DueDate:     "March 31, 2024",
+		AccountID:   3,
+	}
+
+	email, err := Render(LoanDelinquent, "zh", data)
+	require.NoError(t, err)
+	require.Equal(t, "您的 Simple Bank 贷款 #9 已逾期", email.Subject)
+	require.Contains(t, email.HTML, "您的贷款 #9 第 2 期")
+	require.Contains(t, email.Text, "请向账户 #3 存入至少 88.85 USD")
+}
+
+func TestRenderNewDeviceAlert(t *testing.T) {
+	data := NewDeviceAlertData{
+		FullName:  "Alice",
+		LoginTime: "March 31, 2024 10:30 UTC",
+		UserAgent: "<script>alert(1)</script>",
+		ClientIP:  "203.0.113.7",
+		BlockURL:  "https://bank.example.com/v1/block_session?session_id=abc&signature=def",
+	}
+
+	email, err := Render(NewDeviceAlert, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "New sign-in to your Simple Bank account", email.Subject)
+	require.Contains(t, email.HTML, "&lt;script&gt;alert(1)&lt;/script&gt;")
+	require.Contains(t, email.HTML, `href="https://bank.example.com/v1/block_session?session_id=abc&amp;signature=def"`)
+	require.Contains(t, email.Text, data.BlockURL)
+	require.NotContains(t, email.Text, "Location")
+
+	data.Location = "Sydney, AU"
+	email, err = Render(NewDeviceAlert, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "您的 Simple Bank 账户在新设备上登录", email.Subject)
+	require.Contains(t, email.Text, "IP 地址：203.0.113.7\n位置：Sydney, AU\n")
+}
+
+func TestRenderAccountOwnershipTransferred(t *testing.T) {
+	data := AccountOwnershipTransferredData{
+		FullName:      "Alice",
+		AccountID:     7,
+		Currency:      "USD",
+		PreviousOwner: "alice",
+		NewOwner:      "bob",
+		TransferTime:  "March 31, 2024 10:30 UTC",
+	}
+
+	email, err := Render(AccountOwnershipTransferred, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "Ownership of Simple Bank account #7 has changed", email.Subject)
+	require.Contains(t, email.Text, "Your account #7 (USD) was transferred to bob on March 31, 2024 10:30 UTC.\n")
+	require.NotContains(t, email.HTML, "transferred to you")
+
+	data.FullName = "Bob"
+	data.Received = true
+	email, err = Render(AccountOwnershipTransferred, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "Simple Bank 账户 #7 的所有者已变更", email.Subject)
+	require.Contains(t, email.Text, "账户 #7（USD）已于 March 31, 2024 10:30 UTC 从 alice 转移给您。")
+}
+
+func TestRenderDisputeUpdated(t *testing.T) {
+	data := DisputeUpdatedData{
+		FullName:   "Alice",
+		DisputeID:  3,
+		TransferID: 42,
+		Status:     "under_review",
+		UpdateTime: "March 31, 2024 10:30 UTC",
+	}
+
+	email, err := Render(DisputeUpdated, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "Update on your Simple Bank dispute #3", email.Subject)
+	require.Contains(t, email.Text, "started reviewing your dispute #3 about transfer #42 on March 31, 2024 10:30 UTC.\n")
+	require.NotContains(t, email.Text, "Resolution:")
+
+	data.Status = "rejected"
+	data.Resolution = "<b>the merchant delivered the goods</b>"
+	email, err = Render(DisputeUpdated, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Contains(t, email.Text, "was rejected on March 31, 2024 10:30 UTC.\nResolution: <b>the merchant delivered the goods</b>\n")
+	require.Contains(t, email.HTML, "Resolution: &lt;b&gt;the merchant delivered the goods&lt;/b&gt;")
+
+	data.Status = "refunded"
+	email, err = Render(DisputeUpdated, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "您的 Simple Bank 争议 #3 有新进展", email.Subject)
+	require.Contains(t, email.Text, "款项已退回您的账户。")
+}
+
+func TestRenderAccountExists(t *testing.T) {
+	data := AccountExistsData{FullName: "Alice", Username: "alice"}
+
+	email, err := Render(AccountExists, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "You already have a Simple Bank account", email.Subject)
+	require.Contains(t, email.HTML, "your account <b>alice</b>")
+	require.Contains(t, email.Text, "already belongs to your account alice")
+
+	email, err = Render(AccountExists, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "您已经有一个 Simple Bank 账户", email.Subject)
+	require.Contains(t, email.Text, "已经属于您的账户 alice")
+}
+
+func TestRenderUnknownLocale(t *testing.T) {
+	email, err := Render(PasswordReset, "fr", PasswordResetData{FullName: "Alice", ResetURL: "https://bank.example.com/reset"})
+	require.NoError(t, err)
+	require.Equal(t, "Reset your Simple Bank password", email.Subject)
+	require.Contains(t, email.Text, "We received a request to reset the password")
+}
+
+func TestRenderVerifyEmailInChinese(t *testing.T) {
+	data := VerifyEmailData{
+		FullName:  "<b>Alice</b>",
+		VerifyURL: "https://bank.example.com/verify?id=1&code=abc",
+	}
+
+	email, err := Render(VerifyEmail, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "欢迎使用 Simple Bank", email.Subject)
+	require.Contains(t, email.HTML, "&lt;b&gt;Alice&lt;/b&gt;，您好")
+	require.Contains(t, email.HTML, `href="https://bank.example.com/verify?id=1&amp;code=abc"`)
+	require.Contains(t, email.Text, "请打开下面的链接验证您的电子邮件地址")
+}
+
+func TestRenderAccountDeletionScheduled(t *testing.T) {
+	data := AccountDeletionScheduledData{
+		FullName:     "Alice",
+		DeletionTime: "April 30, 2024 10:30 UTC",
+		CancelURL:    "https://bank.example.com/v1/cancel_account_deletion?username=alice&signature=def",
+	}
+
+	email, err := Render(AccountDeletionScheduled, DefaultLocale, data)
+	require.NoError(t, err)
+	require.Equal(t, "Your Simple Bank account will be deleted", email.Subject)
+	require.Contains(t, email.HTML, "April 30, 2024 10:30 UTC")
+	require.Contains(t, email.HTML, `href="https://bank.example.com/v1/cancel_account_deletion?username=alice&amp;signature=def"`)
+	require.Contains(t, email.Text, data.CancelURL)
+
+	email, err = Render(AccountDeletionScheduled, i18n.Chinese, data)
+	require.NoError(t, err)
+	require.Equal(t, "您的 Simple Bank 账户将被删除", email.Subject)
+	require.Contains(t, email.Text, "请在这之前打开下面的链接取消删除")
+}
+
+// 每个翻译之后的模板都要同时有 HTML 和纯文本两个版本
+func TestLocalizedTemplatesArePaired(t *testing.T) {
+	for _, locale := range i18n.Locales() {
+		for _, name := range []string{VerifyEmail, PasswordReset, Statement, ReconciliationReport, LoanDelinquent, NewDeviceAlert, AccountOwnershipTransferred, VerifyEmailReminder, DisputeUpdated, AccountExists, AccountDeletionScheduled} {
+			html := localizedName(name, locale, ".html")
+			text := localizedName(name, locale, ".txt")
+			require.Equal(t, strings.TrimSuffix(html, ".html"), strings.TrimSuffix(text, ".txt"), "%s in %s", name, locale)
+		}
+	}
+}
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, "March 31, 2024", FormatDate(date, i18n.English))
+	require.Equal(t, "2024年3月31日", FormatDate(date, i18n.Chinese))
+	require.Equal(t, "March 31, 2024", FormatDate(date, "fr"))
+	require.Equal(t, "March 2024", FormatMonth(date, i18n.English))
+	require.Equal(t, "2024年3月", FormatMonth(date, i18n.Chinese))
+
+	loginTime := time.Date(2024, time.March, 31, 10, 30, 0, 0, time.UTC)
+	require.Equal(t, "March 31, 2024 10:30 UTC", FormatTime(loginTime, i18n.English))
+	require.Equal(t, "2024年3月31日 10:30 UTC", FormatTime(loginTime, i18n.Chinese))
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	_, err := Render("unknown", DefaultLocale, nil)
+	require.Error(t, err)
+}
+
+func TestRenderVerifyEmailReminder(t *testing.T) {
+	data := VerifyEmailReminderData{
+		VerifyEmailData: VerifyEmailData{
+			FullName:  "Alice",
+			VerifyURL: "https://bank.example.com/verify?id=1",
+		},
+		DisableDate: "2024年3月8日",
+	}
+
+	email, err := Render(VerifyEmailReminder, "zh", data)
+	require.NoError(t, err)
+	require.Equal(t, "请验证您的 Simple Bank 电子邮件地址", email.Subject)
+	require.Contains(t, email.HTML, `href="https://bank.example.com/verify?id=1"`)
+	require.Contains(t, email.Text, "如果在 2024年3月8日 之前还没有完成验证")
+
+	// 不会自动停用账户时不提停用的日期
+	data.DisableDate = ""
+	email, err = Render(VerifyEmailReminder, DefaultLocale, data)
+	require.NoError(t, err)
+	require.NotContains(t, email.Text, "disabled")
+}
@@ -3,26 +3,26 @@ package main
 import (
 	"context"
 	"os"
+	"time"
 
 	// "log"
-	"net"
 	"net/http"
 
-	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
-	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hibiken/asynq"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/rakyll/statik/fs"
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/techschool/bank/api"
+	"github.com/techschool/bank/db/migration"
 	db "github.com/techschool/bank/db/sqlc"
-	_ "github.com/techschool/bank/doc/statik"
+	"github.com/techschool/bank/doc"
+	"github.com/techschool/bank/eventbus"
 	"github.com/techschool/bank/gapi"
+	"github.com/techschool/bank/graph"
 	"github.com/techschool/bank/mail"
+	"github.com/techschool/bank/notify"
 	"github.com/techschool/bank/pb"
+	pbv2 "github.com/techschool/bank/pb/v2"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/token"
 	"github.com/techschool/bank/util"
 	"github.com/techschool/bank/worker"
 
@@ -30,114 +30,190 @@ import (
 
 	// "github.com/techschool/bank/worker"
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // 注册 gzip 编码，客户端请求压缩时 gRPC 服务端用同样的编码压缩响应
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 /*
-加载配置，连接到数据库，然后调用 runGrpcServer 函数来启动 gRPC 服务器。
-runGrpcServer 函数创建了一个新的 gRPC 服务器实例，
-注册了银行服务的实现，并启动了 gRPC 服务器监听特定的端口。
-reflection.Register(grpcServer) 这一行启用了 gRPC 反射，
-这允许工具像 evans 或 grpcurl 在运行时查询服务器支持的服务和方法。
-*/
+main 只负责执行 cobra 命令，具体的命令见 cmd_*.go：
+
+	bank serve                 启动 gRPC 和 HTTP 网关（默认同时运行迁移和后台任务）
+	bank worker                只运行后台任务（任务处理器、定时任务和事件转发）
+	bank migrate up|down|version
+	bank create-admin          创建管理员账号
+	bank seed                  生成演示数据
+	bank loadtest              对数据库做并发压测
 
+API 和 worker 可以分开部署，分别扩容。
+*/
 func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
 
-	config, err := util.LoadConfig(".")
+/*
+runDBMigration 在启动时运行数据库迁移（migrate 为 true），或者只检查数据库结构是不是最新的。
+数据库比这个版本的迁移旧或者上一次迁移失败（dirty）时拒绝启动，
+否则新代码会用到还不存在的表和列，请求出错比直接启动失败更难发现。
+*/
+func runDBMigration(config util.Config, migrate bool) {
+	migrator, err := migration.New(config.MigrationURL, config.DBSource)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot load config")
+		log.Fatal().Err(err).Msg("cannot create migrator")
 	}
+	defer migrator.Close()
 
-	if config.Environment == "development" {
-		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	if migrate {
+		if err = migrator.Up(); err != nil {
+			log.Fatal().Err(err).Msg("failed to run migrate up")
+		}
+		log.Info().Msg("db migrated successfully")
 	}
 
-	connPool, err := pgxpool.New(context.Background(), config.DBSource)
+	status, err := migrator.Status()
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot connect to db")
+		log.Fatal().Err(err).Msg("cannot get migration status")
 	}
+	if status.Behind() {
+		log.Fatal().Uint("version", status.Version).Uint("latest_version", status.LatestVersion).
+			Bool("dirty", status.Dirty).Msg("db schema is behind, run bank migrate up first")
+	}
+}
 
-	runDBMigration(config.MigrationURL, config.DBSource)
-
-	/*
-		初始化数据库存储（db.NewStore(conn)）。
-		配置Redis客户端（asynq.RedisClientOpt）。
-		创建任务分发器（taskDistributor）。
-		启动任务处理器（go runTaskProcessor(redisOpt, store)）。
-		启动网关服务器（go runGatewayServer(config, store, taskDistributor)）。
-		运行gRPC服务器（runGrpcServer(config, store, taskDistributor)）。
+/*
+这个函数启动了任务处理器，它将从Redis队列中取出任务并处理它们。
+任务处理器使用的电子邮件发送器由配置里的 EMAIL_PROVIDER 决定（mail.NewEmailSender），
+用来发送验证邮件。
+*/
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisConnOpt, store db.Store) worker.TaskProcessor {
+	mailer, err := mail.NewEmailSender(config)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create email sender")
+	}
+	mailer = mail.NewFaultInjectingSender(mailer)
 
-		这些步骤整合了异步工作处理器到web服务器中，确保了当web服务器运行时，
-		后台任务处理器也同时运行。
-	*/
-	store := db.NewStore(connPool)
+	notifiers, err := notify.NewNotifiers(config, mailer)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create notifiers")
+	}
 
-	redisOpt := asynq.RedisClientOpt{
-		Addr: config.RedisAddress,
+	taskProcessor, err := worker.NewRedisTaskProcessor(config, redisOpt, store, mailer, notifiers)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create task processor")
 	}
 
-	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	go runTaskProcessor(config, redisOpt, store)
-	go runGatewayServer(config, store, taskDistributor)
-	runGrpcServer(config, store, taskDistributor)
+	log.Info().Msg("start task processor")
+	err = taskProcessor.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task processor")
+	}
+	return taskProcessor
 }
 
-func runDBMigration(migrationURL string, dbSource string) {
-	migration, err := migrate.New(migrationURL, dbSource)
+/*
+runTaskScheduler 启动定时任务的调度器，例如每个月初发送账户月度汇总邮件。
+多个 worker 副本都会启动调度器，但只有选举出来的 leader 真正放任务，见 worker.TaskScheduler。
+*/
+func runTaskScheduler(config util.Config, redisOpt asynq.RedisConnOpt) worker.TaskScheduler {
+	taskScheduler, err := worker.NewRedisTaskScheduler(redisOpt, config.WorkerLeaderTTL)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create new migrate instance")
+		log.Fatal().Err(err).Msg("cannot create task scheduler")
 	}
 
-	if err = migration.Up(); err != nil && err != migrate.ErrNoChange {
-		log.Fatal().Err(err).Msg("failed to run migrate up")
+	log.Info().Msg("start task scheduler")
+	err = taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
 	}
-
-	log.Info().Msg("db migrated successfully")
+	return taskScheduler
 }
 
 /*
-这个函数启动了任务处理器，它将从Redis队列中取出任务并处理它们。
-可以假设在生产环境中，任务处理器会使用电子邮件发送器（如mailer := mail.NewGmailSender(...)）
-来发送验证邮件。
+runEventRelay 把 outbox_events 里还没有发布的领域事件转发到 EVENT_BUS 配置的消息系统（kafka 或 nats）。
+没有配置 EVENT_BUS 时不启动，事件只留在 outbox 表里，由定时任务按 EVENT_RETENTION 清理。
 */
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
-	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
-	log.Info().Msg("start task processor")
-	err := taskProcessor.Start()
+func runEventRelay(ctx context.Context, config util.Config, store db.Store) {
+	publisher, err := eventbus.NewPublisher(config)
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed to start task processor")
+		log.Fatal().Err(err).Msg("cannot create event publisher")
+	}
+	if publisher == nil {
+		log.Info().Msg("event bus is disabled")
+		return
 	}
+	defer publisher.Close()
+
+	log.Info().Str("bus", config.EventBus).Msg("start event relay")
+	relay := eventbus.NewRelay(store, publisher, config.EventRelayInterval, config.EventRelayBatchSize)
+	relay.Run(ctx)
 }
 
 /*
-这两个函数启动了gRPC服务器和网关服务器。
-gRPC服务器处理来自其他服务或客户端的gRPC请求，
-而网关服务器将HTTP请求转换为gRPC请求。
-这两个服务器都使用taskDistributor来分发任务，例如用户注册后发送验证邮件的任务。
+newGapiServer 创建 gRPC 服务器和 HTTP 网关共用的 gapi.Server，配置文件改了之后重新加载。
+它使用 taskDistributor 来分发任务，例如用户注册后发送验证邮件的任务。
 */
-func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+func newGapiServer(config util.Config, configWatcher *util.ConfigWatcher, store db.Store, taskDistributor worker.TaskDistributor, taskInspector worker.TaskInspector, revocationStore token.RevocationStore) *gapi.Server {
+	server, err := gapi.NewServer(config, store, taskDistributor, taskInspector, revocationStore)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
+	configWatcher.Subscribe(server.ReloadConfig)
+	return server
+}
 
-	gprcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(gprcLogger)
-	pb.RegisterSimpleBankServer(grpcServer, server)
-	reflection.Register(grpcServer)
-
-	listener, err := net.Listen("tcp", config.GRPCServerAddress)
+/*
+runGrpcServer 启动 gRPC 服务器，处理来自其他服务或客户端的 gRPC 请求。
+除了 GRPC_SERVER_ADDRESS 配置的地址，还在 gatewayListener 上处理 HTTP 网关转过来的请求。
+*/
+func runGrpcServer(ctx context.Context, config util.Config, grpcServer *grpc.Server, gatewayListener *gapi.InProcessListener) {
+	listeners, err := listen(config.GRPCServerAddress)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create listener")
 	}
-	log.Printf("start gRPC server at %s", listener.Addr().String())
-	err = grpcServer.Serve(listener)
+	log.Info().Strs("addresses", listenerAddresses(listeners)).Msg("start gRPC server")
+
+	// GracefulStop 等正在处理的请求结束，超过 shutdownTimeout 之后直接断开
+	err = serveListeners(ctx, append(listeners, gatewayListener), grpcServer.Serve, func() {
+		timer := time.AfterFunc(shutdownTimeout, grpcServer.Stop)
+		defer timer.Stop()
+		grpcServer.GracefulStop()
+	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot start gRPC server")
 	}
+	log.Info().Msg("gRPC server stopped")
+}
+
+// newGrpcServer 创建注册了所有服务的 gRPC 服务器，gRPC 端口和网关上的 gRPC-Web 共用
+func newGrpcServer(config util.Config, server *gapi.Server) *grpc.Server {
+	// 拦截器的顺序见 gapi.Server.UnaryInterceptors，消息大小和 keepalive 见 gapi.GrpcServerOptions
+	options := append(gapi.GrpcServerOptions(config), grpc.ChainUnaryInterceptor(server.UnaryInterceptors()...))
+	grpcServer := grpc.NewServer(options...)
+	pb.RegisterSimpleBankServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, server)
+	pbv2.RegisterSimpleBankV2Server(grpcServer, gapi.NewServerV2(server))
+	// 反射会把所有 RPC 的定义暴露给任何客户端，只在开发环境里给 evans、grpcurl 这类工具用
+	if config.DebugEnabled() {
+		reflection.Register(grpcServer)
+	}
+	return grpcServer
+}
 
+/*
+newHTTPServer 创建网关用的 http.Server，超时和请求头大小来自配置，0 表示用 net/http 的默认值（不限制）。
+ReadHeaderTimeout 防止慢速发送请求头的客户端一直占着连接；WriteTimeout 从读完请求头开始算，
+会截断比它更久的响应，gRPC-Web 的 websocket 连接也受它限制。
+*/
+func newHTTPServer(config util.Config, handler http.Handler) *http.Server {
+	return &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: config.HTTPReadHeaderTimeout,
+		ReadTimeout:       config.HTTPReadTimeout,
+		WriteTimeout:      config.HTTPWriteTimeout,
+		IdleTimeout:       config.HTTPIdleTimeout,
+		MaxHeaderBytes:    config.HTTPMaxHeaderBytes,
+	}
 }
 
 /*
@@ -146,73 +222,134 @@ func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.Ta
 然后将服务器的 gRPC 响应转换回 HTTP/JSON。
 这允许客户端既可以使用原生的 gRPC 也可以使用更通用的 HTTP 来与您的服务通信。
 
-1.启动 gRPC-Gateway：runGatewayServer 函数启动了一个 HTTP 服务器，
-该服务器使用 grpcMux 将接收到的 HTTP 请求转换为 gRPC 请求。
-
-5.并行运行：在 main 函数中，通过 go runGatewayServer(config, store)
-6.异步地运行了 HTTP 服务器，同时主线程在运行 gRPC 服务器。
+网关通过 gatewayListener 的进程内连接调用 grpcServer，而不是直接调用 gapi.Server 的方法，
+日志、认证、限流和 metrics 这些拦截器对 HTTP 请求同样有效，见 gapi.Server.GatewayMux。
 */
-func runGatewayServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+func runGatewayServer(ctx context.Context, config util.Config, server *gapi.Server, grpcServer *grpc.Server, gatewayListener *gapi.InProcessListener, store db.Store) {
+	conn, err := gatewayListener.Dial()
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create server")
+		log.Fatal().Err(err).Msg("cannot connect to gRPC server")
 	}
+	defer conn.Close()
 
-	//2.配置 JSON 解析器：使用 runtime.JSONPb 来自定义 JSON 的编组和解组行为，使其可以正确处理 proto 消息。
-	jsonOption := runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			UseProtoNames: true,
-		},
-		UnmarshalOptions: protojson.UnmarshalOptions{
-			DiscardUnknown: true,
-		},
-	})
+	grpcMux, err := server.GatewayMux(ctx, conn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create gateway")
+	}
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	// HTTP 监听器：创建了一个监听特定地址的 HTTP 监听器，允许客户端通过 HTTP 协议连接到您的服务。
+	mux := http.NewServeMux()
+	// 只有 API 的 GET 请求有 ETag，swagger、指标和文件下载不经过这个中间件
+	mux.Handle("/", gapi.ETag(grpcMux))
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	// 生产环境一般关掉 SWAGGER_ENABLED，不对外暴露 API 文档
+	if config.SwaggerEnabled {
+		swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(http.FS(doc.SwaggerFS())))
+		mux.Handle("/swagger/", swaggerHandler)
+	}
+	// GraphQL 不经过 grpc-gateway，所以单独加上认证和限流的中间件
+	if config.GraphQLEnabled {
+		mux.Handle(graph.Path, server.HttpRateLimit(server.HttpAuthenticate(graph.NewHandler(store))))
+	}
+	// 指标和 pprof 不对外公开，指标正常由 runMetricsServer 在内网地址上提供
+	if config.DebugEnabled() {
+		gapi.RegisterMetricsHandlers(mux)
+		gapi.RegisterDebugHandlers(mux)
+	}
 
-	//3.注册服务处理程序：通过调用 pb.RegisterSimpleBankHandlerServer，
-	//将 gRPC 服务注册到了 gRPC-Gateway，这样 HTTP 请求就可以转发到相应的 gRPC 方法。
-	err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
+	// local blob store 的签名下载链接由 gateway 返回文件，S3 的链接直接指向 S3
+	blobStore, err := storage.NewBlobStore(config)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot register handler server")
+		log.Fatal().Err(err).Msg("cannot create blob store")
+	}
+	if localStore, ok := blobStore.(*storage.LocalStore); ok {
+		mux.Handle(storage.LocalBlobPath, server.HttpRateLimit(http.StripPrefix(storage.LocalBlobPath, localStore.Handler())))
 	}
 
-	//4.HTTP 监听器：创建了一个监听特定地址的 HTTP 监听器，允许客户端通过 HTTP 协议连接到您的服务。
-	mux := http.NewServeMux()
-	mux.Handle("/", grpcMux)
+	listeners, err := listen(config.HTTPServerAddress)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create listener")
+	}
 
-	statikFS, err := fs.New()
+	log.Info().Strs("addresses", listenerAddresses(listeners)).Msg("start HTTP gateway server")
+	// 中间件的顺序见 gapi.Server.HTTPHandler
+	handler := server.HTTPHandler(mux)
+	// gRPC-Web 请求在 HTTP 中间件之前分出去，由 gRPC 的拦截器处理
+	if config.GRPCWebEnabled {
+		handler = server.GrpcWeb(grpcServer)(handler)
+	}
+	httpServer := newHTTPServer(config, handler)
+	err = serveListeners(ctx, listeners, httpServer.Serve, func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			httpServer.Close()
+		}
+	})
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create statik fs")
+		log.Fatal().Err(err).Msg("cannot start HTTP gateway server")
+	}
+	log.Info().Msg("HTTP gateway server stopped")
+}
+
+/*
+runMetricsServer 在 METRICS_SERVER_ADDRESS 上提供 /metrics 和 /debug/vars，给 Prometheus 抓取。
+这个地址只应该在内网或者 Pod 里可以访问，没有认证；没有配置时不启动。
+*/
+func runMetricsServer(ctx context.Context, config util.Config) {
+	if config.MetricsServerAddress == "" {
+		return
 	}
 
-	swaggerHandler := http.StripPrefix("/swagger/", http.FileServer(statikFS))
-	mux.Handle("/swagger/", swaggerHandler)
+	mux := http.NewServeMux()
+	gapi.RegisterMetricsHandlers(mux)
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
+	listeners, err := listen(config.MetricsServerAddress)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot create listener")
+		log.Fatal().Err(err).Msg("cannot create metrics listener")
 	}
 
-	log.Printf("start HTTP gateway server at %s", listener.Addr().String())
-	handler := gapi.HttpLogger(mux)
-	err = http.Serve(listener, handler)
+	log.Info().Strs("addresses", listenerAddresses(listeners)).Msg("start metrics server")
+	httpServer := newHTTPServer(config, mux)
+	err = serveListeners(ctx, listeners, httpServer.Serve, func() {
+		httpServer.Close()
+	})
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start HTTP gateway server")
+		log.Fatal().Err(err).Msg("cannot start metrics server")
 	}
+	log.Info().Msg("metrics server stopped")
 }
 
-func runGinServer(config util.Config, store db.Store) {
-	server, err := api.NewServer(config, store)
+/*
+runGinServer 在 GIN_SERVER_ADDRESS 上提供 Gin 版本的 API，给还没有换成 HTTP 网关的客户端用；没有配置时不启动。
+和网关一样，ctx 取消之后停止接受新的连接，等正在处理的请求结束（最多 shutdownTimeout）。
+*/
+func runGinServer(ctx context.Context, config util.Config, store db.Store, taskDistributor worker.TaskDistributor, revocationStore token.RevocationStore) {
+	if config.GinServerAddress == "" {
+		return
+	}
+
+	server, err := api.NewServer(config, store, taskDistributor, revocationStore)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	err = server.Start(config.HTTPServerAddress)
+	listeners, err := listen(config.GinServerAddress)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot create Gin server listener")
+	}
+
+	log.Info().Strs("addresses", listenerAddresses(listeners)).Msg("start Gin server")
+	httpServer := newHTTPServer(config, server.Handler())
+	err = serveListeners(ctx, listeners, httpServer.Serve, func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			httpServer.Close()
+		}
+	})
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot start server")
+		log.Fatal().Err(err).Msg("cannot start Gin server")
 	}
+	log.Info().Msg("Gin server stopped")
 }
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestRootCommand(t *testing.T) {
+	cmd := newRootCommand()
+
+	for _, path := range [][]string{
+		{"serve"},
+		{"worker"},
+		{"migrate", "up"},
+		{"migrate", "down"},
+		{"migrate", "version"},
+		{"migrate", "status"},
+		{"create-admin"},
+		{"seed"},
+		{"loadtest"},
+	} {
+		found, _, err := cmd.Find(path)
+		require.NoError(t, err)
+		require.Equal(t, path[len(path)-1], found.Name())
+	}
+}
+
+func TestParseMigrateDownSteps(t *testing.T) {
+	steps, err := parseMigrateDownSteps(nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, steps)
+
+	steps, err = parseMigrateDownSteps([]string{"3"})
+	require.NoError(t, err)
+	require.Equal(t, 3, steps)
+
+	for _, arg := range []string{"0", "-1", "abc"} {
+		_, err = parseMigrateDownSteps([]string{arg})
+		require.Error(t, err)
+	}
+}
+
+func TestNewCreateAdminUserParams(t *testing.T) {
+	password := util.RandomString(6)
+	arg, err := newCreateAdminUserParams("admin_user", "Admin User", "admin@example.com", password)
+	require.NoError(t, err)
+	require.Equal(t, "admin_user", arg.Username)
+	require.NoError(t, util.CheckPassword(password, arg.HashedPassword))
+
+	_, err = newCreateAdminUserParams("Admin!", "Admin User", "admin@example.com", password)
+	require.Error(t, err)
+
+	_, err = newCreateAdminUserParams("admin_user", "Admin User", "invalid-email", password)
+	require.Error(t, err)
+
+	_, err = newCreateAdminUserParams("admin_user", "Admin User", "admin@example.com", "123")
+	require.Error(t, err)
+}
+
+func TestNewHTTPServer(t *testing.T) {
+	config := util.Config{
+		HTTPReadHeaderTimeout: 5 * time.Second,
+		HTTPReadTimeout:       30 * time.Second,
+		HTTPWriteTimeout:      30 * time.Second,
+		HTTPIdleTimeout:       2 * time.Minute,
+		HTTPMaxHeaderBytes:    64 << 10,
+	}
+
+	server := newHTTPServer(config, http.NotFoundHandler())
+	require.Equal(t, config.HTTPReadHeaderTimeout, server.ReadHeaderTimeout)
+	require.Equal(t, config.HTTPReadTimeout, server.ReadTimeout)
+	require.Equal(t, config.HTTPWriteTimeout, server.WriteTimeout)
+	require.Equal(t, config.HTTPIdleTimeout, server.IdleTimeout)
+	require.Equal(t, config.HTTPMaxHeaderBytes, server.MaxHeaderBytes)
+}
+
+func TestListen(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "grpc.sock")
+
+	listeners, err := listen("127.0.0.1:0,unix:" + socketPath)
+	require.NoError(t, err)
+	require.Len(t, listeners, 2)
+	require.Equal(t, "tcp", listeners[0].Addr().Network())
+	require.Equal(t, "unix", listeners[1].Addr().Network())
+
+	// 还有进程在监听的 socket 不能删掉
+	_, err = listen("unix:" + socketPath)
+	require.ErrorContains(t, err, "in use")
+
+	// 关闭监听器的时候删除 socket 文件
+	for _, listener := range listeners {
+		require.NoError(t, listener.Close())
+	}
+	_, err = os.Stat(socketPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = listen("localhost")
+	require.Error(t, err)
+}
+
+func TestListenRemovesStaleSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "grpc.sock")
+
+	// 模拟被 kill -9 的进程留下的 socket 文件
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: socketPath, Net: "unix"})
+	require.NoError(t, err)
+	listener.SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+	_, err = os.Stat(socketPath)
+	require.NoError(t, err)
+
+	listeners, err := listen("unix:" + socketPath)
+	require.NoError(t, err)
+	require.NoError(t, listeners[0].Close())
+
+	// 不是 socket 的文件不会被删掉
+	filePath := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(filePath, []byte("data"), 0o600))
+	_, err = listen("unix:" + filePath)
+	require.ErrorContains(t, err, "not a socket")
+}
+
+func TestServeListeners(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "http.sock")
+	listeners, err := listen("127.0.0.1:0,unix:" + socketPath)
+	require.NoError(t, err)
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- serveListeners(ctx, listeners, server.Serve, func() {
+			server.Shutdown(context.Background())
+		})
+	}()
+
+	// 两个地址都能访问
+	rsp, err := http.Get("http://" + listeners[0].Addr().String())
+	require.NoError(t, err)
+	rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+
+	unixClient := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+		},
+	}}
+	rsp, err = unixClient.Get("http://unix/")
+	require.NoError(t, err)
+	rsp.Body.Close()
+	require.Equal(t, http.StatusOK, rsp.StatusCode)
+	unixClient.CloseIdleConnections()
+
+	cancel()
+	select {
+	case err = <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+	_, err = os.Stat(socketPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
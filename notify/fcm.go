@@ -0,0 +1,106 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+/*
+FCMSender 通过 Firebase Cloud Messaging 的 HTTP v1 API 给手机推送通知。
+v1 API 需要 OAuth2 access token，这里用 service account 的私钥自己换 token（见 token_source.go），
+recipient 是手机 app 注册之后拿到的 device token。
+*/
+
+const (
+	fcmBaseURL        = "https://fcm.googleapis.com"
+	fcmRequestTimeout = 10 * time.Second
+	fcmScope          = "https://www.googleapis.com/auth/firebase.messaging"
+)
+
+type FCMSender struct {
+	projectID   string
+	baseURL     string
+	tokenSource *serviceAccountTokenSource
+	client      *http.Client
+}
+
+// NewFCMSender reads the service account JSON key downloaded from the Firebase console
+func NewFCMSender(credentialsFile string) (Notifier, error) {
+	data, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	client := &http.Client{Timeout: fcmRequestTimeout}
+	tokenSource, err := newServiceAccountTokenSource(data, fcmScope, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FCMSender{
+		projectID:   tokenSource.account.ProjectID,
+		baseURL:     fcmBaseURL,
+		tokenSource: tokenSource,
+		client:      client,
+	}, nil
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmMessage struct {
+	Token        string          `json:"token"`
+	Notification fcmNotification `json:"notification"`
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+func (sender *FCMSender) Notify(ctx context.Context, recipient string, title string, body string) error {
+	accessToken, err := sender.tokenSource.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get fcm access token: %w", err)
+	}
+
+	payload, err := json.Marshal(fcmRequest{
+		Message: fcmMessage{
+			Token: recipient,
+			Notification: fcmNotification{
+				Title: title,
+				Body:  body,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal fcm message: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/projects/%s/messages:send", sender.baseURL, sender.projectID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create fcm request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	rsp, err := sender.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call fcm: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("fcm returned status %d: %s", rsp.StatusCode, errBody)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeServiceAccount(t *testing.T, tokenURI string) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(serviceAccount{
+		ProjectID:   "simple-bank",
+		PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail: "bank@simple-bank.iam.gserviceaccount.com",
+		TokenURI:    tokenURI,
+	})
+	require.NoError(t, err)
+
+	file := filepath.Join(t.TempDir(), "service_account.json")
+	require.NoError(t, os.WriteFile(file, data, 0600))
+	return file
+}
+
+func TestFCMSender(t *testing.T) {
+	tokenRequests := 0
+	var msg fcmRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+		require.NotEmpty(t, r.PostForm.Get("assertion"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"test-token","expires_in":3600,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/v1/projects/simple-bank/messages:send", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		w.Write([]byte(`{"name":"projects/simple-bank/messages/1"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	notifier, err := NewFCMSender(writeServiceAccount(t, server.URL+"/token"))
+	require.NoError(t, err)
+
+	sender := notifier.(*FCMSender)
+	sender.baseURL = server.URL
+
+	for i := 0; i < 2; i++ {
+		err = sender.Notify(context.Background(), "device-token", "Transfer alert", "You received 10 USD")
+		require.NoError(t, err)
+	}
+
+	// access token 会被缓存
+	require.Equal(t, 1, tokenRequests)
+	require.Equal(t, "device-token", msg.Message.Token)
+	require.Equal(t, "Transfer alert", msg.Message.Notification.Title)
+}
+
+func TestNewFCMSenderInvalidCredentials(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "service_account.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"private_key":"not a key"}`), 0600))
+
+	_, err := NewFCMSender(file)
+	require.Error(t, err)
+}
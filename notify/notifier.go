@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/techschool/bank/mail"
+	"github.com/techschool/bank/util"
+)
+
+/*
+Notifier 是所有通知渠道（邮件、短信、手机推送）的统一接口，
+worker 根据用户的通知偏好选出渠道，再用对应的 Notifier 发送。
+
+recipient 的含义取决于渠道：邮件是邮箱地址，短信是 E.164 格式的手机号，推送是设备的 FCM token。
+*/
+type Notifier interface {
+	Notify(ctx context.Context, recipient string, title string, body string) error
+}
+
+// Supported notification channels
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+	ChannelPush  = "push"
+)
+
+// Notifiers 按渠道保存可用的 Notifier，没有配置的渠道不在里面
+type Notifiers map[string]Notifier
+
+// NewNotifiers 创建所有已配置的渠道：邮件总是可用的，
+// 短信需要配置 Twilio，推送需要配置 FCM 的 service account。
+func NewNotifiers(config util.Config, mailer mail.EmailSender) (Notifiers, error) {
+	notifiers := Notifiers{
+		ChannelEmail: NewEmailNotifier(mailer),
+	}
+
+	if config.TwilioAccountSID != "" {
+		notifiers[ChannelSMS] = NewTwilioSender(config.TwilioAccountSID, config.TwilioAuthToken, config.TwilioFromNumber)
+	}
+
+	if config.FCMCredentialsFile != "" {
+		push, err := NewFCMSender(config.FCMCredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create fcm sender: %w", err)
+		}
+		notifiers[ChannelPush] = push
+	}
+
+	return notifiers, nil
+}
+
+type EmailNotifier struct {
+	mailer mail.EmailSender
+}
+
+// NewEmailNotifier adapts a mail.EmailSender to the Notifier interface
+func NewEmailNotifier(mailer mail.EmailSender) Notifier {
+	return &EmailNotifier{
+		mailer: mailer,
+	}
+}
+
+func (notifier *EmailNotifier) Notify(ctx context.Context, recipient string, title string, body string) error {
+	content := strings.ReplaceAll(html.EscapeString(body), "\n", "<br/>\n")
+	return notifier.mailer.SendEmail(title, content, body, []string{recipient}, nil, nil, nil)
+}
@@ -0,0 +1,157 @@
+package notify
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+Google 的 service account 换 access token 的流程（OAuth2 JWT bearer grant）：
+ 1. 用 service account 的私钥对一个 JWT 做 RS256 签名，里面写明 client_email、scope 和 token_uri
+ 2. 把 JWT POST 到 token_uri，换回一个一小时有效的 access token
+
+token 会缓存起来，快过期的时候再重新换，避免每次推送都多请求一次。
+*/
+
+const tokenRefreshMargin = time.Minute
+
+type serviceAccount struct {
+	ProjectID   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type serviceAccountTokenSource struct {
+	account    serviceAccount
+	privateKey *rsa.PrivateKey
+	scope      string
+	client     *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func newServiceAccountTokenSource(credentials []byte, scope string, client *http.Client) (*serviceAccountTokenSource, error) {
+	var account serviceAccount
+	if err := json.Unmarshal(credentials, &account); err != nil {
+		return nil, fmt.Errorf("failed to parse service account: %w", err)
+	}
+
+	block, _ := pem.Decode([]byte(account.PrivateKey))
+	if block == nil {
+		return nil, errors.New("invalid service account private key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account private key: %w", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("service account private key is not an RSA key")
+	}
+
+	return &serviceAccountTokenSource{
+		account:    account,
+		privateKey: privateKey,
+		scope:      scope,
+		client:     client,
+	}, nil
+}
+
+// Token returns a cached access token, or exchanges a new one when it is about to expire
+func (source *serviceAccountTokenSource) Token(ctx context.Context) (string, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.accessToken != "" && time.Now().Add(tokenRefreshMargin).Before(source.expiresAt) {
+		return source.accessToken, nil
+	}
+
+	assertion, err := source.signAssertion(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, source.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := source.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return "", fmt.Errorf("token endpoint returned status %d: %s", rsp.StatusCode, errBody)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	source.accessToken = token.AccessToken
+	source.expiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	return source.accessToken, nil
+}
+
+func (source *serviceAccountTokenSource) signAssertion(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"iss":   source.account.ClientEmail,
+		"scope": source.scope,
+		"aud":   source.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(unsigned))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, source.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign assertion: %w", err)
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+/*
+TwilioSender 通过 Twilio 的 Messages API 发送短信，
+用 Account SID 和 Auth Token 做 HTTP Basic 认证。
+短信没有标题，所以 title 和 body 拼在一起发送。
+*/
+
+const (
+	twilioBaseURL        = "https://api.twilio.com"
+	twilioRequestTimeout = 10 * time.Second
+)
+
+type TwilioSender struct {
+	accountSID string
+	authToken  string
+	fromNumber string
+	baseURL    string
+	client     *http.Client
+}
+
+func NewTwilioSender(accountSID string, authToken string, fromNumber string) Notifier {
+	return &TwilioSender{
+		accountSID: accountSID,
+		authToken:  authToken,
+		fromNumber: fromNumber,
+		baseURL:    twilioBaseURL,
+		client:     &http.Client{Timeout: twilioRequestTimeout},
+	}
+}
+
+func (sender *TwilioSender) Notify(ctx context.Context, recipient string, title string, body string) error {
+	form := url.Values{}
+	form.Set("To", recipient)
+	form.Set("From", sender.fromNumber)
+	form.Set("Body", fmt.Sprintf("%s: %s", title, body))
+
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/Messages.json", sender.baseURL, url.PathEscape(sender.accountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create twilio request: %w", err)
+	}
+	req.SetBasicAuth(sender.accountSID, sender.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	rsp, err := sender.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call twilio: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("twilio returned status %d: %s", rsp.StatusCode, errBody)
+	}
+	return nil
+}
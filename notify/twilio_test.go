@@ -0,0 +1,48 @@
+package notify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTwilioSender(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+
+		username, password, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "AC123", username)
+		require.Equal(t, "secret", password)
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "+15550001111", r.PostForm.Get("To"))
+		require.Equal(t, "+15559998888", r.PostForm.Get("From"))
+		require.Equal(t, "Transfer alert: You received 10 USD", r.PostForm.Get("Body"))
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	sender := NewTwilioSender("AC123", "secret", "+15559998888").(*TwilioSender)
+	sender.baseURL = server.URL
+
+	err := sender.Notify(context.Background(), "+15550001111", "Transfer alert", "You received 10 USD")
+	require.NoError(t, err)
+}
+
+func TestTwilioSenderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	sender := NewTwilioSender("AC123", "secret", "+15559998888").(*TwilioSender)
+	sender.baseURL = server.URL
+
+	err := sender.Notify(context.Background(), "+15550001111", "Transfer alert", "You received 10 USD")
+	require.ErrorContains(t, err, "400")
+}
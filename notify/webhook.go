@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/techschool/bank/version"
+)
+
+/*
+WebhookSender 把事件以 JSON 的形式 POST 到配置的地址，用于通知外部系统（比如外部转账的状态变化）。
+请求头里带上事件类型、时间戳和签名，签名是用共享密钥对 "时间戳.请求体" 做 HMAC-SHA256，
+接收方用同一个密钥重新计算就可以确认请求来自我们并且没有被篡改，时间戳可以用来拒绝重放的旧请求。
+User-Agent 里带上服务的版本（比如 "bank/v1.2.0"），接收方排查问题时可以知道是哪个版本发出的请求。
+*/
+
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+
+	webhookRequestTimeout = 10 * time.Second
+)
+
+type WebhookSender struct {
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func NewWebhookSender(url string, secret string) *WebhookSender {
+	return &WebhookSender{
+		url:    url,
+		secret: []byte(secret),
+		client: &http.Client{Timeout: webhookRequestTimeout},
+	}
+}
+
+// Send posts the event payload to the webhook URL. A non-2xx response is returned as an error so the caller can retry.
+func (sender *WebhookSender) Send(ctx context.Context, event string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", version.UserAgent())
+	req.Header.Set(WebhookEventHeader, event)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(sender.secret, timestamp, body))
+
+	rsp, err := sender.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("webhook returned status %d: %s", rsp.StatusCode, data)
+	}
+	return nil
+}
+
+// SignWebhook returns the hex encoded HMAC-SHA256 signature of "timestamp.body"
+func SignWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/version"
+)
+
+func TestWebhookSender(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "external_transfer.settled", r.Header.Get(WebhookEventHeader))
+		require.Equal(t, version.UserAgent(), r.Header.Get("User-Agent"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":1,"status":"settled"}`, string(body))
+
+		timestamp := r.Header.Get(WebhookTimestampHeader)
+		require.NotEmpty(t, timestamp)
+		require.Equal(t, SignWebhook([]byte("secret"), timestamp, body), r.Header.Get(WebhookSignatureHeader))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(server.URL, "secret")
+	err := sender.Send(context.Background(), "external_transfer.settled", map[string]interface{}{
+		"id":     1,
+		"status": "settled",
+	})
+	require.NoError(t, err)
+}
+
+func TestWebhookSenderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(server.URL, "secret")
+	err := sender.Send(context.Background(), "external_transfer.settled", map[string]interface{}{"id": 1})
+	require.Error(t, err)
+}
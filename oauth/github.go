@@ -0,0 +1,84 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+/*
+GitHubProvider 使用 GitHub 的 OAuth App 登录。
+GitHub 不是 OpenID Connect，用户的邮箱可能是私密的，
+所以 /user 里没有邮箱的时候，还要调用 /user/emails 找到已验证的主邮箱。
+*/
+
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	githubAPIURL   = "https://api.github.com"
+	githubScope    = "read:user user:email"
+)
+
+type GitHubProvider struct {
+	clientCredentials
+	authURL  string
+	tokenURL string
+	apiURL   string
+}
+
+func NewGitHubProvider(clientID string, clientSecret string, redirectURL string) Provider {
+	return &GitHubProvider{
+		clientCredentials: newClientCredentials(clientID, clientSecret, redirectURL),
+		authURL:           githubAuthURL,
+		tokenURL:          githubTokenURL,
+		apiURL:            githubAPIURL,
+	}
+}
+
+func (provider *GitHubProvider) AuthCodeURL(state string) string {
+	return provider.authCodeURL(provider.authURL, githubScope, state)
+}
+
+func (provider *GitHubProvider) Exchange(ctx context.Context, code string) (*UserInfo, error) {
+	accessToken, err := provider.exchangeCode(ctx, provider.tokenURL, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	err = provider.getJSON(ctx, provider.apiURL+"/user", accessToken, &user)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("github user has no id")
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	err = provider.getJSON(ctx, provider.apiURL+"/user/emails", accessToken, &emails)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &UserInfo{
+		Subject: strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+		Login:   user.Login,
+	}
+	for _, email := range emails {
+		if email.Primary {
+			info.Email = email.Email
+			info.EmailVerified = email.Verified
+			break
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,57 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitHubProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login/oauth/access_token":
+			require.Equal(t, "application/json", r.Header.Get("Accept"))
+			require.NoError(t, r.ParseForm())
+
+			// GitHub 对无效的 code 也返回 200
+			if r.PostForm.Get("code") != "good-code" {
+				json.NewEncoder(w).Encode(map[string]string{"error": "bad_verification_code"})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "github-token"})
+		case "/user":
+			require.Equal(t, "Bearer github-token", r.Header.Get("Authorization"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"id":    42,
+				"login": "octocat",
+				"name":  "The Octocat",
+			})
+		case "/user/emails":
+			json.NewEncoder(w).Encode([]map[string]interface{}{
+				{"email": "old@example.com", "primary": false, "verified": true},
+				{"email": "octocat@example.com", "primary": true, "verified": true},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewGitHubProvider("client-id", "client-secret", "http://localhost/v1/oauth/github/callback").(*GitHubProvider)
+	provider.tokenURL = server.URL + "/login/oauth/access_token"
+	provider.apiURL = server.URL
+
+	info, err := provider.Exchange(context.Background(), "good-code")
+	require.NoError(t, err)
+	require.Equal(t, "42", info.Subject)
+	require.Equal(t, "octocat", info.Login)
+	require.Equal(t, "octocat@example.com", info.Email)
+	require.True(t, info.EmailVerified)
+
+	_, err = provider.Exchange(context.Background(), "bad-code")
+	require.ErrorIs(t, err, ErrInvalidCode)
+}
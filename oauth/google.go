@@ -0,0 +1,66 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+)
+
+/*
+GoogleProvider 使用 Google 的 OpenID Connect 登录，
+换到 access token 之后调用 userinfo 接口读取 sub、邮箱和姓名。
+*/
+
+const (
+	googleAuthURL     = "https://accounts.google.com/o/oauth2/v2/auth"
+	googleTokenURL    = "https://oauth2.googleapis.com/token"
+	googleUserInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+	googleScope       = "openid email profile"
+)
+
+type GoogleProvider struct {
+	clientCredentials
+	authURL     string
+	tokenURL    string
+	userInfoURL string
+}
+
+func NewGoogleProvider(clientID string, clientSecret string, redirectURL string) Provider {
+	return &GoogleProvider{
+		clientCredentials: newClientCredentials(clientID, clientSecret, redirectURL),
+		authURL:           googleAuthURL,
+		tokenURL:          googleTokenURL,
+		userInfoURL:       googleUserInfoURL,
+	}
+}
+
+func (provider *GoogleProvider) AuthCodeURL(state string) string {
+	return provider.authCodeURL(provider.authURL, googleScope, state)
+}
+
+func (provider *GoogleProvider) Exchange(ctx context.Context, code string) (*UserInfo, error) {
+	accessToken, err := provider.exchangeCode(ctx, provider.tokenURL, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	err = provider.getJSON(ctx, provider.userInfoURL, accessToken, &info)
+	if err != nil {
+		return nil, err
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("google userinfo has no subject")
+	}
+
+	return &UserInfo{
+		Subject:       info.Subject,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		Name:          info.Name,
+	}, nil
+}
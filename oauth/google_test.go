@@ -0,0 +1,62 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoogleProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+			require.Equal(t, "client-id", r.PostForm.Get("client_id"))
+			require.Equal(t, "client-secret", r.PostForm.Get("client_secret"))
+			require.Equal(t, "http://localhost/v1/oauth/google/callback", r.PostForm.Get("redirect_uri"))
+
+			if r.PostForm.Get("code") != "good-code" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "google-token"})
+		case "/userinfo":
+			require.Equal(t, "Bearer google-token", r.Header.Get("Authorization"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"sub":            "1234567890",
+				"email":          "alice@example.com",
+				"email_verified": true,
+				"name":           "Alice Smith",
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewGoogleProvider("client-id", "client-secret", "http://localhost/v1/oauth/google/callback").(*GoogleProvider)
+	provider.tokenURL = server.URL + "/token"
+	provider.userInfoURL = server.URL + "/userinfo"
+
+	authURL, err := url.Parse(provider.AuthCodeURL("some-state"))
+	require.NoError(t, err)
+	require.Equal(t, "accounts.google.com", authURL.Host)
+	require.Equal(t, "some-state", authURL.Query().Get("state"))
+	require.Equal(t, "client-id", authURL.Query().Get("client_id"))
+
+	info, err := provider.Exchange(context.Background(), "good-code")
+	require.NoError(t, err)
+	require.Equal(t, "1234567890", info.Subject)
+	require.Equal(t, "alice@example.com", info.Email)
+	require.True(t, info.EmailVerified)
+	require.Equal(t, "Alice Smith", info.Name)
+
+	_, err = provider.Exchange(context.Background(), "bad-code")
+	require.ErrorIs(t, err, ErrInvalidCode)
+}
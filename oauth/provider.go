@@ -0,0 +1,170 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+Provider 封装了一个第三方登录（OAuth2 / OpenID Connect）提供方。
+登录流程是标准的授权码模式：
+ 1. 客户端拿到 AuthCodeURL，把用户重定向到提供方的登录页；
+ 2. 用户同意之后，提供方带着 code 和 state 重定向回我们的回调地址；
+ 3. 服务端用 Exchange 把 code 换成 access token，再用它读取用户信息。
+*/
+type Provider interface {
+	AuthCodeURL(state string) string
+	Exchange(ctx context.Context, code string) (*UserInfo, error)
+}
+
+// UserInfo 是从提供方读取到的用户信息，Subject 是用户在提供方那里不会变的 ID
+type UserInfo struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Login         string
+}
+
+// Supported OAuth providers
+const (
+	ProviderGoogle = "google"
+	ProviderGitHub = "github"
+)
+
+const oauthRequestTimeout = 10 * time.Second
+
+var ErrInvalidCode = errors.New("invalid authorization code")
+
+// Providers 按名字保存已配置的提供方，没有配置 client ID 的提供方不在里面
+type Providers map[string]Provider
+
+// NewProviders 创建所有已配置的提供方，回调地址是 OAUTH_REDIRECT_BASE_URL/<provider>/callback
+func NewProviders(config util.Config) Providers {
+	providers := Providers{}
+
+	if config.OAuthGoogleClientID != "" {
+		providers[ProviderGoogle] = NewGoogleProvider(
+			config.OAuthGoogleClientID,
+			config.OAuthGoogleClientSecret,
+			redirectURL(config.OAuthRedirectBaseURL, ProviderGoogle),
+		)
+	}
+
+	if config.OAuthGitHubClientID != "" {
+		providers[ProviderGitHub] = NewGitHubProvider(
+			config.OAuthGitHubClientID,
+			config.OAuthGitHubClientSecret,
+			redirectURL(config.OAuthRedirectBaseURL, ProviderGitHub),
+		)
+	}
+
+	return providers
+}
+
+func redirectURL(baseURL string, provider string) string {
+	return fmt.Sprintf("%s/%s/callback", strings.TrimSuffix(baseURL, "/"), provider)
+}
+
+// clientCredentials 是两个提供方共用的 OAuth2 客户端配置
+type clientCredentials struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	client       *http.Client
+}
+
+func newClientCredentials(clientID string, clientSecret string, redirectURL string) clientCredentials {
+	return clientCredentials{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		client:       &http.Client{Timeout: oauthRequestTimeout},
+	}
+}
+
+func (credentials clientCredentials) authCodeURL(authURL string, scope string, state string) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", credentials.clientID)
+	query.Set("redirect_uri", credentials.redirectURL)
+	query.Set("scope", scope)
+	query.Set("state", state)
+	return authURL + "?" + query.Encode()
+}
+
+// exchangeCode 用授权码换 access token
+func (credentials clientCredentials) exchangeCode(ctx context.Context, tokenURL string, code string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", credentials.redirectURL)
+	form.Set("client_id", credentials.clientID)
+	form.Set("client_secret", credentials.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub 默认返回 form 编码，要显式要求 JSON
+	req.Header.Set("Accept", "application/json")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		Error       string `json:"error"`
+	}
+	err = credentials.do(req, &token)
+	if err != nil {
+		return "", err
+	}
+
+	// GitHub 在 code 无效时也返回 200，错误放在 body 里
+	if token.Error != "" || token.AccessToken == "" {
+		return "", ErrInvalidCode
+	}
+	return token.AccessToken, nil
+}
+
+// getJSON 带着 access token 调用提供方的 API
+func (credentials clientCredentials) getJSON(ctx context.Context, endpoint string, accessToken string, dst interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	return credentials.do(req, dst)
+}
+
+func (credentials clientCredentials) do(req *http.Request, dst interface{}) error {
+	rsp, err := credentials.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s: %w", req.URL.Host, err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode == http.StatusBadRequest || rsp.StatusCode == http.StatusUnauthorized {
+		return ErrInvalidCode
+	}
+	if rsp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return fmt.Errorf("%s returned status %d: %s", req.URL.Host, rsp.StatusCode, errBody)
+	}
+
+	err = json.NewDecoder(rsp.Body).Decode(dst)
+	if err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", req.URL.Host, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package oauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+/*
+state 参数用来防止 CSRF：发起登录时生成，回调时原样带回来，服务端要确认是自己签发的。
+这里不需要把 state 存起来，而是把过期时间和随机数一起用 HMAC 签名，
+回调的时候重新计算签名就能验证。签名里包含提供方的名字，一个提供方的 state 不能拿到另一个提供方用。
+*/
+
+const StateDuration = 10 * time.Minute
+
+var ErrInvalidState = errors.New("invalid oauth state")
+
+// NewState 为指定的提供方生成一个签名过的 state
+func NewState(key []byte, provider string, now time.Time) (string, error) {
+	payload := make([]byte, 16+8)
+	_, err := rand.Read(payload[:16])
+	if err != nil {
+		return "", err
+	}
+	binary.BigEndian.PutUint64(payload[16:], uint64(now.Add(StateDuration).Unix()))
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + signState(key, provider, encoded), nil
+}
+
+// VerifyState 检查 state 的签名和过期时间
+func VerifyState(key []byte, provider string, state string, now time.Time) error {
+	encoded, signature, found := strings.Cut(state, ".")
+	if !found {
+		return ErrInvalidState
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(signState(key, provider, encoded))) {
+		return ErrInvalidState
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(payload) != 16+8 {
+		return ErrInvalidState
+	}
+
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[16:])), 0)
+	if now.After(expiresAt) {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+func signState(key []byte, provider string, encoded string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(provider + ":" + encoded))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,26 @@
+package oauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestState(t *testing.T) {
+	key := []byte(util.RandomString(32))
+	now := time.Now()
+
+	state, err := NewState(key, ProviderGoogle, now)
+	require.NoError(t, err)
+
+	require.NoError(t, VerifyState(key, ProviderGoogle, state, now))
+
+	// 换了提供方、换了密钥、过期或者被篡改都不行
+	require.ErrorIs(t, VerifyState(key, ProviderGitHub, state, now), ErrInvalidState)
+	require.ErrorIs(t, VerifyState([]byte(util.RandomString(32)), ProviderGoogle, state, now), ErrInvalidState)
+	require.ErrorIs(t, VerifyState(key, ProviderGoogle, state, now.Add(StateDuration+time.Minute)), ErrInvalidState)
+	require.ErrorIs(t, VerifyState(key, ProviderGoogle, "x"+state, now), ErrInvalidState)
+	require.ErrorIs(t, VerifyState(key, ProviderGoogle, "", now), ErrInvalidState)
+}
@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: activity.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ActivityItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ActivityItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActivityItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ActivityItemMultiError, or
+// nil if none found.
+func (m *ActivityItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActivityItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ActivityItemValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ActivityItemValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ActivityItemValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Details.(type) {
+	case *ActivityItem_MoneyMovement:
+		if v == nil {
+			err := ActivityItemValidationError{
+				field:  "Details",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMoneyMovement()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "MoneyMovement",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "MoneyMovement",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMoneyMovement()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ActivityItemValidationError{
+					field:  "MoneyMovement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ActivityItem_CardAuthorization:
+		if v == nil {
+			err := ActivityItemValidationError{
+				field:  "Details",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCardAuthorization()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "CardAuthorization",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "CardAuthorization",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCardAuthorization()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ActivityItemValidationError{
+					field:  "CardAuthorization",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ActivityItem_SecurityEvent:
+		if v == nil {
+			err := ActivityItemValidationError{
+				field:  "Details",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSecurityEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "SecurityEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ActivityItemValidationError{
+						field:  "SecurityEvent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSecurityEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ActivityItemValidationError{
+					field:  "SecurityEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ActivityItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActivityItemMultiError is an error wrapping multiple validation errors
+// returned by ActivityItem.ValidateAll() if the designated constraints aren't met.
+type ActivityItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActivityItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActivityItemMultiError) AllErrors() []error { return m }
+
+// ActivityItemValidationError is the validation error returned by
+// ActivityItem.Validate if the designated constraints aren't met.
+type ActivityItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivityItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivityItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivityItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivityItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivityItemValidationError) ErrorName() string { return "ActivityItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ActivityItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivityItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivityItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivityItemValidationError{}
+
+// Validate checks the field values on MoneyMovementActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoneyMovementActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoneyMovementActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoneyMovementActivityMultiError, or nil if none found.
+func (m *MoneyMovementActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoneyMovementActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntryId
+
+	// no validation rules for TransferId
+
+	// no validation rules for Amount
+
+	// no validation rules for CounterpartyAccountId
+
+	// no validation rules for Memo
+
+	if len(errors) > 0 {
+		return MoneyMovementActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMovementActivityMultiError is an error wrapping multiple validation
+// errors returned by MoneyMovementActivity.ValidateAll() if the designated
+// constraints aren't met.
+type MoneyMovementActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMovementActivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMovementActivityMultiError) AllErrors() []error { return m }
+
+// MoneyMovementActivityValidationError is the validation error returned by
+// MoneyMovementActivity.Validate if the designated constraints aren't met.
+type MoneyMovementActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyMovementActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyMovementActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyMovementActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyMovementActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyMovementActivityValidationError) ErrorName() string {
+	return "MoneyMovementActivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoneyMovementActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoneyMovementActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyMovementActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyMovementActivityValidationError{}
+
+// Validate checks the field values on CardAuthorizationActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CardAuthorizationActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CardAuthorizationActivity with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CardAuthorizationActivityMultiError, or nil if none found.
+func (m *CardAuthorizationActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CardAuthorizationActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HoldId
+
+	// no validation rules for CardId
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	// no validation rules for Description
+
+	// no validation rules for TransferId
+
+	if len(errors) > 0 {
+		return CardAuthorizationActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// CardAuthorizationActivityMultiError is an error wrapping multiple validation
+// errors returned by CardAuthorizationActivity.ValidateAll() if the
+// designated constraints aren't met.
+type CardAuthorizationActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CardAuthorizationActivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CardAuthorizationActivityMultiError) AllErrors() []error { return m }
+
+// CardAuthorizationActivityValidationError is the validation error returned by
+// CardAuthorizationActivity.Validate if the designated constraints aren't met.
+type CardAuthorizationActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CardAuthorizationActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CardAuthorizationActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CardAuthorizationActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CardAuthorizationActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CardAuthorizationActivityValidationError) ErrorName() string {
+	return "CardAuthorizationActivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CardAuthorizationActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCardAuthorizationActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CardAuthorizationActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CardAuthorizationActivityValidationError{}
+
+// Validate checks the field values on SecurityEventActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SecurityEventActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecurityEventActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SecurityEventActivityMultiError, or nil if none found.
+func (m *SecurityEventActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecurityEventActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Event
+
+	// no validation rules for UserAgent
+
+	// no validation rules for ClientIp
+
+	// no validation rules for Country
+
+	// no validation rules for City
+
+	if len(errors) > 0 {
+		return SecurityEventActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecurityEventActivityMultiError is an error wrapping multiple validation
+// errors returned by SecurityEventActivity.ValidateAll() if the designated
+// constraints aren't met.
+type SecurityEventActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecurityEventActivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecurityEventActivityMultiError) AllErrors() []error { return m }
+
+// SecurityEventActivityValidationError is the validation error returned by
+// SecurityEventActivity.Validate if the designated constraints aren't met.
+type SecurityEventActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecurityEventActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecurityEventActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecurityEventActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecurityEventActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecurityEventActivityValidationError) ErrorName() string {
+	return "SecurityEventActivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SecurityEventActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecurityEventActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecurityEventActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecurityEventActivityValidationError{}
@@ -0,0 +1,659 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: dashboard.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CurrencyDeposits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CurrencyDeposits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CurrencyDeposits with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CurrencyDepositsMultiError, or nil if none found.
+func (m *CurrencyDeposits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CurrencyDeposits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	// no validation rules for AccountCount
+
+	// no validation rules for TotalBalance
+
+	if len(errors) > 0 {
+		return CurrencyDepositsMultiError(errors)
+	}
+
+	return nil
+}
+
+// CurrencyDepositsMultiError is an error wrapping multiple validation errors
+// returned by CurrencyDeposits.ValidateAll() if the designated constraints
+// aren't met.
+type CurrencyDepositsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CurrencyDepositsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CurrencyDepositsMultiError) AllErrors() []error { return m }
+
+// CurrencyDepositsValidationError is the validation error returned by
+// CurrencyDeposits.Validate if the designated constraints aren't met.
+type CurrencyDepositsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CurrencyDepositsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CurrencyDepositsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CurrencyDepositsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CurrencyDepositsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CurrencyDepositsValidationError) ErrorName() string { return "CurrencyDepositsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CurrencyDepositsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCurrencyDeposits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CurrencyDepositsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CurrencyDepositsValidationError{}
+
+// Validate checks the field values on DailyTransferVolume with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DailyTransferVolume) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyTransferVolume with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DailyTransferVolumeMultiError, or nil if none found.
+func (m *DailyTransferVolume) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyTransferVolume) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DailyTransferVolumeValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DailyTransferVolumeValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DailyTransferVolumeValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Currency
+
+	// no validation rules for TransferCount
+
+	// no validation rules for TotalAmount
+
+	if len(errors) > 0 {
+		return DailyTransferVolumeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyTransferVolumeMultiError is an error wrapping multiple validation
+// errors returned by DailyTransferVolume.ValidateAll() if the designated
+// constraints aren't met.
+type DailyTransferVolumeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyTransferVolumeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyTransferVolumeMultiError) AllErrors() []error { return m }
+
+// DailyTransferVolumeValidationError is the validation error returned by
+// DailyTransferVolume.Validate if the designated constraints aren't met.
+type DailyTransferVolumeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyTransferVolumeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyTransferVolumeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyTransferVolumeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyTransferVolumeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyTransferVolumeValidationError) ErrorName() string {
+	return "DailyTransferVolumeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DailyTransferVolumeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyTransferVolume.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyTransferVolumeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyTransferVolumeValidationError{}
+
+// Validate checks the field values on DailyNewUsers with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyNewUsers) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyNewUsers with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyNewUsersMultiError, or
+// nil if none found.
+func (m *DailyNewUsers) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyNewUsers) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DailyNewUsersValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DailyNewUsersValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DailyNewUsersValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserCount
+
+	if len(errors) > 0 {
+		return DailyNewUsersMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyNewUsersMultiError is an error wrapping multiple validation errors
+// returned by DailyNewUsers.ValidateAll() if the designated constraints
+// aren't met.
+type DailyNewUsersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyNewUsersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyNewUsersMultiError) AllErrors() []error { return m }
+
+// DailyNewUsersValidationError is the validation error returned by
+// DailyNewUsers.Validate if the designated constraints aren't met.
+type DailyNewUsersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyNewUsersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyNewUsersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyNewUsersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyNewUsersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyNewUsersValidationError) ErrorName() string { return "DailyNewUsersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyNewUsersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyNewUsers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyNewUsersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyNewUsersValidationError{}
+
+// Validate checks the field values on DailyFailedLogins with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DailyFailedLogins) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyFailedLogins with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DailyFailedLoginsMultiError, or nil if none found.
+func (m *DailyFailedLogins) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyFailedLogins) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DailyFailedLoginsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DailyFailedLoginsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DailyFailedLoginsValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FailedCount
+
+	// no validation rules for UsernameCount
+
+	// no validation rules for ClientIpCount
+
+	if len(errors) > 0 {
+		return DailyFailedLoginsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyFailedLoginsMultiError is an error wrapping multiple validation errors
+// returned by DailyFailedLogins.ValidateAll() if the designated constraints
+// aren't met.
+type DailyFailedLoginsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyFailedLoginsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyFailedLoginsMultiError) AllErrors() []error { return m }
+
+// DailyFailedLoginsValidationError is the validation error returned by
+// DailyFailedLogins.Validate if the designated constraints aren't met.
+type DailyFailedLoginsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyFailedLoginsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyFailedLoginsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyFailedLoginsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyFailedLoginsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyFailedLoginsValidationError) ErrorName() string {
+	return "DailyFailedLoginsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DailyFailedLoginsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyFailedLogins.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyFailedLoginsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyFailedLoginsValidationError{}
+
+// Validate checks the field values on AccountActivity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AccountActivity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountActivityMultiError, or nil if none found.
+func (m *AccountActivity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountActivity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Owner
+
+	// no validation rules for Currency
+
+	// no validation rules for EntryCount
+
+	// no validation rules for TotalIn
+
+	// no validation rules for TotalOut
+
+	if len(errors) > 0 {
+		return AccountActivityMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountActivityMultiError is an error wrapping multiple validation errors
+// returned by AccountActivity.ValidateAll() if the designated constraints
+// aren't met.
+type AccountActivityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountActivityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountActivityMultiError) AllErrors() []error { return m }
+
+// AccountActivityValidationError is the validation error returned by
+// AccountActivity.Validate if the designated constraints aren't met.
+type AccountActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountActivityValidationError) ErrorName() string { return "AccountActivityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountActivityValidationError{}
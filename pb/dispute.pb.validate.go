@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: dispute.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Dispute with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Dispute) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Dispute with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DisputeMultiError, or nil if none found.
+func (m *Dispute) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Dispute) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TransferId
+
+	// no validation rules for OpenedBy
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
+	// no validation rules for Resolution
+
+	// no validation rules for ResolvedBy
+
+	// no validation rules for RefundTransferId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisputeValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisputeValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResolvedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "ResolvedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisputeValidationError{
+					field:  "ResolvedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResolvedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisputeValidationError{
+				field:  "ResolvedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisputeMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisputeMultiError is an error wrapping multiple validation errors returned
+// by Dispute.ValidateAll() if the designated constraints aren't met.
+type DisputeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisputeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisputeMultiError) AllErrors() []error { return m }
+
+// DisputeValidationError is the validation error returned by Dispute.Validate
+// if the designated constraints aren't met.
+type DisputeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisputeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisputeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisputeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisputeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisputeValidationError) ErrorName() string { return "DisputeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DisputeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDispute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisputeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisputeValidationError{}
+
+// Validate checks the field values on DisputeNote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DisputeNote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisputeNote with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DisputeNoteMultiError, or
+// nil if none found.
+func (m *DisputeNote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisputeNote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisputeId
+
+	// no validation rules for Author
+
+	// no validation rules for Note
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisputeNoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisputeNoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisputeNoteValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisputeNoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisputeNoteMultiError is an error wrapping multiple validation errors
+// returned by DisputeNote.ValidateAll() if the designated constraints aren't met.
+type DisputeNoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisputeNoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisputeNoteMultiError) AllErrors() []error { return m }
+
+// DisputeNoteValidationError is the validation error returned by
+// DisputeNote.Validate if the designated constraints aren't met.
+type DisputeNoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisputeNoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisputeNoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisputeNoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisputeNoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisputeNoteValidationError) ErrorName() string { return "DisputeNoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DisputeNoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisputeNote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisputeNoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisputeNoteValidationError{}
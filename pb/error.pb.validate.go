@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: error.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FieldViolation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldViolation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldViolationMultiError,
+// or nil if none found.
+func (m *FieldViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return FieldViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldViolationMultiError is an error wrapping multiple validation errors
+// returned by FieldViolation.ValidateAll() if the designated constraints
+// aren't met.
+type FieldViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldViolationMultiError) AllErrors() []error { return m }
+
+// FieldViolationValidationError is the validation error returned by
+// FieldViolation.Validate if the designated constraints aren't met.
+type FieldViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldViolationValidationError) ErrorName() string { return "FieldViolationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldViolationValidationError{}
+
+// Validate checks the field values on ErrorResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorResponseMultiError, or
+// nil if none found.
+func (m *ErrorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetFieldViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ErrorResponseValidationError{
+						field:  fmt.Sprintf("FieldViolations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ErrorResponseValidationError{
+						field:  fmt.Sprintf("FieldViolations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ErrorResponseValidationError{
+					field:  fmt.Sprintf("FieldViolations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for RequestId
+
+	if len(errors) > 0 {
+		return ErrorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorResponseMultiError is an error wrapping multiple validation errors
+// returned by ErrorResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ErrorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorResponseMultiError) AllErrors() []error { return m }
+
+// ErrorResponseValidationError is the validation error returned by
+// ErrorResponse.Validate if the designated constraints aren't met.
+type ErrorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorResponseValidationError) ErrorName() string { return "ErrorResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorResponseValidationError{}
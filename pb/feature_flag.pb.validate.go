@@ -0,0 +1,174 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: feature_flag.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FeatureFlag) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FeatureFlag with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FeatureFlagMultiError, or
+// nil if none found.
+func (m *FeatureFlag) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FeatureFlag) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Enabled
+
+	// no validation rules for RolloutPercentage
+
+	// no validation rules for Description
+
+	// no validation rules for UpdatedBy
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FeatureFlagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FeatureFlagValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FeatureFlagValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FeatureFlagMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeatureFlagMultiError is an error wrapping multiple validation errors
+// returned by FeatureFlag.ValidateAll() if the designated constraints aren't met.
+type FeatureFlagMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeatureFlagMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeatureFlagMultiError) AllErrors() []error { return m }
+
+// FeatureFlagValidationError is the validation error returned by
+// FeatureFlag.Validate if the designated constraints aren't met.
+type FeatureFlagValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeatureFlagValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeatureFlagValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeatureFlagValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeatureFlagValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeatureFlagValidationError) ErrorName() string { return "FeatureFlagValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeatureFlagValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFeatureFlag.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeatureFlagValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeatureFlagValidationError{}
@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: kyc_document.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on KYCDocument with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KYCDocument) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KYCDocument with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KYCDocumentMultiError, or
+// nil if none found.
+func (m *KYCDocument) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KYCDocument) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Username
+
+	// no validation rules for DocumentType
+
+	// no validation rules for ContentType
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Status
+
+	// no validation rules for RejectionReason
+
+	// no validation rules for ReviewedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KYCDocumentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KYCDocumentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KYCDocumentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReviewedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KYCDocumentValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KYCDocumentValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KYCDocumentValidationError{
+				field:  "ReviewedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return KYCDocumentMultiError(errors)
+	}
+
+	return nil
+}
+
+// KYCDocumentMultiError is an error wrapping multiple validation errors
+// returned by KYCDocument.ValidateAll() if the designated constraints aren't met.
+type KYCDocumentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KYCDocumentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KYCDocumentMultiError) AllErrors() []error { return m }
+
+// KYCDocumentValidationError is the validation error returned by
+// KYCDocument.Validate if the designated constraints aren't met.
+type KYCDocumentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KYCDocumentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KYCDocumentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KYCDocumentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KYCDocumentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KYCDocumentValidationError) ErrorName() string { return "KYCDocumentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KYCDocumentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKYCDocument.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KYCDocumentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KYCDocumentValidationError{}
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: loan.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Loan with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Loan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Loan with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LoanMultiError, or nil if none found.
+func (m *Loan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Loan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountId
+
+	// no validation rules for Principal
+
+	// no validation rules for Currency
+
+	// no validation rules for AnnualRateBps
+
+	// no validation rules for TermMonths
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LoanMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoanMultiError is an error wrapping multiple validation errors returned by
+// Loan.ValidateAll() if the designated constraints aren't met.
+type LoanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanMultiError) AllErrors() []error { return m }
+
+// LoanValidationError is the validation error returned by Loan.Validate if the
+// designated constraints aren't met.
+type LoanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanValidationError) ErrorName() string { return "LoanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanValidationError{}
+
+// Validate checks the field values on LoanPayment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoanPayment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoanPayment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoanPaymentMultiError, or
+// nil if none found.
+func (m *LoanPayment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoanPayment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Installment
+
+	if all {
+		switch v := interface{}(m.GetDueDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LoanPaymentValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LoanPaymentValidationError{
+					field:  "DueDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDueDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LoanPaymentValidationError{
+				field:  "DueDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Principal
+
+	// no validation rules for Interest
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return LoanPaymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoanPaymentMultiError is an error wrapping multiple validation errors
+// returned by LoanPayment.ValidateAll() if the designated constraints aren't met.
+type LoanPaymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoanPaymentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoanPaymentMultiError) AllErrors() []error { return m }
+
+// LoanPaymentValidationError is the validation error returned by
+// LoanPayment.Validate if the designated constraints aren't met.
+type LoanPaymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoanPaymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoanPaymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoanPaymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoanPaymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoanPaymentValidationError) ErrorName() string { return "LoanPaymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoanPaymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoanPayment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoanPaymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoanPaymentValidationError{}
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on NotificationPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPreferencesMultiError, or nil if none found.
+func (m *NotificationPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EmailEnabled
+
+	// no validation rules for SmsEnabled
+
+	// no validation rules for PushEnabled
+
+	// no validation rules for PhoneNumber
+
+	// no validation rules for DeviceToken
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationPreferencesValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationPreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPreferencesMultiError is an error wrapping multiple validation
+// errors returned by NotificationPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPreferencesMultiError) AllErrors() []error { return m }
+
+// NotificationPreferencesValidationError is the validation error returned by
+// NotificationPreferences.Validate if the designated constraints aren't met.
+type NotificationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferencesValidationError) ErrorName() string {
+	return "NotificationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferencesValidationError{}
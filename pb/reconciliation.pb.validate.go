@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: reconciliation.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BalanceDiscrepancy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BalanceDiscrepancy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BalanceDiscrepancy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BalanceDiscrepancyMultiError, or nil if none found.
+func (m *BalanceDiscrepancy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BalanceDiscrepancy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for AccountBalance
+
+	// no validation rules for EntriesBalance
+
+	if len(errors) > 0 {
+		return BalanceDiscrepancyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BalanceDiscrepancyMultiError is an error wrapping multiple validation errors
+// returned by BalanceDiscrepancy.ValidateAll() if the designated constraints
+// aren't met.
+type BalanceDiscrepancyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BalanceDiscrepancyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BalanceDiscrepancyMultiError) AllErrors() []error { return m }
+
+// BalanceDiscrepancyValidationError is the validation error returned by
+// BalanceDiscrepancy.Validate if the designated constraints aren't met.
+type BalanceDiscrepancyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BalanceDiscrepancyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BalanceDiscrepancyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BalanceDiscrepancyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BalanceDiscrepancyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BalanceDiscrepancyValidationError) ErrorName() string {
+	return "BalanceDiscrepancyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BalanceDiscrepancyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBalanceDiscrepancy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BalanceDiscrepancyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BalanceDiscrepancyValidationError{}
+
+// Validate checks the field values on ReconciliationReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReconciliationReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReconciliationReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconciliationReportMultiError, or nil if none found.
+func (m *ReconciliationReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReconciliationReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for AccountsChecked
+
+	// no validation rules for DiscrepancyCount
+
+	for idx, item := range m.GetDiscrepancies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconciliationReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconciliationReportValidationError{
+						field:  fmt.Sprintf("Discrepancies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconciliationReportValidationError{
+					field:  fmt.Sprintf("Discrepancies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReconciliationReportValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReconciliationReportValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReconciliationReportValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReconciliationReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReconciliationReportValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReconciliationReportValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReconciliationReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReconciliationReportMultiError is an error wrapping multiple validation
+// errors returned by ReconciliationReport.ValidateAll() if the designated
+// constraints aren't met.
+type ReconciliationReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReconciliationReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReconciliationReportMultiError) AllErrors() []error { return m }
+
+// ReconciliationReportValidationError is the validation error returned by
+// ReconciliationReport.Validate if the designated constraints aren't met.
+type ReconciliationReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReconciliationReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReconciliationReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReconciliationReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReconciliationReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReconciliationReportValidationError) ErrorName() string {
+	return "ReconciliationReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReconciliationReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReconciliationReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReconciliationReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReconciliationReportValidationError{}
+
+// Validate checks the field values on UnbalancedTransfer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnbalancedTransfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnbalancedTransfer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnbalancedTransferMultiError, or nil if none found.
+func (m *UnbalancedTransfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnbalancedTransfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TransferId
+
+	// no validation rules for FromAccountId
+
+	// no validation rules for ToAccountId
+
+	// no validation rules for Amount
+
+	// no validation rules for EntryCount
+
+	// no validation rules for EntriesSum
+
+	if len(errors) > 0 {
+		return UnbalancedTransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnbalancedTransferMultiError is an error wrapping multiple validation errors
+// returned by UnbalancedTransfer.ValidateAll() if the designated constraints
+// aren't met.
+type UnbalancedTransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnbalancedTransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnbalancedTransferMultiError) AllErrors() []error { return m }
+
+// UnbalancedTransferValidationError is the validation error returned by
+// UnbalancedTransfer.Validate if the designated constraints aren't met.
+type UnbalancedTransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnbalancedTransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnbalancedTransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnbalancedTransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnbalancedTransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnbalancedTransferValidationError) ErrorName() string {
+	return "UnbalancedTransferValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnbalancedTransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnbalancedTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnbalancedTransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnbalancedTransferValidationError{}
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_add_dispute_note.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddDisputeNoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisputeId int64  `protobuf:"varint,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty"`
+	Note      string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AddDisputeNoteRequest) Reset() {
+	*x = AddDisputeNoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_add_dispute_note_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDisputeNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDisputeNoteRequest) ProtoMessage() {}
+
+func (x *AddDisputeNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_add_dispute_note_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDisputeNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddDisputeNoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_add_dispute_note_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddDisputeNoteRequest) GetDisputeId() int64 {
+	if x != nil {
+		return x.DisputeId
+	}
+	return 0
+}
+
+func (x *AddDisputeNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AddDisputeNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the first note moves an open dispute to under_review
+	Dispute *Dispute     `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	Note    *DisputeNote `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (x *AddDisputeNoteResponse) Reset() {
+	*x = AddDisputeNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_add_dispute_note_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDisputeNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDisputeNoteResponse) ProtoMessage() {}
+
+func (x *AddDisputeNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_add_dispute_note_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDisputeNoteResponse.ProtoReflect.Descriptor instead.
+func (*AddDisputeNoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_add_dispute_note_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddDisputeNoteResponse) GetDispute() *Dispute {
+	if x != nil {
+		return x.Dispute
+	}
+	return nil
+}
+
+func (x *AddDisputeNoteResponse) GetNote() *DisputeNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+var File_rpc_add_dispute_note_proto protoreflect.FileDescriptor
+
+var file_rpc_add_dispute_note_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x44,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01,
+	0x18, 0xe8, 0x07, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x64, 0x0a, 0x16, 0x41, 0x64, 0x64,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x42,
+	0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65,
+	0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_add_dispute_note_proto_rawDescOnce sync.Once
+	file_rpc_add_dispute_note_proto_rawDescData = file_rpc_add_dispute_note_proto_rawDesc
+)
+
+func file_rpc_add_dispute_note_proto_rawDescGZIP() []byte {
+	file_rpc_add_dispute_note_proto_rawDescOnce.Do(func() {
+		file_rpc_add_dispute_note_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_add_dispute_note_proto_rawDescData)
+	})
+	return file_rpc_add_dispute_note_proto_rawDescData
+}
+
+var file_rpc_add_dispute_note_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_add_dispute_note_proto_goTypes = []interface{}{
+	(*AddDisputeNoteRequest)(nil),  // 0: pb.AddDisputeNoteRequest
+	(*AddDisputeNoteResponse)(nil), // 1: pb.AddDisputeNoteResponse
+	(*Dispute)(nil),                // 2: pb.Dispute
+	(*DisputeNote)(nil),            // 3: pb.DisputeNote
+}
+var file_rpc_add_dispute_note_proto_depIdxs = []int32{
+	2, // 0: pb.AddDisputeNoteResponse.dispute:type_name -> pb.Dispute
+	3, // 1: pb.AddDisputeNoteResponse.note:type_name -> pb.DisputeNote
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_add_dispute_note_proto_init() }
+func file_rpc_add_dispute_note_proto_init() {
+	if File_rpc_add_dispute_note_proto != nil {
+		return
+	}
+	file_dispute_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_add_dispute_note_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDisputeNoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_add_dispute_note_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDisputeNoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_add_dispute_note_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_add_dispute_note_proto_goTypes,
+		DependencyIndexes: file_rpc_add_dispute_note_proto_depIdxs,
+		MessageInfos:      file_rpc_add_dispute_note_proto_msgTypes,
+	}.Build()
+	File_rpc_add_dispute_note_proto = out.File
+	file_rpc_add_dispute_note_proto_rawDesc = nil
+	file_rpc_add_dispute_note_proto_goTypes = nil
+	file_rpc_add_dispute_note_proto_depIdxs = nil
+}
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_add_dispute_note.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AddDisputeNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddDisputeNoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddDisputeNoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddDisputeNoteRequestMultiError, or nil if none found.
+func (m *AddDisputeNoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddDisputeNoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDisputeId() <= 0 {
+		err := AddDisputeNoteRequestValidationError{
+			field:  "DisputeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNote()); l < 1 || l > 1000 {
+		err := AddDisputeNoteRequestValidationError{
+			field:  "Note",
+			reason: "value length must be between 1 and 1000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddDisputeNoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddDisputeNoteRequestMultiError is an error wrapping multiple validation
+// errors returned by AddDisputeNoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddDisputeNoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddDisputeNoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddDisputeNoteRequestMultiError) AllErrors() []error { return m }
+
+// AddDisputeNoteRequestValidationError is the validation error returned by
+// AddDisputeNoteRequest.Validate if the designated constraints aren't met.
+type AddDisputeNoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddDisputeNoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddDisputeNoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddDisputeNoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddDisputeNoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddDisputeNoteRequestValidationError) ErrorName() string {
+	return "AddDisputeNoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddDisputeNoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddDisputeNoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddDisputeNoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddDisputeNoteRequestValidationError{}
+
+// Validate checks the field values on AddDisputeNoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddDisputeNoteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddDisputeNoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddDisputeNoteResponseMultiError, or nil if none found.
+func (m *AddDisputeNoteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddDisputeNoteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDispute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddDisputeNoteResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddDisputeNoteResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDispute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddDisputeNoteResponseValidationError{
+				field:  "Dispute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddDisputeNoteResponseValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddDisputeNoteResponseValidationError{
+					field:  "Note",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddDisputeNoteResponseValidationError{
+				field:  "Note",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddDisputeNoteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddDisputeNoteResponseMultiError is an error wrapping multiple validation
+// errors returned by AddDisputeNoteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AddDisputeNoteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddDisputeNoteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddDisputeNoteResponseMultiError) AllErrors() []error { return m }
+
+// AddDisputeNoteResponseValidationError is the validation error returned by
+// AddDisputeNoteResponse.Validate if the designated constraints aren't met.
+type AddDisputeNoteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddDisputeNoteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddDisputeNoteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddDisputeNoteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddDisputeNoteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddDisputeNoteResponseValidationError) ErrorName() string {
+	return "AddDisputeNoteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddDisputeNoteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddDisputeNoteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddDisputeNoteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddDisputeNoteResponseValidationError{}
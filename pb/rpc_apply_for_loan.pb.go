@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_apply_for_loan.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplyForLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the account that receives the loan and pays the installments
+	AccountId  int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Principal  int64 `protobuf:"varint,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	TermMonths int32 `protobuf:"varint,3,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+}
+
+func (x *ApplyForLoanRequest) Reset() {
+	*x = ApplyForLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_apply_for_loan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyForLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyForLoanRequest) ProtoMessage() {}
+
+func (x *ApplyForLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_apply_for_loan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyForLoanRequest.ProtoReflect.Descriptor instead.
+func (*ApplyForLoanRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_apply_for_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplyForLoanRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ApplyForLoanRequest) GetPrincipal() int64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *ApplyForLoanRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+type ApplyForLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	// the estimated repayment schedule if the loan is approved today
+	Schedule []*LoanPayment `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *ApplyForLoanResponse) Reset() {
+	*x = ApplyForLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_apply_for_loan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyForLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyForLoanResponse) ProtoMessage() {}
+
+func (x *ApplyForLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_apply_for_loan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyForLoanResponse.ProtoReflect.Descriptor instead.
+func (*ApplyForLoanResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_apply_for_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyForLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *ApplyForLoanResponse) GetSchedule() []*LoanPayment {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+var File_rpc_apply_for_loan_proto protoreflect.FileDescriptor
+
+var file_rpc_apply_for_loan_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0a,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x46, 0x6f, 0x72,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x65,
+	0x72, 0x6d, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x02, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x65, 0x72,
+	0x6d, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x22, 0x61, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x46, 0x6f, 0x72, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0x2b, 0x0a,
+	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68,
+	0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_apply_for_loan_proto_rawDescOnce sync.Once
+	file_rpc_apply_for_loan_proto_rawDescData = file_rpc_apply_for_loan_proto_rawDesc
+)
+
+func file_rpc_apply_for_loan_proto_rawDescGZIP() []byte {
+	file_rpc_apply_for_loan_proto_rawDescOnce.Do(func() {
+		file_rpc_apply_for_loan_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_apply_for_loan_proto_rawDescData)
+	})
+	return file_rpc_apply_for_loan_proto_rawDescData
+}
+
+var file_rpc_apply_for_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_apply_for_loan_proto_goTypes = []interface{}{
+	(*ApplyForLoanRequest)(nil),  // 0: pb.ApplyForLoanRequest
+	(*ApplyForLoanResponse)(nil), // 1: pb.ApplyForLoanResponse
+	(*Loan)(nil),                 // 2: pb.Loan
+	(*LoanPayment)(nil),          // 3: pb.LoanPayment
+}
+var file_rpc_apply_for_loan_proto_depIdxs = []int32{
+	2, // 0: pb.ApplyForLoanResponse.loan:type_name -> pb.Loan
+	3, // 1: pb.ApplyForLoanResponse.schedule:type_name -> pb.LoanPayment
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_apply_for_loan_proto_init() }
+func file_rpc_apply_for_loan_proto_init() {
+	if File_rpc_apply_for_loan_proto != nil {
+		return
+	}
+	file_loan_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_apply_for_loan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyForLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_apply_for_loan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyForLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_apply_for_loan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_apply_for_loan_proto_goTypes,
+		DependencyIndexes: file_rpc_apply_for_loan_proto_depIdxs,
+		MessageInfos:      file_rpc_apply_for_loan_proto_msgTypes,
+	}.Build()
+	File_rpc_apply_for_loan_proto = out.File
+	file_rpc_apply_for_loan_proto_rawDesc = nil
+	file_rpc_apply_for_loan_proto_goTypes = nil
+	file_rpc_apply_for_loan_proto_depIdxs = nil
+}
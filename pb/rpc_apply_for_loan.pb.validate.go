@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_apply_for_loan.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ApplyForLoanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyForLoanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyForLoanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyForLoanRequestMultiError, or nil if none found.
+func (m *ApplyForLoanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyForLoanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := ApplyForLoanRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrincipal() <= 0 {
+		err := ApplyForLoanRequestValidationError{
+			field:  "Principal",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTermMonths(); val < 1 || val > 360 {
+		err := ApplyForLoanRequestValidationError{
+			field:  "TermMonths",
+			reason: "value must be inside range [1, 360]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApplyForLoanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyForLoanRequestMultiError is an error wrapping multiple validation
+// errors returned by ApplyForLoanRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyForLoanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyForLoanRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyForLoanRequestMultiError) AllErrors() []error { return m }
+
+// ApplyForLoanRequestValidationError is the validation error returned by
+// ApplyForLoanRequest.Validate if the designated constraints aren't met.
+type ApplyForLoanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyForLoanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyForLoanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyForLoanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyForLoanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyForLoanRequestValidationError) ErrorName() string {
+	return "ApplyForLoanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyForLoanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyForLoanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyForLoanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyForLoanRequestValidationError{}
+
+// Validate checks the field values on ApplyForLoanResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyForLoanResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyForLoanResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyForLoanResponseMultiError, or nil if none found.
+func (m *ApplyForLoanResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyForLoanResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLoan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplyForLoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplyForLoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplyForLoanResponseValidationError{
+				field:  "Loan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSchedule() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApplyForLoanResponseValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApplyForLoanResponseValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApplyForLoanResponseValidationError{
+					field:  fmt.Sprintf("Schedule[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApplyForLoanResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyForLoanResponseMultiError is an error wrapping multiple validation
+// errors returned by ApplyForLoanResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyForLoanResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyForLoanResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyForLoanResponseMultiError) AllErrors() []error { return m }
+
+// ApplyForLoanResponseValidationError is the validation error returned by
+// ApplyForLoanResponse.Validate if the designated constraints aren't met.
+type ApplyForLoanResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyForLoanResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyForLoanResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyForLoanResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyForLoanResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyForLoanResponseValidationError) ErrorName() string {
+	return "ApplyForLoanResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyForLoanResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyForLoanResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyForLoanResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyForLoanResponseValidationError{}
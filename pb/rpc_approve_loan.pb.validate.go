@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_approve_loan.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ApproveLoanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveLoanRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveLoanRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveLoanRequestMultiError, or nil if none found.
+func (m *ApproveLoanRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveLoanRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLoanId() <= 0 {
+		err := ApproveLoanRequestValidationError{
+			field:  "LoanId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Approve
+
+	if len(errors) > 0 {
+		return ApproveLoanRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveLoanRequestMultiError is an error wrapping multiple validation errors
+// returned by ApproveLoanRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ApproveLoanRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveLoanRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveLoanRequestMultiError) AllErrors() []error { return m }
+
+// ApproveLoanRequestValidationError is the validation error returned by
+// ApproveLoanRequest.Validate if the designated constraints aren't met.
+type ApproveLoanRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveLoanRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveLoanRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveLoanRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveLoanRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveLoanRequestValidationError) ErrorName() string {
+	return "ApproveLoanRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveLoanRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveLoanRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveLoanRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveLoanRequestValidationError{}
+
+// Validate checks the field values on ApproveLoanResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveLoanResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveLoanResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveLoanResponseMultiError, or nil if none found.
+func (m *ApproveLoanResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveLoanResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetLoan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveLoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveLoanResponseValidationError{
+					field:  "Loan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveLoanResponseValidationError{
+				field:  "Loan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSchedule() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ApproveLoanResponseValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ApproveLoanResponseValidationError{
+						field:  fmt.Sprintf("Schedule[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ApproveLoanResponseValidationError{
+					field:  fmt.Sprintf("Schedule[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ApproveLoanResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveLoanResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveLoanResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveLoanResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveLoanResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveLoanResponseMultiError) AllErrors() []error { return m }
+
+// ApproveLoanResponseValidationError is the validation error returned by
+// ApproveLoanResponse.Validate if the designated constraints aren't met.
+type ApproveLoanResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveLoanResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveLoanResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveLoanResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveLoanResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveLoanResponseValidationError) ErrorName() string {
+	return "ApproveLoanResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveLoanResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveLoanResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveLoanResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveLoanResponseValidationError{}
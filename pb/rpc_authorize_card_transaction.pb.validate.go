@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_authorize_card_transaction.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AuthorizeCardTransactionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuthorizeCardTransactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeCardTransactionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthorizeCardTransactionRequestMultiError, or nil if none found.
+func (m *AuthorizeCardTransactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeCardTransactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CardNumber
+
+	if val := m.GetExpiryMonth(); val < 1 || val > 12 {
+		err := AuthorizeCardTransactionRequestValidationError{
+			field:  "ExpiryMonth",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetExpiryYear(); val < 2000 || val > 9999 {
+		err := AuthorizeCardTransactionRequestValidationError{
+			field:  "ExpiryYear",
+			reason: "value must be inside range [2000, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := AuthorizeCardTransactionRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Currency
+
+	if l := utf8.RuneCountInString(m.GetMerchant()); l < 1 || l > 100 {
+		err := AuthorizeCardTransactionRequestValidationError{
+			field:  "Merchant",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AuthorizeCardTransactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeCardTransactionRequestMultiError is an error wrapping multiple
+// validation errors returned by AuthorizeCardTransactionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AuthorizeCardTransactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeCardTransactionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeCardTransactionRequestMultiError) AllErrors() []error { return m }
+
+// AuthorizeCardTransactionRequestValidationError is the validation error
+// returned by AuthorizeCardTransactionRequest.Validate if the designated
+// constraints aren't met.
+type AuthorizeCardTransactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeCardTransactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeCardTransactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeCardTransactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeCardTransactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeCardTransactionRequestValidationError) ErrorName() string {
+	return "AuthorizeCardTransactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeCardTransactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeCardTransactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeCardTransactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeCardTransactionRequestValidationError{}
+
+// Validate checks the field values on AuthorizeCardTransactionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AuthorizeCardTransactionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthorizeCardTransactionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AuthorizeCardTransactionResponseMultiError, or nil if none found.
+func (m *AuthorizeCardTransactionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthorizeCardTransactionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Approved
+
+	// no validation rules for DeclineReason
+
+	// no validation rules for HoldId
+
+	if len(errors) > 0 {
+		return AuthorizeCardTransactionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorizeCardTransactionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// AuthorizeCardTransactionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AuthorizeCardTransactionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorizeCardTransactionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorizeCardTransactionResponseMultiError) AllErrors() []error { return m }
+
+// AuthorizeCardTransactionResponseValidationError is the validation error
+// returned by AuthorizeCardTransactionResponse.Validate if the designated
+// constraints aren't met.
+type AuthorizeCardTransactionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorizeCardTransactionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorizeCardTransactionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorizeCardTransactionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorizeCardTransactionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorizeCardTransactionResponseValidationError) ErrorName() string {
+	return "AuthorizeCardTransactionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthorizeCardTransactionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthorizeCardTransactionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorizeCardTransactionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorizeCardTransactionResponseValidationError{}
@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_block_session.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _rpc_block_session_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on BlockSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockSessionRequestMultiError, or nil if none found.
+func (m *BlockSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = BlockSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDeviceId() <= 0 {
+		err := BlockSessionRequestValidationError{
+			field:  "DeviceId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ExpiresAt
+
+	if utf8.RuneCountInString(m.GetSignature()) != 64 {
+		err := BlockSessionRequestValidationError{
+			field:  "Signature",
+			reason: "value length must be 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return BlockSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *BlockSessionRequest) _validateUuid(uuid string) error {
+	if matched := _rpc_block_session_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// BlockSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by BlockSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BlockSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockSessionRequestMultiError) AllErrors() []error { return m }
+
+// BlockSessionRequestValidationError is the validation error returned by
+// BlockSessionRequest.Validate if the designated constraints aren't met.
+type BlockSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockSessionRequestValidationError) ErrorName() string {
+	return "BlockSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockSessionRequestValidationError{}
+
+// Validate checks the field values on BlockSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlockSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlockSessionResponseMultiError, or nil if none found.
+func (m *BlockSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsBlocked
+
+	if len(errors) > 0 {
+		return BlockSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by BlockSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BlockSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockSessionResponseMultiError) AllErrors() []error { return m }
+
+// BlockSessionResponseValidationError is the validation error returned by
+// BlockSessionResponse.Validate if the designated constraints aren't met.
+type BlockSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockSessionResponseValidationError) ErrorName() string {
+	return "BlockSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlockSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockSessionResponseValidationError{}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_cancel_account_deletion.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CancelAccountDeletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAccountDeletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAccountDeletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelAccountDeletionRequestMultiError, or nil if none found.
+func (m *CancelAccountDeletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAccountDeletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 100 {
+		err := CancelAccountDeletionRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CancelAccountDeletionRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := CancelAccountDeletionRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ExpiresAt
+
+	if utf8.RuneCountInString(m.GetSignature()) != 64 {
+		err := CancelAccountDeletionRequestValidationError{
+			field:  "Signature",
+			reason: "value length must be 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return CancelAccountDeletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelAccountDeletionRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelAccountDeletionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelAccountDeletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAccountDeletionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAccountDeletionRequestMultiError) AllErrors() []error { return m }
+
+// CancelAccountDeletionRequestValidationError is the validation error returned
+// by CancelAccountDeletionRequest.Validate if the designated constraints
+// aren't met.
+type CancelAccountDeletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAccountDeletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAccountDeletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAccountDeletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAccountDeletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAccountDeletionRequestValidationError) ErrorName() string {
+	return "CancelAccountDeletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAccountDeletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAccountDeletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAccountDeletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAccountDeletionRequestValidationError{}
+
+var _CancelAccountDeletionRequest_Username_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on CancelAccountDeletionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelAccountDeletionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelAccountDeletionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelAccountDeletionResponseMultiError, or nil if none found.
+func (m *CancelAccountDeletionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelAccountDeletionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsCancelled
+
+	if len(errors) > 0 {
+		return CancelAccountDeletionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelAccountDeletionResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelAccountDeletionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CancelAccountDeletionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelAccountDeletionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelAccountDeletionResponseMultiError) AllErrors() []error { return m }
+
+// CancelAccountDeletionResponseValidationError is the validation error
+// returned by CancelAccountDeletionResponse.Validate if the designated
+// constraints aren't met.
+type CancelAccountDeletionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelAccountDeletionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelAccountDeletionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelAccountDeletionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelAccountDeletionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelAccountDeletionResponseValidationError) ErrorName() string {
+	return "CancelAccountDeletionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelAccountDeletionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelAccountDeletionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelAccountDeletionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelAccountDeletionResponseValidationError{}
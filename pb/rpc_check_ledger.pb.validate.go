@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_check_ledger.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CheckLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckLedgerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckLedgerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckLedgerRequestMultiError, or nil if none found.
+func (m *CheckLedgerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckLedgerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CheckLedgerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckLedgerRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckLedgerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckLedgerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckLedgerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckLedgerRequestMultiError) AllErrors() []error { return m }
+
+// CheckLedgerRequestValidationError is the validation error returned by
+// CheckLedgerRequest.Validate if the designated constraints aren't met.
+type CheckLedgerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckLedgerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckLedgerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckLedgerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckLedgerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckLedgerRequestValidationError) ErrorName() string {
+	return "CheckLedgerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckLedgerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckLedgerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckLedgerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckLedgerRequestValidationError{}
+
+// Validate checks the field values on CheckLedgerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckLedgerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckLedgerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckLedgerResponseMultiError, or nil if none found.
+func (m *CheckLedgerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckLedgerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Balanced
+
+	// no validation rules for TransfersChecked
+
+	for idx, item := range m.GetUnbalancedTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckLedgerResponseValidationError{
+						field:  fmt.Sprintf("UnbalancedTransfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckLedgerResponseValidationError{
+						field:  fmt.Sprintf("UnbalancedTransfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckLedgerResponseValidationError{
+					field:  fmt.Sprintf("UnbalancedTransfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckLedgerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckLedgerResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckLedgerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckLedgerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckLedgerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckLedgerResponseMultiError) AllErrors() []error { return m }
+
+// CheckLedgerResponseValidationError is the validation error returned by
+// CheckLedgerResponse.Validate if the designated constraints aren't met.
+type CheckLedgerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckLedgerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckLedgerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckLedgerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckLedgerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckLedgerResponseValidationError) ErrorName() string {
+	return "CheckLedgerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckLedgerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckLedgerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckLedgerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckLedgerResponseValidationError{}
@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_collect_payment.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CollectPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectPaymentRequestMultiError, or nil if none found.
+func (m *CollectPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMandateId() <= 0 {
+		err := CollectPaymentRequestValidationError{
+			field:  "MandateId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := CollectPaymentRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CollectPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CollectPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CollectPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectPaymentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectPaymentRequestMultiError) AllErrors() []error { return m }
+
+// CollectPaymentRequestValidationError is the validation error returned by
+// CollectPaymentRequest.Validate if the designated constraints aren't met.
+type CollectPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectPaymentRequestValidationError) ErrorName() string {
+	return "CollectPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectPaymentRequestValidationError{}
+
+// Validate checks the field values on CollectPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectPaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectPaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectPaymentResponseMultiError, or nil if none found.
+func (m *CollectPaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectPaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMandate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectPaymentResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectPaymentResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMandate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectPaymentResponseValidationError{
+				field:  "Mandate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectPaymentResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectPaymentResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectPaymentResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CollectPaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectPaymentResponseMultiError is an error wrapping multiple validation
+// errors returned by CollectPaymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CollectPaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectPaymentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectPaymentResponseMultiError) AllErrors() []error { return m }
+
+// CollectPaymentResponseValidationError is the validation error returned by
+// CollectPaymentResponse.Validate if the designated constraints aren't met.
+type CollectPaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectPaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectPaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectPaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectPaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectPaymentResponseValidationError) ErrorName() string {
+	return "CollectPaymentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectPaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectPaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectPaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectPaymentResponseValidationError{}
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_create_balance_alert.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateBalanceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// low_balance or large_debit
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// In the smallest unit of the account currency
+	Threshold int64 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *CreateBalanceAlertRequest) Reset() {
+	*x = CreateBalanceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_balance_alert_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBalanceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceAlertRequest) ProtoMessage() {}
+
+func (x *CreateBalanceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_balance_alert_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateBalanceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_balance_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBalanceAlertRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *CreateBalanceAlertRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateBalanceAlertRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type CreateBalanceAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceAlert *BalanceAlert `protobuf:"bytes,1,opt,name=balance_alert,json=balanceAlert,proto3" json:"balance_alert,omitempty"`
+}
+
+func (x *CreateBalanceAlertResponse) Reset() {
+	*x = CreateBalanceAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_balance_alert_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBalanceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBalanceAlertResponse) ProtoMessage() {}
+
+func (x *CreateBalanceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_balance_alert_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBalanceAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateBalanceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_balance_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBalanceAlertResponse) GetBalanceAlert() *BalanceAlert {
+	if x != nil {
+		return x.BalanceAlert
+	}
+	return nil
+}
+
+var File_rpc_create_balance_alert_proto protoreflect.FileDescriptor
+
+var file_rpc_create_balance_alert_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x13, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x7e, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0x53, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_balance_alert_proto_rawDescOnce sync.Once
+	file_rpc_create_balance_alert_proto_rawDescData = file_rpc_create_balance_alert_proto_rawDesc
+)
+
+func file_rpc_create_balance_alert_proto_rawDescGZIP() []byte {
+	file_rpc_create_balance_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_create_balance_alert_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_balance_alert_proto_rawDescData)
+	})
+	return file_rpc_create_balance_alert_proto_rawDescData
+}
+
+var file_rpc_create_balance_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_balance_alert_proto_goTypes = []interface{}{
+	(*CreateBalanceAlertRequest)(nil),  // 0: pb.CreateBalanceAlertRequest
+	(*CreateBalanceAlertResponse)(nil), // 1: pb.CreateBalanceAlertResponse
+	(*BalanceAlert)(nil),               // 2: pb.BalanceAlert
+}
+var file_rpc_create_balance_alert_proto_depIdxs = []int32{
+	2, // 0: pb.CreateBalanceAlertResponse.balance_alert:type_name -> pb.BalanceAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_balance_alert_proto_init() }
+func file_rpc_create_balance_alert_proto_init() {
+	if File_rpc_create_balance_alert_proto != nil {
+		return
+	}
+	file_balance_alert_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_balance_alert_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBalanceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_balance_alert_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBalanceAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_balance_alert_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_balance_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_create_balance_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_create_balance_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_create_balance_alert_proto = out.File
+	file_rpc_create_balance_alert_proto_rawDesc = nil
+	file_rpc_create_balance_alert_proto_goTypes = nil
+	file_rpc_create_balance_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_create_balance_alert.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateBalanceAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBalanceAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBalanceAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBalanceAlertRequestMultiError, or nil if none found.
+func (m *CreateBalanceAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBalanceAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := CreateBalanceAlertRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Kind
+
+	if m.GetThreshold() <= 0 {
+		err := CreateBalanceAlertRequestValidationError{
+			field:  "Threshold",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateBalanceAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBalanceAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBalanceAlertRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateBalanceAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBalanceAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBalanceAlertRequestMultiError) AllErrors() []error { return m }
+
+// CreateBalanceAlertRequestValidationError is the validation error returned by
+// CreateBalanceAlertRequest.Validate if the designated constraints aren't met.
+type CreateBalanceAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBalanceAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBalanceAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBalanceAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBalanceAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBalanceAlertRequestValidationError) ErrorName() string {
+	return "CreateBalanceAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBalanceAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBalanceAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBalanceAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBalanceAlertRequestValidationError{}
+
+// Validate checks the field values on CreateBalanceAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBalanceAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBalanceAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBalanceAlertResponseMultiError, or nil if none found.
+func (m *CreateBalanceAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBalanceAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBalanceAlert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBalanceAlertResponseValidationError{
+					field:  "BalanceAlert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBalanceAlertResponseValidationError{
+					field:  "BalanceAlert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalanceAlert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBalanceAlertResponseValidationError{
+				field:  "BalanceAlert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBalanceAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBalanceAlertResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateBalanceAlertResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBalanceAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBalanceAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBalanceAlertResponseMultiError) AllErrors() []error { return m }
+
+// CreateBalanceAlertResponseValidationError is the validation error returned
+// by CreateBalanceAlertResponse.Validate if the designated constraints aren't met.
+type CreateBalanceAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBalanceAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBalanceAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBalanceAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBalanceAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBalanceAlertResponseValidationError) ErrorName() string {
+	return "CreateBalanceAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBalanceAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBalanceAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBalanceAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBalanceAlertResponseValidationError{}
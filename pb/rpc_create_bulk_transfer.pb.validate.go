@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_create_bulk_transfer.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BulkTransferItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BulkTransferItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTransferItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTransferItemMultiError, or nil if none found.
+func (m *BulkTransferItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTransferItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetToAccountId() < 0 {
+		err := BulkTransferItemValidationError{
+			field:  "ToAccountId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Amount
+
+	// no validation rules for Category
+
+	if utf8.RuneCountInString(m.GetMemo()) > 255 {
+		err := BulkTransferItemValidationError{
+			field:  "Memo",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReference()) > 64 {
+		err := BulkTransferItemValidationError{
+			field:  "Reference",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AmountDecimal
+
+	if utf8.RuneCountInString(m.GetToAccountNumber()) > 64 {
+		err := BulkTransferItemValidationError{
+			field:  "ToAccountNumber",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BulkTransferItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTransferItemMultiError is an error wrapping multiple validation errors
+// returned by BulkTransferItem.ValidateAll() if the designated constraints
+// aren't met.
+type BulkTransferItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTransferItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTransferItemMultiError) AllErrors() []error { return m }
+
+// BulkTransferItemValidationError is the validation error returned by
+// BulkTransferItem.Validate if the designated constraints aren't met.
+type BulkTransferItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTransferItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTransferItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTransferItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTransferItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTransferItemValidationError) ErrorName() string { return "BulkTransferItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BulkTransferItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTransferItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTransferItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTransferItemValidationError{}
+
+// Validate checks the field values on CreateBulkTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBulkTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBulkTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBulkTransferRequestMultiError, or nil if none found.
+func (m *CreateBulkTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBulkTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFromAccountId() <= 0 {
+		err := CreateBulkTransferRequestValidationError{
+			field:  "FromAccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Currency
+
+	if l := len(m.GetItems()); l < 1 || l > 100 {
+		err := CreateBulkTransferRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateBulkTransferRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateBulkTransferRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateBulkTransferRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for BestEffort
+
+	if len(errors) > 0 {
+		return CreateBulkTransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBulkTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBulkTransferRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateBulkTransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBulkTransferRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBulkTransferRequestMultiError) AllErrors() []error { return m }
+
+// CreateBulkTransferRequestValidationError is the validation error returned by
+// CreateBulkTransferRequest.Validate if the designated constraints aren't met.
+type CreateBulkTransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBulkTransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBulkTransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBulkTransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBulkTransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBulkTransferRequestValidationError) ErrorName() string {
+	return "CreateBulkTransferRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBulkTransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBulkTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBulkTransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBulkTransferRequestValidationError{}
+
+// Validate checks the field values on BulkTransferItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkTransferItemResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkTransferItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkTransferItemResultMultiError, or nil if none found.
+func (m *BulkTransferItemResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkTransferItemResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ToAccountId
+
+	// no validation rules for Amount
+
+	// no validation rules for Success
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkTransferItemResultValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkTransferItemResultValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkTransferItemResultValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkTransferItemResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkTransferItemResultMultiError is an error wrapping multiple validation
+// errors returned by BulkTransferItemResult.ValidateAll() if the designated
+// constraints aren't met.
+type BulkTransferItemResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkTransferItemResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkTransferItemResultMultiError) AllErrors() []error { return m }
+
+// BulkTransferItemResultValidationError is the validation error returned by
+// BulkTransferItemResult.Validate if the designated constraints aren't met.
+type BulkTransferItemResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkTransferItemResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkTransferItemResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkTransferItemResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkTransferItemResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkTransferItemResultValidationError) ErrorName() string {
+	return "BulkTransferItemResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkTransferItemResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkTransferItemResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkTransferItemResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkTransferItemResultValidationError{}
+
+// Validate checks the field values on CreateBulkTransferResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBulkTransferResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBulkTransferResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBulkTransferResponseMultiError, or nil if none found.
+func (m *CreateBulkTransferResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBulkTransferResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateBulkTransferResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateBulkTransferResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateBulkTransferResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFromAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBulkTransferResponseValidationError{
+					field:  "FromAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBulkTransferResponseValidationError{
+					field:  "FromAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBulkTransferResponseValidationError{
+				field:  "FromAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBulkTransferResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBulkTransferResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateBulkTransferResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateBulkTransferResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBulkTransferResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBulkTransferResponseMultiError) AllErrors() []error { return m }
+
+// CreateBulkTransferResponseValidationError is the validation error returned
+// by CreateBulkTransferResponse.Validate if the designated constraints aren't met.
+type CreateBulkTransferResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBulkTransferResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBulkTransferResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBulkTransferResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBulkTransferResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBulkTransferResponseValidationError) ErrorName() string {
+	return "CreateBulkTransferResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBulkTransferResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBulkTransferResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBulkTransferResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBulkTransferResponseValidationError{}
@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_create_mandate.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMandateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMandateRequestMultiError, or nil if none found.
+func (m *CreateMandateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMandateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPayerAccountId() <= 0 {
+		err := CreateMandateRequestValidationError{
+			field:  "PayerAccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPayeeAccountId() <= 0 {
+		err := CreateMandateRequestValidationError{
+			field:  "PayeeAccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxAmount() <= 0 {
+		err := CreateMandateRequestValidationError{
+			field:  "MaxAmount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateMandateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMandateRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMandateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMandateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMandateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMandateRequestMultiError) AllErrors() []error { return m }
+
+// CreateMandateRequestValidationError is the validation error returned by
+// CreateMandateRequest.Validate if the designated constraints aren't met.
+type CreateMandateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMandateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMandateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMandateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMandateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMandateRequestValidationError) ErrorName() string {
+	return "CreateMandateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMandateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMandateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMandateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMandateRequestValidationError{}
+
+// Validate checks the field values on CreateMandateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMandateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMandateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMandateResponseMultiError, or nil if none found.
+func (m *CreateMandateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMandateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMandate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateMandateResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateMandateResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMandate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateMandateResponseValidationError{
+				field:  "Mandate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateMandateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMandateResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateMandateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMandateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMandateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMandateResponseMultiError) AllErrors() []error { return m }
+
+// CreateMandateResponseValidationError is the validation error returned by
+// CreateMandateResponse.Validate if the designated constraints aren't met.
+type CreateMandateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMandateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMandateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMandateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMandateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMandateResponseValidationError) ErrorName() string {
+	return "CreateMandateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMandateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMandateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMandateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMandateResponseValidationError{}
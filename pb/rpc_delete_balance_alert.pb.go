@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_delete_balance_alert.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeleteBalanceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBalanceAlertRequest) Reset() {
+	*x = DeleteBalanceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_delete_balance_alert_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBalanceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceAlertRequest) ProtoMessage() {}
+
+func (x *DeleteBalanceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_balance_alert_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_balance_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteBalanceAlertRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBalanceAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBalanceAlertResponse) Reset() {
+	*x = DeleteBalanceAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_delete_balance_alert_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBalanceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBalanceAlertResponse) ProtoMessage() {}
+
+func (x *DeleteBalanceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_delete_balance_alert_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBalanceAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBalanceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_delete_balance_alert_proto_rawDescGZIP(), []int{1}
+}
+
+var File_rpc_delete_balance_alert_proto protoreflect.FileDescriptor
+
+var file_rpc_delete_balance_alert_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x02, 0x70, 0x62, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x34, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_delete_balance_alert_proto_rawDescOnce sync.Once
+	file_rpc_delete_balance_alert_proto_rawDescData = file_rpc_delete_balance_alert_proto_rawDesc
+)
+
+func file_rpc_delete_balance_alert_proto_rawDescGZIP() []byte {
+	file_rpc_delete_balance_alert_proto_rawDescOnce.Do(func() {
+		file_rpc_delete_balance_alert_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_delete_balance_alert_proto_rawDescData)
+	})
+	return file_rpc_delete_balance_alert_proto_rawDescData
+}
+
+var file_rpc_delete_balance_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_delete_balance_alert_proto_goTypes = []interface{}{
+	(*DeleteBalanceAlertRequest)(nil),  // 0: pb.DeleteBalanceAlertRequest
+	(*DeleteBalanceAlertResponse)(nil), // 1: pb.DeleteBalanceAlertResponse
+}
+var file_rpc_delete_balance_alert_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_delete_balance_alert_proto_init() }
+func file_rpc_delete_balance_alert_proto_init() {
+	if File_rpc_delete_balance_alert_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_delete_balance_alert_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_delete_balance_alert_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBalanceAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_delete_balance_alert_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_delete_balance_alert_proto_goTypes,
+		DependencyIndexes: file_rpc_delete_balance_alert_proto_depIdxs,
+		MessageInfos:      file_rpc_delete_balance_alert_proto_msgTypes,
+	}.Build()
+	File_rpc_delete_balance_alert_proto = out.File
+	file_rpc_delete_balance_alert_proto_rawDesc = nil
+	file_rpc_delete_balance_alert_proto_goTypes = nil
+	file_rpc_delete_balance_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_disable_user.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DisableUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableUserRequestMultiError, or nil if none found.
+func (m *DisableUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 100 {
+		err := DisableUserRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DisableUserRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := DisableUserRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisableUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableUserRequestMultiError is an error wrapping multiple validation errors
+// returned by DisableUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableUserRequestMultiError) AllErrors() []error { return m }
+
+// DisableUserRequestValidationError is the validation error returned by
+// DisableUserRequest.Validate if the designated constraints aren't met.
+type DisableUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableUserRequestValidationError) ErrorName() string {
+	return "DisableUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableUserRequestValidationError{}
+
+var _DisableUserRequest_Username_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on DisableUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableUserResponseMultiError, or nil if none found.
+func (m *DisableUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisableUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableUserResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableUserResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableUserResponseMultiError) AllErrors() []error { return m }
+
+// DisableUserResponseValidationError is the validation error returned by
+// DisableUserResponse.Validate if the designated constraints aren't met.
+type DisableUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableUserResponseValidationError) ErrorName() string {
+	return "DisableUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableUserResponseValidationError{}
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_exchange_oauth_code.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExchangeOAuthCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExchangeOAuthCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeOAuthCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExchangeOAuthCodeRequestMultiError, or nil if none found.
+func (m *ExchangeOAuthCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeOAuthCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 2048 {
+		err := ExchangeOAuthCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return ExchangeOAuthCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeOAuthCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by ExchangeOAuthCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExchangeOAuthCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeOAuthCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeOAuthCodeRequestMultiError) AllErrors() []error { return m }
+
+// ExchangeOAuthCodeRequestValidationError is the validation error returned by
+// ExchangeOAuthCodeRequest.Validate if the designated constraints aren't met.
+type ExchangeOAuthCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeOAuthCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeOAuthCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeOAuthCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeOAuthCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeOAuthCodeRequestValidationError) ErrorName() string {
+	return "ExchangeOAuthCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeOAuthCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeOAuthCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeOAuthCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeOAuthCodeRequestValidationError{}
+
+// Validate checks the field values on ExchangeOAuthCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExchangeOAuthCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExchangeOAuthCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExchangeOAuthCodeResponseMultiError, or nil if none found.
+func (m *ExchangeOAuthCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExchangeOAuthCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeOAuthCodeResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SessionId
+
+	// no validation rules for AccessToken
+
+	// no validation rules for RefreshToken
+
+	if all {
+		switch v := interface{}(m.GetAccessTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "AccessTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "AccessTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccessTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeOAuthCodeResponseValidationError{
+				field:  "AccessTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefreshTokenExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExchangeOAuthCodeResponseValidationError{
+					field:  "RefreshTokenExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshTokenExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExchangeOAuthCodeResponseValidationError{
+				field:  "RefreshTokenExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsNewUser
+
+	if len(errors) > 0 {
+		return ExchangeOAuthCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExchangeOAuthCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by ExchangeOAuthCodeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ExchangeOAuthCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExchangeOAuthCodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExchangeOAuthCodeResponseMultiError) AllErrors() []error { return m }
+
+// ExchangeOAuthCodeResponseValidationError is the validation error returned by
+// ExchangeOAuthCodeResponse.Validate if the designated constraints aren't met.
+type ExchangeOAuthCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeOAuthCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeOAuthCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeOAuthCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeOAuthCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeOAuthCodeResponseValidationError) ErrorName() string {
+	return "ExchangeOAuthCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeOAuthCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeOAuthCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeOAuthCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeOAuthCodeResponseValidationError{}
@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_external_payout.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExternalPayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExternalPayoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExternalPayoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExternalPayoutRequestMultiError, or nil if none found.
+func (m *ExternalPayoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExternalPayoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFromAccountId() <= 0 {
+		err := ExternalPayoutRequestValidationError{
+			field:  "FromAccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := ExternalPayoutRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RoutingNumber
+
+	if !_ExternalPayoutRequest_AccountNumber_Pattern.MatchString(m.GetAccountNumber()) {
+		err := ExternalPayoutRequestValidationError{
+			field:  "AccountNumber",
+			reason: "value does not match regex pattern \"^[0-9]{4,17}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExternalPayoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExternalPayoutRequestMultiError is an error wrapping multiple validation
+// errors returned by ExternalPayoutRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExternalPayoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExternalPayoutRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExternalPayoutRequestMultiError) AllErrors() []error { return m }
+
+// ExternalPayoutRequestValidationError is the validation error returned by
+// ExternalPayoutRequest.Validate if the designated constraints aren't met.
+type ExternalPayoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalPayoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalPayoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalPayoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalPayoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalPayoutRequestValidationError) ErrorName() string {
+	return "ExternalPayoutRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExternalPayoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalPayoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalPayoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalPayoutRequestValidationError{}
+
+var _ExternalPayoutRequest_AccountNumber_Pattern = regexp.MustCompile("^[0-9]{4,17}$")
+
+// Validate checks the field values on ExternalPayoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExternalPayoutResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExternalPayoutResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExternalPayoutResponseMultiError, or nil if none found.
+func (m *ExternalPayoutResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExternalPayoutResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExternalTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExternalPayoutResponseValidationError{
+					field:  "ExternalTransfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExternalPayoutResponseValidationError{
+					field:  "ExternalTransfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExternalTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExternalPayoutResponseValidationError{
+				field:  "ExternalTransfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AvailableBalance
+
+	// no validation rules for Fee
+
+	if len(errors) > 0 {
+		return ExternalPayoutResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExternalPayoutResponseMultiError is an error wrapping multiple validation
+// errors returned by ExternalPayoutResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExternalPayoutResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExternalPayoutResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExternalPayoutResponseMultiError) AllErrors() []error { return m }
+
+// ExternalPayoutResponseValidationError is the validation error returned by
+// ExternalPayoutResponse.Validate if the designated constraints aren't met.
+type ExternalPayoutResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalPayoutResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalPayoutResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalPayoutResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalPayoutResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalPayoutResponseValidationError) ErrorName() string {
+	return "ExternalPayoutResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExternalPayoutResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalPayoutResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalPayoutResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalPayoutResponseValidationError{}
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_force_password_reset.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ForcePasswordResetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForcePasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForcePasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForcePasswordResetRequestMultiError, or nil if none found.
+func (m *ForcePasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForcePasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 100 {
+		err := ForcePasswordResetRequestValidationError{
+			field:  "Username",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ForcePasswordResetRequest_Username_Pattern.MatchString(m.GetUsername()) {
+		err := ForcePasswordResetRequestValidationError{
+			field:  "Username",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ForcePasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForcePasswordResetRequestMultiError is an error wrapping multiple validation
+// errors returned by ForcePasswordResetRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ForcePasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForcePasswordResetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForcePasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// ForcePasswordResetRequestValidationError is the validation error returned by
+// ForcePasswordResetRequest.Validate if the designated constraints aren't met.
+type ForcePasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForcePasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForcePasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForcePasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForcePasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForcePasswordResetRequestValidationError) ErrorName() string {
+	return "ForcePasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForcePasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForcePasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForcePasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForcePasswordResetRequestValidationError{}
+
+var _ForcePasswordResetRequest_Username_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on ForcePasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForcePasswordResetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForcePasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForcePasswordResetResponseMultiError, or nil if none found.
+func (m *ForcePasswordResetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForcePasswordResetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ForcePasswordResetResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ForcePasswordResetResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ForcePasswordResetResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ForcePasswordResetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForcePasswordResetResponseMultiError is an error wrapping multiple
+// validation errors returned by ForcePasswordResetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ForcePasswordResetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForcePasswordResetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForcePasswordResetResponseMultiError) AllErrors() []error { return m }
+
+// ForcePasswordResetResponseValidationError is the validation error returned
+// by ForcePasswordResetResponse.Validate if the designated constraints aren't met.
+type ForcePasswordResetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForcePasswordResetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForcePasswordResetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForcePasswordResetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForcePasswordResetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForcePasswordResetResponseValidationError) ErrorName() string {
+	return "ForcePasswordResetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForcePasswordResetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForcePasswordResetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForcePasswordResetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForcePasswordResetResponseValidationError{}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_freeze_card.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FreezeCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FreezeCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeCardRequestMultiError, or nil if none found.
+func (m *FreezeCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCardId() <= 0 {
+		err := FreezeCardRequestValidationError{
+			field:  "CardId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Frozen
+
+	if len(errors) > 0 {
+		return FreezeCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeCardRequestMultiError is an error wrapping multiple validation errors
+// returned by FreezeCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FreezeCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeCardRequestMultiError) AllErrors() []error { return m }
+
+// FreezeCardRequestValidationError is the validation error returned by
+// FreezeCardRequest.Validate if the designated constraints aren't met.
+type FreezeCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeCardRequestValidationError) ErrorName() string {
+	return "FreezeCardRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FreezeCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeCardRequestValidationError{}
+
+// Validate checks the field values on FreezeCardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FreezeCardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeCardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeCardResponseMultiError, or nil if none found.
+func (m *FreezeCardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeCardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeCardResponseValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeCardResponseValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeCardResponseValidationError{
+				field:  "Card",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FreezeCardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeCardResponseMultiError is an error wrapping multiple validation errors
+// returned by FreezeCardResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FreezeCardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeCardResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeCardResponseMultiError) AllErrors() []error { return m }
+
+// FreezeCardResponseValidationError is the validation error returned by
+// FreezeCardResponse.Validate if the designated constraints aren't met.
+type FreezeCardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeCardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeCardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeCardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeCardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeCardResponseValidationError) ErrorName() string {
+	return "FreezeCardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FreezeCardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeCardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeCardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeCardResponseValidationError{}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_account_by_number.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetAccountByNumberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountByNumberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountByNumberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountByNumberRequestMultiError, or nil if none found.
+func (m *GetAccountByNumberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountByNumberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetNumber()); l < 1 || l > 64 {
+		err := GetAccountByNumberRequestValidationError{
+			field:  "Number",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccountByNumberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountByNumberRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccountByNumberRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetAccountByNumberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountByNumberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountByNumberRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountByNumberRequestValidationError is the validation error returned by
+// GetAccountByNumberRequest.Validate if the designated constraints aren't met.
+type GetAccountByNumberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountByNumberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountByNumberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountByNumberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountByNumberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountByNumberRequestValidationError) ErrorName() string {
+	return "GetAccountByNumberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountByNumberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountByNumberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountByNumberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountByNumberRequestValidationError{}
+
+// Validate checks the field values on GetAccountByNumberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountByNumberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountByNumberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountByNumberResponseMultiError, or nil if none found.
+func (m *GetAccountByNumberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountByNumberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountByNumberResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountByNumberResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountByNumberResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccountByNumberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountByNumberResponseMultiError is an error wrapping multiple
+// validation errors returned by GetAccountByNumberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetAccountByNumberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountByNumberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountByNumberResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountByNumberResponseValidationError is the validation error returned
+// by GetAccountByNumberResponse.Validate if the designated constraints aren't met.
+type GetAccountByNumberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountByNumberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountByNumberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountByNumberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountByNumberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountByNumberResponseValidationError) ErrorName() string {
+	return "GetAccountByNumberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountByNumberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountByNumberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountByNumberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountByNumberResponseValidationError{}
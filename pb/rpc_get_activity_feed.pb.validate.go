@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_activity_feed.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetActivityFeedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetActivityFeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActivityFeedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActivityFeedRequestMultiError, or nil if none found.
+func (m *GetActivityFeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActivityFeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := GetActivityFeedRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if val := m.GetPageSize(); val < 5 || val > 50 {
+		err := GetActivityFeedRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [5, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetActivityFeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActivityFeedRequestMultiError is an error wrapping multiple validation
+// errors returned by GetActivityFeedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetActivityFeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActivityFeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActivityFeedRequestMultiError) AllErrors() []error { return m }
+
+// GetActivityFeedRequestValidationError is the validation error returned by
+// GetActivityFeedRequest.Validate if the designated constraints aren't met.
+type GetActivityFeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActivityFeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActivityFeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActivityFeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActivityFeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActivityFeedRequestValidationError) ErrorName() string {
+	return "GetActivityFeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActivityFeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActivityFeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActivityFeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActivityFeedRequestValidationError{}
+
+// Validate checks the field values on GetActivityFeedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetActivityFeedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetActivityFeedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetActivityFeedResponseMultiError, or nil if none found.
+func (m *GetActivityFeedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetActivityFeedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetActivityFeedResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetActivityFeedResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetActivityFeedResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetActivityFeedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetActivityFeedResponseMultiError is an error wrapping multiple validation
+// errors returned by GetActivityFeedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetActivityFeedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetActivityFeedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetActivityFeedResponseMultiError) AllErrors() []error { return m }
+
+// GetActivityFeedResponseValidationError is the validation error returned by
+// GetActivityFeedResponse.Validate if the designated constraints aren't met.
+type GetActivityFeedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetActivityFeedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetActivityFeedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetActivityFeedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetActivityFeedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetActivityFeedResponseValidationError) ErrorName() string {
+	return "GetActivityFeedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetActivityFeedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetActivityFeedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetActivityFeedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetActivityFeedResponseValidationError{}
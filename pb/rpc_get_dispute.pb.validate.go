@@ -0,0 +1,314 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_dispute.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetDisputeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetDisputeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDisputeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDisputeRequestMultiError, or nil if none found.
+func (m *GetDisputeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDisputeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetDisputeRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDisputeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDisputeRequestMultiError is an error wrapping multiple validation errors
+// returned by GetDisputeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetDisputeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDisputeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDisputeRequestMultiError) AllErrors() []error { return m }
+
+// GetDisputeRequestValidationError is the validation error returned by
+// GetDisputeRequest.Validate if the designated constraints aren't met.
+type GetDisputeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDisputeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDisputeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDisputeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDisputeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDisputeRequestValidationError) ErrorName() string {
+	return "GetDisputeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDisputeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDisputeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDisputeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDisputeRequestValidationError{}
+
+// Validate checks the field values on GetDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDisputeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDisputeResponseMultiError, or nil if none found.
+func (m *GetDisputeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDisputeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDispute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDispute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDisputeResponseValidationError{
+				field:  "Dispute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetNotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDisputeResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDisputeResponseValidationError{
+						field:  fmt.Sprintf("Notes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDisputeResponseValidationError{
+					field:  fmt.Sprintf("Notes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDisputeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDisputeResponseMultiError is an error wrapping multiple validation errors
+// returned by GetDisputeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetDisputeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDisputeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDisputeResponseMultiError) AllErrors() []error { return m }
+
+// GetDisputeResponseValidationError is the validation error returned by
+// GetDisputeResponse.Validate if the designated constraints aren't met.
+type GetDisputeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDisputeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDisputeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDisputeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDisputeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDisputeResponseValidationError) ErrorName() string {
+	return "GetDisputeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDisputeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDisputeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDisputeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDisputeResponseValidationError{}
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_get_export_status.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetExportStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportId int64 `protobuf:"varint,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+}
+
+func (x *GetExportStatusRequest) Reset() {
+	*x = GetExportStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_export_status_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportStatusRequest) ProtoMessage() {}
+
+func (x *GetExportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_export_status_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetExportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_export_status_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetExportStatusRequest) GetExportId() int64 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type GetExportStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportJob *ExportJob `protobuf:"bytes,1,opt,name=export_job,json=exportJob,proto3" json:"export_job,omitempty"`
+	// Short-lived link to download the CSV file, only set when the export is completed
+	DownloadUrl string `protobuf:"bytes,2,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+}
+
+func (x *GetExportStatusResponse) Reset() {
+	*x = GetExportStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_export_status_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportStatusResponse) ProtoMessage() {}
+
+func (x *GetExportStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_export_status_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetExportStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_export_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetExportStatusResponse) GetExportJob() *ExportJob {
+	if x != nil {
+		return x.ExportJob
+	}
+	return nil
+}
+
+func (x *GetExportStatusResponse) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+var File_rpc_get_export_status_proto protoreflect.FileDescriptor
+
+var file_rpc_get_export_status_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x10, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_get_export_status_proto_rawDescOnce sync.Once
+	file_rpc_get_export_status_proto_rawDescData = file_rpc_get_export_status_proto_rawDesc
+)
+
+func file_rpc_get_export_status_proto_rawDescGZIP() []byte {
+	file_rpc_get_export_status_proto_rawDescOnce.Do(func() {
+		file_rpc_get_export_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_export_status_proto_rawDescData)
+	})
+	return file_rpc_get_export_status_proto_rawDescData
+}
+
+var file_rpc_get_export_status_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_export_status_proto_goTypes = []interface{}{
+	(*GetExportStatusRequest)(nil),  // 0: pb.GetExportStatusRequest
+	(*GetExportStatusResponse)(nil), // 1: pb.GetExportStatusResponse
+	(*ExportJob)(nil),               // 2: pb.ExportJob
+}
+var file_rpc_get_export_status_proto_depIdxs = []int32{
+	2, // 0: pb.GetExportStatusResponse.export_job:type_name -> pb.ExportJob
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_export_status_proto_init() }
+func file_rpc_get_export_status_proto_init() {
+	if File_rpc_get_export_status_proto != nil {
+		return
+	}
+	file_export_job_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_export_status_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_export_status_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_export_status_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_export_status_proto_goTypes,
+		DependencyIndexes: file_rpc_get_export_status_proto_depIdxs,
+		MessageInfos:      file_rpc_get_export_status_proto_msgTypes,
+	}.Build()
+	File_rpc_get_export_status_proto = out.File
+	file_rpc_get_export_status_proto_rawDesc = nil
+	file_rpc_get_export_status_proto_goTypes = nil
+	file_rpc_get_export_status_proto_depIdxs = nil
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_export_status.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetExportStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExportStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExportStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExportStatusRequestMultiError, or nil if none found.
+func (m *GetExportStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExportStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetExportId() <= 0 {
+		err := GetExportStatusRequestValidationError{
+			field:  "ExportId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetExportStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExportStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetExportStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetExportStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExportStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExportStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetExportStatusRequestValidationError is the validation error returned by
+// GetExportStatusRequest.Validate if the designated constraints aren't met.
+type GetExportStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExportStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExportStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExportStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExportStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExportStatusRequestValidationError) ErrorName() string {
+	return "GetExportStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExportStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExportStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExportStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExportStatusRequestValidationError{}
+
+// Validate checks the field values on GetExportStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExportStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExportStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExportStatusResponseMultiError, or nil if none found.
+func (m *GetExportStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExportStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExportJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExportStatusResponseValidationError{
+					field:  "ExportJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExportStatusResponseValidationError{
+					field:  "ExportJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExportStatusResponseValidationError{
+				field:  "ExportJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DownloadUrl
+
+	if len(errors) > 0 {
+		return GetExportStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExportStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetExportStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetExportStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExportStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExportStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetExportStatusResponseValidationError is the validation error returned by
+// GetExportStatusResponse.Validate if the designated constraints aren't met.
+type GetExportStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExportStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExportStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExportStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExportStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExportStatusResponseValidationError) ErrorName() string {
+	return "GetExportStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExportStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExportStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExportStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExportStatusResponseValidationError{}
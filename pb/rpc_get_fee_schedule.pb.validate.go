@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_fee_schedule.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetFeeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeeScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeeScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeeScheduleRequestMultiError, or nil if none found.
+func (m *GetFeeScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeeScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return GetFeeScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeeScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by GetFeeScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeeScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeeScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeeScheduleRequestMultiError) AllErrors() []error { return m }
+
+// GetFeeScheduleRequestValidationError is the validation error returned by
+// GetFeeScheduleRequest.Validate if the designated constraints aren't met.
+type GetFeeScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeeScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeeScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeeScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeeScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeeScheduleRequestValidationError) ErrorName() string {
+	return "GetFeeScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeeScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeeScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeeScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeeScheduleRequestValidationError{}
+
+// Validate checks the field values on Fee with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Fee) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Fee with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in FeeMultiError, or nil if none found.
+func (m *Fee) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Fee) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Currency
+
+	// no validation rules for Flat
+
+	// no validation rules for Bps
+
+	if len(errors) > 0 {
+		return FeeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FeeMultiError is an error wrapping multiple validation errors returned by
+// Fee.ValidateAll() if the designated constraints aren't met.
+type FeeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FeeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FeeMultiError) AllErrors() []error { return m }
+
+// FeeValidationError is the validation error returned by Fee.Validate if the
+// designated constraints aren't met.
+type FeeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FeeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FeeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FeeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FeeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FeeValidationError) ErrorName() string { return "FeeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FeeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFee.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FeeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FeeValidationError{}
+
+// Validate checks the field values on GetFeeScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetFeeScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFeeScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFeeScheduleResponseMultiError, or nil if none found.
+func (m *GetFeeScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFeeScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFees() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFeeScheduleResponseValidationError{
+						field:  fmt.Sprintf("Fees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFeeScheduleResponseValidationError{
+						field:  fmt.Sprintf("Fees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFeeScheduleResponseValidationError{
+					field:  fmt.Sprintf("Fees[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetFeeScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFeeScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by GetFeeScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetFeeScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFeeScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFeeScheduleResponseMultiError) AllErrors() []error { return m }
+
+// GetFeeScheduleResponseValidationError is the validation error returned by
+// GetFeeScheduleResponse.Validate if the designated constraints aren't met.
+type GetFeeScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFeeScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFeeScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFeeScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFeeScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFeeScheduleResponseValidationError) ErrorName() string {
+	return "GetFeeScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetFeeScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFeeScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFeeScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFeeScheduleResponseValidationError{}
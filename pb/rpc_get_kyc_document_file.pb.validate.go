@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_kyc_document_file.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetKYCDocumentFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKYCDocumentFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKYCDocumentFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKYCDocumentFileRequestMultiError, or nil if none found.
+func (m *GetKYCDocumentFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKYCDocumentFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDocumentId() <= 0 {
+		err := GetKYCDocumentFileRequestValidationError{
+			field:  "DocumentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetKYCDocumentFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKYCDocumentFileRequestMultiError is an error wrapping multiple validation
+// errors returned by GetKYCDocumentFileRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetKYCDocumentFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKYCDocumentFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKYCDocumentFileRequestMultiError) AllErrors() []error { return m }
+
+// GetKYCDocumentFileRequestValidationError is the validation error returned by
+// GetKYCDocumentFileRequest.Validate if the designated constraints aren't met.
+type GetKYCDocumentFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKYCDocumentFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKYCDocumentFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKYCDocumentFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKYCDocumentFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKYCDocumentFileRequestValidationError) ErrorName() string {
+	return "GetKYCDocumentFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKYCDocumentFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKYCDocumentFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKYCDocumentFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKYCDocumentFileRequestValidationError{}
+
+// Validate checks the field values on GetKYCDocumentFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetKYCDocumentFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKYCDocumentFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKYCDocumentFileResponseMultiError, or nil if none found.
+func (m *GetKYCDocumentFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKYCDocumentFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	// no validation rules for DownloadUrl
+
+	if len(errors) > 0 {
+		return GetKYCDocumentFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKYCDocumentFileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetKYCDocumentFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetKYCDocumentFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKYCDocumentFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKYCDocumentFileResponseMultiError) AllErrors() []error { return m }
+
+// GetKYCDocumentFileResponseValidationError is the validation error returned
+// by GetKYCDocumentFileResponse.Validate if the designated constraints aren't met.
+type GetKYCDocumentFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKYCDocumentFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKYCDocumentFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKYCDocumentFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKYCDocumentFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKYCDocumentFileResponseValidationError) ErrorName() string {
+	return "GetKYCDocumentFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetKYCDocumentFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKYCDocumentFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKYCDocumentFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKYCDocumentFileResponseValidationError{}
@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_migration_status.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetMigrationStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMigrationStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMigrationStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMigrationStatusRequestMultiError, or nil if none found.
+func (m *GetMigrationStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMigrationStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMigrationStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMigrationStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetMigrationStatusRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetMigrationStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMigrationStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMigrationStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetMigrationStatusRequestValidationError is the validation error returned by
+// GetMigrationStatusRequest.Validate if the designated constraints aren't met.
+type GetMigrationStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMigrationStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMigrationStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMigrationStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMigrationStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMigrationStatusRequestValidationError) ErrorName() string {
+	return "GetMigrationStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMigrationStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMigrationStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMigrationStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMigrationStatusRequestValidationError{}
+
+// Validate checks the field values on GetMigrationStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMigrationStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMigrationStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMigrationStatusResponseMultiError, or nil if none found.
+func (m *GetMigrationStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMigrationStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Dirty
+
+	// no validation rules for LatestVersion
+
+	// no validation rules for Pending
+
+	// no validation rules for Behind
+
+	if len(errors) > 0 {
+		return GetMigrationStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMigrationStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMigrationStatusResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMigrationStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMigrationStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMigrationStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetMigrationStatusResponseValidationError is the validation error returned
+// by GetMigrationStatusResponse.Validate if the designated constraints aren't met.
+type GetMigrationStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMigrationStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMigrationStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMigrationStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMigrationStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMigrationStatusResponseValidationError) ErrorName() string {
+	return "GetMigrationStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMigrationStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMigrationStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMigrationStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMigrationStatusResponseValidationError{}
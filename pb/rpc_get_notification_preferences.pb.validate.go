@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_notification_preferences.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetNotificationPreferencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *GetNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetNotificationPreferencesRequestValidationError is the validation error
+// returned by GetNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "GetNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationPreferencesRequestValidationError{}
+
+// Validate checks the field values on GetNotificationPreferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNotificationPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetNotificationPreferencesResponseMultiError, or nil if none found.
+func (m *GetNotificationPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNotificationPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationPreferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNotificationPreferencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetNotificationPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationPreferencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// GetNotificationPreferencesResponseValidationError is the validation error
+// returned by GetNotificationPreferencesResponse.Validate if the designated
+// constraints aren't met.
+type GetNotificationPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationPreferencesResponseValidationError) ErrorName() string {
+	return "GetNotificationPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationPreferencesResponseValidationError{}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_oauth_url.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetOAuthURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOAuthURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOAuthURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOAuthURLRequestMultiError, or nil if none found.
+func (m *GetOAuthURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOAuthURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Provider
+
+	if len(errors) > 0 {
+		return GetOAuthURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOAuthURLRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOAuthURLRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOAuthURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOAuthURLRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOAuthURLRequestMultiError) AllErrors() []error { return m }
+
+// GetOAuthURLRequestValidationError is the validation error returned by
+// GetOAuthURLRequest.Validate if the designated constraints aren't met.
+type GetOAuthURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOAuthURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOAuthURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOAuthURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOAuthURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOAuthURLRequestValidationError) ErrorName() string {
+	return "GetOAuthURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOAuthURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOAuthURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOAuthURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOAuthURLRequestValidationError{}
+
+// Validate checks the field values on GetOAuthURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOAuthURLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOAuthURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOAuthURLResponseMultiError, or nil if none found.
+func (m *GetOAuthURLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOAuthURLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthUrl
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return GetOAuthURLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOAuthURLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOAuthURLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOAuthURLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOAuthURLResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOAuthURLResponseMultiError) AllErrors() []error { return m }
+
+// GetOAuthURLResponseValidationError is the validation error returned by
+// GetOAuthURLResponse.Validate if the designated constraints aren't met.
+type GetOAuthURLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOAuthURLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOAuthURLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOAuthURLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOAuthURLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOAuthURLResponseValidationError) ErrorName() string {
+	return "GetOAuthURLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOAuthURLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOAuthURLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOAuthURLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOAuthURLResponseValidationError{}
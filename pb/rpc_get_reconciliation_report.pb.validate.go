@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_reconciliation_report.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetReconciliationReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReconciliationReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReconciliationReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetReconciliationReportRequestMultiError, or nil if none found.
+func (m *GetReconciliationReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReconciliationReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.ReportId != nil {
+
+		if m.GetReportId() <= 0 {
+			err := GetReconciliationReportRequestValidationError{
+				field:  "ReportId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReconciliationReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReconciliationReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetReconciliationReportRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetReconciliationReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReconciliationReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReconciliationReportRequestMultiError) AllErrors() []error { return m }
+
+// GetReconciliationReportRequestValidationError is the validation error
+// returned by GetReconciliationReportRequest.Validate if the designated
+// constraints aren't met.
+type GetReconciliationReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReconciliationReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReconciliationReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReconciliationReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReconciliationReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReconciliationReportRequestValidationError) ErrorName() string {
+	return "GetReconciliationReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReconciliationReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReconciliationReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReconciliationReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReconciliationReportRequestValidationError{}
+
+// Validate checks the field values on GetReconciliationReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReconciliationReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReconciliationReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetReconciliationReportResponseMultiError, or nil if none found.
+func (m *GetReconciliationReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReconciliationReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReconciliationReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReconciliationReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReconciliationReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReconciliationReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReconciliationReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetReconciliationReportResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetReconciliationReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReconciliationReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReconciliationReportResponseMultiError) AllErrors() []error { return m }
+
+// GetReconciliationReportResponseValidationError is the validation error
+// returned by GetReconciliationReportResponse.Validate if the designated
+// constraints aren't met.
+type GetReconciliationReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReconciliationReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReconciliationReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReconciliationReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReconciliationReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReconciliationReportResponseValidationError) ErrorName() string {
+	return "GetReconciliationReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReconciliationReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReconciliationReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReconciliationReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReconciliationReportResponseValidationError{}
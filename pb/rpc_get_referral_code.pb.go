@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_get_referral_code.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetReferralCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetReferralCodeRequest) Reset() {
+	*x = GetReferralCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_referral_code_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReferralCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeRequest) ProtoMessage() {}
+
+func (x *GetReferralCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_referral_code_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_referral_code_proto_rawDescGZIP(), []int{0}
+}
+
+type GetReferralCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// share this code, new users enter it as referral_code when they sign up
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// referred users who haven't verified their email or received their first deposit yet
+	PendingCount  int64 `protobuf:"varint,2,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	CreditedCount int64 `protobuf:"varint,3,opt,name=credited_count,json=creditedCount,proto3" json:"credited_count,omitempty"`
+}
+
+func (x *GetReferralCodeResponse) Reset() {
+	*x = GetReferralCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_referral_code_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReferralCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReferralCodeResponse) ProtoMessage() {}
+
+func (x *GetReferralCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_referral_code_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReferralCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_referral_code_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetReferralCodeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetReferralCodeResponse) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *GetReferralCodeResponse) GetCreditedCount() int64 {
+	if x != nil {
+		return x.CreditedCount
+	}
+	return 0
+}
+
+var File_rpc_get_referral_code_proto protoreflect.FileDescriptor
+
+var file_rpc_get_referral_code_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f,
+	0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_referral_code_proto_rawDescOnce sync.Once
+	file_rpc_get_referral_code_proto_rawDescData = file_rpc_get_referral_code_proto_rawDesc
+)
+
+func file_rpc_get_referral_code_proto_rawDescGZIP() []byte {
+	file_rpc_get_referral_code_proto_rawDescOnce.Do(func() {
+		file_rpc_get_referral_code_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_referral_code_proto_rawDescData)
+	})
+	return file_rpc_get_referral_code_proto_rawDescData
+}
+
+var file_rpc_get_referral_code_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_referral_code_proto_goTypes = []interface{}{
+	(*GetReferralCodeRequest)(nil),  // 0: pb.GetReferralCodeRequest
+	(*GetReferralCodeResponse)(nil), // 1: pb.GetReferralCodeResponse
+}
+var file_rpc_get_referral_code_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_referral_code_proto_init() }
+func file_rpc_get_referral_code_proto_init() {
+	if File_rpc_get_referral_code_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_referral_code_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReferralCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_referral_code_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReferralCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_referral_code_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_referral_code_proto_goTypes,
+		DependencyIndexes: file_rpc_get_referral_code_proto_depIdxs,
+		MessageInfos:      file_rpc_get_referral_code_proto_msgTypes,
+	}.Build()
+	File_rpc_get_referral_code_proto = out.File
+	file_rpc_get_referral_code_proto_rawDesc = nil
+	file_rpc_get_referral_code_proto_goTypes = nil
+	file_rpc_get_referral_code_proto_depIdxs = nil
+}
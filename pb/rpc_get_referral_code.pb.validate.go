@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_referral_code.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetReferralCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReferralCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReferralCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReferralCodeRequestMultiError, or nil if none found.
+func (m *GetReferralCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReferralCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetReferralCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReferralCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReferralCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReferralCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReferralCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReferralCodeRequestMultiError) AllErrors() []error { return m }
+
+// GetReferralCodeRequestValidationError is the validation error returned by
+// GetReferralCodeRequest.Validate if the designated constraints aren't met.
+type GetReferralCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReferralCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReferralCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReferralCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReferralCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReferralCodeRequestValidationError) ErrorName() string {
+	return "GetReferralCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReferralCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReferralCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReferralCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReferralCodeRequestValidationError{}
+
+// Validate checks the field values on GetReferralCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReferralCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReferralCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReferralCodeResponseMultiError, or nil if none found.
+func (m *GetReferralCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReferralCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for PendingCount
+
+	// no validation rules for CreditedCount
+
+	if len(errors) > 0 {
+		return GetReferralCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReferralCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReferralCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetReferralCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReferralCodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReferralCodeResponseMultiError) AllErrors() []error { return m }
+
+// GetReferralCodeResponseValidationError is the validation error returned by
+// GetReferralCodeResponse.Validate if the designated constraints aren't met.
+type GetReferralCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReferralCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReferralCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReferralCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReferralCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReferralCodeResponseValidationError) ErrorName() string {
+	return "GetReferralCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReferralCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReferralCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReferralCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReferralCodeResponseValidationError{}
@@ -0,0 +1,431 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_spending_summary.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetSpendingSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSpendingSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSpendingSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSpendingSummaryRequestMultiError, or nil if none found.
+func (m *GetSpendingSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSpendingSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := GetSpendingSummaryRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMonths(); val < 1 || val > 12 {
+		err := GetSpendingSummaryRequestValidationError{
+			field:  "Months",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSpendingSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSpendingSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSpendingSummaryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetSpendingSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSpendingSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSpendingSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetSpendingSummaryRequestValidationError is the validation error returned by
+// GetSpendingSummaryRequest.Validate if the designated constraints aren't met.
+type GetSpendingSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSpendingSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSpendingSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSpendingSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSpendingSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSpendingSummaryRequestValidationError) ErrorName() string {
+	return "GetSpendingSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSpendingSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSpendingSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSpendingSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSpendingSummaryRequestValidationError{}
+
+// Validate checks the field values on SpendingSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SpendingSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpendingSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpendingSummaryMultiError, or nil if none found.
+func (m *SpendingSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpendingSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMonth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SpendingSummaryValidationError{
+					field:  "Month",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SpendingSummaryValidationError{
+					field:  "Month",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMonth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SpendingSummaryValidationError{
+				field:  "Month",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Category
+
+	// no validation rules for TotalAmount
+
+	// no validation rules for TransferCount
+
+	if len(errors) > 0 {
+		return SpendingSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpendingSummaryMultiError is an error wrapping multiple validation errors
+// returned by SpendingSummary.ValidateAll() if the designated constraints
+// aren't met.
+type SpendingSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpendingSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpendingSummaryMultiError) AllErrors() []error { return m }
+
+// SpendingSummaryValidationError is the validation error returned by
+// SpendingSummary.Validate if the designated constraints aren't met.
+type SpendingSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpendingSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpendingSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpendingSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpendingSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpendingSummaryValidationError) ErrorName() string { return "SpendingSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpendingSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpendingSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpendingSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpendingSummaryValidationError{}
+
+// Validate checks the field values on GetSpendingSummaryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSpendingSummaryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSpendingSummaryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSpendingSummaryResponseMultiError, or nil if none found.
+func (m *GetSpendingSummaryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSpendingSummaryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSpendingSummaryResponseValidationError{
+						field:  fmt.Sprintf("Summaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSpendingSummaryResponseValidationError{
+						field:  fmt.Sprintf("Summaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSpendingSummaryResponseValidationError{
+					field:  fmt.Sprintf("Summaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSpendingSummaryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSpendingSummaryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSpendingSummaryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetSpendingSummaryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSpendingSummaryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSpendingSummaryResponseMultiError) AllErrors() []error { return m }
+
+// GetSpendingSummaryResponseValidationError is the validation error returned
+// by GetSpendingSummaryResponse.Validate if the designated constraints aren't met.
+type GetSpendingSummaryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSpendingSummaryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSpendingSummaryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSpendingSummaryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSpendingSummaryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSpendingSummaryResponseValidationError) ErrorName() string {
+	return "GetSpendingSummaryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSpendingSummaryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSpendingSummaryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSpendingSummaryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSpendingSummaryResponseValidationError{}
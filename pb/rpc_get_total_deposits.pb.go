@@ -0,0 +1,204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_get_total_deposits.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTotalDepositsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTotalDepositsRequest) Reset() {
+	*x = GetTotalDepositsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_total_deposits_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalDepositsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalDepositsRequest) ProtoMessage() {}
+
+func (x *GetTotalDepositsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_total_deposits_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalDepositsRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalDepositsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_total_deposits_proto_rawDescGZIP(), []int{0}
+}
+
+type GetTotalDepositsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 按币种汇总 depositor 用户的账户余额，已经关闭的账户不算
+	Deposits []*CurrencyDeposits `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+}
+
+func (x *GetTotalDepositsResponse) Reset() {
+	*x = GetTotalDepositsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_total_deposits_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalDepositsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalDepositsResponse) ProtoMessage() {}
+
+func (x *GetTotalDepositsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_total_deposits_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalDepositsResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalDepositsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_total_deposits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetTotalDepositsResponse) GetDeposits() []*CurrencyDeposits {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+var File_rpc_get_total_deposits_proto protoreflect.FileDescriptor
+
+var file_rpc_get_total_deposits_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x0f, 0x64, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x42, 0x1f, 0x5a, 0x1d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73,
+	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_total_deposits_proto_rawDescOnce sync.Once
+	file_rpc_get_total_deposits_proto_rawDescData = file_rpc_get_total_deposits_proto_rawDesc
+)
+
+func file_rpc_get_total_deposits_proto_rawDescGZIP() []byte {
+	file_rpc_get_total_deposits_proto_rawDescOnce.Do(func() {
+		file_rpc_get_total_deposits_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_total_deposits_proto_rawDescData)
+	})
+	return file_rpc_get_total_deposits_proto_rawDescData
+}
+
+var file_rpc_get_total_deposits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_total_deposits_proto_goTypes = []interface{}{
+	(*GetTotalDepositsRequest)(nil),  // 0: pb.GetTotalDepositsRequest
+	(*GetTotalDepositsResponse)(nil), // 1: pb.GetTotalDepositsResponse
+	(*CurrencyDeposits)(nil),         // 2: pb.CurrencyDeposits
+}
+var file_rpc_get_total_deposits_proto_depIdxs = []int32{
+	2, // 0: pb.GetTotalDepositsResponse.deposits:type_name -> pb.CurrencyDeposits
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_total_deposits_proto_init() }
+func file_rpc_get_total_deposits_proto_init() {
+	if File_rpc_get_total_deposits_proto != nil {
+		return
+	}
+	file_dashboard_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_total_deposits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTotalDepositsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_total_deposits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTotalDepositsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_total_deposits_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_total_deposits_proto_goTypes,
+		DependencyIndexes: file_rpc_get_total_deposits_proto_depIdxs,
+		MessageInfos:      file_rpc_get_total_deposits_proto_msgTypes,
+	}.Build()
+	File_rpc_get_total_deposits_proto = out.File
+	file_rpc_get_total_deposits_proto_rawDesc = nil
+	file_rpc_get_total_deposits_proto_goTypes = nil
+	file_rpc_get_total_deposits_proto_depIdxs = nil
+}
@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_total_deposits.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetTotalDepositsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTotalDepositsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTotalDepositsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTotalDepositsRequestMultiError, or nil if none found.
+func (m *GetTotalDepositsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTotalDepositsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetTotalDepositsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTotalDepositsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTotalDepositsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTotalDepositsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTotalDepositsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTotalDepositsRequestMultiError) AllErrors() []error { return m }
+
+// GetTotalDepositsRequestValidationError is the validation error returned by
+// GetTotalDepositsRequest.Validate if the designated constraints aren't met.
+type GetTotalDepositsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTotalDepositsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTotalDepositsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTotalDepositsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTotalDepositsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTotalDepositsRequestValidationError) ErrorName() string {
+	return "GetTotalDepositsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTotalDepositsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTotalDepositsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTotalDepositsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTotalDepositsRequestValidationError{}
+
+// Validate checks the field values on GetTotalDepositsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTotalDepositsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTotalDepositsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTotalDepositsResponseMultiError, or nil if none found.
+func (m *GetTotalDepositsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTotalDepositsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeposits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetTotalDepositsResponseValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetTotalDepositsResponseValidationError{
+						field:  fmt.Sprintf("Deposits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetTotalDepositsResponseValidationError{
+					field:  fmt.Sprintf("Deposits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetTotalDepositsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTotalDepositsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTotalDepositsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTotalDepositsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTotalDepositsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTotalDepositsResponseMultiError) AllErrors() []error { return m }
+
+// GetTotalDepositsResponseValidationError is the validation error returned by
+// GetTotalDepositsResponse.Validate if the designated constraints aren't met.
+type GetTotalDepositsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTotalDepositsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTotalDepositsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTotalDepositsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTotalDepositsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTotalDepositsResponseValidationError) ErrorName() string {
+	return "GetTotalDepositsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTotalDepositsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTotalDepositsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTotalDepositsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTotalDepositsResponseValidationError{}
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_transfer_quote.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetTransferQuoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransferQuoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransferQuoteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransferQuoteRequestMultiError, or nil if none found.
+func (m *GetTransferQuoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransferQuoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FromCurrency
+
+	// no validation rules for ToCurrency
+
+	// no validation rules for Amount
+
+	// no validation rules for AmountDecimal
+
+	if len(errors) > 0 {
+		return GetTransferQuoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransferQuoteRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransferQuoteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransferQuoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransferQuoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransferQuoteRequestMultiError) AllErrors() []error { return m }
+
+// GetTransferQuoteRequestValidationError is the validation error returned by
+// GetTransferQuoteRequest.Validate if the designated constraints aren't met.
+type GetTransferQuoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransferQuoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransferQuoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransferQuoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransferQuoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransferQuoteRequestValidationError) ErrorName() string {
+	return "GetTransferQuoteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransferQuoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransferQuoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransferQuoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransferQuoteRequestValidationError{}
+
+// Validate checks the field values on GetTransferQuoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransferQuoteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransferQuoteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransferQuoteResponseMultiError, or nil if none found.
+func (m *GetTransferQuoteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransferQuoteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuote()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransferQuoteResponseValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransferQuoteResponseValidationError{
+					field:  "Quote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuote()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransferQuoteResponseValidationError{
+				field:  "Quote",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTransferQuoteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransferQuoteResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTransferQuoteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransferQuoteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransferQuoteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransferQuoteResponseMultiError) AllErrors() []error { return m }
+
+// GetTransferQuoteResponseValidationError is the validation error returned by
+// GetTransferQuoteResponse.Validate if the designated constraints aren't met.
+type GetTransferQuoteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransferQuoteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransferQuoteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransferQuoteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransferQuoteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransferQuoteResponseValidationError) ErrorName() string {
+	return "GetTransferQuoteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransferQuoteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransferQuoteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransferQuoteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransferQuoteResponseValidationError{}
@@ -0,0 +1,315 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_transfer_status.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetTransferStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransferStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransferStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransferStatusRequestMultiError, or nil if none found.
+func (m *GetTransferStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransferStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTransferId() <= 0 {
+		err := GetTransferStatusRequestValidationError{
+			field:  "TransferId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTransferStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransferStatusRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTransferStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTransferStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransferStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransferStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetTransferStatusRequestValidationError is the validation error returned by
+// GetTransferStatusRequest.Validate if the designated constraints aren't met.
+type GetTransferStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransferStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransferStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransferStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransferStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransferStatusRequestValidationError) ErrorName() string {
+	return "GetTransferStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransferStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransferStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransferStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransferStatusRequestValidationError{}
+
+// Validate checks the field values on GetTransferStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTransferStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTransferStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTransferStatusResponseMultiError, or nil if none found.
+func (m *GetTransferStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTransferStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransferStatusResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransferStatusResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransferStatusResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for FailureReason
+
+	// no validation rules for ReversalTransferId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetTransferStatusResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetTransferStatusResponseValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetTransferStatusResponseValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetTransferStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTransferStatusResponseMultiError is an error wrapping multiple validation
+// errors returned by GetTransferStatusResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetTransferStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTransferStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTransferStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetTransferStatusResponseValidationError is the validation error returned by
+// GetTransferStatusResponse.Validate if the designated constraints aren't met.
+type GetTransferStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTransferStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTransferStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTransferStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTransferStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTransferStatusResponseValidationError) ErrorName() string {
+	return "GetTransferStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTransferStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTransferStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTransferStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTransferStatusResponseValidationError{}
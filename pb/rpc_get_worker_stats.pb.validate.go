@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_get_worker_stats.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetWorkerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkerStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkerStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkerStatsRequestMultiError, or nil if none found.
+func (m *GetWorkerStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkerStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetWorkerStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkerStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkerStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkerStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkerStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkerStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkerStatsRequestValidationError is the validation error returned by
+// GetWorkerStatsRequest.Validate if the designated constraints aren't met.
+type GetWorkerStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkerStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkerStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkerStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkerStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkerStatsRequestValidationError) ErrorName() string {
+	return "GetWorkerStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkerStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkerStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkerStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkerStatsRequestValidationError{}
+
+// Validate checks the field values on QueueStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueueStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueueStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueueStatsMultiError, or
+// nil if none found.
+func (m *QueueStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueueStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queue
+
+	// no validation rules for Size
+
+	// no validation rules for Pending
+
+	// no validation rules for Active
+
+	// no validation rules for Scheduled
+
+	// no validation rules for Retry
+
+	// no validation rules for Archived
+
+	// no validation rules for Completed
+
+	// no validation rules for ProcessedToday
+
+	// no validation rules for FailedToday
+
+	// no validation rules for ProcessedTotal
+
+	// no validation rules for FailedTotal
+
+	// no validation rules for FailureRate
+
+	// no validation rules for LatencyMs
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return QueueStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueueStatsMultiError is an error wrapping multiple validation errors
+// returned by QueueStats.ValidateAll() if the designated constraints aren't met.
+type QueueStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueueStatsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueueStatsMultiError) AllErrors() []error { return m }
+
+// QueueStatsValidationError is the validation error returned by
+// QueueStats.Validate if the designated constraints aren't met.
+type QueueStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueStatsValidationError) ErrorName() string { return "QueueStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueueStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueStatsValidationError{}
+
+// Validate checks the field values on GetWorkerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkerStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkerStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkerStatsResponseMultiError, or nil if none found.
+func (m *GetWorkerStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkerStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueues() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWorkerStatsResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWorkerStatsResponseValidationError{
+						field:  fmt.Sprintf("Queues[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWorkerStatsResponseValidationError{
+					field:  fmt.Sprintf("Queues[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWorkerStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkerStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkerStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkerStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkerStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkerStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkerStatsResponseValidationError is the validation error returned by
+// GetWorkerStatsResponse.Validate if the designated constraints aren't met.
+type GetWorkerStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkerStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkerStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkerStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkerStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkerStatsResponseValidationError) ErrorName() string {
+	return "GetWorkerStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkerStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkerStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkerStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkerStatsResponseValidationError{}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_issue_card.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on IssueCardRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssueCardRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueCardRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueCardRequestMultiError, or nil if none found.
+func (m *IssueCardRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueCardRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := IssueCardRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IssueCardRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueCardRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueCardRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueCardRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueCardRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueCardRequestMultiError) AllErrors() []error { return m }
+
+// IssueCardRequestValidationError is the validation error returned by
+// IssueCardRequest.Validate if the designated constraints aren't met.
+type IssueCardRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueCardRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueCardRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueCardRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueCardRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueCardRequestValidationError) ErrorName() string { return "IssueCardRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueCardRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueCardRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueCardRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueCardRequestValidationError{}
+
+// Validate checks the field values on IssueCardResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssueCardResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueCardResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueCardResponseMultiError, or nil if none found.
+func (m *IssueCardResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueCardResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueCardResponseValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueCardResponseValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueCardResponseValidationError{
+				field:  "Card",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return IssueCardResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueCardResponseMultiError is an error wrapping multiple validation errors
+// returned by IssueCardResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IssueCardResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueCardResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueCardResponseMultiError) AllErrors() []error { return m }
+
+// IssueCardResponseValidationError is the validation error returned by
+// IssueCardResponse.Validate if the designated constraints aren't met.
+type IssueCardResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueCardResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueCardResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueCardResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueCardResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueCardResponseValidationError) ErrorName() string {
+	return "IssueCardResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueCardResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueCardResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueCardResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueCardResponseValidationError{}
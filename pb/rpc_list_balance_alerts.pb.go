@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_list_balance_alerts.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListBalanceAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *ListBalanceAlertsRequest) Reset() {
+	*x = ListBalanceAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_balance_alerts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceAlertsRequest) ProtoMessage() {}
+
+func (x *ListBalanceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_balance_alerts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_balance_alerts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListBalanceAlertsRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type ListBalanceAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BalanceAlerts []*BalanceAlert `protobuf:"bytes,1,rep,name=balance_alerts,json=balanceAlerts,proto3" json:"balance_alerts,omitempty"`
+}
+
+func (x *ListBalanceAlertsResponse) Reset() {
+	*x = ListBalanceAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_balance_alerts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceAlertsResponse) ProtoMessage() {}
+
+func (x *ListBalanceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_balance_alerts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListBalanceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_balance_alerts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListBalanceAlertsResponse) GetBalanceAlerts() []*BalanceAlert {
+	if x != nil {
+		return x.BalanceAlerts
+	}
+	return nil
+}
+
+var File_rpc_list_balance_alerts_proto protoreflect.FileDescriptor
+
+var file_rpc_list_balance_alerts_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x13, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x42, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x0d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63,
+	0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_balance_alerts_proto_rawDescOnce sync.Once
+	file_rpc_list_balance_alerts_proto_rawDescData = file_rpc_list_balance_alerts_proto_rawDesc
+)
+
+func file_rpc_list_balance_alerts_proto_rawDescGZIP() []byte {
+	file_rpc_list_balance_alerts_proto_rawDescOnce.Do(func() {
+		file_rpc_list_balance_alerts_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_balance_alerts_proto_rawDescData)
+	})
+	return file_rpc_list_balance_alerts_proto_rawDescData
+}
+
+var file_rpc_list_balance_alerts_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_balance_alerts_proto_goTypes = []interface{}{
+	(*ListBalanceAlertsRequest)(nil),  // 0: pb.ListBalanceAlertsRequest
+	(*ListBalanceAlertsResponse)(nil), // 1: pb.ListBalanceAlertsResponse
+	(*BalanceAlert)(nil),              // 2: pb.BalanceAlert
+}
+var file_rpc_list_balance_alerts_proto_depIdxs = []int32{
+	2, // 0: pb.ListBalanceAlertsResponse.balance_alerts:type_name -> pb.BalanceAlert
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_balance_alerts_proto_init() }
+func file_rpc_list_balance_alerts_proto_init() {
+	if File_rpc_list_balance_alerts_proto != nil {
+		return
+	}
+	file_balance_alert_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_balance_alerts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_balance_alerts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_balance_alerts_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_balance_alerts_proto_goTypes,
+		DependencyIndexes: file_rpc_list_balance_alerts_proto_depIdxs,
+		MessageInfos:      file_rpc_list_balance_alerts_proto_msgTypes,
+	}.Build()
+	File_rpc_list_balance_alerts_proto = out.File
+	file_rpc_list_balance_alerts_proto_rawDesc = nil
+	file_rpc_list_balance_alerts_proto_goTypes = nil
+	file_rpc_list_balance_alerts_proto_depIdxs = nil
+}
@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_balance_alerts.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListBalanceAlertsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalanceAlertsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalanceAlertsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalanceAlertsRequestMultiError, or nil if none found.
+func (m *ListBalanceAlertsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalanceAlertsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := ListBalanceAlertsRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBalanceAlertsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalanceAlertsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBalanceAlertsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBalanceAlertsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalanceAlertsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalanceAlertsRequestMultiError) AllErrors() []error { return m }
+
+// ListBalanceAlertsRequestValidationError is the validation error returned by
+// ListBalanceAlertsRequest.Validate if the designated constraints aren't met.
+type ListBalanceAlertsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalanceAlertsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalanceAlertsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalanceAlertsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalanceAlertsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalanceAlertsRequestValidationError) ErrorName() string {
+	return "ListBalanceAlertsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalanceAlertsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalanceAlertsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalanceAlertsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalanceAlertsRequestValidationError{}
+
+// Validate checks the field values on ListBalanceAlertsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBalanceAlertsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBalanceAlertsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBalanceAlertsResponseMultiError, or nil if none found.
+func (m *ListBalanceAlertsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBalanceAlertsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBalanceAlerts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBalanceAlertsResponseValidationError{
+						field:  fmt.Sprintf("BalanceAlerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBalanceAlertsResponseValidationError{
+						field:  fmt.Sprintf("BalanceAlerts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBalanceAlertsResponseValidationError{
+					field:  fmt.Sprintf("BalanceAlerts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBalanceAlertsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBalanceAlertsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBalanceAlertsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListBalanceAlertsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBalanceAlertsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBalanceAlertsResponseMultiError) AllErrors() []error { return m }
+
+// ListBalanceAlertsResponseValidationError is the validation error returned by
+// ListBalanceAlertsResponse.Validate if the designated constraints aren't met.
+type ListBalanceAlertsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBalanceAlertsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBalanceAlertsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBalanceAlertsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBalanceAlertsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBalanceAlertsResponseValidationError) ErrorName() string {
+	return "ListBalanceAlertsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBalanceAlertsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBalanceAlertsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBalanceAlertsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBalanceAlertsResponseValidationError{}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_daily_failed_logins.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListDailyFailedLoginsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyFailedLoginsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyFailedLoginsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDailyFailedLoginsRequestMultiError, or nil if none found.
+func (m *ListDailyFailedLoginsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyFailedLoginsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyFailedLoginsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyFailedLoginsRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyFailedLoginsRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyFailedLoginsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyFailedLoginsRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyFailedLoginsRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListDailyFailedLoginsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyFailedLoginsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDailyFailedLoginsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListDailyFailedLoginsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyFailedLoginsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyFailedLoginsRequestMultiError) AllErrors() []error { return m }
+
+// ListDailyFailedLoginsRequestValidationError is the validation error returned
+// by ListDailyFailedLoginsRequest.Validate if the designated constraints
+// aren't met.
+type ListDailyFailedLoginsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyFailedLoginsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyFailedLoginsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyFailedLoginsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyFailedLoginsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyFailedLoginsRequestValidationError) ErrorName() string {
+	return "ListDailyFailedLoginsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyFailedLoginsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyFailedLoginsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyFailedLoginsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyFailedLoginsRequestValidationError{}
+
+// Validate checks the field values on ListDailyFailedLoginsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyFailedLoginsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyFailedLoginsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDailyFailedLoginsResponseMultiError, or nil if none found.
+func (m *ListDailyFailedLoginsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyFailedLoginsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDailyFailedLoginsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDailyFailedLoginsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDailyFailedLoginsResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDailyFailedLoginsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyFailedLoginsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDailyFailedLoginsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDailyFailedLoginsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyFailedLoginsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyFailedLoginsResponseMultiError) AllErrors() []error { return m }
+
+// ListDailyFailedLoginsResponseValidationError is the validation error
+// returned by ListDailyFailedLoginsResponse.Validate if the designated
+// constraints aren't met.
+type ListDailyFailedLoginsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyFailedLoginsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyFailedLoginsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyFailedLoginsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyFailedLoginsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyFailedLoginsResponseValidationError) ErrorName() string {
+	return "ListDailyFailedLoginsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyFailedLoginsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyFailedLoginsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyFailedLoginsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyFailedLoginsResponseValidationError{}
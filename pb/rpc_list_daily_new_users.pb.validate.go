@@ -0,0 +1,332 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_daily_new_users.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListDailyNewUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyNewUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyNewUsersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDailyNewUsersRequestMultiError, or nil if none found.
+func (m *ListDailyNewUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyNewUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyNewUsersRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyNewUsersRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyNewUsersRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyNewUsersRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyNewUsersRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyNewUsersRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListDailyNewUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyNewUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDailyNewUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDailyNewUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyNewUsersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyNewUsersRequestMultiError) AllErrors() []error { return m }
+
+// ListDailyNewUsersRequestValidationError is the validation error returned by
+// ListDailyNewUsersRequest.Validate if the designated constraints aren't met.
+type ListDailyNewUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyNewUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyNewUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyNewUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyNewUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyNewUsersRequestValidationError) ErrorName() string {
+	return "ListDailyNewUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyNewUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyNewUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyNewUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyNewUsersRequestValidationError{}
+
+// Validate checks the field values on ListDailyNewUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyNewUsersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyNewUsersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDailyNewUsersResponseMultiError, or nil if none found.
+func (m *ListDailyNewUsersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyNewUsersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDailyNewUsersResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDailyNewUsersResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDailyNewUsersResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDailyNewUsersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyNewUsersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDailyNewUsersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListDailyNewUsersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyNewUsersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyNewUsersResponseMultiError) AllErrors() []error { return m }
+
+// ListDailyNewUsersResponseValidationError is the validation error returned by
+// ListDailyNewUsersResponse.Validate if the designated constraints aren't met.
+type ListDailyNewUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyNewUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyNewUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyNewUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyNewUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyNewUsersResponseValidationError) ErrorName() string {
+	return "ListDailyNewUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyNewUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyNewUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyNewUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyNewUsersResponseValidationError{}
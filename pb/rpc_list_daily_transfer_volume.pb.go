@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_list_daily_transfer_volume.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListDailyTransferVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 不传的话统计最近 30 天，时间范围最多 366 天
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListDailyTransferVolumeRequest) Reset() {
+	*x = ListDailyTransferVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_daily_transfer_volume_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDailyTransferVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDailyTransferVolumeRequest) ProtoMessage() {}
+
+func (x *ListDailyTransferVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_daily_transfer_volume_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDailyTransferVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ListDailyTransferVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_daily_transfer_volume_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListDailyTransferVolumeRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListDailyTransferVolumeRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListDailyTransferVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volumes []*DailyTransferVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *ListDailyTransferVolumeResponse) Reset() {
+	*x = ListDailyTransferVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_daily_transfer_volume_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDailyTransferVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDailyTransferVolumeResponse) ProtoMessage() {}
+
+func (x *ListDailyTransferVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_daily_transfer_volume_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDailyTransferVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ListDailyTransferVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_daily_transfer_volume_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDailyTransferVolumeResponse) GetVolumes() []*DailyTransferVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+var File_rpc_list_daily_transfer_volume_proto protoreflect.FileDescriptor
+
+var file_rpc_list_daily_transfer_volume_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0f, 0x64, 0x61, 0x73, 0x68,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x54, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c,
+	0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_daily_transfer_volume_proto_rawDescOnce sync.Once
+	file_rpc_list_daily_transfer_volume_proto_rawDescData = file_rpc_list_daily_transfer_volume_proto_rawDesc
+)
+
+func file_rpc_list_daily_transfer_volume_proto_rawDescGZIP() []byte {
+	file_rpc_list_daily_transfer_volume_proto_rawDescOnce.Do(func() {
+		file_rpc_list_daily_transfer_volume_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_daily_transfer_volume_proto_rawDescData)
+	})
+	return file_rpc_list_daily_transfer_volume_proto_rawDescData
+}
+
+var file_rpc_list_daily_transfer_volume_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_daily_transfer_volume_proto_goTypes = []interface{}{
+	(*ListDailyTransferVolumeRequest)(nil),  // 0: pb.ListDailyTransferVolumeRequest
+	(*ListDailyTransferVolumeResponse)(nil), // 1: pb.ListDailyTransferVolumeResponse
+	(*timestamppb.Timestamp)(nil),           // 2: google.protobuf.Timestamp
+	(*DailyTransferVolume)(nil),             // 3: pb.DailyTransferVolume
+}
+var file_rpc_list_daily_transfer_volume_proto_depIdxs = []int32{
+	2, // 0: pb.ListDailyTransferVolumeRequest.start_time:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.ListDailyTransferVolumeRequest.end_time:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.ListDailyTransferVolumeResponse.volumes:type_name -> pb.DailyTransferVolume
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_daily_transfer_volume_proto_init() }
+func file_rpc_list_daily_transfer_volume_proto_init() {
+	if File_rpc_list_daily_transfer_volume_proto != nil {
+		return
+	}
+	file_dashboard_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_daily_transfer_volume_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDailyTransferVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_daily_transfer_volume_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDailyTransferVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_daily_transfer_volume_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_daily_transfer_volume_proto_goTypes,
+		DependencyIndexes: file_rpc_list_daily_transfer_volume_proto_depIdxs,
+		MessageInfos:      file_rpc_list_daily_transfer_volume_proto_msgTypes,
+	}.Build()
+	File_rpc_list_daily_transfer_volume_proto = out.File
+	file_rpc_list_daily_transfer_volume_proto_rawDesc = nil
+	file_rpc_list_daily_transfer_volume_proto_goTypes = nil
+	file_rpc_list_daily_transfer_volume_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_daily_transfer_volume.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListDailyTransferVolumeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyTransferVolumeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyTransferVolumeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDailyTransferVolumeRequestMultiError, or nil if none found.
+func (m *ListDailyTransferVolumeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyTransferVolumeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyTransferVolumeRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyTransferVolumeRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyTransferVolumeRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDailyTransferVolumeRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDailyTransferVolumeRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDailyTransferVolumeRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListDailyTransferVolumeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyTransferVolumeRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDailyTransferVolumeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListDailyTransferVolumeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyTransferVolumeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyTransferVolumeRequestMultiError) AllErrors() []error { return m }
+
+// ListDailyTransferVolumeRequestValidationError is the validation error
+// returned by ListDailyTransferVolumeRequest.Validate if the designated
+// constraints aren't met.
+type ListDailyTransferVolumeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyTransferVolumeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyTransferVolumeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyTransferVolumeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyTransferVolumeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyTransferVolumeRequestValidationError) ErrorName() string {
+	return "ListDailyTransferVolumeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyTransferVolumeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyTransferVolumeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyTransferVolumeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyTransferVolumeRequestValidationError{}
+
+// Validate checks the field values on ListDailyTransferVolumeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDailyTransferVolumeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDailyTransferVolumeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDailyTransferVolumeResponseMultiError, or nil if none found.
+func (m *ListDailyTransferVolumeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDailyTransferVolumeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVolumes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDailyTransferVolumeResponseValidationError{
+						field:  fmt.Sprintf("Volumes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDailyTransferVolumeResponseValidationError{
+						field:  fmt.Sprintf("Volumes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDailyTransferVolumeResponseValidationError{
+					field:  fmt.Sprintf("Volumes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDailyTransferVolumeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDailyTransferVolumeResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDailyTransferVolumeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDailyTransferVolumeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDailyTransferVolumeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDailyTransferVolumeResponseMultiError) AllErrors() []error { return m }
+
+// ListDailyTransferVolumeResponseValidationError is the validation error
+// returned by ListDailyTransferVolumeResponse.Validate if the designated
+// constraints aren't met.
+type ListDailyTransferVolumeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDailyTransferVolumeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDailyTransferVolumeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDailyTransferVolumeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDailyTransferVolumeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDailyTransferVolumeResponseValidationError) ErrorName() string {
+	return "ListDailyTransferVolumeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDailyTransferVolumeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDailyTransferVolumeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDailyTransferVolumeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDailyTransferVolumeResponseValidationError{}
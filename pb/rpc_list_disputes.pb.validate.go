@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_disputes.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListDisputesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDisputesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDisputesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDisputesRequestMultiError, or nil if none found.
+func (m *ListDisputesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDisputesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for PageToken
+
+	if val := m.GetPageSize(); val < 5 || val > 50 {
+		err := ListDisputesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [5, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListDisputesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDisputesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDisputesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDisputesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDisputesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDisputesRequestMultiError) AllErrors() []error { return m }
+
+// ListDisputesRequestValidationError is the validation error returned by
+// ListDisputesRequest.Validate if the designated constraints aren't met.
+type ListDisputesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDisputesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDisputesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDisputesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDisputesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDisputesRequestValidationError) ErrorName() string {
+	return "ListDisputesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDisputesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDisputesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDisputesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDisputesRequestValidationError{}
+
+// Validate checks the field values on ListDisputesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDisputesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDisputesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDisputesResponseMultiError, or nil if none found.
+func (m *ListDisputesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDisputesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDisputes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDisputesResponseValidationError{
+						field:  fmt.Sprintf("Disputes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDisputesResponseValidationError{
+						field:  fmt.Sprintf("Disputes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDisputesResponseValidationError{
+					field:  fmt.Sprintf("Disputes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListDisputesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDisputesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDisputesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDisputesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDisputesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDisputesResponseMultiError) AllErrors() []error { return m }
+
+// ListDisputesResponseValidationError is the validation error returned by
+// ListDisputesResponse.Validate if the designated constraints aren't met.
+type ListDisputesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDisputesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDisputesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDisputesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDisputesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDisputesResponseValidationError) ErrorName() string {
+	return "ListDisputesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDisputesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDisputesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDisputesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDisputesResponseValidationError{}
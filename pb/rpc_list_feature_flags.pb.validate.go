@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_feature_flags.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListFeatureFlagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsRequestMultiError, or nil if none found.
+func (m *ListFeatureFlagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsRequestMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsRequestValidationError is the validation error returned by
+// ListFeatureFlagsRequest.Validate if the designated constraints aren't met.
+type ListFeatureFlagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsRequestValidationError) ErrorName() string {
+	return "ListFeatureFlagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsRequestValidationError{}
+
+// Validate checks the field values on ListFeatureFlagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFeatureFlagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFeatureFlagsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFeatureFlagsResponseMultiError, or nil if none found.
+func (m *ListFeatureFlagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFeatureFlagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFlags() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFeatureFlagsResponseValidationError{
+						field:  fmt.Sprintf("Flags[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFeatureFlagsResponseValidationError{
+					field:  fmt.Sprintf("Flags[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFeatureFlagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFeatureFlagsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListFeatureFlagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListFeatureFlagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFeatureFlagsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFeatureFlagsResponseMultiError) AllErrors() []error { return m }
+
+// ListFeatureFlagsResponseValidationError is the validation error returned by
+// ListFeatureFlagsResponse.Validate if the designated constraints aren't met.
+type ListFeatureFlagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFeatureFlagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFeatureFlagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFeatureFlagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFeatureFlagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFeatureFlagsResponseValidationError) ErrorName() string {
+	return "ListFeatureFlagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFeatureFlagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFeatureFlagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFeatureFlagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFeatureFlagsResponseValidationError{}
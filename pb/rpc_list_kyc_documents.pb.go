@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_list_kyc_documents.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListKYCDocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bankers can list the documents of any user, defaults to the authenticated user
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ListKYCDocumentsRequest) Reset() {
+	*x = ListKYCDocumentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_kyc_documents_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKYCDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKYCDocumentsRequest) ProtoMessage() {}
+
+func (x *ListKYCDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_kyc_documents_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKYCDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListKYCDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListKYCDocumentsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ListKYCDocumentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents []*KYCDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	KycLevel  int32          `protobuf:"varint,2,opt,name=kyc_level,json=kycLevel,proto3" json:"kyc_level,omitempty"`
+}
+
+func (x *ListKYCDocumentsResponse) Reset() {
+	*x = ListKYCDocumentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_kyc_documents_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListKYCDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKYCDocumentsResponse) ProtoMessage() {}
+
+func (x *ListKYCDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_kyc_documents_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKYCDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListKYCDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListKYCDocumentsResponse) GetDocuments() []*KYCDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *ListKYCDocumentsResponse) GetKycLevel() int32 {
+	if x != nil {
+		return x.KycLevel
+	}
+	return 0
+}
+
+type ListPendingKYCDocumentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId   int32 `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListPendingKYCDocumentsRequest) Reset() {
+	*x = ListPendingKYCDocumentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_kyc_documents_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingKYCDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingKYCDocumentsRequest) ProtoMessage() {}
+
+func (x *ListPendingKYCDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_kyc_documents_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingKYCDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingKYCDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPendingKYCDocumentsRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListPendingKYCDocumentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPendingKYCDocumentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents []*KYCDocument `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *ListPendingKYCDocumentsResponse) Reset() {
+	*x = ListPendingKYCDocumentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_kyc_documents_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingKYCDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingKYCDocumentsResponse) ProtoMessage() {}
+
+func (x *ListPendingKYCDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_kyc_documents_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingKYCDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingKYCDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_kyc_documents_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPendingKYCDocumentsResponse) GetDocuments() []*KYCDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+var File_rpc_list_kyc_documents_proto protoreflect.FileDescriptor
+
+var file_rpc_list_kyc_documents_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6b, 0x79, 0x63, 0x5f, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x1a, 0x12, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x51, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42,
+	0x17, 0x72, 0x15, 0x10, 0x03, 0x18, 0x64, 0x32, 0x0c, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5f, 0x5d, 0x2b, 0x24, 0xd0, 0x01, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x66, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6b, 0x79, 0x63, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6b, 0x79, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x6a, 0x0a, 0x1e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x01, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x32, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x50, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x62, 0x2e, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f,
+	0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_list_kyc_documents_proto_rawDescOnce sync.Once
+	file_rpc_list_kyc_documents_proto_rawDescData = file_rpc_list_kyc_documents_proto_rawDesc
+)
+
+func file_rpc_list_kyc_documents_proto_rawDescGZIP() []byte {
+	file_rpc_list_kyc_documents_proto_rawDescOnce.Do(func() {
+		file_rpc_list_kyc_documents_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_kyc_documents_proto_rawDescData)
+	})
+	return file_rpc_list_kyc_documents_proto_rawDescData
+}
+
+var file_rpc_list_kyc_documents_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_list_kyc_documents_proto_goTypes = []interface{}{
+	(*ListKYCDocumentsRequest)(nil),         // 0: pb.ListKYCDocumentsRequest
+	(*ListKYCDocumentsResponse)(nil),        // 1: pb.ListKYCDocumentsResponse
+	(*ListPendingKYCDocumentsRequest)(nil),  // 2: pb.ListPendingKYCDocumentsRequest
+	(*ListPendingKYCDocumentsResponse)(nil), // 3: pb.ListPendingKYCDocumentsResponse
+	(*KYCDocument)(nil),                     // 4: pb.KYCDocument
+}
+var file_rpc_list_kyc_documents_proto_depIdxs = []int32{
+	4, // 0: pb.ListKYCDocumentsResponse.documents:type_name -> pb.KYCDocument
+	4, // 1: pb.ListPendingKYCDocumentsResponse.documents:type_name -> pb.KYCDocument
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_kyc_documents_proto_init() }
+func file_rpc_list_kyc_documents_proto_init() {
+	if File_rpc_list_kyc_documents_proto != nil {
+		return
+	}
+	file_kyc_document_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_kyc_documents_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKYCDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_kyc_documents_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListKYCDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_kyc_documents_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingKYCDocumentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_kyc_documents_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingKYCDocumentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_kyc_documents_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_kyc_documents_proto_goTypes,
+		DependencyIndexes: file_rpc_list_kyc_documents_proto_depIdxs,
+		MessageInfos:      file_rpc_list_kyc_documents_proto_msgTypes,
+	}.Build()
+	File_rpc_list_kyc_documents_proto = out.File
+	file_rpc_list_kyc_documents_proto_rawDesc = nil
+	file_rpc_list_kyc_documents_proto_goTypes = nil
+	file_rpc_list_kyc_documents_proto_depIdxs = nil
+}
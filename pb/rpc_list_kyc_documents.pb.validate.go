@@ -0,0 +1,566 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_kyc_documents.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListKYCDocumentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKYCDocumentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKYCDocumentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKYCDocumentsRequestMultiError, or nil if none found.
+func (m *ListKYCDocumentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKYCDocumentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUsername() != "" {
+
+		if l := utf8.RuneCountInString(m.GetUsername()); l < 3 || l > 100 {
+			err := ListKYCDocumentsRequestValidationError{
+				field:  "Username",
+				reason: "value length must be between 3 and 100 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_ListKYCDocumentsRequest_Username_Pattern.MatchString(m.GetUsername()) {
+			err := ListKYCDocumentsRequestValidationError{
+				field:  "Username",
+				reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListKYCDocumentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKYCDocumentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListKYCDocumentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListKYCDocumentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKYCDocumentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKYCDocumentsRequestMultiError) AllErrors() []error { return m }
+
+// ListKYCDocumentsRequestValidationError is the validation error returned by
+// ListKYCDocumentsRequest.Validate if the designated constraints aren't met.
+type ListKYCDocumentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKYCDocumentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKYCDocumentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKYCDocumentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKYCDocumentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKYCDocumentsRequestValidationError) ErrorName() string {
+	return "ListKYCDocumentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKYCDocumentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKYCDocumentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKYCDocumentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKYCDocumentsRequestValidationError{}
+
+var _ListKYCDocumentsRequest_Username_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on ListKYCDocumentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListKYCDocumentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListKYCDocumentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListKYCDocumentsResponseMultiError, or nil if none found.
+func (m *ListKYCDocumentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListKYCDocumentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListKYCDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListKYCDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListKYCDocumentsResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for KycLevel
+
+	if len(errors) > 0 {
+		return ListKYCDocumentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListKYCDocumentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListKYCDocumentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListKYCDocumentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListKYCDocumentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListKYCDocumentsResponseMultiError) AllErrors() []error { return m }
+
+// ListKYCDocumentsResponseValidationError is the validation error returned by
+// ListKYCDocumentsResponse.Validate if the designated constraints aren't met.
+type ListKYCDocumentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListKYCDocumentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListKYCDocumentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListKYCDocumentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListKYCDocumentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListKYCDocumentsResponseValidationError) ErrorName() string {
+	return "ListKYCDocumentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListKYCDocumentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListKYCDocumentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListKYCDocumentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListKYCDocumentsResponseValidationError{}
+
+// Validate checks the field values on ListPendingKYCDocumentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingKYCDocumentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingKYCDocumentsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPendingKYCDocumentsRequestMultiError, or nil if none found.
+func (m *ListPendingKYCDocumentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingKYCDocumentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPageId() < 1 {
+		err := ListPendingKYCDocumentsRequestValidationError{
+			field:  "PageId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 5 || val > 50 {
+		err := ListPendingKYCDocumentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [5, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPendingKYCDocumentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingKYCDocumentsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListPendingKYCDocumentsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListPendingKYCDocumentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingKYCDocumentsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingKYCDocumentsRequestMultiError) AllErrors() []error { return m }
+
+// ListPendingKYCDocumentsRequestValidationError is the validation error
+// returned by ListPendingKYCDocumentsRequest.Validate if the designated
+// constraints aren't met.
+type ListPendingKYCDocumentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingKYCDocumentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingKYCDocumentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingKYCDocumentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingKYCDocumentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingKYCDocumentsRequestValidationError) ErrorName() string {
+	return "ListPendingKYCDocumentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingKYCDocumentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingKYCDocumentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingKYCDocumentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingKYCDocumentsRequestValidationError{}
+
+// Validate checks the field values on ListPendingKYCDocumentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPendingKYCDocumentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPendingKYCDocumentsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListPendingKYCDocumentsResponseMultiError, or nil if none found.
+func (m *ListPendingKYCDocumentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPendingKYCDocumentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDocuments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPendingKYCDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPendingKYCDocumentsResponseValidationError{
+						field:  fmt.Sprintf("Documents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPendingKYCDocumentsResponseValidationError{
+					field:  fmt.Sprintf("Documents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPendingKYCDocumentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPendingKYCDocumentsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPendingKYCDocumentsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListPendingKYCDocumentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPendingKYCDocumentsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPendingKYCDocumentsResponseMultiError) AllErrors() []error { return m }
+
+// ListPendingKYCDocumentsResponseValidationError is the validation error
+// returned by ListPendingKYCDocumentsResponse.Validate if the designated
+// constraints aren't met.
+type ListPendingKYCDocumentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPendingKYCDocumentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPendingKYCDocumentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPendingKYCDocumentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPendingKYCDocumentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPendingKYCDocumentsResponseValidationError) ErrorName() string {
+	return "ListPendingKYCDocumentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPendingKYCDocumentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPendingKYCDocumentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPendingKYCDocumentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPendingKYCDocumentsResponseValidationError{}
@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_list_transfers.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListTransfersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransfersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransfersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransfersRequestMultiError, or nil if none found.
+func (m *ListTransfersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransfersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := ListTransfersRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if val := m.GetPageSize(); val < 5 || val > 50 {
+		err := ListTransfersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [5, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTransfersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransfersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTransfersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransfersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransfersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransfersRequestMultiError) AllErrors() []error { return m }
+
+// ListTransfersRequestValidationError is the validation error returned by
+// ListTransfersRequest.Validate if the designated constraints aren't met.
+type ListTransfersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransfersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransfersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransfersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransfersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransfersRequestValidationError) ErrorName() string {
+	return "ListTransfersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransfersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransfersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransfersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransfersRequestValidationError{}
+
+// Validate checks the field values on ListTransfersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTransfersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTransfersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTransfersResponseMultiError, or nil if none found.
+func (m *ListTransfersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTransfersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTransfersResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTransfersResponseValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListTransfersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTransfersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTransfersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTransfersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTransfersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTransfersResponseMultiError) AllErrors() []error { return m }
+
+// ListTransfersResponseValidationError is the validation error returned by
+// ListTransfersResponse.Validate if the designated constraints aren't met.
+type ListTransfersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTransfersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTransfersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTransfersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTransfersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTransfersResponseValidationError) ErrorName() string {
+	return "ListTransfersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTransfersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTransfersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTransfersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTransfersResponseValidationError{}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_open_dispute.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on OpenDisputeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenDisputeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenDisputeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenDisputeRequestMultiError, or nil if none found.
+func (m *OpenDisputeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenDisputeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTransferId() <= 0 {
+		err := OpenDisputeRequestValidationError{
+			field:  "TransferId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 500 {
+		err := OpenDisputeRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OpenDisputeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenDisputeRequestMultiError is an error wrapping multiple validation errors
+// returned by OpenDisputeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OpenDisputeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenDisputeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenDisputeRequestMultiError) AllErrors() []error { return m }
+
+// OpenDisputeRequestValidationError is the validation error returned by
+// OpenDisputeRequest.Validate if the designated constraints aren't met.
+type OpenDisputeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenDisputeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenDisputeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenDisputeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenDisputeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenDisputeRequestValidationError) ErrorName() string {
+	return "OpenDisputeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenDisputeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenDisputeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenDisputeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenDisputeRequestValidationError{}
+
+// Validate checks the field values on OpenDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenDisputeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenDisputeResponseMultiError, or nil if none found.
+func (m *OpenDisputeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenDisputeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDispute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDispute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenDisputeResponseValidationError{
+				field:  "Dispute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenDisputeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenDisputeResponseMultiError is an error wrapping multiple validation
+// errors returned by OpenDisputeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OpenDisputeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenDisputeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenDisputeResponseMultiError) AllErrors() []error { return m }
+
+// OpenDisputeResponseValidationError is the validation error returned by
+// OpenDisputeResponse.Validate if the designated constraints aren't met.
+type OpenDisputeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenDisputeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenDisputeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenDisputeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenDisputeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenDisputeResponseValidationError) ErrorName() string {
+	return "OpenDisputeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenDisputeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenDisputeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenDisputeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenDisputeResponseValidationError{}
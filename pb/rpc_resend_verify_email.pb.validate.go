@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_resend_verify_email.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ResendVerifyEmailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendVerifyEmailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendVerifyEmailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendVerifyEmailRequestMultiError, or nil if none found.
+func (m *ResendVerifyEmailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendVerifyEmailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResendVerifyEmailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendVerifyEmailRequestMultiError is an error wrapping multiple validation
+// errors returned by ResendVerifyEmailRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResendVerifyEmailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendVerifyEmailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendVerifyEmailRequestMultiError) AllErrors() []error { return m }
+
+// ResendVerifyEmailRequestValidationError is the validation error returned by
+// ResendVerifyEmailRequest.Validate if the designated constraints aren't met.
+type ResendVerifyEmailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendVerifyEmailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendVerifyEmailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendVerifyEmailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendVerifyEmailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendVerifyEmailRequestValidationError) ErrorName() string {
+	return "ResendVerifyEmailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendVerifyEmailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendVerifyEmailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendVerifyEmailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendVerifyEmailRequestValidationError{}
+
+// Validate checks the field values on ResendVerifyEmailResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResendVerifyEmailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResendVerifyEmailResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResendVerifyEmailResponseMultiError, or nil if none found.
+func (m *ResendVerifyEmailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResendVerifyEmailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Email
+
+	if len(errors) > 0 {
+		return ResendVerifyEmailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResendVerifyEmailResponseMultiError is an error wrapping multiple validation
+// errors returned by ResendVerifyEmailResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ResendVerifyEmailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResendVerifyEmailResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResendVerifyEmailResponseMultiError) AllErrors() []error { return m }
+
+// ResendVerifyEmailResponseValidationError is the validation error returned by
+// ResendVerifyEmailResponse.Validate if the designated constraints aren't met.
+type ResendVerifyEmailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResendVerifyEmailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResendVerifyEmailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResendVerifyEmailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResendVerifyEmailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResendVerifyEmailResponseValidationError) ErrorName() string {
+	return "ResendVerifyEmailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResendVerifyEmailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResendVerifyEmailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResendVerifyEmailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResendVerifyEmailResponseValidationError{}
@@ -0,0 +1,322 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_resolve_dispute.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ResolveDisputeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveDisputeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveDisputeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveDisputeRequestMultiError, or nil if none found.
+func (m *ResolveDisputeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveDisputeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDisputeId() <= 0 {
+		err := ResolveDisputeRequestValidationError{
+			field:  "DisputeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Refund
+
+	if l := utf8.RuneCountInString(m.GetResolution()); l < 1 || l > 500 {
+		err := ResolveDisputeRequestValidationError{
+			field:  "Resolution",
+			reason: "value length must be between 1 and 500 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResolveDisputeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveDisputeRequestMultiError is an error wrapping multiple validation
+// errors returned by ResolveDisputeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveDisputeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveDisputeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveDisputeRequestMultiError) AllErrors() []error { return m }
+
+// ResolveDisputeRequestValidationError is the validation error returned by
+// ResolveDisputeRequest.Validate if the designated constraints aren't met.
+type ResolveDisputeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveDisputeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveDisputeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveDisputeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveDisputeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveDisputeRequestValidationError) ErrorName() string {
+	return "ResolveDisputeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveDisputeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveDisputeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveDisputeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveDisputeRequestValidationError{}
+
+// Validate checks the field values on ResolveDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResolveDisputeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResolveDisputeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResolveDisputeResponseMultiError, or nil if none found.
+func (m *ResolveDisputeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResolveDisputeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDispute()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveDisputeResponseValidationError{
+					field:  "Dispute",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDispute()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveDisputeResponseValidationError{
+				field:  "Dispute",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResolveDisputeResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResolveDisputeResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResolveDisputeResponseValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResolveDisputeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResolveDisputeResponseMultiError is an error wrapping multiple validation
+// errors returned by ResolveDisputeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResolveDisputeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResolveDisputeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResolveDisputeResponseMultiError) AllErrors() []error { return m }
+
+// ResolveDisputeResponseValidationError is the validation error returned by
+// ResolveDisputeResponse.Validate if the designated constraints aren't met.
+type ResolveDisputeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResolveDisputeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResolveDisputeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResolveDisputeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResolveDisputeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResolveDisputeResponseValidationError) ErrorName() string {
+	return "ResolveDisputeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResolveDisputeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResolveDisputeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResolveDisputeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResolveDisputeResponseValidationError{}
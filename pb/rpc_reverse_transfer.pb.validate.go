@@ -0,0 +1,320 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_reverse_transfer.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReverseTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReverseTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReverseTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReverseTransferRequestMultiError, or nil if none found.
+func (m *ReverseTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReverseTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTransferId() <= 0 {
+		err := ReverseTransferRequestValidationError{
+			field:  "TransferId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 200 {
+		err := ReverseTransferRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReverseTransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReverseTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by ReverseTransferRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReverseTransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReverseTransferRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReverseTransferRequestMultiError) AllErrors() []error { return m }
+
+// ReverseTransferRequestValidationError is the validation error returned by
+// ReverseTransferRequest.Validate if the designated constraints aren't met.
+type ReverseTransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReverseTransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReverseTransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReverseTransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReverseTransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReverseTransferRequestValidationError) ErrorName() string {
+	return "ReverseTransferRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReverseTransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReverseTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReverseTransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReverseTransferRequestValidationError{}
+
+// Validate checks the field values on ReverseTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReverseTransferResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReverseTransferResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReverseTransferResponseMultiError, or nil if none found.
+func (m *ReverseTransferResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReverseTransferResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOriginal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReverseTransferResponseValidationError{
+					field:  "Original",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReverseTransferResponseValidationError{
+					field:  "Original",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOriginal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReverseTransferResponseValidationError{
+				field:  "Original",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReversal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReverseTransferResponseValidationError{
+					field:  "Reversal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReverseTransferResponseValidationError{
+					field:  "Reversal",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReversal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReverseTransferResponseValidationError{
+				field:  "Reversal",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReverseTransferResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReverseTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by ReverseTransferResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReverseTransferResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReverseTransferResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReverseTransferResponseMultiError) AllErrors() []error { return m }
+
+// ReverseTransferResponseValidationError is the validation error returned by
+// ReverseTransferResponse.Validate if the designated constraints aren't met.
+type ReverseTransferResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReverseTransferResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReverseTransferResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReverseTransferResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReverseTransferResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReverseTransferResponseValidationError) ErrorName() string {
+	return "ReverseTransferResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReverseTransferResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReverseTransferResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReverseTransferResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReverseTransferResponseValidationError{}
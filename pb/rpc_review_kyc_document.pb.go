@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_review_kyc_document.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewKYCDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentId int64 `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	// false to reject the document
+	Approve bool `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	// required when the document is rejected
+	RejectionReason string `protobuf:"bytes,3,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason,omitempty"`
+}
+
+func (x *ReviewKYCDocumentRequest) Reset() {
+	*x = ReviewKYCDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_kyc_document_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewKYCDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKYCDocumentRequest) ProtoMessage() {}
+
+func (x *ReviewKYCDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_kyc_document_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKYCDocumentRequest.ProtoReflect.Descriptor instead.
+func (*ReviewKYCDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_review_kyc_document_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewKYCDocumentRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *ReviewKYCDocumentRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewKYCDocumentRequest) GetRejectionReason() string {
+	if x != nil {
+		return x.RejectionReason
+	}
+	return ""
+}
+
+type ReviewKYCDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document *KYCDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	// the KYC level of the document owner after the review
+	KycLevel int32 `protobuf:"varint,2,opt,name=kyc_level,json=kycLevel,proto3" json:"kyc_level,omitempty"`
+}
+
+func (x *ReviewKYCDocumentResponse) Reset() {
+	*x = ReviewKYCDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_kyc_document_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewKYCDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKYCDocumentResponse) ProtoMessage() {}
+
+func (x *ReviewKYCDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_kyc_document_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKYCDocumentResponse.ProtoReflect.Descriptor instead.
+func (*ReviewKYCDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_review_kyc_document_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewKYCDocumentResponse) GetDocument() *KYCDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *ReviewKYCDocumentResponse) GetKycLevel() int32 {
+	if x != nil {
+		return x.KycLevel
+	}
+	return 0
+}
+
+var File_rpc_review_kyc_document_proto protoreflect.FileDescriptor
+
+var file_rpc_review_kyc_document_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x79, 0x63,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x12, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x89, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x59, 0x43, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x19,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62,
+	0x2e, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x79, 0x63, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6b, 0x79, 0x63, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_review_kyc_document_proto_rawDescOnce sync.Once
+	file_rpc_review_kyc_document_proto_rawDescData = file_rpc_review_kyc_document_proto_rawDesc
+)
+
+func file_rpc_review_kyc_document_proto_rawDescGZIP() []byte {
+	file_rpc_review_kyc_document_proto_rawDescOnce.Do(func() {
+		file_rpc_review_kyc_document_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_review_kyc_document_proto_rawDescData)
+	})
+	return file_rpc_review_kyc_document_proto_rawDescData
+}
+
+var file_rpc_review_kyc_document_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_review_kyc_document_proto_goTypes = []interface{}{
+	(*ReviewKYCDocumentRequest)(nil),  // 0: pb.ReviewKYCDocumentRequest
+	(*ReviewKYCDocumentResponse)(nil), // 1: pb.ReviewKYCDocumentResponse
+	(*KYCDocument)(nil),               // 2: pb.KYCDocument
+}
+var file_rpc_review_kyc_document_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewKYCDocumentResponse.document:type_name -> pb.KYCDocument
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_review_kyc_document_proto_init() }
+func file_rpc_review_kyc_document_proto_init() {
+	if File_rpc_review_kyc_document_proto != nil {
+		return
+	}
+	file_kyc_document_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_review_kyc_document_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewKYCDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_review_kyc_document_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewKYCDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_review_kyc_document_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_review_kyc_document_proto_goTypes,
+		DependencyIndexes: file_rpc_review_kyc_document_proto_depIdxs,
+		MessageInfos:      file_rpc_review_kyc_document_proto_msgTypes,
+	}.Build()
+	File_rpc_review_kyc_document_proto = out.File
+	file_rpc_review_kyc_document_proto_rawDesc = nil
+	file_rpc_review_kyc_document_proto_goTypes = nil
+	file_rpc_review_kyc_document_proto_depIdxs = nil
+}
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_review_kyc_document.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReviewKYCDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewKYCDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewKYCDocumentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewKYCDocumentRequestMultiError, or nil if none found.
+func (m *ReviewKYCDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewKYCDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetDocumentId() <= 0 {
+		err := ReviewKYCDocumentRequestValidationError{
+			field:  "DocumentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Approve
+
+	// no validation rules for RejectionReason
+
+	if len(errors) > 0 {
+		return ReviewKYCDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewKYCDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by ReviewKYCDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReviewKYCDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewKYCDocumentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewKYCDocumentRequestMultiError) AllErrors() []error { return m }
+
+// ReviewKYCDocumentRequestValidationError is the validation error returned by
+// ReviewKYCDocumentRequest.Validate if the designated constraints aren't met.
+type ReviewKYCDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewKYCDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewKYCDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewKYCDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewKYCDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewKYCDocumentRequestValidationError) ErrorName() string {
+	return "ReviewKYCDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewKYCDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewKYCDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewKYCDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewKYCDocumentRequestValidationError{}
+
+// Validate checks the field values on ReviewKYCDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReviewKYCDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewKYCDocumentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewKYCDocumentResponseMultiError, or nil if none found.
+func (m *ReviewKYCDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewKYCDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewKYCDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewKYCDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewKYCDocumentResponseValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for KycLevel
+
+	if len(errors) > 0 {
+		return ReviewKYCDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewKYCDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by ReviewKYCDocumentResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReviewKYCDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewKYCDocumentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewKYCDocumentResponseMultiError) AllErrors() []error { return m }
+
+// ReviewKYCDocumentResponseValidationError is the validation error returned by
+// ReviewKYCDocumentResponse.Validate if the designated constraints aren't met.
+type ReviewKYCDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewKYCDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewKYCDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewKYCDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewKYCDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewKYCDocumentResponseValidationError) ErrorName() string {
+	return "ReviewKYCDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewKYCDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewKYCDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewKYCDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewKYCDocumentResponseValidationError{}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_revoke_mandate.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RevokeMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeMandateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeMandateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeMandateRequestMultiError, or nil if none found.
+func (m *RevokeMandateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeMandateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RevokeMandateRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeMandateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeMandateRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeMandateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeMandateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeMandateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeMandateRequestMultiError) AllErrors() []error { return m }
+
+// RevokeMandateRequestValidationError is the validation error returned by
+// RevokeMandateRequest.Validate if the designated constraints aren't met.
+type RevokeMandateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeMandateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeMandateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeMandateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeMandateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeMandateRequestValidationError) ErrorName() string {
+	return "RevokeMandateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeMandateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeMandateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeMandateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeMandateRequestValidationError{}
+
+// Validate checks the field values on RevokeMandateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeMandateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeMandateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeMandateResponseMultiError, or nil if none found.
+func (m *RevokeMandateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeMandateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMandate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevokeMandateResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevokeMandateResponseValidationError{
+					field:  "Mandate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMandate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevokeMandateResponseValidationError{
+				field:  "Mandate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RevokeMandateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeMandateResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeMandateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeMandateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeMandateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeMandateResponseMultiError) AllErrors() []error { return m }
+
+// RevokeMandateResponseValidationError is the validation error returned by
+// RevokeMandateResponse.Validate if the designated constraints aren't met.
+type RevokeMandateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeMandateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeMandateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeMandateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeMandateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeMandateResponseValidationError) ErrorName() string {
+	return "RevokeMandateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeMandateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeMandateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeMandateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeMandateResponseValidationError{}
@@ -0,0 +1,444 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_search_accounts.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SearchAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAccountsRequestMultiError, or nil if none found.
+func (m *SearchAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 2 || l > 100 {
+		err := SearchAccountsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 2 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPageId() < 1 {
+		err := SearchAccountsRequestValidationError{
+			field:  "PageId",
+			reason: "value must be greater than or equal to 1",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 5 || val > 50 {
+		err := SearchAccountsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [5, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SearchAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAccountsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchAccountsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAccountsRequestMultiError) AllErrors() []error { return m }
+
+// SearchAccountsRequestValidationError is the validation error returned by
+// SearchAccountsRequest.Validate if the designated constraints aren't met.
+type SearchAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAccountsRequestValidationError) ErrorName() string {
+	return "SearchAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAccountsRequestValidationError{}
+
+// Validate checks the field values on AccountSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AccountSearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AccountSearchResultMultiError, or nil if none found.
+func (m *AccountSearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountSearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountSearchResultValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountSearchResultValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountSearchResultValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OwnerFullName
+
+	// no validation rules for OwnerEmail
+
+	// no validation rules for Rank
+
+	if len(errors) > 0 {
+		return AccountSearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountSearchResultMultiError is an error wrapping multiple validation
+// errors returned by AccountSearchResult.ValidateAll() if the designated
+// constraints aren't met.
+type AccountSearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountSearchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountSearchResultMultiError) AllErrors() []error { return m }
+
+// AccountSearchResultValidationError is the validation error returned by
+// AccountSearchResult.Validate if the designated constraints aren't met.
+type AccountSearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountSearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountSearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountSearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountSearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountSearchResultValidationError) ErrorName() string {
+	return "AccountSearchResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AccountSearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountSearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountSearchResultValidationError{}
+
+// Validate checks the field values on SearchAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAccountsResponseMultiError, or nil if none found.
+func (m *SearchAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchAccountsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchAccountsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchAccountsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SearchAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAccountsResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchAccountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAccountsResponseMultiError) AllErrors() []error { return m }
+
+// SearchAccountsResponseValidationError is the validation error returned by
+// SearchAccountsResponse.Validate if the designated constraints aren't met.
+type SearchAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAccountsResponseValidationError) ErrorName() string {
+	return "SearchAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAccountsResponseValidationError{}
@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_seed_data.proto
+
+package pb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SeedDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users           int32  `protobuf:"varint,1,opt,name=users,proto3" json:"users,omitempty"`
+	AccountsPerUser int32  `protobuf:"varint,2,opt,name=accounts_per_user,json=accountsPerUser,proto3" json:"accounts_per_user,omitempty"`
+	Transfers       int32  `protobuf:"varint,3,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	Days            int32  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	Password        string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *SeedDataRequest) Reset() {
+	*x = SeedDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_seed_data_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedDataRequest) ProtoMessage() {}
+
+func (x *SeedDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_seed_data_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedDataRequest.ProtoReflect.Descriptor instead.
+func (*SeedDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_seed_data_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SeedDataRequest) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *SeedDataRequest) GetAccountsPerUser() int32 {
+	if x != nil {
+		return x.AccountsPerUser
+	}
+	return 0
+}
+
+func (x *SeedDataRequest) GetTransfers() int32 {
+	if x != nil {
+		return x.Transfers
+	}
+	return 0
+}
+
+func (x *SeedDataRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SeedDataRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type SeedDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users     int32 `protobuf:"varint,1,opt,name=users,proto3" json:"users,omitempty"`
+	Accounts  int32 `protobuf:"varint,2,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	Transfers int32 `protobuf:"varint,3,opt,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *SeedDataResponse) Reset() {
+	*x = SeedDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_seed_data_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeedDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedDataResponse) ProtoMessage() {}
+
+func (x *SeedDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_seed_data_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedDataResponse.ProtoReflect.Descriptor instead.
+func (*SeedDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_seed_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeedDataResponse) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *SeedDataResponse) GetAccounts() int32 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *SeedDataResponse) GetTransfers() int32 {
+	if x != nil {
+		return x.Transfers
+	}
+	return 0
+}
+
+var File_rpc_seed_data_proto protoreflect.FileDescriptor
+
+var file_rpc_seed_data_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xda, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x20,
+	0x00, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xfa, 0x42, 0x08, 0x1a, 0x06, 0x18, 0xa0, 0x8d, 0x06, 0x28, 0x00, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05, 0x18, 0xc2, 0x1c,
+	0x20, 0x00, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72,
+	0x04, 0x10, 0x06, 0x18, 0x64, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x62, 0x0a, 0x10, 0x53, 0x65, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x42, 0x1f, 0x5a, 0x1d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e,
+	0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_seed_data_proto_rawDescOnce sync.Once
+	file_rpc_seed_data_proto_rawDescData = file_rpc_seed_data_proto_rawDesc
+)
+
+func file_rpc_seed_data_proto_rawDescGZIP() []byte {
+	file_rpc_seed_data_proto_rawDescOnce.Do(func() {
+		file_rpc_seed_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_seed_data_proto_rawDescData)
+	})
+	return file_rpc_seed_data_proto_rawDescData
+}
+
+var file_rpc_seed_data_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_seed_data_proto_goTypes = []interface{}{
+	(*SeedDataRequest)(nil),  // 0: pb.SeedDataRequest
+	(*SeedDataResponse)(nil), // 1: pb.SeedDataResponse
+}
+var file_rpc_seed_data_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_seed_data_proto_init() }
+func file_rpc_seed_data_proto_init() {
+	if File_rpc_seed_data_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_seed_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_seed_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeedDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_seed_data_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_seed_data_proto_goTypes,
+		DependencyIndexes: file_rpc_seed_data_proto_depIdxs,
+		MessageInfos:      file_rpc_seed_data_proto_msgTypes,
+	}.Build()
+	File_rpc_seed_data_proto = out.File
+	file_rpc_seed_data_proto_rawDesc = nil
+	file_rpc_seed_data_proto_goTypes = nil
+	file_rpc_seed_data_proto_depIdxs = nil
+}
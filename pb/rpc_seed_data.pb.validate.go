@@ -0,0 +1,297 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_seed_data.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SeedDataRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SeedDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeedDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeedDataRequestMultiError, or nil if none found.
+func (m *SeedDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeedDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetUsers(); val <= 0 || val > 1000 {
+		err := SeedDataRequestValidationError{
+			field:  "Users",
+			reason: "value must be inside range (0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAccountsPerUser() <= 0 {
+		err := SeedDataRequestValidationError{
+			field:  "AccountsPerUser",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetTransfers(); val < 0 || val > 100000 {
+		err := SeedDataRequestValidationError{
+			field:  "Transfers",
+			reason: "value must be inside range [0, 100000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDays(); val <= 0 || val > 3650 {
+		err := SeedDataRequestValidationError{
+			field:  "Days",
+			reason: "value must be inside range (0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 6 || l > 100 {
+		err := SeedDataRequestValidationError{
+			field:  "Password",
+			reason: "value length must be between 6 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SeedDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeedDataRequestMultiError is an error wrapping multiple validation errors
+// returned by SeedDataRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SeedDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeedDataRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeedDataRequestMultiError) AllErrors() []error { return m }
+
+// SeedDataRequestValidationError is the validation error returned by
+// SeedDataRequest.Validate if the designated constraints aren't met.
+type SeedDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeedDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeedDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeedDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeedDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeedDataRequestValidationError) ErrorName() string { return "SeedDataRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeedDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeedDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeedDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeedDataRequestValidationError{}
+
+// Validate checks the field values on SeedDataResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SeedDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeedDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeedDataResponseMultiError, or nil if none found.
+func (m *SeedDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeedDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Users
+
+	// no validation rules for Accounts
+
+	// no validation rules for Transfers
+
+	if len(errors) > 0 {
+		return SeedDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeedDataResponseMultiError is an error wrapping multiple validation errors
+// returned by SeedDataResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SeedDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeedDataResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeedDataResponseMultiError) AllErrors() []error { return m }
+
+// SeedDataResponseValidationError is the validation error returned by
+// SeedDataResponse.Validate if the designated constraints aren't met.
+type SeedDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeedDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeedDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeedDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeedDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeedDataResponseValidationError) ErrorName() string { return "SeedDataResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeedDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeedDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeedDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeedDataResponseValidationError{}
@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_set_default_account.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SetDefaultAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDefaultAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDefaultAccountRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDefaultAccountRequestMultiError, or nil if none found.
+func (m *SetDefaultAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDefaultAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := SetDefaultAccountRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetDefaultAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDefaultAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by SetDefaultAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetDefaultAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDefaultAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDefaultAccountRequestMultiError) AllErrors() []error { return m }
+
+// SetDefaultAccountRequestValidationError is the validation error returned by
+// SetDefaultAccountRequest.Validate if the designated constraints aren't met.
+type SetDefaultAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDefaultAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDefaultAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDefaultAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDefaultAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDefaultAccountRequestValidationError) ErrorName() string {
+	return "SetDefaultAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDefaultAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDefaultAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDefaultAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDefaultAccountRequestValidationError{}
+
+// Validate checks the field values on SetDefaultAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDefaultAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDefaultAccountResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDefaultAccountResponseMultiError, or nil if none found.
+func (m *SetDefaultAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDefaultAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetDefaultAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetDefaultAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetDefaultAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetDefaultAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetDefaultAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by SetDefaultAccountResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetDefaultAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDefaultAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDefaultAccountResponseMultiError) AllErrors() []error { return m }
+
+// SetDefaultAccountResponseValidationError is the validation error returned by
+// SetDefaultAccountResponse.Validate if the designated constraints aren't met.
+type SetDefaultAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDefaultAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDefaultAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDefaultAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDefaultAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDefaultAccountResponseValidationError) ErrorName() string {
+	return "SetDefaultAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDefaultAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDefaultAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDefaultAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDefaultAccountResponseValidationError{}
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_start_export.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on StartExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartExportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartExportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartExportRequestMultiError, or nil if none found.
+func (m *StartExportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartExportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := StartExportRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Kind
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return StartExportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartExportRequestMultiError is an error wrapping multiple validation errors
+// returned by StartExportRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartExportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartExportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartExportRequestMultiError) AllErrors() []error { return m }
+
+// StartExportRequestValidationError is the validation error returned by
+// StartExportRequest.Validate if the designated constraints aren't met.
+type StartExportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartExportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartExportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartExportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartExportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartExportRequestValidationError) ErrorName() string {
+	return "StartExportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartExportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartExportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartExportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartExportRequestValidationError{}
+
+// Validate checks the field values on StartExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartExportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartExportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartExportResponseMultiError, or nil if none found.
+func (m *StartExportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartExportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExportJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartExportResponseValidationError{
+					field:  "ExportJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartExportResponseValidationError{
+					field:  "ExportJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExportJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartExportResponseValidationError{
+				field:  "ExportJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartExportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartExportResponseMultiError is an error wrapping multiple validation
+// errors returned by StartExportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartExportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartExportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartExportResponseMultiError) AllErrors() []error { return m }
+
+// StartExportResponseValidationError is the validation error returned by
+// StartExportResponse.Validate if the designated constraints aren't met.
+type StartExportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartExportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartExportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartExportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartExportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartExportResponseValidationError) ErrorName() string {
+	return "StartExportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartExportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartExportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartExportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartExportResponseValidationError{}
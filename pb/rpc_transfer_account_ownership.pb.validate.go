@@ -0,0 +1,321 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_transfer_account_ownership.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TransferAccountOwnershipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferAccountOwnershipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferAccountOwnershipRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TransferAccountOwnershipRequestMultiError, or nil if none found.
+func (m *TransferAccountOwnershipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferAccountOwnershipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := TransferAccountOwnershipRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewOwner()); l < 3 || l > 100 {
+		err := TransferAccountOwnershipRequestValidationError{
+			field:  "NewOwner",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TransferAccountOwnershipRequest_NewOwner_Pattern.MatchString(m.GetNewOwner()) {
+		err := TransferAccountOwnershipRequestValidationError{
+			field:  "NewOwner",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 200 {
+		err := TransferAccountOwnershipRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferAccountOwnershipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferAccountOwnershipRequestMultiError is an error wrapping multiple
+// validation errors returned by TransferAccountOwnershipRequest.ValidateAll()
+// if the designated constraints aren't met.
+type TransferAccountOwnershipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferAccountOwnershipRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferAccountOwnershipRequestMultiError) AllErrors() []error { return m }
+
+// TransferAccountOwnershipRequestValidationError is the validation error
+// returned by TransferAccountOwnershipRequest.Validate if the designated
+// constraints aren't met.
+type TransferAccountOwnershipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferAccountOwnershipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferAccountOwnershipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferAccountOwnershipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferAccountOwnershipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferAccountOwnershipRequestValidationError) ErrorName() string {
+	return "TransferAccountOwnershipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferAccountOwnershipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferAccountOwnershipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferAccountOwnershipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferAccountOwnershipRequestValidationError{}
+
+var _TransferAccountOwnershipRequest_NewOwner_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on TransferAccountOwnershipResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *TransferAccountOwnershipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferAccountOwnershipResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// TransferAccountOwnershipResponseMultiError, or nil if none found.
+func (m *TransferAccountOwnershipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferAccountOwnershipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferAccountOwnershipResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferAccountOwnershipResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferAccountOwnershipResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PreviousOwner
+
+	if len(errors) > 0 {
+		return TransferAccountOwnershipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferAccountOwnershipResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// TransferAccountOwnershipResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransferAccountOwnershipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferAccountOwnershipResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferAccountOwnershipResponseMultiError) AllErrors() []error { return m }
+
+// TransferAccountOwnershipResponseValidationError is the validation error
+// returned by TransferAccountOwnershipResponse.Validate if the designated
+// constraints aren't met.
+type TransferAccountOwnershipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferAccountOwnershipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferAccountOwnershipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferAccountOwnershipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferAccountOwnershipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferAccountOwnershipResponseValidationError) ErrorName() string {
+	return "TransferAccountOwnershipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferAccountOwnershipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferAccountOwnershipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferAccountOwnershipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferAccountOwnershipResponseValidationError{}
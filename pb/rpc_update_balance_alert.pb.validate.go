@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_update_balance_alert.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UpdateBalanceAlertRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBalanceAlertRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBalanceAlertRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBalanceAlertRequestMultiError, or nil if none found.
+func (m *UpdateBalanceAlertRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBalanceAlertRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateBalanceAlertRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetThreshold() <= 0 {
+		err := UpdateBalanceAlertRequestValidationError{
+			field:  "Threshold",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateBalanceAlertRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBalanceAlertRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBalanceAlertRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateBalanceAlertRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBalanceAlertRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBalanceAlertRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBalanceAlertRequestValidationError is the validation error returned by
+// UpdateBalanceAlertRequest.Validate if the designated constraints aren't met.
+type UpdateBalanceAlertRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBalanceAlertRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBalanceAlertRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBalanceAlertRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBalanceAlertRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBalanceAlertRequestValidationError) ErrorName() string {
+	return "UpdateBalanceAlertRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBalanceAlertRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBalanceAlertRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBalanceAlertRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBalanceAlertRequestValidationError{}
+
+// Validate checks the field values on UpdateBalanceAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBalanceAlertResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBalanceAlertResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBalanceAlertResponseMultiError, or nil if none found.
+func (m *UpdateBalanceAlertResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBalanceAlertResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBalanceAlert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBalanceAlertResponseValidationError{
+					field:  "BalanceAlert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBalanceAlertResponseValidationError{
+					field:  "BalanceAlert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBalanceAlert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBalanceAlertResponseValidationError{
+				field:  "BalanceAlert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBalanceAlertResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBalanceAlertResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateBalanceAlertResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateBalanceAlertResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBalanceAlertResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBalanceAlertResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBalanceAlertResponseValidationError is the validation error returned
+// by UpdateBalanceAlertResponse.Validate if the designated constraints aren't met.
+type UpdateBalanceAlertResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBalanceAlertResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBalanceAlertResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBalanceAlertResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBalanceAlertResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBalanceAlertResponseValidationError) ErrorName() string {
+	return "UpdateBalanceAlertResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBalanceAlertResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBalanceAlertResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBalanceAlertResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBalanceAlertResponseValidationError{}
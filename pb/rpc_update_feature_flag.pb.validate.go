@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_update_feature_flag.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UpdateFeatureFlagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFeatureFlagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFeatureFlagRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFeatureFlagRequestMultiError, or nil if none found.
+func (m *UpdateFeatureFlagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFeatureFlagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 3 || l > 100 {
+		err := UpdateFeatureFlagRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 3 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateFeatureFlagRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := UpdateFeatureFlagRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9_]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if val := m.GetRolloutPercentage(); val < 0 || val > 100 {
+		err := UpdateFeatureFlagRequestValidationError{
+			field:  "RolloutPercentage",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 500 {
+		err := UpdateFeatureFlagRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateFeatureFlagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFeatureFlagRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateFeatureFlagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateFeatureFlagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFeatureFlagRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFeatureFlagRequestMultiError) AllErrors() []error { return m }
+
+// UpdateFeatureFlagRequestValidationError is the validation error returned by
+// UpdateFeatureFlagRequest.Validate if the designated constraints aren't met.
+type UpdateFeatureFlagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFeatureFlagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFeatureFlagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFeatureFlagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFeatureFlagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFeatureFlagRequestValidationError) ErrorName() string {
+	return "UpdateFeatureFlagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFeatureFlagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFeatureFlagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFeatureFlagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFeatureFlagRequestValidationError{}
+
+var _UpdateFeatureFlagRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9_]+$")
+
+// Validate checks the field values on UpdateFeatureFlagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateFeatureFlagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateFeatureFlagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateFeatureFlagResponseMultiError, or nil if none found.
+func (m *UpdateFeatureFlagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateFeatureFlagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFlag()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateFeatureFlagResponseValidationError{
+					field:  "Flag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateFeatureFlagResponseValidationError{
+					field:  "Flag",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFlag()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateFeatureFlagResponseValidationError{
+				field:  "Flag",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateFeatureFlagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateFeatureFlagResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateFeatureFlagResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateFeatureFlagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateFeatureFlagResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateFeatureFlagResponseMultiError) AllErrors() []error { return m }
+
+// UpdateFeatureFlagResponseValidationError is the validation error returned by
+// UpdateFeatureFlagResponse.Validate if the designated constraints aren't met.
+type UpdateFeatureFlagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateFeatureFlagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateFeatureFlagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateFeatureFlagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateFeatureFlagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateFeatureFlagResponseValidationError) ErrorName() string {
+	return "UpdateFeatureFlagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateFeatureFlagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateFeatureFlagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateFeatureFlagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateFeatureFlagResponseValidationError{}
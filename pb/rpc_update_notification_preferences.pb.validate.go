@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_update_notification_preferences.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UpdateNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *UpdateNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.EmailEnabled != nil {
+		// no validation rules for EmailEnabled
+	}
+
+	if m.SmsEnabled != nil {
+		// no validation rules for SmsEnabled
+	}
+
+	if m.PushEnabled != nil {
+		// no validation rules for PushEnabled
+	}
+
+	if m.PhoneNumber != nil {
+
+		if m.GetPhoneNumber() != "" {
+
+			if !_UpdateNotificationPreferencesRequest_PhoneNumber_Pattern.MatchString(m.GetPhoneNumber()) {
+				err := UpdateNotificationPreferencesRequestValidationError{
+					field:  "PhoneNumber",
+					reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if m.DeviceToken != nil {
+
+		if m.GetDeviceToken() != "" {
+
+			if utf8.RuneCountInString(m.GetDeviceToken()) > 4096 {
+				err := UpdateNotificationPreferencesRequestValidationError{
+					field:  "DeviceToken",
+					reason: "value length must be at most 4096 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationPreferencesRequestValidationError is the validation error
+// returned by UpdateNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "UpdateNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationPreferencesRequestValidationError{}
+
+var _UpdateNotificationPreferencesRequest_PhoneNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on UpdateNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateNotificationPreferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateNotificationPreferencesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateNotificationPreferencesResponseMultiError, or nil if none found.
+func (m *UpdateNotificationPreferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateNotificationPreferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPreferences()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateNotificationPreferencesResponseValidationError{
+					field:  "Preferences",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPreferences()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateNotificationPreferencesResponseValidationError{
+				field:  "Preferences",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateNotificationPreferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateNotificationPreferencesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateNotificationPreferencesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateNotificationPreferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateNotificationPreferencesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateNotificationPreferencesResponseMultiError) AllErrors() []error { return m }
+
+// UpdateNotificationPreferencesResponseValidationError is the validation error
+// returned by UpdateNotificationPreferencesResponse.Validate if the
+// designated constraints aren't met.
+type UpdateNotificationPreferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateNotificationPreferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateNotificationPreferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateNotificationPreferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateNotificationPreferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateNotificationPreferencesResponseValidationError) ErrorName() string {
+	return "UpdateNotificationPreferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateNotificationPreferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateNotificationPreferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateNotificationPreferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateNotificationPreferencesResponseValidationError{}
@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_update_transfer_memo.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UpdateTransferMemoRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTransferMemoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTransferMemoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTransferMemoRequestMultiError, or nil if none found.
+func (m *UpdateTransferMemoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTransferMemoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateTransferMemoRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Memo != nil {
+
+		if utf8.RuneCountInString(m.GetMemo()) > 255 {
+			err := UpdateTransferMemoRequestValidationError{
+				field:  "Memo",
+				reason: "value length must be at most 255 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Category != nil {
+		// no validation rules for Category
+	}
+
+	if len(errors) > 0 {
+		return UpdateTransferMemoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTransferMemoRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTransferMemoRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateTransferMemoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTransferMemoRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTransferMemoRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTransferMemoRequestValidationError is the validation error returned by
+// UpdateTransferMemoRequest.Validate if the designated constraints aren't met.
+type UpdateTransferMemoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTransferMemoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTransferMemoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTransferMemoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTransferMemoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTransferMemoRequestValidationError) ErrorName() string {
+	return "UpdateTransferMemoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTransferMemoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTransferMemoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTransferMemoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTransferMemoRequestValidationError{}
+
+// Validate checks the field values on UpdateTransferMemoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTransferMemoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTransferMemoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTransferMemoResponseMultiError, or nil if none found.
+func (m *UpdateTransferMemoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTransferMemoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTransferMemoResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTransferMemoResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTransferMemoResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTransferMemoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTransferMemoResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateTransferMemoResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTransferMemoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTransferMemoResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTransferMemoResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTransferMemoResponseValidationError is the validation error returned
+// by UpdateTransferMemoResponse.Validate if the designated constraints aren't met.
+type UpdateTransferMemoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTransferMemoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTransferMemoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTransferMemoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTransferMemoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTransferMemoResponseValidationError) ErrorName() string {
+	return "UpdateTransferMemoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTransferMemoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTransferMemoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTransferMemoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTransferMemoResponseValidationError{}
@@ -0,0 +1,226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: rpc_upload_kyc_document.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UploadKYCDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocumentType string `protobuf:"bytes,1,opt,name=document_type,json=documentType,proto3" json:"document_type,omitempty"`
+	// JPEG, PNG or PDF file. On the HTTP gateway this is base64 encoded,
+	// or the file can be uploaded as multipart/form-data to /v1/upload_kyc_document/form
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UploadKYCDocumentRequest) Reset() {
+	*x = UploadKYCDocumentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_upload_kyc_document_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadKYCDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKYCDocumentRequest) ProtoMessage() {}
+
+func (x *UploadKYCDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_upload_kyc_document_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKYCDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadKYCDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_upload_kyc_document_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UploadKYCDocumentRequest) GetDocumentType() string {
+	if x != nil {
+		return x.DocumentType
+	}
+	return ""
+}
+
+func (x *UploadKYCDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type UploadKYCDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document *KYCDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+}
+
+func (x *UploadKYCDocumentResponse) Reset() {
+	*x = UploadKYCDocumentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_upload_kyc_document_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadKYCDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadKYCDocumentResponse) ProtoMessage() {}
+
+func (x *UploadKYCDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_upload_kyc_document_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadKYCDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadKYCDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_upload_kyc_document_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UploadKYCDocumentResponse) GetDocument() *KYCDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+var File_rpc_upload_kyc_document_proto protoreflect.FileDescriptor
+
+var file_rpc_upload_kyc_document_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6b, 0x79, 0x63,
+	0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x02, 0x70, 0x62, 0x1a, 0x12, 0x6b, 0x79, 0x63, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x48, 0x0a, 0x19, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x59, 0x43, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x59, 0x43, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x1f, 0x5a, 0x1d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68, 0x73,
+	0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_upload_kyc_document_proto_rawDescOnce sync.Once
+	file_rpc_upload_kyc_document_proto_rawDescData = file_rpc_upload_kyc_document_proto_rawDesc
+)
+
+func file_rpc_upload_kyc_document_proto_rawDescGZIP() []byte {
+	file_rpc_upload_kyc_document_proto_rawDescOnce.Do(func() {
+		file_rpc_upload_kyc_document_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_upload_kyc_document_proto_rawDescData)
+	})
+	return file_rpc_upload_kyc_document_proto_rawDescData
+}
+
+var file_rpc_upload_kyc_document_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_upload_kyc_document_proto_goTypes = []interface{}{
+	(*UploadKYCDocumentRequest)(nil),  // 0: pb.UploadKYCDocumentRequest
+	(*UploadKYCDocumentResponse)(nil), // 1: pb.UploadKYCDocumentResponse
+	(*KYCDocument)(nil),               // 2: pb.KYCDocument
+}
+var file_rpc_upload_kyc_document_proto_depIdxs = []int32{
+	2, // 0: pb.UploadKYCDocumentResponse.document:type_name -> pb.KYCDocument
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_upload_kyc_document_proto_init() }
+func file_rpc_upload_kyc_document_proto_init() {
+	if File_rpc_upload_kyc_document_proto != nil {
+		return
+	}
+	file_kyc_document_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_upload_kyc_document_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadKYCDocumentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_upload_kyc_document_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadKYCDocumentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_upload_kyc_document_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_upload_kyc_document_proto_goTypes,
+		DependencyIndexes: file_rpc_upload_kyc_document_proto_depIdxs,
+		MessageInfos:      file_rpc_upload_kyc_document_proto_msgTypes,
+	}.Build()
+	File_rpc_upload_kyc_document_proto = out.File
+	file_rpc_upload_kyc_document_proto_rawDesc = nil
+	file_rpc_upload_kyc_document_proto_goTypes = nil
+	file_rpc_upload_kyc_document_proto_depIdxs = nil
+}
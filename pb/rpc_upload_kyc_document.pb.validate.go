@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rpc_upload_kyc_document.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on UploadKYCDocumentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadKYCDocumentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKYCDocumentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKYCDocumentRequestMultiError, or nil if none found.
+func (m *UploadKYCDocumentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKYCDocumentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DocumentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UploadKYCDocumentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadKYCDocumentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadKYCDocumentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadKYCDocumentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKYCDocumentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKYCDocumentRequestMultiError) AllErrors() []error { return m }
+
+// UploadKYCDocumentRequestValidationError is the validation error returned by
+// UploadKYCDocumentRequest.Validate if the designated constraints aren't met.
+type UploadKYCDocumentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKYCDocumentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKYCDocumentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKYCDocumentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKYCDocumentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKYCDocumentRequestValidationError) ErrorName() string {
+	return "UploadKYCDocumentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKYCDocumentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKYCDocumentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKYCDocumentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKYCDocumentRequestValidationError{}
+
+// Validate checks the field values on UploadKYCDocumentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadKYCDocumentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadKYCDocumentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadKYCDocumentResponseMultiError, or nil if none found.
+func (m *UploadKYCDocumentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadKYCDocumentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDocument()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadKYCDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadKYCDocumentResponseValidationError{
+					field:  "Document",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDocument()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadKYCDocumentResponseValidationError{
+				field:  "Document",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadKYCDocumentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadKYCDocumentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadKYCDocumentResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UploadKYCDocumentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadKYCDocumentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadKYCDocumentResponseMultiError) AllErrors() []error { return m }
+
+// UploadKYCDocumentResponseValidationError is the validation error returned by
+// UploadKYCDocumentResponse.Validate if the designated constraints aren't met.
+type UploadKYCDocumentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadKYCDocumentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadKYCDocumentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadKYCDocumentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadKYCDocumentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadKYCDocumentResponseValidationError) ErrorName() string {
+	return "UploadKYCDocumentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadKYCDocumentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadKYCDocumentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadKYCDocumentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadKYCDocumentResponseValidationError{}
@@ -0,0 +1,354 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: transfer.proto
+
+package pb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Transfer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Transfer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Transfer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferMultiError, or nil
+// if none found.
+func (m *Transfer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Transfer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FromAccountId
+
+	// no validation rules for ToAccountId
+
+	// no validation rules for Amount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Category
+
+	// no validation rules for Memo
+
+	// no validation rules for Reference
+
+	// no validation rules for ReversalOf
+
+	// no validation rules for FeeOf
+
+	if len(errors) > 0 {
+		return TransferMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferMultiError is an error wrapping multiple validation errors returned
+// by Transfer.ValidateAll() if the designated constraints aren't met.
+type TransferMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferMultiError) AllErrors() []error { return m }
+
+// TransferValidationError is the validation error returned by
+// Transfer.Validate if the designated constraints aren't met.
+type TransferValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferValidationError) ErrorName() string { return "TransferValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransfer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferValidationError{}
+
+// Validate checks the field values on TransferQuote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TransferQuote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferQuote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TransferQuoteMultiError, or
+// nil if none found.
+func (m *TransferQuote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferQuote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FromCurrency
+
+	// no validation rules for ToCurrency
+
+	// no validation rules for FromAmount
+
+	// no validation rules for Fee
+
+	// no validation rules for ToAmount
+
+	// no validation rules for Rate
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferQuoteValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferQuoteValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferQuoteValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferQuoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferQuoteValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferQuoteValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransferQuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferQuoteMultiError is an error wrapping multiple validation errors
+// returned by TransferQuote.ValidateAll() if the designated constraints
+// aren't met.
+type TransferQuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferQuoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferQuoteMultiError) AllErrors() []error { return m }
+
+// TransferQuoteValidationError is the validation error returned by
+// TransferQuote.Validate if the designated constraints aren't met.
+type TransferQuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferQuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferQuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferQuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferQuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferQuoteValidationError) ErrorName() string { return "TransferQuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TransferQuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferQuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferQuoteValidationError{}
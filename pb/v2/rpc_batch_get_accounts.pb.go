@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: v2/rpc_batch_get_accounts.proto
+
+package pbv2
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchGetAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountIds []int64 `protobuf:"varint,1,rep,packed,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+}
+
+func (x *BatchGetAccountsRequest) Reset() {
+	*x = BatchGetAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsRequest) ProtoMessage() {}
+
+func (x *BatchGetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_batch_get_accounts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchGetAccountsRequest) GetAccountIds() []int64 {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+type BatchGetAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one result for each requested id, in the same order as the request
+	Results []*AccountResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchGetAccountsResponse) Reset() {
+	*x = BatchGetAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAccountsResponse) ProtoMessage() {}
+
+func (x *BatchGetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_batch_get_accounts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchGetAccountsResponse) GetResults() []*AccountResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AccountResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId int64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Types that are assignable to Result:
+	//	*AccountResult_Account
+	//	*AccountResult_Error
+	Result isAccountResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *AccountResult) Reset() {
+	*x = AccountResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountResult) ProtoMessage() {}
+
+func (x *AccountResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountResult.ProtoReflect.Descriptor instead.
+func (*AccountResult) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_batch_get_accounts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountResult) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (m *AccountResult) GetResult() isAccountResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AccountResult) GetAccount() *Account {
+	if x, ok := x.GetResult().(*AccountResult_Account); ok {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountResult) GetError() *BatchError {
+	if x, ok := x.GetResult().(*AccountResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isAccountResult_Result interface {
+	isAccountResult_Result()
+}
+
+type AccountResult_Account struct {
+	Account *Account `protobuf:"bytes,2,opt,name=account,proto3,oneof"`
+}
+
+type AccountResult_Error struct {
+	// NOT_FOUND or PERMISSION_DENIED
+	Error *BatchError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*AccountResult_Account) isAccountResult_Result() {}
+
+func (*AccountResult_Error) isAccountResult_Result() {}
+
+type BatchError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the gRPC status code name, e.g. NOT_FOUND
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BatchError) Reset() {
+	*x = BatchError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchError) ProtoMessage() {}
+
+func (x *BatchError) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_rpc_batch_get_accounts_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchError.ProtoReflect.Descriptor instead.
+func (*BatchError) Descriptor() ([]byte, []int) {
+	return file_v2_rpc_batch_get_accounts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_v2_rpc_batch_get_accounts_proto protoreflect.FileDescriptor
+
+var file_v2_rpc_batch_get_accounts_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x76, 0x32, 0x2f, 0x72, 0x70, 0x63, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x67,
+	0x65, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x05, 0x70, 0x62, 0x2e, 0x76, 0x32, 0x1a, 0x10, 0x76, 0x32, 0x2f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x42, 0x12, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0x32, 0x18,
+	0x01, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x73, 0x22, 0x4a, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x8f, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62,
+	0x2e, 0x76, 0x32, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x3a, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x27, 0x5a,
+	0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x63, 0x68,
+	0x73, 0x63, 0x68, 0x6f, 0x6f, 0x6c, 0x2f, 0x62, 0x61, 0x6e, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x76,
+	0x32, 0x3b, 0x70, 0x62, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v2_rpc_batch_get_accounts_proto_rawDescOnce sync.Once
+	file_v2_rpc_batch_get_accounts_proto_rawDescData = file_v2_rpc_batch_get_accounts_proto_rawDesc
+)
+
+func file_v2_rpc_batch_get_accounts_proto_rawDescGZIP() []byte {
+	file_v2_rpc_batch_get_accounts_proto_rawDescOnce.Do(func() {
+		file_v2_rpc_batch_get_accounts_proto_rawDescData = protoimpl.X.CompressGZIP(file_v2_rpc_batch_get_accounts_proto_rawDescData)
+	})
+	return file_v2_rpc_batch_get_accounts_proto_rawDescData
+}
+
+var file_v2_rpc_batch_get_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v2_rpc_batch_get_accounts_proto_goTypes = []interface{}{
+	(*BatchGetAccountsRequest)(nil),  // 0: pb.v2.BatchGetAccountsRequest
+	(*BatchGetAccountsResponse)(nil), // 1: pb.v2.BatchGetAccountsResponse
+	(*AccountResult)(nil),            // 2: pb.v2.AccountResult
+	(*BatchError)(nil),               // 3: pb.v2.BatchError
+	(*Account)(nil),                  // 4: pb.v2.Account
+}
+var file_v2_rpc_batch_get_accounts_proto_depIdxs = []int32{
+	2, // 0: pb.v2.BatchGetAccountsResponse.results:type_name -> pb.v2.AccountResult
+	4, // 1: pb.v2.AccountResult.account:type_name -> pb.v2.Account
+	3, // 2: pb.v2.AccountResult.error:type_name -> pb.v2.BatchError
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v2_rpc_batch_get_accounts_proto_init() }
+func file_v2_rpc_batch_get_accounts_proto_init() {
+	if File_v2_rpc_batch_get_accounts_proto != nil {
+		return
+	}
+	file_v2_account_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v2_rpc_batch_get_accounts_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_rpc_batch_get_accounts_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_rpc_batch_get_accounts_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_rpc_batch_get_accounts_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v2_rpc_batch_get_accounts_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AccountResult_Account)(nil),
+		(*AccountResult_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v2_rpc_batch_get_accounts_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v2_rpc_batch_get_accounts_proto_goTypes,
+		DependencyIndexes: file_v2_rpc_batch_get_accounts_proto_depIdxs,
+		MessageInfos:      file_v2_rpc_batch_get_accounts_proto_msgTypes,
+	}.Build()
+	File_v2_rpc_batch_get_accounts_proto = out.File
+	file_v2_rpc_batch_get_accounts_proto_rawDesc = nil
+	file_v2_rpc_batch_get_accounts_proto_goTypes = nil
+	file_v2_rpc_batch_get_accounts_proto_depIdxs = nil
+}
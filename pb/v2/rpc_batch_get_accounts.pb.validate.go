@@ -0,0 +1,608 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: v2/rpc_batch_get_accounts.proto
+
+package pbv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BatchGetAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetAccountsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetAccountsRequestMultiError, or nil if none found.
+func (m *BatchGetAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetAccountIds()); l < 1 || l > 50 {
+		err := BatchGetAccountsRequestValidationError{
+			field:  "AccountIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_BatchGetAccountsRequest_AccountIds_Unique := make(map[int64]struct{}, len(m.GetAccountIds()))
+
+	for idx, item := range m.GetAccountIds() {
+		_, _ = idx, item
+
+		if _, exists := _BatchGetAccountsRequest_AccountIds_Unique[item]; exists {
+			err := BatchGetAccountsRequestValidationError{
+				field:  fmt.Sprintf("AccountIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_BatchGetAccountsRequest_AccountIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := BatchGetAccountsRequestValidationError{
+				field:  fmt.Sprintf("AccountIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetAccountsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetAccountsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetAccountsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetAccountsRequestValidationError is the validation error returned by
+// BatchGetAccountsRequest.Validate if the designated constraints aren't met.
+type BatchGetAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetAccountsRequestValidationError) ErrorName() string {
+	return "BatchGetAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetAccountsRequestValidationError{}
+
+// Validate checks the field values on BatchGetAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetAccountsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetAccountsResponseMultiError, or nil if none found.
+func (m *BatchGetAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetAccountsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetAccountsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetAccountsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetAccountsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetAccountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetAccountsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetAccountsResponseValidationError is the validation error returned by
+// BatchGetAccountsResponse.Validate if the designated constraints aren't met.
+type BatchGetAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetAccountsResponseValidationError) ErrorName() string {
+	return "BatchGetAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetAccountsResponseValidationError{}
+
+// Validate checks the field values on AccountResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccountResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccountResultMultiError, or
+// nil if none found.
+func (m *AccountResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	switch v := m.Result.(type) {
+	case *AccountResult_Account:
+		if v == nil {
+			err := AccountResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAccount()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountResultValidationError{
+						field:  "Account",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountResultValidationError{
+						field:  "Account",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountResultValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AccountResult_Error:
+		if v == nil {
+			err := AccountResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AccountResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AccountResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AccountResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AccountResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountResultMultiError is an error wrapping multiple validation errors
+// returned by AccountResult.ValidateAll() if the designated constraints
+// aren't met.
+type AccountResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountResultMultiError) AllErrors() []error { return m }
+
+// AccountResultValidationError is the validation error returned by
+// AccountResult.Validate if the designated constraints aren't met.
+type AccountResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountResultValidationError) ErrorName() string { return "AccountResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountResultValidationError{}
+
+// Validate checks the field values on BatchError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchErrorMultiError, or
+// nil if none found.
+func (m *BatchError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchErrorMultiError is an error wrapping multiple validation errors
+// returned by BatchError.ValidateAll() if the designated constraints aren't met.
+type BatchErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchErrorMultiError) AllErrors() []error { return m }
+
+// BatchErrorValidationError is the validation error returned by
+// BatchError.Validate if the designated constraints aren't met.
+type BatchErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchErrorValidationError) ErrorName() string { return "BatchErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchErrorValidationError{}
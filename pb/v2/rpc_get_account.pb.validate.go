@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: v2/rpc_get_account.proto
+
+package pbv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on GetAccountRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountRequestMultiError, or nil if none found.
+func (m *GetAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAccountId() <= 0 {
+		err := GetAccountRequestValidationError{
+			field:  "AccountId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAccountRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountRequestValidationError is the validation error returned by
+// GetAccountRequest.Validate if the designated constraints aren't met.
+type GetAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountRequestValidationError) ErrorName() string {
+	return "GetAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountRequestValidationError{}
+
+// Validate checks the field values on GetAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountResponseMultiError, or nil if none found.
+func (m *GetAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAccountResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountResponseValidationError is the validation error returned by
+// GetAccountResponse.Validate if the designated constraints aren't met.
+type GetAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountResponseValidationError) ErrorName() string {
+	return "GetAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountResponseValidationError{}
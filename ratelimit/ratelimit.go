@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/techschool/bank/util"
+	"golang.org/x/time/rate"
+)
+
+/*
+Limiter 按 key（登录用户名或者客户端 IP）做令牌桶限流，gRPC 拦截器、HTTP 网关和 Gin 的中间件共用。
+每个 key 每秒补充 RATE_LIMIT_RPS 个令牌，最多攒 RATE_LIMIT_BURST 个。
+RATE_LIMIT_RPS 为 0 时不限流，Allow 总是放行；nil 的 Limiter 也总是放行。
+这两个配置可以热更新（见 util.ConfigWatcher），Update 会同时修改已经存在的令牌桶。
+
+限流状态只保存在当前进程的内存里，多个实例时每个实例分别计算。
+超过 idleTimeout 没有请求的 key 会被清理掉，内存不会随着 IP 的数量一直增长。
+*/
+
+const idleTimeout = 10 * time.Minute
+
+type Limiter struct {
+	mu          sync.Mutex
+	rps         rate.Limit
+	burst       int
+	visitors    map[string]*visitor
+	lastCleanup time.Time
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func NewLimiter(config util.Config) *Limiter {
+	limiter := &Limiter{
+		visitors:    map[string]*visitor{},
+		lastCleanup: time.Now(),
+	}
+	limiter.Update(config)
+	return limiter
+}
+
+// Update changes the rate and burst of the limiter, including the buckets of keys that were already seen
+func (limiter *Limiter) Update(config util.Config) {
+	burst := config.RateLimitBurst
+	if burst <= 0 {
+		burst = 1
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.rps = rate.Limit(config.RateLimitRPS)
+	limiter.burst = burst
+
+	now := time.Now()
+	for _, v := range limiter.visitors {
+		v.limiter.SetLimitAt(now, limiter.rps)
+		v.limiter.SetBurstAt(now, limiter.burst)
+	}
+}
+
+// Allow 消耗 key 的一个令牌，令牌用完时返回 false
+func (limiter *Limiter) Allow(key string) bool {
+	if limiter == nil {
+		return true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if limiter.rps <= 0 {
+		return true
+	}
+
+	now := time.Now()
+	if now.Sub(limiter.lastCleanup) > idleTimeout {
+		for k, v := range limiter.visitors {
+			if now.Sub(v.lastSeen) > idleTimeout {
+				delete(limiter.visitors, k)
+			}
+		}
+		limiter.lastCleanup = now
+	}
+
+	v, ok := limiter.visitors[key]
+	if !ok {
+		v = &visitor{limiter: rate.NewLimiter(limiter.rps, limiter.burst)}
+		limiter.visitors[key] = v
+	}
+	v.lastSeen = now
+
+	return v.limiter.AllowN(now, 1)
+}
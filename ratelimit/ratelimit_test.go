@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 2})
+	require.NotNil(t, limiter)
+
+	require.True(t, limiter.Allow("alice"))
+	require.True(t, limiter.Allow("alice"))
+	require.False(t, limiter.Allow("alice"))
+
+	// 每个 key 有自己的令牌桶
+	require.True(t, limiter.Allow("bob"))
+}
+
+func TestLimiterDisabled(t *testing.T) {
+	limiter := NewLimiter(util.Config{})
+	require.NotNil(t, limiter)
+
+	for i := 0; i < 100; i++ {
+		require.True(t, limiter.Allow("alice"))
+	}
+
+	var nilLimiter *Limiter
+	require.True(t, nilLimiter.Allow("alice"))
+}
+
+func TestLimiterUpdate(t *testing.T) {
+	limiter := NewLimiter(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 1})
+
+	require.True(t, limiter.Allow("alice"))
+	require.False(t, limiter.Allow("alice"))
+
+	// 关掉限流之后马上放行
+	limiter.Update(util.Config{})
+	require.True(t, limiter.Allow("alice"))
+
+	// 重新打开限流，已经存在的令牌桶也用新的 burst
+	limiter.Update(util.Config{RateLimitRPS: 0.001, RateLimitBurst: 3})
+	require.True(t, limiter.Allow("bob"))
+	require.True(t, limiter.Allow("bob"))
+	require.True(t, limiter.Allow("bob"))
+	require.False(t, limiter.Allow("bob"))
+}
@@ -0,0 +1,61 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+/*
+AWSProvider 从 AWS Secrets Manager 读取一个 JSON 格式的 secret，
+和部署脚本（.github/workflows/deploy.yml）里用的是同一个 secret，只是不再写到 app.env 里。
+endpoint 一般为空，测试或者用 LocalStack 的时候才需要设置。
+*/
+type AWSProvider struct {
+	region   string
+	endpoint string
+	secretID string
+}
+
+func NewAWSProvider(region string, endpoint string, secretID string) *AWSProvider {
+	return &AWSProvider{
+		region:   region,
+		endpoint: endpoint,
+		secretID: secretID,
+	}
+}
+
+func (provider *AWSProvider) Secrets(ctx context.Context) (map[string]string, error) {
+	var options []func(*awsconfig.LoadOptions) error
+	if provider.region != "" {
+		options = append(options, awsconfig.WithRegion(provider.region))
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	client := secretsmanager.NewFromConfig(awsConfig, func(o *secretsmanager.Options) {
+		if provider.endpoint != "" {
+			o.BaseEndpoint = aws.String(provider.endpoint)
+		}
+	})
+
+	output, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(provider.secretID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", provider.secretID, err)
+	}
+
+	values := map[string]string{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.SecretString)), &values); err != nil {
+		return nil, fmt.Errorf("secret %s is not a JSON object of strings: %w", provider.secretID, err)
+	}
+	return values, nil
+}
@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+/*
+secrets 包从外部的密钥管理服务读取敏感配置（数据库连接串、令牌密钥、邮箱密码等），
+这样生产环境的 app.env 里不需要保存这些值。util.LoadConfig 在启动的时候调用 Provider，
+返回的值会覆盖 app.env 和环境变量里同名的配置项。
+
+Provider 有三种实现，由 SECRETS_PROVIDER 配置选择：
+  - env（默认）：直接使用环境变量，和没有密钥管理服务时的行为一样，适合开发环境
+  - aws：AWS Secrets Manager 里的一个 JSON 格式的 secret（SECRETS_AWS_SECRET_ID），
+    每个 key 是配置项的名字，比如 {"DB_SOURCE": "...", "TOKEN_SYMMETRIC_KEY": "..."}，
+    凭证使用 AWS SDK 默认的查找顺序（环境变量、~/.aws、EKS 的 IRSA 等）
+  - vault：HashiCorp Vault KV v2 引擎里的一个 secret（VAULT_SECRET_PATH），格式和 aws 一样，用 VAULT_TOKEN 认证
+
+这个包不能依赖 util（util.LoadConfig 会调用它），所以用自己的 Config 结构传入设置。
+*/
+
+// Types of secrets providers supported by SECRETS_PROVIDER
+const (
+	ProviderEnv   = "env"
+	ProviderAWS   = "aws"
+	ProviderVault = "vault"
+)
+
+// Provider returns secret values keyed by config key, e.g. "DB_SOURCE"
+type Provider interface {
+	Secrets(ctx context.Context) (map[string]string, error)
+}
+
+// Config holds the settings needed to reach the secrets provider
+type Config struct {
+	Provider        string
+	AWSRegion       string
+	AWSEndpoint     string
+	AWSSecretID     string
+	VaultAddress    string
+	VaultToken      string
+	VaultSecretPath string
+	// EnvKeys are the environment variables returned by the env provider
+	EnvKeys []string
+}
+
+// NewProvider creates the secrets provider selected by the config
+func NewProvider(config Config) (Provider, error) {
+	switch config.Provider {
+	case "", ProviderEnv:
+		return NewEnvProvider(config.EnvKeys), nil
+	case ProviderAWS:
+		if config.AWSSecretID == "" {
+			return nil, fmt.Errorf("SECRETS_AWS_SECRET_ID is required for the aws secrets provider")
+		}
+		return NewAWSProvider(config.AWSRegion, config.AWSEndpoint, config.AWSSecretID), nil
+	case ProviderVault:
+		if config.VaultAddress == "" || config.VaultToken == "" || config.VaultSecretPath == "" {
+			return nil, fmt.Errorf("VAULT_ADDR, VAULT_TOKEN and VAULT_SECRET_PATH are required for the vault secrets provider")
+		}
+		return NewVaultProvider(config.VaultAddress, config.VaultToken, config.VaultSecretPath), nil
+	default:
+		return nil, fmt.Errorf("unsupported secrets provider %q", config.Provider)
+	}
+}
+
+// EnvProvider reads secrets from environment variables
+type EnvProvider struct {
+	keys []string
+}
+
+func NewEnvProvider(keys []string) *EnvProvider {
+	return &EnvProvider{keys: keys}
+}
+
+func (provider *EnvProvider) Secrets(ctx context.Context) (map[string]string, error) {
+	values := map[string]string{}
+	for _, key := range provider.keys {
+		if value, ok := os.LookupEnv(key); ok {
+			values[key] = value
+		}
+	}
+	return values, nil
+}
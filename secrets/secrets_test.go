@@ -0,0 +1,86 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	provider, err := NewProvider(Config{})
+	require.NoError(t, err)
+	require.IsType(t, &EnvProvider{}, provider)
+
+	_, err = NewProvider(Config{Provider: ProviderAWS})
+	require.ErrorContains(t, err, "SECRETS_AWS_SECRET_ID")
+
+	_, err = NewProvider(Config{Provider: ProviderVault, VaultAddress: "http://vault:8200"})
+	require.ErrorContains(t, err, "VAULT_TOKEN")
+
+	_, err = NewProvider(Config{Provider: "gcp"})
+	require.ErrorContains(t, err, "unsupported")
+}
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("DB_SOURCE", "postgresql://localhost/bank")
+
+	values, err := NewEnvProvider([]string{"DB_SOURCE", "SECRETS_TEST_UNSET"}).Secrets(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"DB_SOURCE": "postgresql://localhost/bank"}, values)
+}
+
+func TestVaultProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/secret/data/bank", r.URL.Path)
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"data":     map[string]string{"DB_SOURCE": "postgresql://vault/bank"},
+				"metadata": map[string]interface{}{"version": 3},
+			},
+		})
+	}))
+	defer server.Close()
+
+	values, err := NewVaultProvider(server.URL+"/", "root", "/secret/data/bank").Secrets(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "postgresql://vault/bank", values["DB_SOURCE"])
+
+	_, err = NewVaultProvider(server.URL, "wrong", "secret/data/bank").Secrets(context.Background())
+	require.ErrorContains(t, err, "status 403")
+}
+
+func TestAWSProvider(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secretsmanager.GetSecretValue", r.Header.Get("X-Amz-Target"))
+
+		var input struct {
+			SecretId string
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		require.Equal(t, "bank", input.SecretId)
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		json.NewEncoder(w).Encode(map[string]string{
+			"Name":         "bank",
+			"SecretString": `{"DB_SOURCE":"postgresql://aws/bank","TOKEN_SYMMETRIC_KEY":"12345678901234567890123456789012"}`,
+		})
+	}))
+	defer server.Close()
+
+	values, err := NewAWSProvider("us-east-1", server.URL, "bank").Secrets(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "postgresql://aws/bank", values["DB_SOURCE"])
+	require.Equal(t, "12345678901234567890123456789012", values["TOKEN_SYMMETRIC_KEY"])
+}
@@ -0,0 +1,70 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+/*
+VaultProvider 调用 Vault 的 HTTP API 读取 KV v2 引擎里的一个 secret，用 token 认证。
+secretPath 是完整的 API 路径（不包括 /v1/），KV v2 的路径中间要带 data，比如 "secret/data/bank"。
+返回的结构是 {"data": {"data": {...}, "metadata": {...}}}，只取里面的 data。
+*/
+
+const vaultRequestTimeout = 10 * time.Second
+
+type VaultProvider struct {
+	address    string
+	token      string
+	secretPath string
+	client     *http.Client
+}
+
+func NewVaultProvider(address string, token string, secretPath string) *VaultProvider {
+	return &VaultProvider{
+		address:    strings.TrimSuffix(address, "/"),
+		token:      token,
+		secretPath: strings.Trim(secretPath, "/"),
+		client:     &http.Client{Timeout: vaultRequestTimeout},
+	}
+}
+
+type vaultKVResponse struct {
+	Data struct {
+		Data map[string]string `json:"data"`
+	} `json:"data"`
+}
+
+func (provider *VaultProvider) Secrets(ctx context.Context) (map[string]string, error) {
+	url := fmt.Sprintf("%s/v1/%s", provider.address, provider.secretPath)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", provider.token)
+
+	rsp, err := provider.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call vault: %w", err)
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(io.LimitReader(rsp.Body, 1024))
+		return nil, fmt.Errorf("vault returned status %d: %s", rsp.StatusCode, data)
+	}
+
+	var secret vaultKVResponse
+	if err := json.NewDecoder(rsp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("failed to decode vault secret %s: %w", provider.secretPath, err)
+	}
+	if secret.Data.Data == nil {
+		return nil, fmt.Errorf("vault secret %s has no data", provider.secretPath)
+	}
+	return secret.Data.Data, nil
+}
@@ -0,0 +1,190 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/util"
+	"github.com/techschool/bank/val"
+)
+
+/*
+seed 给本地和 staging 环境生成演示数据：随机的用户、账户和过去一段时间里的转账，
+前端开发和压测不用再手动注册、转账。
+
+所有数据都通过 store 正常创建（TransferTx 会同时写 entries 和更新余额），
+最后再用 BackdateTransfer 把转账和对应的 entries 的时间改到过去，
+这样月度汇总、消费统计这些按时间统计的功能也有数据可以看。
+
+用户名都以 UsernamePrefix 开头，所有用户的密码都是 Options.Password，方便直接登录。
+*/
+
+// UsernamePrefix is the prefix of all seeded usernames, so seeded data is easy to find and clean up
+const UsernamePrefix = "seed_"
+
+var categories = []string{
+	util.CategoryGroceries,
+	util.CategoryRent,
+	util.CategoryUtilities,
+	util.CategoryTransport,
+	util.CategoryEntertainment,
+	util.CategorySalary,
+	util.CategoryOther,
+}
+
+// Options controls how much data is generated
+type Options struct {
+	Users           int
+	AccountsPerUser int
+	Transfers       int
+	// Days is how far back the transfers are spread
+	Days     int
+	Password string
+}
+
+// Result is the number of rows created
+type Result struct {
+	Users     int `json:"users"`
+	Accounts  int `json:"accounts"`
+	Transfers int `json:"transfers"`
+}
+
+// Validate checks the options before anything is written to the database
+func (opts Options) Validate() error {
+	if opts.Users <= 0 {
+		return fmt.Errorf("number of users must be positive")
+	}
+	if opts.AccountsPerUser <= 0 {
+		return fmt.Errorf("number of accounts per user must be positive")
+	}
+	// 每个用户每种货币只能有一个账户
+	if n := len(util.SupportedCurrencies()); opts.AccountsPerUser > n {
+		return fmt.Errorf("number of accounts per user must not exceed the %d supported currencies", n)
+	}
+	if opts.Transfers < 0 {
+		return fmt.Errorf("number of transfers must not be negative")
+	}
+	if opts.Days <= 0 {
+		return fmt.Errorf("number of days must be positive")
+	}
+	if err := val.ValidatePassword(opts.Password); err != nil {
+		return fmt.Errorf("password %w", err)
+	}
+	return nil
+}
+
+// Run creates the seed data
+func Run(ctx context.Context, store db.Store, opts Options) (Result, error) {
+	var result Result
+	if err := opts.Validate(); err != nil {
+		return result, err
+	}
+
+	// bcrypt 很慢，所有用户共用同一个哈希
+	hashedPassword, err := util.HashPassword(opts.Password)
+	if err != nil {
+		return result, err
+	}
+
+	currencies := util.SupportedCurrencies()
+	accountsByCurrency := make(map[string][]db.Account)
+	balances := make(map[int64]int64)
+
+	for i := 0; i < opts.Users; i++ {
+		username := UsernamePrefix + util.RandomString(8)
+		_, err := store.CreateUser(ctx, db.CreateUserParams{
+			Username:       username,
+			HashedPassword: hashedPassword,
+			FullName:       "Seed " + util.RandomOwner(),
+			Email:          username + "@email.com",
+			Locale:         i18n.DefaultLocale,
+		})
+		if err != nil {
+			return result, fmt.Errorf("cannot create user: %w", err)
+		}
+		result.Users++
+
+		for _, index := range rand.Perm(len(currencies))[:opts.AccountsPerUser] {
+			currency := currencies[index].Code
+			account, err := store.CreateAccount(ctx, db.CreateAccountParams{
+				Owner:    username,
+				Balance:  util.RandomInt(10000, 1000000),
+				Currency: currency,
+				Number:   util.RandomAccountNumber(),
+			})
+			if err != nil {
+				return result, fmt.Errorf("cannot create account: %w", err)
+			}
+			result.Accounts++
+
+			accountsByCurrency[currency] = append(accountsByCurrency[currency], account)
+			balances[account.ID] = account.Balance
+		}
+	}
+
+	// 只有至少两个账户的币种才能转账
+	var transferCurrencies []string
+	for currency, accounts := range accountsByCurrency {
+		if len(accounts) >= 2 {
+			transferCurrencies = append(transferCurrencies, currency)
+		}
+	}
+	if len(transferCurrencies) == 0 {
+		return result, nil
+	}
+
+	for _, createdAt := range transferTimes(time.Now(), opts.Days, opts.Transfers) {
+		accounts := accountsByCurrency[transferCurrencies[rand.Intn(len(transferCurrencies))]]
+		i, j := rand.Intn(len(accounts)), rand.Intn(len(accounts)-1)
+		if j >= i {
+			j++
+		}
+		from, to := accounts[i], accounts[j]
+		if balances[from.ID] < 10 {
+			continue
+		}
+
+		// 每次最多转出余额的十分之一，余额不会变成负数
+		txResult, err := store.TransferTx(ctx, db.TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        util.RandomInt(1, balances[from.ID]/10),
+			Category:      categories[rand.Intn(len(categories))],
+		})
+		if err != nil {
+			return result, fmt.Errorf("cannot create transfer: %w", err)
+		}
+		balances[from.ID] = txResult.FromAccount.Balance
+		balances[to.ID] = txResult.ToAccount.Balance
+
+		err = store.BackdateTransfer(ctx, db.BackdateTransferParams{
+			CreatedAt: createdAt,
+			ID:        txResult.Transfer.ID,
+		})
+		if err != nil {
+			return result, fmt.Errorf("cannot backdate transfer: %w", err)
+		}
+		result.Transfers++
+	}
+
+	return result, nil
+}
+
+// transferTimes returns n random times in the last days before now, in ascending order like real history
+func transferTimes(now time.Time, days int, n int) []time.Time {
+	span := time.Duration(days) * 24 * time.Hour
+
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = now.Add(-time.Duration(rand.Int63n(int64(span))))
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	return times
+}
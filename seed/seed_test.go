@@ -0,0 +1,93 @@
+package seed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+func TestRun(t *testing.T) {
+	original := util.SupportedCurrencies()
+	defer util.SetSupportedCurrencies(original)
+	util.SetSupportedCurrencies([]util.Currency{{Code: util.USD, MinorUnits: 2}})
+
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		CreateUser(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+			require.Regexp(t, "^"+UsernamePrefix+"[a-z]+$", arg.Username)
+			require.NoError(t, util.CheckPassword("secret", arg.HashedPassword))
+			return db.User{Username: arg.Username}, nil
+		})
+
+	var accountID int64
+	store.EXPECT().
+		CreateAccount(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(ctx context.Context, arg db.CreateAccountParams) (db.Account, error) {
+			require.Equal(t, util.USD, arg.Currency)
+			accountID++
+			return db.Account{ID: accountID, Owner: arg.Owner, Balance: arg.Balance, Currency: arg.Currency}, nil
+		})
+
+	store.EXPECT().
+		TransferTx(gomock.Any(), gomock.Any()).
+		Times(5).
+		DoAndReturn(func(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+			require.NotEqual(t, arg.FromAccountID, arg.ToAccountID)
+			require.Positive(t, arg.Amount)
+			require.True(t, util.IsSupportedCategory(arg.Category))
+			return db.TransferTxResult{
+				Transfer:    db.Transfer{ID: util.RandomInt(1, 1000)},
+				FromAccount: db.Account{ID: arg.FromAccountID, Balance: 10000},
+				ToAccount:   db.Account{ID: arg.ToAccountID, Balance: 10000},
+			}, nil
+		})
+
+	var lastCreatedAt time.Time
+	store.EXPECT().
+		BackdateTransfer(gomock.Any(), gomock.Any()).
+		Times(5).
+		DoAndReturn(func(ctx context.Context, arg db.BackdateTransferParams) error {
+			require.True(t, arg.CreatedAt.Before(time.Now()))
+			require.True(t, arg.CreatedAt.After(time.Now().Add(-7*24*time.Hour)))
+			require.False(t, arg.CreatedAt.Before(lastCreatedAt))
+			lastCreatedAt = arg.CreatedAt
+			return nil
+		})
+
+	result, err := Run(context.Background(), store, Options{
+		Users:           2,
+		AccountsPerUser: 1,
+		Transfers:       5,
+		Days:            7,
+		Password:        "secret",
+	})
+	require.NoError(t, err)
+	require.Equal(t, Result{Users: 2, Accounts: 2, Transfers: 5}, result)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	valid := Options{Users: 1, AccountsPerUser: 1, Transfers: 0, Days: 1, Password: "secret"}
+	require.NoError(t, valid.Validate())
+
+	invalid := []Options{
+		{Users: 0, AccountsPerUser: 1, Days: 1, Password: "secret"},
+		{Users: 1, AccountsPerUser: 100, Days: 1, Password: "secret"},
+		{Users: 1, AccountsPerUser: 1, Transfers: -1, Days: 1, Password: "secret"},
+		{Users: 1, AccountsPerUser: 1, Days: 0, Password: "secret"},
+		{Users: 1, AccountsPerUser: 1, Days: 1, Password: "123"},
+	}
+	for _, opts := range invalid {
+		require.Error(t, opts.Validate())
+	}
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = store.Put(ctx, "kyc/alice/1.png", strings.NewReader("content"), 7, "image/png")
+	require.NoError(t, err)
+
+	reader, err := store.Get(ctx, "kyc/alice/1.png")
+	require.NoError(t, err)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(data))
+
+	_, err = store.Get(ctx, "kyc/alice/2.png")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocalStoreInvalidKey(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../app.env", "/etc/passwd", ""} {
+		err = store.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain")
+		require.Error(t, err, key)
+	}
+}
+
+func TestLocalStoreDelete(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = store.Put(ctx, "statements/alice/2024-03.csv", strings.NewReader("a,b"), 3, "text/csv")
+	require.NoError(t, err)
+
+	require.NoError(t, store.Delete(ctx, "statements/alice/2024-03.csv"))
+	_, err = store.Get(ctx, "statements/alice/2024-03.csv")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// 删除不存在的文件不算错误
+	require.NoError(t, store.Delete(ctx, "statements/alice/2024-03.csv"))
+}
+
+func TestLocalStoreSignedURL(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = store.SignedURL(ctx, "kyc/alice/1.png", time.Minute)
+	require.ErrorIs(t, err, ErrSignedURLNotSupported)
+
+	require.Error(t, store.EnableSignedURLs("http://localhost:8080", "too short"))
+	require.NoError(t, store.EnableSignedURLs("http://localhost:8080/", util.RandomString(32)))
+
+	err = store.Put(ctx, "kyc/alice/1.png", strings.NewReader("content"), 7, "image/png")
+	require.NoError(t, err)
+
+	signedURL, err := store.SignedURL(ctx, "kyc/alice/1.png", time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(signedURL, "http://localhost:8080/blobs/kyc/alice/1.png?"))
+
+	mux := http.NewServeMux()
+	mux.Handle(LocalBlobPath, http.StripPrefix(LocalBlobPath, store.Handler()))
+
+	get := func(rawURL string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, rawURL, nil))
+		return recorder
+	}
+
+	recorder := get(signedURL)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "content", recorder.Body.String())
+	require.Equal(t, "image/png", recorder.Header().Get("Content-Type"))
+
+	// 签名只对这一个 key 有效
+	recorder = get(strings.Replace(signedURL, "1.png", "2.png", 1))
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+
+	expiredURL, err := store.SignedURL(ctx, "kyc/alice/1.png", -time.Minute)
+	require.NoError(t, err)
+	recorder = get(expiredURL)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+
+	parsed, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	recorder = get(parsed.Path)
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
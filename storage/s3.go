@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config contains the settings of an S3 (or S3-compatible, e.g. MinIO) bucket
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+	// ForcePathStyle uses http://endpoint/bucket/key instead of http://bucket.endpoint/key, which MinIO needs by default
+	ForcePathStyle bool
+}
+
+// S3Store saves blobs as objects in an S3 bucket
+type S3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Store(config S3Config) (*S3Store, error) {
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("S3 endpoint and bucket must not be empty")
+	}
+
+	bucketLookup := minio.BucketLookupAuto
+	if config.ForcePathStyle {
+		bucketLookup = minio.BucketLookupPath
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, ""),
+		Secure:       config.UseSSL,
+		Region:       config.Region,
+		BucketLookup: bucketLookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	return &S3Store{
+		client: client,
+		bucket: config.Bucket,
+	}, nil
+}
+
+func (store *S3Store) Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error {
+	_, err := store.client.PutObject(ctx, store.bucket, key, data, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	return nil
+}
+
+func (store *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := store.client.GetObject(ctx, store.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	// GetObject 不会发请求，要 Stat 一下才知道对象存不存在
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return object, nil
+}
+
+func (store *S3Store) Delete(ctx context.Context, key string) error {
+	// S3 删除不存在的对象也会返回成功
+	err := store.client.RemoveObject(ctx, store.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+func (store *S3Store) SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	url, err := store.client.PresignedGetObject(ctx, store.bucket, key, expiry, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object URL: %w", err)
+	}
+	return url.String(), nil
+}
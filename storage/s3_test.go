@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3StoreSignedURL(t *testing.T) {
+	// 设置了 region 时预签名不需要访问 S3
+	store, err := NewS3Store(S3Config{
+		Endpoint:        "localhost:9000",
+		Region:          "us-east-1",
+		Bucket:          "bank",
+		AccessKeyID:     "minioadmin",
+		SecretAccessKey: "minioadmin",
+		ForcePathStyle:  true,
+	})
+	require.NoError(t, err)
+
+	signedURL, err := store.SignedURL(context.Background(), "kyc/alice/1.png", time.Hour)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	require.Equal(t, "localhost:9000", parsed.Host)
+	require.Equal(t, "/bank/kyc/alice/1.png", parsed.Path)
+	require.Equal(t, "3600", parsed.Query().Get("X-Amz-Expires"))
+	require.NotEmpty(t, parsed.Query().Get("X-Amz-Signature"))
+}
+
+func TestNewS3StoreInvalidConfig(t *testing.T) {
+	_, err := NewS3Store(S3Config{Endpoint: "localhost:9000"})
+	require.Error(t, err)
+}
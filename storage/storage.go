@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+storage 包负责保存文件（KYC 证件、月度对账单、邮件附件等），数据库里只保存文件的 key。
+
+BlobStore 有两种实现，由 BLOB_STORE 配置选择：
+  - local：保存在本机的 BLOB_STORE_DIR 目录下，适合开发环境和单机部署
+  - s3：保存在 S3 或者兼容 S3 协议的对象存储（比如 MinIO，需要设置 S3_FORCE_PATH_STYLE）里，多个实例共享同一份文件
+
+SignedURL 返回一个有时效的下载链接，客户端可以直接下载文件，不用经过 gRPC 接口。
+S3 用预签名 URL；local 用 BLOB_STORE_SIGNING_KEY 做 HMAC 签名，由 HTTP gateway 上的 LocalStore.Handler 校验并返回文件。
+
+没有配置 BLOB_STORE 时 NewBlobStore 返回 nil，需要保存文件的功能会直接返回错误或者跳过。
+*/
+
+var (
+	// ErrNotFound is returned by Get when there is no blob with the given key
+	ErrNotFound = errors.New("blob not found")
+	// ErrSignedURLNotSupported is returned by SignedURL when the blob store isn't configured to sign URLs
+	ErrSignedURLNotSupported = errors.New("signed URLs are not configured for the blob store")
+)
+
+// Types of blob stores supported by BLOB_STORE
+const (
+	BlobStoreLocal = "local"
+	BlobStoreS3    = "s3"
+)
+
+// BlobStore stores and retrieves files by key
+type BlobStore interface {
+	Put(ctx context.Context, key string, data io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the blob, deleting a key that doesn't exist is not an error
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that can be used to download the blob until it expires
+	SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// NewBlobStore creates the blob store selected by the config, or nil if no blob store is configured
+func NewBlobStore(config util.Config) (BlobStore, error) {
+	switch config.BlobStore {
+	case "":
+		return nil, nil
+	case BlobStoreLocal:
+		store, err := NewLocalStore(config.BlobStoreDir)
+		if err != nil {
+			return nil, err
+		}
+		if config.BlobStorePublicURL != "" {
+			if err := store.EnableSignedURLs(config.BlobStorePublicURL, config.BlobStoreSigningKey); err != nil {
+				return nil, err
+			}
+		}
+		return store, nil
+	case BlobStoreS3:
+		return NewS3Store(S3Config{
+			Endpoint:        config.S3Endpoint,
+			Region:          config.S3Region,
+			Bucket:          config.S3Bucket,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+			UseSSL:          config.S3UseSSL,
+			ForcePathStyle:  config.S3ForcePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob store %q", config.BlobStore)
+	}
+}
@@ -1,11 +1,13 @@
 package token
 
 import (
-	"errors"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"fmt"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 const minSecretKeySize = 32
@@ -23,6 +25,9 @@ VerifyToken方法检查令牌的签名是否有效，并验证令牌是否已经
 
 Maker接口：Payload结构体：JWTMaker结构体
 Maker接口定义了操作JWT的标准方法集。
+JWTMaker 使用 golang-jwt/jwt/v5（dgrijalva/jwt-go 已经不再维护），
+除了 HS256 之外还支持 RS256 和 EdDSA：私钥签名，只有公钥的服务也能验证令牌。
+令牌的 claims 还是原来的 Payload JSON，所以旧版本签发的 HS256 令牌仍然可以验证。
 Payload结构体定义了JWT中要包含的数据和验证逻辑。
 JWTMaker结构体实现了Maker接口，提供了使用HMAC SHA-256算法签名JWT的具体逻辑。
 
@@ -32,54 +37,152 @@ JWTMaker结构体实现了Maker接口，提供了使用HMAC SHA-256算法签名J
 当需要验证一个JWT时，JWTMaker的VerifyToken会被调用，它解析JWT，
 验证签名，并检查Payload的有效性。
 */
+// Constants for the supported JWT signing algorithms
+const (
+	JWTAlgorithmHS256 = "HS256"
+	JWTAlgorithmRS256 = "RS256"
+	JWTAlgorithmEdDSA = "EdDSA"
+)
+
 // JWTMaker is a JSON Web Token maker
 type JWTMaker struct {
-	secretKey string
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
 }
 
-// NewJWTMaker creates a new JWTMaker
+// NewJWTMaker creates a new JWTMaker that signs tokens with HS256
 func NewJWTMaker(secretKey string) (Maker, error) {
 	if len(secretKey) < minSecretKeySize {
 		return nil, fmt.Errorf("invalid key size: must be at least %d characters", minSecretKeySize)
 	}
-	return &JWTMaker{secretKey}, nil
+
+	maker := &JWTMaker{
+		method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secretKey),
+		verifyKey: []byte(secretKey),
+	}
+	return maker, nil
+}
+
+// NewAsymmetricJWTMaker creates a new JWTMaker that signs tokens with RS256 or EdDSA.
+// privateKeyPEM 为空时这个 maker 只能验证令牌；publicKeyPEM 为空时从私钥推导出公钥。
+func NewAsymmetricJWTMaker(algorithm string, privateKeyPEM []byte, publicKeyPEM []byte) (Maker, error) {
+	maker := &JWTMaker{}
+
+	var err error
+	switch algorithm {
+	case JWTAlgorithmRS256:
+		maker.method = jwt.SigningMethodRS256
+		if len(privateKeyPEM) > 0 {
+			var privateKey *rsa.PrivateKey
+			privateKey, err = jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RSA private key: %w", err)
+			}
+			maker.signKey = privateKey
+			maker.verifyKey = &privateKey.PublicKey
+		}
+		if len(publicKeyPEM) > 0 {
+			maker.verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(publicKeyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("invalid RSA public key: %w", err)
+			}
+		}
+	case JWTAlgorithmEdDSA:
+		maker.method = jwt.SigningMethodEdDSA
+		if len(privateKeyPEM) > 0 {
+			var privateKey crypto.PrivateKey
+			privateKey, err = jwt.ParseEdPrivateKeyFromPEM(privateKeyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Ed25519 private key: %w", err)
+			}
+			maker.signKey = privateKey
+			maker.verifyKey = privateKey.(ed25519.PrivateKey).Public()
+		}
+		if len(publicKeyPEM) > 0 {
+			maker.verifyKey, err = jwt.ParseEdPublicKeyFromPEM(publicKeyPEM)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Ed25519 public key: %w", err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm: %s", algorithm)
+	}
+
+	if maker.verifyKey == nil {
+		return nil, fmt.Errorf("either a private key or a public key is required")
+	}
+
+	return maker, nil
+}
+
+// jwtClaims 让 Payload 满足 jwt.Claims 接口，JSON 格式和 Payload 完全一样
+type jwtClaims struct {
+	*Payload
+}
+
+func (claims jwtClaims) GetExpirationTime() (*jwt.NumericDate, error) {
+	return jwt.NewNumericDate(claims.ExpiredAt), nil
+}
+
+func (claims jwtClaims) GetIssuedAt() (*jwt.NumericDate, error) {
+	return jwt.NewNumericDate(claims.IssuedAt), nil
+}
+
+func (claims jwtClaims) GetNotBefore() (*jwt.NumericDate, error) {
+	return nil, nil
+}
+
+func (claims jwtClaims) GetIssuer() (string, error) {
+	return "", nil
+}
+
+func (claims jwtClaims) GetSubject() (string, error) {
+	return claims.Username, nil
+}
+
+func (claims jwtClaims) GetAudience() (jwt.ClaimStrings, error) {
+	return nil, nil
 }
 
 // CreateToken creates a new token for a specific username and duration
 func (maker *JWTMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	if maker.signKey == nil {
+		return "", nil, ErrVerifyOnly
+	}
+
 	payload, err := NewPayload(username, role, duration)
 	if err != nil {
 		return "", payload, err
 	}
 
-	jwtToken := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
-	token, err := jwtToken.SignedString([]byte(maker.secretKey))
+	jwtToken := jwt.NewWithClaims(maker.method, jwtClaims{payload})
+	token, err := jwtToken.SignedString(maker.signKey)
 	return token, payload, err
 }
 
 // VerifyToken checks if the token is valid or not
+// 只接受 maker 自己的签名算法，防止 alg=none 或者把 RSA 公钥当成 HMAC 密钥之类的攻击。
 func (maker *JWTMaker) VerifyToken(token string) (*Payload, error) {
 	keyFunc := func(token *jwt.Token) (interface{}, error) {
-		_, ok := token.Method.(*jwt.SigningMethodHMAC)
-		if !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(maker.secretKey), nil
+		return maker.verifyKey, nil
 	}
 
-	jwtToken, err := jwt.ParseWithClaims(token, &Payload{}, keyFunc)
+	claims := jwtClaims{&Payload{}}
+	_, err := jwt.ParseWithClaims(token, &claims, keyFunc,
+		jwt.WithValidMethods([]string{maker.method.Alg()}),
+		// 过期时间由 Payload.Valid 检查，这样返回的错误和 PasetoMaker 一样
+		jwt.WithoutClaimsValidation(),
+	)
 	if err != nil {
-		verr, ok := err.(*jwt.ValidationError)
-		if ok && errors.Is(verr.Inner, ErrExpiredToken) {
-			return nil, ErrExpiredToken
-		}
 		return nil, ErrInvalidToken
 	}
 
-	payload, ok := jwtToken.Claims.(*Payload)
-	if !ok {
-		return nil, ErrInvalidToken
+	err = claims.Payload.Valid()
+	if err != nil {
+		return nil, err
 	}
 
-	return payload, nil
+	return claims.Payload, nil
 }
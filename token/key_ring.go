@@ -0,0 +1,169 @@
+package token
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/techschool/bank/util"
+)
+
+/*
+KeyRingMaker 让签名/加密密钥可以在不停机的情况下轮换：
+  - 新令牌总是用当前的 active 密钥签发，并把密钥 ID（kid）写进令牌的 footer；
+  - 验证时先读 footer 里的 kid，找到对应的密钥来验证。footer 也在 MAC/签名的保护范围内，
+    所以改掉 kid 只会让验证失败；
+  - 轮换之前签发的令牌没有 kid，就依次用所有密钥尝试验证。
+
+轮换的步骤：把旧密钥加到 TOKEN_RETIRED_KEYS，换上新的 TOKEN_SYMMETRIC_KEY（或者 TOKEN_PRIVATE_KEY）
+和 TOKEN_KEY_ID，等旧密钥签发的令牌全部过期之后再把它从 TOKEN_RETIRED_KEYS 里删掉。
+*/
+type KeyRingMaker struct {
+	active Maker
+	keys   map[string]Maker
+	// makers 按照 active 在前的顺序保存所有密钥，用来验证没有 kid 的令牌
+	makers []Maker
+}
+
+type tokenFooter struct {
+	KeyID string `json:"kid"`
+}
+
+func newFooter(keyID string) []byte {
+	if keyID == "" {
+		return nil
+	}
+
+	footer, _ := json.Marshal(tokenFooter{KeyID: keyID})
+	return footer
+}
+
+// parseKeyID 读取令牌 footer 里的 kid，这时候还没有验证过 footer，只能用来挑选密钥
+func parseKeyID(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return ""
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[3])
+	if err != nil {
+		return ""
+	}
+
+	var footer tokenFooter
+	if err := json.Unmarshal(data, &footer); err != nil {
+		return ""
+	}
+	return footer.KeyID
+}
+
+// NewKeyRingMaker creates a maker that signs with the active key and verifies with all keys
+func NewKeyRingMaker(tokenType string, activeKeyID string, activeKey string, publicKey string, retiredKeys map[string]string) (Maker, error) {
+	if activeKeyID == "" {
+		return nil, fmt.Errorf("active key ID is required")
+	}
+
+	active, err := newKeyedMaker(tokenType, activeKeyID, activeKey, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid active key %s: %w", activeKeyID, err)
+	}
+
+	ring := &KeyRingMaker{
+		active: active,
+		keys:   map[string]Maker{activeKeyID: active},
+		makers: []Maker{active},
+	}
+
+	for keyID, key := range retiredKeys {
+		if _, ok := ring.keys[keyID]; ok {
+			return nil, fmt.Errorf("duplicate key ID: %s", keyID)
+		}
+
+		// 公钥模式下退役的密钥只需要公钥
+		var maker Maker
+		if tokenType == TypePasetoV4Public {
+			maker, err = newKeyedMaker(tokenType, keyID, "", key)
+		} else {
+			maker, err = newKeyedMaker(tokenType, keyID, key, "")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid retired key %s: %w", keyID, err)
+		}
+
+		ring.keys[keyID] = maker
+		ring.makers = append(ring.makers, maker)
+	}
+
+	return ring, nil
+}
+
+func newKeyedMaker(tokenType string, keyID string, key string, publicKey string) (Maker, error) {
+	switch tokenType {
+	case "", TypePasetoV2Local:
+		return newPasetoMaker(key, keyID)
+	case TypePasetoV4Local:
+		return newPasetoV4LocalMaker(key, keyID)
+	case TypePasetoV4Public:
+		return newPasetoV4PublicMaker(key, publicKey, keyID)
+	default:
+		return nil, fmt.Errorf("unsupported token type: %s", tokenType)
+	}
+}
+
+// CreateToken creates a new token with the active key
+func (ring *KeyRingMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	return ring.active.CreateToken(username, role, duration)
+}
+
+// VerifyToken checks the token with the key named in its footer, or with every key for legacy tokens
+func (ring *KeyRingMaker) VerifyToken(token string) (*Payload, error) {
+	if keyID := parseKeyID(token); keyID != "" {
+		maker, ok := ring.keys[keyID]
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return maker.VerifyToken(token)
+	}
+
+	for _, maker := range ring.makers {
+		payload, err := maker.VerifyToken(token)
+		if err != ErrInvalidToken {
+			return payload, err
+		}
+	}
+	return nil, ErrInvalidToken
+}
+
+// parseRetiredKeys 解析 TOKEN_RETIRED_KEYS，格式是 "kid1:key1,kid2:key2"
+func parseRetiredKeys(value string) (map[string]string, error) {
+	keys := map[string]string{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		keyID, key, ok := strings.Cut(item, ":")
+		if !ok || keyID == "" || key == "" {
+			return nil, fmt.Errorf("invalid retired key %q: must be in the format kid:key", item)
+		}
+		keys[keyID] = key
+	}
+	return keys, nil
+}
+
+func newKeyRingMakerFromConfig(config util.Config) (Maker, error) {
+	retiredKeys, err := parseRetiredKeys(config.TokenRetiredKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	activeKey := config.TokenSymmetricKey
+	if config.TokenType == TypePasetoV4Public {
+		activeKey = config.TokenPrivateKey
+	}
+
+	return NewKeyRingMaker(config.TokenType, config.TokenKeyID, activeKey, config.TokenPublicKey, retiredKeys)
+}
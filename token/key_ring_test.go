@@ -0,0 +1,126 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestKeyRingMakerRotation(t *testing.T) {
+	for _, tokenType := range []string{TypePasetoV2Local, TypePasetoV4Local} {
+		t.Run(tokenType, func(t *testing.T) {
+			oldKey := util.RandomString(32)
+			newKey := util.RandomString(32)
+			username := util.RandomOwner()
+
+			// 轮换之前：没有配置 kid 的 maker 签发的旧令牌
+			legacyMaker, err := NewMaker(util.Config{TokenType: tokenType, TokenSymmetricKey: oldKey})
+			require.NoError(t, err)
+			legacyToken, _, err := legacyMaker.CreateToken(username, util.DepositorRole, time.Minute)
+			require.NoError(t, err)
+
+			// 第一次轮换：给旧密钥加上 kid
+			oldRing, err := NewMaker(util.Config{TokenType: tokenType, TokenSymmetricKey: oldKey, TokenKeyID: "k1"})
+			require.NoError(t, err)
+			oldToken, _, err := oldRing.CreateToken(username, util.DepositorRole, time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, "k1", parseKeyID(oldToken))
+
+			// 第二次轮换：新密钥 k2 生效，k1 退役
+			ring, err := NewMaker(util.Config{
+				TokenType:         tokenType,
+				TokenSymmetricKey: newKey,
+				TokenKeyID:        "k2",
+				TokenRetiredKeys:  fmt.Sprintf("k1:%s", oldKey),
+			})
+			require.NoError(t, err)
+
+			newToken, _, err := ring.CreateToken(username, util.DepositorRole, time.Minute)
+			require.NoError(t, err)
+			require.Equal(t, "k2", parseKeyID(newToken))
+
+			for _, token := range []string{legacyToken, oldToken, newToken} {
+				payload, err := ring.VerifyToken(token)
+				require.NoError(t, err)
+				require.Equal(t, username, payload.Username)
+			}
+
+			// k1 彻底删除之后，它签发的令牌就失效了
+			finalRing, err := NewMaker(util.Config{TokenType: tokenType, TokenSymmetricKey: newKey, TokenKeyID: "k2"})
+			require.NoError(t, err)
+
+			_, err = finalRing.VerifyToken(oldToken)
+			require.EqualError(t, err, ErrInvalidToken.Error())
+			_, err = finalRing.VerifyToken(legacyToken)
+			require.EqualError(t, err, ErrInvalidToken.Error())
+			_, err = finalRing.VerifyToken(newToken)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestKeyRingMakerPublicKeys(t *testing.T) {
+	_, oldPrivateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, newPrivateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	oldRing, err := NewMaker(util.Config{
+		TokenType:       TypePasetoV4Public,
+		TokenPrivateKey: hex.EncodeToString(oldPrivateKey),
+		TokenKeyID:      "k1",
+	})
+	require.NoError(t, err)
+	oldToken, _, err := oldRing.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	// 退役的密钥只需要公钥
+	oldPublicKey := oldPrivateKey.Public().(ed25519.PublicKey)
+	ring, err := NewMaker(util.Config{
+		TokenType:        TypePasetoV4Public,
+		TokenPrivateKey:  hex.EncodeToString(newPrivateKey),
+		TokenKeyID:       "k2",
+		TokenRetiredKeys: "k1:" + hex.EncodeToString(oldPublicKey),
+	})
+	require.NoError(t, err)
+
+	_, err = ring.VerifyToken(oldToken)
+	require.NoError(t, err)
+}
+
+func TestKeyRingMakerUnknownKeyID(t *testing.T) {
+	ring, err := NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenKeyID: "k2"})
+	require.NoError(t, err)
+
+	otherRing, err := NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenKeyID: "k3"})
+	require.NoError(t, err)
+	token, _, err := otherRing.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	payload, err := ring.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestKeyRingMakerInvalidConfig(t *testing.T) {
+	// 没有 active kid
+	_, err := NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenRetiredKeys: "k1:" + util.RandomString(32)})
+	require.Error(t, err)
+
+	// 格式错误
+	_, err = NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenKeyID: "k2", TokenRetiredKeys: "k1"})
+	require.Error(t, err)
+
+	// kid 重复
+	_, err = NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenKeyID: "k1", TokenRetiredKeys: "k1:" + util.RandomString(32)})
+	require.Error(t, err)
+
+	// 退役密钥长度不对
+	_, err = NewMaker(util.Config{TokenSymmetricKey: util.RandomString(32), TokenKeyID: "k2", TokenRetiredKeys: "k1:short"})
+	require.Error(t, err)
+}
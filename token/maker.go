@@ -1,7 +1,11 @@
 package token
 
 import (
+	"fmt"
+	"os"
 	"time"
+
+	"github.com/techschool/bank/util"
 )
 
 //这个接口定义了两个主要的方法：CreateToken用于创建一个新的JWT，
@@ -16,3 +20,62 @@ type Maker interface {
 	// VerifyToken checks if the token is valid or not
 	VerifyToken(token string) (*Payload, error)
 }
+
+// Constants for the supported TOKEN_TYPE values
+const (
+	TypePasetoV2Local  = "paseto.v2.local"
+	TypePasetoV4Local  = "paseto.v4.local"
+	TypePasetoV4Public = "paseto.v4.public"
+	TypeJWTHS256       = "jwt.hs256"
+	TypeJWTRS256       = "jwt.rs256"
+	TypeJWTEdDSA       = "jwt.eddsa"
+)
+
+// NewMaker creates the token maker selected by TOKEN_TYPE.
+// 默认还是原来的 v2.local，这样已经签发的令牌不受影响；
+// paseto.v4.public 用 TOKEN_PRIVATE_KEY 签名，只配置 TOKEN_PUBLIC_KEY 的服务只能验证令牌。
+// 配置了 TOKEN_KEY_ID 时使用支持密钥轮换的 KeyRingMaker。
+func NewMaker(config util.Config) (Maker, error) {
+	if config.TokenKeyID != "" || config.TokenRetiredKeys != "" {
+		return newKeyRingMakerFromConfig(config)
+	}
+
+	switch config.TokenType {
+	case "", TypePasetoV2Local:
+		return NewPasetoMaker(config.TokenSymmetricKey)
+	case TypePasetoV4Local:
+		return NewPasetoV4LocalMaker(config.TokenSymmetricKey)
+	case TypePasetoV4Public:
+		return NewPasetoV4PublicMaker(config.TokenPrivateKey, config.TokenPublicKey)
+	case TypeJWTHS256:
+		return NewJWTMaker(config.TokenSymmetricKey)
+	case TypeJWTRS256:
+		return newAsymmetricJWTMakerFromFiles(JWTAlgorithmRS256, config.TokenPrivateKeyFile, config.TokenPublicKeyFile)
+	case TypeJWTEdDSA:
+		return newAsymmetricJWTMakerFromFiles(JWTAlgorithmEdDSA, config.TokenPrivateKeyFile, config.TokenPublicKeyFile)
+	default:
+		return nil, fmt.Errorf("unsupported token type: %s", config.TokenType)
+	}
+}
+
+// newAsymmetricJWTMakerFromFiles 从 PEM 文件读取 JWT 的密钥，文件路径为空表示没有这个密钥
+func newAsymmetricJWTMakerFromFiles(algorithm string, privateKeyFile string, publicKeyFile string) (Maker, error) {
+	var privateKeyPEM, publicKeyPEM []byte
+	var err error
+
+	if privateKeyFile != "" {
+		privateKeyPEM, err = os.ReadFile(privateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read private key file: %w", err)
+		}
+	}
+
+	if publicKeyFile != "" {
+		publicKeyPEM, err = os.ReadFile(publicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read public key file: %w", err)
+		}
+	}
+
+	return NewAsymmetricJWTMaker(algorithm, privateKeyPEM, publicKeyPEM)
+}
@@ -18,6 +18,7 @@ import (
 type PasetoMaker struct {
 	paseto       *paseto.V2
 	symmetricKey []byte
+	keyID        string
 }
 
 // NewPasetoMaker creates a new PasetoMaker
@@ -25,6 +26,11 @@ type PasetoMaker struct {
 // 它验证传入的对称密钥是否符合chacha20poly1305.KeySize的长度要求，
 // 这是使用Chacha20-Poly1305算法进行加密所需的密钥长度。
 func NewPasetoMaker(symmetricKey string) (Maker, error) {
+	return newPasetoMaker(symmetricKey, "")
+}
+
+// newPasetoMaker 创建的 maker 会把 keyID 写进令牌的 footer，见 key_ring.go
+func newPasetoMaker(symmetricKey string, keyID string) (*PasetoMaker, error) {
 	if len(symmetricKey) != chacha20poly1305.KeySize {
 		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", chacha20poly1305.KeySize)
 	}
@@ -32,6 +38,7 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	maker := &PasetoMaker{
 		paseto:       paseto.NewV2(),
 		symmetricKey: []byte(symmetricKey),
+		keyID:        keyID,
 	}
 
 	return maker, nil
@@ -47,7 +54,12 @@ func (maker *PasetoMaker) CreateToken(username string, role string, duration tim
 		return "", payload, err
 	}
 
-	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
+	var footer interface{}
+	if maker.keyID != "" {
+		footer = &tokenFooter{KeyID: maker.keyID}
+	}
+
+	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, footer)
 	return token, payload, err
 }
 
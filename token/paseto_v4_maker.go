@@ -0,0 +1,163 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrVerifyOnly is returned when a maker that only has a public key is asked to create a token
+var ErrVerifyOnly = errors.New("token maker can only verify tokens")
+
+// PasetoV4LocalMaker is a PASETO v4.local (symmetric key) token maker
+type PasetoV4LocalMaker struct {
+	symmetricKey []byte
+	keyID        string
+}
+
+// NewPasetoV4LocalMaker creates a new PasetoV4LocalMaker
+// 和 NewPasetoMaker 一样要求 32 个字符的对称密钥。
+func NewPasetoV4LocalMaker(symmetricKey string) (Maker, error) {
+	return newPasetoV4LocalMaker(symmetricKey, "")
+}
+
+func newPasetoV4LocalMaker(symmetricKey string, keyID string) (*PasetoV4LocalMaker, error) {
+	if len(symmetricKey) != 32 {
+		return nil, fmt.Errorf("invalid key size: must be exactly %d characters", 32)
+	}
+
+	maker := &PasetoV4LocalMaker{
+		symmetricKey: []byte(symmetricKey),
+		keyID:        keyID,
+	}
+	return maker, nil
+}
+
+// CreateToken creates a new token for a specific username and duration
+func (maker *PasetoV4LocalMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	token, err := v4LocalEncrypt(maker.symmetricKey, message, newFooter(maker.keyID))
+	return token, payload, err
+}
+
+// VerifyToken checks if the token is valid or not
+func (maker *PasetoV4LocalMaker) VerifyToken(token string) (*Payload, error) {
+	message, err := v4LocalDecrypt(maker.symmetricKey, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return parseV4Payload(message)
+}
+
+// PasetoV4PublicMaker is a PASETO v4.public (Ed25519) token maker.
+// 只有公钥的时候只能验证令牌，其他微服务就是这样使用的。
+type PasetoV4PublicMaker struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	keyID      string
+}
+
+// NewPasetoV4PublicMaker creates a new PasetoV4PublicMaker from hex encoded keys.
+// privateKeyHex 可以是 32 字节的 seed 或者 64 字节的完整私钥，为空时这个 maker 只能验证令牌；
+// publicKeyHex 为空时从私钥推导出公钥。
+func NewPasetoV4PublicMaker(privateKeyHex string, publicKeyHex string) (Maker, error) {
+	return newPasetoV4PublicMaker(privateKeyHex, publicKeyHex, "")
+}
+
+func newPasetoV4PublicMaker(privateKeyHex string, publicKeyHex string, keyID string) (*PasetoV4PublicMaker, error) {
+	maker := &PasetoV4PublicMaker{
+		keyID: keyID,
+	}
+
+	if privateKeyHex != "" {
+		privateKey, err := hex.DecodeString(privateKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid private key: %w", err)
+		}
+
+		switch len(privateKey) {
+		case ed25519.SeedSize:
+			maker.privateKey = ed25519.NewKeyFromSeed(privateKey)
+		case ed25519.PrivateKeySize:
+			maker.privateKey = ed25519.PrivateKey(privateKey)
+		default:
+			return nil, fmt.Errorf("invalid private key size: must be %d or %d bytes", ed25519.SeedSize, ed25519.PrivateKeySize)
+		}
+		maker.publicKey = maker.privateKey.Public().(ed25519.PublicKey)
+	}
+
+	if publicKeyHex != "" {
+		publicKey, err := hex.DecodeString(publicKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+		if len(publicKey) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key size: must be %d bytes", ed25519.PublicKeySize)
+		}
+		if maker.publicKey != nil && !maker.publicKey.Equal(ed25519.PublicKey(publicKey)) {
+			return nil, fmt.Errorf("public key doesn't match the private key")
+		}
+		maker.publicKey = publicKey
+	}
+
+	if maker.publicKey == nil {
+		return nil, fmt.Errorf("either a private key or a public key is required")
+	}
+
+	return maker, nil
+}
+
+// CreateToken creates a new token for a specific username and duration
+func (maker *PasetoV4PublicMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
+	if maker.privateKey == nil {
+		return "", nil, ErrVerifyOnly
+	}
+
+	payload, err := NewPayload(username, role, duration)
+	if err != nil {
+		return "", payload, err
+	}
+
+	message, err := json.Marshal(payload)
+	if err != nil {
+		return "", payload, err
+	}
+
+	return v4PublicSign(maker.privateKey, message, newFooter(maker.keyID)), payload, nil
+}
+
+// VerifyToken checks if the token is valid or not
+func (maker *PasetoV4PublicMaker) VerifyToken(token string) (*Payload, error) {
+	message, err := v4PublicVerify(maker.publicKey, token)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return parseV4Payload(message)
+}
+
+func parseV4Payload(message []byte) (*Payload, error) {
+	payload := &Payload{}
+	if err := json.Unmarshal(message, payload); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	if err := payload.Valid(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
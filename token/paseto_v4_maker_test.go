@@ -0,0 +1,160 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestPAE(t *testing.T) {
+	require.Equal(t, []byte("\x00\x00\x00\x00\x00\x00\x00\x00"), pae())
+	require.Equal(t, []byte("\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"), pae([]byte("")))
+	require.Equal(t, []byte("\x01\x00\x00\x00\x00\x00\x00\x00\x04\x00\x00\x00\x00\x00\x00\x00test"), pae([]byte("test")))
+}
+
+func TestPasetoV4LocalMaker(t *testing.T) {
+	maker, err := NewPasetoV4LocalMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	role := util.DepositorRole
+	duration := time.Minute
+
+	issuedAt := time.Now()
+	expiredAt := issuedAt.Add(duration)
+
+	token, payload, err := maker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, v4LocalHeader))
+	require.NotEmpty(t, payload)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.NotZero(t, payload.ID)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, role, payload.Role)
+	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
+	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
+
+	// 用别的密钥解不开
+	otherMaker, err := NewPasetoV4LocalMaker(util.RandomString(32))
+	require.NoError(t, err)
+	payload, err = otherMaker.VerifyToken(token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+
+	// v2 的令牌不能当成 v4 使用
+	v2Maker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+	v2Token, _, err := v2Maker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+	_, err = maker.VerifyToken(v2Token)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+}
+
+func TestExpiredPasetoV4LocalToken(t *testing.T) {
+	maker, err := NewPasetoV4LocalMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
+	require.NoError(t, err)
+
+	payload, err := maker.VerifyToken(token)
+	require.EqualError(t, err, ErrExpiredToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestTamperedPasetoV4LocalToken(t *testing.T) {
+	maker, err := NewPasetoV4LocalMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, _, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	// 改掉 nonce 里的一个字符
+	i := len(v4LocalHeader) + 10
+	replacement := byte('A')
+	if token[i] == replacement {
+		replacement = 'B'
+	}
+	tampered := token[:i] + string(replacement) + token[i+1:]
+
+	payload, err := maker.VerifyToken(tampered)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestPasetoV4PublicMaker(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	maker, err := NewPasetoV4PublicMaker(hex.EncodeToString(privateKey.Seed()), "")
+	require.NoError(t, err)
+
+	username := util.RandomOwner()
+	token, _, err := maker.CreateToken(username, util.BankerRole, time.Minute)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(token, v4PublicHeader))
+
+	// 其他服务只需要公钥就可以验证
+	verifier, err := NewPasetoV4PublicMaker("", hex.EncodeToString(publicKey))
+	require.NoError(t, err)
+
+	payload, err := verifier.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, username, payload.Username)
+	require.Equal(t, util.BankerRole, payload.Role)
+
+	_, _, err = verifier.CreateToken(username, util.BankerRole, time.Minute)
+	require.ErrorIs(t, err, ErrVerifyOnly)
+
+	// 别的密钥对签的令牌验证不通过
+	_, otherPrivateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherMaker, err := NewPasetoV4PublicMaker(hex.EncodeToString(otherPrivateKey), "")
+	require.NoError(t, err)
+	otherToken, _, err := otherMaker.CreateToken(username, util.AdminRole, time.Minute)
+	require.NoError(t, err)
+
+	payload, err = verifier.VerifyToken(otherToken)
+	require.EqualError(t, err, ErrInvalidToken.Error())
+	require.Nil(t, payload)
+}
+
+func TestNewPasetoV4PublicMakerInvalidKeys(t *testing.T) {
+	_, err := NewPasetoV4PublicMaker("", "")
+	require.Error(t, err)
+
+	_, err = NewPasetoV4PublicMaker("not-hex", "")
+	require.Error(t, err)
+
+	_, err = NewPasetoV4PublicMaker(hex.EncodeToString([]byte("short")), "")
+	require.Error(t, err)
+
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, err = NewPasetoV4PublicMaker(hex.EncodeToString(privateKey), hex.EncodeToString(publicKey))
+	require.Error(t, err)
+}
+
+func TestNewMaker(t *testing.T) {
+	symmetricKey := util.RandomString(32)
+
+	maker, err := NewMaker(util.Config{TokenSymmetricKey: symmetricKey})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoMaker{}, maker)
+
+	maker, err = NewMaker(util.Config{TokenType: TypePasetoV4Local, TokenSymmetricKey: symmetricKey})
+	require.NoError(t, err)
+	require.IsType(t, &PasetoV4LocalMaker{}, maker)
+
+	_, err = NewMaker(util.Config{TokenType: "jwt.none", TokenSymmetricKey: symmetricKey})
+	require.Error(t, err)
+}
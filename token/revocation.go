@@ -0,0 +1,180 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+/*
+令牌签发之后在过期之前一直有效，RevocationStore 用来提前让某个令牌失效（比如令牌泄露了）。
+每个被撤销的令牌 ID 在 Redis 里存一个 key，TTL 设成令牌剩下的有效时间，
+令牌过期之后 key 也会被 Redis 自动删除，所以撤销列表不会无限增长。
+
+访问令牌的 ID 没有保存在任何地方，管理员禁用用户或者强制重置密码时没办法一个一个撤销，
+所以另外用 RevokeUser 记下撤销的时间：这个用户在这之前签发的令牌都算被撤销了。
+TTL 设成访问令牌的有效期，过了这段时间之前签发的令牌都已经过期，记录也就不需要了。
+
+ErrRevokedToken 由验证令牌的地方（gapi 的 authorizeUser）返回。
+*/
+var ErrRevokedToken = errors.New("token has been revoked")
+
+type RevocationStore interface {
+	// Revoke marks the token as revoked until ttl passes
+	Revoke(ctx context.Context, tokenID uuid.UUID, ttl time.Duration) error
+
+	// IsRevoked checks if the token has been revoked
+	IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error)
+
+	// RevokeUser marks all tokens issued to the user before now as revoked until ttl passes
+	RevokeUser(ctx context.Context, username string, ttl time.Duration) error
+
+	// IsUserRevoked checks if the user's tokens issued at issuedAt have been revoked
+	IsUserRevoked(ctx context.Context, username string, issuedAt time.Time) (bool, error)
+}
+
+const (
+	revokedTokenKeyPrefix = "revoked_token:"
+	revokedUserKeyPrefix  = "revoked_user:"
+)
+
+// RedisRevocationStore keeps revoked token IDs in Redis
+type RedisRevocationStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisRevocationStore creates a new RedisRevocationStore
+func NewRedisRevocationStore(client redis.UniversalClient) RevocationStore {
+	return &RedisRevocationStore{
+		client: client,
+	}
+}
+
+func (store *RedisRevocationStore) Revoke(ctx context.Context, tokenID uuid.UUID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := store.client.Set(ctx, revokedTokenKeyPrefix+tokenID.String(), 1, ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+func (store *RedisRevocationStore) IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	n, err := store.client.Exists(ctx, revokedTokenKeyPrefix+tokenID.String()).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check revoked token: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (store *RedisRevocationStore) RevokeUser(ctx context.Context, username string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := store.client.Set(ctx, revokedUserKeyPrefix+username, time.Now().UnixNano(), ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+func (store *RedisRevocationStore) IsUserRevoked(ctx context.Context, username string, issuedAt time.Time) (bool, error) {
+	revokedAt, err := store.client.Get(ctx, revokedUserKeyPrefix+username).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check revoked user tokens: %w", err)
+	}
+	return issuedAt.Before(time.Unix(0, revokedAt)), nil
+}
+
+// InMemoryRevocationStore keeps revoked token IDs in memory.
+// 只在单个实例里有效，用于测试和本地开发。
+type InMemoryRevocationStore struct {
+	mutex        sync.Mutex
+	revoked      map[uuid.UUID]time.Time
+	revokedUsers map[string]revokedUser
+}
+
+type revokedUser struct {
+	revokedAt time.Time
+	expiredAt time.Time
+}
+
+// NewInMemoryRevocationStore creates a new InMemoryRevocationStore
+func NewInMemoryRevocationStore() RevocationStore {
+	return &InMemoryRevocationStore{
+		revoked:      make(map[uuid.UUID]time.Time),
+		revokedUsers: make(map[string]revokedUser),
+	}
+}
+
+func (store *InMemoryRevocationStore) Revoke(ctx context.Context, tokenID uuid.UUID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.revoked[tokenID] = time.Now().Add(ttl)
+	return nil
+}
+
+func (store *InMemoryRevocationStore) IsRevoked(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	expiredAt, ok := store.revoked[tokenID]
+	if !ok {
+		return false, nil
+	}
+
+	if time.Now().After(expiredAt) {
+		delete(store.revoked, tokenID)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (store *InMemoryRevocationStore) RevokeUser(ctx context.Context, username string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := time.Now()
+	store.revokedUsers[username] = revokedUser{
+		revokedAt: now,
+		expiredAt: now.Add(ttl),
+	}
+	return nil
+}
+
+func (store *InMemoryRevocationStore) IsUserRevoked(ctx context.Context, username string, issuedAt time.Time) (bool, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	revoked, ok := store.revokedUsers[username]
+	if !ok {
+		return false, nil
+	}
+
+	if time.Now().After(revoked.expiredAt) {
+		delete(store.revokedUsers, username)
+		return false, nil
+	}
+	return issuedAt.Before(revoked.revokedAt), nil
+}
@@ -0,0 +1,56 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryRevocationStore(t *testing.T) {
+	store := NewInMemoryRevocationStore()
+	ctx := context.Background()
+
+	tokenID := uuid.New()
+	revoked, err := store.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, store.Revoke(ctx, tokenID, time.Minute))
+	revoked, err = store.IsRevoked(ctx, tokenID)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// 已经过期的令牌不需要再记录
+	expiredID := uuid.New()
+	require.NoError(t, store.Revoke(ctx, expiredID, -time.Minute))
+	revoked, err = store.IsRevoked(ctx, expiredID)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
+
+func TestInMemoryRevocationStoreRevokeUser(t *testing.T) {
+	store := NewInMemoryRevocationStore()
+	ctx := context.Background()
+
+	issuedAt := time.Now()
+	revoked, err := store.IsUserRevoked(ctx, "alice", issuedAt)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, store.RevokeUser(ctx, "alice", time.Minute))
+	revoked, err = store.IsUserRevoked(ctx, "alice", issuedAt)
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// 撤销之后签发的令牌和其他用户的令牌不受影响
+	revoked, err = store.IsUserRevoked(ctx, "alice", time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	revoked, err = store.IsUserRevoked(ctx, "bob", issuedAt)
+	require.NoError(t, err)
+	require.False(t, revoked)
+}
@@ -0,0 +1,79 @@
+package util
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// AccountNumberPrefix is the country code position of every account number we issue ("SB" for Simple Bank)
+	AccountNumberPrefix = "SB"
+	// AccountNumberLength is the length of the account numbers we issue
+	AccountNumberLength = 20
+)
+
+/*
+账号的格式和 IBAN 一样：2 位字母前缀 + 2 位校验位 + 16 位随机数字，比如 SB97 1234 5678 9012 3456。
+校验位按 ISO 7064 MOD 97-10 计算：把前 4 位移到末尾，字母换成两位数字（A=10，B=11 ... Z=35），
+得到的整数除以 97 余 1 的账号才是有效的。这样输错一位或者相邻两位写反都能在查数据库之前发现。
+
+中间的数字用 crypto/rand 生成，不能从一个账号推出别的账号。账户的 ID 还是自增的，只在内部使用。
+已有账户的账号由迁移 000035 生成，算法和这里一样。
+*/
+
+// GenerateAccountNumber generates a random account number with valid check digits
+func GenerateAccountNumber() (string, error) {
+	digits := make([]byte, 0, AccountNumberLength-4)
+	for len(digits) < cap(digits) {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits = append(digits, byte('0'+n.Int64()))
+	}
+
+	return withCheckDigits(string(digits)), nil
+}
+
+// withCheckDigits 在 16 位数字前面加上前缀和校验位
+func withCheckDigits(digits string) string {
+	check := 98 - mod97(digits+AccountNumberPrefix+"00")
+	return fmt.Sprintf("%s%02d%s", AccountNumberPrefix, check, digits)
+}
+
+// NormalizeAccountNumber removes the spaces and uppercases the letters of an account number entered by a user
+func NormalizeAccountNumber(number string) string {
+	return strings.ToUpper(strings.ReplaceAll(number, " ", ""))
+}
+
+// IsValidAccountNumber returns true if the normalized account number has our format and valid check digits
+func IsValidAccountNumber(number string) bool {
+	if len(number) != AccountNumberLength || !strings.HasPrefix(number, AccountNumberPrefix) {
+		return false
+	}
+	for _, c := range number[len(AccountNumberPrefix):] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	// 和 IBAN 一样，校验位只能是 02 到 98，00 和 97、01 和 98 除以 97 的余数一样
+	if check := number[2:4]; check < "02" || check > "98" {
+		return false
+	}
+	return mod97(number[4:]+number[:4]) == 1
+}
+
+// mod97 把字母换成数字之后计算除以 97 的余数，number 只能包含数字和大写字母
+func mod97(number string) int {
+	remainder := 0
+	for _, c := range number {
+		if c >= 'A' && c <= 'Z' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+			continue
+		}
+		remainder = (remainder*10 + int(c-'0')) % 97
+	}
+	return remainder
+}
@@ -0,0 +1,37 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAccountNumber(t *testing.T) {
+	numbers := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		number, err := GenerateAccountNumber()
+		require.NoError(t, err)
+		require.Len(t, number, AccountNumberLength)
+		require.True(t, strings.HasPrefix(number, AccountNumberPrefix))
+		require.True(t, IsValidAccountNumber(number))
+		require.False(t, numbers[number])
+		numbers[number] = true
+	}
+}
+
+func TestIsValidAccountNumber(t *testing.T) {
+	require.True(t, IsValidAccountNumber("SB971234567890123456"))
+	// 改一位或者交换相邻两位之后校验位不对
+	require.False(t, IsValidAccountNumber("SB971234567890123457"))
+	require.False(t, IsValidAccountNumber("SB972134567890123456"))
+	require.False(t, IsValidAccountNumber("GB971234567890123456"))
+	require.False(t, IsValidAccountNumber("SB001234567890123456"))
+	require.False(t, IsValidAccountNumber("SB97123456789012345"))
+	require.False(t, IsValidAccountNumber("SB97123456789012345X"))
+	require.False(t, IsValidAccountNumber(""))
+}
+
+func TestNormalizeAccountNumber(t *testing.T) {
+	require.Equal(t, "SB971234567890123456", NormalizeAccountNumber("sb97 1234 5678 9012 3456"))
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Kinds of items in the activity feed of an account, they are also the values of the kind column in ListAccountActivity
+const (
+	ActivityTransfer          = "transfer"
+	ActivityDeposit           = "deposit"
+	ActivityCardAuthorization = "card_authorization"
+	ActivitySecurity          = "security"
+)
+
+// ActivityLogin is the security event recorded when the owner of the account logs in
+const ActivityLogin = "login"
+
+/*
+活动流合并了几张表的记录，按时间倒序排列，不同表的 ID 没法直接比较，
+所以游标里记录的是上一页最后一条的 (occurred_at, kind, item_id)，下一页取比它更早的记录。
+和 EncodePageToken 一样，token 对客户端是不透明的 base64 编码的 JSON。
+*/
+
+// ActivityCursor is the position of an item in the activity feed
+type ActivityCursor struct {
+	OccurredAt time.Time `json:"occurred_at"`
+	Kind       string    `json:"kind"`
+	ItemID     string    `json:"item_id"`
+}
+
+// EncodeActivityPageToken encodes the position of the last item of a page of the activity feed
+func EncodeActivityPageToken(cursor ActivityCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeActivityPageToken returns the position stored in the token, or nil for an empty token (the first page)
+func DecodeActivityPageToken(token string) (*ActivityCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+
+	var cursor ActivityCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.OccurredAt.IsZero() || cursor.Kind == "" || cursor.ItemID == "" {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &cursor, nil
+}
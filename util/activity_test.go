@@ -0,0 +1,34 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityPageToken(t *testing.T) {
+	cursor := ActivityCursor{
+		OccurredAt: time.Now().UTC().Truncate(time.Microsecond),
+		Kind:       ActivityTransfer,
+		ItemID:     "42",
+	}
+
+	token := EncodeActivityPageToken(cursor)
+	require.NotEmpty(t, token)
+
+	decoded, err := DecodeActivityPageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, cursor, *decoded)
+
+	decoded, err = DecodeActivityPageToken("")
+	require.NoError(t, err)
+	require.Nil(t, decoded)
+
+	_, err = DecodeActivityPageToken("not-a-valid-token!")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// ListTransfers 的 token 不能用在活动流上
+	_, err = DecodeActivityPageToken(EncodePageToken(42))
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
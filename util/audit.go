@@ -0,0 +1,26 @@
+package util
+
+// Actions recorded in the audit log
+const (
+	AuditAccountOwnershipTransferred = "account.ownership_transferred"
+	AuditTransferReversed            = "transfer.reversed"
+	AuditDisputeResolved             = "dispute.resolved"
+	AuditDuplicateEmailSignup        = "user.duplicate_email_signup"
+)
+
+// Types of resources recorded in the audit log
+const (
+	AuditResourceAccount  = "account"
+	AuditResourceTransfer = "transfer"
+	AuditResourceDispute  = "dispute"
+	AuditResourceUser     = "user"
+)
+
+// AuditActorAnonymous is the actor of actions done without signing in, such as signing up
+const AuditActorAnonymous = "anonymous"
+
+// Reasons of failed logins, counted by the admin dashboard
+const (
+	FailedLoginUserNotFound      = "user_not_found"
+	FailedLoginIncorrectPassword = "incorrect_password"
+)
@@ -0,0 +1,31 @@
+package util
+
+// Constants for the kind of balance alert an account owner can set
+const (
+	BalanceAlertLowBalance = "low_balance"
+	BalanceAlertLargeDebit = "large_debit"
+)
+
+// IsSupportedBalanceAlertKind returns true if the kind of balance alert is supported
+func IsSupportedBalanceAlertKind(kind string) bool {
+	switch kind {
+	case BalanceAlertLowBalance, BalanceAlertLargeDebit:
+		return true
+	}
+	return false
+}
+
+/*
+BalanceAlertTriggered 判断一笔扣款是否触发提醒，before 和 after 是扣款前后的余额：
+  - low_balance：余额从不低于 threshold 变成低于 threshold 时触发，已经低于 threshold 之后再扣款不会重复提醒
+  - large_debit：单笔扣款金额不低于 threshold 时触发
+*/
+func BalanceAlertTriggered(kind string, threshold int64, before int64, after int64) bool {
+	switch kind {
+	case BalanceAlertLowBalance:
+		return before >= threshold && after < threshold
+	case BalanceAlertLargeDebit:
+		return before-after >= threshold
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceAlertTriggered(t *testing.T) {
+	testCases := []struct {
+		name      string
+		kind      string
+		threshold int64
+		before    int64
+		after     int64
+		triggered bool
+	}{
+		{"LowBalanceCrossed", BalanceAlertLowBalance, 1000, 1500, 900, true},
+		{"LowBalanceFromExactThreshold", BalanceAlertLowBalance, 1000, 1000, 999, true},
+		{"LowBalanceStillAbove", BalanceAlertLowBalance, 1000, 1500, 1000, false},
+		{"LowBalanceAlreadyBelow", BalanceAlertLowBalance, 1000, 900, 800, false},
+		{"LargeDebit", BalanceAlertLargeDebit, 500, 1500, 1000, true},
+		{"SmallDebit", BalanceAlertLargeDebit, 500, 1500, 1001, false},
+		{"UnsupportedKind", "unknown", 500, 1500, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.triggered, BalanceAlertTriggered(tc.kind, tc.threshold, tc.before, tc.after))
+		})
+	}
+}
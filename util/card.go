@@ -0,0 +1,101 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// Constants for the status of a card
+const (
+	CardActive = "active"
+	CardFrozen = "frozen"
+)
+
+const (
+	// CardBIN is the issuer identification number at the start of every card number we issue
+	CardBIN = "400000"
+	// CardNumberLength is the length of the card numbers we issue
+	CardNumberLength = 16
+	// CardValidity is how long a newly issued card is valid
+	CardValidity = 3 * 365 * 24 * time.Hour
+)
+
+/*
+卡号（PAN）的最后一位是 Luhn 校验位：从右往左数，偶数位上的数字乘以 2（大于 9 时减 9），
+所有数字加起来必须是 10 的倍数。GeneratePAN 用 crypto/rand 随机生成中间的数字，然后算出校验位。
+
+完整的卡号只在发卡时返回一次，数据库里只保存 HashPAN 的结果（用于授权时查找卡）和最后 4 位（用于显示）。
+*/
+
+// GeneratePAN generates a random card number with the given prefix and a valid Luhn check digit
+func GeneratePAN(prefix string, length int) (string, error) {
+	if len(prefix) >= length {
+		return "", fmt.Errorf("prefix %q is too long for a %d-digit card number", prefix, length)
+	}
+
+	digits := []byte(prefix)
+	for len(digits) < length-1 {
+		n, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits = append(digits, byte('0'+n.Int64()))
+	}
+
+	digits = append(digits, '0')
+	digits[length-1] = byte('0' + (10-luhnSum(string(digits))%10)%10)
+	return string(digits), nil
+}
+
+// IsValidLuhn returns true if the number only contains digits and has a valid Luhn check digit
+func IsValidLuhn(number string) bool {
+	if len(number) < 2 {
+		return false
+	}
+	for _, c := range number {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return luhnSum(number)%10 == 0
+}
+
+func luhnSum(number string) int {
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum
+}
+
+// HashPAN returns the keyed hash of a card number, so the number can be looked up without being stored
+func HashPAN(key string, pan string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(pan))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// MaskPAN masks a card number given its last 4 digits, e.g. "**** **** **** 4242"
+func MaskPAN(last4 string) string {
+	return "**** **** **** " + last4
+}
+
+// IsCardExpired returns true if the card has expired; a card is valid until the end of its expiry month
+func IsCardExpired(expiryMonth int32, expiryYear int32, now time.Time) bool {
+	endOfMonth := time.Date(int(expiryYear), time.Month(expiryMonth)+1, 1, 0, 0, 0, 0, time.UTC)
+	return !now.Before(endOfMonth)
+}
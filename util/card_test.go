@@ -0,0 +1,46 @@
+package util
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePAN(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		pan, err := GeneratePAN(CardBIN, CardNumberLength)
+		require.NoError(t, err)
+		require.Len(t, pan, CardNumberLength)
+		require.True(t, strings.HasPrefix(pan, CardBIN))
+		require.True(t, IsValidLuhn(pan))
+	}
+
+	_, err := GeneratePAN("1234567890123456", CardNumberLength)
+	require.Error(t, err)
+}
+
+func TestIsValidLuhn(t *testing.T) {
+	require.True(t, IsValidLuhn("4242424242424242"))
+	require.True(t, IsValidLuhn("79927398713"))
+	require.False(t, IsValidLuhn("4242424242424241"))
+	require.False(t, IsValidLuhn("4242-4242"))
+	require.False(t, IsValidLuhn("4"))
+}
+
+func TestHashPAN(t *testing.T) {
+	hash := HashPAN("key", "4242424242424242")
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, HashPAN("key", "4242424242424242"))
+	require.NotEqual(t, hash, HashPAN("other", "4242424242424242"))
+	require.Equal(t, "**** **** **** 4242", MaskPAN("4242"))
+}
+
+func TestIsCardExpired(t *testing.T) {
+	now := time.Date(2024, time.March, 31, 23, 59, 0, 0, time.UTC)
+	require.False(t, IsCardExpired(3, 2024, now))
+	require.True(t, IsCardExpired(2, 2024, now))
+	require.True(t, IsCardExpired(3, 2024, now.Add(time.Minute)))
+	require.False(t, IsCardExpired(12, 2024, now))
+}
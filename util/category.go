@@ -0,0 +1,26 @@
+package util
+
+// Constants for all supported transfer categories
+const (
+	CategoryGroceries     = "groceries"
+	CategoryRent          = "rent"
+	CategoryUtilities     = "utilities"
+	CategoryTransport     = "transport"
+	CategoryEntertainment = "entertainment"
+	CategorySalary        = "salary"
+	CategoryOther         = "other"
+)
+
+// CategoryUncategorized is used in reports for transfers without a category
+const CategoryUncategorized = "uncategorized"
+
+// IsSupportedCategory returns true if the transfer category is supported.
+// An empty category means the transfer is uncategorized.
+func IsSupportedCategory(category string) bool {
+	switch category {
+	case "", CategoryGroceries, CategoryRent, CategoryUtilities, CategoryTransport,
+		CategoryEntertainment, CategorySalary, CategoryOther:
+		return true
+	}
+	return false
+}
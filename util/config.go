@@ -1,41 +1,361 @@
 package util
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
+	"github.com/techschool/bank/secrets"
 )
 
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
-}
-
-// LoadConfig reads configuration from file or environment variables.
+	Environment               string        `mapstructure:"ENVIRONMENT"`
+	LogPayloads               bool          `mapstructure:"LOG_PAYLOADS"`
+	LogRedactFields           string        `mapstructure:"LOG_REDACT_FIELDS"`
+	LogLevel                  string        `mapstructure:"LOG_LEVEL"`
+	LogFormat                 string        `mapstructure:"LOG_FORMAT"`
+	LogSampling               int           `mapstructure:"LOG_SAMPLING"`
+	LogCaller                 bool          `mapstructure:"LOG_CALLER"`
+	FaultInjection            string        `mapstructure:"FAULT_INJECTION"`
+	DBSource                  string        `mapstructure:"DB_SOURCE"`
+	DBReplicaSources          string        `mapstructure:"DB_REPLICA_SOURCES"`
+	DBStatementTimeout        time.Duration `mapstructure:"DB_STATEMENT_TIMEOUT"`
+	DBTxMaxAttempts           int           `mapstructure:"DB_TX_MAX_ATTEMPTS"`
+	DBSlowQueryThreshold      time.Duration `mapstructure:"DB_SLOW_QUERY_THRESHOLD"`
+	RequestTimeout            time.Duration `mapstructure:"REQUEST_TIMEOUT"`
+	RateLimitRPS              float64       `mapstructure:"RATE_LIMIT_RPS"`
+	RateLimitBurst            int           `mapstructure:"RATE_LIMIT_BURST"`
+	SupportedCurrencies       string        `mapstructure:"SUPPORTED_CURRENCIES"`
+	MigrationURL              string        `mapstructure:"MIGRATION_URL"`
+	HTTPServerAddress         string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	MetricsServerAddress      string        `mapstructure:"METRICS_SERVER_ADDRESS"`
+	GinServerAddress          string        `mapstructure:"GIN_SERVER_ADDRESS"`
+	RedisMode                 string        `mapstructure:"REDIS_MODE"`
+	RedisAddress              string        `mapstructure:"REDIS_ADDRESS"`
+	RedisPassword             string        `mapstructure:"REDIS_PASSWORD"`
+	RedisDB                   int           `mapstructure:"REDIS_DB"`
+	RedisSentinelMasterName   string        `mapstructure:"REDIS_SENTINEL_MASTER_NAME"`
+	RedisSentinelAddresses    string        `mapstructure:"REDIS_SENTINEL_ADDRESSES"`
+	RedisSentinelPassword     string        `mapstructure:"REDIS_SENTINEL_PASSWORD"`
+	RedisClusterAddresses     string        `mapstructure:"REDIS_CLUSTER_ADDRESSES"`
+	CacheEnabled              bool          `mapstructure:"CACHE_ENABLED"`
+	CacheTTL                  time.Duration `mapstructure:"CACHE_TTL"`
+	FeatureFlagCacheTTL       time.Duration `mapstructure:"FEATURE_FLAG_CACHE_TTL"`
+	HTTPCompressionMinSize    int           `mapstructure:"HTTP_COMPRESSION_MIN_SIZE"`
+	HTTPReadHeaderTimeout     time.Duration `mapstructure:"HTTP_READ_HEADER_TIMEOUT"`
+	HTTPReadTimeout           time.Duration `mapstructure:"HTTP_READ_TIMEOUT"`
+	HTTPWriteTimeout          time.Duration `mapstructure:"HTTP_WRITE_TIMEOUT"`
+	HTTPIdleTimeout           time.Duration `mapstructure:"HTTP_IDLE_TIMEOUT"`
+	HTTPMaxHeaderBytes        int           `mapstructure:"HTTP_MAX_HEADER_BYTES"`
+	HTTPMaxBodySize           int64         `mapstructure:"HTTP_MAX_BODY_SIZE"`
+	CORSAllowedOrigins        string        `mapstructure:"CORS_ALLOWED_ORIGINS"`
+	CORSAllowedMethods        string        `mapstructure:"CORS_ALLOWED_METHODS"`
+	CORSAllowedHeaders        string        `mapstructure:"CORS_ALLOWED_HEADERS"`
+	CORSAllowCredentials      bool          `mapstructure:"CORS_ALLOW_CREDENTIALS"`
+	CORSMaxAge                time.Duration `mapstructure:"CORS_MAX_AGE"`
+	SwaggerEnabled            bool          `mapstructure:"SWAGGER_ENABLED"`
+	GraphQLEnabled            bool          `mapstructure:"GRAPHQL_ENABLED"`
+	GRPCWebEnabled            bool          `mapstructure:"GRPC_WEB_ENABLED"`
+	GRPCWebWebsockets         bool          `mapstructure:"GRPC_WEB_WEBSOCKETS"`
+	GRPCWebPingInterval       time.Duration `mapstructure:"GRPC_WEB_PING_INTERVAL"`
+	GRPCServerAddress         string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	GRPCMaxRecvMsgSize        int           `mapstructure:"GRPC_MAX_RECV_MSG_SIZE"`
+	GRPCMaxSendMsgSize        int           `mapstructure:"GRPC_MAX_SEND_MSG_SIZE"`
+	GRPCMaxConcurrentStreams  int           `mapstructure:"GRPC_MAX_CONCURRENT_STREAMS"`
+	GRPCKeepaliveTime         time.Duration `mapstructure:"GRPC_KEEPALIVE_TIME"`
+	GRPCKeepaliveTimeout      time.Duration `mapstructure:"GRPC_KEEPALIVE_TIMEOUT"`
+	GRPCKeepaliveMinTime      time.Duration `mapstructure:"GRPC_KEEPALIVE_MIN_TIME"`
+	GRPCKeepaliveWithoutCalls bool          `mapstructure:"GRPC_KEEPALIVE_WITHOUT_CALLS"`
+	GRPCMaxConnectionIdle     time.Duration `mapstructure:"GRPC_MAX_CONNECTION_IDLE"`
+	GRPCMaxConnectionAge      time.Duration `mapstructure:"GRPC_MAX_CONNECTION_AGE"`
+	GRPCMaxConnectionAgeGrace time.Duration `mapstructure:"GRPC_MAX_CONNECTION_AGE_GRACE"`
+	TokenType                 string        `mapstructure:"TOKEN_TYPE"`
+	TokenSymmetricKey         string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	TokenPrivateKey           string        `mapstructure:"TOKEN_PRIVATE_KEY"`
+	TokenPublicKey            string        `mapstructure:"TOKEN_PUBLIC_KEY"`
+	TokenPrivateKeyFile       string        `mapstructure:"TOKEN_PRIVATE_KEY_FILE"`
+	TokenPublicKeyFile        string        `mapstructure:"TOKEN_PUBLIC_KEY_FILE"`
+	TokenKeyID                string        `mapstructure:"TOKEN_KEY_ID"`
+	TokenRetiredKeys          string        `mapstructure:"TOKEN_RETIRED_KEYS"`
+	LinkSigningKey            string        `mapstructure:"LINK_SIGNING_KEY"`
+	PasswordMinLength         int           `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordRequiredClasses   string        `mapstructure:"PASSWORD_REQUIRED_CLASSES"`
+	PasswordBreachListPath    string        `mapstructure:"PASSWORD_BREACH_LIST_PATH"`
+	PasswordHistorySize       int32         `mapstructure:"PASSWORD_HISTORY_SIZE"`
+	PasswordHashAlgorithm     string        `mapstructure:"PASSWORD_HASH_ALGORITHM"`
+	PasswordBcryptCost        int           `mapstructure:"PASSWORD_BCRYPT_COST"`
+	PasswordArgon2Time        int           `mapstructure:"PASSWORD_ARGON2_TIME"`
+	PasswordArgon2Memory      int           `mapstructure:"PASSWORD_ARGON2_MEMORY"`
+	PasswordArgon2Threads     int           `mapstructure:"PASSWORD_ARGON2_THREADS"`
+	EmailEnumProtection       bool          `mapstructure:"EMAIL_ENUMERATION_PROTECTION"`
+	AccessTokenDuration       time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration      time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	EmailSenderName           string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress        string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword       string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	EmailProvider             string        `mapstructure:"EMAIL_PROVIDER"`
+	SMTPHost                  string        `mapstructure:"SMTP_HOST"`
+	SMTPPort                  int           `mapstructure:"SMTP_PORT"`
+	SMTPUsername              string        `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword              string        `mapstructure:"SMTP_PASSWORD"`
+	SMTPTLSMode               string        `mapstructure:"SMTP_TLS_MODE"`
+	SendGridAPIKey            string        `mapstructure:"SENDGRID_API_KEY"`
+	SESRegion                 string        `mapstructure:"SES_REGION"`
+	TwilioAccountSID          string        `mapstructure:"TWILIO_ACCOUNT_SID"`
+	TwilioAuthToken           string        `mapstructure:"TWILIO_AUTH_TOKEN"`
+	TwilioFromNumber          string        `mapstructure:"TWILIO_FROM_NUMBER"`
+	FCMCredentialsFile        string        `mapstructure:"FCM_CREDENTIALS_FILE"`
+	UserDataRetention         time.Duration `mapstructure:"USER_DATA_RETENTION"`
+	AccountDeletionDelay      time.Duration `mapstructure:"ACCOUNT_DELETION_DELAY"`
+	VerifyEmailReminderAfter  time.Duration `mapstructure:"VERIFY_EMAIL_REMINDER_AFTER"`
+	VerifyEmailMaxReminders   int32         `mapstructure:"VERIFY_EMAIL_MAX_REMINDERS"`
+	UnverifiedDisableAfter    time.Duration `mapstructure:"UNVERIFIED_DISABLE_AFTER"`
+	PendingOperationTimeout   time.Duration `mapstructure:"PENDING_OPERATION_TIMEOUT"`
+	OpsAlertEmails            string        `mapstructure:"OPS_ALERT_EMAILS"`
+	ExternalClearingAccounts  string        `mapstructure:"EXTERNAL_CLEARING_ACCOUNTS"`
+	ExternalSettlementDelay   time.Duration `mapstructure:"EXTERNAL_SETTLEMENT_DELAY"`
+	ExternalWebhookURL        string        `mapstructure:"EXTERNAL_WEBHOOK_URL"`
+	ExternalWebhookSecret     string        `mapstructure:"EXTERNAL_WEBHOOK_SECRET"`
+	LoanFundingAccounts       string        `mapstructure:"LOAN_FUNDING_ACCOUNTS"`
+	LoanAnnualRateBps         int32         `mapstructure:"LOAN_ANNUAL_RATE_BPS"`
+	LoanGracePeriod           time.Duration `mapstructure:"LOAN_GRACE_PERIOD"`
+	FXRates                   string        `mapstructure:"FX_RATES"`
+	FXFeeBps                  int32         `mapstructure:"FX_FEE_BPS"`
+	FXQuoteTTL                time.Duration `mapstructure:"FX_QUOTE_TTL"`
+	FXAccounts                string        `mapstructure:"FX_ACCOUNTS"`
+	TransferFees              string        `mapstructure:"TRANSFER_FEES"`
+	WithdrawalFees            string        `mapstructure:"WITHDRAWAL_FEES"`
+	FeeAccounts               string        `mapstructure:"FEE_ACCOUNTS"`
+	ReferralBonusAmount       int64         `mapstructure:"REFERRAL_BONUS_AMOUNT"`
+	ReferralBonusCurrency     string        `mapstructure:"REFERRAL_BONUS_CURRENCY"`
+	ReferralFundingAccount    int64         `mapstructure:"REFERRAL_FUNDING_ACCOUNT"`
+	ReferralMaxPerReferrer    int64         `mapstructure:"REFERRAL_MAX_PER_REFERRER"`
+	ReferralMaxPerIP          int64         `mapstructure:"REFERRAL_MAX_PER_IP"`
+	BlobStore                 string        `mapstructure:"BLOB_STORE"`
+	BlobStoreDir              string        `mapstructure:"BLOB_STORE_DIR"`
+	BlobStorePublicURL        string        `mapstructure:"BLOB_STORE_PUBLIC_URL"`
+	BlobStoreSigningKey       string        `mapstructure:"BLOB_STORE_SIGNING_KEY"`
+	S3Endpoint                string        `mapstructure:"S3_ENDPOINT"`
+	S3Region                  string        `mapstructure:"S3_REGION"`
+	S3Bucket                  string        `mapstructure:"S3_BUCKET"`
+	S3AccessKeyID             string        `mapstructure:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey         string        `mapstructure:"S3_SECRET_ACCESS_KEY"`
+	S3UseSSL                  bool          `mapstructure:"S3_USE_SSL"`
+	S3ForcePathStyle          bool          `mapstructure:"S3_FORCE_PATH_STYLE"`
+	KYCMaxDocumentSize        int64         `mapstructure:"KYC_MAX_DOCUMENT_SIZE"`
+	AvatarMaxSize             int64         `mapstructure:"AVATAR_MAX_SIZE"`
+	KYCTransferLimits         string        `mapstructure:"KYC_TRANSFER_LIMITS"`
+	EventBus                  string        `mapstructure:"EVENT_BUS"`
+	KafkaBrokers              string        `mapstructure:"KAFKA_BROKERS"`
+	NATSURL                   string        `mapstructure:"NATS_URL"`
+	NATSStream                string        `mapstructure:"NATS_STREAM"`
+	EventTopicPrefix          string        `mapstructure:"EVENT_TOPIC_PREFIX"`
+	EventRelayInterval        time.Duration `mapstructure:"EVENT_RELAY_INTERVAL"`
+	EventRelayBatchSize       int32         `mapstructure:"EVENT_RELAY_BATCH_SIZE"`
+	EventRetention            time.Duration `mapstructure:"EVENT_RETENTION"`
+	WorkerConcurrency         int           `mapstructure:"WORKER_CONCURRENCY"`
+	WorkerCriticalQueueWeight int           `mapstructure:"WORKER_CRITICAL_QUEUE_WEIGHT"`
+	WorkerDefaultQueueWeight  int           `mapstructure:"WORKER_DEFAULT_QUEUE_WEIGHT"`
+	WorkerStrictPriority      bool          `mapstructure:"WORKER_STRICT_PRIORITY"`
+	WorkerShutdownTimeout     time.Duration `mapstructure:"WORKER_SHUTDOWN_TIMEOUT"`
+	WorkerLeaderTTL           time.Duration `mapstructure:"WORKER_LEADER_TTL"`
+	FrontendBaseURL           string        `mapstructure:"FRONTEND_BASE_URL"`
+	MobileDeepLinkURL         string        `mapstructure:"MOBILE_DEEP_LINK_URL"`
+	VerifyEmailPath           string        `mapstructure:"VERIFY_EMAIL_PATH"`
+	BlockSessionPath          string        `mapstructure:"BLOCK_SESSION_PATH"`
+	CancelDeletionPath        string        `mapstructure:"CANCEL_DELETION_PATH"`
+	GeoIPDBPath               string        `mapstructure:"GEOIP_DB_PATH"`
+	OAuthRedirectBaseURL      string        `mapstructure:"OAUTH_REDIRECT_BASE_URL"`
+	OAuthStateKey             string        `mapstructure:"OAUTH_STATE_KEY"`
+	CardPANKey                string        `mapstructure:"CARD_PAN_KEY"`
+	OAuthGoogleClientID       string        `mapstructure:"OAUTH_GOOGLE_CLIENT_ID"`
+	OAuthGoogleClientSecret   string        `mapstructure:"OAUTH_GOOGLE_CLIENT_SECRET"`
+	OAuthGitHubClientID       string        `mapstructure:"OAUTH_GITHUB_CLIENT_ID"`
+	OAuthGitHubClientSecret   string        `mapstructure:"OAUTH_GITHUB_CLIENT_SECRET"`
+	SecretsProvider           string        `mapstructure:"SECRETS_PROVIDER"`
+	SecretsAWSRegion          string        `mapstructure:"SECRETS_AWS_REGION"`
+	SecretsAWSEndpoint        string        `mapstructure:"SECRETS_AWS_ENDPOINT"`
+	SecretsAWSSecretID        string        `mapstructure:"SECRETS_AWS_SECRET_ID"`
+	VaultAddress              string        `mapstructure:"VAULT_ADDR"`
+	VaultToken                string        `mapstructure:"VAULT_TOKEN"`
+	VaultSecretPath           string        `mapstructure:"VAULT_SECRET_PATH"`
+}
+
+/*
+LoadConfig 读取配置并校验。配置来自 path 目录下的 app.env 和环境变量，环境变量优先。
+app.env 不存在的时候只用环境变量（比如容器里所有配置都通过环境变量传进来）。
+
+任何配置项 KEY 都可以改成设置 KEY_FILE，值是一个文件的路径，配置项的值就是这个文件的内容（去掉首尾空白），
+这样 Docker / Kubernetes 的 secret 可以以文件的形式挂载进来，而不用出现在环境变量里。
+本身名字就以 _FILE 结尾的配置项（比如 TOKEN_PRIVATE_KEY_FILE）保持原来的含义。
+
+最后从 SECRETS_PROVIDER 选择的密钥管理服务（见 secrets 包）读取敏感配置，覆盖前面得到的值，
+生产环境的 DB_SOURCE、TOKEN_SYMMETRIC_KEY、EMAIL_SENDER_PASSWORD 等可以只保存在 AWS Secrets Manager 或 Vault 里。
+*/
 func LoadConfig(path string) (config Config, err error) {
-	viper.AddConfigPath(path)
-	viper.SetConfigName("app")
-	viper.SetConfigType("env")
+	return readConfig(newConfigViper(path))
+}
+
+func newConfigViper(path string) *viper.Viper {
+	v := viper.New()
+	v.AddConfigPath(path)
+	v.SetConfigName("app")
+	v.SetConfigType("env")
 
-	viper.AutomaticEnv()
+	v.AutomaticEnv()
 
-	err = viper.ReadInConfig()
+	// AutomaticEnv 只在 Get 的时候查环境变量，Unmarshal 只认识已知的 key，
+	// 所以每个配置项都要显式绑定，没有 app.env 的时候环境变量才会生效
+	for _, key := range configKeys() {
+		_ = v.BindEnv(key)
+		_ = v.BindEnv(key + secretFileSuffix)
+	}
+	return v
+}
+
+func readConfig(v *viper.Viper) (config Config, err error) {
+	err = v.ReadInConfig()
+	if err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) {
+			return
+		}
+	}
+
+	err = resolveSecretFiles(v)
 	if err != nil {
 		return
 	}
 
-	err = viper.Unmarshal(&config)
+	err = resolveSecrets(v)
+	if err != nil {
+		return
+	}
+
+	err = v.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+
+	err = config.Validate()
 	return
 }
+
+const secretFileSuffix = "_FILE"
+
+// resolveSecretFiles 把设置了 KEY_FILE 的配置项替换成文件的内容
+func resolveSecretFiles(v *viper.Viper) error {
+	keys := configKeys()
+	known := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		known[key] = true
+	}
+
+	for _, key := range keys {
+		fileKey := key + secretFileSuffix
+		if known[fileKey] {
+			continue
+		}
+
+		file := v.GetString(fileKey)
+		if file == "" {
+			continue
+		}
+
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("cannot read %s: %w", fileKey, err)
+		}
+		v.Set(key, strings.TrimSpace(string(data)))
+	}
+	return nil
+}
+
+// SecretKeys are the sensitive config keys, the env secrets provider reads them from environment variables
+var SecretKeys = []string{
+	"DB_SOURCE",
+	"TOKEN_SYMMETRIC_KEY",
+	"TOKEN_PRIVATE_KEY",
+	"LINK_SIGNING_KEY",
+	"EMAIL_SENDER_PASSWORD",
+	"SMTP_PASSWORD",
+	"SENDGRID_API_KEY",
+	"REDIS_PASSWORD",
+	"OAUTH_STATE_KEY",
+	"CARD_PAN_KEY",
+	"EXTERNAL_WEBHOOK_SECRET",
+	"S3_SECRET_ACCESS_KEY",
+}
+
+const secretsTimeout = 10 * time.Second
+
+// resolveSecrets 用密钥管理服务返回的值覆盖配置，不认识的 key 会被忽略
+func resolveSecrets(v *viper.Viper) error {
+	provider, err := secrets.NewProvider(secrets.Config{
+		Provider:        v.GetString("SECRETS_PROVIDER"),
+		AWSRegion:       v.GetString("SECRETS_AWS_REGION"),
+		AWSEndpoint:     v.GetString("SECRETS_AWS_ENDPOINT"),
+		AWSSecretID:     v.GetString("SECRETS_AWS_SECRET_ID"),
+		VaultAddress:    v.GetString("VAULT_ADDR"),
+		VaultToken:      v.GetString("VAULT_TOKEN"),
+		VaultSecretPath: v.GetString("VAULT_SECRET_PATH"),
+		EnvKeys:         SecretKeys,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretsTimeout)
+	defer cancel()
+
+	values, err := provider.Secrets(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot load secrets: %w", err)
+	}
+
+	known := map[string]bool{}
+	for _, key := range configKeys() {
+		known[key] = true
+	}
+	for key, value := range values {
+		if known[key] {
+			v.Set(key, value)
+		}
+	}
+	return nil
+}
+
+// configKeys returns the mapstructure keys of all Config fields, e.g. "DB_SOURCE"
+func configKeys() []string {
+	configType := reflect.TypeOf(Config{})
+	keys := make([]string, 0, configType.NumField())
+	for i := 0; i < configType.NumField(); i++ {
+		if key := configType.Field(i).Tag.Get("mapstructure"); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// Values of ENVIRONMENT that change the behavior of the server
+const (
+	// DevelopmentEnvironment is the value of ENVIRONMENT on a developer's machine
+	DevelopmentEnvironment = "development"
+	// ProductionEnvironment is the value of ENVIRONMENT serving real customers
+	ProductionEnvironment = "production"
+)
+
+/*
+DebugEnabled 表示是否打开调试用的功能：gRPC 反射、网关上的 /debug/pprof 和 debug 级别的日志。
+这些功能会暴露服务的内部信息（所有 RPC 的定义、堆栈和内存），只在开发环境里打开。
+*/
+func (config Config) DebugEnabled() bool {
+	return config.Environment == DevelopmentEnvironment
+}
@@ -0,0 +1,177 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type configValue struct {
+	key   string
+	value string
+}
+
+// LogLevels are the accepted values of LOG_LEVEL, empty means debug in development and info elsewhere
+var LogLevels = []string{"trace", "debug", "info", "warn", "error"}
+
+// LogFormats are the accepted values of LOG_FORMAT, empty means console in development and json elsewhere
+var LogFormats = []string{"json", "console"}
+
+/*
+Validate 在启动的时候检查配置，把所有的问题一次性报出来（errors.Join），
+而不是等到某个功能第一次用到这个配置的时候才失败：
+  - 必填的配置项不能为空，邮件链接的签名密钥 LINK_SIGNING_KEY 至少 32 个字符
+  - 监听地址是逗号分隔的 host:port 或者 unix:/path（见 ParseListenAddresses），Redis 地址必须是 host:port 的格式
+  - 所有的时长都不能是负数，令牌的有效期必须大于 0
+  - 限流和日志级别的取值要合法，HTTP 的写超时要比 REQUEST_TIMEOUT 长
+  - gRPC 的消息大小和并发流数量不能超过 int32
+  - 密码哈希的算法和参数要合法（见 NewPasswordHasher）
+  - 生产环境不能配置 FAULT_INJECTION
+
+只检查所有部署都需要的配置，某个功能专用的配置（比如 S3、Twilio）由对应的组件在创建的时候检查。
+*/
+func (config Config) Validate() error {
+	var errs []error
+
+	required := []configValue{
+		{"DB_SOURCE", config.DBSource},
+		{"HTTP_SERVER_ADDRESS", config.HTTPServerAddress},
+		{"GRPC_SERVER_ADDRESS", config.GRPCServerAddress},
+	}
+	for _, item := range required {
+		if item.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", item.key))
+		}
+	}
+
+	// 不管令牌用哪种算法，邮件里的链接都用这个密钥签名（见 LinkSigner），为空的话谁都能伪造链接
+	if len(config.LinkSigningKey) < MinLinkSigningKeySize {
+		errs = append(errs, fmt.Errorf("LINK_SIGNING_KEY must have at least %d characters", MinLinkSigningKeySize))
+	}
+
+	listenAddresses := []configValue{
+		{"HTTP_SERVER_ADDRESS", config.HTTPServerAddress},
+		{"GRPC_SERVER_ADDRESS", config.GRPCServerAddress},
+		{"METRICS_SERVER_ADDRESS", config.MetricsServerAddress},
+		{"GIN_SERVER_ADDRESS", config.GinServerAddress},
+	}
+	for _, item := range listenAddresses {
+		if item.value == "" {
+			continue
+		}
+		if _, err := ParseListenAddresses(item.value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", item.key, err))
+		}
+	}
+	if config.RedisAddress != "" {
+		if err := validateAddress(config.RedisAddress); err != nil {
+			errs = append(errs, fmt.Errorf("REDIS_ADDRESS: %w", err))
+		}
+	}
+
+	fields := reflect.ValueOf(config)
+	configType := fields.Type()
+	for i := 0; i < configType.NumField(); i++ {
+		if configType.Field(i).Type != reflect.TypeOf(time.Duration(0)) {
+			continue
+		}
+		if fields.Field(i).Int() < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", configType.Field(i).Tag.Get("mapstructure")))
+		}
+	}
+
+	if config.AccessTokenDuration <= 0 {
+		errs = append(errs, errors.New("ACCESS_TOKEN_DURATION must be positive"))
+	}
+	if config.RefreshTokenDuration <= 0 {
+		errs = append(errs, errors.New("REFRESH_TOKEN_DURATION must be positive"))
+	}
+
+	if config.RateLimitRPS < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_RPS must not be negative"))
+	}
+	if config.RateLimitBurst < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_BURST must not be negative"))
+	}
+
+	if config.PasswordMinLength < 0 {
+		errs = append(errs, errors.New("PASSWORD_MIN_LENGTH must not be negative"))
+	}
+	if config.PasswordHistorySize < 0 {
+		errs = append(errs, errors.New("PASSWORD_HISTORY_SIZE must not be negative"))
+	}
+	for key, value := range map[string]int{
+		"PASSWORD_BCRYPT_COST":    config.PasswordBcryptCost,
+		"PASSWORD_ARGON2_TIME":    config.PasswordArgon2Time,
+		"PASSWORD_ARGON2_MEMORY":  config.PasswordArgon2Memory,
+		"PASSWORD_ARGON2_THREADS": config.PasswordArgon2Threads,
+	} {
+		if value < 0 {
+			errs = append(errs, fmt.Errorf("%s must not be negative", key))
+		}
+	}
+	if _, err := NewPasswordHasher(config); err != nil {
+		errs = append(errs, err)
+	}
+
+	if config.HTTPMaxHeaderBytes < 0 {
+		errs = append(errs, errors.New("HTTP_MAX_HEADER_BYTES must not be negative"))
+	}
+	if config.HTTPMaxBodySize < 0 {
+		errs = append(errs, errors.New("HTTP_MAX_BODY_SIZE must not be negative"))
+	}
+	// 写超时从读完请求头开始算，比处理请求的超时还短的话，超时的请求连 504 都返回不了
+	if config.HTTPWriteTimeout > 0 && config.HTTPWriteTimeout <= config.RequestTimeout {
+		errs = append(errs, errors.New("HTTP_WRITE_TIMEOUT must be longer than REQUEST_TIMEOUT"))
+	}
+
+	for key, value := range map[string]int{
+		"GRPC_MAX_RECV_MSG_SIZE":      config.GRPCMaxRecvMsgSize,
+		"GRPC_MAX_SEND_MSG_SIZE":      config.GRPCMaxSendMsgSize,
+		"GRPC_MAX_CONCURRENT_STREAMS": config.GRPCMaxConcurrentStreams,
+	} {
+		if value < 0 || value > math.MaxInt32 {
+			errs = append(errs, fmt.Errorf("%s must be between 0 and %d", key, math.MaxInt32))
+		}
+	}
+	// 宽限期是连接到了 GRPC_MAX_CONNECTION_AGE 之后等待正在处理的请求的时间，单独配置没有作用
+	if config.GRPCMaxConnectionAgeGrace > 0 && config.GRPCMaxConnectionAge == 0 {
+		errs = append(errs, errors.New("GRPC_MAX_CONNECTION_AGE_GRACE requires GRPC_MAX_CONNECTION_AGE"))
+	}
+
+	if config.LogLevel != "" && !slices.Contains(LogLevels, config.LogLevel) {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL must be one of %v", LogLevels))
+	}
+	if config.LogFormat != "" && !slices.Contains(LogFormats, config.LogFormat) {
+		errs = append(errs, fmt.Errorf("LOG_FORMAT must be one of %v", LogFormats))
+	}
+	if config.LogSampling < 0 {
+		errs = append(errs, errors.New("LOG_SAMPLING must not be negative"))
+	}
+
+	// 故障注入只用于测试和 staging，生产环境配置了说明部署出了问题，直接拒绝启动
+	if config.FaultInjection != "" && config.Environment == ProductionEnvironment {
+		errs = append(errs, errors.New("FAULT_INJECTION must not be set in production"))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateAddress checks that the address is host:port with a valid port number, the host may be empty
+func validateAddress(address string) error {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%q is not a host:port address", address)
+	}
+
+	number, err := strconv.Atoi(port)
+	if err != nil || number < 0 || number > 65535 {
+		return fmt.Errorf("%q has an invalid port", address)
+	}
+	return nil
+}
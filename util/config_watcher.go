@@ -0,0 +1,103 @@
+package util
+
+import (
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+/*
+ConfigWatcher 用 fsnotify 监听 app.env，文件修改之后重新读取并校验配置，再通知订阅者。
+重新读取的配置校验失败时保留原来的配置，错误交给 onError（一般是打一条日志），不会让服务退出。
+
+只有少数配置可以热更新（见 reloadConfig），目前是：
+  - LOG_LEVEL：日志级别
+  - RATE_LIMIT_RPS、RATE_LIMIT_BURST：限流
+  - FAULT_INJECTION：故障注入
+
+其他的配置（数据库、监听地址、密钥等）修改之后需要重启服务才会生效，重新读取时沿用启动时的值。
+密钥只在启动的时候从 *_FILE 和 SECRETS_PROVIDER 读取一次，修改 app.env 不会再去请求 AWS Secrets Manager 或 Vault，
+密钥服务暂时不可用也不影响热更新。
+
+只用环境变量、没有 app.env 的时候没有文件可以监听，WatchConfig 不做任何事情。
+在 Kubernetes 里把 ConfigMap 挂载成 app.env 时，更新 ConfigMap 就可以触发热更新。
+*/
+type ConfigWatcher struct {
+	mu        sync.Mutex
+	config    Config
+	listeners []func(config Config)
+	onError   func(err error)
+}
+
+// WatchConfig starts watching the app.env file in path
+func WatchConfig(path string, onError func(err error)) *ConfigWatcher {
+	watcher := &ConfigWatcher{onError: onError}
+
+	v := newConfigViper(path)
+	config, err := readConfig(v)
+	if err != nil || v.ConfigFileUsed() == "" {
+		return watcher
+	}
+	watcher.config = config
+
+	v.OnConfigChange(func(event fsnotify.Event) {
+		watcher.mu.Lock()
+		current := watcher.config
+		watcher.mu.Unlock()
+
+		watcher.reload(reloadConfig(v, current))
+	})
+	v.WatchConfig()
+
+	return watcher
+}
+
+// Subscribe registers a listener that is called with the new config after every successful reload
+func (watcher *ConfigWatcher) Subscribe(listener func(config Config)) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	watcher.listeners = append(watcher.listeners, listener)
+}
+
+func (watcher *ConfigWatcher) reload(config Config, err error) {
+	if err != nil {
+		if watcher.onError != nil {
+			watcher.onError(err)
+		}
+		return
+	}
+
+	watcher.mu.Lock()
+	watcher.config = config
+	listeners := append([]func(config Config){}, watcher.listeners...)
+	watcher.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(config)
+	}
+}
+
+// reloadConfig 重新读取 app.env，把可以热更新的配置项换到 current 上，其他的配置项（包括密钥）保持不变
+func reloadConfig(v *viper.Viper, current Config) (Config, error) {
+	if err := v.ReadInConfig(); err != nil {
+		return current, err
+	}
+
+	var changed Config
+	if err := v.Unmarshal(&changed); err != nil {
+		return current, err
+	}
+
+	config := current
+	config.LogLevel = changed.LogLevel
+	config.RateLimitRPS = changed.RateLimitRPS
+	config.RateLimitBurst = changed.RateLimitBurst
+	config.FaultInjection = changed.FaultInjection
+
+	if err := config.Validate(); err != nil {
+		return current, err
+	}
+	return config, nil
+}
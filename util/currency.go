@@ -1,17 +1,149 @@
 package util
 
-// Constants for all supported currencies
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Constants for the default supported currencies
 const (
 	USD = "USD"
 	EUR = "EUR"
 	CAD = "CAD"
+	JPY = "JPY"
 )
 
+// Currency describes a supported currency and its number of decimal places (ISO 4217 minor units)
+type Currency struct {
+	Code       string `json:"code"`
+	MinorUnits int    `json:"minor_units"`
+}
+
+/*
+支持的货币不再写死在代码里，而是放在一个注册表里，启动时从配置 SUPPORTED_CURRENCIES 加载
+（格式是 "USD:2,EUR:2,JPY:0"，冒号后面是小数位数）。没有配置时使用下面的默认值。
+Gin 的 currency 验证器、gapi 的 val.ValidateCurrency 和 Money 都通过这个注册表判断货币是否受支持，
+所以新增一种货币只需要改配置，不需要改代码。
+*/
+var (
+	currenciesMu sync.RWMutex
+	currencies   = map[string]int{
+		USD: 2,
+		EUR: 2,
+		CAD: 2,
+		JPY: 0,
+	}
+)
+
+// ParseCurrencies parses a list of currencies in the format "USD:2,EUR:2,JPY:0"
+func ParseCurrencies(value string) ([]Currency, error) {
+	var result []Currency
+	seen := map[string]bool{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		code, units, ok := strings.Cut(item, ":")
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if !ok || len(code) != 3 || !isLetters(code) {
+			return nil, fmt.Errorf("invalid currency %q: must be in the format CODE:minor_units", item)
+		}
+
+		minorUnits, err := strconv.Atoi(strings.TrimSpace(units))
+		if err != nil || minorUnits < 0 || minorUnits > 4 {
+			return nil, fmt.Errorf("invalid minor units for currency %s: must be between 0 and 4", code)
+		}
+
+		if seen[code] {
+			return nil, fmt.Errorf("duplicate currency %s", code)
+		}
+		seen[code] = true
+		result = append(result, Currency{Code: code, MinorUnits: minorUnits})
+	}
+	return result, nil
+}
+
+func isLetters(value string) bool {
+	for _, c := range value {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// SetSupportedCurrencies replaces the set of supported currencies.
+// An empty list keeps the current set.
+func SetSupportedCurrencies(list []Currency) {
+	if len(list) == 0 {
+		return
+	}
+
+	next := make(map[string]int, len(list))
+	for _, currency := range list {
+		next[currency.Code] = currency.MinorUnits
+	}
+
+	currenciesMu.Lock()
+	defer currenciesMu.Unlock()
+	currencies = next
+}
+
+// SupportedCurrencies returns all supported currencies ordered by code
+func SupportedCurrencies() []Currency {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	list := make([]Currency, 0, len(currencies))
+	for code, minorUnits := range currencies {
+		list = append(list, Currency{Code: code, MinorUnits: minorUnits})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Code < list[j].Code
+	})
+	return list
+}
+
 // IsSupportedCurrency returns true if the currency is supported
 func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case USD, EUR, CAD:
-		return true
+	_, ok := lookupCurrency(currency)
+	return ok
+}
+
+func lookupCurrency(currency string) (int, bool) {
+	currenciesMu.RLock()
+	defer currenciesMu.RUnlock()
+
+	minorUnits, ok := currencies[currency]
+	return minorUnits, ok
+}
+
+// ParseCurrencyAccounts parses a list of internal accounts keyed by currency in the format "USD:1,EUR:2",
+// e.g. EXTERNAL_CLEARING_ACCOUNTS or LOAN_FUNDING_ACCOUNTS
+func ParseCurrencyAccounts(value string) (map[string]int64, error) {
+	accounts := map[string]int64{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		currency, id, ok := strings.Cut(item, ":")
+		currency = strings.TrimSpace(currency)
+		if !ok || currency == "" {
+			return nil, fmt.Errorf("invalid currency account %q: must be in the format currency:account_id", item)
+		}
+
+		accountID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+		if err != nil || accountID <= 0 {
+			return nil, fmt.Errorf("invalid account id for currency %s", currency)
+		}
+		accounts[currency] = accountID
 	}
-	return false
+	return accounts, nil
 }
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrencies(t *testing.T) {
+	currencies, err := ParseCurrencies(" usd:2, JPY:0 ,,BHD:3")
+	require.NoError(t, err)
+	require.Equal(t, []Currency{
+		{Code: USD, MinorUnits: 2},
+		{Code: JPY, MinorUnits: 0},
+		{Code: "BHD", MinorUnits: 3},
+	}, currencies)
+
+	currencies, err = ParseCurrencies("")
+	require.NoError(t, err)
+	require.Empty(t, currencies)
+
+	for _, value := range []string{"USD", "US:2", "USD:x", "USD:-1", "USD:9", "USD:2,USD:2"} {
+		_, err = ParseCurrencies(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestSetSupportedCurrencies(t *testing.T) {
+	original := SupportedCurrencies()
+	defer SetSupportedCurrencies(original)
+
+	SetSupportedCurrencies([]Currency{{Code: "BHD", MinorUnits: 3}, {Code: USD, MinorUnits: 2}})
+	require.True(t, IsSupportedCurrency("BHD"))
+	require.False(t, IsSupportedCurrency(JPY))
+	require.Equal(t, []Currency{{Code: "BHD", MinorUnits: 3}, {Code: USD, MinorUnits: 2}}, SupportedCurrencies())
+
+	money, err := ParseMoney("1.234", "BHD")
+	require.NoError(t, err)
+	require.Equal(t, int64(1234), money.Amount)
+
+	// 空列表保持原来的设置
+	SetSupportedCurrencies(nil)
+	require.True(t, IsSupportedCurrency("BHD"))
+}
+
+func TestParseCurrencyAccounts(t *testing.T) {
+	accounts, err := ParseCurrencyAccounts("USD:1, EUR:2,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int64{USD: 1, EUR: 2}, accounts)
+
+	for _, value := range []string{"USD", ":1", "USD:x", "USD:0"} {
+		_, err = ParseCurrencyAccounts(value)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,57 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+)
+
+/*
+设备指纹用来判断用户是不是从一台没见过的设备登录的。
+指纹是 User-Agent 加上客户端 IP 所在网段的哈希：
+IPv4 取 /24，IPv6 取 /48，这样同一个家庭或者公司网络里 IP 变了也还是同一台设备，
+换了一个城市或者运营商就会被当成新设备。
+*/
+
+const (
+	deviceIPv4PrefixBits = 24
+	deviceIPv6PrefixBits = 48
+)
+
+// DeviceFingerprint returns the fingerprint of the device that sent a request
+func DeviceFingerprint(userAgent string, clientIP string) string {
+	hash := sha256.Sum256([]byte(userAgent + "|" + ClientNetwork(clientIP)))
+	return hex.EncodeToString(hash[:])
+}
+
+// ClientNetwork returns the network of a client IP, for example 203.0.113.0/24.
+func ClientNetwork(clientIP string) string {
+	ip := ParseClientIP(clientIP)
+	if ip == nil {
+		return clientHost(clientIP)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(deviceIPv4PrefixBits, 8*net.IPv4len)
+		return (&net.IPNet{IP: ip4.Mask(mask), Mask: mask}).String()
+	}
+
+	mask := net.CIDRMask(deviceIPv6PrefixBits, 8*net.IPv6len)
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
+
+// ParseClientIP parses the client IP of a session, or returns nil if it isn't a valid IP.
+// The IP may come from X-Forwarded-For (a list of IPs) or from the peer address (ip:port).
+func ParseClientIP(clientIP string) net.IP {
+	return net.ParseIP(clientHost(clientIP))
+}
+
+func clientHost(clientIP string) string {
+	// X-Forwarded-For 里第一个 IP 是最初的客户端
+	host := strings.TrimSpace(strings.Split(clientIP, ",")[0])
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return host
+}
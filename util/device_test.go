@@ -0,0 +1,42 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientNetwork(t *testing.T) {
+	testCases := map[string]string{
+		"203.0.113.7":               "203.0.113.0/24",
+		"203.0.113.7:54321":         "203.0.113.0/24",
+		"203.0.113.7, 10.0.0.1":     "203.0.113.0/24",
+		"2001:db8:abcd:12::1":       "2001:db8:abcd::/48",
+		"[2001:db8:abcd:12::1]:443": "2001:db8:abcd::/48",
+		"unknown":                   "unknown",
+		"":                          "",
+	}
+
+	for clientIP, network := range testCases {
+		require.Equal(t, network, ClientNetwork(clientIP), clientIP)
+	}
+}
+
+func TestDeviceFingerprint(t *testing.T) {
+	userAgent := "Mozilla/5.0"
+
+	fingerprint := DeviceFingerprint(userAgent, "203.0.113.7:1234")
+	require.Len(t, fingerprint, 64)
+
+	// 同一个网段里换了 IP 或者端口还是同一台设备
+	require.Equal(t, fingerprint, DeviceFingerprint(userAgent, "203.0.113.99:4321"))
+
+	require.NotEqual(t, fingerprint, DeviceFingerprint(userAgent, "198.51.100.7:1234"))
+	require.NotEqual(t, fingerprint, DeviceFingerprint("curl/8.0", "203.0.113.7:1234"))
+}
+
+func TestParseClientIP(t *testing.T) {
+	require.Equal(t, "203.0.113.7", ParseClientIP("203.0.113.7:54321").String())
+	require.Equal(t, "2001:db8::1", ParseClientIP("2001:db8::1, 10.0.0.1").String())
+	require.Nil(t, ParseClientIP("unknown"))
+}
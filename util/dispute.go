@@ -0,0 +1,23 @@
+package util
+
+// Constants for the status of a dispute
+const (
+	DisputeOpen        = "open"
+	DisputeUnderReview = "under_review"
+	DisputeRefunded    = "refunded"
+	DisputeRejected    = "rejected"
+)
+
+// IsSupportedDisputeStatus returns true if the dispute status is supported
+func IsSupportedDisputeStatus(status string) bool {
+	switch status {
+	case DisputeOpen, DisputeUnderReview, DisputeRefunded, DisputeRejected:
+		return true
+	}
+	return false
+}
+
+// IsDisputeResolved returns true if the dispute has been refunded or rejected and can't change anymore
+func IsDisputeResolved(status string) bool {
+	return status == DisputeRefunded || status == DisputeRejected
+}
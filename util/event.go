@@ -0,0 +1,37 @@
+package util
+
+import "time"
+
+// Types of the domain events published to the event bus
+const (
+	EventUserCreated       = "user.created"
+	EventTransferCompleted = "transfer.completed"
+	EventEmailVerified     = "email.verified"
+)
+
+// UserCreatedEvent is the payload of a user.created event
+type UserCreatedEvent struct {
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TransferCompletedEvent is the payload of a transfer.completed event
+type TransferCompletedEvent struct {
+	TransferID    int64     `json:"transfer_id"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	Currency      string    `json:"currency"`
+	Category      string    `json:"category,omitempty"`
+	Reference     string    `json:"reference,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// EmailVerifiedEvent is the payload of an email.verified event
+type EmailVerifiedEvent struct {
+	Username   string    `json:"username"`
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verified_at"`
+}
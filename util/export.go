@@ -0,0 +1,44 @@
+package util
+
+// Constants for the kind of data exported by an export job
+const (
+	ExportTransfers = "transfers"
+	ExportEntries   = "entries"
+)
+
+/*
+Constants for the file format of an export job.
+OFX、QIF 和 ISO 20022 camt.053 是记账软件能直接导入的对账单格式，只能从分录（entries）生成，见 exporter 包
+*/
+const (
+	ExportCSV     = "csv"
+	ExportOFX     = "ofx"
+	ExportQIF     = "qif"
+	ExportCAMT053 = "camt053"
+)
+
+// Constants for the status of an export job
+const (
+	ExportPending   = "pending"
+	ExportRunning   = "running"
+	ExportCompleted = "completed"
+	ExportFailed    = "failed"
+)
+
+// IsSupportedExportKind returns true if the kind of export is supported
+func IsSupportedExportKind(kind string) bool {
+	switch kind {
+	case ExportTransfers, ExportEntries:
+		return true
+	}
+	return false
+}
+
+// IsSupportedExportFormat returns true if the file format of export is supported
+func IsSupportedExportFormat(format string) bool {
+	switch format {
+	case ExportCSV, ExportOFX, ExportQIF, ExportCAMT053:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+package util
+
+// Constants for the status of an external (ACH/SEPA-style) transfer
+const (
+	ExternalTransferInitiated = "initiated"
+	ExternalTransferSubmitted = "submitted"
+	ExternalTransferSettled   = "settled"
+	ExternalTransferReturned  = "returned"
+)
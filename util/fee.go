@@ -0,0 +1,126 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+转账和外部转账（提现）的手续费分别来自配置 TRANSFER_FEES 和 WITHDRAWAL_FEES，格式是
+"USD:25+50,USD/banker:0+0,EUR:20+30"，每一条是 "币种[/角色]:固定金额+比例"，
+固定金额是最小货币单位，比例是基点（万分之几，最多 10000），比如 USD:25+50 表示 0.25 美元加上金额的 0.5%。
+带角色的规则优先于只写币种的规则，没有规则的币种不收手续费。
+
+手续费在转账的同一个事务里作为单独的一笔转账从转出账户转到这个币种的收入账户（FEE_ACCOUNTS）。
+*/
+type FeeRule struct {
+	Currency string `json:"currency"`
+	// Role is empty when the rule applies to all roles
+	Role string `json:"role"`
+	Flat int64  `json:"flat"`
+	Bps  int32  `json:"bps"`
+}
+
+// Constants for the kinds of fees returned by GetFeeSchedule
+const (
+	FeeKindTransfer   = "transfer"
+	FeeKindWithdrawal = "withdrawal"
+)
+
+// FeeSchedule is a list of fee rules sorted by currency and role
+type FeeSchedule []FeeRule
+
+// ParseFeeSchedule parses TRANSFER_FEES or WITHDRAWAL_FEES in the format "USD:25+50,USD/banker:0+0"
+func ParseFeeSchedule(value string) (FeeSchedule, error) {
+	var schedule FeeSchedule
+	seen := map[string]bool{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		key, fee, ok := strings.Cut(item, ":")
+		flat, bps, isFee := strings.Cut(strings.TrimSpace(fee), "+")
+		currency, role, _ := strings.Cut(strings.TrimSpace(key), "/")
+		if !ok || !isFee || currency == "" {
+			return nil, fmt.Errorf("invalid fee %q: must be in the format currency[/role]:flat+bps", item)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate fee for %s", key)
+		}
+		seen[key] = true
+
+		parsedFlat, err := strconv.ParseInt(strings.TrimSpace(flat), 10, 64)
+		if err != nil || parsedFlat < 0 {
+			return nil, fmt.Errorf("invalid flat fee for %s", key)
+		}
+		parsedBps, err := strconv.ParseInt(strings.TrimSpace(bps), 10, 32)
+		if err != nil || parsedBps < 0 || parsedBps > 10000 {
+			return nil, fmt.Errorf("invalid fee basis points for %s", key)
+		}
+
+		schedule = append(schedule, FeeRule{
+			Currency: currency,
+			Role:     role,
+			Flat:     parsedFlat,
+			Bps:      int32(parsedBps),
+		})
+	}
+
+	sort.Slice(schedule, func(i, j int) bool {
+		if schedule[i].Currency != schedule[j].Currency {
+			return schedule[i].Currency < schedule[j].Currency
+		}
+		return schedule[i].Role < schedule[j].Role
+	})
+	return schedule, nil
+}
+
+// Rule returns the rule for the currency and role, preferring a role specific rule
+func (schedule FeeSchedule) Rule(currency string, role string) (FeeRule, bool) {
+	var rule FeeRule
+	found := false
+	for _, item := range schedule {
+		if item.Currency != currency {
+			continue
+		}
+		if item.Role == role {
+			return item, true
+		}
+		if item.Role == "" {
+			rule, found = item, true
+		}
+	}
+	return rule, found
+}
+
+// Currencies returns the currencies that have at least one rule, sorted
+func (schedule FeeSchedule) Currencies() []string {
+	var currencies []string
+	for _, rule := range schedule {
+		if len(currencies) == 0 || currencies[len(currencies)-1] != rule.Currency {
+			currencies = append(currencies, rule.Currency)
+		}
+	}
+	return currencies
+}
+
+// Fee returns the fee of the currency and role for amount, zero if there is no rule
+func (schedule FeeSchedule) Fee(currency string, role string, amount int64) int64 {
+	rule, ok := schedule.Rule(currency, role)
+	if !ok {
+		return 0
+	}
+	return rule.Fee(amount)
+}
+
+// Fee returns the flat fee plus bps basis points of amount rounded up
+func (rule FeeRule) Fee(amount int64) int64 {
+	// 分成两部分计算，amount 很大的时候也不会溢出
+	bps := int64(rule.Bps)
+	percentage := amount/10000*bps + (amount%10000*bps+9999)/10000
+	return rule.Flat + percentage
+}
@@ -0,0 +1,44 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeeSchedule(t *testing.T) {
+	schedule, err := ParseFeeSchedule(" USD/banker:0+0, EUR:20+30,USD:25+50,")
+	require.NoError(t, err)
+	require.Equal(t, FeeSchedule{
+		{Currency: EUR, Flat: 20, Bps: 30},
+		{Currency: USD, Flat: 25, Bps: 50},
+		{Currency: USD, Role: BankerRole, Flat: 0, Bps: 0},
+	}, schedule)
+
+	schedule, err = ParseFeeSchedule("")
+	require.NoError(t, err)
+	require.Empty(t, schedule)
+
+	for _, value := range []string{"USD", "USD:25", "USD:x+1", "USD:1+x", "USD:-1+0", "USD:0+10001", ":1+1", "USD:1+1,USD:2+2"} {
+		_, err = ParseFeeSchedule(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestFeeSchedule(t *testing.T) {
+	schedule, err := ParseFeeSchedule("USD:25+50,USD/banker:0+0,EUR/depositor:0+100")
+	require.NoError(t, err)
+
+	// 0.25 + 100.00 * 0.5%
+	require.Equal(t, int64(75), schedule.Fee(USD, DepositorRole, 10000))
+	// 比例部分向上取整
+	require.Equal(t, int64(26), schedule.Fee(USD, DepositorRole, 1))
+	require.Equal(t, int64(0), schedule.Fee(USD, BankerRole, 10000))
+	require.Equal(t, int64(100), schedule.Fee(EUR, DepositorRole, 10000))
+	require.Equal(t, int64(0), schedule.Fee(EUR, BankerRole, 10000))
+	require.Equal(t, int64(0), schedule.Fee(CAD, DepositorRole, 10000))
+	require.Equal(t, []string{EUR, USD}, schedule.Currencies())
+
+	rule := FeeRule{Bps: 10000}
+	require.Equal(t, int64(9223372036854775807), rule.Fee(9223372036854775807))
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+/*
+外币转账的汇率来自配置 FX_RATES，格式是 "USD/EUR:0.92,USD/JPY:151.3"，表示 1 单位前一种货币可以换多少后一种货币，
+只配置了一个方向时反方向用倒数。汇率用 big.Rat 计算，不会有浮点数的误差。
+
+QuoteFX 先从转出金额里扣掉手续费（FX_FEE_BPS，万分之几，向上取整），再把剩下的钱按汇率换算成转入货币的最小单位，
+向下取整，零头留在银行的外汇账户里（FX_ACCOUNTS）。
+*/
+type FXRates map[string]*big.Rat
+
+var ErrUnsupportedCurrencyPair = errors.New("currency exchange is not supported for this currency pair")
+
+func fxPair(from string, to string) string {
+	return from + "/" + to
+}
+
+// ParseFXRates parses FX_RATES in the format "USD/EUR:0.92,USD/JPY:151.3"
+func ParseFXRates(value string) (FXRates, error) {
+	rates := FXRates{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		pair, rate, ok := strings.Cut(item, ":")
+		from, to, isPair := strings.Cut(strings.TrimSpace(pair), "/")
+		if !ok || !isPair || from == "" || to == "" || from == to {
+			return nil, fmt.Errorf("invalid exchange rate %q: must be in the format FROM/TO:rate", item)
+		}
+
+		parsedRate, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+		if !ok || parsedRate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid exchange rate for %s/%s", from, to)
+		}
+		rates[fxPair(from, to)] = parsedRate
+	}
+	return rates, nil
+}
+
+// Rate returns how many units of to one unit of from buys
+func (rates FXRates) Rate(from string, to string) (*big.Rat, bool) {
+	if rate, ok := rates[fxPair(from, to)]; ok {
+		return rate, true
+	}
+	if rate, ok := rates[fxPair(to, from)]; ok {
+		return new(big.Rat).Inv(rate), true
+	}
+	return nil, false
+}
+
+// FXQuote is the result of converting an amount to another currency, all amounts are in minor units
+type FXQuote struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	FromAmount   int64  `json:"from_amount"`
+	Fee          int64  `json:"fee"`
+	ToAmount     int64  `json:"to_amount"`
+	// Rate is a decimal string with at most 8 decimal places
+	Rate string `json:"rate"`
+}
+
+// QuoteFX converts from into toCurrency after deducting a fee of feeBps basis points
+func QuoteFX(rates FXRates, feeBps int32, from Money, toCurrency string) (FXQuote, error) {
+	rate, ok := rates.Rate(from.Currency, toCurrency)
+	if !ok {
+		return FXQuote{}, ErrUnsupportedCurrencyPair
+	}
+
+	fromUnits, err := MinorUnits(from.Currency)
+	if err != nil {
+		return FXQuote{}, err
+	}
+	toUnits, err := MinorUnits(toCurrency)
+	if err != nil {
+		return FXQuote{}, err
+	}
+
+	// 手续费向上取整，和换算一样用 big.Int 计算，金额很大的时候也不会溢出
+	amount := big.NewInt(from.Amount)
+	fee := new(big.Int).Mul(amount, big.NewInt(int64(feeBps)))
+	fee.Add(fee, big.NewInt(9999))
+	fee.Quo(fee, big.NewInt(10000))
+	if !fee.IsInt64() {
+		return FXQuote{}, fmt.Errorf("%w: too large to exchange", ErrInvalidAmount)
+	}
+
+	// (转出金额 - 手续费) / 10^fromUnits * rate * 10^toUnits
+	converted := new(big.Rat).SetInt(new(big.Int).Sub(amount, fee))
+	converted.Mul(converted, rate)
+	converted.Mul(converted, new(big.Rat).SetFrac(pow10(toUnits), pow10(fromUnits)))
+	toAmount := new(big.Int).Quo(converted.Num(), converted.Denom())
+	if !toAmount.IsInt64() {
+		return FXQuote{}, fmt.Errorf("%w: too large to exchange", ErrInvalidAmount)
+	}
+	if toAmount.Sign() <= 0 {
+		return FXQuote{}, fmt.Errorf("%w: too small to exchange", ErrInvalidAmount)
+	}
+
+	return FXQuote{
+		FromCurrency: from.Currency,
+		ToCurrency:   toCurrency,
+		FromAmount:   from.Amount,
+		Fee:          fee.Int64(),
+		ToAmount:     toAmount.Int64(),
+		Rate:         formatRate(rate),
+	}, nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// formatRate formats the rate with at most 8 decimal places and without trailing zeros
+func formatRate(rate *big.Rat) string {
+	value := rate.FloatString(8)
+	value = strings.TrimRight(value, "0")
+	return strings.TrimSuffix(value, ".")
+}
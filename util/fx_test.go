@@ -0,0 +1,84 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFXRates(t *testing.T) {
+	rates, err := ParseFXRates(" USD/EUR:0.92, USD/JPY:150,")
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+
+	rate, ok := rates.Rate(USD, EUR)
+	require.True(t, ok)
+	require.Equal(t, "23/25", rate.String())
+
+	// 没有配置的方向用倒数
+	rate, ok = rates.Rate(JPY, USD)
+	require.True(t, ok)
+	require.Equal(t, "1/150", rate.String())
+
+	_, ok = rates.Rate(EUR, JPY)
+	require.False(t, ok)
+
+	rates, err = ParseFXRates("")
+	require.NoError(t, err)
+	require.Empty(t, rates)
+
+	for _, value := range []string{"USD", "USD:1", "USD/:1", "USD/USD:1", "USD/EUR:x", "USD/EUR:0", "USD/EUR:-1"} {
+		_, err = ParseFXRates(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestQuoteFX(t *testing.T) {
+	rates, err := ParseFXRates("USD/EUR:0.92,USD/JPY:150")
+	require.NoError(t, err)
+
+	// 100.00 USD，手续费 1%，剩下 99.00 USD 换成 91.08 EUR
+	quote, err := QuoteFX(rates, 100, Money{Amount: 10000, Currency: USD}, EUR)
+	require.NoError(t, err)
+	require.Equal(t, FXQuote{
+		FromCurrency: USD,
+		ToCurrency:   EUR,
+		FromAmount:   10000,
+		Fee:          100,
+		ToAmount:     9108,
+		Rate:         "0.92",
+	}, quote)
+
+	// USD 有两位小数，JPY 没有：12.34 USD 换成 1851 JPY
+	quote, err = QuoteFX(rates, 0, Money{Amount: 1234, Currency: USD}, JPY)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), quote.Fee)
+	require.Equal(t, int64(1851), quote.ToAmount)
+
+	// 反方向：1000 JPY 换成 6.66 USD，零头向下取整
+	quote, err = QuoteFX(rates, 0, Money{Amount: 1000, Currency: JPY}, USD)
+	require.NoError(t, err)
+	require.Equal(t, int64(666), quote.ToAmount)
+	require.Equal(t, "0.00666667", quote.Rate)
+
+	// 手续费向上取整
+	quote, err = QuoteFX(rates, 1, Money{Amount: 101, Currency: USD}, EUR)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), quote.Fee)
+
+	// 金额接近 MaxInt64 时手续费的计算不能溢出
+	quote, err = QuoteFX(rates, 100, Money{Amount: math.MaxInt64, Currency: USD}, EUR)
+	require.NoError(t, err)
+	require.Equal(t, int64(92233720368547759), quote.Fee)
+	require.Equal(t, int64(8400647251167329804), quote.ToAmount)
+
+	_, err = QuoteFX(rates, math.MaxInt32, Money{Amount: math.MaxInt64, Currency: USD}, EUR)
+	require.ErrorIs(t, err, ErrInvalidAmount)
+
+	_, err = QuoteFX(rates, 0, Money{Amount: 1, Currency: JPY}, USD)
+	require.ErrorIs(t, err, ErrInvalidAmount)
+
+	_, err = QuoteFX(rates, 0, Money{Amount: 1000, Currency: EUR}, JPY)
+	require.ErrorIs(t, err, ErrUnsupportedCurrencyPair)
+}
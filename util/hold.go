@@ -0,0 +1,8 @@
+package util
+
+// Constants for the status of a hold on an account balance
+const (
+	HoldActive   = "active"
+	HoldReleased = "released"
+	HoldCaptured = "captured"
+)
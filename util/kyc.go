@@ -0,0 +1,72 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Constants for the type of a KYC document
+const (
+	KYCPassport       = "passport"
+	KYCDriversLicense = "drivers_license"
+	KYCNationalID     = "national_id"
+	KYCProofOfAddress = "proof_of_address"
+)
+
+// Constants for the review status of a KYC document
+const (
+	KYCDocumentPending  = "pending"
+	KYCDocumentApproved = "approved"
+	KYCDocumentRejected = "rejected"
+)
+
+/*
+KYC 等级由已经批准的证件决定：
+  - 0：没有批准的证件
+  - 1：有一份批准的身份证件（护照、驾照或身份证）
+  - 2：身份证件加上一份批准的地址证明
+*/
+const (
+	KYCLevelNone     int32 = 0
+	KYCLevelIdentity int32 = 1
+	KYCLevelFull     int32 = 2
+)
+
+// IsSupportedKYCDocumentType returns true if the KYC document type is supported
+func IsSupportedKYCDocumentType(documentType string) bool {
+	switch documentType {
+	case KYCPassport, KYCDriversLicense, KYCNationalID, KYCProofOfAddress:
+		return true
+	}
+	return false
+}
+
+// ParseKYCTransferLimits parses KYC_TRANSFER_LIMITS in the format "0:100000,1:1000000",
+// which maps a KYC level to the maximum amount of a single transfer. Levels that aren't listed have no limit.
+func ParseKYCTransferLimits(value string) (map[int32]int64, error) {
+	limits := map[int32]int64{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		level, limit, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid KYC transfer limit %q: must be in the format level:amount", item)
+		}
+
+		parsedLevel, err := strconv.ParseInt(strings.TrimSpace(level), 10, 32)
+		if err != nil || parsedLevel < 0 {
+			return nil, fmt.Errorf("invalid KYC level %q", level)
+		}
+
+		parsedLimit, err := strconv.ParseInt(strings.TrimSpace(limit), 10, 64)
+		if err != nil || parsedLimit <= 0 {
+			return nil, fmt.Errorf("invalid transfer limit for KYC level %d", parsedLevel)
+		}
+		limits[int32(parsedLevel)] = parsedLimit
+	}
+	return limits, nil
+}
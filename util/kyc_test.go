@@ -0,0 +1,18 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKYCTransferLimits(t *testing.T) {
+	limits, err := ParseKYCTransferLimits("0:100, 1:1000,")
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int64{KYCLevelNone: 100, KYCLevelIdentity: 1000}, limits)
+
+	for _, value := range []string{"0", "x:100", "-1:100", "0:0", "0:x"} {
+		_, err = ParseKYCTransferLimits(value)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+HTTP_SERVER_ADDRESS 和 GRPC_SERVER_ADDRESS 可以用逗号分隔多个监听地址，服务器同时在所有地址上监听，比如
+"0.0.0.0:9090,unix:/run/bank/grpc.sock"。unix: 开头的是 Unix domain socket 的路径，
+给同一台机器（或者同一个 pod）里的 sidecar 代理用，不经过 TCP 协议栈，也不会暴露到网络上。
+*/
+const unixAddressPrefix = "unix:"
+
+// ListenAddress is a TCP host:port or the path of a unix socket
+type ListenAddress struct {
+	// Network is "tcp" or "unix"
+	Network string
+	Address string
+}
+
+// String returns the address in the same format as the config
+func (address ListenAddress) String() string {
+	if address.Network == "unix" {
+		return unixAddressPrefix + address.Address
+	}
+	return address.Address
+}
+
+// ParseListenAddresses parses a comma separated list of host:port and unix:/path addresses
+func ParseListenAddresses(value string) ([]ListenAddress, error) {
+	var addresses []ListenAddress
+	seen := map[ListenAddress]bool{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		address := ListenAddress{Network: "tcp", Address: item}
+		if path, ok := strings.CutPrefix(item, unixAddressPrefix); ok {
+			if path == "" {
+				return nil, fmt.Errorf("%q has an empty socket path", item)
+			}
+			address = ListenAddress{Network: "unix", Address: path}
+		} else if err := validateAddress(item); err != nil {
+			return nil, err
+		}
+
+		if seen[address] {
+			return nil, fmt.Errorf("duplicate listen address %q", item)
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no listen address")
+	}
+	return addresses, nil
+}
@@ -0,0 +1,23 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListenAddresses(t *testing.T) {
+	addresses, err := ParseListenAddresses(" 0.0.0.0:9090, unix:/run/bank/grpc.sock,")
+	require.NoError(t, err)
+	require.Equal(t, []ListenAddress{
+		{Network: "tcp", Address: "0.0.0.0:9090"},
+		{Network: "unix", Address: "/run/bank/grpc.sock"},
+	}, addresses)
+	require.Equal(t, "unix:/run/bank/grpc.sock", addresses[1].String())
+	require.Equal(t, "0.0.0.0:9090", addresses[0].String())
+
+	for _, value := range []string{"", ",", "localhost", "localhost:http", "unix:", ":9090,:9090", "unix:/tmp/a.sock,unix:/tmp/a.sock"} {
+		_, err = ParseListenAddresses(value)
+		require.Error(t, err, value)
+	}
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"math"
+	"time"
+)
+
+// Constants for the status of a loan
+const (
+	LoanPending    = "pending"
+	LoanActive     = "active"
+	LoanRejected   = "rejected"
+	LoanDelinquent = "delinquent"
+	LoanPaidOff    = "paid_off"
+)
+
+// Constants for the status of a loan installment
+const (
+	LoanPaymentScheduled = "scheduled"
+	LoanPaymentPaid      = "paid"
+)
+
+// LoanInstallment is one row of a loan repayment schedule
+type LoanInstallment struct {
+	Number    int32
+	DueDate   time.Time
+	Principal int64
+	Interest  int64
+	Amount    int64
+}
+
+/*
+LoanSchedule 按等额本息（annuity）生成还款计划：每个月还的总额相同，前期利息多、本金少。
+
+所有金额都是币种的最小单位（整数），每期的还款额向上取整，
+每期利息按剩余本金四舍五入，最后一期把剩下的本金全部还清，所以本金之和一定等于借款金额。
+年利率用基点（basis points）表示，例如 800 表示 8%。
+
+第 i 期在 start 之后第 i 个月的同一天到期，如果那个月没有这一天（比如 1 月 31 日借款），就用那个月的最后一天。
+*/
+func LoanSchedule(principal int64, annualRateBps int32, termMonths int32, start time.Time) []LoanInstallment {
+	if principal <= 0 || termMonths <= 0 {
+		return nil
+	}
+
+	monthlyRate := float64(annualRateBps) / 10000 / 12
+	n := float64(termMonths)
+
+	var payment int64
+	if monthlyRate == 0 {
+		payment = int64(math.Ceil(float64(principal) / n))
+	} else {
+		payment = int64(math.Ceil(float64(principal) * monthlyRate / (1 - math.Pow(1+monthlyRate, -n))))
+	}
+
+	schedule := make([]LoanInstallment, termMonths)
+	balance := principal
+	for i := int32(0); i < termMonths; i++ {
+		interest := int64(math.Round(float64(balance) * monthlyRate))
+
+		principalPart := payment - interest
+		if i == termMonths-1 || principalPart > balance {
+			principalPart = balance
+		}
+		balance -= principalPart
+
+		schedule[i] = LoanInstallment{
+			Number:    i + 1,
+			DueDate:   addMonthsClamped(start, int(i)+1),
+			Principal: principalPart,
+			Interest:  interest,
+			Amount:    principalPart + interest,
+		}
+	}
+
+	return schedule
+}
+
+// addMonthsClamped adds months to t without overflowing into the next month, e.g. Jan 31 + 1 month = Feb 28
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
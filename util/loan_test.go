@@ -0,0 +1,46 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoanSchedule(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	schedule := LoanSchedule(100000, 1200, 12, start)
+	require.Len(t, schedule, 12)
+
+	var totalPrincipal int64
+	for i, installment := range schedule {
+		require.Equal(t, int32(i+1), installment.Number)
+		require.Equal(t, installment.Principal+installment.Interest, installment.Amount)
+		totalPrincipal += installment.Principal
+	}
+	require.Equal(t, int64(100000), totalPrincipal)
+
+	// 12% 年利率、12 期，每月还款 8885（向上取整），第一期利息是 1000
+	require.Equal(t, int64(8885), schedule[0].Amount)
+	require.Equal(t, int64(1000), schedule[0].Interest)
+
+	// 1 月 31 日借款，2 月没有 31 日，用月底
+	require.Equal(t, time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC), schedule[0].DueDate)
+	require.Equal(t, time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC), schedule[1].DueDate)
+	require.Equal(t, time.Date(2025, time.January, 31, 12, 0, 0, 0, time.UTC), schedule[11].DueDate)
+}
+
+func TestLoanScheduleWithoutInterest(t *testing.T) {
+	schedule := LoanSchedule(1000, 0, 3, time.Now())
+	require.Len(t, schedule, 3)
+	require.Equal(t, int64(334), schedule[0].Amount)
+	require.Equal(t, int64(334), schedule[1].Amount)
+	require.Equal(t, int64(332), schedule[2].Amount)
+	require.Zero(t, schedule[2].Interest)
+}
+
+func TestLoanScheduleInvalid(t *testing.T) {
+	require.Nil(t, LoanSchedule(0, 800, 12, time.Now()))
+	require.Nil(t, LoanSchedule(1000, 800, 0, time.Now()))
+}
@@ -0,0 +1,105 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+/*
+Money 表示某种货币的金额，Amount 是最小货币单位（minor unit）的整数，
+比如 USD 的 1234 表示 12.34 美元，JPY 没有小数，1234 就是 1234 日元。
+数据库和 proto 里存的都是最小单位的整数，这样不会有浮点数的误差。
+
+每种货币的小数位数来自 ISO 4217（见 currency.go 里的注册表），ParseMoney 用它把 "12.34" 这样的十进制字符串转换成最小单位，
+小数位数超过货币允许的位数（比如 JPY 的 "100.5"）会返回错误，而不是悄悄四舍五入。
+*/
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+var (
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrInvalidAmount       = errors.New("invalid amount")
+)
+
+// MinorUnits returns the number of decimal places of the currency, e.g. 2 for USD and 0 for JPY
+func MinorUnits(currency string) (int, error) {
+	units, ok := lookupCurrency(currency)
+	if !ok {
+		return 0, ErrUnsupportedCurrency
+	}
+	return units, nil
+}
+
+// NewMoney creates a Money from an amount in minor units
+func NewMoney(amount int64, currency string) (Money, error) {
+	if _, err := MinorUnits(currency); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseMoney parses a decimal string like "12.34" into minor units of the currency
+func ParseMoney(value string, currency string) (Money, error) {
+	units, err := MinorUnits(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	whole, fraction, hasPoint := strings.Cut(value, ".")
+	if whole == "" || (hasPoint && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("%w: %q is not a decimal number", ErrInvalidAmount, value)
+	}
+	if len(fraction) > units {
+		return Money{}, fmt.Errorf("%w: %s allows at most %d decimal places", ErrInvalidAmount, currency, units)
+	}
+
+	amount, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", units-len(fraction)), 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q is out of range", ErrInvalidAmount, value)
+	}
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Decimal formats the amount in major units, e.g. "12.34" for 1234 USD cents
+func (money Money) Decimal() string {
+	units, err := MinorUnits(money.Currency)
+	if err != nil || units == 0 {
+		return strconv.FormatInt(money.Amount, 10)
+	}
+
+	sign := ""
+	amount := uint64(money.Amount)
+	if money.Amount < 0 {
+		sign = "-"
+		amount = uint64(-(money.Amount + 1)) + 1 // 避免 math.MinInt64 取反溢出
+	}
+
+	scale := uint64(math.Pow10(units))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, units, amount%scale)
+}
+
+// String formats the money with its currency code, e.g. "12.34 USD"
+func (money Money) String() string {
+	return money.Decimal() + " " + money.Currency
+}
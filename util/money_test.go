@@ -0,0 +1,60 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	testCases := []struct {
+		value    string
+		currency string
+		amount   int64
+		err      error
+	}{
+		{value: "12.34", currency: USD, amount: 1234},
+		{value: "12.3", currency: EUR, amount: 1230},
+		{value: "12", currency: CAD, amount: 1200},
+		{value: "-0.05", currency: USD, amount: -5},
+		{value: "1500", currency: JPY, amount: 1500},
+		{value: "100.5", currency: JPY, err: ErrInvalidAmount},
+		{value: "1.234", currency: USD, err: ErrInvalidAmount},
+		{value: "1.", currency: USD, err: ErrInvalidAmount},
+		{value: ".5", currency: USD, err: ErrInvalidAmount},
+		{value: "1e3", currency: USD, err: ErrInvalidAmount},
+		{value: "99999999999999999999", currency: USD, err: ErrInvalidAmount},
+		{value: "10", currency: "XYZ", err: ErrUnsupportedCurrency},
+	}
+
+	for _, tc := range testCases {
+		money, err := ParseMoney(tc.value, tc.currency)
+		if tc.err != nil {
+			require.ErrorIs(t, err, tc.err, tc.value)
+			continue
+		}
+
+		require.NoError(t, err, tc.value)
+		require.Equal(t, tc.amount, money.Amount, tc.value)
+		require.Equal(t, tc.currency, money.Currency)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	require.Equal(t, "12.34 USD", Money{Amount: 1234, Currency: USD}.String())
+	require.Equal(t, "0.05 EUR", Money{Amount: 5, Currency: EUR}.String())
+	require.Equal(t, "-1.50 CAD", Money{Amount: -150, Currency: CAD}.String())
+	require.Equal(t, "1500 JPY", Money{Amount: 1500, Currency: JPY}.String())
+	require.Equal(t, "-92233720368547758.08 USD", Money{Amount: math.MinInt64, Currency: USD}.String())
+}
+
+func TestMoneyRoundTrip(t *testing.T) {
+	for _, currency := range []string{USD, EUR, CAD, JPY} {
+		money := Money{Amount: RandomMoney(), Currency: currency}
+
+		parsed, err := ParseMoney(money.Decimal(), currency)
+		require.NoError(t, err)
+		require.Equal(t, money, parsed)
+	}
+}
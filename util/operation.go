@@ -0,0 +1,14 @@
+package util
+
+// Constants for the status of a pending operation
+const (
+	OperationPending   = "pending"
+	OperationConfirmed = "confirmed"
+	OperationReversed  = "reversed"
+)
+
+// OperationExternalPayout is a transfer that still needs to be settled by an external system
+const OperationExternalPayout = "external_payout"
+
+// TransferCompleted is reported for transfers that were never tracked as a pending operation
+const TransferCompleted = "completed"
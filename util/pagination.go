@@ -0,0 +1,59 @@
+package util
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+/*
+keyset（游标）分页：客户端不再传 page_id，而是传上一页返回的 next_page_token。
+token 里记录的是上一页最后一条记录的 ID，下一页查询用 id > last_id 继续往后取，
+这样即使在翻页期间有新数据插入，也不会出现重复或者漏掉的记录。
+token 对客户端来说是不透明的字符串，内部就是 base64 编码后的 JSON。
+*/
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type pageCursor struct {
+	LastID int64 `json:"last_id"`
+}
+
+// EncodePageToken encodes the ID of the last item of a page into an opaque cursor
+func EncodePageToken(lastID int64) string {
+	data, _ := json.Marshal(pageCursor{LastID: lastID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageToken returns the ID stored in the cursor, or 0 for an empty token (the first page)
+func DecodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.LastID <= 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return cursor.LastID, nil
+}
+
+/*
+Paginate 配合“多查一条”的技巧使用：调用方用 pageSize+1 作为 LIMIT 去查询，
+如果真的多拿到了一条，说明后面还有数据，就把多的那条去掉，并用本页最后一条的 ID 生成 next_page_token；
+否则说明已经是最后一页，返回空的 token。
+*/
+func Paginate[T any](items []T, pageSize int32, id func(T) int64) ([]T, string) {
+	if int32(len(items)) <= pageSize {
+		return items, ""
+	}
+
+	items = items[:pageSize]
+	return items, EncodePageToken(id(items[len(items)-1]))
+}
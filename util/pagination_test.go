@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	lastID := RandomInt(1, 1000)
+
+	token := EncodePageToken(lastID)
+	require.NotEmpty(t, token)
+
+	decoded, err := DecodePageToken(token)
+	require.NoError(t, err)
+	require.Equal(t, lastID, decoded)
+
+	decoded, err = DecodePageToken("")
+	require.NoError(t, err)
+	require.Zero(t, decoded)
+
+	_, err = DecodePageToken("not-a-valid-token!")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = DecodePageToken(EncodePageToken(0))
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPaginate(t *testing.T) {
+	items := []int64{1, 2, 3, 4, 5, 6}
+	id := func(item int64) int64 { return item }
+
+	page, next := Paginate(items, 5, id)
+	require.Len(t, page, 5)
+	require.Equal(t, EncodePageToken(5), next)
+
+	page, next = Paginate(items[:5], 5, id)
+	require.Len(t, page, 5)
+	require.Empty(t, next)
+}
@@ -1,14 +1,142 @@
 package util
 
 import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"sync"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// HashPassword returns the bcrypt hash of the password
+// Algorithms of PASSWORD_HASH_ALGORITHM
+const (
+	PasswordHashBcrypt   = "bcrypt"
+	PasswordHashArgon2id = "argon2id"
+)
+
+const (
+	defaultArgon2Time    = 3
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+	argon2SaltLength     = 16
+	argon2KeyLength      = 32
+)
+
+// ErrMismatchedHashAndPassword is returned by CheckPassword when the password is incorrect
+var ErrMismatchedHashAndPassword = bcrypt.ErrMismatchedHashAndPassword
+
+/*
+PasswordHasher 是生成密码哈希用的算法和参数，启动时从配置加载（见 NewPasswordHasher）：
+
+	PASSWORD_HASH_ALGORITHM   bcrypt 或者 argon2id，没有配置时是 bcrypt
+	PASSWORD_BCRYPT_COST      bcrypt 的 cost，没有配置时是 bcrypt.DefaultCost
+	PASSWORD_ARGON2_TIME      argon2id 的迭代次数
+	PASSWORD_ARGON2_MEMORY    argon2id 用的内存，单位 KiB
+	PASSWORD_ARGON2_THREADS   argon2id 的并行度
+
+哈希里记录了算法和参数，CheckPassword 不管哪种哈希都能验证，所以切换算法或者调整参数之后旧的哈希还能用；
+登录成功之后用 PasswordNeedsRehash 判断哈希是不是过时了，过时的话按当前的参数重新生成。
+*/
+type PasswordHasher struct {
+	Algorithm     string
+	BcryptCost    int
+	Argon2Time    uint32
+	Argon2Memory  uint32
+	Argon2Threads uint8
+}
+
+var (
+	passwordHasherMu sync.RWMutex
+	passwordHasher   = PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.DefaultCost}
+)
+
+// NewPasswordHasher builds the password hasher from the config, using defaults for the parameters that are not set
+func NewPasswordHasher(config Config) (PasswordHasher, error) {
+	hasher := PasswordHasher{
+		Algorithm:     config.PasswordHashAlgorithm,
+		BcryptCost:    config.PasswordBcryptCost,
+		Argon2Time:    defaultArgon2Time,
+		Argon2Memory:  defaultArgon2Memory,
+		Argon2Threads: defaultArgon2Threads,
+	}
+	if config.PasswordArgon2Threads > math.MaxUint8 {
+		return hasher, fmt.Errorf("PASSWORD_ARGON2_THREADS must be at most %d", math.MaxUint8)
+	}
+	if hasher.Algorithm == "" {
+		hasher.Algorithm = PasswordHashBcrypt
+	}
+	if hasher.BcryptCost == 0 {
+		hasher.BcryptCost = bcrypt.DefaultCost
+	}
+	if config.PasswordArgon2Time > 0 {
+		hasher.Argon2Time = uint32(config.PasswordArgon2Time)
+	}
+	if config.PasswordArgon2Memory > 0 {
+		hasher.Argon2Memory = uint32(config.PasswordArgon2Memory)
+	}
+	if config.PasswordArgon2Threads > 0 {
+		hasher.Argon2Threads = uint8(config.PasswordArgon2Threads)
+	}
+
+	switch hasher.Algorithm {
+	case PasswordHashBcrypt:
+		if hasher.BcryptCost < bcrypt.MinCost || hasher.BcryptCost > bcrypt.MaxCost {
+			return hasher, fmt.Errorf("PASSWORD_BCRYPT_COST must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	case PasswordHashArgon2id:
+		// argon2 要求内存至少是并行度的 8 倍
+		if hasher.Argon2Memory < 8*uint32(hasher.Argon2Threads) {
+			return hasher, errors.New("PASSWORD_ARGON2_MEMORY must be at least 8 KiB per thread")
+		}
+	default:
+		return hasher, fmt.Errorf("unsupported PASSWORD_HASH_ALGORITHM %q", hasher.Algorithm)
+	}
+	return hasher, nil
+}
+
+// SetPasswordHasher replaces the hasher used by HashPassword and PasswordNeedsRehash
+func SetPasswordHasher(hasher PasswordHasher) {
+	passwordHasherMu.Lock()
+	defer passwordHasherMu.Unlock()
+	passwordHasher = hasher
+}
+
+// CurrentPasswordHasher returns the hasher used by HashPassword and PasswordNeedsRehash
+func CurrentPasswordHasher() PasswordHasher {
+	passwordHasherMu.RLock()
+	defer passwordHasherMu.RUnlock()
+	return passwordHasher
+}
+
+// HashPassword returns the hash of the password using the current password hasher
 func HashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return CurrentPasswordHasher().Hash(password)
+}
+
+// Hash returns the hash of the password using the algorithm and parameters of the hasher
+func (hasher PasswordHasher) Hash(password string) (string, error) {
+	if hasher.Algorithm == PasswordHashArgon2id {
+		salt := make([]byte, argon2SaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return "", fmt.Errorf("failed to hash password: %w", err)
+		}
+		params := argon2Params{
+			time:    hasher.Argon2Time,
+			memory:  hasher.Argon2Memory,
+			threads: hasher.Argon2Threads,
+			salt:    salt,
+		}
+		params.key = params.derive(password, argon2KeyLength)
+		return params.encode(), nil
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), hasher.BcryptCost)
 	if err != nil {
 		return "", fmt.Errorf("failed to hash password: %w", err)
 	}
@@ -17,5 +145,122 @@ func HashPassword(password string) (string, error) {
 
 // CheckPassword checks if the provided password is correct or not
 func CheckPassword(password string, hashedPassword string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	if !strings.HasPrefix(hashedPassword, "$"+PasswordHashArgon2id+"$") {
+		return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	}
+
+	params, err := decodeArgon2(hashedPassword)
+	if err != nil {
+		return err
+	}
+	key := params.derive(password, uint32(len(params.key)))
+	if subtle.ConstantTimeCompare(key, params.key) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+// dummyPassword 是用户不存在时用来比较的哈希，按当前的哈希参数生成，参数变了之后重新生成
+var dummyPassword struct {
+	sync.Mutex
+	hasher PasswordHasher
+	hash   string
+}
+
+// CheckDummyPassword compares the password with a dummy hash using the current parameters,
+// so that a login for an unknown user takes as long as one with a wrong password
+func CheckDummyPassword(password string) {
+	hasher := CurrentPasswordHasher()
+
+	dummyPassword.Lock()
+	if dummyPassword.hash == "" || dummyPassword.hasher != hasher {
+		hash, err := hasher.Hash(RandomString(16))
+		if err != nil {
+			dummyPassword.Unlock()
+			return
+		}
+		dummyPassword.hasher = hasher
+		dummyPassword.hash = hash
+	}
+	hash := dummyPassword.hash
+	dummyPassword.Unlock()
+
+	_ = CheckPassword(password, hash)
+}
+
+// PasswordNeedsRehash reports whether the hash was generated with a different algorithm or parameters than the current password hasher
+func PasswordNeedsRehash(hashedPassword string) bool {
+	hasher := CurrentPasswordHasher()
+
+	if hasher.Algorithm == PasswordHashArgon2id {
+		params, err := decodeArgon2(hashedPassword)
+		if err != nil {
+			return true
+		}
+		return params.time != hasher.Argon2Time ||
+			params.memory != hasher.Argon2Memory ||
+			params.threads != hasher.Argon2Threads ||
+			len(params.key) != argon2KeyLength
+	}
+
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	return err != nil || cost != hasher.BcryptCost
+}
+
+// argon2Params 是 argon2id 哈希里记录的参数，格式和 PHC 字符串一样：
+// $argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>
+type argon2Params struct {
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+func (params argon2Params) derive(password string, keyLength uint32) []byte {
+	return argon2.IDKey([]byte(password), params.salt, params.time, params.memory, params.threads, keyLength)
+}
+
+func (params argon2Params) encode() string {
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		PasswordHashArgon2id,
+		argon2.Version,
+		params.memory,
+		params.time,
+		params.threads,
+		base64.RawStdEncoding.EncodeToString(params.salt),
+		base64.RawStdEncoding.EncodeToString(params.key),
+	)
+}
+
+func decodeArgon2(hashedPassword string) (argon2Params, error) {
+	var params argon2Params
+
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != PasswordHashArgon2id {
+		return params, errors.New("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, errors.New("unsupported argon2id version")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return params, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	if params.time == 0 || params.threads == 0 {
+		return params, errors.New("invalid argon2id parameters")
+	}
+
+	var err error
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return params, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return params, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	if len(params.key) == 0 {
+		return params, errors.New("invalid argon2id key")
+	}
+	return params, nil
 }
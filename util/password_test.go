@@ -1,6 +1,7 @@
 package util
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +27,87 @@ func TestPassword(t *testing.T) {
 	require.NotEmpty(t, hashedPassword2)
 	require.NotEqual(t, hashedPassword1, hashedPassword2)
 }
+
+func TestArgon2idPassword(t *testing.T) {
+	hasher, err := NewPasswordHasher(Config{PasswordHashAlgorithm: PasswordHashArgon2id, PasswordArgon2Memory: 1024})
+	require.NoError(t, err)
+
+	password := RandomString(6)
+	hashedPassword, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(hashedPassword, "$argon2id$v=19$m=1024,t=3,p=4$"))
+
+	require.NoError(t, CheckPassword(password, hashedPassword))
+	require.ErrorIs(t, CheckPassword(RandomString(6), hashedPassword), ErrMismatchedHashAndPassword)
+	require.Error(t, CheckPassword(password, "$argon2id$v=19$m=1024,t=0,p=4$c2FsdA$a2V5"))
+
+	hashedPassword2, err := hasher.Hash(password)
+	require.NoError(t, err)
+	require.NotEqual(t, hashedPassword, hashedPassword2)
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	defaultHasher := CurrentPasswordHasher()
+	defer SetPasswordHasher(defaultHasher)
+
+	password := RandomString(6)
+	bcryptHash, err := PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.MinCost}.Hash(password)
+	require.NoError(t, err)
+
+	argon2Hasher, err := NewPasswordHasher(Config{PasswordHashAlgorithm: PasswordHashArgon2id, PasswordArgon2Memory: 1024})
+	require.NoError(t, err)
+	argon2Hash, err := argon2Hasher.Hash(password)
+	require.NoError(t, err)
+
+	SetPasswordHasher(PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.MinCost})
+	require.False(t, PasswordNeedsRehash(bcryptHash))
+	require.True(t, PasswordNeedsRehash(argon2Hash))
+
+	SetPasswordHasher(PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.MinCost + 1})
+	require.True(t, PasswordNeedsRehash(bcryptHash))
+
+	SetPasswordHasher(argon2Hasher)
+	require.False(t, PasswordNeedsRehash(argon2Hash))
+	require.True(t, PasswordNeedsRehash(bcryptHash))
+
+	argon2Hasher.Argon2Time++
+	SetPasswordHasher(argon2Hasher)
+	require.True(t, PasswordNeedsRehash(argon2Hash))
+
+	// 算法和参数变了之后旧的哈希还能验证
+	require.NoError(t, CheckPassword(password, bcryptHash))
+}
+
+func TestNewPasswordHasher(t *testing.T) {
+	hasher, err := NewPasswordHasher(Config{})
+	require.NoError(t, err)
+	require.Equal(t, PasswordHashBcrypt, hasher.Algorithm)
+	require.Equal(t, bcrypt.DefaultCost, hasher.BcryptCost)
+
+	_, err = NewPasswordHasher(Config{PasswordHashAlgorithm: "md5"})
+	require.ErrorContains(t, err, "unsupported PASSWORD_HASH_ALGORITHM")
+
+	_, err = NewPasswordHasher(Config{PasswordBcryptCost: bcrypt.MaxCost + 1})
+	require.ErrorContains(t, err, "PASSWORD_BCRYPT_COST")
+
+	_, err = NewPasswordHasher(Config{PasswordHashAlgorithm: PasswordHashArgon2id, PasswordArgon2Threads: 256})
+	require.ErrorContains(t, err, "PASSWORD_ARGON2_THREADS")
+}
+
+func TestCheckDummyPassword(t *testing.T) {
+	defaultHasher := CurrentPasswordHasher()
+	defer SetPasswordHasher(defaultHasher)
+
+	SetPasswordHasher(PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.MinCost})
+	CheckDummyPassword(RandomString(6))
+	cost, err := bcrypt.Cost([]byte(dummyPassword.hash))
+	require.NoError(t, err)
+	require.Equal(t, bcrypt.MinCost, cost)
+
+	// 参数变了之后假的哈希也跟着变，耗时和真实用户的哈希一样
+	SetPasswordHasher(PasswordHasher{Algorithm: PasswordHashBcrypt, BcryptCost: bcrypt.MinCost + 1})
+	CheckDummyPassword(RandomString(6))
+	cost, err = bcrypt.Cost([]byte(dummyPassword.hash))
+	require.NoError(t, err)
+	require.Equal(t, bcrypt.MinCost+1, cost)
+}
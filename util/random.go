@@ -43,11 +43,20 @@ func RandomMoney() int64 {
 
 // RandomCurrency generates a random currency code
 func RandomCurrency() string {
-	currencies := []string{"USD", "EUR", "CAD"}
+	currencies := []string{"USD", "EUR", "CAD", "JPY"}
 	n := len(currencies)
 	return currencies[rand.Intn(n)]
 }
 
+// RandomAccountNumber generates a random account number with valid check digits
+func RandomAccountNumber() string {
+	var sb strings.Builder
+	for i := 0; i < AccountNumberLength-4; i++ {
+		sb.WriteByte(byte('0' + rand.Intn(10)))
+	}
+	return withCheckDigits(sb.String())
+}
+
 // RandomEmail generates a random email
 func RandomEmail() string {
 	return fmt.Sprintf("%s@email.com", RandomString(6))
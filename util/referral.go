@@ -0,0 +1,51 @@
+package util
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+)
+
+// Constants for the status of a referral
+const (
+	ReferralPending  = "pending"
+	ReferralCredited = "credited"
+	ReferralRejected = "rejected"
+)
+
+// ReferralCodeLength is the length of the referral codes we issue
+const ReferralCodeLength = 8
+
+// referralCodeAlphabet 去掉了容易看错的 0/O 和 1/I/L
+const referralCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// GenerateReferralCode generates a random referral code with crypto/rand
+func GenerateReferralCode() (string, error) {
+	code := make([]byte, ReferralCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(referralCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = referralCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// NormalizeReferralCode removes spaces and upper-cases a referral code typed by a user
+func NormalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(code), ""))
+}
+
+// IsValidReferralCode returns true if the normalized code could have been issued by GenerateReferralCode
+func IsValidReferralCode(code string) bool {
+	if len(code) != ReferralCodeLength {
+		return false
+	}
+	for _, c := range code {
+		if !strings.ContainsRune(referralCodeAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
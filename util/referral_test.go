@@ -0,0 +1,23 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateReferralCode(t *testing.T) {
+	code1, err := GenerateReferralCode()
+	require.NoError(t, err)
+	require.True(t, IsValidReferralCode(code1))
+
+	code2, err := GenerateReferralCode()
+	require.NoError(t, err)
+	require.NotEqual(t, code1, code2)
+
+	// 用户输入的时候可能带空格或者用小写
+	require.Equal(t, code1, NormalizeReferralCode(" "+code1[:4]+" "+strings.ToLower(code1[4:])))
+	require.False(t, IsValidReferralCode("ABCD"))
+	require.False(t, IsValidReferralCode("ABCDEFG0"))
+}
@@ -3,4 +3,5 @@ package util
 const (
 	DepositorRole = "depositor"
 	BankerRole    = "banker"
+	AdminRole     = "admin"
 )
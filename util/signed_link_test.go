@@ -0,0 +1,55 @@
+package util
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedLink(t *testing.T) {
+	key := RandomString(32)
+	signer := NewLinkSigner(key, LinkPurposeVerifyEmail)
+
+	params := url.Values{}
+	params.Set("email_id", "1")
+	params.Set("secret_code", RandomString(32))
+
+	signed := signer.Sign(params, time.Now().Add(time.Minute))
+	require.NoError(t, signer.Verify(signed))
+
+	// 改了任何参数签名都会失效
+	tampered := url.Values{}
+	for key, values := range signed {
+		tampered[key] = values
+	}
+	tampered.Set("email_id", "2")
+	require.ErrorIs(t, signer.Verify(tampered), ErrInvalidLinkSignature)
+
+	// 用别的密钥签的链接也不行
+	require.ErrorIs(t, NewLinkSigner(RandomString(32), LinkPurposeVerifyEmail).Verify(signed), ErrInvalidLinkSignature)
+
+	// 别的用途的链接也不行
+	require.ErrorIs(t, NewLinkSigner(key, LinkPurposeCancelAccountDeletion).Verify(signed), ErrInvalidLinkSignature)
+
+	// 没有配置密钥的时候谁都能签出链接，一律拒绝
+	emptyKeySigner := NewLinkSigner("", LinkPurposeVerifyEmail)
+	require.ErrorIs(t, emptyKeySigner.Verify(emptyKeySigner.Sign(params, time.Now().Add(time.Minute))), ErrInvalidLinkSignature)
+
+	expired := signer.Sign(params, time.Now().Add(-time.Minute))
+	require.ErrorIs(t, signer.Verify(expired), ErrLinkExpired)
+}
+
+func TestBuildLink(t *testing.T) {
+	params := url.Values{}
+	params.Set("email_id", "1")
+
+	link, err := BuildLink("https://bank.example.com/", "/verify_email", params)
+	require.NoError(t, err)
+	require.Equal(t, "https://bank.example.com/verify_email?email_id=1", link)
+
+	link, err = BuildLink("simplebank://", "verify_email", params)
+	require.NoError(t, err)
+	require.Equal(t, "simplebank://verify_email?email_id=1", link)
+}
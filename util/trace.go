@@ -0,0 +1,77 @@
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+)
+
+// TraceparentHeader 是 W3C Trace Context 的请求头，格式是 00-<trace id>-<span id>-<flags>
+const TraceparentHeader = "traceparent"
+
+// TraceContext identifies the trace of a request and the span of the current service
+type TraceContext struct {
+	TraceID string
+	SpanID  string
+}
+
+type traceContextKey struct{}
+
+/*
+NewTraceContext 为当前服务的这一次处理创建一个 span。
+上游传来了合法的 traceparent 时沿用它的 trace id，日志就能和上游的串起来；
+没有传或者格式不对时生成新的 trace id。
+*/
+func NewTraceContext(traceparent string) TraceContext {
+	traceID, ok := parseTraceparent(traceparent)
+	if !ok {
+		traceID = randomHex(16)
+	}
+	return TraceContext{TraceID: traceID, SpanID: randomHex(8)}
+}
+
+// Traceparent 返回传给下游（以及写回响应头）的 traceparent
+func (trace TraceContext) Traceparent() string {
+	return "00-" + trace.TraceID + "-" + trace.SpanID + "-01"
+}
+
+func ContextWithTrace(ctx context.Context, trace TraceContext) context.Context {
+	return context.WithValue(ctx, traceContextKey{}, trace)
+}
+
+func TraceFromContext(ctx context.Context) (TraceContext, bool) {
+	trace, ok := ctx.Value(traceContextKey{}).(TraceContext)
+	return trace, ok
+}
+
+// parseTraceparent 只接受 version 00，trace id 和 parent id 都不能全是 0
+func parseTraceparent(traceparent string) (string, bool) {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) != 4 || parts[0] != "00" || len(parts[3]) != 2 {
+		return "", false
+	}
+
+	traceID, spanID := strings.ToLower(parts[1]), strings.ToLower(parts[2])
+	if !isHex(traceID, 32) || !isHex(spanID, 16) {
+		return "", false
+	}
+	if traceID == strings.Repeat("0", 32) || spanID == strings.Repeat("0", 16) {
+		return "", false
+	}
+	return traceID, true
+}
+
+func isHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,39 @@
+package util
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTraceContext(t *testing.T) {
+	parent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	trace := NewTraceContext(parent)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID)
+	require.Len(t, trace.SpanID, 16)
+	require.NotEqual(t, "00f067aa0ba902b7", trace.SpanID)
+	require.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-"+trace.SpanID+"-01", trace.Traceparent())
+
+	for _, invalid := range []string{
+		"",
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"00-xyz92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		trace := NewTraceContext(invalid)
+		require.Len(t, trace.TraceID, 32)
+		require.NotEqual(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID)
+	}
+
+	ctx := ContextWithTrace(context.Background(), trace)
+	got, ok := TraceFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, trace, got)
+
+	_, ok = TraceFromContext(context.Background())
+	require.False(t, ok)
+}
@@ -0,0 +1,171 @@
+package val
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/techschool/bank/util"
+)
+
+// Character classes of PASSWORD_REQUIRED_CLASSES
+const (
+	PasswordClassLower  = "lower"
+	PasswordClassUpper  = "upper"
+	PasswordClassDigit  = "digit"
+	PasswordClassSymbol = "symbol"
+)
+
+// PasswordClasses are the accepted values of PASSWORD_REQUIRED_CLASSES
+var PasswordClasses = []string{PasswordClassLower, PasswordClassUpper, PasswordClassDigit, PasswordClassSymbol}
+
+const (
+	defaultPasswordMinLength = 6
+	passwordMaxLength        = 100
+)
+
+/*
+PasswordPolicy 是设置新密码时的规则，启动时从配置加载（见 NewPasswordPolicy）：
+
+	PASSWORD_MIN_LENGTH         最短长度，没有配置时是 6
+	PASSWORD_REQUIRED_CLASSES   必须包含的字符类型，逗号分隔的 lower、upper、digit、symbol
+	PASSWORD_BREACH_LIST_PATH   泄露密码列表的文件，每行一个，不区分大小写，列表里的密码不能使用
+
+只检查新密码（注册、修改密码、创建管理员），登录时不检查，
+这样收紧规则之后，按旧规则设置密码的用户还能登录。
+*/
+type PasswordPolicy struct {
+	MinLength       int
+	RequiredClasses []string
+	breached        map[string]bool
+}
+
+var (
+	passwordPolicyMu sync.RWMutex
+	passwordPolicy   = PasswordPolicy{MinLength: defaultPasswordMinLength}
+)
+
+// NewPasswordPolicy builds the password policy from the config and loads the breach list
+func NewPasswordPolicy(config util.Config) (PasswordPolicy, error) {
+	policy := PasswordPolicy{MinLength: config.PasswordMinLength}
+	if policy.MinLength == 0 {
+		policy.MinLength = defaultPasswordMinLength
+	}
+	if policy.MinLength < 1 || policy.MinLength > passwordMaxLength {
+		return policy, fmt.Errorf("PASSWORD_MIN_LENGTH must be between 1 and %d", passwordMaxLength)
+	}
+
+	for _, class := range strings.Split(config.PasswordRequiredClasses, ",") {
+		class = strings.TrimSpace(class)
+		if class == "" {
+			continue
+		}
+		if !isPasswordClass(class) {
+			return policy, fmt.Errorf("PASSWORD_REQUIRED_CLASSES: unknown character class %q, must be one of %v", class, PasswordClasses)
+		}
+		policy.RequiredClasses = append(policy.RequiredClasses, class)
+	}
+
+	if config.PasswordBreachListPath != "" {
+		breached, err := loadBreachList(config.PasswordBreachListPath)
+		if err != nil {
+			return policy, fmt.Errorf("cannot load PASSWORD_BREACH_LIST_PATH: %w", err)
+		}
+		policy.breached = breached
+	}
+	return policy, nil
+}
+
+func loadBreachList(path string) (map[string]bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	breached := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if password := strings.TrimSpace(scanner.Text()); password != "" {
+			breached[strings.ToLower(password)] = true
+		}
+	}
+	return breached, scanner.Err()
+}
+
+// SetPasswordPolicy replaces the policy used by ValidatePassword
+func SetPasswordPolicy(policy PasswordPolicy) {
+	passwordPolicyMu.Lock()
+	defer passwordPolicyMu.Unlock()
+	passwordPolicy = policy
+}
+
+// CurrentPasswordPolicy returns the policy used by ValidatePassword
+func CurrentPasswordPolicy() PasswordPolicy {
+	passwordPolicyMu.RLock()
+	defer passwordPolicyMu.RUnlock()
+	return passwordPolicy
+}
+
+// ValidatePassword checks a new password against the current password policy
+func ValidatePassword(value string) error {
+	return CurrentPasswordPolicy().Validate(value)
+}
+
+// Validate checks a new password against the policy
+func (policy PasswordPolicy) Validate(value string) error {
+	if err := ValidateString(value, policy.MinLength, passwordMaxLength); err != nil {
+		return err
+	}
+
+	for _, class := range policy.RequiredClasses {
+		if !strings.ContainsFunc(value, passwordClassFunc(class)) {
+			return fmt.Errorf("must contain at least one %s character", class)
+		}
+	}
+
+	if policy.breached[strings.ToLower(value)] {
+		return fmt.Errorf("has appeared in a data breach, choose a different password")
+	}
+	return nil
+}
+
+// PasswordReusedError is the field violation of a new password that matches one of the last historySize passwords
+func PasswordReusedError(historySize int32) error {
+	if historySize <= 1 {
+		return fmt.Errorf("must be different from the current password")
+	}
+	return fmt.Errorf("must be different from your last %d passwords", historySize)
+}
+
+// ValidateLoginPassword 只检查登录时输入的密码的长度，不按密码规则检查
+func ValidateLoginPassword(value string) error {
+	return ValidateString(value, 1, passwordMaxLength)
+}
+
+func isPasswordClass(class string) bool {
+	for _, c := range PasswordClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func passwordClassFunc(class string) func(rune) bool {
+	switch class {
+	case PasswordClassLower:
+		return unicode.IsLower
+	case PasswordClassUpper:
+		return unicode.IsUpper
+	case PasswordClassDigit:
+		return unicode.IsDigit
+	default:
+		return func(r rune) bool {
+			return unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package val
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestNewPasswordPolicy(t *testing.T) {
+	policy, err := NewPasswordPolicy(util.Config{})
+	require.NoError(t, err)
+	require.Equal(t, defaultPasswordMinLength, policy.MinLength)
+	require.Empty(t, policy.RequiredClasses)
+
+	policy, err = NewPasswordPolicy(util.Config{PasswordMinLength: 10, PasswordRequiredClasses: "upper, digit"})
+	require.NoError(t, err)
+	require.Equal(t, 10, policy.MinLength)
+	require.Equal(t, []string{PasswordClassUpper, PasswordClassDigit}, policy.RequiredClasses)
+
+	_, err = NewPasswordPolicy(util.Config{PasswordRequiredClasses: "emoji"})
+	require.ErrorContains(t, err, "unknown character class")
+
+	_, err = NewPasswordPolicy(util.Config{PasswordMinLength: passwordMaxLength + 1})
+	require.ErrorContains(t, err, "PASSWORD_MIN_LENGTH")
+
+	_, err = NewPasswordPolicy(util.Config{PasswordBreachListPath: filepath.Join(t.TempDir(), "missing.txt")})
+	require.ErrorContains(t, err, "PASSWORD_BREACH_LIST_PATH")
+}
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	breachList := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(breachList, []byte("Password1!\n\nqwerty123\n"), 0o600))
+
+	policy, err := NewPasswordPolicy(util.Config{
+		PasswordMinLength:       8,
+		PasswordRequiredClasses: "lower,upper,digit,symbol",
+		PasswordBreachListPath:  breachList,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		password string
+		errMsg   string
+	}{
+		{name: "OK", password: "Secret#2024"},
+		{name: "TooShort", password: "Se#1", errMsg: "must contain from 8-100 characters"},
+		{name: "MissingUpper", password: "secret#2024", errMsg: "must contain at least one upper character"},
+		{name: "MissingDigit", password: "Secret#word", errMsg: "must contain at least one digit character"},
+		{name: "MissingSymbol", password: "Secret2024", errMsg: "must contain at least one symbol character"},
+		{name: "Breached", password: "pASSWORD1!", errMsg: "has appeared in a data breach"},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Validate(tc.password)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestSetPasswordPolicy(t *testing.T) {
+	defaultPolicy := CurrentPasswordPolicy()
+	defer SetPasswordPolicy(defaultPolicy)
+
+	require.NoError(t, ValidatePassword("secret"))
+
+	SetPasswordPolicy(PasswordPolicy{MinLength: 12})
+	require.Error(t, ValidatePassword("secret"))
+	// 登录不按密码规则检查
+	require.NoError(t, ValidateLoginPassword("secret"))
+}
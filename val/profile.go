@@ -0,0 +1,58 @@
+package val
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+/*
+用户资料（UpdateUser 里的 phone_number、date_of_birth 和 address）的规则：
+  - 手机号是 E.164 格式，比如 +14155550123，和通知设置里短信的手机号一样；
+  - 出生日期是 YYYY-MM-DD，开户要求年满 MinimumAge 岁，也不能早于 150 年前；
+  - 手机号和地址可以设成空字符串来清除，出生日期设置之后只能修改，不能清除。
+*/
+
+// MinimumAge is the age a user must have reached to set their date of birth
+const MinimumAge = 18
+
+// DateOfBirthLayout is the format of date_of_birth in requests and responses
+const DateOfBirthLayout = time.DateOnly
+
+var isValidPhoneNumber = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`).MatchString
+
+func ValidatePhoneNumber(value string) error {
+	if value == "" {
+		return nil
+	}
+	if !isValidPhoneNumber(value) {
+		return fmt.Errorf("must be an E.164 phone number, e.g. +14155550123")
+	}
+	return nil
+}
+
+func ValidateAddress(value string) error {
+	return ValidateString(value, 0, 200)
+}
+
+// ValidateDateOfBirth parses a YYYY-MM-DD date of birth and checks the user is at least MinimumAge years old
+func ValidateDateOfBirth(value string) (time.Time, error) {
+	return validateDateOfBirth(value, time.Now())
+}
+
+func validateDateOfBirth(value string, now time.Time) (time.Time, error) {
+	dateOfBirth, err := time.Parse(DateOfBirthLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be a date in YYYY-MM-DD format")
+	}
+
+	// 按日期比较，生日当天就算满岁；2 月 29 日出生的人在平年的 3 月 1 日满岁
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if dateOfBirth.AddDate(MinimumAge, 0, 0).After(today) {
+		return time.Time{}, fmt.Errorf("must be at least %d years old", MinimumAge)
+	}
+	if dateOfBirth.Before(today.AddDate(-150, 0, 0)) {
+		return time.Time{}, fmt.Errorf("must be within the last 150 years")
+	}
+	return dateOfBirth, nil
+}
@@ -0,0 +1,41 @@
+package val
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePhoneNumber(t *testing.T) {
+	for _, value := range []string{"", "+14155550123", "+6421000000", "+861380013800012"} {
+		require.NoError(t, ValidatePhoneNumber(value), value)
+	}
+	for _, value := range []string{"14155550123", "+04155550123", "+1415555", "+1415555012345678", "+1 415 555 0123", "+1-415-555-0123"} {
+		require.Error(t, ValidatePhoneNumber(value), value)
+	}
+}
+
+func TestValidateDateOfBirth(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)
+
+	dateOfBirth, err := validateDateOfBirth("2006-03-01", now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2006, time.March, 1, 0, 0, 0, 0, time.UTC), dateOfBirth)
+
+	_, err = validateDateOfBirth("2006-03-02", now)
+	require.ErrorContains(t, err, "at least 18 years old")
+
+	// 2 月 29 日出生的人在 3 月 1 日满 18 岁
+	_, err = validateDateOfBirth("2006-02-28", now)
+	require.NoError(t, err)
+	_, err = validateDateOfBirth("2004-02-29", time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC))
+	require.ErrorContains(t, err, "at least 18 years old")
+	_, err = validateDateOfBirth("2004-02-29", time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	for _, value := range []string{"", "2006-3-1", "01/03/2006", "2006-02-30", "1850-01-01", "2030-01-01"} {
+		_, err = validateDateOfBirth(value, now)
+		require.Error(t, err, value)
+	}
+}
@@ -1,14 +1,29 @@
 package val
 
 import (
+	"errors"
 	"fmt"
 	"net/mail"
 	"regexp"
+	"strings"
+
+	"github.com/techschool/bank/i18n"
+	"github.com/techschool/bank/util"
 )
 
+/*
+gapi 请求里简单的规则（长度、正则、范围等）写在 proto 的 (validate.rules) 注解里，见 gapi/validation.go。
+这里保留依赖配置（货币、密码规则）、需要计算（routing number 校验位、Luhn、金额解析）
+或者由更新掩码决定是否检查的规则。
+
+用户名、密码、邮箱、姓名、货币和金额这些 Gin 版本的 API 也有的字段，两边都用这里的函数校验，
+同样的输入返回同样的错误信息：gapi 在 validateXRequest 里调用，Gin 通过 binding 标签调用（见 api/validator.go）。
+*/
+
 var (
 	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
 	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidRouting  = regexp.MustCompile(`^[0-9]{9}$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -19,30 +34,26 @@ func ValidateString(value string, minLength int, maxLength int) error {
 	return nil
 }
 
-func ValidateUsername(value string) error {
+func ValidateFullName(value string) error {
 	if err := ValidateString(value, 3, 100); err != nil {
 		return err
 	}
-	if !isValidUsername(value) {
-		return fmt.Errorf("must contain only lowercase letters, digits, or underscore")
+	if !isValidFullName(value) {
+		return fmt.Errorf("must contain only letters or spaces")
 	}
 	return nil
 }
 
-func ValidateFullName(value string) error {
+func ValidateUsername(value string) error {
 	if err := ValidateString(value, 3, 100); err != nil {
 		return err
 	}
-	if !isValidFullName(value) {
-		return fmt.Errorf("must contain only letters or spaces")
+	if !isValidUsername(value) {
+		return fmt.Errorf("must contain only lowercase letters, digits, or underscore")
 	}
 	return nil
 }
 
-func ValidatePassword(value string) error {
-	return ValidateString(value, 6, 100)
-}
-
 func ValidateEmail(value string) error {
 	if err := ValidateString(value, 3, 200); err != nil {
 		return err
@@ -53,13 +64,157 @@ func ValidateEmail(value string) error {
 	return nil
 }
 
-func ValidateEmailId(value int64) error {
+// ValidateRecipient 检查按用户名或邮箱指定的收款人，包含 @ 的按邮箱校验，否则按用户名校验
+func ValidateRecipient(value string) error {
+	if strings.Contains(value, "@") {
+		return ValidateEmail(value)
+	}
+	return ValidateUsername(value)
+}
+
+func ValidatePageToken(value string) error {
+	if _, err := util.DecodePageToken(value); err != nil {
+		return fmt.Errorf("is not a valid page token")
+	}
+	return nil
+}
+
+func ValidateActivityPageToken(value string) error {
+	if _, err := util.DecodeActivityPageToken(value); err != nil {
+		return fmt.Errorf("is not a valid page token")
+	}
+	return nil
+}
+
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("is not a supported currency")
+	}
+	return nil
+}
+
+func ValidateAmount(value int64) error {
 	if value <= 0 {
 		return fmt.Errorf("must be a positive integer")
 	}
 	return nil
 }
 
-func ValidateSecretCode(value string) error {
-	return ValidateString(value, 32, 128)
+// ValidateMoney 检查金额并返回最小货币单位的整数。
+// 金额可以是最小单位的整数 amount，也可以是十进制字符串 amountDecimal（比如 "12.34"），只能传其中一个；
+// 十进制字符串的小数位数不能超过货币允许的位数，比如 JPY 不能有小数。
+func ValidateMoney(amount int64, amountDecimal string, currency string) (int64, error) {
+	if amountDecimal == "" {
+		return amount, ValidateAmount(amount)
+	}
+
+	if amount != 0 {
+		return 0, fmt.Errorf("cannot be used together with amount")
+	}
+
+	money, err := util.ParseMoney(amountDecimal, currency)
+	if err != nil {
+		if errors.Is(err, util.ErrUnsupportedCurrency) {
+			return 0, fmt.Errorf("cannot be parsed without a supported currency")
+		}
+		return 0, fmt.Errorf("is not a valid %s amount", currency)
+	}
+
+	if money.Amount <= 0 {
+		return 0, fmt.Errorf("must be a positive amount")
+	}
+	return money.Amount, nil
+}
+
+// ValidateRoutingNumber 检查 9 位的 ABA routing number，最后一位是校验位：
+// 3*(d1+d4+d7) + 7*(d2+d5+d8) + (d3+d6+d9) 必须是 10 的倍数
+func ValidateRoutingNumber(value string) error {
+	if !isValidRouting(value) {
+		return fmt.Errorf("must contain exactly 9 digits")
+	}
+
+	weights := []int{3, 7, 1}
+	sum := 0
+	for i, c := range value {
+		sum += weights[i%3] * int(c-'0')
+	}
+	if sum%10 != 0 {
+		return fmt.Errorf("has an invalid checksum")
+	}
+	return nil
+}
+
+func ValidateCategory(value string) error {
+	if !util.IsSupportedCategory(value) {
+		return fmt.Errorf("is not a supported category")
+	}
+	return nil
+}
+
+func ValidateCardNumber(value string) error {
+	if len(value) < 12 || len(value) > 19 {
+		return fmt.Errorf("must contain from 12-19 digits")
+	}
+	if !util.IsValidLuhn(value) {
+		return fmt.Errorf("is not a valid card number")
+	}
+	return nil
+}
+
+// ValidateAccountNumber 检查账号的格式和校验位，value 要先用 util.NormalizeAccountNumber 去掉空格
+func ValidateAccountNumber(value string) error {
+	if len(value) != util.AccountNumberLength {
+		return fmt.Errorf("must contain exactly %d characters", util.AccountNumberLength)
+	}
+	if !util.IsValidAccountNumber(value) {
+		return fmt.Errorf("is not a valid account number")
+	}
+	return nil
+}
+
+func ValidateKYCDocumentType(value string) error {
+	if !util.IsSupportedKYCDocumentType(value) {
+		return fmt.Errorf("unsupported KYC document type")
+	}
+	return nil
+}
+
+func ValidateExportKind(value string) error {
+	if !util.IsSupportedExportKind(value) {
+		return fmt.Errorf("must be %s or %s", util.ExportTransfers, util.ExportEntries)
+	}
+	return nil
+}
+
+func ValidateExportFormat(value string) error {
+	if !util.IsSupportedExportFormat(value) {
+		return fmt.Errorf("must be %s, %s, %s or %s", util.ExportCSV, util.ExportOFX, util.ExportQIF, util.ExportCAMT053)
+	}
+	return nil
+}
+
+func ValidateBalanceAlertKind(value string) error {
+	if !util.IsSupportedBalanceAlertKind(value) {
+		return fmt.Errorf("must be %s or %s", util.BalanceAlertLowBalance, util.BalanceAlertLargeDebit)
+	}
+	return nil
+}
+
+func ValidateDisputeStatus(value string) error {
+	if !util.IsSupportedDisputeStatus(value) {
+		return fmt.Errorf("must be one of %s, %s, %s or %s",
+			util.DisputeOpen, util.DisputeUnderReview, util.DisputeRefunded, util.DisputeRejected)
+	}
+	return nil
+}
+
+func ValidateLocale(value string) error {
+	if !i18n.IsSupported(value) {
+		return fmt.Errorf("is not a supported locale")
+	}
+	return nil
+}
+
+func ValidateRejectionReason(value string) error {
+	return ValidateString(value, 1, 200)
 }
@@ -0,0 +1,65 @@
+package version
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+/*
+version 包保存当前二进制的构建信息，这几个变量在构建的时候通过 -ldflags 注入，例如：
+
+	go build -ldflags "-X github.com/techschool/bank/version.Version=v1.2.0 \
+		-X github.com/techschool/bank/version.Commit=$(git rev-parse HEAD) \
+		-X github.com/techschool/bank/version.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)"
+
+Makefile 的 build 目标和 Dockerfile 都是这样构建的。
+用 go run 或者直接 go build 的时候没有注入，Commit 和 BuildDate 退回到 Go 自己写进二进制的 vcs 信息，
+Version 保持 "dev"。
+*/
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// Info is the build information of the running binary
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+	GoVersion string `json:"go_version"`
+}
+
+// Get returns the build information, falling back to the vcs settings recorded by the Go toolchain
+func Get() Info {
+	info := Info{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+	}
+
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.Commit == "" {
+				info.Commit = setting.Value
+			}
+		case "vcs.time":
+			if info.BuildDate == "" {
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+	return info
+}
+
+// UserAgent is sent with outgoing HTTP requests such as webhooks, e.g. "bank/v1.2.0"
+func UserAgent() string {
+	return "bank/" + Version
+}
@@ -0,0 +1,32 @@
+package version
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	info := Get()
+	require.Equal(t, "dev", info.Version)
+	require.Equal(t, runtime.Version(), info.GoVersion)
+	require.Equal(t, "bank/dev", UserAgent())
+}
+
+func TestGetWithLdflags(t *testing.T) {
+	defer func(version, commit, buildDate string) {
+		Version, Commit, BuildDate = version, commit, buildDate
+	}(Version, Commit, BuildDate)
+
+	// 模拟 -ldflags -X 注入的值，注入的值优先于 vcs 信息
+	Version = "v1.2.0"
+	Commit = "0123456789abcdef"
+	BuildDate = "2024-01-02T03:04:05Z"
+
+	info := Get()
+	require.Equal(t, "v1.2.0", info.Version)
+	require.Equal(t, "0123456789abcdef", info.Commit)
+	require.Equal(t, "2024-01-02T03:04:05Z", info.BuildDate)
+	require.Equal(t, "bank/v1.2.0", UserAgent())
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/techschool/bank/util"
+)
+
+/*
+newServerConfig 根据 util.Config 里的 WORKER_* 配置生成 asynq.Config：
+- WORKER_CONCURRENCY：同时处理的任务数，0 表示使用 asynq 的默认值（CPU 核数）；
+- WORKER_CRITICAL_QUEUE_WEIGHT / WORKER_DEFAULT_QUEUE_WEIGHT：队列权重，0 表示使用默认的 10 和 5；
+- WORKER_STRICT_PRIORITY：为 true 时 critical 队列清空之后才会处理 default 队列；
+- WORKER_SHUTDOWN_TIMEOUT：关闭时等待正在处理的任务完成的时间，0 表示使用 asynq 的默认值（8 秒）。
+
+配置不合理（比如负数）时直接返回错误，让服务在启动时就失败，而不是带着错误的配置运行。
+*/
+const (
+	defaultCriticalQueueWeight = 10
+	defaultDefaultQueueWeight  = 5
+)
+
+func newServerConfig(config util.Config) (asynq.Config, error) {
+	if config.WorkerConcurrency < 0 {
+		return asynq.Config{}, fmt.Errorf("WORKER_CONCURRENCY must not be negative: %d", config.WorkerConcurrency)
+	}
+
+	if config.WorkerCriticalQueueWeight < 0 {
+		return asynq.Config{}, fmt.Errorf("WORKER_CRITICAL_QUEUE_WEIGHT must not be negative: %d", config.WorkerCriticalQueueWeight)
+	}
+
+	if config.WorkerDefaultQueueWeight < 0 {
+		return asynq.Config{}, fmt.Errorf("WORKER_DEFAULT_QUEUE_WEIGHT must not be negative: %d", config.WorkerDefaultQueueWeight)
+	}
+
+	if config.WorkerShutdownTimeout < 0 {
+		return asynq.Config{}, fmt.Errorf("WORKER_SHUTDOWN_TIMEOUT must not be negative: %s", config.WorkerShutdownTimeout)
+	}
+
+	criticalWeight := config.WorkerCriticalQueueWeight
+	if criticalWeight == 0 {
+		criticalWeight = defaultCriticalQueueWeight
+	}
+
+	defaultWeight := config.WorkerDefaultQueueWeight
+	if defaultWeight == 0 {
+		defaultWeight = defaultDefaultQueueWeight
+	}
+
+	// StrictPriority 按权重从高到低处理，critical 的权重比 default 低的话就反过来了
+	if config.WorkerStrictPriority && criticalWeight <= defaultWeight {
+		return asynq.Config{}, fmt.Errorf("WORKER_CRITICAL_QUEUE_WEIGHT (%d) must be greater than WORKER_DEFAULT_QUEUE_WEIGHT (%d) when WORKER_STRICT_PRIORITY is enabled",
+			criticalWeight, defaultWeight)
+	}
+
+	return asynq.Config{
+		Concurrency: config.WorkerConcurrency,
+		Queues: map[string]int{
+			QueueCritical: criticalWeight,
+			QueueDefault:  defaultWeight,
+		},
+		StrictPriority:  config.WorkerStrictPriority,
+		ShutdownTimeout: config.WorkerShutdownTimeout,
+		Logger:          NewLogger(),
+	}, nil
+}
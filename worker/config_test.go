@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestNewServerConfig(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input util.Config
+		check func(t *testing.T, config asynq.Config, err error)
+	}{
+		{
+			name:  "Defaults",
+			input: util.Config{},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.NoError(t, err)
+				require.Zero(t, config.Concurrency)
+				require.Equal(t, defaultCriticalQueueWeight, config.Queues[QueueCritical])
+				require.Equal(t, defaultDefaultQueueWeight, config.Queues[QueueDefault])
+				require.False(t, config.StrictPriority)
+			},
+		},
+		{
+			name: "Custom",
+			input: util.Config{
+				WorkerConcurrency:         20,
+				WorkerCriticalQueueWeight: 6,
+				WorkerDefaultQueueWeight:  3,
+				WorkerStrictPriority:      true,
+				WorkerShutdownTimeout:     30 * time.Second,
+			},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 20, config.Concurrency)
+				require.Equal(t, 6, config.Queues[QueueCritical])
+				require.Equal(t, 3, config.Queues[QueueDefault])
+				require.True(t, config.StrictPriority)
+				require.Equal(t, 30*time.Second, config.ShutdownTimeout)
+			},
+		},
+		{
+			name:  "NegativeConcurrency",
+			input: util.Config{WorkerConcurrency: -1},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:  "NegativeQueueWeight",
+			input: util.Config{WorkerDefaultQueueWeight: -5},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:  "NegativeShutdownTimeout",
+			input: util.Config{WorkerShutdownTimeout: -time.Second},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "StrictPriorityWithLowerCriticalWeight",
+			input: util.Config{
+				WorkerCriticalQueueWeight: 1,
+				WorkerDefaultQueueWeight:  5,
+				WorkerStrictPriority:      true,
+			},
+			check: func(t *testing.T, config asynq.Config, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := newServerConfig(tc.input)
+			tc.check(t, config, err)
+		})
+	}
+}
@@ -2,8 +2,14 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/faultinject"
+	"github.com/techschool/bank/util"
 )
 
 /*
@@ -18,24 +24,169 @@ DistributeTaskSendVerifyEmail 方法将验证邮件发送的任务封装成 JSON
 然后通过 asynq.Client 的 EnqueueContext 方法将任务加入队列。
 */
 type TaskDistributor interface {
+	DistributeUnique(
+		ctx context.Context,
+		taskType string,
+		payload interface{},
+		ttl time.Duration,
+		opts ...asynq.Option,
+	) error
 	DistributeTaskSendVerifyEmail(
 		ctx context.Context,
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskAnonymizeUser(
+		ctx context.Context,
+		payload *PayloadAnonymizeUser,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendTransferAlert(
+		ctx context.Context,
+		payload *PayloadSendTransferAlert,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendNotification(
+		ctx context.Context,
+		payload *PayloadSendNotification,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskConfirmPendingOperation(
+		ctx context.Context,
+		payload *PayloadConfirmPendingOperation,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskReversePendingOperation(
+		ctx context.Context,
+		payload *PayloadReversePendingOperation,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskAdvanceExternalTransfer(
+		ctx context.Context,
+		payload *PayloadAdvanceExternalTransfer,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendExternalTransferWebhook(
+		ctx context.Context,
+		payload *PayloadSendExternalTransferWebhook,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendLoanDelinquencyNotice(
+		ctx context.Context,
+		payload *PayloadSendLoanDelinquencyNotice,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendNewDeviceAlert(
+		ctx context.Context,
+		payload *PayloadSendNewDeviceAlert,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendAccountOwnershipNotice(
+		ctx context.Context,
+		payload *PayloadSendAccountOwnershipNotice,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskGenerateExport(
+		ctx context.Context,
+		payload *PayloadGenerateExport,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendDisputeUpdate(
+		ctx context.Context,
+		payload *PayloadSendDisputeUpdate,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendAccountExistsEmail(
+		ctx context.Context,
+		payload *PayloadSendAccountExistsEmail,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskDeleteUser(
+		ctx context.Context,
+		payload *PayloadDeleteUser,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendAccountDeletionEmail(
+		ctx context.Context,
+		payload *PayloadSendAccountDeletionEmail,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
 	client *asynq.Client
 }
 
-func NewRedisTaskDistributor(redisOpt asynq.RedisClientOpt) TaskDistributor {
+func NewRedisTaskDistributor(redisOpt asynq.RedisConnOpt) TaskDistributor {
 	client := asynq.NewClient(redisOpt)
 	return &RedisTaskDistributor{
 		client: client,
 	}
 }
 
+// enqueue adds the task to the queue, the faultinject.EnqueueTask point simulates a Redis outage
+func (distributor *RedisTaskDistributor) enqueue(ctx context.Context, task *asynq.Task) (*asynq.TaskInfo, error) {
+	if err := faultinject.Check(faultinject.EnqueueTask); err != nil {
+		return nil, err
+	}
+	return distributor.client.EnqueueContext(ctx, task)
+}
+
+/*
+newTask 把 payload 包在信封里创建任务（见 payload.go）。ctx 里有 trace 时在信封里加上 traceparent，
+处理任务的时候 tracingMiddleware 沿用它的 trace id，任务的日志就能和分发它的请求串起来。
+*/
+func newTask(ctx context.Context, taskType string, payload interface{}, opts ...asynq.Option) (*asynq.Task, error) {
+	var traceparent string
+	if trace, ok := util.TraceFromContext(ctx); ok {
+		traceparent = trace.Traceparent()
+	}
+
+	data, err := encodeTaskPayload(payload, traceparent)
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(taskType, data, opts...), nil
+}
+
+/*
+DistributeUnique 用 asynq.Unique 分发任务：在 ttl 之内（或者任务处理成功之前），
+同一个队列里任务类型和 payload 都相同的任务只会入队一次。
+比如 CreateUser 被客户端重试时，payload 都是同一个 username，不会给用户发好几封验证邮件。
+所以这里的信封不加 traceparent（见 newTask），否则每个请求的 payload 都不一样。
+
+重复的任务不算错误，只记录日志然后返回 nil，
+否则 CreateUserTx 的 AfterCreate 会因为这个错误把刚创建的用户回滚掉。
+*/
+func (distributor *RedisTaskDistributor) DistributeUnique(
+	ctx context.Context,
+	taskType string,
+	payload interface{},
+	ttl time.Duration,
+	opts ...asynq.Option,
+) error {
+	data, err := encodeTaskPayload(payload, "")
+	if err != nil {
+		return err
+	}
+
+	opts = append(opts, asynq.Unique(ttl))
+	task := asynq.NewTask(taskType, data, opts...)
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		if errors.Is(err, asynq.ErrDuplicateTask) {
+			log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+				Msg("task already enqueued")
+			return nil
+		}
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
 /*
 这部分的主题：
 在一个web服务中实现后台工作处理机制。
@@ -0,0 +1,27 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/faultinject"
+)
+
+func TestDistributeRedisOutage(t *testing.T) {
+	t.Cleanup(faultinject.Reset)
+
+	// 故障在连接 Redis 之前返回，这里的地址不需要真的有 Redis
+	distributor := NewRedisTaskDistributor(asynq.RedisClientOpt{Addr: "localhost:0"})
+
+	faultinject.Enable()
+	require.NoError(t, faultinject.Inject(faultinject.EnqueueTask, faultinject.Fault{}))
+
+	err := distributor.DistributeTaskGenerateExport(context.Background(), &PayloadGenerateExport{ExportJobID: 1})
+	require.ErrorIs(t, err, faultinject.ErrInjected)
+
+	err = distributor.DistributeUnique(context.Background(), TaskSendVerifyEmail, &PayloadSendVerifyEmail{Username: "alice"}, time.Minute)
+	require.ErrorIs(t, err, faultinject.ErrInjected)
+}
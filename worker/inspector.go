@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+/*
+TaskInspector 读取 Redis 里每个队列的统计信息（等待、执行中、重试、归档的任务数，以及今天处理成功和失败的数量），
+管理员可以通过 AdminService.GetWorkerStats 查看，不需要另外部署 asynqmon。
+*/
+type TaskInspector interface {
+	GetQueueStats() ([]QueueStats, error)
+}
+
+type QueueStats struct {
+	Queue          string        `json:"queue"`
+	Size           int           `json:"size"`
+	Pending        int           `json:"pending"`
+	Active         int           `json:"active"`
+	Scheduled      int           `json:"scheduled"`
+	Retry          int           `json:"retry"`
+	Archived       int           `json:"archived"`
+	Completed      int           `json:"completed"`
+	Processed      int           `json:"processed"`
+	Failed         int           `json:"failed"`
+	ProcessedTotal int           `json:"processed_total"`
+	FailedTotal    int           `json:"failed_total"`
+	Latency        time.Duration `json:"latency"`
+	Paused         bool          `json:"paused"`
+}
+
+// FailureRate 返回今天失败的任务占处理过的任务的比例
+func (stats QueueStats) FailureRate() float64 {
+	if stats.Processed == 0 {
+		return 0
+	}
+	return float64(stats.Failed) / float64(stats.Processed)
+}
+
+type RedisTaskInspector struct {
+	inspector *asynq.Inspector
+}
+
+func NewRedisTaskInspector(redisOpt asynq.RedisConnOpt) TaskInspector {
+	return &RedisTaskInspector{
+		inspector: asynq.NewInspector(redisOpt),
+	}
+}
+
+func (inspector *RedisTaskInspector) GetQueueStats() ([]QueueStats, error) {
+	// 只有入队过任务的队列才会出现在 Redis 里
+	queues, err := inspector.inspector.Queues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %w", err)
+	}
+
+	stats := make([]QueueStats, 0, len(queues))
+	for _, queue := range queues {
+		info, err := inspector.inspector.GetQueueInfo(queue)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get info of queue %s: %w", queue, err)
+		}
+
+		stats = append(stats, QueueStats{
+			Queue:          info.Queue,
+			Size:           info.Size,
+			Pending:        info.Pending,
+			Active:         info.Active,
+			Scheduled:      info.Scheduled,
+			Retry:          info.Retry,
+			Archived:       info.Archived,
+			Completed:      info.Completed,
+			Processed:      info.Processed,
+			Failed:         info.Failed,
+			ProcessedTotal: info.ProcessedTotal,
+			FailedTotal:    info.FailedTotal,
+			Latency:        info.Latency,
+			Paused:         info.Paused,
+		})
+	}
+
+	return stats, nil
+}
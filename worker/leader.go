@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/util"
+)
+
+// defaultLeaderTTL 在没有配置 WORKER_LEADER_TTL 时使用
+const defaultLeaderTTL = 15 * time.Second
+
+/*
+LeaderElector 让多个 worker 副本里只有一个是 leader，用来运行只能有一份的组件（比如定时任务调度器）。
+
+实现是 Redis 里的一个锁：SET key id NX PX ttl 抢到锁的副本成为 leader，
+之后每 ttl/3 续期一次；续期失败（锁被别人拿走或者 Redis 不可用）就立刻退位，
+这样即使 leader 和 Redis 断开，锁过期之后也不会同时有两个 leader。
+leader 正常退出时主动删除锁，其他副本在下一次尝试时就能接手，不用等锁过期。
+*/
+type LeaderElector struct {
+	locker leaderLocker
+	key    string
+	id     string
+	ttl    time.Duration
+}
+
+// leaderLocker 是锁的基本操作，测试里用内存实现代替 Redis
+type leaderLocker interface {
+	acquire(ctx context.Context, key string, id string, ttl time.Duration) (bool, error)
+	renew(ctx context.Context, key string, id string, ttl time.Duration) (bool, error)
+	release(ctx context.Context, key string, id string) error
+}
+
+// NewRedisLeaderElector creates a LeaderElector that uses the given Redis key as the lock
+func NewRedisLeaderElector(client redis.UniversalClient, key string, ttl time.Duration) *LeaderElector {
+	return newLeaderElector(&redisLeaderLocker{client: client}, key, ttl)
+}
+
+func newLeaderElector(locker leaderLocker, key string, ttl time.Duration) *LeaderElector {
+	if ttl <= 0 {
+		ttl = defaultLeaderTTL
+	}
+
+	// 日志里能看出是哪个副本拿到了锁，随机后缀区分同一台机器上的多个进程
+	hostname, _ := os.Hostname()
+	return &LeaderElector{
+		locker: locker,
+		key:    key,
+		id:     fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), util.RandomString(6)),
+		ttl:    ttl,
+	}
+}
+
+/*
+Run 一直参与选举直到 ctx 被取消。成为 leader 之后在新的 goroutine 里调用 lead，
+失去 leader 身份时取消传给 lead 的 ctx，并等 lead 返回之后才会重新参与选举。
+lead 自己提前返回也会释放锁，让其他副本接手。
+*/
+func (elector *LeaderElector) Run(ctx context.Context, lead func(ctx context.Context)) {
+	ticker := time.NewTicker(elector.ttl / 3)
+	defer ticker.Stop()
+
+	var stepDown context.CancelFunc
+	var done chan struct{}
+
+	resign := func() {
+		stepDown()
+		<-done
+		stepDown = nil
+
+		// ctx 可能已经取消了，用新的 ctx 删除锁
+		releaseCtx, cancel := context.WithTimeout(context.Background(), elector.ttl)
+		defer cancel()
+		if err := elector.locker.release(releaseCtx, elector.key, elector.id); err != nil {
+			log.Error().Err(err).Str("key", elector.key).Msg("cannot release leader lock")
+		}
+	}
+
+	for {
+		if stepDown == nil {
+			acquired, err := elector.locker.acquire(ctx, elector.key, elector.id, elector.ttl)
+			if err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Str("key", elector.key).Msg("cannot acquire leader lock")
+			}
+			if acquired {
+				log.Info().Str("key", elector.key).Str("id", elector.id).Msg("became leader")
+
+				var leaderCtx context.Context
+				leaderCtx, stepDown = context.WithCancel(ctx)
+				done = make(chan struct{})
+				go func() {
+					defer close(done)
+					lead(leaderCtx)
+				}()
+			}
+		} else {
+			select {
+			case <-done:
+				log.Info().Str("key", elector.key).Msg("leader exited, release leader lock")
+				resign()
+			default:
+				renewed, err := elector.locker.renew(ctx, elector.key, elector.id, elector.ttl)
+				if err != nil && ctx.Err() == nil {
+					log.Error().Err(err).Str("key", elector.key).Msg("cannot renew leader lock")
+				}
+				if !renewed && ctx.Err() == nil {
+					log.Warn().Str("key", elector.key).Msg("lost leadership")
+					resign()
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if stepDown != nil {
+				resign()
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// redisLeaderLocker 只有持有锁的副本才能续期和删除锁，所以续期和删除都用 Lua 脚本先比较 id
+type redisLeaderLocker struct {
+	client redis.UniversalClient
+}
+
+var (
+	renewLeaderLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+	releaseLeaderLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+func (locker *redisLeaderLocker) acquire(ctx context.Context, key string, id string, ttl time.Duration) (bool, error) {
+	return locker.client.SetNX(ctx, key, id, ttl).Result()
+}
+
+func (locker *redisLeaderLocker) renew(ctx context.Context, key string, id string, ttl time.Duration) (bool, error) {
+	n, err := renewLeaderLockScript.Run(ctx, locker.client, []string{key}, id, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+func (locker *redisLeaderLocker) release(ctx context.Context, key string, id string) error {
+	return releaseLeaderLockScript.Run(ctx, locker.client, []string{key}, id).Err()
+}
@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLeaderLocker 是只在测试里用的锁，不检查过期时间
+type memoryLeaderLocker struct {
+	mu      sync.Mutex
+	holders map[string]string
+}
+
+func newMemoryLeaderLocker() *memoryLeaderLocker {
+	return &memoryLeaderLocker{holders: make(map[string]string)}
+}
+
+func (locker *memoryLeaderLocker) acquire(ctx context.Context, key string, id string, ttl time.Duration) (bool, error) {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	if _, ok := locker.holders[key]; ok {
+		return false, nil
+	}
+	locker.holders[key] = id
+	return true, nil
+}
+
+func (locker *memoryLeaderLocker) renew(ctx context.Context, key string, id string, ttl time.Duration) (bool, error) {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	return locker.holders[key] == id, nil
+}
+
+func (locker *memoryLeaderLocker) release(ctx context.Context, key string, id string) error {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	if locker.holders[key] == id {
+		delete(locker.holders, key)
+	}
+	return nil
+}
+
+func (locker *memoryLeaderLocker) holder(key string) string {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	return locker.holders[key]
+}
+
+func (locker *memoryLeaderLocker) steal(key string, id string) {
+	locker.mu.Lock()
+	defer locker.mu.Unlock()
+	locker.holders[key] = id
+}
+
+func TestLeaderElectorOnlyOneLeader(t *testing.T) {
+	locker := newMemoryLeaderLocker()
+	ttl := 30 * time.Millisecond
+
+	var leaders, maxLeaders int32
+	lead := func(ctx context.Context) {
+		n := atomic.AddInt32(&leaders, 1)
+		for {
+			old := atomic.LoadInt32(&maxLeaders)
+			if n <= old || atomic.CompareAndSwapInt32(&maxLeaders, old, n) {
+				break
+			}
+		}
+		<-ctx.Done()
+		atomic.AddInt32(&leaders, -1)
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	done1 := make(chan struct{})
+	go func() {
+		defer close(done1)
+		newLeaderElector(locker, "leader", ttl).Run(ctx1, lead)
+	}()
+
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&leaders) == 1 }, time.Second, 5*time.Millisecond)
+
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	elector2 := newLeaderElector(locker, "leader", ttl)
+	done2 := make(chan struct{})
+	go func() {
+		defer close(done2)
+		elector2.Run(ctx2, lead)
+	}()
+
+	// 第一个副本退出之后释放锁，第二个副本接手
+	time.Sleep(3 * ttl)
+	cancel1()
+	<-done1
+
+	require.Eventually(t, func() bool { return locker.holder("leader") == elector2.id }, time.Second, 5*time.Millisecond)
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&leaders) == 1 }, time.Second, 5*time.Millisecond)
+
+	cancel2()
+	<-done2
+	require.Equal(t, int32(0), atomic.LoadInt32(&leaders))
+	require.Equal(t, int32(1), atomic.LoadInt32(&maxLeaders))
+	require.Empty(t, locker.holder("leader"))
+}
+
+func TestLeaderElectorStepsDownWhenLockIsLost(t *testing.T) {
+	locker := newMemoryLeaderLocker()
+	elector := newLeaderElector(locker, "leader", 30*time.Millisecond)
+
+	stopped := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go elector.Run(ctx, func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	require.Eventually(t, func() bool { return locker.holder("leader") == elector.id }, time.Second, 5*time.Millisecond)
+
+	// 锁过期之后被别的副本拿走，续期失败时要停止 lead
+	locker.steal("leader", "other")
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("leader did not step down after losing the lock")
+	}
+
+	// 不能删除别人的锁
+	require.Equal(t, "other", locker.holder("leader"))
+}
@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/rs/zerolog/log"
+	"github.com/techschool/bank/util"
+)
+
+/*
+taskMiddlewares 是 Start 用 mux.Use 给所有任务加上的中间件，顺序和 gapi 的拦截器对应（从外到内）：
+  - tracingMiddleware：沿用分发任务时的 trace（见 newTask），为这次处理生成一个 span；
+  - loggerMiddleware：每个任务一个 logger，带着 task_id、type、queue、retry 和 trace_id，
+    处理函数用 log.Ctx(ctx) 记录日志，失败的任务在这里记录错误和 payload；
+  - metricsMiddleware：把处理时间和结果记录到 Prometheus；
+  - recoveryMiddleware：捕获处理函数里的 panic，记录调用栈之后作为普通的错误返回，任务按 asynq 的规则重试。
+*/
+func taskMiddlewares() []asynq.MiddlewareFunc {
+	return []asynq.MiddlewareFunc{
+		tracingMiddleware,
+		loggerMiddleware,
+		metricsMiddleware,
+		recoveryMiddleware,
+	}
+}
+
+/*
+任务的统计数据在 METRICS_SERVER_ADDRESS 的 /metrics 上（见 gapi.RegisterMetricsHandlers）：
+  - worker_task_duration_seconds：按任务类型统计处理时间的直方图
+  - worker_tasks_total：按任务类型和 result 统计处理次数，result 是 ok、error 或 panic
+*/
+var (
+	taskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "worker_task_duration_seconds",
+		Help:    "Latency of processed tasks.",
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"type"})
+	taskTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "worker_tasks_total",
+		Help: "Number of processed tasks by result.",
+	}, []string{"type", "result"})
+)
+
+// errTaskPanic 是 recoveryMiddleware 返回的错误，metricsMiddleware 用它区分 panic 和普通的错误
+var errTaskPanic = errors.New("task panicked")
+
+/*
+tracingMiddleware 读取任务信封里的 traceparent，和 gapi.TracingInterceptor 一样生成新的 span 放进 ctx，
+任务的日志就能和分发它的请求用 trace_id 串起来。定时任务和旧的任务没有 traceparent，生成新的 trace id。
+*/
+func tracingMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		// payload 格式不对时由处理函数返回错误，这里不处理
+		envelope, _ := parseTaskEnvelope(task.Payload())
+
+		trace := util.NewTraceContext(envelope.Traceparent)
+		return handler.ProcessTask(util.ContextWithTrace(ctx, trace), task)
+	})
+}
+
+func loggerMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		logContext := log.With().Str("type", task.Type())
+		if taskID, ok := asynq.GetTaskID(ctx); ok {
+			logContext = logContext.Str("task_id", taskID)
+		}
+		if queue, ok := asynq.GetQueueName(ctx); ok {
+			logContext = logContext.Str("queue", queue)
+		}
+		if retryCount, ok := asynq.GetRetryCount(ctx); ok {
+			logContext = logContext.Int("retry", retryCount)
+		}
+		if maxRetry, ok := asynq.GetMaxRetry(ctx); ok {
+			logContext = logContext.Int("max_retry", maxRetry)
+		}
+		if trace, ok := util.TraceFromContext(ctx); ok {
+			logContext = logContext.Str("trace_id", trace.TraceID)
+		}
+		logger := logContext.Logger()
+
+		startTime := time.Now()
+		err := handler.ProcessTask(logger.WithContext(ctx), task)
+
+		// 成功的任务由处理函数自己记录结果，这里只记录耗时
+		if err != nil {
+			logger.Error().Err(err).Bytes("payload", task.Payload()).
+				Dur("duration", time.Since(startTime)).Msg("process task failed")
+		} else {
+			logger.Debug().Dur("duration", time.Since(startTime)).Msg("finished task")
+		}
+		return err
+	})
+}
+
+func metricsMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) error {
+		startTime := time.Now()
+		err := handler.ProcessTask(ctx, task)
+
+		result := "ok"
+		switch {
+		case errors.Is(err, errTaskPanic):
+			result = "panic"
+		case err != nil:
+			result = "error"
+		}
+		taskDuration.WithLabelValues(task.Type()).Observe(time.Since(startTime).Seconds())
+		taskTotal.WithLabelValues(task.Type(), result).Inc()
+		return err
+	})
+}
+
+// recoveryMiddleware 和 asynq 自己的 panic 恢复一样让任务重试，但是日志带着任务的字段，metrics 也能统计到
+func recoveryMiddleware(handler asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, task *asynq.Task) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				log.Ctx(ctx).Error().
+					Interface("panic", r).
+					Str("stack", string(debug.Stack())).
+					Msg("recovered from panic")
+				err = fmt.Errorf("%w: %v", errTaskPanic, r)
+			}
+		}()
+
+		return handler.ProcessTask(ctx, task)
+	})
+}
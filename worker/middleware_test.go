@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func chainMiddlewares(handler asynq.HandlerFunc) asynq.Handler {
+	middlewares := taskMiddlewares()
+	var h asynq.Handler = handler
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+func TestNewTask(t *testing.T) {
+	payload := &PayloadAnonymizeUser{Username: util.RandomOwner()}
+
+	// 没有 trace 时信封里没有 traceparent，DistributeUnique 的唯一性不受影响
+	task, err := newTask(context.Background(), TaskAnonymizeUser, payload)
+	require.NoError(t, err)
+	require.NotContains(t, string(task.Payload()), "traceparent")
+
+	trace := util.NewTraceContext("")
+	task, err = newTask(util.ContextWithTrace(context.Background(), trace), TaskAnonymizeUser, payload)
+	require.NoError(t, err)
+
+	var decoded PayloadAnonymizeUser
+	require.NoError(t, decodeTaskPayload(task.Payload(), &decoded))
+	require.Equal(t, *payload, decoded)
+
+	var processedTrace util.TraceContext
+	handler := chainMiddlewares(func(ctx context.Context, task *asynq.Task) error {
+		processedTrace, _ = util.TraceFromContext(ctx)
+		return nil
+	})
+	require.NoError(t, handler.ProcessTask(context.Background(), task))
+	require.Equal(t, trace.TraceID, processedTrace.TraceID)
+	require.NotEqual(t, trace.SpanID, processedTrace.SpanID)
+}
+
+func TestTaskMiddlewares(t *testing.T) {
+	taskType := "task:test_" + util.RandomString(6)
+	task := asynq.NewTask(taskType, nil)
+
+	handler := chainMiddlewares(func(ctx context.Context, task *asynq.Task) error {
+		// 定时任务没有 traceparent，也会生成一个 trace
+		_, ok := util.TraceFromContext(ctx)
+		require.True(t, ok)
+		return nil
+	})
+	require.NoError(t, handler.ProcessTask(context.Background(), task))
+
+	errFailed := errors.New("failed")
+	handler = chainMiddlewares(func(ctx context.Context, task *asynq.Task) error {
+		return errFailed
+	})
+	require.ErrorIs(t, handler.ProcessTask(context.Background(), task), errFailed)
+
+	// panic 不会让 worker 退出，作为错误返回之后任务会重试
+	handler = chainMiddlewares(func(ctx context.Context, task *asynq.Task) error {
+		panic("boom")
+	})
+	err := handler.ProcessTask(context.Background(), task)
+	require.ErrorIs(t, err, errTaskPanic)
+	require.NotErrorIs(t, err, asynq.SkipRetry)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(taskTotal.WithLabelValues(taskType, "ok")))
+	require.Equal(t, float64(1), testutil.ToFloat64(taskTotal.WithLabelValues(taskType, "error")))
+	require.Equal(t, float64(1), testutil.ToFloat64(taskTotal.WithLabelValues(taskType, "panic")))
+}
@@ -7,6 +7,7 @@ package mockwk
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	asynq "github.com/hibiken/asynq"
@@ -36,6 +37,291 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTaskAdvanceExternalTransfer mocks base method.
+func (m *MockTaskDistributor) DistributeTaskAdvanceExternalTransfer(arg0 context.Context, arg1 *worker.PayloadAdvanceExternalTransfer, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskAdvanceExternalTransfer", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskAdvanceExternalTransfer indicates an expected call of DistributeTaskAdvanceExternalTransfer.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskAdvanceExternalTransfer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskAdvanceExternalTransfer", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskAdvanceExternalTransfer), varargs...)
+}
+
+// DistributeTaskAnonymizeUser mocks base method.
+func (m *MockTaskDistributor) DistributeTaskAnonymizeUser(arg0 context.Context, arg1 *worker.PayloadAnonymizeUser, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskAnonymizeUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskAnonymizeUser indicates an expected call of DistributeTaskAnonymizeUser.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskAnonymizeUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskAnonymizeUser", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskAnonymizeUser), varargs...)
+}
+
+// DistributeTaskConfirmPendingOperation mocks base method.
+func (m *MockTaskDistributor) DistributeTaskConfirmPendingOperation(arg0 context.Context, arg1 *worker.PayloadConfirmPendingOperation, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskConfirmPendingOperation", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskConfirmPendingOperation indicates an expected call of DistributeTaskConfirmPendingOperation.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskConfirmPendingOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskConfirmPendingOperation", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskConfirmPendingOperation), varargs...)
+}
+
+// DistributeTaskDeleteUser mocks base method.
+func (m *MockTaskDistributor) DistributeTaskDeleteUser(arg0 context.Context, arg1 *worker.PayloadDeleteUser, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskDeleteUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskDeleteUser indicates an expected call of DistributeTaskDeleteUser.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskDeleteUser(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskDeleteUser", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskDeleteUser), varargs...)
+}
+
+// DistributeTaskGenerateExport mocks base method.
+func (m *MockTaskDistributor) DistributeTaskGenerateExport(arg0 context.Context, arg1 *worker.PayloadGenerateExport, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskGenerateExport", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskGenerateExport indicates an expected call of DistributeTaskGenerateExport.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskGenerateExport(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskGenerateExport", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskGenerateExport), varargs...)
+}
+
+// DistributeTaskReversePendingOperation mocks base method.
+func (m *MockTaskDistributor) DistributeTaskReversePendingOperation(arg0 context.Context, arg1 *worker.PayloadReversePendingOperation, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskReversePendingOperation", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskReversePendingOperation indicates an expected call of DistributeTaskReversePendingOperation.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskReversePendingOperation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskReversePendingOperation", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskReversePendingOperation), varargs...)
+}
+
+// DistributeTaskSendAccountDeletionEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendAccountDeletionEmail(arg0 context.Context, arg1 *worker.PayloadSendAccountDeletionEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendAccountDeletionEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendAccountDeletionEmail indicates an expected call of DistributeTaskSendAccountDeletionEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendAccountDeletionEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendAccountDeletionEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendAccountDeletionEmail), varargs...)
+}
+
+// DistributeTaskSendAccountExistsEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendAccountExistsEmail(arg0 context.Context, arg1 *worker.PayloadSendAccountExistsEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendAccountExistsEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendAccountExistsEmail indicates an expected call of DistributeTaskSendAccountExistsEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendAccountExistsEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendAccountExistsEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendAccountExistsEmail), varargs...)
+}
+
+// DistributeTaskSendAccountOwnershipNotice mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendAccountOwnershipNotice(arg0 context.Context, arg1 *worker.PayloadSendAccountOwnershipNotice, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendAccountOwnershipNotice", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendAccountOwnershipNotice indicates an expected call of DistributeTaskSendAccountOwnershipNotice.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendAccountOwnershipNotice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendAccountOwnershipNotice", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendAccountOwnershipNotice), varargs...)
+}
+
+// DistributeTaskSendDisputeUpdate mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendDisputeUpdate(arg0 context.Context, arg1 *worker.PayloadSendDisputeUpdate, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendDisputeUpdate", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendDisputeUpdate indicates an expected call of DistributeTaskSendDisputeUpdate.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendDisputeUpdate(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendDisputeUpdate", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendDisputeUpdate), varargs...)
+}
+
+// DistributeTaskSendExternalTransferWebhook mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendExternalTransferWebhook(arg0 context.Context, arg1 *worker.PayloadSendExternalTransferWebhook, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendExternalTransferWebhook", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendExternalTransferWebhook indicates an expected call of DistributeTaskSendExternalTransferWebhook.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendExternalTransferWebhook(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendExternalTransferWebhook", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendExternalTransferWebhook), varargs...)
+}
+
+// DistributeTaskSendLoanDelinquencyNotice mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendLoanDelinquencyNotice(arg0 context.Context, arg1 *worker.PayloadSendLoanDelinquencyNotice, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendLoanDelinquencyNotice", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendLoanDelinquencyNotice indicates an expected call of DistributeTaskSendLoanDelinquencyNotice.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendLoanDelinquencyNotice(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendLoanDelinquencyNotice", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendLoanDelinquencyNotice), varargs...)
+}
+
+// DistributeTaskSendNewDeviceAlert mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendNewDeviceAlert(arg0 context.Context, arg1 *worker.PayloadSendNewDeviceAlert, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendNewDeviceAlert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendNewDeviceAlert indicates an expected call of DistributeTaskSendNewDeviceAlert.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendNewDeviceAlert(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendNewDeviceAlert", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendNewDeviceAlert), varargs...)
+}
+
+// DistributeTaskSendNotification mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendNotification(arg0 context.Context, arg1 *worker.PayloadSendNotification, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendNotification", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendNotification indicates an expected call of DistributeTaskSendNotification.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendNotification(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendNotification", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendNotification), varargs...)
+}
+
+// DistributeTaskSendTransferAlert mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendTransferAlert(arg0 context.Context, arg1 *worker.PayloadSendTransferAlert, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendTransferAlert", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendTransferAlert indicates an expected call of DistributeTaskSendTransferAlert.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendTransferAlert(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendTransferAlert", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendTransferAlert), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
@@ -54,3 +340,22 @@ func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendVerifyEmail(arg0, a
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendVerifyEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendVerifyEmail), varargs...)
 }
+
+// DistributeUnique mocks base method.
+func (m *MockTaskDistributor) DistributeUnique(arg0 context.Context, arg1 string, arg2 interface{}, arg3 time.Duration, arg4 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeUnique", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeUnique indicates an expected call of DistributeUnique.
+func (mr *MockTaskDistributorMockRecorder) DistributeUnique(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeUnique", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeUnique), varargs...)
+}
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+/*
+CheckNewDevice 在登录成功之后记录这次登录的设备指纹（User-Agent 加上 IP 所在的网段，见 util.DeviceFingerprint）。
+如果用户以前登录过、但是没见过这个指纹，就分发一个 TaskSendNewDeviceAlert 任务给用户发安全提醒。
+用户第一次登录（或者功能上线之后第一次登录）时还没有任何设备记录，这时只记录设备，不发提醒。
+
+gapi 的 LoginUser、第三方登录和 Gin 版本的 loginUser 都在创建会话之后调用它。
+会话已经创建好了，所以这里出错只记录日志，不影响登录的结果。
+*/
+func CheckNewDevice(ctx context.Context, store db.Store, taskDistributor TaskDistributor, session db.Session) {
+	fingerprint := util.DeviceFingerprint(session.UserAgent, session.ClientIp)
+
+	device, err := store.GetUserDevice(ctx, db.GetUserDeviceParams{
+		Username:    session.Username,
+		Fingerprint: fingerprint,
+	})
+	if err == nil {
+		err = store.TouchUserDevice(ctx, db.TouchUserDeviceParams{
+			ID:        device.ID,
+			UserAgent: session.UserAgent,
+			ClientIp:  session.ClientIp,
+		})
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("device_id", device.ID).Msg("failed to update user device")
+		}
+		return
+	}
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		log.Ctx(ctx).Error().Err(err).Str("username", session.Username).Msg("failed to get user device")
+		return
+	}
+
+	knownDevices, err := store.CountUserDevices(ctx, session.Username)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("username", session.Username).Msg("failed to count user devices")
+		return
+	}
+
+	device, err = store.CreateUserDevice(ctx, db.CreateUserDeviceParams{
+		Username:    session.Username,
+		Fingerprint: fingerprint,
+		UserAgent:   session.UserAgent,
+		ClientIp:    session.ClientIp,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("username", session.Username).Msg("failed to create user device")
+		return
+	}
+
+	if knownDevices == 0 {
+		return
+	}
+
+	taskPayload := &PayloadSendNewDeviceAlert{
+		Username:  session.Username,
+		SessionID: session.ID,
+		DeviceID:  device.ID,
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(5),
+		asynq.Queue(QueueCritical),
+	}
+
+	err = taskDistributor.DistributeTaskSendNewDeviceAlert(ctx, taskPayload, opts...)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Str("session_id", session.ID.String()).Msg("failed to distribute new device alert")
+	}
+}
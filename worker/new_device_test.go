@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+// newDeviceAlertRecorder 记录分发的新设备提醒，err 不为空时模拟分发失败
+type newDeviceAlertRecorder struct {
+	TaskDistributor
+	err      error
+	payloads []*PayloadSendNewDeviceAlert
+}
+
+func (recorder *newDeviceAlertRecorder) DistributeTaskSendNewDeviceAlert(ctx context.Context, payload *PayloadSendNewDeviceAlert, opts ...asynq.Option) error {
+	recorder.payloads = append(recorder.payloads, payload)
+	return recorder.err
+}
+
+func TestCheckNewDevice(t *testing.T) {
+	user := db.User{Username: util.RandomOwner()}
+	session := db.Session{
+		ID:        uuid.New(),
+		Username:  user.Username,
+		UserAgent: "Mozilla/5.0",
+		ClientIp:  "203.0.113.7:54321",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	fingerprint := util.DeviceFingerprint(session.UserAgent, session.ClientIp)
+	device := db.UserDevice{
+		ID:          util.RandomInt(1, 1000),
+		Username:    user.Username,
+		Fingerprint: fingerprint,
+	}
+
+	alert := &PayloadSendNewDeviceAlert{
+		Username:  user.Username,
+		SessionID: session.ID,
+		DeviceID:  device.ID,
+	}
+
+	testCases := []struct {
+		name          string
+		distributeErr error
+		buildStubs    func(store *mockdb.MockStore)
+		alerts        []*PayloadSendNewDeviceAlert
+	}{
+		{
+			name: "KnownDevice",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserDevice(gomock.Any(), gomock.Eq(db.GetUserDeviceParams{Username: user.Username, Fingerprint: fingerprint})).
+					Times(1).
+					Return(device, nil)
+				store.EXPECT().
+					TouchUserDevice(gomock.Any(), gomock.Eq(db.TouchUserDeviceParams{ID: device.ID, UserAgent: session.UserAgent, ClientIp: session.ClientIp})).
+					Times(1).
+					Return(nil)
+				store.EXPECT().CreateUserDevice(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "NewDevice",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{}, db.ErrRecordNotFound)
+				store.EXPECT().CountUserDevices(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(int64(2), nil)
+				store.EXPECT().
+					CreateUserDevice(gomock.Any(), gomock.Eq(db.CreateUserDeviceParams{
+						Username:    user.Username,
+						Fingerprint: fingerprint,
+						UserAgent:   session.UserAgent,
+						ClientIp:    session.ClientIp,
+					})).
+					Times(1).
+					Return(device, nil)
+			},
+			alerts: []*PayloadSendNewDeviceAlert{alert},
+		},
+		{
+			name: "FirstDevice",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{}, db.ErrRecordNotFound)
+				store.EXPECT().CountUserDevices(gomock.Any(), gomock.Any()).Times(1).Return(int64(0), nil)
+				store.EXPECT().CreateUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(device, nil)
+			},
+		},
+		{
+			name:          "DistributeError",
+			distributeErr: errors.New("redis is down"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{}, db.ErrRecordNotFound)
+				store.EXPECT().CountUserDevices(gomock.Any(), gomock.Any()).Times(1).Return(int64(1), nil)
+				store.EXPECT().CreateUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(device, nil)
+			},
+			alerts: []*PayloadSendNewDeviceAlert{alert},
+		},
+		{
+			name: "GetDeviceError",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserDevice(gomock.Any(), gomock.Any()).Times(1).Return(db.UserDevice{}, errors.New("db is down"))
+				store.EXPECT().CreateUserDevice(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			// 出错只记录日志，不会影响登录
+			recorder := &newDeviceAlertRecorder{err: tc.distributeErr}
+			CheckNewDevice(context.Background(), store, recorder, session)
+			require.Equal(t, tc.alerts, recorder.payloads)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+)
+
+/*
+任务的 payload 都包在 taskEnvelope 里：{"version":1,"traceparent":"...","payload":{...}}。
+部署的时候队列里还有旧版本分发的任务，新的 worker 也要能处理，所以 payload 的格式带着版本：
+  - 只加字段不用改版本，旧任务里没有的字段是零值；
+  - 改字段的名字、类型或者含义时，给 payload 实现 versionedPayload，把版本加一，
+    并在 upgradePayload 里把旧版本的数据转换成新的格式；
+  - 在 testdata/payloads 里加上新版本的样例，旧版本的样例不要删，TestPayloadCompatibility 检查它们都还能解析。
+
+加上信封之前分发的任务是 payload 本身（可能带着 traceparent 字段），当作版本 0，格式和版本 1 一样。
+比 worker 知道的版本新的任务（滚动部署时旧的 worker 拿到新的 worker 分发的任务）返回普通的错误，
+任务重试的时候一般会交给已经更新的 worker。
+*/
+type taskEnvelope struct {
+	Version     int             `json:"version"`
+	Traceparent string          `json:"traceparent,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+// defaultPayloadVersion 是没有实现 versionedPayload 的 payload 的版本
+const defaultPayloadVersion = 1
+
+// versionedPayload 由改过格式的 payload 实现
+type versionedPayload interface {
+	// payloadVersion 返回 payload 现在的版本，分发任务时写进信封
+	payloadVersion() int
+	// upgradePayload 解析 version 版本的 data，version 比 payloadVersion 小
+	upgradePayload(version int, data json.RawMessage) error
+}
+
+var errPayloadVersion = errors.New("unsupported payload version")
+
+func payloadVersion(payload interface{}) int {
+	if versioned, ok := payload.(versionedPayload); ok {
+		return versioned.payloadVersion()
+	}
+	return defaultPayloadVersion
+}
+
+// encodeTaskPayload 把 payload 包在当前版本的信封里，traceparent 为空时不写
+func encodeTaskPayload(payload interface{}, traceparent string) ([]byte, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	envelope, err := json.Marshal(taskEnvelope{
+		Version:     payloadVersion(payload),
+		Traceparent: traceparent,
+		Payload:     data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task envelope: %w", err)
+	}
+	return envelope, nil
+}
+
+// parseTaskEnvelope 解析信封，没有信封的旧任务返回版本 0 和原来的数据
+func parseTaskEnvelope(data []byte) (taskEnvelope, error) {
+	var envelope struct {
+		Version     *int            `json:"version"`
+		Traceparent string          `json:"traceparent"`
+		Payload     json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return taskEnvelope{}, err
+	}
+	if envelope.Version == nil || envelope.Payload == nil {
+		return taskEnvelope{Version: 0, Traceparent: envelope.Traceparent, Payload: data}, nil
+	}
+	return taskEnvelope{
+		Version:     *envelope.Version,
+		Traceparent: envelope.Traceparent,
+		Payload:     envelope.Payload,
+	}, nil
+}
+
+/*
+decodeTaskPayload 把任务的 payload 解析到 payload 里，旧版本的数据交给 upgradePayload 转换。
+格式不对的 payload 重试也没用，返回的错误包着 asynq.SkipRetry；版本比 worker 新的返回 errPayloadVersion，任务会重试。
+*/
+func decodeTaskPayload(data []byte, payload interface{}) error {
+	envelope, err := parseTaskEnvelope(data)
+	if err != nil {
+		return fmt.Errorf("invalid task payload: %s: %w", err, asynq.SkipRetry)
+	}
+
+	version := envelope.Version
+	if version < 0 {
+		return fmt.Errorf("invalid task payload version %d: %w", version, asynq.SkipRetry)
+	}
+	if version == 0 {
+		version = 1
+	}
+
+	current := payloadVersion(payload)
+	switch {
+	case version > current:
+		return fmt.Errorf("%w: %d, the latest known version is %d", errPayloadVersion, version, current)
+	case version < current:
+		err = payload.(versionedPayload).upgradePayload(version, envelope.Payload)
+	default:
+		err = json.Unmarshal(envelope.Payload, payload)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid task payload of version %d: %s: %w", version, err, asynq.SkipRetry)
+	}
+	return nil
+}
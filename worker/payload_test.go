@@ -0,0 +1,241 @@
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+var sampleTime = time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+
+/*
+payloadSamples 是每种任务的 payload 样例。testdata/payloads 里的 <任务名>.v<版本>.json 是这个样例在各个版本的编码，
+v0 是加上信封之前的格式。改了 payload 的格式之后，旧版本的文件解析出来还要等于这里的样例。
+*/
+var payloadSamples = map[string]interface{}{
+	TaskAdvanceExternalTransfer:  &PayloadAdvanceExternalTransfer{ExternalTransferID: 7, Status: "submitted"},
+	TaskAnonymizeUser:            &PayloadAnonymizeUser{Username: "alice"},
+	TaskConfirmPendingOperation:  &PayloadConfirmPendingOperation{OperationID: 12},
+	TaskDeleteUser:               &PayloadDeleteUser{Username: "alice", ScheduledAt: sampleTime},
+	TaskGenerateExport:           &PayloadGenerateExport{ExportJobID: 3},
+	TaskReversePendingOperation:  &PayloadReversePendingOperation{OperationID: 12, Reason: "expired"},
+	TaskSendAccountDeletionEmail: &PayloadSendAccountDeletionEmail{Username: "alice", ScheduledAt: sampleTime},
+	TaskSendAccountExistsEmail: &PayloadSendAccountExistsEmail{
+		Email:             "alice@example.com",
+		AttemptedUsername: "alice2",
+		ClientIp:          "203.0.113.7",
+		Country:           "NZ",
+		City:              "Auckland",
+	},
+	TaskSendAccountOwnershipNotice: &PayloadSendAccountOwnershipNotice{
+		Username:      "bob",
+		AccountID:     42,
+		Currency:      "USD",
+		PreviousOwner: "alice",
+		NewOwner:      "bob",
+		TransferredAt: sampleTime,
+	},
+	TaskSendDisputeUpdate:           &PayloadSendDisputeUpdate{DisputeID: 5, Status: "resolved", UpdatedAt: sampleTime},
+	TaskSendExternalTransferWebhook: &PayloadSendExternalTransferWebhook{ExternalTransferID: 7, Status: "settled"},
+	TaskSendLoanDelinquencyNotice:   &PayloadSendLoanDelinquencyNotice{LoanID: 9, PaymentID: 31},
+	TaskSendNewDeviceAlert: &PayloadSendNewDeviceAlert{
+		Username:  "alice",
+		SessionID: uuid.MustParse("0b5e5f5c-3a1d-4d0e-9a43-1c1f6c6c2f10"),
+		DeviceID:  4,
+	},
+	TaskSendNotification: &PayloadSendNotification{
+		Channel:   "sms",
+		Username:  "alice",
+		Recipient: "+6421000000",
+		Title:     "Transfer received",
+		Body:      "You received 10.00 USD",
+	},
+	TaskSendTransferAlert: &PayloadSendTransferAlert{
+		TransferID: 100,
+		BalanceAlerts: []db.BalanceAlert{
+			{ID: 1, AccountID: 42, Kind: "low_balance", Threshold: 1000, CreatedAt: sampleTime, UpdatedAt: sampleTime},
+		},
+	},
+	TaskSendVerifyEmail: &PayloadSendVerifyEmail{Username: "alice", Reminder: true},
+}
+
+func newPayload(sample interface{}) interface{} {
+	return reflect.New(reflect.TypeOf(sample).Elem()).Interface()
+}
+
+func TestPayloadCompatibility(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "payloads", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	hasCurrentVersion := map[string]bool{}
+	for _, file := range files {
+		name, version, ok := strings.Cut(strings.TrimSuffix(filepath.Base(file), ".json"), ".v")
+		require.True(t, ok, "%s must be named <task>.v<version>.json", file)
+		parsedVersion, err := strconv.Atoi(version)
+		require.NoError(t, err, file)
+
+		taskType := "task:" + name
+		sample, ok := payloadSamples[taskType]
+		require.True(t, ok, "no payload sample for %s", file)
+		if parsedVersion == payloadVersion(sample) {
+			hasCurrentVersion[taskType] = true
+		}
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			payload := newPayload(sample)
+			require.NoError(t, decodeTaskPayload(data, payload))
+			require.Equal(t, sample, payload)
+		})
+	}
+
+	for taskType, sample := range payloadSamples {
+		// 每种任务都要有当前版本的样例，格式改了之后才知道旧的任务长什么样
+		require.True(t, hasCurrentVersion[taskType], "missing testdata/payloads/%s.v%d.json",
+			strings.TrimPrefix(taskType, "task:"), payloadVersion(sample))
+
+		data, err := encodeTaskPayload(sample, "")
+		require.NoError(t, err)
+		payload := newPayload(sample)
+		require.NoError(t, decodeTaskPayload(data, payload))
+		require.Equal(t, sample, payload)
+	}
+}
+
+// testPayloadV2 把版本 1 的 name 拆成了 first_name 和 last_name
+type testPayloadV2 struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+func (payload *testPayloadV2) payloadVersion() int {
+	return 2
+}
+
+func (payload *testPayloadV2) upgradePayload(version int, data json.RawMessage) error {
+	if version != 1 {
+		return fmt.Errorf("unknown version %d", version)
+	}
+	var v1 struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &v1); err != nil {
+		return err
+	}
+	payload.FirstName, payload.LastName, _ = strings.Cut(v1.Name, " ")
+	return nil
+}
+
+func TestDecodeTaskPayload(t *testing.T) {
+	expected := testPayloadV2{FirstName: "Ada", LastName: "Lovelace"}
+
+	data, err := encodeTaskPayload(&expected, "")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"version":2,"payload":{"first_name":"Ada","last_name":"Lovelace"}}`, string(data))
+
+	testCases := []struct {
+		name  string
+		data  string
+		check func(t *testing.T, payload testPayloadV2, err error)
+	}{
+		{
+			name: "CurrentVersion",
+			data: string(data),
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.NoError(t, err)
+				require.Equal(t, expected, payload)
+			},
+		},
+		{
+			name: "OlderVersion",
+			data: `{"version":1,"traceparent":"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01","payload":{"name":"Ada Lovelace"}}`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.NoError(t, err)
+				require.Equal(t, expected, payload)
+			},
+		},
+		{
+			// 加上信封之前的任务当作版本 1
+			name: "WithoutEnvelope",
+			data: `{"name":"Ada Lovelace"}`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.NoError(t, err)
+				require.Equal(t, expected, payload)
+			},
+		},
+		{
+			// 新的 worker 分发的任务，重试的时候交给新的 worker
+			name: "NewerVersion",
+			data: `{"version":3,"payload":{}}`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.ErrorIs(t, err, errPayloadVersion)
+				require.NotErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+		{
+			name: "InvalidJSON",
+			data: `{"version":`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+		{
+			name: "InvalidPayload",
+			data: `{"version":2,"payload":{"first_name":1}}`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+		{
+			name: "NegativeVersion",
+			data: `{"version":-1,"payload":{}}`,
+			check: func(t *testing.T, payload testPayloadV2, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			var payload testPayloadV2
+			err := decodeTaskPayload([]byte(tc.data), &payload)
+			tc.check(t, payload, err)
+		})
+	}
+}
+
+func TestParseTaskEnvelope(t *testing.T) {
+	traceparent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+
+	data, err := encodeTaskPayload(&PayloadAnonymizeUser{Username: "alice"}, traceparent)
+	require.NoError(t, err)
+	envelope, err := parseTaskEnvelope(data)
+	require.NoError(t, err)
+	require.Equal(t, 1, envelope.Version)
+	require.Equal(t, traceparent, envelope.Traceparent)
+	require.JSONEq(t, `{"username":"alice"}`, string(envelope.Payload))
+
+	// 加上信封之前 traceparent 和 payload 的字段放在一起
+	legacy := `{"traceparent":"` + traceparent + `","username":"alice"}`
+	envelope, err = parseTaskEnvelope([]byte(legacy))
+	require.NoError(t, err)
+	require.Equal(t, 0, envelope.Version)
+	require.Equal(t, traceparent, envelope.Traceparent)
+	require.Equal(t, legacy, string(envelope.Payload))
+}
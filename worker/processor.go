@@ -2,11 +2,14 @@ package worker
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hibiken/asynq"
-	"github.com/rs/zerolog/log"
 	db "github.com/techschool/bank/db/sqlc"
 	"github.com/techschool/bank/mail"
+	"github.com/techschool/bank/notify"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
 	// "github.com/techschool/bank/mail"
 )
 
@@ -27,13 +30,38 @@ const (
 
 type TaskProcessor interface {
 	Start() error
+	Shutdown()
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAnonymizeUser(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendMonthlySummary(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendTransferAlert(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendNotification(ctx context.Context, task *asynq.Task) error
+	ProcessTaskConfirmPendingOperation(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReversePendingOperation(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpirePendingOperations(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileBalances(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAdvanceExternalTransfer(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendExternalTransferWebhook(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCollectLoanPayments(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendLoanDelinquencyNotice(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCleanOutboxEvents(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendNewDeviceAlert(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAccountOwnershipNotice(ctx context.Context, task *asynq.Task) error
+	ProcessTaskGenerateExport(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendVerifyEmailReminders(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendDisputeUpdate(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendAccountExistsEmail(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
-	mailer mail.EmailSender
+	config      util.Config
+	server      *asynq.Server
+	store       db.Store
+	mailer      mail.EmailSender
+	notifiers   notify.Notifiers
+	webhook     *notify.WebhookSender
+	distributor TaskDistributor
+	blobStore   storage.BlobStore
 }
 
 /*
@@ -41,40 +69,83 @@ type RedisTaskProcessor struct {
 一旦队列中出现了任务，它就会处理这些任务。
 例如，ProcessTaskSendVerifyEmail函数将处理发送验证邮件的任务。
 */
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(config util.Config, redisOpt asynq.RedisConnOpt, store db.Store, mailer mail.EmailSender, notifiers notify.Notifiers) (TaskProcessor, error) {
 	// logger := NewLogger()
 	// redis.SetLogger(logger)
 
-	server := asynq.NewServer(
-		redisOpt,
-		asynq.Config{
-			Queues: map[string]int{
-				QueueCritical: 10,
-				QueueDefault:  5,
-			},
-			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
-				log.Error().Err(err).Str("type", task.Type()).
-					Bytes("payload", task.Payload()).Msg("process task failed")
-			}),
-			Logger: NewLogger(),
-		},
-	)
+	// 并发数、队列权重等都来自配置，见 config.go
+	serverConfig, err := newServerConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid worker config: %w", err)
+	}
 
-	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+	server := asynq.NewServer(redisOpt, serverConfig)
+
+	// 外部转账状态变化的 webhook 是可选的，没有配置地址时不发送
+	var webhook *notify.WebhookSender
+	if config.ExternalWebhookURL != "" {
+		webhook = notify.NewWebhookSender(config.ExternalWebhookURL, config.ExternalWebhookSecret)
+	}
+
+	// 配置了 blob store 时，邮件附件从 blob store 读取，worker 生成的对账单也保存在里面
+	blobStore, err := storage.NewBlobStore(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create blob store: %w", err)
 	}
+	if blobStore != nil {
+		mailer = mail.NewBlobAttachmentSender(mailer, blobStore)
+	}
+
+	// 有些任务处理完之后还要分发新的任务（比如转账提醒按渠道拆分）
+	return &RedisTaskProcessor{
+		config:      config,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		notifiers:   notifiers,
+		webhook:     webhook,
+		distributor: NewRedisTaskDistributor(redisOpt),
+		blobStore:   blobStore,
+	}, nil
 }
 
 func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
+	// panic 恢复、metrics、trace 和日志，见 middleware.go
+	mux.Use(taskMiddlewares()...)
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskAnonymizeUser, processor.ProcessTaskAnonymizeUser)
+	mux.HandleFunc(TaskSendMonthlySummary, processor.ProcessTaskSendMonthlySummary)
+	mux.HandleFunc(TaskSendTransferAlert, processor.ProcessTaskSendTransferAlert)
+	mux.HandleFunc(TaskSendNotification, processor.ProcessTaskSendNotification)
+	mux.HandleFunc(TaskConfirmPendingOperation, processor.ProcessTaskConfirmPendingOperation)
+	mux.HandleFunc(TaskReversePendingOperation, processor.ProcessTaskReversePendingOperation)
+	mux.HandleFunc(TaskExpirePendingOperations, processor.ProcessTaskExpirePendingOperations)
+	mux.HandleFunc(TaskReconcileBalances, processor.ProcessTaskReconcileBalances)
+	mux.HandleFunc(TaskAdvanceExternalTransfer, processor.ProcessTaskAdvanceExternalTransfer)
+	mux.HandleFunc(TaskSendExternalTransferWebhook, processor.ProcessTaskSendExternalTransferWebhook)
+	mux.HandleFunc(TaskCollectLoanPayments, processor.ProcessTaskCollectLoanPayments)
+	mux.HandleFunc(TaskSendLoanDelinquencyNotice, processor.ProcessTaskSendLoanDelinquencyNotice)
+	mux.HandleFunc(TaskCleanOutboxEvents, processor.ProcessTaskCleanOutboxEvents)
+	mux.HandleFunc(TaskSendNewDeviceAlert, processor.ProcessTaskSendNewDeviceAlert)
+	mux.HandleFunc(TaskSendAccountOwnershipNotice, processor.ProcessTaskSendAccountOwnershipNotice)
+	mux.HandleFunc(TaskGenerateExport, processor.ProcessTaskGenerateExport)
+	mux.HandleFunc(TaskSendVerifyEmailReminders, processor.ProcessTaskSendVerifyEmailReminders)
+	mux.HandleFunc(TaskSendDisputeUpdate, processor.ProcessTaskSendDisputeUpdate)
+	mux.HandleFunc(TaskSendAccountExistsEmail, processor.ProcessTaskSendAccountExistsEmail)
+	mux.HandleFunc(TaskCreditReferralBonuses, processor.ProcessTaskCreditReferralBonuses)
+	mux.HandleFunc(TaskDeleteUser, processor.ProcessTaskDeleteUser)
+	mux.HandleFunc(TaskSendAccountDeletionEmail, processor.ProcessTaskSendAccountDeletionEmail)
 
 	return processor.server.Start(mux)
 }
 
+// Shutdown 停止从队列里取新的任务，等正在处理的任务完成（最多 WORKER_SHUTDOWN_TIMEOUT），没完成的任务会回到队列里由其他副本处理
+func (processor *RedisTaskProcessor) Shutdown() {
+	processor.server.Shutdown()
+}
+
 /*
 和task_send_verify_email.go差不多，另一个解释版本
 
@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/techschool/bank/util"
+)
+
+// Constants for the supported REDIS_MODE values
+const (
+	RedisModeStandalone = "standalone"
+	RedisModeSentinel   = "sentinel"
+	RedisModeCluster    = "cluster"
+)
+
+/*
+NewRedisConnOpt 根据 REDIS_MODE 创建 asynq 用的 Redis 连接配置：
+- standalone（默认）：只连 REDIS_ADDRESS 一个节点；
+- sentinel：通过 REDIS_SENTINEL_ADDRESSES 里的哨兵找到 REDIS_SENTINEL_MASTER_NAME 对应的主节点，
+主节点故障切换之后会自动连到新的主节点，worker 不需要重启；
+- cluster：连接 REDIS_CLUSTER_ADDRESSES 里的集群节点。
+
+多个地址用逗号分隔。distributor、processor、scheduler 和 inspector 都用这里返回的配置。
+*/
+func NewRedisConnOpt(config util.Config) (asynq.RedisConnOpt, error) {
+	switch config.RedisMode {
+	case "", RedisModeStandalone:
+		if config.RedisAddress == "" {
+			return nil, fmt.Errorf("REDIS_ADDRESS is required in %s mode", RedisModeStandalone)
+		}
+		return asynq.RedisClientOpt{
+			Addr:     config.RedisAddress,
+			Password: config.RedisPassword,
+			DB:       config.RedisDB,
+		}, nil
+	case RedisModeSentinel:
+		sentinelAddrs := splitAddresses(config.RedisSentinelAddresses)
+		if len(sentinelAddrs) == 0 {
+			return nil, fmt.Errorf("REDIS_SENTINEL_ADDRESSES is required in %s mode", RedisModeSentinel)
+		}
+		if config.RedisSentinelMasterName == "" {
+			return nil, fmt.Errorf("REDIS_SENTINEL_MASTER_NAME is required in %s mode", RedisModeSentinel)
+		}
+		return asynq.RedisFailoverClientOpt{
+			MasterName:       config.RedisSentinelMasterName,
+			SentinelAddrs:    sentinelAddrs,
+			SentinelPassword: config.RedisSentinelPassword,
+			Password:         config.RedisPassword,
+			DB:               config.RedisDB,
+		}, nil
+	case RedisModeCluster:
+		addrs := splitAddresses(config.RedisClusterAddresses)
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("REDIS_CLUSTER_ADDRESSES is required in %s mode", RedisModeCluster)
+		}
+		return asynq.RedisClusterClientOpt{
+			Addrs:    addrs,
+			Password: config.RedisPassword,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported redis mode: %s", config.RedisMode)
+	}
+}
+
+func splitAddresses(value string) []string {
+	var addrs []string
+	for _, addr := range strings.Split(value, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
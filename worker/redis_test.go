@@ -0,0 +1,90 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestNewRedisConnOpt(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input util.Config
+		check func(t *testing.T, opt asynq.RedisConnOpt, err error)
+	}{
+		{
+			name:  "Standalone",
+			input: util.Config{RedisAddress: "localhost:6379", RedisDB: 2},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.NoError(t, err)
+				clientOpt, ok := opt.(asynq.RedisClientOpt)
+				require.True(t, ok)
+				require.Equal(t, "localhost:6379", clientOpt.Addr)
+				require.Equal(t, 2, clientOpt.DB)
+			},
+		},
+		{
+			name: "Sentinel",
+			input: util.Config{
+				RedisMode:               RedisModeSentinel,
+				RedisSentinelMasterName: "mymaster",
+				RedisSentinelAddresses:  "sentinel1:26379, sentinel2:26379,",
+			},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.NoError(t, err)
+				failoverOpt, ok := opt.(asynq.RedisFailoverClientOpt)
+				require.True(t, ok)
+				require.Equal(t, "mymaster", failoverOpt.MasterName)
+				require.Equal(t, []string{"sentinel1:26379", "sentinel2:26379"}, failoverOpt.SentinelAddrs)
+			},
+		},
+		{
+			name: "SentinelWithoutMasterName",
+			input: util.Config{
+				RedisMode:              RedisModeSentinel,
+				RedisSentinelAddresses: "sentinel1:26379",
+			},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "Cluster",
+			input: util.Config{
+				RedisMode:             RedisModeCluster,
+				RedisClusterAddresses: "node1:6379,node2:6379,node3:6379",
+			},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.NoError(t, err)
+				clusterOpt, ok := opt.(asynq.RedisClusterClientOpt)
+				require.True(t, ok)
+				require.Len(t, clusterOpt.Addrs, 3)
+			},
+		},
+		{
+			name:  "ClusterWithoutAddresses",
+			input: util.Config{RedisMode: RedisModeCluster},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:  "UnsupportedMode",
+			input: util.Config{RedisMode: "replication", RedisAddress: "localhost:6379"},
+			check: func(t *testing.T, opt asynq.RedisConnOpt, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			opt, err := NewRedisConnOpt(tc.input)
+			tc.check(t, opt, err)
+		})
+	}
+}
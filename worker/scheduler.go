@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+)
+
+/*
+TaskScheduler 负责按照 cron 表达式定时把任务放进队列，
+真正的处理还是由 TaskProcessor 完成。
+
+这里用的是 asynq.PeriodicTaskManager，它会定期调用 GetConfigs 同步定时任务。
+每个 asynq 调度器都会在 cron 时间到的时候各自放一次任务，
+所以 worker 扩成多个副本之后，只有选举出来的 leader 运行调度器（见 LeaderElector），
+不会重复发送月度汇总邮件、重复扣贷款等。leader 退出或者失联之后，其他副本会接手。
+
+Shutdown 停止调度器并释放 leader 锁，让其他副本马上接手。
+*/
+type TaskScheduler interface {
+	Start() error
+	Shutdown()
+}
+
+const schedulerLeaderKey = "bank:worker:scheduler:leader"
+
+type RedisTaskScheduler struct {
+	redisOpt asynq.RedisConnOpt
+	elector  *LeaderElector
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisConnOpt, leaderTTL time.Duration) (TaskScheduler, error) {
+	// 先创建一次，配置有问题的话启动时就返回错误
+	if _, err := newPeriodicTaskManager(redisOpt); err != nil {
+		return nil, err
+	}
+
+	client := redisOpt.MakeRedisClient().(redis.UniversalClient)
+	return &RedisTaskScheduler{
+		redisOpt: redisOpt,
+		elector:  NewRedisLeaderElector(client, schedulerLeaderKey, leaderTTL),
+	}, nil
+}
+
+func newPeriodicTaskManager(redisOpt asynq.RedisConnOpt) (*asynq.PeriodicTaskManager, error) {
+	return asynq.NewPeriodicTaskManager(asynq.PeriodicTaskManagerOpts{
+		RedisConnOpt:               redisOpt,
+		PeriodicTaskConfigProvider: &periodicTaskConfigProvider{},
+		SchedulerOpts: &asynq.SchedulerOpts{
+			Logger: NewLogger(),
+		},
+	})
+}
+
+func (scheduler *RedisTaskScheduler) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	scheduler.cancel = cancel
+	scheduler.done = make(chan struct{})
+
+	go func() {
+		defer close(scheduler.done)
+		scheduler.elector.Run(ctx, scheduler.lead)
+	}()
+	return nil
+}
+
+// lead 在当前副本是 leader 的时候运行调度器，失去 leader 身份时停止。asynq 的调度器停止之后不能再启动，所以每次都创建新的
+func (scheduler *RedisTaskScheduler) lead(ctx context.Context) {
+	manager, err := newPeriodicTaskManager(scheduler.redisOpt)
+	if err != nil {
+		log.Error().Err(err).Msg("cannot create periodic task manager")
+		return
+	}
+
+	if err := manager.Start(); err != nil {
+		log.Error().Err(err).Msg("failed to start periodic task manager")
+		return
+	}
+
+	<-ctx.Done()
+	manager.Shutdown()
+}
+
+func (scheduler *RedisTaskScheduler) Shutdown() {
+	if scheduler.cancel == nil {
+		return
+	}
+	scheduler.cancel()
+	<-scheduler.done
+}
+
+type periodicTaskConfigProvider struct{}
+
+func (provider *periodicTaskConfigProvider) GetConfigs() ([]*asynq.PeriodicTaskConfig, error) {
+	return []*asynq.PeriodicTaskConfig{
+		{
+			Cronspec: MonthlySummaryCronspec,
+			Task:     asynq.NewTask(TaskSendMonthlySummary, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueDefault), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: ExpirePendingOperationsCronspec,
+			Task:     asynq.NewTask(TaskExpirePendingOperations, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueCritical), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: ReconcileBalancesCronspec,
+			Task:     asynq.NewTask(TaskReconcileBalances, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueDefault), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: CollectLoanPaymentsCronspec,
+			Task:     asynq.NewTask(TaskCollectLoanPayments, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueCritical), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: CleanOutboxEventsCronspec,
+			Task:     asynq.NewTask(TaskCleanOutboxEvents, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueDefault), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: SendVerifyEmailRemindersCronspec,
+			Task:     asynq.NewTask(TaskSendVerifyEmailReminders, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueDefault), asynq.MaxRetry(3)},
+		},
+		{
+			Cronspec: CreditReferralBonusesCronspec,
+			Task:     asynq.NewTask(TaskCreditReferralBonuses, nil),
+			Opts:     []asynq.Option{asynq.Queue(QueueDefault), asynq.MaxRetry(3)},
+		},
+	}, nil
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+/*
+TaskAdvanceExternalTransfer 把外部转账推进到下一个状态，模拟清算网络的处理过程：
+1. ExternalPayout 创建转账之后分发这个任务，把转账变成 submitted（提交给清算网络）；
+2. submitted 之后再分发一个延迟 EXTERNAL_SETTLEMENT_DELAY 的任务，模拟清算需要的时间，
+   到时间后转账变成 settled，冻结的钱转到这个币种的清算账户（EXTERNAL_CLEARING_ACCOUNTS）；
+3. 对方账号命中 simulatedReturns 里的测试账号时，转账会被退回（returned），冻结的钱被释放。
+
+每次状态变化之后都分发一个 TaskSendExternalTransferWebhook 通知外部系统。
+状态已经变过了（比如任务被重复执行）时直接返回 nil，不再重试。
+*/
+
+const TaskAdvanceExternalTransfer = "task:advance_external_transfer"
+
+type PayloadAdvanceExternalTransfer struct {
+	ExternalTransferID int64  `json:"external_transfer_id"`
+	Status             string `json:"status"`
+}
+
+/*
+simulatedReturns 是模拟环境里会被退回的测试账号（按账号结尾匹配），退回原因使用 ACH 的 return code，
+这样客户端不需要真的接入清算网络就能测试退回的流程。
+*/
+var simulatedReturns = map[string]string{
+	"0001": "R01: insufficient funds",
+	"0002": "R02: account closed",
+	"0003": "R03: no account/unable to locate account",
+}
+
+// simulatedReturnReason 返回模拟的退回原因，空字符串表示正常清算
+func simulatedReturnReason(accountNumber string) string {
+	for suffix, reason := range simulatedReturns {
+		if strings.HasSuffix(accountNumber, suffix) {
+			return reason
+		}
+	}
+	return ""
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskAdvanceExternalTransfer(
+	ctx context.Context,
+	payload *PayloadAdvanceExternalTransfer,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskAdvanceExternalTransfer, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskAdvanceExternalTransfer(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadAdvanceExternalTransfer
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	externalTransfer, err := processor.store.GetExternalTransfer(ctx, payload.ExternalTransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("external transfer doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get external transfer: %w", err)
+	}
+
+	arg := db.AdvanceExternalTransferTxParams{
+		ID:     externalTransfer.ID,
+		Status: payload.Status,
+	}
+
+	if payload.Status == util.ExternalTransferSettled {
+		if reason := simulatedReturnReason(externalTransfer.AccountNumber); reason != "" {
+			arg.Status = util.ExternalTransferReturned
+			arg.ReturnReason = reason
+		} else {
+			clearingAccounts, err := util.ParseCurrencyAccounts(processor.config.ExternalClearingAccounts)
+			if err != nil {
+				return fmt.Errorf("invalid clearing accounts: %w", asynq.SkipRetry)
+			}
+
+			clearingAccountID, ok := clearingAccounts[externalTransfer.Currency]
+			if !ok {
+				return fmt.Errorf("no clearing account for currency %s: %w", externalTransfer.Currency, asynq.SkipRetry)
+			}
+			arg.ClearingAccountID = clearingAccountID
+		}
+	}
+
+	result, err := processor.store.AdvanceExternalTransferTx(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidExternalTransferTransition) {
+			log.Ctx(ctx).Warn().Err(err).Int64("external_transfer_id", externalTransfer.ID).
+				Msg("external transfer has already moved on")
+			return nil
+		}
+		return fmt.Errorf("failed to advance external transfer: %w", err)
+	}
+
+	// 状态已经变了，后续任务分发失败只记录日志，避免重试时报状态错误
+	err = processor.distributor.DistributeTaskSendExternalTransferWebhook(ctx, &PayloadSendExternalTransferWebhook{
+		ExternalTransferID: result.ExternalTransfer.ID,
+		Status:             result.ExternalTransfer.Status,
+	}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Int64("external_transfer_id", result.ExternalTransfer.ID).
+			Msg("failed to distribute external transfer webhook")
+	}
+
+	if result.ExternalTransfer.Status == util.ExternalTransferSubmitted {
+		err = processor.distributor.DistributeTaskAdvanceExternalTransfer(ctx, &PayloadAdvanceExternalTransfer{
+			ExternalTransferID: result.ExternalTransfer.ID,
+			Status:             util.ExternalTransferSettled,
+		}, asynq.MaxRetry(10), asynq.ProcessIn(processor.config.ExternalSettlementDelay), asynq.Queue(QueueCritical))
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("external_transfer_id", result.ExternalTransfer.ID).
+				Msg("failed to distribute external transfer settlement")
+		}
+	}
+
+	log.Ctx(ctx).Info().Int64("external_transfer_id", result.ExternalTransfer.ID).
+		Str("status", result.ExternalTransfer.Status).Msg("processed task")
+	return nil
+}
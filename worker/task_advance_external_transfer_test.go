@@ -0,0 +1,13 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatedReturnReason(t *testing.T) {
+	require.Empty(t, simulatedReturnReason("123456789"))
+	require.Equal(t, "R01: insufficient funds", simulatedReturnReason("12340001"))
+	require.Equal(t, "R03: no account/unable to locate account", simulatedReturnReason("0003"))
+}
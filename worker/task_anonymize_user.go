@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+用户被软删除时会安排一个延迟执行的匿名化任务（asynq.ProcessIn 保留期），
+到期后由这里清除用户的个人信息，并删掉 blob store 里的头像。
+如果用户不存在或者没有被删除，就直接跳过，不需要重试。
+*/
+
+const TaskAnonymizeUser = "task:anonymize_user"
+
+type PayloadAnonymizeUser struct {
+	Username string `json:"username"`
+	// AvatarKey 是删除用户时的头像，匿名化之后 users 表里就没有这个 key 了
+	AvatarKey string `json:"avatar_key,omitempty"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskAnonymizeUser(
+	ctx context.Context,
+	payload *PayloadAnonymizeUser,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskAnonymizeUser, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskAnonymizeUser(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadAnonymizeUser
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	_, err := processor.store.AnonymizeUserTx(ctx, db.AnonymizeUserTxParams{
+		Username: payload.Username,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			log.Ctx(ctx).Warn().Str("username", payload.Username).
+				Msg("user is not deleted, skip anonymization")
+			return nil
+		}
+		return fmt.Errorf("failed to anonymize user: %w", err)
+	}
+
+	// 匿名化可以重复执行，删除头像失败时重试整个任务
+	if payload.AvatarKey != "" && processor.blobStore != nil {
+		err = processor.blobStore.Delete(ctx, payload.AvatarKey)
+		if err != nil {
+			return fmt.Errorf("failed to delete avatar: %w", err)
+		}
+	}
+
+	log.Ctx(ctx).Info().Str("username", payload.Username).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+每天由 scheduler 触发一次，删除 outbox_events 里超过 EVENT_RETENTION 的事件，避免表无限增长。
+
+已经发布的事件随时可以删；还没发布的事件只有在没有配置 EVENT_BUS 时才删，
+否则消息系统暂时不可用的那段时间里产生的事件会在 relay 发出去之前就被清掉。
+EVENT_RETENTION 为 0 时不清理。
+*/
+
+const TaskCleanOutboxEvents = "task:clean_outbox_events"
+
+// CleanOutboxEventsCronspec 每天 03:00 (UTC) 执行，排在对账任务之后
+const CleanOutboxEventsCronspec = "0 3 * * *"
+
+func (processor *RedisTaskProcessor) ProcessTaskCleanOutboxEvents(ctx context.Context, task *asynq.Task) error {
+	if processor.config.EventRetention <= 0 {
+		log.Ctx(ctx).Info().Msg("outbox retention is disabled, skip task")
+		return nil
+	}
+
+	err := processor.store.DeleteOutboxEvents(ctx, db.DeleteOutboxEventsParams{
+		CreatedBefore:      time.Now().Add(-processor.config.EventRetention),
+		IncludeUnpublished: processor.config.EventBus == "",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete outbox events: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Msg("processed task")
+	return nil
+}
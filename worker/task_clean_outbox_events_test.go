@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/eventbus"
+	"github.com/techschool/bank/util"
+)
+
+func TestCleanOutboxEvents(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     util.Config
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name:   "BusEnabled",
+			config: util.Config{EventBus: eventbus.BusKafka, EventRetention: time.Hour},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteOutboxEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.DeleteOutboxEventsParams) error {
+						require.False(t, arg.IncludeUnpublished)
+						require.WithinDuration(t, time.Now().Add(-time.Hour), arg.CreatedBefore, time.Second)
+						return nil
+					})
+			},
+		},
+		{
+			name:   "BusDisabled",
+			config: util.Config{EventRetention: time.Hour},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					DeleteOutboxEvents(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.DeleteOutboxEventsParams) error {
+						require.True(t, arg.IncludeUnpublished)
+						return nil
+					})
+			},
+		},
+		{
+			name:   "RetentionDisabled",
+			config: util.Config{EventBus: eventbus.BusNATS},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().DeleteOutboxEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			processor := &RedisTaskProcessor{config: tc.config, store: store}
+			err := processor.ProcessTaskCleanOutboxEvents(context.Background(), asynq.NewTask(TaskCleanOutboxEvents, nil))
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+由 scheduler 每小时触发一次，找出所有已经到期但还没有还的分期，逐个调用 CollectLoanPaymentTx 从借款人的账户扣款。
+
+余额不够时这一期保持 scheduled，下次再试；
+如果已经超过到期日 LOAN_GRACE_PERIOD 还没有扣款成功，就把贷款标记为 delinquent，
+并分发一个 TaskSendLoanDelinquencyNotice 给借款人发邮件。
+MarkLoanDelinquent 只更新 active 的贷款，所以同一笔贷款在恢复 active 之前只会通知一次。
+
+某一期扣款失败只记录日志，不返回错误，否则整个任务重试时会重复处理已经成功的分期（虽然它们已经不是 scheduled 了）。
+*/
+
+const TaskCollectLoanPayments = "task:collect_loan_payments"
+
+const CollectLoanPaymentsCronspec = "@every 1h"
+
+// collectLoanPaymentsBatchSize 是每次从数据库取出的分期数量，按 id 分页直到取完
+const collectLoanPaymentsBatchSize = 100
+
+func (processor *RedisTaskProcessor) ProcessTaskCollectLoanPayments(ctx context.Context, task *asynq.Task) error {
+	now := time.Now()
+	collected, failed := 0, 0
+
+	var lastID int64
+	for {
+		payments, err := processor.store.ListDueLoanPayments(ctx, db.ListDueLoanPaymentsParams{
+			DueDate: now,
+			ID:      lastID,
+			Limit:   collectLoanPaymentsBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list due loan payments: %w", err)
+		}
+
+		for _, payment := range payments {
+			lastID = payment.ID
+
+			if err := processor.collectLoanPayment(ctx, payment, now); err != nil {
+				log.Ctx(ctx).Error().Err(err).
+					Int64("loan_id", payment.LoanID).Int64("payment_id", payment.ID).
+					Msg("failed to collect loan payment")
+				failed++
+				continue
+			}
+			collected++
+		}
+
+		if len(payments) < collectLoanPaymentsBatchSize {
+			break
+		}
+	}
+
+	log.Ctx(ctx).Info().Int("collected", collected).
+		Int("failed", failed).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) collectLoanPayment(ctx context.Context, payment db.LoanPayment, now time.Time) error {
+	_, err := processor.store.CollectLoanPaymentTx(ctx, db.CollectLoanPaymentTxParams{
+		PaymentID: payment.ID,
+	})
+	if err == nil || errors.Is(err, db.ErrLoanPaymentNotScheduled) {
+		return nil
+	}
+
+	if !errors.Is(err, db.ErrInsufficientBalance) || now.Sub(payment.DueDate) < processor.config.LoanGracePeriod {
+		return err
+	}
+
+	_, markErr := processor.store.MarkLoanDelinquent(ctx, payment.LoanID)
+	if markErr != nil {
+		// 贷款已经是 delinquent 了，不用再通知
+		if errors.Is(markErr, db.ErrRecordNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to mark loan delinquent: %w", markErr)
+	}
+
+	markErr = processor.distributor.DistributeTaskSendLoanDelinquencyNotice(ctx, &PayloadSendLoanDelinquencyNotice{
+		LoanID:    payment.LoanID,
+		PaymentID: payment.ID,
+	}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+	if markErr != nil {
+		return fmt.Errorf("failed to distribute loan delinquency notice: %w", markErr)
+	}
+
+	return err
+}
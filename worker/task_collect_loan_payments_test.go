@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+// noticeRecorder 记录分发的逾期通知。mockwk 依赖 worker 包，不能在这里使用
+type noticeRecorder struct {
+	TaskDistributor
+	notices []*PayloadSendLoanDelinquencyNotice
+}
+
+func (recorder *noticeRecorder) DistributeTaskSendLoanDelinquencyNotice(ctx context.Context, payload *PayloadSendLoanDelinquencyNotice, opts ...asynq.Option) error {
+	recorder.notices = append(recorder.notices, payload)
+	return nil
+}
+
+func TestCollectLoanPayment(t *testing.T) {
+	now := time.Now()
+	payment := db.LoanPayment{ID: 3, LoanID: 2, Amount: 100, Status: util.LoanPaymentScheduled}
+
+	testCases := []struct {
+		name       string
+		dueDate    time.Time
+		buildStubs func(store *mockdb.MockStore)
+		notices    []*PayloadSendLoanDelinquencyNotice
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name:    "Collected",
+			dueDate: now,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CollectLoanPaymentTx(gomock.Any(), gomock.Eq(db.CollectLoanPaymentTxParams{PaymentID: payment.ID})).
+					Times(1).
+					Return(db.CollectLoanPaymentTxResult{}, nil)
+				store.EXPECT().MarkLoanDelinquent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "InsufficientBalanceWithinGracePeriod",
+			dueDate: now.Add(-time.Hour),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CollectLoanPaymentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectLoanPaymentTxResult{}, db.ErrInsufficientBalance)
+				store.EXPECT().MarkLoanDelinquent(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, db.ErrInsufficientBalance)
+			},
+		},
+		{
+			name:    "Delinquent",
+			dueDate: now.Add(-96 * time.Hour),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CollectLoanPaymentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectLoanPaymentTxResult{}, db.ErrInsufficientBalance)
+				store.EXPECT().
+					MarkLoanDelinquent(gomock.Any(), gomock.Eq(payment.LoanID)).
+					Times(1).
+					Return(db.Loan{ID: payment.LoanID, Status: util.LoanDelinquent}, nil)
+			},
+			notices: []*PayloadSendLoanDelinquencyNotice{{LoanID: payment.LoanID, PaymentID: payment.ID}},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, db.ErrInsufficientBalance)
+			},
+		},
+		{
+			name:    "AlreadyDelinquent",
+			dueDate: now.Add(-96 * time.Hour),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CollectLoanPaymentTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CollectLoanPaymentTxResult{}, db.ErrInsufficientBalance)
+				store.EXPECT().
+					MarkLoanDelinquent(gomock.Any(), gomock.Eq(payment.LoanID)).
+					Times(1).
+					Return(db.Loan{}, db.ErrRecordNotFound)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, db.ErrInsufficientBalance)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			distributor := &noticeRecorder{}
+			tc.buildStubs(store)
+
+			processor := &RedisTaskProcessor{
+				config:      util.Config{LoanGracePeriod: 72 * time.Hour},
+				store:       store,
+				distributor: distributor,
+			}
+
+			p := payment
+			p.DueDate = tc.dueDate
+			err := processor.collectLoanPayment(context.Background(), p, now)
+			tc.checkError(t, err)
+			require.Equal(t, tc.notices, distributor.notices)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+外部系统（比如清算行）确认转账已经完成之后，分发 TaskConfirmPendingOperation，
+把 pending_operations 里对应的操作标记为 confirmed。
+
+操作已经被确认或者已经被撤销时直接返回 nil，不再重试，
+因为 ConfirmPendingOperationTx 会锁住这一行，只有 pending 状态才会被处理。
+*/
+
+const TaskConfirmPendingOperation = "task:confirm_pending_operation"
+
+type PayloadConfirmPendingOperation struct {
+	OperationID int64 `json:"operation_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskConfirmPendingOperation(
+	ctx context.Context,
+	payload *PayloadConfirmPendingOperation,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskConfirmPendingOperation, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskConfirmPendingOperation(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadConfirmPendingOperation
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	result, err := processor.store.ConfirmPendingOperationTx(ctx, db.ConfirmPendingOperationTxParams{
+		OperationID: payload.OperationID,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("operation doesn't exist: %w", asynq.SkipRetry)
+		}
+		if errors.Is(err, db.ErrOperationNotPending) {
+			log.Ctx(ctx).Warn().Int64("operation_id", payload.OperationID).
+				Msg("operation is no longer pending")
+			return nil
+		}
+		return fmt.Errorf("failed to confirm pending operation: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("operation_id", result.Operation.ID).
+		Int64("transfer_id", result.Operation.TransferID).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+由 scheduler 每 10 分钟触发一次，找出被推荐人已经验证邮箱并且有过入账的 pending 推荐，
+逐个调用 CreditReferralBonusTx 给推荐人和被推荐人各转 REFERRAL_BONUS_AMOUNT。
+同一个推荐人、同一个注册 IP 最多拿 REFERRAL_MAX_PER_REFERRER、REFERRAL_MAX_PER_IP 次奖励，超过的推荐会被拒绝。
+
+REFERRAL_BONUS_AMOUNT 或者 REFERRAL_FUNDING_ACCOUNT 为 0 时不发奖励，推荐一直保持 pending，打开之后再补发。
+有一方没有这个币种的账户时推荐保持 pending，开户之后下次再试；其他错误只记录日志，和 ProcessTaskCollectLoanPayments 一样不返回错误。
+*/
+
+const TaskCreditReferralBonuses = "task:credit_referral_bonuses"
+
+const CreditReferralBonusesCronspec = "@every 10m"
+
+// creditReferralBonusesBatchSize 是每次从数据库取出的推荐数量，按 id 分页直到取完
+const creditReferralBonusesBatchSize = 100
+
+func (processor *RedisTaskProcessor) ProcessTaskCreditReferralBonuses(ctx context.Context, task *asynq.Task) error {
+	if processor.config.ReferralBonusAmount <= 0 || processor.config.ReferralFundingAccount == 0 {
+		log.Ctx(ctx).Debug().Msg("referral bonuses are disabled")
+		return nil
+	}
+
+	credited, rejected, skipped, failed := 0, 0, 0, 0
+
+	var lastID int64
+	for {
+		referrals, err := processor.store.ListEligibleReferrals(ctx, db.ListEligibleReferralsParams{
+			ID:    lastID,
+			Limit: creditReferralBonusesBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list eligible referrals: %w", err)
+		}
+
+		for _, referral := range referrals {
+			lastID = referral.ID
+
+			result, err := processor.store.CreditReferralBonusTx(ctx, db.CreditReferralBonusTxParams{
+				ReferralID:       referral.ID,
+				Amount:           processor.config.ReferralBonusAmount,
+				Currency:         processor.config.ReferralBonusCurrency,
+				FundingAccountID: processor.config.ReferralFundingAccount,
+				MaxPerReferrer:   processor.config.ReferralMaxPerReferrer,
+				MaxPerIP:         processor.config.ReferralMaxPerIP,
+			})
+			switch {
+			case errors.Is(err, db.ErrReferralNoAccount), errors.Is(err, db.ErrReferralNotPending):
+				skipped++
+			case err != nil:
+				log.Ctx(ctx).Error().Err(err).
+					Int64("referral_id", referral.ID).Msg("failed to credit referral bonus")
+				failed++
+			case result.ReferrerBonus == nil:
+				log.Ctx(ctx).Info().Int64("referral_id", referral.ID).
+					Str("reason", result.Referral.RejectReason).Msg("rejected referral")
+				rejected++
+			default:
+				credited++
+			}
+		}
+
+		if len(referrals) < creditReferralBonusesBatchSize {
+			break
+		}
+	}
+
+	log.Ctx(ctx).Info().Int("credited", credited).Int("rejected", rejected).
+		Int("skipped", skipped).Int("failed", failed).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+func TestProcessTaskCreditReferralBonuses(t *testing.T) {
+	config := util.Config{
+		ReferralBonusAmount:    500,
+		ReferralBonusCurrency:  util.USD,
+		ReferralFundingAccount: 9,
+		ReferralMaxPerReferrer: 10,
+		ReferralMaxPerIP:       3,
+	}
+
+	testCases := []struct {
+		name       string
+		config     util.Config
+		buildStubs func(store *mockdb.MockStore)
+	}{
+		{
+			name:   "OK",
+			config: config,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListEligibleReferrals(gomock.Any(), gomock.Eq(db.ListEligibleReferralsParams{ID: 0, Limit: creditReferralBonusesBatchSize})).
+					Times(1).
+					Return([]db.Referral{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}, nil)
+
+				store.EXPECT().
+					CreditReferralBonusTx(gomock.Any(), gomock.Eq(db.CreditReferralBonusTxParams{
+						ReferralID:       1,
+						Amount:           500,
+						Currency:         util.USD,
+						FundingAccountID: 9,
+						MaxPerReferrer:   10,
+						MaxPerIP:         3,
+					})).
+					Times(1).
+					Return(db.CreditReferralBonusTxResult{
+						ReferrerBonus: &db.TransferTxResult{},
+						ReferredBonus: &db.TransferTxResult{},
+					}, nil)
+				store.EXPECT().
+					CreditReferralBonusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreditReferralBonusTxResult{
+						Referral: db.Referral{ID: 2, Status: util.ReferralRejected, RejectReason: "sign up IP bonus limit reached"},
+					}, nil)
+				store.EXPECT().
+					CreditReferralBonusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreditReferralBonusTxResult{}, db.ErrReferralNoAccount)
+				// 一个推荐失败不影响后面的推荐
+				store.EXPECT().
+					CreditReferralBonusTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreditReferralBonusTxResult{}, sql.ErrConnDone)
+			},
+		},
+		{
+			name: "Disabled",
+			config: util.Config{
+				ReferralBonusCurrency:  util.USD,
+				ReferralFundingAccount: 9,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListEligibleReferrals(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreditReferralBonusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "NoFundingAccount",
+			config: util.Config{
+				ReferralBonusAmount:   500,
+				ReferralBonusCurrency: util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListEligibleReferrals(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreditReferralBonusTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			processor := &RedisTaskProcessor{
+				config: tc.config,
+				store:  store,
+			}
+
+			err := processor.ProcessTaskCreditReferralBonuses(context.Background(), asynq.NewTask(TaskCreditReferralBonuses, nil))
+			require.NoError(t, err)
+		})
+	}
+}
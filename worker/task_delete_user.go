@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+用户自己申请删除账号时，gapi 的 DeleteUser 只记下删除的时间（ACCOUNT_DELETION_DELAY 之后），
+并分发一个在那个时间执行（asynq.ProcessAt）的 TaskDeleteUser，由这里调用 DeleteUserTx 真正删除账号，
+之后和管理员删除的用户一样安排匿名化任务。
+
+在这之前用户可以用邮件里的链接取消删除（gapi 的 CancelAccountDeletion），所以执行之前要检查
+用户的 deletion_scheduled_at 还是分发任务时的时间，取消之后这个任务就直接跳过。
+如果这期间账户又收到了钱，余额不为 0 不能删除，就取消这次删除，让用户登录之后把钱转走再重新申请。
+*/
+
+const TaskDeleteUser = "task:delete_user"
+
+type PayloadDeleteUser struct {
+	Username string `json:"username"`
+	// ScheduledAt 是分发任务时用户的 deletion_scheduled_at
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskDeleteUser(
+	ctx context.Context,
+	payload *PayloadDeleteUser,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskDeleteUser, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskDeleteUser(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDeleteUser
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			log.Ctx(ctx).Warn().Str("username", payload.Username).Msg("user doesn't exist, skip deletion")
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !isDeletionScheduled(user, payload.ScheduledAt) {
+		log.Ctx(ctx).Info().Str("username", payload.Username).
+			Msg("deletion was cancelled, skip")
+		return nil
+	}
+
+	_, err = processor.store.DeleteUserTx(ctx, db.DeleteUserTxParams{
+		Username: payload.Username,
+		AfterDelete: func(user db.User) error {
+			taskPayload := &PayloadAnonymizeUser{
+				Username:  user.Username,
+				AvatarKey: user.AvatarKey,
+			}
+			return processor.distributor.DistributeTaskAnonymizeUser(ctx, taskPayload,
+				asynq.MaxRetry(10), asynq.ProcessIn(processor.config.UserDataRetention), asynq.Queue(QueueDefault))
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrAccountBalanceNotZero) {
+			return processor.cancelUserDeletion(ctx, payload)
+		}
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("username", payload.Username).Msg("processed task")
+	return nil
+}
+
+// cancelUserDeletion 在账户还有余额时取消这次删除，用户可以重新登录
+func (processor *RedisTaskProcessor) cancelUserDeletion(ctx context.Context, payload PayloadDeleteUser) error {
+	_, err := processor.store.CancelUserDeletion(ctx, db.CancelUserDeletionParams{
+		Username:            payload.Username,
+		DeletionScheduledAt: pgtype.Timestamptz{Time: payload.ScheduledAt, Valid: true},
+	})
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		return fmt.Errorf("failed to cancel user deletion: %w", err)
+	}
+
+	log.Ctx(ctx).Warn().Str("username", payload.Username).
+		Msg("user still has accounts with non-zero balance, deletion cancelled")
+	return nil
+}
+
+// isDeletionScheduled 检查用户是否还在等待 scheduledAt 这次删除，取消之后或者重新申请之后都不是
+func isDeletionScheduled(user db.User, scheduledAt time.Time) bool {
+	return !user.DeletedAt.Valid &&
+		user.DeletionScheduledAt.Valid &&
+		user.DeletionScheduledAt.Time.Equal(scheduledAt)
+}
@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+// anonymizeRecorder 记录分发的匿名化任务
+type anonymizeRecorder struct {
+	TaskDistributor
+	payloads []*PayloadAnonymizeUser
+}
+
+func (recorder *anonymizeRecorder) DistributeTaskAnonymizeUser(ctx context.Context, payload *PayloadAnonymizeUser, opts ...asynq.Option) error {
+	recorder.payloads = append(recorder.payloads, payload)
+	return nil
+}
+
+func TestProcessTaskDeleteUser(t *testing.T) {
+	scheduledAt := sampleTime
+	user := db.User{
+		Username:            "alice",
+		DeletionScheduledAt: pgtype.Timestamptz{Time: scheduledAt, Valid: true},
+	}
+
+	data, err := encodeTaskPayload(&PayloadDeleteUser{Username: user.Username, ScheduledAt: scheduledAt}, "")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *anonymizeRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.DeleteUserTxParams) (db.DeleteUserTxResult, error) {
+						require.Equal(t, user.Username, arg.Username)
+						return db.DeleteUserTxResult{User: user}, arg.AfterDelete(user)
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *anonymizeRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []*PayloadAnonymizeUser{{Username: user.Username}}, recorder.payloads)
+			},
+		},
+		{
+			name: "DeletionCancelled",
+			buildStubs: func(store *mockdb.MockStore) {
+				cancelled := user
+				cancelled.DeletionScheduledAt = pgtype.Timestamptz{}
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(cancelled, nil)
+				store.EXPECT().DeleteUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *anonymizeRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.payloads)
+			},
+		},
+		{
+			// 取消之后又重新申请了删除，由新的任务处理
+			name: "Rescheduled",
+			buildStubs: func(store *mockdb.MockStore) {
+				rescheduled := user
+				rescheduled.DeletionScheduledAt = pgtype.Timestamptz{Time: scheduledAt.Add(time.Hour), Valid: true}
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(rescheduled, nil)
+				store.EXPECT().DeleteUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *anonymizeRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.payloads)
+			},
+		},
+		{
+			name: "UserNotFound",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(1).Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().DeleteUserTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *anonymizeRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.payloads)
+			},
+		},
+		{
+			name: "BalanceNotZero",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().
+					DeleteUserTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.DeleteUserTxResult{}, db.ErrAccountBalanceNotZero)
+				store.EXPECT().
+					CancelUserDeletion(gomock.Any(), gomock.Eq(db.CancelUserDeletionParams{
+						Username:            user.Username,
+						DeletionScheduledAt: user.DeletionScheduledAt,
+					})).
+					Times(1).
+					Return(db.User{Username: user.Username}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *anonymizeRecorder, err error) {
+				require.NoError(t, err)
+				require.Empty(t, recorder.payloads)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			recorder := &anonymizeRecorder{}
+			processor := &RedisTaskProcessor{store: store, distributor: recorder}
+
+			err := processor.ProcessTaskDeleteUser(context.Background(), asynq.NewTask(TaskDeleteUser, data))
+			tc.checkResponse(t, recorder, err)
+		})
+	}
+}
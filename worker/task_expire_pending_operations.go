@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+由 scheduler 每 5 分钟触发一次，找出超过 PENDING_OPERATION_TIMEOUT 还没有被确认的操作，
+给每个操作分发一个 TaskReversePendingOperation。
+
+这里只负责分发，真正的撤销在 ProcessTaskReversePendingOperation 里完成，
+每个操作单独重试。如果同一个操作被分发了两次，第二次会因为状态已经不是 pending 而被忽略。
+*/
+
+const TaskExpirePendingOperations = "task:expire_pending_operations"
+
+const ExpirePendingOperationsCronspec = "@every 5m"
+
+// expirePendingOperationsBatchSize 限制每次处理的数量，剩下的留给下一次
+const expirePendingOperationsBatchSize = 100
+
+func (processor *RedisTaskProcessor) ProcessTaskExpirePendingOperations(ctx context.Context, task *asynq.Task) error {
+	createdBefore := time.Now().Add(-processor.config.PendingOperationTimeout)
+
+	operations, err := processor.store.ListStalePendingOperations(ctx, db.ListStalePendingOperationsParams{
+		CreatedAt: createdBefore,
+		Limit:     expirePendingOperationsBatchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list stale pending operations: %w", err)
+	}
+
+	for _, operation := range operations {
+		err := processor.distributor.DistributeTaskReversePendingOperation(ctx, &PayloadReversePendingOperation{
+			OperationID: operation.ID,
+			Reason:      "operation timed out",
+		}, asynq.MaxRetry(10), asynq.Queue(QueueCritical))
+		if err != nil {
+			return fmt.Errorf("failed to distribute reverse pending operation: %w", err)
+		}
+	}
+
+	log.Ctx(ctx).Info().Int("expired", len(operations)).Msg("processed task")
+	return nil
+}
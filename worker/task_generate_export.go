@@ -0,0 +1,337 @@
+package worker
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/exporter"
+	"github.com/techschool/bank/util"
+)
+
+/*
+TaskGenerateExport 把一个账户的全部转账或者分录导出成 CSV，保存到 blob store 的 exports/<username>/<任务 ID>.csv。
+分录还可以导出成 OFX、QIF 或者 camt.053 的对账单（见 exporter 包），文件的扩展名跟着格式变。
+导出的数据可能很多，所以不在 StartExport 请求里同步生成：
+RPC 只创建 export_jobs 记录并分发任务，客户端用 GetExportStatus 轮询进度，完成后拿到下载链接。
+
+CSV 一边分页查询一边通过 io.Pipe 写进 blob store，不会把整个文件放在内存里；
+每写完一页更新一次 processed_rows。
+任务重试时从头重新导出，同一个 key 的文件会被覆盖；最后一次重试也失败时把任务标记为 failed。
+*/
+const TaskGenerateExport = "task:generate_export"
+
+// exportPageSize 是导出时每次从数据库读取的行数，也是更新进度的间隔
+const exportPageSize = 1000
+
+type PayloadGenerateExport struct {
+	ExportJobID int64 `json:"export_job_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskGenerateExport(
+	ctx context.Context,
+	payload *PayloadGenerateExport,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskGenerateExport, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskGenerateExport(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadGenerateExport
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	job, err := processor.store.GetExportJob(ctx, payload.ExportJobID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("export job doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get export job: %w", err)
+	}
+
+	if job.Status == util.ExportCompleted || job.Status == util.ExportFailed {
+		log.Ctx(ctx).Info().Int64("export_job_id", job.ID).
+			Str("status", job.Status).Msg("export job already finished, skip task")
+		return nil
+	}
+
+	if processor.blobStore == nil {
+		processor.failExport(ctx, job, "exports are not enabled")
+		return fmt.Errorf("blob store is not configured: %w", asynq.SkipRetry)
+	}
+
+	job, err = processor.generateExport(ctx, job)
+	if err != nil {
+		if isLastRetry(ctx) {
+			processor.failExport(ctx, job, err.Error())
+		}
+		return fmt.Errorf("failed to generate export: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("export_job_id", job.ID).
+		Int64("rows", job.ProcessedRows).Str("key", job.FileKey).Msg("processed task")
+	return nil
+}
+
+// generateExport writes the CSV file of the export job to the blob store and marks the job as completed
+func (processor *RedisTaskProcessor) generateExport(ctx context.Context, job db.ExportJob) (db.ExportJob, error) {
+	var total int64
+	var statement db.GetAccountStatementRow
+	var err error
+	switch {
+	case exporter.IsStatementFormat(job.Format):
+		// 余额和最后一条分录在同一个查询里取，对账单的期末余额和导出的分录一致
+		statement, err = processor.store.GetAccountStatement(ctx, job.AccountID)
+		total = statement.EntryCount
+	case job.Kind == util.ExportTransfers:
+		total, err = processor.store.CountAccountTransfers(ctx, job.AccountID)
+	case job.Kind == util.ExportEntries:
+		total, err = processor.store.CountAccountEntries(ctx, job.AccountID)
+	default:
+		return job, fmt.Errorf("unsupported export kind %q", job.Kind)
+	}
+	if err != nil {
+		return job, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	job, err = processor.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+		ID:            job.ID,
+		Status:        pgtype.Text{String: util.ExportRunning, Valid: true},
+		ProcessedRows: pgtype.Int8{Int64: 0, Valid: true},
+		TotalRows:     pgtype.Int8{Int64: total, Valid: true},
+	})
+	if err != nil {
+		return job, fmt.Errorf("failed to start export job: %w", err)
+	}
+
+	// 写 CSV 的 goroutine 出错时关闭 pipe，Put 会读到这个错误；Put 出错时关闭读的一端，让写的一端停下来
+	reader, writer := io.Pipe()
+	done := make(chan int64, 1)
+	go func() {
+		var processed int64
+		var err error
+		if exporter.IsStatementFormat(job.Format) {
+			processed, err = processor.writeExportStatement(ctx, job, statement, writer)
+		} else {
+			processed, err = processor.writeExportCSV(ctx, job, writer)
+		}
+		writer.CloseWithError(err)
+		done <- processed
+	}()
+
+	key := fmt.Sprintf("exports/%s/%d.%s", job.Owner, job.ID, exporter.Extension(job.Format))
+	err = processor.blobStore.Put(ctx, key, reader, -1, exporter.ContentType(job.Format))
+	reader.CloseWithError(err)
+	processed := <-done
+	if err != nil {
+		return job, fmt.Errorf("failed to store export: %w", err)
+	}
+
+	job, err = processor.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+		ID:            job.ID,
+		Status:        pgtype.Text{String: util.ExportCompleted, Valid: true},
+		ProcessedRows: pgtype.Int8{Int64: processed, Valid: true},
+		FileKey:       pgtype.Text{String: key, Valid: true},
+		CompletedAt:   pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return job, fmt.Errorf("failed to complete export job: %w", err)
+	}
+	return job, nil
+}
+
+// writeExportCSV writes the rows of the export job page by page and returns the number of rows written
+func (processor *RedisTaskProcessor) writeExportCSV(ctx context.Context, job db.ExportJob, w io.Writer) (int64, error) {
+	writer := csv.NewWriter(w)
+	var processed int64
+	var afterID int64
+
+	switch job.Kind {
+	case util.ExportTransfers:
+		writer.Write([]string{"id", "created_at", "from_account_id", "to_account_id", "amount", "category", "memo", "reference"})
+	case util.ExportEntries:
+		writer.Write([]string{"id", "created_at", "transfer_id", "amount"})
+	}
+
+	for {
+		var rows [][]string
+		switch job.Kind {
+		case util.ExportTransfers:
+			transfers, err := processor.store.ListTransfers(ctx, db.ListTransfersParams{
+				FromAccountID: job.AccountID,
+				ToAccountID:   job.AccountID,
+				AfterID:       afterID,
+				Limit:         exportPageSize,
+			})
+			if err != nil {
+				return processed, fmt.Errorf("failed to list transfers: %w", err)
+			}
+			for _, transfer := range transfers {
+				rows = append(rows, []string{
+					strconv.FormatInt(transfer.ID, 10),
+					transfer.CreatedAt.UTC().Format(time.RFC3339),
+					strconv.FormatInt(transfer.FromAccountID, 10),
+					strconv.FormatInt(transfer.ToAccountID, 10),
+					strconv.FormatInt(transfer.Amount, 10),
+					transfer.Category,
+					transfer.Memo,
+					transfer.Reference,
+				})
+				afterID = transfer.ID
+			}
+		case util.ExportEntries:
+			entries, err := processor.store.ListEntries(ctx, db.ListEntriesParams{
+				AccountID: job.AccountID,
+				AfterID:   afterID,
+				Limit:     exportPageSize,
+			})
+			if err != nil {
+				return processed, fmt.Errorf("failed to list entries: %w", err)
+			}
+			for _, entry := range entries {
+				transferID := ""
+				if entry.TransferID.Valid {
+					transferID = strconv.FormatInt(entry.TransferID.Int64, 10)
+				}
+				rows = append(rows, []string{
+					strconv.FormatInt(entry.ID, 10),
+					entry.CreatedAt.UTC().Format(time.RFC3339),
+					transferID,
+					strconv.FormatInt(entry.Amount, 10),
+				})
+				afterID = entry.ID
+			}
+		}
+
+		if err := writer.WriteAll(rows); err != nil {
+			return processed, err
+		}
+		processed += int64(len(rows))
+
+		if len(rows) < exportPageSize {
+			return processed, nil
+		}
+
+		_, err := processor.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+			ID:            job.ID,
+			ProcessedRows: pgtype.Int8{Int64: processed, Valid: true},
+		})
+		if err != nil {
+			return processed, fmt.Errorf("failed to update export progress: %w", err)
+		}
+	}
+}
+
+// writeExportStatement writes the entries of the export job as an accounting statement and returns the number of entries written
+func (processor *RedisTaskProcessor) writeExportStatement(ctx context.Context, job db.ExportJob, statement db.GetAccountStatementRow, w io.Writer) (int64, error) {
+	writer, err := exporter.NewWriter(job.Format, w)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	err = writer.WriteHeader(exporter.Statement{
+		ID:             fmt.Sprintf("EXPORT-%d", job.ID),
+		AccountNumber:  statement.Number,
+		Owner:          statement.FullName,
+		Currency:       statement.Currency,
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		From:           statement.CreatedAt,
+		To:             now,
+		CreatedAt:      now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var processed int64
+	var afterID int64
+	for {
+		rows, err := processor.store.ListStatementEntries(ctx, db.ListStatementEntriesParams{
+			AccountID: job.AccountID,
+			AfterID:   afterID,
+			LastID:    statement.LastEntryID,
+			Limit:     exportPageSize,
+		})
+		if err != nil {
+			return processed, fmt.Errorf("failed to list entries: %w", err)
+		}
+
+		entries := make([]exporter.Entry, 0, len(rows))
+		for _, row := range rows {
+			entries = append(entries, exporter.Entry{
+				ID:                    row.ID,
+				TransferID:            row.TransferID.Int64,
+				Amount:                row.Amount,
+				BookedAt:              row.CreatedAt,
+				Memo:                  row.Memo,
+				Reference:             row.Reference,
+				CounterpartyAccountID: row.CounterpartyAccountID,
+			})
+			afterID = row.ID
+		}
+		if err := writer.WriteEntries(entries); err != nil {
+			return processed, err
+		}
+		processed += int64(len(rows))
+
+		if len(rows) < exportPageSize {
+			return processed, writer.Close()
+		}
+
+		_, err = processor.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+			ID:            job.ID,
+			ProcessedRows: pgtype.Int8{Int64: processed, Valid: true},
+		})
+		if err != nil {
+			return processed, fmt.Errorf("failed to update export progress: %w", err)
+		}
+	}
+}
+
+// failExport marks the export job as failed, the error is only logged because the task fails anyway
+func (processor *RedisTaskProcessor) failExport(ctx context.Context, job db.ExportJob, reason string) {
+	_, err := processor.store.UpdateExportJob(ctx, db.UpdateExportJobParams{
+		ID:          job.ID,
+		Status:      pgtype.Text{String: util.ExportFailed, Valid: true},
+		Error:       pgtype.Text{String: reason, Valid: true},
+		CompletedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Int64("export_job_id", job.ID).Msg("failed to mark export job as failed")
+	}
+}
+
+// isLastRetry returns true if asynq won't retry the task again after it fails
+func isLastRetry(ctx context.Context) bool {
+	retried, ok := asynq.GetRetryCount(ctx)
+	if !ok {
+		return true
+	}
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	if !ok {
+		return true
+	}
+	return retried >= maxRetry
+}
@@ -0,0 +1,231 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
+)
+
+func TestGenerateExport(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	transfers := []db.Transfer{
+		{ID: 1, FromAccountID: 5, ToAccountID: 6, Amount: 100, CreatedAt: createdAt, Category: "food", Memo: "lunch, tea"},
+		{ID: 2, FromAccountID: 6, ToAccountID: 5, Amount: 20, CreatedAt: createdAt},
+	}
+	entries := []db.Entry{
+		{ID: 3, AccountID: 5, Amount: -100, CreatedAt: createdAt, TransferID: pgtype.Int8{Int64: 1, Valid: true}},
+		{ID: 4, AccountID: 5, Amount: 50, CreatedAt: createdAt},
+	}
+
+	testCases := []struct {
+		name       string
+		kind       string
+		buildStubs func(store *mockdb.MockStore)
+		checkCSV   func(t *testing.T, content string, err error)
+	}{
+		{
+			name: "Transfers",
+			kind: util.ExportTransfers,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountAccountTransfers(gomock.Any(), gomock.Eq(int64(5))).
+					Times(1).
+					Return(int64(len(transfers)), nil)
+				store.EXPECT().
+					ListTransfers(gomock.Any(), gomock.Eq(db.ListTransfersParams{
+						FromAccountID: 5,
+						ToAccountID:   5,
+						Limit:         exportPageSize,
+					})).
+					Times(1).
+					Return(transfers, nil)
+			},
+			checkCSV: func(t *testing.T, content string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "id,created_at,from_account_id,to_account_id,amount,category,memo,reference\n"+
+					"1,2024-03-01T12:00:00Z,5,6,100,food,\"lunch, tea\",\n"+
+					"2,2024-03-01T12:00:00Z,6,5,20,,,\n", content)
+			},
+		},
+		{
+			name: "Entries",
+			kind: util.ExportEntries,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountAccountEntries(gomock.Any(), gomock.Eq(int64(5))).
+					Times(1).
+					Return(int64(len(entries)), nil)
+				store.EXPECT().
+					ListEntries(gomock.Any(), gomock.Eq(db.ListEntriesParams{
+						AccountID: 5,
+						Limit:     exportPageSize,
+					})).
+					Times(1).
+					Return(entries, nil)
+			},
+			checkCSV: func(t *testing.T, content string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "id,created_at,transfer_id,amount\n"+
+					"3,2024-03-01T12:00:00Z,1,-100\n"+
+					"4,2024-03-01T12:00:00Z,,50\n", content)
+			},
+		},
+		{
+			name: "ListError",
+			kind: util.ExportTransfers,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CountAccountTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				store.EXPECT().
+					ListTransfers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil, errors.New("connection reset"))
+			},
+			checkCSV: func(t *testing.T, content string, err error) {
+				require.ErrorContains(t, err, "connection reset")
+				// 出错时不保存不完整的文件
+				require.Empty(t, content)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			job := db.ExportJob{ID: 7, Owner: "alice", AccountID: 5, Kind: tc.kind, Status: util.ExportPending}
+			var updates []db.UpdateExportJobParams
+			store.EXPECT().
+				UpdateExportJob(gomock.Any(), gomock.Any()).
+				AnyTimes().
+				DoAndReturn(func(ctx context.Context, arg db.UpdateExportJobParams) (db.ExportJob, error) {
+					updates = append(updates, arg)
+					if arg.Status.Valid {
+						job.Status = arg.Status.String
+					}
+					if arg.FileKey.Valid {
+						job.FileKey = arg.FileKey.String
+					}
+					return job, nil
+				})
+
+			blobStore, err := storage.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			processor := &RedisTaskProcessor{store: store, blobStore: blobStore}
+
+			result, err := processor.generateExport(context.Background(), job)
+
+			content := ""
+			if err == nil {
+				require.Equal(t, util.ExportCompleted, result.Status)
+				require.Equal(t, "exports/alice/7.csv", result.FileKey)
+
+				last := updates[len(updates)-1]
+				require.Equal(t, int64(2), last.ProcessedRows.Int64)
+				require.True(t, last.CompletedAt.Valid)
+
+				reader, err := blobStore.Get(context.Background(), result.FileKey)
+				require.NoError(t, err)
+				defer reader.Close()
+
+				data, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				content = string(data)
+			} else {
+				_, getErr := blobStore.Get(context.Background(), "exports/alice/7.csv")
+				require.ErrorIs(t, getErr, storage.ErrNotFound)
+			}
+
+			require.Equal(t, util.ExportRunning, updates[0].Status.String)
+			tc.checkCSV(t, content, err)
+		})
+	}
+}
+
+func TestGenerateStatementExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	createdAt := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	store.EXPECT().
+		GetAccountStatement(gomock.Any(), gomock.Eq(int64(5))).
+		Times(1).
+		Return(db.GetAccountStatementRow{
+			Number:         "4000123412341234",
+			Currency:       util.USD,
+			CreatedAt:      createdAt,
+			ClosingBalance: 950,
+			OpeningBalance: 1000,
+			EntryCount:     2,
+			LastEntryID:    4,
+		}, nil)
+	store.EXPECT().
+		ListStatementEntries(gomock.Any(), gomock.Eq(db.ListStatementEntriesParams{
+			AccountID: 5,
+			LastID:    4,
+			Limit:     exportPageSize,
+		})).
+		Times(1).
+		Return([]db.ListStatementEntriesRow{
+			{ID: 3, Amount: -100, CreatedAt: createdAt, TransferID: pgtype.Int8{Int64: 1, Valid: true}, Memo: "lunch", CounterpartyAccountID: 6},
+			{ID: 4, Amount: 50, CreatedAt: createdAt},
+		}, nil)
+
+	job := db.ExportJob{ID: 7, Owner: "alice", AccountID: 5, Kind: util.ExportEntries, Format: util.ExportQIF, Status: util.ExportPending}
+	store.EXPECT().
+		UpdateExportJob(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(ctx context.Context, arg db.UpdateExportJobParams) (db.ExportJob, error) {
+			if arg.Status.Valid {
+				job.Status = arg.Status.String
+			}
+			if arg.FileKey.Valid {
+				job.FileKey = arg.FileKey.String
+			}
+			if arg.ProcessedRows.Valid {
+				job.ProcessedRows = arg.ProcessedRows.Int64
+			}
+			return job, nil
+		})
+
+	blobStore, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	processor := &RedisTaskProcessor{store: store, blobStore: blobStore}
+
+	result, err := processor.generateExport(context.Background(), job)
+	require.NoError(t, err)
+	require.Equal(t, util.ExportCompleted, result.Status)
+	require.Equal(t, "exports/alice/7.qif", result.FileKey)
+	require.Equal(t, int64(2), result.ProcessedRows)
+
+	reader, err := blobStore.Get(context.Background(), result.FileKey)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "!Type:Bank\n"+
+		"D03/01/2024\nT10.00\nPOpening Balance\nL[4000123412341234]\n^\n"+
+		"D03/01/2024\nT-1.00\nPAccount #6\nMlunch\n^\n"+
+		"D03/01/2024\nT0.50\nPDeposit\n^\n", string(data))
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+)
+
+/*
+每天夜里由 scheduler 触发一次，用 entries 重新计算所有账户的余额并和 accounts.balance 对账，
+结果保存为一份 reconciliation report，管理员可以通过 GetReconciliationReport 查看。
+
+发现不一致时给 OPS_ALERT_EMAILS（逗号分隔）里的地址发邮件，没有配置的话只记录日志。
+邮件发送失败不返回错误，否则任务重试时会再生成一份报告。
+*/
+
+const TaskReconcileBalances = "task:reconcile_balances"
+
+// ReconcileBalancesCronspec 每天 02:00 (UTC) 执行，避开白天的转账高峰
+const ReconcileBalancesCronspec = "0 2 * * *"
+
+func (processor *RedisTaskProcessor) ProcessTaskReconcileBalances(ctx context.Context, task *asynq.Task) error {
+	result, err := processor.store.ReconcileBalancesTx(ctx, db.ReconcileBalancesTxParams{
+		StartedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile balances: %w", err)
+	}
+
+	logger := log.Ctx(ctx).Info()
+	if len(result.Discrepancies) > 0 {
+		logger = log.Ctx(ctx).Warn()
+		if err := processor.sendReconciliationAlert(result); err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Int64("report_id", result.Report.ID).Msg("failed to send reconciliation alert")
+		}
+	}
+
+	logger.Str("type", task.Type()).Int64("report_id", result.Report.ID).
+		Int64("accounts_checked", result.Report.AccountsChecked).
+		Int64("discrepancies", result.Report.DiscrepancyCount).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) sendReconciliationAlert(result db.ReconcileBalancesTxResult) error {
+	var to []string
+	for _, address := range strings.Split(processor.config.OpsAlertEmails, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			to = append(to, address)
+		}
+	}
+	if len(to) == 0 {
+		return nil
+	}
+
+	data := templates.ReconciliationReportData{
+		ReportID:        result.Report.ID,
+		AccountsChecked: result.Report.AccountsChecked,
+		StartedAt:       result.Report.StartedAt.Format(time.RFC3339),
+	}
+	for _, discrepancy := range result.Discrepancies {
+		data.Discrepancies = append(data.Discrepancies, templates.ReconciliationDiscrepancy{
+			AccountID:      discrepancy.AccountID,
+			AccountBalance: discrepancy.AccountBalance,
+			EntriesBalance: discrepancy.EntriesBalance,
+		})
+	}
+
+	email, err := templates.Render(templates.ReconciliationReport, templates.DefaultLocale, data)
+	if err != nil {
+		return err
+	}
+
+	return processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, to, nil, nil, nil)
+}
@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+TaskReversePendingOperation 是 saga 的补偿步骤：
+外部系统返回失败，或者操作一直没有被确认（见 task_expire_pending_operations.go），
+就做一笔反向转账把钱退回转出账户，并记录失败原因。
+
+撤销成功之后再分发一个转账提醒，让双方都知道这笔钱被退回了。
+*/
+
+const TaskReversePendingOperation = "task:reverse_pending_operation"
+
+type PayloadReversePendingOperation struct {
+	OperationID int64  `json:"operation_id"`
+	Reason      string `json:"reason"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskReversePendingOperation(
+	ctx context.Context,
+	payload *PayloadReversePendingOperation,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskReversePendingOperation, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskReversePendingOperation(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadReversePendingOperation
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	result, err := processor.store.ReversePendingOperationTx(ctx, db.ReversePendingOperationTxParams{
+		OperationID: payload.OperationID,
+		Reason:      payload.Reason,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("operation doesn't exist: %w", asynq.SkipRetry)
+		}
+		if errors.Is(err, db.ErrOperationNotPending) {
+			log.Ctx(ctx).Warn().Int64("operation_id", payload.OperationID).
+				Msg("operation is no longer pending")
+			return nil
+		}
+		return fmt.Errorf("failed to reverse pending operation: %w", err)
+	}
+
+	// 钱已经退回去了，提醒分发失败只记录日志，避免重试时报 operation is no longer pending。
+	// 转账之前已经被 banker 冲正过的话，双方那时已经收到过提醒了
+	if !result.AlreadyReversed {
+		err = processor.distributor.DistributeTaskSendTransferAlert(ctx, &PayloadSendTransferAlert{
+			TransferID:    result.Reversal.Transfer.ID,
+			BalanceAlerts: result.Reversal.BalanceAlerts,
+		}, asynq.MaxRetry(5), asynq.Queue(QueueDefault))
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Int64("transfer_id", result.Reversal.Transfer.ID).
+				Msg("failed to distribute transfer alert")
+		}
+	}
+
+	log.Ctx(ctx).Info().Int64("operation_id", result.Operation.ID).
+		Int64("reversal_transfer_id", result.Reversal.Transfer.ID).Msg("processed task")
+	return nil
+}
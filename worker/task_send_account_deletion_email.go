@@ -0,0 +1,102 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/util"
+)
+
+/*
+用户申请删除账号之后，gapi 的 DeleteUser 分发 TaskSendAccountDeletionEmail，告诉用户账号会在什么时候删除。
+邮件里的取消链接指向 CANCEL_DELETION_PATH，带上用户名的签名，链接在删除的时间过期；
+用户已经取消了删除的话就不再发送。
+*/
+
+const TaskSendAccountDeletionEmail = "task:send_account_deletion_email"
+
+type PayloadSendAccountDeletionEmail struct {
+	Username    string    `json:"username"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendAccountDeletionEmail(
+	ctx context.Context,
+	payload *PayloadSendAccountDeletionEmail,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendAccountDeletionEmail, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendAccountDeletionEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAccountDeletionEmail
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !isDeletionScheduled(user, payload.ScheduledAt) {
+		log.Ctx(ctx).Info().Str("username", payload.Username).
+			Msg("deletion was cancelled, skip")
+		return nil
+	}
+
+	cancelURL, err := processor.cancelDeletionURL(user.Username, payload.ScheduledAt)
+	if err != nil {
+		return fmt.Errorf("failed to build cancel deletion link: %w", err)
+	}
+
+	email, err := templates.Render(templates.AccountDeletionScheduled, user.Locale, templates.AccountDeletionScheduledData{
+		FullName:     user.FullName,
+		DeletionTime: templates.FormatTime(payload.ScheduledAt.UTC(), user.Locale),
+		CancelURL:    cancelURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send account deletion email: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("username", user.Username).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
+
+// cancelDeletionURL 生成取消删除的链接，参数和 gapi 的 CancelAccountDeletion 校验签名时用的一致
+func (processor *RedisTaskProcessor) cancelDeletionURL(username string, scheduledAt time.Time) (string, error) {
+	params := url.Values{}
+	params.Set("username", username)
+
+	signer := util.NewLinkSigner(processor.config.LinkSigningKey, util.LinkPurposeCancelAccountDeletion)
+	params = signer.Sign(params, scheduledAt)
+
+	return util.BuildLink(processor.config.FrontendBaseURL, processor.config.CancelDeletionPath, params)
+}
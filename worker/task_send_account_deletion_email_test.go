@@ -0,0 +1,39 @@
+package worker
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/util"
+)
+
+func TestCancelDeletionURL(t *testing.T) {
+	config := util.Config{
+		LinkSigningKey:     util.RandomString(32),
+		FrontendBaseURL:    "https://bank.example.com",
+		CancelDeletionPath: "/v1/cancel_account_deletion",
+	}
+	processor := &RedisTaskProcessor{config: config}
+	scheduledAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	link, err := processor.cancelDeletionURL("alice", scheduledAt)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	require.Equal(t, "/v1/cancel_account_deletion", parsed.Path)
+
+	// 链接在删除的时间过期，gapi 用 expires_at 找到要取消的那次删除
+	params := parsed.Query()
+	require.Equal(t, "alice", params.Get("username"))
+	require.Equal(t, strconv.FormatInt(scheduledAt.Unix(), 10), params.Get(util.LinkExpiresAtParam))
+
+	signer := util.NewLinkSigner(config.LinkSigningKey, util.LinkPurposeCancelAccountDeletion)
+	require.NoError(t, signer.Verify(params))
+
+	params.Set("username", "bob")
+	require.ErrorIs(t, signer.Verify(params), util.ErrInvalidLinkSignature)
+}
@@ -0,0 +1,101 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/util"
+)
+
+/*
+TaskSendAccountExistsEmail 在有人用已经注册过的邮箱注册时通知这个邮箱原来的主人。
+为了不让别人通过注册接口探测哪些邮箱已经注册，CreateUser 对这种情况返回和注册成功一样的响应（见 EMAIL_ENUMERATION_PROTECTION），
+真正的情况记到审计日志里，并且只告诉邮箱的主人。
+*/
+const TaskSendAccountExistsEmail = "task:send_account_exists_email"
+
+type PayloadSendAccountExistsEmail struct {
+	Email string `json:"email"`
+	// AttemptedUsername 是这次尝试注册的用户名，只记在审计日志里
+	AttemptedUsername string `json:"attempted_username"`
+	ClientIp          string `json:"client_ip"`
+	Country           string `json:"country"`
+	City              string `json:"city"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendAccountExistsEmail(
+	ctx context.Context,
+	payload *PayloadSendAccountExistsEmail,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendAccountExistsEmail, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	// 载荷里有邮箱地址，日志里不记录载荷
+	log.Ctx(ctx).Info().Str("type", task.Type()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendAccountExistsEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAccountExistsEmail
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	// 注册和发送之间邮箱的主人可能已经删除了账户或者改了邮箱，这时不用再通知
+	user, err := processor.store.GetUserByEmail(ctx, payload.Email)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("email doesn't belong to any user: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// 重试时审计日志可能会重复记录，比漏记好
+	details, err := json.Marshal(map[string]string{"attempted_username": payload.AttemptedUsername})
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit log details: %w", err)
+	}
+	_, err = processor.store.CreateAuditLog(ctx, db.CreateAuditLogParams{
+		Actor:        util.AuditActorAnonymous,
+		Action:       util.AuditDuplicateEmailSignup,
+		ResourceType: util.AuditResourceUser,
+		ResourceID:   user.Username,
+		Details:      details,
+		ClientIp:     payload.ClientIp,
+		Country:      payload.Country,
+		City:         payload.City,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+
+	email, err := templates.Render(templates.AccountExists, user.Locale, templates.AccountExistsData{
+		FullName: user.FullName,
+		Username: user.Username,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send account exists email: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("username", user.Username).Msg("processed task")
+	return nil
+}
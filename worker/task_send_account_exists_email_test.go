@@ -0,0 +1,105 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+// emailRecorder 记录发送的邮件
+type emailRecorder struct {
+	subjects   []string
+	recipients []string
+}
+
+func (recorder *emailRecorder) SendEmail(subject string, content string, textContent string, to []string, cc []string, bcc []string, attachFiles []string) error {
+	recorder.subjects = append(recorder.subjects, subject)
+	recorder.recipients = append(recorder.recipients, to...)
+	return nil
+}
+
+func TestSendAccountExistsEmail(t *testing.T) {
+	owner := db.User{Username: "alice", FullName: "Alice", Email: "alice@example.com", Locale: "en"}
+	payload := PayloadSendAccountExistsEmail{
+		Email:             owner.Email,
+		AttemptedUsername: "mallory",
+		ClientIp:          "1.2.3.4",
+	}
+	jsonPayload, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkEmail func(t *testing.T, recorder *emailRecorder, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Eq(owner.Email)).
+					Times(1).
+					Return(owner, nil)
+				store.EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateAuditLogParams) (db.AuditLog, error) {
+						require.Equal(t, util.AuditActorAnonymous, arg.Actor)
+						require.Equal(t, util.AuditDuplicateEmailSignup, arg.Action)
+						require.Equal(t, util.AuditResourceUser, arg.ResourceType)
+						require.Equal(t, owner.Username, arg.ResourceID)
+						require.JSONEq(t, `{"attempted_username":"mallory"}`, string(arg.Details))
+						require.Equal(t, payload.ClientIp, arg.ClientIp)
+						return db.AuditLog{}, nil
+					})
+			},
+			checkEmail: func(t *testing.T, recorder *emailRecorder, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{owner.Email}, recorder.recipients)
+				require.Equal(t, []string{"You already have a Simple Bank account"}, recorder.subjects)
+			},
+		},
+		{
+			name: "EmailNotRegistered",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserByEmail(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.User{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateAuditLog(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkEmail: func(t *testing.T, recorder *emailRecorder, err error) {
+				require.True(t, errors.Is(err, asynq.SkipRetry))
+				require.Empty(t, recorder.recipients)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			recorder := &emailRecorder{}
+			processor := &RedisTaskProcessor{store: store, mailer: recorder}
+
+			err := processor.ProcessTaskSendAccountExistsEmail(context.Background(), asynq.NewTask(TaskSendAccountExistsEmail, jsonPayload))
+			tc.checkEmail(t, recorder, err)
+		})
+	}
+}
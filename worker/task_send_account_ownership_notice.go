@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+)
+
+/*
+TaskSendAccountOwnershipNotice 在 banker 把账户转给另一个用户之后通知双方。
+原来的所有者和新的所有者各分发一个任务，这样一封邮件发送失败重试时不会给另一方重复发邮件。
+*/
+const TaskSendAccountOwnershipNotice = "task:send_account_ownership_notice"
+
+type PayloadSendAccountOwnershipNotice struct {
+	// Username 是收件人，是 PreviousOwner 或者 NewOwner 之一
+	Username      string    `json:"username"`
+	AccountID     int64     `json:"account_id"`
+	Currency      string    `json:"currency"`
+	PreviousOwner string    `json:"previous_owner"`
+	NewOwner      string    `json:"new_owner"`
+	TransferredAt time.Time `json:"transferred_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendAccountOwnershipNotice(
+	ctx context.Context,
+	payload *PayloadSendAccountOwnershipNotice,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendAccountOwnershipNotice, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendAccountOwnershipNotice(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendAccountOwnershipNotice
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	email, err := templates.Render(templates.AccountOwnershipTransferred, user.Locale, templates.AccountOwnershipTransferredData{
+		FullName:      user.FullName,
+		AccountID:     payload.AccountID,
+		Currency:      payload.Currency,
+		PreviousOwner: payload.PreviousOwner,
+		NewOwner:      payload.NewOwner,
+		TransferTime:  templates.FormatTime(payload.TransferredAt.UTC(), user.Locale),
+		Received:      user.Username == payload.NewOwner,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send account ownership notice: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("account_id", payload.AccountID).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
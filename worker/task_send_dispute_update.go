@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+)
+
+/*
+TaskSendDisputeUpdate 在争议打开和每次状态变化之后给打开争议的用户发邮件。
+Status 和 UpdatedAt 是分发任务时争议的状态，任务延迟执行时争议可能已经变成了下一个状态，
+邮件里还是描述这一次的变化，下一次变化会有自己的邮件。
+*/
+const TaskSendDisputeUpdate = "task:send_dispute_update"
+
+type PayloadSendDisputeUpdate struct {
+	DisputeID int64     `json:"dispute_id"`
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendDisputeUpdate(
+	ctx context.Context,
+	payload *PayloadSendDisputeUpdate,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendDisputeUpdate, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendDisputeUpdate(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendDisputeUpdate
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	dispute, err := processor.store.GetDispute(ctx, payload.DisputeID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("dispute doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get dispute: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, dispute.OpenedBy)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("user doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	email, err := templates.Render(templates.DisputeUpdated, user.Locale, templates.DisputeUpdatedData{
+		FullName:   user.FullName,
+		DisputeID:  dispute.ID,
+		TransferID: dispute.TransferID,
+		Status:     payload.Status,
+		Resolution: dispute.Resolution,
+		UpdateTime: templates.FormatTime(payload.UpdatedAt.UTC(), user.Locale),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send dispute update: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("dispute_id", dispute.ID).Str("status", payload.Status).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+)
+
+/*
+TaskSendExternalTransferWebhook 在外部转账状态变化之后通知 EXTERNAL_WEBHOOK_URL，
+事件类型是 "external_transfer.<status>"，请求体是外部转账当前的记录。
+接收方返回非 2xx 时任务会按 asynq 的退避策略重试；没有配置地址时直接跳过。
+*/
+
+const TaskSendExternalTransferWebhook = "task:send_external_transfer_webhook"
+
+type PayloadSendExternalTransferWebhook struct {
+	ExternalTransferID int64  `json:"external_transfer_id"`
+	Status             string `json:"status"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendExternalTransferWebhook(
+	ctx context.Context,
+	payload *PayloadSendExternalTransferWebhook,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendExternalTransferWebhook, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendExternalTransferWebhook(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendExternalTransferWebhook
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	if processor.webhook == nil {
+		log.Ctx(ctx).Debug().Msg("external webhook is not configured")
+		return nil
+	}
+
+	externalTransfer, err := processor.store.GetExternalTransfer(ctx, payload.ExternalTransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("external transfer doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get external transfer: %w", err)
+	}
+
+	err = processor.webhook.Send(ctx, "external_transfer."+payload.Status, externalTransfer)
+	if err != nil {
+		return fmt.Errorf("failed to send external transfer webhook: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("external_transfer_id", externalTransfer.ID).
+		Str("status", payload.Status).Msg("processed task")
+	return nil
+}
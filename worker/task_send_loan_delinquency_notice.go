@@ -0,0 +1,110 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/util"
+)
+
+// TaskSendLoanDelinquencyNotice 在贷款被标记为 delinquent 时给借款人发邮件，提醒他往账户里存钱
+const TaskSendLoanDelinquencyNotice = "task:send_loan_delinquency_notice"
+
+type PayloadSendLoanDelinquencyNotice struct {
+	LoanID int64 `json:"loan_id"`
+	// PaymentID 是逾期的那一期
+	PaymentID int64 `json:"payment_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendLoanDelinquencyNotice(
+	ctx context.Context,
+	payload *PayloadSendLoanDelinquencyNotice,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendLoanDelinquencyNotice, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendLoanDelinquencyNotice(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendLoanDelinquencyNotice
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	loan, err := processor.store.GetLoan(ctx, payload.LoanID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("loan doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get loan: %w", err)
+	}
+
+	// 通知发出之前借款人已经还上了
+	if loan.Status != util.LoanDelinquent {
+		log.Ctx(ctx).Info().Int64("loan_id", loan.ID).
+			Str("status", loan.Status).Msg("loan is no longer delinquent, skip")
+		return nil
+	}
+
+	payments, err := processor.store.ListLoanPayments(ctx, loan.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list loan payments: %w", err)
+	}
+
+	var payment *db.LoanPayment
+	for i := range payments {
+		if payments[i].ID == payload.PaymentID {
+			payment = &payments[i]
+			break
+		}
+	}
+	if payment == nil {
+		return fmt.Errorf("loan payment doesn't exist: %w", asynq.SkipRetry)
+	}
+
+	account, err := processor.store.GetAccount(ctx, loan.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, account.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	email, err := templates.Render(templates.LoanDelinquent, user.Locale, templates.LoanDelinquentData{
+		FullName:    user.FullName,
+		LoanID:      loan.ID,
+		Installment: payment.Installment,
+		AmountDue:   util.Money{Amount: payment.Amount, Currency: loan.Currency}.String(),
+		DueDate:     templates.FormatDate(payment.DueDate.UTC(), user.Locale),
+		AccountID:   account.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send loan delinquency notice: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Int64("loan_id", loan.ID).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/storage"
+)
+
+/*
+每个月初由 scheduler（asynq.PeriodicTaskManager）触发一次，
+统计上个月每个账户的转入、转出总额以及月底余额，
+按用户汇总成一封邮件发出去，邮件内容用 mail/templates 里的 statement 模板渲染。
+配置了 blob store 时，还会生成一份 CSV 对账单保存到 statements/<username>/<月份>.csv，
+作为邮件附件发送，邮件里带一个 7 天有效的下载链接。
+
+任务本身没有 payload，处理的时候根据当前时间算出上个月的时间范围。
+某个用户的邮件发送失败只记录日志，不返回错误，
+否则整个任务重试时会给已经发过的用户再发一遍。
+*/
+
+const TaskSendMonthlySummary = "task:send_monthly_summary"
+
+// MonthlySummaryCronspec 每个月 1 号 00:00 (UTC) 执行
+const MonthlySummaryCronspec = "0 0 1 * *"
+
+// statementURLExpiry 是对账单下载链接的有效期，S3 预签名 URL 最长就是 7 天
+const statementURLExpiry = 7 * 24 * time.Hour
+
+func (processor *RedisTaskProcessor) ProcessTaskSendMonthlySummary(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	endTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	startTime := endTime.AddDate(0, -1, 0)
+
+	summaries, err := processor.store.ListMonthlyAccountSummaries(ctx, db.ListMonthlyAccountSummariesParams{
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list monthly account summaries: %w", err)
+	}
+
+	// 查询结果按 owner 排序，同一个用户的账户是连续的
+	sent := 0
+	for i := 0; i < len(summaries); {
+		data := templates.StatementData{
+			FullName: summaries[i].FullName,
+			Month:    templates.FormatMonth(startTime, summaries[i].Locale),
+		}
+
+		j := i
+		for ; j < len(summaries) && summaries[j].Owner == summaries[i].Owner; j++ {
+			data.Accounts = append(data.Accounts, templates.StatementAccount{
+				AccountID:     summaries[j].AccountID,
+				Currency:      summaries[j].Currency,
+				TotalIn:       summaries[j].TotalIn,
+				TotalOut:      summaries[j].TotalOut,
+				EndingBalance: summaries[j].EndingBalance,
+			})
+		}
+
+		// 对账单保存失败时仍然发送邮件，只是不带附件
+		var attachFiles []string
+		if processor.blobStore != nil {
+			key, url, err := processor.storeStatement(ctx, summaries[i].Owner, startTime, data.Accounts)
+			if err != nil {
+				log.Ctx(ctx).Error().Err(err).
+					Str("username", summaries[i].Owner).Msg("failed to store statement")
+			} else {
+				attachFiles = []string{key}
+				data.DownloadURL = url
+			}
+		}
+
+		if err := processor.sendMonthlySummary(summaries[i].Email, summaries[i].Locale, data, attachFiles); err != nil {
+			log.Ctx(ctx).Error().Err(err).
+				Str("username", summaries[i].Owner).Msg("failed to send monthly summary")
+		} else {
+			sent++
+		}
+
+		i = j
+	}
+
+	log.Ctx(ctx).Info().Time("start_time", startTime).
+		Int("sent", sent).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) sendMonthlySummary(to string, locale string, data templates.StatementData, attachFiles []string) error {
+	email, err := templates.Render(templates.Statement, locale, data)
+	if err != nil {
+		return err
+	}
+
+	return processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{to}, nil, nil, attachFiles)
+}
+
+// storeStatement saves the CSV statement of a user in the blob store and returns its key and download URL.
+// The URL is empty if the blob store can't sign URLs.
+func (processor *RedisTaskProcessor) storeStatement(ctx context.Context, owner string, month time.Time, accounts []templates.StatementAccount) (key string, url string, err error) {
+	content, err := statementCSV(accounts)
+	if err != nil {
+		return "", "", err
+	}
+
+	key = fmt.Sprintf("statements/%s/%s.csv", owner, month.Format("2006-01"))
+	err = processor.blobStore.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/csv")
+	if err != nil {
+		return "", "", err
+	}
+
+	url, err = processor.blobStore.SignedURL(ctx, key, statementURLExpiry)
+	if err != nil && !errors.Is(err, storage.ErrSignedURLNotSupported) {
+		return "", "", err
+	}
+	return key, url, nil
+}
+
+func statementCSV(accounts []templates.StatementAccount) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write([]string{"account_id", "currency", "total_in", "total_out", "ending_balance"})
+	for _, account := range accounts {
+		writer.Write([]string{
+			strconv.FormatInt(account.AccountID, 10),
+			account.Currency,
+			strconv.FormatInt(account.TotalIn, 10),
+			strconv.FormatInt(account.TotalOut, 10),
+			strconv.FormatInt(account.EndingBalance, 10),
+		})
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
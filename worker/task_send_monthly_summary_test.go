@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/storage"
+	"github.com/techschool/bank/util"
+)
+
+func TestStoreStatement(t *testing.T) {
+	blobStore, err := storage.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	processor := &RedisTaskProcessor{blobStore: blobStore}
+	month := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	accounts := []templates.StatementAccount{
+		{AccountID: 1, Currency: util.USD, TotalIn: 150, TotalOut: 50, EndingBalance: 1100},
+		{AccountID: 2, Currency: util.EUR, TotalIn: 0, TotalOut: 20, EndingBalance: 80},
+	}
+
+	// 没有配置签名时不返回下载链接
+	key, url, err := processor.storeStatement(context.Background(), "alice", month, accounts)
+	require.NoError(t, err)
+	require.Equal(t, "statements/alice/2024-03.csv", key)
+	require.Empty(t, url)
+
+	reader, err := blobStore.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "account_id,currency,total_in,total_out,ending_balance\n1,USD,150,50,1100\n2,EUR,0,20,80\n", string(content))
+
+	require.NoError(t, blobStore.EnableSignedURLs("http://localhost:8080", util.RandomString(32)))
+	_, url, err = processor.storeStatement(context.Background(), "alice", month, accounts)
+	require.NoError(t, err)
+	require.Contains(t, url, "http://localhost:8080/blobs/statements/alice/2024-03.csv?")
+}
@@ -0,0 +1,127 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+	"github.com/techschool/bank/util"
+)
+
+/*
+用户从一台没见过的设备（或者一个新的网段）登录之后，gapi 会分发 TaskSendNewDeviceAlert 任务，
+给用户发一封安全提醒邮件。邮件里的“不是我本人”链接指向 BLOCK_SESSION_PATH，
+带上会话 ID 和设备 ID 的签名，打开之后这次登录的会话会被封禁，设备记录也会被删掉。
+链接的过期时间和会话的过期时间一致，会话过期之后封禁也就没有意义了。
+*/
+
+const TaskSendNewDeviceAlert = "task:send_new_device_alert"
+
+type PayloadSendNewDeviceAlert struct {
+	Username  string    `json:"username"`
+	SessionID uuid.UUID `json:"session_id"`
+	DeviceID  int64     `json:"device_id"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendNewDeviceAlert(
+	ctx context.Context,
+	payload *PayloadSendNewDeviceAlert,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendNewDeviceAlert, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendNewDeviceAlert(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendNewDeviceAlert
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	session, err := processor.store.GetSession(ctx, payload.SessionID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("session doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	// 用户在邮件发出之前已经把这个会话踢下线了
+	if session.IsBlocked || session.Username != payload.Username {
+		log.Ctx(ctx).Info().Str("session_id", session.ID.String()).
+			Msg("session is no longer active, skip")
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	blockURL, err := processor.blockSessionURL(session, payload.DeviceID)
+	if err != nil {
+		return fmt.Errorf("failed to build block session link: %w", err)
+	}
+
+	email, err := templates.Render(templates.NewDeviceAlert, user.Locale, templates.NewDeviceAlertData{
+		FullName:  user.FullName,
+		LoginTime: templates.FormatTime(session.CreatedAt.UTC(), user.Locale),
+		UserAgent: session.UserAgent,
+		ClientIP:  session.ClientIp,
+		Location:  sessionLocation(session),
+		BlockURL:  blockURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, []string{user.Email}, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send new device alert: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("session_id", session.ID.String()).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
+
+// sessionLocation 返回 "城市, 国家"，会话里没有的部分省略
+func sessionLocation(session db.Session) string {
+	parts := make([]string, 0, 2)
+	for _, part := range []string{session.City, session.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// blockSessionURL 生成“不是我本人”的链接，参数和 gapi 的 BlockSession 校验签名时用的一致
+func (processor *RedisTaskProcessor) blockSessionURL(session db.Session, deviceID int64) (string, error) {
+	params := url.Values{}
+	params.Set("session_id", session.ID.String())
+	params.Set("device_id", strconv.FormatInt(deviceID, 10))
+
+	signer := util.NewLinkSigner(processor.config.LinkSigningKey, util.LinkPurposeBlockSession)
+	params = signer.Sign(params, session.ExpiresAt)
+
+	return util.BuildLink(processor.config.FrontendBaseURL, processor.config.BlockSessionPath, params)
+}
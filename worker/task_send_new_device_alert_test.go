@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+func TestBlockSessionURL(t *testing.T) {
+	config := util.Config{
+		LinkSigningKey:   util.RandomString(32),
+		FrontendBaseURL:  "https://bank.example.com",
+		BlockSessionPath: "/v1/block_session",
+	}
+	processor := &RedisTaskProcessor{config: config}
+
+	session := db.Session{
+		ID:        uuid.New(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	link, err := processor.blockSessionURL(session, 7)
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	require.Equal(t, "bank.example.com", parsed.Host)
+	require.Equal(t, "/v1/block_session", parsed.Path)
+
+	params := parsed.Query()
+	require.Equal(t, session.ID.String(), params.Get("session_id"))
+	require.Equal(t, "7", params.Get("device_id"))
+
+	// 链接的签名要能被 gapi 校验通过，改了设备 ID 就不行了
+	signer := util.NewLinkSigner(config.LinkSigningKey, util.LinkPurposeBlockSession)
+	require.NoError(t, signer.Verify(params))
+
+	params.Set("device_id", "8")
+	require.ErrorIs(t, signer.Verify(params), util.ErrInvalidLinkSignature)
+}
+
+func TestSessionLocation(t *testing.T) {
+	require.Equal(t, "Sydney, AU", sessionLocation(db.Session{Country: "AU", City: "Sydney"}))
+	require.Equal(t, "AU", sessionLocation(db.Session{Country: "AU"}))
+	require.Empty(t, sessionLocation(db.Session{}))
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+/*
+TaskSendNotification 通过某一个渠道发送一条通知，
+具体用哪个 notify.Notifier 由 payload 里的 channel 决定。
+如果这个渠道在当前环境没有配置（比如没有 Twilio 账号），就跳过，不重试。
+*/
+
+const TaskSendNotification = "task:send_notification"
+
+type PayloadSendNotification struct {
+	Channel   string `json:"channel"`
+	Username  string `json:"username"`
+	Recipient string `json:"recipient"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendNotification(
+	ctx context.Context,
+	payload *PayloadSendNotification,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendNotification, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Str("channel", payload.Channel).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendNotification(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendNotification
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	notifier, ok := processor.notifiers[payload.Channel]
+	if !ok {
+		log.Ctx(ctx).Warn().Str("channel", payload.Channel).
+			Msg("notification channel is not configured, skip")
+		return nil
+	}
+
+	err := notifier.Notify(ctx, payload.Recipient, payload.Title, payload.Body)
+	if err != nil {
+		return fmt.Errorf("failed to send %s notification: %w", payload.Channel, err)
+	}
+
+	log.Ctx(ctx).Info().Str("channel", payload.Channel).
+		Str("username", payload.Username).Msg("processed task")
+	return nil
+}
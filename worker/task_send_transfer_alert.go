@@ -0,0 +1,185 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/notify"
+	"github.com/techschool/bank/util"
+)
+
+/*
+转账成功之后，gapi 会分发一个 TaskSendTransferAlert 任务。
+这里根据转出方和转入方各自的通知偏好，给每个开启的渠道（邮件、短信、推送）
+再分发一个 TaskSendNotification 任务，每个渠道单独重试，互不影响。
+
+没有设置过通知偏好的用户默认只发邮件。冲正转账（reversal_of 不为空）用退款的说法通知双方。
+
+BalanceAlerts 是这笔转账触发的余额提醒（低余额、大额扣款），由 TransferTx 在同一个事务里算出来，
+每个提醒再单独给转出方发一条通知。
+*/
+
+const TaskSendTransferAlert = "task:send_transfer_alert"
+
+type PayloadSendTransferAlert struct {
+	TransferID    int64             `json:"transfer_id"`
+	BalanceAlerts []db.BalanceAlert `json:"balance_alerts,omitempty"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendTransferAlert(
+	ctx context.Context,
+	payload *PayloadSendTransferAlert,
+	opts ...asynq.Option,
+) error {
+	task, err := newTask(ctx, TaskSendTransferAlert, payload, opts...)
+	if err != nil {
+		return err
+	}
+	info, err := distributor.enqueue(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Ctx(ctx).Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskSendTransferAlert(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendTransferAlert
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
+	}
+
+	transfer, err := processor.store.GetTransfer(ctx, payload.TransferID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("transfer doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get transfer: %w", err)
+	}
+
+	fromAccount, err := processor.store.GetAccount(ctx, transfer.FromAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get from account: %w", err)
+	}
+
+	toAccount, err := processor.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get to account: %w", err)
+	}
+
+	// 金额按币种的最小单位格式化，例如 1234 USD 显示为 12.34 USD
+	amount := util.Money{Amount: transfer.Amount, Currency: fromAccount.Currency}
+
+	title := "Transfer alert"
+	sentBody := fmt.Sprintf("You sent %s from account #%d to account #%d.",
+		amount, fromAccount.ID, toAccount.ID)
+	receivedBody := fmt.Sprintf("You received %s on account #%d from account #%d.",
+		amount, toAccount.ID, fromAccount.ID)
+	if transfer.ReversalOf.Valid {
+		// 冲正转账的转出方是原来的收款方，转入方是原来的付款方
+		title = "Transfer reversed"
+		sentBody = fmt.Sprintf("Transfer #%d was reversed: %s was returned from account #%d to account #%d.",
+			transfer.ReversalOf.Int64, amount, fromAccount.ID, toAccount.ID)
+		receivedBody = fmt.Sprintf("Transfer #%d was reversed: %s was refunded to account #%d.",
+			transfer.ReversalOf.Int64, amount, toAccount.ID)
+	}
+	// 手续费转到银行的收入账户，只通知付款方
+	notifyReceiver := toAccount.Owner != fromAccount.Owner
+	if transfer.FeeOf.Valid {
+		title = "Transfer fee"
+		sentBody = fmt.Sprintf("A fee of %s was charged to account #%d for transfer #%d.",
+			amount, fromAccount.ID, transfer.FeeOf.Int64)
+		notifyReceiver = false
+	}
+
+	err = processor.fanOutNotification(ctx, fromAccount.Owner, title, sentBody)
+	if err != nil {
+		return err
+	}
+
+	if notifyReceiver {
+		err = processor.fanOutNotification(ctx, toAccount.Owner, title, receivedBody)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, alert := range payload.BalanceAlerts {
+		threshold := util.Money{Amount: alert.Threshold, Currency: fromAccount.Currency}
+		var body string
+		switch alert.Kind {
+		case util.BalanceAlertLowBalance:
+			body = fmt.Sprintf("The balance of account #%d fell below %s after you sent %s.",
+				fromAccount.ID, threshold, amount)
+		case util.BalanceAlertLargeDebit:
+			body = fmt.Sprintf("%s was debited from account #%d, which is at least your alert threshold of %s.",
+				amount, fromAccount.ID, threshold)
+		default:
+			continue
+		}
+
+		err = processor.fanOutNotification(ctx, fromAccount.Owner, "Balance alert", body)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Ctx(ctx).Info().Int64("transfer_id", transfer.ID).
+		Int("balance_alerts", len(payload.BalanceAlerts)).Msg("processed task")
+	return nil
+}
+
+// fanOutNotification 给用户开启的每个渠道分发一个 TaskSendNotification
+func (processor *RedisTaskProcessor) fanOutNotification(ctx context.Context, username string, title string, body string) error {
+	user, err := processor.store.GetUser(ctx, username)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			// 用户已经被删除了，不需要通知
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	preferences, err := processor.store.GetNotificationPreferences(ctx, username)
+	if err != nil {
+		if !errors.Is(err, db.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get notification preferences: %w", err)
+		}
+		preferences = db.NotificationPreference{
+			Username:     username,
+			EmailEnabled: true,
+		}
+	}
+
+	recipients := map[string]string{}
+	if preferences.EmailEnabled {
+		recipients[notify.ChannelEmail] = user.Email
+	}
+	if preferences.SmsEnabled && preferences.PhoneNumber != "" {
+		recipients[notify.ChannelSMS] = preferences.PhoneNumber
+	}
+	if preferences.PushEnabled && preferences.DeviceToken != "" {
+		recipients[notify.ChannelPush] = preferences.DeviceToken
+	}
+
+	for channel, recipient := range recipients {
+		err := processor.distributor.DistributeTaskSendNotification(ctx, &PayloadSendNotification{
+			Channel:   channel,
+			Username:  username,
+			Recipient: recipient,
+			Title:     title,
+			Body:      body,
+		}, asynq.MaxRetry(5), asynq.Queue(QueueDefault))
+		if err != nil {
+			return fmt.Errorf("failed to distribute %s notification: %w", channel, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/notify"
+	"github.com/techschool/bank/util"
+)
+
+// notificationRecorder 记录分发的通知任务
+type notificationRecorder struct {
+	TaskDistributor
+	payloads []*PayloadSendNotification
+}
+
+func (recorder *notificationRecorder) DistributeTaskSendNotification(ctx context.Context, payload *PayloadSendNotification, opts ...asynq.Option) error {
+	recorder.payloads = append(recorder.payloads, payload)
+	return nil
+}
+
+func TestSendTransferAlertWithBalanceAlerts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fromAccount := db.Account{ID: 1, Owner: "alice", Balance: 900, Currency: util.USD}
+	toAccount := db.Account{ID: 2, Owner: "bob", Currency: util.USD}
+	transfer := db.Transfer{ID: 7, FromAccountID: fromAccount.ID, ToAccountID: toAccount.ID, Amount: 600}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTransfer(gomock.Any(), transfer.ID).Times(1).Return(transfer, nil)
+	store.EXPECT().GetAccount(gomock.Any(), fromAccount.ID).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), toAccount.ID).Times(1).Return(toAccount, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, username string) (db.User, error) {
+			return db.User{Username: username, Email: username + "@example.com"}, nil
+		})
+	store.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).AnyTimes().
+		Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+	distributor := &notificationRecorder{}
+	processor := &RedisTaskProcessor{store: store, distributor: distributor}
+
+	payload, err := json.Marshal(&PayloadSendTransferAlert{
+		TransferID: transfer.ID,
+		BalanceAlerts: []db.BalanceAlert{
+			{AccountID: fromAccount.ID, Kind: util.BalanceAlertLargeDebit, Threshold: 500},
+			{AccountID: fromAccount.ID, Kind: util.BalanceAlertLowBalance, Threshold: 1000},
+		},
+	})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskSendTransferAlert(context.Background(), asynq.NewTask(TaskSendTransferAlert, payload))
+	require.NoError(t, err)
+
+	// 转出方和转入方各一条转账提醒，转出方另外每个触发的余额提醒一条
+	require.Len(t, distributor.payloads, 4)
+	for _, notification := range distributor.payloads {
+		require.Equal(t, notify.ChannelEmail, notification.Channel)
+	}
+
+	largeDebit := distributor.payloads[2]
+	require.Equal(t, "alice", largeDebit.Username)
+	require.Equal(t, "Balance alert", largeDebit.Title)
+	require.Contains(t, largeDebit.Body, "6.00 USD was debited from account #1")
+	require.Contains(t, largeDebit.Body, "5.00 USD")
+
+	lowBalance := distributor.payloads[3]
+	require.Equal(t, "alice", lowBalance.Username)
+	require.Contains(t, lowBalance.Body, "fell below 10.00 USD")
+}
+
+func TestSendTransferAlertForReversal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 冲正转账从原来的收款方 bob 转回原来的付款方 alice
+	fromAccount := db.Account{ID: 2, Owner: "bob", Currency: util.USD}
+	toAccount := db.Account{ID: 1, Owner: "alice", Currency: util.USD}
+	transfer := db.Transfer{
+		ID:            8,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        600,
+		ReversalOf:    pgtype.Int8{Int64: 7, Valid: true},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTransfer(gomock.Any(), transfer.ID).Times(1).Return(transfer, nil)
+	store.EXPECT().GetAccount(gomock.Any(), fromAccount.ID).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), toAccount.ID).Times(1).Return(toAccount, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(2).
+		DoAndReturn(func(ctx context.Context, username string) (db.User, error) {
+			return db.User{Username: username, Email: username + "@example.com"}, nil
+		})
+	store.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).Times(2).
+		Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+	distributor := &notificationRecorder{}
+	processor := &RedisTaskProcessor{store: store, distributor: distributor}
+
+	payload, err := json.Marshal(&PayloadSendTransferAlert{TransferID: transfer.ID})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskSendTransferAlert(context.Background(), asynq.NewTask(TaskSendTransferAlert, payload))
+	require.NoError(t, err)
+
+	require.Len(t, distributor.payloads, 2)
+	require.Equal(t, "bob", distributor.payloads[0].Username)
+	require.Equal(t, "Transfer reversed", distributor.payloads[0].Title)
+	require.Equal(t, "Transfer #7 was reversed: 6.00 USD was returned from account #2 to account #1.", distributor.payloads[0].Body)
+	require.Equal(t, "alice", distributor.payloads[1].Username)
+	require.Equal(t, "Transfer #7 was reversed: 6.00 USD was refunded to account #1.", distributor.payloads[1].Body)
+}
+
+func TestSendTransferAlertForFee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fromAccount := db.Account{ID: 1, Owner: "alice", Currency: util.USD}
+	feeAccount := db.Account{ID: 3, Owner: "bank", Currency: util.USD}
+	transfer := db.Transfer{
+		ID:            9,
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   feeAccount.ID,
+		Amount:        75,
+		FeeOf:         pgtype.Int8{Int64: 8, Valid: true},
+	}
+
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetTransfer(gomock.Any(), transfer.ID).Times(1).Return(transfer, nil)
+	store.EXPECT().GetAccount(gomock.Any(), fromAccount.ID).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), feeAccount.ID).Times(1).Return(feeAccount, nil)
+	store.EXPECT().GetUser(gomock.Any(), "alice").Times(1).Return(db.User{Username: "alice", Email: "alice@example.com"}, nil)
+	store.EXPECT().GetNotificationPreferences(gomock.Any(), gomock.Any()).Times(1).
+		Return(db.NotificationPreference{}, db.ErrRecordNotFound)
+
+	distributor := &notificationRecorder{}
+	processor := &RedisTaskProcessor{store: store, distributor: distributor}
+
+	payload, err := json.Marshal(&PayloadSendTransferAlert{TransferID: transfer.ID})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskSendTransferAlert(context.Background(), asynq.NewTask(TaskSendTransferAlert, payload))
+	require.NoError(t, err)
+
+	require.Len(t, distributor.payloads, 1)
+	require.Equal(t, "alice", distributor.payloads[0].Username)
+	require.Equal(t, "Transfer fee", distributor.payloads[0].Title)
+	require.Equal(t, "A fee of 0.75 USD was charged to account #1 for transfer #8.", distributor.payloads[0].Body)
+}
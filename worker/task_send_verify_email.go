@@ -2,12 +2,16 @@ package worker
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
 	"github.com/techschool/bank/util"
 )
 
@@ -22,35 +26,28 @@ ProcessTaskSendVerifyEmail 方法是具体的任务处理逻辑，它从队列
 
 const TaskSendVerifyEmail = "task:send_verify_email"
 
+// VerifyEmailUniqueTTL 同一个用户在这段时间内只会有一个还没发出去的验证邮件任务
+const VerifyEmailUniqueTTL = time.Hour
+
 type PayloadSendVerifyEmail struct {
 	Username string `json:"username"`
+	// Reminder 为 true 时发送提醒邮件（见 TaskSendVerifyEmailReminders），而不是注册时的欢迎邮件
+	Reminder bool `json:"reminder,omitempty"`
 }
 
+// DistributeTaskSendVerifyEmail 的唯一性由任务类型 + username 决定，重复分发会被忽略
 func (distributor *RedisTaskDistributor) DistributeTaskSendVerifyEmail(
 	ctx context.Context,
 	payload *PayloadSendVerifyEmail,
 	opts ...asynq.Option,
 ) error {
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal task payload: %w", err)
-	}
-
-	task := asynq.NewTask(TaskSendVerifyEmail, jsonPayload, opts...)
-	info, err := distributor.client.EnqueueContext(ctx, task)
-	if err != nil {
-		return fmt.Errorf("failed to enqueue task: %w", err)
-	}
-
-	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
-		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
-	return nil
+	return distributor.DistributeUnique(ctx, TaskSendVerifyEmail, payload, VerifyEmailUniqueTTL, opts...)
 }
 
 func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error {
 	var payload PayloadSendVerifyEmail
-	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
-		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	if err := decodeTaskPayload(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to decode payload: %w", err)
 	}
 
 	user, err := processor.store.GetUser(ctx, payload.Username)
@@ -58,6 +55,13 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to get user: %w", err)
 	}
 
+	// 提醒任务分发之后用户可能已经验证了邮箱，或者账户已经被停用
+	if payload.Reminder && (user.IsEmailVerified || user.IsDisabled) {
+		log.Ctx(ctx).Info().Str("username", user.Username).
+			Msg("user is already verified or disabled, skip reminder")
+		return nil
+	}
+
 	verifyEmail, err := processor.store.CreateVerifyEmail(ctx, db.CreateVerifyEmailParams{
 		Username:   user.Username,
 		Email:      user.Email,
@@ -67,26 +71,67 @@ func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmail(ctx context.Cont
 		return fmt.Errorf("failed to create verify email: %w", err)
 	}
 
-	subject := "Welcome to Simple Bank"
-	// TODO: replace this URL with an environment variable that points to a front-end page
-	verifyUrl := fmt.Sprintf("http://localhost:8080/v1/verify_email?email_id=%d&secret_code=%s",
-		verifyEmail.ID, verifyEmail.SecretCode)
-	content := fmt.Sprintf(`Hello %s,<br/>
-	Thank you for registering with us!<br/>
-	Please <a href="%s">click here</a> to verify your email address.<br/>
-	`, user.FullName, verifyUrl)
+	data, err := processor.verifyEmailData(user, verifyEmail)
+	if err != nil {
+		return fmt.Errorf("failed to build verify email link: %w", err)
+	}
+
+	var email *templates.Email
+	if payload.Reminder {
+		email, err = templates.Render(templates.VerifyEmailReminder, user.Locale, templates.VerifyEmailReminderData{
+			VerifyEmailData: data,
+			DisableDate:     processor.unverifiedDisableDate(user),
+		})
+	} else {
+		email, err = templates.Render(templates.VerifyEmail, user.Locale, data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render verify email: %w", err)
+	}
 	to := []string{user.Email}
 
-	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	err = processor.mailer.SendEmail(email.Subject, email.HTML, email.Text, to, nil, nil, nil)
 	if err != nil {
 		return fmt.Errorf("failed to send verify email: %w", err)
 	}
 
-	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+	log.Ctx(ctx).Info().Bytes("payload", task.Payload()).
 		Str("email", user.Email).Msg("processed task")
 	return nil
 }
 
+// verifyEmailData 生成邮件里的验证链接：网页链接指向 FRONTEND_BASE_URL，
+// 配置了 MOBILE_DEEP_LINK_URL 的话再加一个打开 app 的链接。
+// 两个链接都带有签名的过期时间，和数据库里 verify_emails 的 expired_at 一致。
+func (processor *RedisTaskProcessor) verifyEmailData(user db.User, verifyEmail db.VerifyEmail) (templates.VerifyEmailData, error) {
+	params := url.Values{}
+	params.Set("email_id", strconv.FormatInt(verifyEmail.ID, 10))
+	params.Set("secret_code", verifyEmail.SecretCode)
+
+	signer := util.NewLinkSigner(processor.config.LinkSigningKey, util.LinkPurposeVerifyEmail)
+	params = signer.Sign(params, verifyEmail.ExpiredAt)
+
+	data := templates.VerifyEmailData{
+		FullName: user.FullName,
+	}
+
+	var err error
+	data.VerifyURL, err = util.BuildLink(processor.config.FrontendBaseURL, processor.config.VerifyEmailPath, params)
+	if err != nil {
+		return data, err
+	}
+
+	if processor.config.MobileDeepLinkURL != "" {
+		deepLink, err := util.BuildLink(processor.config.MobileDeepLinkURL, processor.config.VerifyEmailPath, params)
+		if err != nil {
+			return data, err
+		}
+		data.DeepLinkURL = htmltemplate.URL(deepLink)
+	}
+
+	return data, nil
+}
+
 /*
 用户注册：
 
@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/mail/templates"
+)
+
+/*
+由 scheduler 每 15 分钟触发一次，处理还没有验证邮箱的用户：
+  - 注册超过 VERIFY_EMAIL_REMINDER_AFTER 的用户发一封提醒邮件，之后每隔同样的时间再发一次，
+    每个用户最多发 VERIFY_EMAIL_MAX_REMINDERS 封，发过几封记录在 users.verify_email_reminders 里。
+  - 注册超过 UNVERIFIED_DISABLE_AFTER 还没有验证的用户被停用，同时 block 掉所有 session，和管理员调用 DisableUser 一样。
+
+提醒邮件还是由 TaskSendVerifyEmail 发送（Reminder 为 true），会生成新的验证链接。
+先分发任务再增加计数：增加计数失败时任务重试，重复分发的任务被 DistributeUnique 忽略；
+计数用乐观锁更新，同时有两个任务在跑也只会算一次。
+
+配置为 0 时不发提醒或者不停用账户。每次最多处理 verifyEmailRemindersBatchSize 个用户，剩下的留给下一次。
+*/
+
+const TaskSendVerifyEmailReminders = "task:send_verify_email_reminders"
+
+const SendVerifyEmailRemindersCronspec = "@every 15m"
+
+const verifyEmailRemindersBatchSize = 100
+
+func (processor *RedisTaskProcessor) ProcessTaskSendVerifyEmailReminders(ctx context.Context, task *asynq.Task) error {
+	reminded, err := processor.sendVerifyEmailReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	disabled, err := processor.disableUnverifiedUsers(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Info().Int("reminded", reminded).Int("disabled", disabled).Msg("processed task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) sendVerifyEmailReminders(ctx context.Context) (int, error) {
+	config := processor.config
+	if config.VerifyEmailReminderAfter <= 0 || config.VerifyEmailMaxReminders <= 0 {
+		return 0, nil
+	}
+
+	users, err := processor.store.ListUsersDueForVerifyEmailReminder(ctx, db.ListUsersDueForVerifyEmailReminderParams{
+		MaxReminders: config.VerifyEmailMaxReminders,
+		RemindBefore: time.Now().Add(-config.VerifyEmailReminderAfter),
+		Limit:        verifyEmailRemindersBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list users due for verify email reminder: %w", err)
+	}
+
+	reminded := 0
+	for _, user := range users {
+		err := processor.distributor.DistributeTaskSendVerifyEmail(ctx, &PayloadSendVerifyEmail{
+			Username: user.Username,
+			Reminder: true,
+		}, asynq.MaxRetry(10), asynq.Queue(QueueDefault))
+		if err != nil {
+			return reminded, fmt.Errorf("failed to distribute verify email reminder: %w", err)
+		}
+
+		rows, err := processor.store.MarkVerifyEmailReminderSent(ctx, db.MarkVerifyEmailReminderSentParams{
+			Username:             user.Username,
+			VerifyEmailReminders: user.VerifyEmailReminders,
+		})
+		if err != nil {
+			return reminded, fmt.Errorf("failed to mark verify email reminder sent: %w", err)
+		}
+		if rows > 0 {
+			reminded++
+		}
+	}
+	return reminded, nil
+}
+
+func (processor *RedisTaskProcessor) disableUnverifiedUsers(ctx context.Context) (int, error) {
+	if processor.config.UnverifiedDisableAfter <= 0 {
+		return 0, nil
+	}
+
+	users, err := processor.store.ListUnverifiedUsers(ctx, db.ListUnverifiedUsersParams{
+		CreatedBefore: time.Now().Add(-processor.config.UnverifiedDisableAfter),
+		Limit:         verifyEmailRemindersBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unverified users: %w", err)
+	}
+
+	disabled := 0
+	for _, user := range users {
+		// 用户在这期间验证了邮箱的话 updated_at 会变，更新不到任何一行，这个用户就不会被停用
+		_, err := processor.store.UpdateUserTx(ctx, db.UpdateUserTxParams{
+			UpdateUserParams: db.UpdateUserParams{
+				Username:          user.Username,
+				IsDisabled:        pgtype.Bool{Bool: true, Valid: true},
+				ExpectedUpdatedAt: pgtype.Timestamptz{Time: user.UpdatedAt, Valid: true},
+			},
+			BlockSessions: true,
+		})
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				continue
+			}
+			return disabled, fmt.Errorf("failed to disable unverified user: %w", err)
+		}
+
+		log.Ctx(ctx).Info().Str("username", user.Username).Time("created_at", user.CreatedAt).
+			Msg("disabled user with unverified email")
+		disabled++
+	}
+	return disabled, nil
+}
+
+// unverifiedDisableDate returns the date the user will be disabled if the email isn't verified, or "" if it won't be
+func (processor *RedisTaskProcessor) unverifiedDisableDate(user db.User) string {
+	if processor.config.UnverifiedDisableAfter <= 0 {
+		return ""
+	}
+	return templates.FormatDate(user.CreatedAt.Add(processor.config.UnverifiedDisableAfter), user.Locale)
+}
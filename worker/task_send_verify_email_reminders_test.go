@@ -0,0 +1,132 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/techschool/bank/db/mock"
+	db "github.com/techschool/bank/db/sqlc"
+	"github.com/techschool/bank/util"
+)
+
+// verifyEmailRecorder 记录分发的验证邮件任务
+type verifyEmailRecorder struct {
+	TaskDistributor
+	payloads []*PayloadSendVerifyEmail
+}
+
+func (recorder *verifyEmailRecorder) DistributeTaskSendVerifyEmail(ctx context.Context, payload *PayloadSendVerifyEmail, opts ...asynq.Option) error {
+	recorder.payloads = append(recorder.payloads, payload)
+	return nil
+}
+
+func TestSendVerifyEmailReminders(t *testing.T) {
+	alice := db.User{Username: "alice", VerifyEmailReminders: 1, UpdatedAt: time.Now().Add(-time.Hour)}
+	bob := db.User{Username: "bob"}
+
+	config := util.Config{
+		VerifyEmailReminderAfter: 24 * time.Hour,
+		VerifyEmailMaxReminders:  3,
+		UnverifiedDisableAfter:   7 * 24 * time.Hour,
+	}
+
+	testCases := []struct {
+		name       string
+		config     util.Config
+		buildStubs func(store *mockdb.MockStore)
+		reminders  []*PayloadSendVerifyEmail
+	}{
+		{
+			name:   "RemindAndDisable",
+			config: config,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListUsersDueForVerifyEmailReminder(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.ListUsersDueForVerifyEmailReminderParams) ([]db.User, error) {
+						require.Equal(t, int32(3), arg.MaxReminders)
+						require.WithinDuration(t, time.Now().Add(-24*time.Hour), arg.RemindBefore, time.Minute)
+						return []db.User{alice, bob}, nil
+					})
+				store.EXPECT().
+					MarkVerifyEmailReminderSent(gomock.Any(), gomock.Eq(db.MarkVerifyEmailReminderSentParams{
+						Username:             "alice",
+						VerifyEmailReminders: 1,
+					})).
+					Times(1).
+					Return(int64(1), nil)
+				// 另一个任务已经提醒过 bob，不重复计数
+				store.EXPECT().
+					MarkVerifyEmailReminderSent(gomock.Any(), gomock.Eq(db.MarkVerifyEmailReminderSentParams{
+						Username: "bob",
+					})).
+					Times(1).
+					Return(int64(0), nil)
+
+				store.EXPECT().
+					ListUnverifiedUsers(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.User{alice, bob}, nil)
+				store.EXPECT().
+					UpdateUserTx(gomock.Any(), gomock.Any()).
+					Times(2).
+					DoAndReturn(func(ctx context.Context, arg db.UpdateUserTxParams) (db.UpdateUserTxResult, error) {
+						require.True(t, arg.IsDisabled.Bool)
+						require.True(t, arg.BlockSessions)
+						require.True(t, arg.ExpectedUpdatedAt.Valid)
+						// bob 刚刚验证了邮箱，updated_at 已经变了
+						if arg.Username == "bob" {
+							return db.UpdateUserTxResult{}, db.ErrRecordNotFound
+						}
+						require.Equal(t, alice.UpdatedAt, arg.ExpectedUpdatedAt.Time)
+						return db.UpdateUserTxResult{}, nil
+					})
+			},
+			reminders: []*PayloadSendVerifyEmail{
+				{Username: "alice", Reminder: true},
+				{Username: "bob", Reminder: true},
+			},
+		},
+		{
+			name:   "Disabled",
+			config: util.Config{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListUsersDueForVerifyEmailReminder(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListUnverifiedUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			distributor := &verifyEmailRecorder{}
+			processor := &RedisTaskProcessor{config: tc.config, store: store, distributor: distributor}
+
+			err := processor.ProcessTaskSendVerifyEmailReminders(context.Background(), asynq.NewTask(TaskSendVerifyEmailReminders, nil))
+			require.NoError(t, err)
+			require.Equal(t, tc.reminders, distributor.payloads)
+		})
+	}
+}
+
+func TestUnverifiedDisableDate(t *testing.T) {
+	user := db.User{CreatedAt: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC), Locale: "en"}
+
+	processor := &RedisTaskProcessor{config: util.Config{UnverifiedDisableAfter: 7 * 24 * time.Hour}}
+	require.Equal(t, "March 8, 2024", processor.unverifiedDisableDate(user))
+
+	processor = &RedisTaskProcessor{}
+	require.Empty(t, processor.unverifiedDisableDate(user))
+}